@@ -30,48 +30,56 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xcf, 0x0b, 0x83, 0x05, 0x02, 0x99, 0x1f, 0x12, 0x1d, 0x0c, 0x82, 0xfd, 0xa2, 0x16,
-	0xa9, 0x88, 0x16, 0x68, 0xd3, 0x36, 0xa4, 0x82, 0x14, 0xd1, 0x2b, 0x2a, 0x12, 0x0b, 0x72, 0xef,
-	0x5e, 0xdb, 0x53, 0x2f, 0xf1, 0x61, 0x3b, 0x29, 0x9d, 0x60, 0x64, 0x42, 0x30, 0x21, 0x21, 0x31,
-	0xb1, 0x80, 0xc4, 0x7f, 0x80, 0x84, 0xc4, 0xc6, 0xd8, 0xb1, 0x23, 0xb9, 0x2c, 0x8c, 0xfd, 0x13,
-	0x50, 0x48, 0xec, 0x5c, 0x9a, 0x34, 0xf2, 0x5d, 0xb2, 0xe5, 0xa4, 0xf7, 0xf9, 0xbe, 0x8f, 0xcf,
-	0xb9, 0x67, 0xe3, 0x69, 0x05, 0xd5, 0x98, 0x0b, 0x16, 0x15, 0x24, 0x88, 0x06, 0x88, 0x02, 0x8b,
-	0xc3, 0x02, 0x0b, 0xaa, 0x61, 0xad, 0xfd, 0x1c, 0xfa, 0x50, 0x68, 0x4c, 0x17, 0xba, 0x3f, 0xdd,
-	0x58, 0x70, 0xc5, 0xc9, 0x4d, 0x8d, 0xb8, 0x1d, 0xc4, 0x65, 0x71, 0xe8, 0xa6, 0x11, 0xb7, 0x31,
-	0x3d, 0x35, 0x67, 0x93, 0x2b, 0xe0, 0x65, 0x1d, 0xa4, 0x7a, 0x21, 0x40, 0xc6, 0xbc, 0x26, 0xbb,
-	0x0d, 0x66, 0xbe, 0x5d, 0xc6, 0x67, 0x8b, 0xed, 0xd2, 0x8d, 0x4e, 0x29, 0xf9, 0x8e, 0xf0, 0xd5,
-	0x15, 0x90, 0xbe, 0x08, 0xb7, 0xe0, 0x19, 0x17, 0x7b, 0xdb, 0x11, 0xdf, 0x2f, 0xbd, 0x02, 0xbf,
-	0xae, 0x42, 0x5e, 0x23, 0x25, 0xd7, 0x42, 0xc8, 0x3d, 0x95, 0xf7, 0x3a, 0x12, 0x53, 0x0f, 0xc6,
-	0x8d, 0xe9, 0xac, 0xe1, 0x86, 0x43, 0x3e, 0x21, 0x7c, 0x51, 0xd7, 0xad, 0x86, 0x52, 0x71, 0x71,
-	0xb0, 0xca, 0xa5, 0x22, 0x0b, 0x99, 0x3a, 0xa4, 0x48, 0xad, 0xb8, 0x98, 0x3f, 0xc0, 0xc8, 0xbd,
-	0xc6, 0x78, 0x39, 0xe2, 0x12, 0x36, 0x76, 0x99, 0x08, 0xc8, 0xac, 0x55, 0x62, 0x0f, 0xd0, 0x26,
-	0xb7, 0x33, 0x73, 0x69, 0x01, 0x0f, 0xaa, 0xbc, 0x01, 0x4f, 0x99, 0xdc, 0xb3, 0x14, 0xe8, 0x01,
-	0xd9, 0x04, 0xd2, 0x9c, 0x11, 0xf8, 0x81, 0x30, 0x2d, 0x83, 0x1a, 0xdc, 0x41, 0xb6, 0xdf, 0x7d,
-	0x65, 0xe4, 0xa1, 0x55, 0xfa, 0xe8, 0x10, 0x6d, 0xfa, 0x68, 0x22, 0x59, 0xc6, 0xfe, 0x17, 0xc2,
-	0xd7, 0x47, 0x17, 0x6f, 0xce, 0x90, 0xca, 0x04, 0x7a, 0x6e, 0xce, 0xe8, 0x15, 0xac, 0x4d, 0x28,
-	0xcd, 0xac, 0xe1, 0x0b, 0xc2, 0x57, 0xca, 0xa0, 0x3c, 0x88, 0xa3, 0xd0, 0x67, 0xed, 0xc2, 0x35,
-	0x90, 0x92, 0xed, 0x80, 0x24, 0x4b, 0xb6, 0xbd, 0x86, 0xc0, 0xda, 0x77, 0x79, 0xac, 0x0c, 0x63,
-	0xf9, 0x13, 0xe1, 0x6b, 0x65, 0x50, 0x8f, 0x59, 0x15, 0x64, 0xcc, 0x7c, 0x18, 0xa6, 0x6b, 0xbd,
-	0xb9, 0xa3, 0x52, 0xb4, 0x77, 0x65, 0x32, 0x61, 0x66, 0x01, 0xed, 0xb1, 0x59, 0x06, 0xb5, 0x52,
-	0x59, 0x1f, 0xa6, 0x5e, 0xb2, 0xed, 0x36, 0x9c, 0xcf, 0x36, 0x36, 0x47, 0xc4, 0x18, 0xdd, 0xb7,
-	0x08, 0x9f, 0xf3, 0x80, 0xc5, 0x71, 0x74, 0x50, 0x6a, 0x40, 0x4d, 0x49, 0x72, 0xc7, 0xf2, 0x23,
-	0x4f, 0x31, 0x5a, 0x6b, 0x2e, 0x0f, 0x6a, 0x54, 0x3e, 0x22, 0x4c, 0x8a, 0x41, 0xb0, 0x01, 0x4c,
-	0xf8, 0xbb, 0x45, 0xa5, 0x44, 0xb8, 0x55, 0x57, 0x40, 0xee, 0x5b, 0x85, 0x0e, 0x82, 0x5a, 0x6a,
-	0x21, 0x37, 0x6f, 0xcc, 0xde, 0x21, 0x7c, 0x5e, 0x0f, 0xf8, 0xe5, 0xa8, 0x2e, 0x15, 0x08, 0x32,
-	0x9f, 0xe9, 0x58, 0xe8, 0x52, 0xda, 0xe9, 0x6e, 0x3e, 0xb8, 0x4f, 0xc8, 0x03, 0x16, 0xac, 0x54,
-	0xd6, 0xcd, 0x5f, 0x6b, 0xde, 0xf6, 0xe5, 0xa7, 0xa9, 0x6c, 0x42, 0x03, 0xb0, 0x11, 0xfa, 0x80,
-	0xf0, 0x85, 0x27, 0x75, 0xb1, 0x03, 0x69, 0x23, 0xbb, 0xd0, 0x93, 0x98, 0x56, 0xba, 0x97, 0x93,
-	0xee, 0x73, 0x5a, 0x83, 0x5c, 0x4e, 0x27, 0xb1, 0x6c, 0x4e, 0x83, 0xb4, 0x71, 0xfa, 0x8c, 0xf0,
-	0x25, 0x0f, 0xb6, 0x05, 0xc8, 0x5d, 0x3d, 0xb7, 0xdb, 0x07, 0xa5, 0x24, 0x8b, 0x96, 0x1b, 0x30,
-	0x88, 0x6a, 0xb7, 0xe2, 0x18, 0x09, 0x7d, 0x87, 0x84, 0x07, 0x12, 0x6a, 0x41, 0x6a, 0x6c, 0x74,
-	0x0c, 0x97, 0x2c, 0xf3, 0x87, 0xc1, 0xd9, 0x0e, 0x89, 0xd3, 0x32, 0xb4, 0xe5, 0x52, 0x74, 0xd8,
-	0xa4, 0xce, 0x51, 0x93, 0x3a, 0xc7, 0x4d, 0x8a, 0xde, 0x24, 0x14, 0x7d, 0x4d, 0x28, 0xfa, 0x9d,
-	0x50, 0x74, 0x98, 0x50, 0xf4, 0x27, 0xa1, 0xe8, 0x6f, 0x42, 0x9d, 0xe3, 0x84, 0xa2, 0xf7, 0x2d,
-	0xea, 0x1c, 0xb6, 0xa8, 0x73, 0xd4, 0xa2, 0xce, 0xf3, 0xd9, 0x1d, 0xde, 0x6b, 0x1f, 0xf2, 0x11,
-	0x77, 0xe4, 0xf9, 0xf4, 0xf3, 0xd6, 0x99, 0xff, 0x17, 0xe4, 0x5b, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xf7, 0x6b, 0xce, 0xc9, 0xb6, 0x0b, 0x00, 0x00,
+	// 783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0x87, 0x33, 0x17, 0x0f, 0x83, 0xa2, 0xae, 0x22, 0x5a, 0x70, 0x15, 0xbd, 0x27, 0xb4, 0x42,
+	0xc5, 0x56, 0xdb, 0xe6, 0x9f, 0x29, 0x9a, 0x88, 0x4d, 0xb5, 0x82, 0x17, 0x99, 0x6e, 0xde, 0x24,
+	0x43, 0x37, 0x99, 0x75, 0x66, 0x92, 0xda, 0x93, 0x1e, 0x3d, 0x89, 0x82, 0x20, 0x08, 0x9e, 0xbc,
+	0x28, 0xf8, 0x0d, 0x04, 0xc1, 0x9b, 0xc7, 0x1e, 0xeb, 0xcd, 0xa6, 0x17, 0x8f, 0xfd, 0x08, 0x12,
+	0x93, 0x99, 0xee, 0x66, 0xb7, 0x61, 0x76, 0x93, 0x5b, 0x43, 0xe7, 0xf9, 0xcd, 0x33, 0x33, 0x99,
+	0xf7, 0x9d, 0xe0, 0x59, 0x09, 0x2d, 0x8f, 0x71, 0xe2, 0x66, 0x04, 0xf0, 0x2e, 0xf0, 0x0c, 0xf1,
+	0x68, 0x86, 0xd4, 0x5a, 0xb4, 0xdd, 0xff, 0x4c, 0x1d, 0xc8, 0x74, 0x67, 0x33, 0xc3, 0x3f, 0xd3,
+	0x1e, 0x67, 0x92, 0x59, 0xd7, 0x15, 0x92, 0x1e, 0x20, 0x69, 0xe2, 0xd1, 0xb4, 0x1f, 0x49, 0x77,
+	0x67, 0x67, 0x16, 0x4c, 0x72, 0x39, 0x3c, 0xef, 0x80, 0x90, 0xcf, 0x38, 0x08, 0x8f, 0xb5, 0xc5,
+	0x70, 0x82, 0xb9, 0xdf, 0x97, 0xf1, 0xc9, 0x6c, 0x7f, 0xe8, 0xfa, 0x60, 0xa8, 0xf5, 0x0d, 0xe1,
+	0x4b, 0x05, 0x10, 0x0e, 0xa7, 0x9b, 0xf0, 0x84, 0xf1, 0xad, 0xba, 0xcb, 0xb6, 0x8b, 0x2f, 0xc0,
+	0xe9, 0x48, 0xca, 0xda, 0x56, 0x31, 0x6d, 0x20, 0x94, 0x3e, 0x96, 0xaf, 0x0e, 0x24, 0x66, 0xee,
+	0x4e, 0x1a, 0x33, 0x58, 0xc3, 0xb5, 0x94, 0xf5, 0x11, 0xe1, 0x73, 0x6a, 0xdc, 0x2a, 0x15, 0x92,
+	0xf1, 0x9d, 0x55, 0x26, 0xa4, 0xb5, 0x1c, 0x6b, 0x06, 0x1f, 0xa9, 0x14, 0x57, 0x92, 0x07, 0x68,
+	0xb9, 0x97, 0x18, 0xe7, 0x5d, 0x26, 0x60, 0xbd, 0x49, 0x78, 0xcd, 0x9a, 0x37, 0x4a, 0x3c, 0x02,
+	0x94, 0xc9, 0xcd, 0xd8, 0x9c, 0x5f, 0xa0, 0x0a, 0x2d, 0xd6, 0x85, 0x47, 0x44, 0x6c, 0x19, 0x0a,
+	0x1c, 0x01, 0xf1, 0x04, 0xfc, 0x9c, 0x16, 0xf8, 0x8e, 0xb0, 0x5d, 0x02, 0x19, 0x3e, 0x41, 0xb2,
+	0x3d, 0xdc, 0x32, 0xeb, 0x9e, 0x51, 0xfa, 0xf8, 0x10, 0x65, 0x7a, 0x7f, 0x2a, 0x59, 0xda, 0xfe,
+	0x27, 0xc2, 0x57, 0xc7, 0x0f, 0xde, 0x98, 0xb3, 0xca, 0x53, 0x98, 0x73, 0x63, 0x4e, 0xad, 0xa0,
+	0x32, 0xa5, 0x34, 0xbd, 0x86, 0xcf, 0x08, 0x5f, 0x28, 0x81, 0xac, 0x82, 0xe7, 0x52, 0x87, 0xf4,
+	0x07, 0x56, 0x40, 0x08, 0xd2, 0x00, 0x61, 0xe5, 0x4c, 0xe7, 0x8a, 0x80, 0x95, 0x6f, 0x7e, 0xa2,
+	0x0c, 0x6d, 0xf9, 0x03, 0xe1, 0x2b, 0x25, 0x90, 0x0f, 0x48, 0x0b, 0x84, 0x47, 0x1c, 0x88, 0xd2,
+	0x35, 0x3e, 0xdc, 0x71, 0x29, 0xca, 0xbb, 0x3c, 0x9d, 0x30, 0xbd, 0x80, 0x7e, 0xd9, 0x2c, 0x81,
+	0x2c, 0x94, 0xd7, 0xa2, 0xd4, 0x8b, 0xa6, 0xb3, 0x45, 0xf3, 0xf1, 0xca, 0xe6, 0x98, 0x18, 0xad,
+	0xfb, 0x1a, 0xe1, 0x53, 0x55, 0x20, 0x9e, 0xe7, 0xee, 0x14, 0xbb, 0xd0, 0x96, 0xc2, 0xba, 0x65,
+	0x78, 0xc9, 0x7d, 0x8c, 0xd2, 0x5a, 0x48, 0x82, 0x6a, 0x95, 0x0f, 0x08, 0x5b, 0xd9, 0x5a, 0x6d,
+	0x1d, 0x08, 0x77, 0x9a, 0x59, 0x29, 0x39, 0xdd, 0xec, 0x48, 0xb0, 0x96, 0x8c, 0x42, 0xc3, 0xa0,
+	0x92, 0x5a, 0x4e, 0xcc, 0x6b, 0xb3, 0x37, 0x08, 0x9f, 0x56, 0x05, 0x3e, 0xef, 0x76, 0x84, 0x04,
+	0x6e, 0x2d, 0xc6, 0x6a, 0x0b, 0x43, 0x4a, 0x39, 0xdd, 0x4e, 0x06, 0x07, 0x84, 0xaa, 0x40, 0x6a,
+	0x85, 0xf2, 0x9a, 0xfe, 0x6a, 0x2d, 0x9a, 0x6e, 0xbe, 0x9f, 0x8a, 0x27, 0x14, 0x82, 0xb5, 0xd0,
+	0x3b, 0x84, 0xcf, 0x3c, 0xec, 0xf0, 0x06, 0xf8, 0x8d, 0xcc, 0x42, 0x47, 0x31, 0xa5, 0x74, 0x27,
+	0x21, 0x1d, 0x70, 0xaa, 0x40, 0x22, 0xa7, 0x0a, 0x4c, 0xe2, 0x54, 0x81, 0x63, 0x9d, 0x3e, 0x21,
+	0x7c, 0xbe, 0x0a, 0x75, 0x0e, 0xa2, 0xa9, 0xea, 0x76, 0xbf, 0x51, 0x0a, 0x6b, 0xc5, 0xf0, 0x00,
+	0xc2, 0xa8, 0x72, 0xcb, 0x4e, 0x90, 0x10, 0x68, 0x12, 0x55, 0x10, 0xd0, 0xae, 0xf9, 0xca, 0xc6,
+	0xc0, 0x30, 0x67, 0x98, 0x1f, 0x05, 0xc7, 0x6b, 0x12, 0xc7, 0x65, 0x04, 0x4e, 0xb6, 0x5f, 0xdc,
+	0x76, 0xda, 0xa4, 0x45, 0x9d, 0x3c, 0x6b, 0xd7, 0x69, 0xc3, 0xf0, 0x64, 0x47, 0xb1, 0x78, 0x27,
+	0x1b, 0xa6, 0x03, 0xef, 0xcf, 0xc7, 0x5e, 0x8d, 0x48, 0x08, 0x6a, 0x99, 0x95, 0x9f, 0x08, 0x32,
+	0xde, 0xfb, 0x33, 0x32, 0x40, 0xcb, 0xbd, 0x47, 0xf8, 0x6c, 0x99, 0x8a, 0x91, 0x1d, 0x33, 0x5b,
+	0x73, 0x88, 0x53, 0x62, 0x4b, 0x49, 0x71, 0xad, 0xf5, 0x15, 0xe1, 0x8b, 0xa1, 0xff, 0xab, 0xe7,
+	0x60, 0x21, 0x59, 0xfc, 0xc8, 0x43, 0xb0, 0x38, 0x61, 0x4a, 0x60, 0x0b, 0x0b, 0x9d, 0x96, 0x97,
+	0x64, 0x0b, 0x43, 0x5c, 0xbc, 0x2d, 0x8c, 0xc0, 0x47, 0x5a, 0x93, 0x0b, 0x12, 0xf4, 0xfb, 0xc4,
+	0xb8, 0x35, 0x05, 0xa8, 0xb8, 0xad, 0x69, 0x04, 0x0e, 0xdc, 0xcd, 0x1c, 0x71, 0xb6, 0xea, 0xd4,
+	0x75, 0xb3, 0xdc, 0x69, 0xd2, 0x2e, 0x71, 0x0d, 0xef, 0xe6, 0x28, 0x16, 0xef, 0x6e, 0x86, 0x69,
+	0xe5, 0x94, 0x73, 0x77, 0xf7, 0xed, 0xd4, 0xde, 0xbe, 0x9d, 0x3a, 0xdc, 0xb7, 0xd1, 0xab, 0x9e,
+	0x8d, 0xbe, 0xf4, 0x6c, 0xf4, 0xab, 0x67, 0xa3, 0xdd, 0x9e, 0x8d, 0xfe, 0xf4, 0x6c, 0xf4, 0xb7,
+	0x67, 0xa7, 0x0e, 0x7b, 0x36, 0x7a, 0x7b, 0x60, 0xa7, 0x76, 0x0f, 0xec, 0xd4, 0xde, 0x81, 0x9d,
+	0x7a, 0x3a, 0xdf, 0x60, 0x47, 0x13, 0x53, 0x36, 0xe6, 0x37, 0xf5, 0xa2, 0xff, 0xf3, 0xe6, 0x89,
+	0xff, 0x3f, 0xa8, 0x6f, 0xfc, 0x1b, 0x00, 0x93, 0xac, 0xfc, 0x43, 0xe6, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -122,6 +130,18 @@ type AdminServiceClient interface {
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
+	// GetDynamicConfig returns the values of a dynamic config key stored in the persistence store.
+	GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error)
+	// UpdateDynamicConfig replaces the values of a dynamic config key stored in the persistence store.
+	UpdateDynamicConfig(ctx context.Context, in *UpdateDynamicConfigRequest, opts ...grpc.CallOption) (*UpdateDynamicConfigResponse, error)
+	// ListDynamicConfig returns all the dynamic config values stored in the persistence store.
+	ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error)
+	// ListDynamicConfigHistory returns the changes of the dynamic config stored in the persistence store.
+	ListDynamicConfigHistory(ctx context.Context, in *ListDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*ListDynamicConfigHistoryResponse, error)
+	// DumpDynamicConfig returns the effective value of every dynamic config key for a namespace and a task queue.
+	DumpDynamicConfig(ctx context.Context, in *DumpDynamicConfigRequest, opts ...grpc.CallOption) (*DumpDynamicConfigResponse, error)
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+	BackfillArchival(ctx context.Context, in *BackfillArchivalRequest, opts ...grpc.CallOption) (*BackfillArchivalResponse, error)
 }
 
 type adminServiceClient struct {
@@ -285,6 +305,69 @@ func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *adminServiceClient) GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error) {
+	out := new(GetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateDynamicConfig(ctx context.Context, in *UpdateDynamicConfigRequest, opts ...grpc.CallOption) (*UpdateDynamicConfigResponse, error) {
+	out := new(UpdateDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error) {
+	out := new(ListDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfigHistory(ctx context.Context, in *ListDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*ListDynamicConfigHistoryResponse, error) {
+	out := new(ListDynamicConfigHistoryResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DumpDynamicConfig(ctx context.Context, in *DumpDynamicConfigRequest, opts ...grpc.CallOption) (*DumpDynamicConfigResponse, error) {
+	out := new(DumpDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DumpDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BackfillArchival(ctx context.Context, in *BackfillArchivalRequest, opts ...grpc.CallOption) (*BackfillArchivalResponse, error) {
+	out := new(BackfillArchivalResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/BackfillArchival", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
@@ -323,6 +406,18 @@ type AdminServiceServer interface {
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
+	// GetDynamicConfig returns the values of a dynamic config key stored in the persistence store.
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	// UpdateDynamicConfig replaces the values of a dynamic config key stored in the persistence store.
+	UpdateDynamicConfig(context.Context, *UpdateDynamicConfigRequest) (*UpdateDynamicConfigResponse, error)
+	// ListDynamicConfig returns all the dynamic config values stored in the persistence store.
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
+	// ListDynamicConfigHistory returns the changes of the dynamic config stored in the persistence store.
+	ListDynamicConfigHistory(context.Context, *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error)
+	// DumpDynamicConfig returns the effective value of every dynamic config key for a namespace and a task queue.
+	DumpDynamicConfig(context.Context, *DumpDynamicConfigRequest) (*DumpDynamicConfigResponse, error)
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	BackfillArchival(context.Context, *BackfillArchivalRequest) (*BackfillArchivalResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -380,6 +475,27 @@ func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context
 func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) GetDynamicConfig(ctx context.Context, req *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateDynamicConfig(ctx context.Context, req *UpdateDynamicConfigRequest) (*UpdateDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfig(ctx context.Context, req *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfigHistory(ctx context.Context, req *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfigHistory not implemented")
+}
+func (*UnimplementedAdminServiceServer) DumpDynamicConfig(ctx context.Context, req *DumpDynamicConfigRequest) (*DumpDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteNamespace(ctx context.Context, req *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (*UnimplementedAdminServiceServer) BackfillArchival(ctx context.Context, req *BackfillArchivalRequest) (*BackfillArchivalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillArchival not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -691,6 +807,132 @@ func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, req.(*GetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateDynamicConfig(ctx, req.(*UpdateDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, req.(*ListDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfigHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfigHistory(ctx, req.(*ListDynamicConfigHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DumpDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DumpDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DumpDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DumpDynamicConfig(ctx, req.(*DumpDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BackfillArchival_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillArchivalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BackfillArchival(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/BackfillArchival",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BackfillArchival(ctx, req.(*BackfillArchivalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -763,6 +1005,34 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResendReplicationTasks",
 			Handler:    _AdminService_ResendReplicationTasks_Handler,
 		},
+		{
+			MethodName: "GetDynamicConfig",
+			Handler:    _AdminService_GetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "UpdateDynamicConfig",
+			Handler:    _AdminService_UpdateDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfig",
+			Handler:    _AdminService_ListDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfigHistory",
+			Handler:    _AdminService_ListDynamicConfigHistory_Handler,
+		},
+		{
+			MethodName: "DumpDynamicConfig",
+			Handler:    _AdminService_DumpDynamicConfig_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _AdminService_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "BackfillArchival",
+			Handler:    _AdminService_BackfillArchival_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
@@ -376,6 +376,146 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfig(ctx context.Context, in *adminservice.GetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfig), varargs...)
+}
+
+// UpdateDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) UpdateDynamicConfig(ctx context.Context, in *adminservice.UpdateDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.UpdateDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDynamicConfig indicates an expected call of UpdateDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) UpdateDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateDynamicConfig), varargs...)
+}
+
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfig(ctx context.Context, in *adminservice.ListDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfig), varargs...)
+}
+
+// ListDynamicConfigHistory mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfigHistory(ctx context.Context, in *adminservice.ListDynamicConfigHistoryRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfigHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfigHistory), varargs...)
+}
+
+// DumpDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) DumpDynamicConfig(ctx context.Context, in *adminservice.DumpDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.DumpDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DumpDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.DumpDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpDynamicConfig indicates an expected call of DumpDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) DumpDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).DumpDynamicConfig), varargs...)
+}
+
+// DeleteNamespace mocks base method.
+func (m *MockAdminServiceClient) DeleteNamespace(ctx context.Context, in *adminservice.DeleteNamespaceRequest, opts ...grpc.CallOption) (*adminservice.DeleteNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNamespace", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockAdminServiceClientMockRecorder) DeleteNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteNamespace), varargs...)
+}
+
+// BackfillArchival mocks base method.
+func (m *MockAdminServiceClient) BackfillArchival(ctx context.Context, in *adminservice.BackfillArchivalRequest, opts ...grpc.CallOption) (*adminservice.BackfillArchivalResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BackfillArchival", varargs...)
+	ret0, _ := ret[0].(*adminservice.BackfillArchivalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillArchival indicates an expected call of BackfillArchival.
+func (mr *MockAdminServiceClientMockRecorder) BackfillArchival(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillArchival", reflect.TypeOf((*MockAdminServiceClient)(nil).BackfillArchival), varargs...)
+}
+
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
 type MockAdminServiceServer struct {
 	ctrl     *gomock.Controller
@@ -653,3 +793,108 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
+
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfig(arg0 context.Context, arg1 *adminservice.GetDynamicConfigRequest) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfig), arg0, arg1)
+}
+
+// UpdateDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) UpdateDynamicConfig(arg0 context.Context, arg1 *adminservice.UpdateDynamicConfigRequest) (*adminservice.UpdateDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDynamicConfig indicates an expected call of UpdateDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) UpdateDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateDynamicConfig), arg0, arg1)
+}
+
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfig(arg0 context.Context, arg1 *adminservice.ListDynamicConfigRequest) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfig), arg0, arg1)
+}
+
+// ListDynamicConfigHistory mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfigHistory(arg0 context.Context, arg1 *adminservice.ListDynamicConfigHistoryRequest) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfigHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfigHistory), arg0, arg1)
+}
+
+// DumpDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) DumpDynamicConfig(arg0 context.Context, arg1 *adminservice.DumpDynamicConfigRequest) (*adminservice.DumpDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DumpDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpDynamicConfig indicates an expected call of DumpDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) DumpDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).DumpDynamicConfig), arg0, arg1)
+}
+
+// DeleteNamespace mocks base method.
+func (m *MockAdminServiceServer) DeleteNamespace(arg0 context.Context, arg1 *adminservice.DeleteNamespaceRequest) (*adminservice.DeleteNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockAdminServiceServerMockRecorder) DeleteNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteNamespace), arg0, arg1)
+}
+
+// BackfillArchival mocks base method.
+func (m *MockAdminServiceServer) BackfillArchival(arg0 context.Context, arg1 *adminservice.BackfillArchivalRequest) (*adminservice.BackfillArchivalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillArchival", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.BackfillArchivalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillArchival indicates an expected call of BackfillArchival.
+func (mr *MockAdminServiceServerMockRecorder) BackfillArchival(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillArchival", reflect.TypeOf((*MockAdminServiceServer)(nil).BackfillArchival), arg0, arg1)
+}
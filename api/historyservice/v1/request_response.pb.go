@@ -279,6 +279,7 @@ type GetMutableStateResponse struct {
 	WorkflowStatus                        v12.WorkflowExecutionStatus     `protobuf:"varint,17,opt,name=workflow_status,json=workflowStatus,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"workflow_status,omitempty"`
 	VersionHistories                      *v18.VersionHistories           `protobuf:"bytes,18,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
 	IsStickyTaskQueueEnabled              bool                            `protobuf:"varint,19,opt,name=is_sticky_task_queue_enabled,json=isStickyTaskQueueEnabled,proto3" json:"is_sticky_task_queue_enabled,omitempty"`
+	BuildId                               string                          `protobuf:"bytes,20,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
 }
 
 func (m *GetMutableStateResponse) Reset()      { *m = GetMutableStateResponse{} }
@@ -446,6 +447,13 @@ func (m *GetMutableStateResponse) GetIsStickyTaskQueueEnabled() bool {
 	return false
 }
 
+func (m *GetMutableStateResponse) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
 type PollMutableStateRequest struct {
 	NamespaceId         string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Execution           *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -4544,6 +4552,178 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
+type HandoffShardRequest struct {
+	ShardId       int32  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	PreviousOwner string `protobuf:"bytes,2,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+}
+
+func (m *HandoffShardRequest) Reset()      { *m = HandoffShardRequest{} }
+func (*HandoffShardRequest) ProtoMessage() {}
+func (*HandoffShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{76}
+}
+func (m *HandoffShardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandoffShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandoffShardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandoffShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffShardRequest.Merge(m, src)
+}
+func (m *HandoffShardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandoffShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffShardRequest proto.InternalMessageInfo
+
+func (m *HandoffShardRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *HandoffShardRequest) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
+	}
+	return ""
+}
+
+type HandoffShardResponse struct {
+}
+
+func (m *HandoffShardResponse) Reset()      { *m = HandoffShardResponse{} }
+func (*HandoffShardResponse) ProtoMessage() {}
+func (*HandoffShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{77}
+}
+func (m *HandoffShardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandoffShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandoffShardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandoffShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffShardResponse.Merge(m, src)
+}
+func (m *HandoffShardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandoffShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffShardResponse proto.InternalMessageInfo
+
+type DeleteWorkflowExecutionRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+}
+
+func (m *DeleteWorkflowExecutionRequest) Reset()      { *m = DeleteWorkflowExecutionRequest{} }
+func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{78}
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *DeleteWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *DeleteWorkflowExecutionRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+type DeleteWorkflowExecutionResponse struct {
+}
+
+func (m *DeleteWorkflowExecutionResponse) Reset()      { *m = DeleteWorkflowExecutionResponse{} }
+func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{79}
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
 	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
@@ -4627,6 +4807,10 @@ func init() {
 	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
 	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
 	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*HandoffShardRequest)(nil), "temporal.server.api.historyservice.v1.HandoffShardRequest")
+	proto.RegisterType((*HandoffShardResponse)(nil), "temporal.server.api.historyservice.v1.HandoffShardResponse")
+	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionRequest")
+	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionResponse")
 }
 
 func init() {
@@ -4634,259 +4818,263 @@ func init() {
 }
 
 var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 4030 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x70, 0x1c, 0x49,
-	0x56, 0x2e, 0xb5, 0xf5, 0xe9, 0xa7, 0x56, 0x4b, 0x5d, 0x92, 0xa5, 0xb6, 0x6c, 0xb7, 0xa5, 0xb2,
-	0x3d, 0xd6, 0xcc, 0xac, 0xdb, 0x63, 0x7b, 0x77, 0x3e, 0xde, 0x65, 0xc1, 0x96, 0xac, 0x71, 0x47,
-	0xd8, 0x1e, 0x4f, 0x49, 0x3b, 0x26, 0xbc, 0x9f, 0xda, 0x52, 0x55, 0xb6, 0x3a, 0x51, 0x75, 0x55,
-	0x4f, 0x65, 0xb5, 0xa4, 0x1e, 0x02, 0x58, 0x62, 0x03, 0x82, 0xe5, 0x40, 0x6c, 0x04, 0xc1, 0x69,
-	0x39, 0x71, 0x80, 0x3d, 0xc1, 0x81, 0x03, 0xb1, 0x07, 0x2e, 0x4b, 0x04, 0xc1, 0x71, 0xe0, 0x34,
-	0x11, 0x5c, 0x18, 0xcf, 0x85, 0x80, 0x3d, 0xec, 0x81, 0x2b, 0x11, 0x44, 0xfe, 0xea, 0xd3, 0x55,
-	0xd5, 0x1f, 0x7f, 0x10, 0xb1, 0xcc, 0x4d, 0x9d, 0xef, 0x93, 0xf9, 0x5e, 0xbe, 0x4f, 0xe6, 0xcb,
-	0x57, 0x82, 0x6f, 0x04, 0xa8, 0xdd, 0xf1, 0x7c, 0xd3, 0xb9, 0x4e, 0x90, 0x7f, 0x88, 0xfc, 0xeb,
-	0x66, 0x07, 0x5f, 0x6f, 0x61, 0x12, 0x78, 0x7e, 0x8f, 0x8e, 0x60, 0x0b, 0x5d, 0x3f, 0xbc, 0x71,
-	0xdd, 0x47, 0x1f, 0x77, 0x11, 0x09, 0x0c, 0x1f, 0x91, 0x8e, 0xe7, 0x12, 0x54, 0xef, 0xf8, 0x5e,
-	0xe0, 0xa9, 0x57, 0x24, 0x75, 0x9d, 0x53, 0xd7, 0xcd, 0x0e, 0xae, 0x27, 0xa9, 0xeb, 0x87, 0x37,
-	0x56, 0x2f, 0x87, 0x93, 0x50, 0xee, 0x96, 0xd7, 0x6e, 0x7b, 0x2e, 0xe5, 0xda, 0x46, 0x84, 0x98,
-	0xfb, 0x82, 0xd9, 0xea, 0x95, 0x04, 0x96, 0xe0, 0x92, 0x46, 0xbb, 0x9a, 0x40, 0x0b, 0x4c, 0x72,
-	0xf0, 0x71, 0x17, 0x75, 0x51, 0x1a, 0x31, 0x39, 0x2b, 0x72, 0xbb, 0x6d, 0x42, 0x91, 0x8e, 0x3c,
-	0xff, 0xa0, 0xe9, 0x78, 0x47, 0x02, 0xeb, 0xb5, 0x04, 0x96, 0x04, 0xa6, 0xb9, 0x5d, 0x4a, 0xe0,
-	0x7d, 0xdc, 0x45, 0x59, 0x6b, 0x4b, 0x8a, 0xd0, 0x34, 0xb1, 0xd3, 0xf5, 0x33, 0x56, 0xf6, 0x95,
-	0x01, 0x4a, 0x4f, 0x63, 0xbf, 0x9e, 0x85, 0x1d, 0x8a, 0xc3, 0xb5, 0x29, 0x50, 0xdf, 0x1c, 0x88,
-	0xda, 0x27, 0xf9, 0xd5, 0x81, 0xc8, 0x54, 0xb1, 0x02, 0xf1, 0x5a, 0x16, 0x62, 0xbe, 0xa6, 0xea,
-	0x59, 0xe8, 0xae, 0xd9, 0x46, 0xa4, 0x63, 0x5a, 0x19, 0xda, 0x78, 0x2b, 0x0b, 0xdf, 0x47, 0x1d,
-	0x07, 0x5b, 0x66, 0x80, 0xb3, 0x2c, 0xe5, 0xed, 0xcc, 0x3d, 0x1b, 0x6a, 0xae, 0xab, 0xb7, 0xb3,
-	0x66, 0x32, 0xed, 0x36, 0x76, 0x87, 0xd2, 0x6a, 0x9f, 0x4f, 0xc2, 0x85, 0x9d, 0xc0, 0xf4, 0x83,
-	0x27, 0x62, 0xba, 0x7b, 0xc7, 0xc8, 0xea, 0xd2, 0xf5, 0xe9, 0x9c, 0x40, 0x5d, 0x87, 0x52, 0x28,
-	0xa5, 0x81, 0xed, 0xaa, 0xb2, 0xa6, 0x6c, 0x14, 0xf5, 0xd9, 0x70, 0xac, 0x61, 0xab, 0x16, 0xcc,
-	0x11, 0xca, 0xc3, 0x10, 0x93, 0x54, 0x27, 0xd6, 0x94, 0x8d, 0xd9, 0x9b, 0xdf, 0x0c, 0x55, 0xc6,
-	0x1c, 0xa8, 0x4f, 0xa0, 0xfa, 0xe1, 0x8d, 0xfa, 0xc0, 0x99, 0xf5, 0x12, 0x63, 0x2a, 0xd7, 0xd1,
-	0x82, 0x33, 0x1d, 0xd3, 0x47, 0x6e, 0x60, 0x20, 0x89, 0x68, 0x60, 0xb7, 0xe9, 0x55, 0x0b, 0x6c,
-	0xb2, 0xaf, 0xd6, 0xb3, 0x9c, 0x36, 0xb4, 0x8d, 0xc3, 0x1b, 0xf5, 0xc7, 0x8c, 0x3a, 0x9c, 0xa5,
-	0xe1, 0x36, 0x3d, 0x7d, 0xb1, 0x93, 0x1e, 0x54, 0xab, 0x30, 0x6d, 0x06, 0x94, 0x5b, 0x50, 0x3d,
-	0xbd, 0xa6, 0x6c, 0x4c, 0xea, 0xf2, 0xa7, 0xfa, 0x2d, 0xb8, 0x2a, 0x39, 0xc6, 0x56, 0x81, 0x8e,
-	0x3b, 0xd8, 0x67, 0x7b, 0x6a, 0x04, 0xb8, 0x8d, 0x48, 0x60, 0xb6, 0x3b, 0xd5, 0xc9, 0x35, 0x65,
-	0xa3, 0xa0, 0x5f, 0x3e, 0xea, 0x17, 0xee, 0x5e, 0x88, 0xbc, 0x2b, 0x71, 0xd5, 0x16, 0x9c, 0xb5,
-	0x3c, 0x37, 0xc0, 0x6e, 0x17, 0x19, 0x26, 0x31, 0x5c, 0x74, 0x64, 0x60, 0x17, 0x07, 0xd8, 0x0c,
-	0x3c, 0xbf, 0x3a, 0xb5, 0xa6, 0x6c, 0x94, 0x6f, 0x5e, 0x4b, 0xea, 0x92, 0xd9, 0x33, 0x15, 0x6a,
-	0x53, 0xd0, 0xdd, 0x21, 0x8f, 0xd0, 0x51, 0x43, 0x12, 0xe9, 0xcb, 0x56, 0xe6, 0xb8, 0xfa, 0x10,
-	0x2a, 0x12, 0x62, 0x1b, 0xc2, 0x91, 0xab, 0xd3, 0x4c, 0x81, 0x6b, 0xc9, 0x19, 0x04, 0x90, 0xce,
-	0xb1, 0xcd, 0xff, 0xd4, 0x17, 0x42, 0x52, 0x31, 0xa2, 0x7e, 0x04, 0xcb, 0x8e, 0x49, 0x02, 0xc3,
-	0xf2, 0xda, 0x1d, 0x07, 0x31, 0x0d, 0xf8, 0x88, 0x74, 0x9d, 0xa0, 0x3a, 0x93, 0xc5, 0x53, 0x38,
-	0x35, 0xdb, 0x8b, 0x9e, 0xe3, 0x99, 0x36, 0xd1, 0x97, 0x28, 0xfd, 0x66, 0x48, 0xae, 0x33, 0x6a,
-	0xf5, 0x01, 0x5c, 0x6a, 0x62, 0x9f, 0x04, 0x86, 0x8d, 0x2c, 0x4c, 0x98, 0x62, 0x4d, 0x72, 0x60,
-	0xec, 0x99, 0xd6, 0x81, 0xd7, 0x6c, 0x1a, 0x04, 0x59, 0x9e, 0x6b, 0x93, 0x6a, 0x91, 0xed, 0xce,
-	0x45, 0x86, 0xba, 0x25, 0x30, 0x77, 0x4d, 0x72, 0x70, 0x97, 0xe3, 0xed, 0x70, 0x34, 0xed, 0x1d,
-	0xa8, 0xe5, 0x19, 0x1a, 0xf7, 0x05, 0xf5, 0x0c, 0x4c, 0xf9, 0x5d, 0x37, 0xb2, 0xee, 0x49, 0xbf,
-	0xeb, 0x36, 0x6c, 0xed, 0x3f, 0x15, 0x58, 0x7e, 0x1f, 0x05, 0x0f, 0xbb, 0x81, 0xb9, 0xe7, 0xa0,
-	0x9d, 0xc0, 0x0c, 0xd0, 0x18, 0x5e, 0xf1, 0x3e, 0x14, 0x43, 0x1b, 0x11, 0x1e, 0xf1, 0x7a, 0x9e,
-	0x3e, 0xd2, 0x4b, 0x8b, 0x68, 0xd5, 0x5b, 0xb0, 0x8c, 0x8e, 0x3b, 0xc8, 0x0a, 0x90, 0x6d, 0xb8,
-	0xe8, 0x38, 0x30, 0xd0, 0x21, 0x75, 0x03, 0x6c, 0x33, 0xd3, 0x2f, 0xe8, 0x8b, 0x12, 0xfa, 0x08,
-	0x1d, 0x07, 0xf7, 0x28, 0xac, 0x61, 0xab, 0x6f, 0xc1, 0x92, 0xd5, 0xf5, 0x99, 0xbf, 0xec, 0xf9,
-	0xa6, 0x6b, 0xb5, 0x8c, 0xc0, 0x3b, 0x40, 0x2e, 0xb3, 0xe8, 0x92, 0xae, 0x0a, 0xd8, 0x5d, 0x06,
-	0xda, 0xa5, 0x10, 0xed, 0x27, 0xb3, 0xb0, 0x92, 0x92, 0x56, 0x28, 0x28, 0x21, 0x8b, 0xf2, 0x02,
-	0xb2, 0x34, 0x60, 0x2e, 0xf4, 0xa0, 0xa0, 0xd7, 0x41, 0x42, 0x31, 0x97, 0x87, 0x31, 0xdb, 0xed,
-	0x75, 0x90, 0x5e, 0x3a, 0x8a, 0xfd, 0x52, 0x35, 0x98, 0xcb, 0xd2, 0xc6, 0xac, 0x1b, 0xd3, 0xc2,
-	0x7b, 0x70, 0xb6, 0xe3, 0xa3, 0x43, 0xec, 0x75, 0x89, 0xc1, 0xa2, 0x09, 0xb2, 0x23, 0xfc, 0xd3,
-	0x0c, 0x7f, 0x59, 0x22, 0xec, 0x70, 0xb8, 0x24, 0xbd, 0x06, 0x8b, 0xcc, 0xb6, 0xb9, 0x21, 0x86,
-	0x44, 0xdc, 0xaf, 0x17, 0x28, 0x68, 0x9b, 0x42, 0x24, 0xfa, 0x26, 0x00, 0xb3, 0x51, 0x96, 0xb5,
-	0x99, 0xd3, 0xa6, 0xa4, 0x0a, 0x93, 0x3a, 0x15, 0x8c, 0xda, 0xe9, 0x87, 0xf4, 0x87, 0x5e, 0x0c,
-	0xe4, 0x9f, 0xea, 0x63, 0xa8, 0x90, 0x00, 0x5b, 0x07, 0x3d, 0x23, 0xc6, 0x6b, 0x7a, 0x0c, 0x5e,
-	0xf3, 0x9c, 0x3c, 0x1c, 0x50, 0xbf, 0x0a, 0xcb, 0x96, 0x83, 0xe9, 0xda, 0x1d, 0xbc, 0xe7, 0x9b,
-	0x7e, 0xcf, 0x38, 0x44, 0x3e, 0xf5, 0x13, 0xe6, 0xa1, 0x45, 0x7d, 0x89, 0x43, 0x1f, 0x70, 0xe0,
-	0x47, 0x1c, 0x16, 0xa3, 0x6a, 0x22, 0x33, 0xe8, 0xfa, 0x28, 0xa4, 0x2a, 0xc6, 0xa9, 0xb6, 0x39,
-	0x50, 0x52, 0x5d, 0x84, 0x59, 0x41, 0x85, 0xdb, 0x1d, 0xa7, 0x0a, 0x0c, 0x15, 0xf8, 0x50, 0xa3,
-	0xdd, 0x71, 0xd4, 0x3a, 0x2c, 0x62, 0x62, 0x84, 0xfb, 0xef, 0x77, 0x5d, 0x17, 0xbb, 0xfb, 0xd5,
-	0xd9, 0x35, 0x65, 0x63, 0x46, 0xaf, 0x60, 0x22, 0x37, 0x5b, 0xe7, 0x00, 0xf5, 0x29, 0xbc, 0x99,
-	0x52, 0x87, 0x41, 0xac, 0x16, 0xb2, 0xbb, 0x0e, 0x32, 0x02, 0x8f, 0x6f, 0x29, 0x0b, 0xba, 0x5e,
-	0x37, 0xa8, 0x96, 0x58, 0x38, 0xb8, 0xd2, 0xa7, 0x82, 0x1d, 0x81, 0xbf, 0xeb, 0xb1, 0x0d, 0xde,
-	0xe5, 0xc8, 0x74, 0x2d, 0x7c, 0x4f, 0xe9, 0xf1, 0x24, 0x92, 0x6f, 0x8e, 0xf1, 0xa8, 0x30, 0xd0,
-	0x0e, 0x85, 0x48, 0xe1, 0xf2, 0xfc, 0xa9, 0x9c, 0xe7, 0x4f, 0xea, 0xef, 0xc2, 0x42, 0x2c, 0xdd,
-	0xf3, 0x5c, 0x35, 0xbf, 0x56, 0xd8, 0x98, 0xbd, 0xb9, 0x53, 0x1f, 0xe9, 0x80, 0x59, 0xcf, 0xf1,
-	0xc6, 0xba, 0x1e, 0xb1, 0xa5, 0x09, 0xeb, 0x9e, 0x1b, 0xf8, 0x3d, 0x7d, 0xde, 0x4f, 0x8e, 0xaa,
-	0xdf, 0x86, 0x72, 0xa8, 0x6a, 0x42, 0xe9, 0xab, 0x0b, 0x2c, 0x95, 0x64, 0x67, 0xca, 0x30, 0xa3,
-	0xa4, 0xdc, 0x97, 0xcf, 0x1d, 0xba, 0x2d, 0xfb, 0xa9, 0x3e, 0x81, 0xf9, 0x04, 0xf3, 0x2e, 0xa9,
-	0x56, 0x18, 0xf7, 0x7a, 0x4e, 0xa2, 0xca, 0x64, 0xdb, 0x25, 0x7a, 0x39, 0xce, 0xb7, 0x4b, 0xd4,
-	0xef, 0x42, 0x45, 0xec, 0x85, 0xc1, 0x15, 0x82, 0x11, 0xa9, 0xaa, 0xcc, 0x05, 0xde, 0x1a, 0xa4,
-	0x36, 0x3a, 0x87, 0xd8, 0xab, 0xfb, 0x92, 0x4e, 0x5f, 0x38, 0xec, 0x1b, 0x51, 0xbf, 0x09, 0xe7,
-	0x31, 0x0d, 0x05, 0xfd, 0x56, 0x85, 0x5c, 0xaa, 0x66, 0xbb, 0xba, 0xc8, 0x6c, 0xb1, 0x8a, 0xc9,
-	0x4e, 0xd2, 0x8a, 0xee, 0x71, 0xf8, 0xea, 0x11, 0x2c, 0x65, 0x69, 0x5f, 0x5d, 0x80, 0xc2, 0x01,
-	0xea, 0x89, 0x34, 0x40, 0xff, 0x54, 0x1b, 0x30, 0x79, 0x68, 0x3a, 0x5d, 0x19, 0xe1, 0x6e, 0x65,
-	0x2e, 0x3e, 0xb6, 0x67, 0x54, 0x80, 0x3e, 0xd6, 0x3a, 0xe7, 0x70, 0x7b, 0xe2, 0x5d, 0x45, 0xfb,
-	0x85, 0x02, 0x2b, 0x8f, 0x3d, 0xc7, 0xf9, 0x7f, 0x92, 0x8c, 0xfe, 0xbb, 0x08, 0xd5, 0xb4, 0xb8,
-	0x5f, 0x66, 0xa3, 0x2f, 0xb3, 0xd1, 0x4b, 0xcb, 0x46, 0x63, 0x66, 0x97, 0xd9, 0x71, 0xb2, 0x4b,
-	0x9e, 0xc1, 0x97, 0x72, 0xb3, 0xc5, 0xef, 0x65, 0x64, 0x8b, 0x39, 0x96, 0x2d, 0x76, 0x47, 0xcc,
-	0x16, 0x79, 0xee, 0x32, 0x62, 0xba, 0xc8, 0x0c, 0xbc, 0xe5, 0x97, 0x16, 0x78, 0xd3, 0xd9, 0x68,
-	0xfe, 0x95, 0x66, 0xa3, 0x85, 0x97, 0x91, 0x8d, 0x4e, 0x2e, 0xdc, 0xff, 0x48, 0x81, 0x73, 0x3a,
-	0x22, 0x28, 0xe8, 0xcb, 0x43, 0x27, 0x10, 0xf2, 0xb5, 0x1a, 0x9c, 0xcf, 0x5e, 0x0a, 0xb7, 0x2f,
-	0xed, 0xb3, 0x09, 0x58, 0xd3, 0x91, 0xe5, 0xf9, 0x76, 0xfc, 0x12, 0x26, 0x02, 0xd8, 0x18, 0x0b,
-	0xfe, 0x4d, 0x50, 0xd3, 0xb7, 0xeb, 0xf1, 0x57, 0x5e, 0x49, 0xdd, 0xb9, 0x69, 0x2c, 0x08, 0x1d,
-	0x3b, 0x0c, 0xcd, 0x20, 0x87, 0x1a, 0xb6, 0xba, 0x02, 0xd3, 0x2c, 0x08, 0x84, 0x71, 0x78, 0x8a,
-	0xfe, 0x6c, 0xd8, 0xea, 0x05, 0x00, 0x59, 0x39, 0x11, 0xe1, 0xb6, 0xa8, 0x17, 0xc5, 0x48, 0xc3,
-	0x56, 0xbf, 0x07, 0xa5, 0x8e, 0xe7, 0x38, 0x61, 0xe1, 0x83, 0x47, 0xda, 0xaf, 0x0f, 0x2d, 0x7c,
-	0x50, 0x5f, 0xdd, 0xf6, 0xfc, 0xb8, 0xbe, 0x64, 0xd5, 0x63, 0x96, 0x32, 0x14, 0x3f, 0xb4, 0x9f,
-	0x4f, 0xc3, 0xfa, 0x00, 0xd5, 0x8a, 0x7c, 0x98, 0x4a, 0x63, 0xca, 0x73, 0xa7, 0xb1, 0x81, 0x29,
-	0x6a, 0x62, 0x60, 0x8a, 0xfa, 0x0a, 0xa8, 0x52, 0xa3, 0x76, 0x7f, 0x1a, 0x5c, 0x08, 0x21, 0x12,
-	0x7b, 0x03, 0x16, 0x72, 0x52, 0x60, 0x99, 0x24, 0xf9, 0xa6, 0x32, 0xeb, 0x64, 0x3a, 0xb3, 0xc6,
-	0x4a, 0x36, 0x53, 0x0c, 0x1a, 0x96, 0x6c, 0xde, 0x85, 0xaa, 0x88, 0xf2, 0xb1, 0x82, 0x8d, 0x38,
-	0xec, 0x4d, 0xb3, 0xc3, 0xde, 0x32, 0x87, 0x47, 0x15, 0x1a, 0x0e, 0x55, 0x9f, 0xc2, 0x5c, 0x58,
-	0x7e, 0x60, 0xe1, 0x98, 0xd7, 0x34, 0xbe, 0x36, 0x2c, 0x18, 0xee, 0xfa, 0xa6, 0x4b, 0x68, 0x96,
-	0x91, 0x5b, 0xc6, 0x7c, 0xbb, 0x64, 0xc7, 0x7e, 0xa9, 0xfb, 0x70, 0x21, 0xa3, 0x90, 0x14, 0x4b,
-	0xb3, 0xc5, 0x31, 0xd2, 0xec, 0x6a, 0xca, 0xe0, 0xa3, 0x8c, 0x9b, 0x73, 0xcd, 0x81, 0xbc, 0x6b,
-	0xce, 0x3a, 0x94, 0x12, 0x09, 0x6b, 0x96, 0x25, 0xac, 0xd9, 0xbd, 0x58, 0xa6, 0xba, 0x0e, 0x8b,
-	0xd1, 0x3e, 0x47, 0x05, 0xaf, 0x12, 0xd3, 0x7b, 0x64, 0x02, 0x51, 0x79, 0xeb, 0x4d, 0x7a, 0x8e,
-	0xe0, 0x5b, 0x1d, 0xa1, 0xcf, 0x09, 0xbb, 0xe0, 0x80, 0x08, 0xd9, 0x83, 0xe9, 0x8f, 0xbb, 0x48,
-	0x24, 0x1f, 0x9a, 0xfe, 0xbe, 0x35, 0x62, 0xfa, 0x1b, 0xea, 0x26, 0xf5, 0x0f, 0x39, 0x5f, 0x9e,
-	0xff, 0xe4, 0x2c, 0xab, 0xdf, 0x87, 0x52, 0x1c, 0x90, 0x11, 0xda, 0x6f, 0x27, 0x43, 0x7b, 0xdf,
-	0xa6, 0xb0, 0x9a, 0x79, 0xdc, 0xab, 0x28, 0xb7, 0x5e, 0x3c, 0x96, 0x47, 0xf1, 0xf1, 0x8e, 0x15,
-	0xe0, 0x43, 0x1c, 0xf4, 0xbe, 0x8c, 0x8f, 0xa3, 0xc5, 0xc7, 0xb8, 0xbe, 0x32, 0xe3, 0xe3, 0x3f,
-	0x17, 0x64, 0x7c, 0xcc, 0x54, 0xad, 0x88, 0x8f, 0x8f, 0x60, 0xbe, 0x2f, 0x32, 0x89, 0x08, 0x79,
-	0x25, 0xb9, 0x90, 0x98, 0x13, 0xf3, 0x83, 0x4b, 0x8f, 0xc5, 0x17, 0xbd, 0x9c, 0x8c, 0x5e, 0xd9,
-	0x06, 0x3d, 0x91, 0x63, 0xd0, 0xb1, 0xd0, 0x54, 0x48, 0x86, 0xa6, 0x07, 0x70, 0x29, 0xc3, 0x91,
-	0x0c, 0xaf, 0x69, 0x04, 0x2d, 0x4c, 0x8c, 0x78, 0x0d, 0xba, 0xa0, 0x5f, 0x4c, 0x3b, 0xd6, 0x07,
-	0xcd, 0xdd, 0x16, 0x26, 0x77, 0x04, 0xb7, 0x87, 0x50, 0x69, 0x21, 0xd3, 0x0f, 0xf6, 0x90, 0x19,
-	0x18, 0x36, 0x0a, 0x4c, 0xec, 0x10, 0xb6, 0x21, 0xa3, 0x94, 0x61, 0x17, 0x42, 0xd2, 0x2d, 0x4e,
-	0x99, 0xce, 0x29, 0x53, 0xcf, 0x9d, 0x53, 0xae, 0xc5, 0xec, 0x36, 0xb4, 0x67, 0x16, 0x7c, 0x8b,
-	0x91, 0x31, 0x3e, 0x92, 0x00, 0xed, 0x67, 0x0a, 0x5c, 0xe2, 0x5b, 0x97, 0xf0, 0x66, 0x51, 0x24,
-	0x1e, 0xcb, 0x63, 0x3c, 0x58, 0x10, 0xa5, 0x69, 0xd4, 0xf7, 0x36, 0xb1, 0x35, 0xd4, 0x04, 0x47,
-	0x58, 0x82, 0x3e, 0x2f, 0xb9, 0x4b, 0x7b, 0xfc, 0x89, 0x02, 0x97, 0x07, 0x13, 0x0a, 0x93, 0x24,
-	0x51, 0xfa, 0x93, 0x2f, 0x32, 0xc2, 0x26, 0xef, 0xbf, 0xac, 0x78, 0x47, 0xaf, 0x5f, 0x89, 0x01,
-	0xed, 0x6f, 0x14, 0x1a, 0x88, 0x52, 0xab, 0xdb, 0x36, 0xb1, 0x33, 0x96, 0x5a, 0x5b, 0x50, 0x6e,
-	0x32, 0x9a, 0x3e, 0xa5, 0xde, 0x79, 0x1e, 0xa5, 0x26, 0x66, 0xd7, 0xe7, 0x9a, 0xf1, 0x9f, 0xda,
-	0x25, 0xea, 0xde, 0xb9, 0x24, 0x42, 0xac, 0x9f, 0x29, 0xa0, 0xa5, 0x83, 0xc0, 0x7d, 0x69, 0xd1,
-	0x63, 0x08, 0xd6, 0x89, 0xfb, 0x50, 0x52, 0xb6, 0xcd, 0x11, 0x64, 0x1b, 0xb6, 0x84, 0x98, 0x9b,
-	0x49, 0x01, 0x1f, 0x53, 0x5b, 0x1f, 0x40, 0x27, 0xcc, 0xe5, 0x75, 0x58, 0xb0, 0x4c, 0xd7, 0x42,
-	0x61, 0x24, 0x45, 0x7c, 0xfd, 0x33, 0xfa, 0x3c, 0x1f, 0xd7, 0xe5, 0x70, 0xdc, 0x7d, 0xe2, 0x3c,
-	0x4f, 0xc8, 0x7d, 0x06, 0x2d, 0x21, 0xed, 0x3e, 0xaf, 0x85, 0xde, 0x93, 0x43, 0x97, 0x36, 0xe4,
-	0x38, 0xe2, 0xff, 0xbe, 0x21, 0xe7, 0xce, 0x9e, 0x6f, 0xc8, 0x59, 0x24, 0x42, 0xac, 0xbf, 0x65,
-	0x86, 0x9c, 0x96, 0x9f, 0xed, 0xf0, 0x58, 0x82, 0xfd, 0x16, 0x94, 0x93, 0xf6, 0x32, 0x86, 0x15,
-	0x0f, 0x9b, 0x5f, 0x9f, 0x4b, 0x98, 0x9c, 0x76, 0x25, 0xdb, 0xde, 0x42, 0x22, 0x21, 0xdc, 0x3f,
-	0x4e, 0x40, 0x6d, 0x07, 0xef, 0xbb, 0xa6, 0xf3, 0x22, 0x4f, 0xcd, 0x4d, 0x28, 0x13, 0xc6, 0xa4,
-	0x4f, 0xb0, 0x5f, 0x1f, 0xfe, 0xd6, 0x3c, 0x70, 0x6e, 0x7d, 0x8e, 0xb3, 0x95, 0x4b, 0xc1, 0x70,
-	0x0e, 0x1d, 0x07, 0xc8, 0xa7, 0x33, 0x65, 0x1c, 0xba, 0x0a, 0xe3, 0x1e, 0xba, 0xce, 0x4a, 0x6e,
-	0x29, 0x10, 0x3d, 0xa2, 0x5b, 0x2d, 0xec, 0xd8, 0xd1, 0x3c, 0x9e, 0xeb, 0xf4, 0x58, 0xda, 0x9f,
-	0xd1, 0x2b, 0x0c, 0x24, 0x89, 0x3e, 0x70, 0x9d, 0x9e, 0xb6, 0x0e, 0x17, 0x73, 0x65, 0x11, 0xba,
-	0xfe, 0x17, 0x05, 0xae, 0x0a, 0x1c, 0x1c, 0xb4, 0x5e, 0xf8, 0x7d, 0xff, 0x87, 0x0a, 0x9c, 0x15,
-	0x5a, 0x3f, 0xc2, 0x41, 0xcb, 0xc8, 0x7a, 0xec, 0xbf, 0x3f, 0xea, 0x06, 0x0c, 0x5b, 0x90, 0xbe,
-	0x4c, 0x92, 0x88, 0xd2, 0xce, 0xee, 0xc0, 0xc6, 0x70, 0x16, 0x83, 0x1f, 0x74, 0xff, 0x5e, 0x81,
-	0x8b, 0x3a, 0x6a, 0x7b, 0x87, 0x88, 0x73, 0x7a, 0xce, 0x62, 0xfa, 0xab, 0x3b, 0x88, 0x27, 0x8f,
-	0xd3, 0x85, 0xbe, 0xe3, 0xb4, 0xa6, 0xd1, 0xb0, 0x97, 0xb7, 0x7c, 0xb1, 0xf7, 0x7f, 0xa7, 0xc0,
-	0xfa, 0x2e, 0xf2, 0xdb, 0xd8, 0x35, 0x03, 0xf4, 0x22, 0xbb, 0xee, 0x41, 0x25, 0x90, 0x7c, 0xfa,
-	0x36, 0xfb, 0xee, 0xd0, 0xcd, 0x1e, 0xba, 0x02, 0x7d, 0x21, 0x64, 0x2e, 0x37, 0xf8, 0x32, 0x68,
-	0x83, 0xc8, 0x84, 0x7c, 0x7f, 0xa5, 0xc0, 0x05, 0x56, 0x8e, 0x7a, 0xc1, 0x8e, 0x15, 0x9f, 0xf2,
-	0x18, 0xbb, 0x63, 0x65, 0xe0, 0xcc, 0x7a, 0x89, 0x31, 0x95, 0xf2, 0xbc, 0x03, 0xb5, 0x3c, 0xf4,
-	0xc1, 0x66, 0xfa, 0xa7, 0x05, 0xb8, 0x22, 0x98, 0xf0, 0x30, 0xfa, 0x22, 0xa2, 0xb6, 0x73, 0x52,
-	0xc1, 0xf6, 0x08, 0xb2, 0x8e, 0xb0, 0x84, 0xbe, 0x6c, 0xa0, 0xfe, 0x5a, 0x2c, 0x70, 0x8a, 0x26,
-	0x96, 0x74, 0x39, 0xa8, 0x2a, 0x51, 0x1a, 0x12, 0x43, 0x16, 0x72, 0x86, 0xc4, 0xdd, 0xd3, 0xaf,
-	0x3e, 0xee, 0x4e, 0xe6, 0xc5, 0xdd, 0x0d, 0x78, 0x6d, 0x98, 0x46, 0x64, 0xaa, 0x53, 0xe0, 0x9c,
-	0x7c, 0x18, 0xc8, 0x28, 0xf1, 0x9d, 0x6c, 0x88, 0x79, 0x03, 0x2a, 0x98, 0x18, 0xc9, 0xf6, 0x1a,
-	0xb6, 0x2d, 0x33, 0xfa, 0x3c, 0x26, 0xdb, 0xf1, 0x5e, 0x1a, 0xad, 0x06, 0xe7, 0xb3, 0xe5, 0x10,
-	0x82, 0xfe, 0xd7, 0x04, 0x3d, 0xb0, 0xd1, 0xe3, 0xeb, 0x26, 0x55, 0x57, 0x38, 0xc9, 0xf3, 0x1c,
-	0x36, 0x5f, 0x9d, 0xc4, 0xeb, 0x50, 0x8a, 0x2c, 0x31, 0x7a, 0x99, 0x0b, 0xc7, 0x1a, 0xb6, 0xfa,
-	0x14, 0x16, 0xe5, 0x59, 0xd4, 0x7e, 0x11, 0x73, 0x53, 0x43, 0x2e, 0xd1, 0xf4, 0x8f, 0xc3, 0x53,
-	0x34, 0xab, 0x3d, 0xb2, 0xf2, 0xc3, 0xe4, 0x38, 0xe5, 0x87, 0xf9, 0x88, 0x9c, 0x0d, 0x68, 0x57,
-	0x69, 0x78, 0x18, 0xa8, 0x75, 0xb1, 0x3f, 0x7f, 0xa1, 0xc0, 0xda, 0x16, 0x22, 0x96, 0x8f, 0xf7,
-	0x5e, 0x28, 0x15, 0x7c, 0x1b, 0xa6, 0xc7, 0x3d, 0x20, 0x0f, 0x9b, 0x56, 0x97, 0x1c, 0xb5, 0x9f,
-	0x16, 0x60, 0x7d, 0x00, 0xb6, 0x08, 0x95, 0xdf, 0x81, 0x85, 0xa8, 0x50, 0x6a, 0x79, 0x6e, 0x13,
-	0xef, 0x8b, 0x0b, 0xf3, 0x8d, 0xec, 0xb5, 0x64, 0x6e, 0xd0, 0x26, 0x23, 0xd4, 0xe7, 0x51, 0x72,
-	0x40, 0xdd, 0x87, 0x95, 0x8c, 0x7a, 0x2c, 0xab, 0xfa, 0x72, 0x81, 0xaf, 0x8f, 0x31, 0x09, 0xab,
-	0xf7, 0x9e, 0x39, 0xca, 0x1a, 0x56, 0xbf, 0x03, 0x6a, 0x07, 0xb9, 0x36, 0x76, 0xf7, 0x0d, 0x93,
-	0x9f, 0x96, 0x31, 0x22, 0xd5, 0x02, 0xab, 0x74, 0x5e, 0xcb, 0x9f, 0xe3, 0x31, 0xa7, 0x91, 0x07,
-	0x6c, 0x36, 0x43, 0xa5, 0x93, 0x18, 0xc4, 0x88, 0xa8, 0xdf, 0x83, 0x05, 0xc9, 0x9d, 0xc5, 0x2f,
-	0x9f, 0xbd, 0xb1, 0x17, 0x92, 0xef, 0x51, 0x39, 0xbc, 0x93, 0xb6, 0xc4, 0x66, 0x98, 0xef, 0xc4,
-	0x40, 0x3e, 0x72, 0xb5, 0x1f, 0x4e, 0xc3, 0xb2, 0x7c, 0xb4, 0x42, 0xcc, 0x16, 0x89, 0xb4, 0xa2,
-	0x2b, 0x50, 0x26, 0x5e, 0xd7, 0xb7, 0x90, 0x61, 0x39, 0x5d, 0x12, 0x20, 0x5f, 0xd8, 0xd1, 0x1c,
-	0x1f, 0xdd, 0xe4, 0x83, 0x29, 0x63, 0x9b, 0x18, 0x35, 0x10, 0x14, 0x5e, 0x42, 0x20, 0xb8, 0x0c,
-	0xe5, 0xbe, 0xf7, 0x73, 0x5e, 0x5b, 0x2b, 0x35, 0xe3, 0x6f, 0xe7, 0x23, 0xbe, 0x37, 0xc8, 0x52,
-	0xba, 0x78, 0x6f, 0x10, 0x3f, 0xd5, 0xdf, 0xc9, 0x78, 0xc7, 0x9d, 0x66, 0x5b, 0xa0, 0x8f, 0x5c,
-	0xd8, 0xc9, 0x52, 0xf0, 0x88, 0xaf, 0xb8, 0xb7, 0x61, 0x5a, 0x70, 0xcc, 0x6e, 0xc1, 0x4c, 0xc7,
-	0x18, 0x5d, 0x12, 0xa8, 0xf7, 0x61, 0xde, 0x45, 0xac, 0x2d, 0xcb, 0x90, 0x3c, 0x8a, 0x23, 0xf2,
-	0x98, 0x73, 0xd1, 0x91, 0xde, 0x15, 0xcf, 0xbd, 0x3d, 0x9a, 0x5a, 0x9b, 0x1e, 0xb5, 0x85, 0xbd,
-	0x6e, 0xb3, 0x89, 0x7c, 0xae, 0x4a, 0xc2, 0x5e, 0x1d, 0x66, 0xf4, 0x0a, 0x03, 0xdd, 0x65, 0x10,
-	0x2e, 0x62, 0xde, 0x2b, 0xc5, 0x6c, 0xde, 0x2b, 0xc5, 0x37, 0xe0, 0x9c, 0x5c, 0x69, 0x16, 0x1d,
-	0x6f, 0x04, 0x5b, 0xe1, 0x6b, 0xba, 0x97, 0xa2, 0xbe, 0x02, 0x65, 0x7e, 0xf8, 0x93, 0x16, 0xc2,
-	0x1e, 0x23, 0x66, 0x74, 0x7e, 0x24, 0x94, 0x96, 0xa4, 0xd6, 0x60, 0x56, 0x4e, 0xe2, 0xda, 0x16,
-	0x7b, 0x0a, 0x9f, 0xd1, 0x8b, 0x9c, 0xe9, 0x23, 0xdb, 0x3a, 0xb9, 0xb7, 0xe1, 0xb3, 0xb0, 0x92,
-	0xb2, 0x11, 0x11, 0xf0, 0xff, 0x68, 0x12, 0xce, 0x86, 0x30, 0xdd, 0x3c, 0x4a, 0xfa, 0xe8, 0x89,
-	0x66, 0xe1, 0x1f, 0x28, 0x19, 0x9e, 0x51, 0x18, 0xf3, 0x89, 0x27, 0x47, 0xb2, 0xf1, 0x9d, 0xe3,
-	0xf4, 0xe0, 0xc2, 0xf8, 0x96, 0x19, 0x98, 0x77, 0x1d, 0x6f, 0x6f, 0xa0, 0x73, 0x4c, 0x8e, 0xc8,
-	0x23, 0xed, 0x1c, 0x59, 0x46, 0x3b, 0xf5, 0x9c, 0xc6, 0x3e, 0x3d, 0xd0, 0xd8, 0x4f, 0xce, 0x4a,
-	0xcf, 0xc3, 0x6a, 0xd6, 0x7e, 0x09, 0x43, 0xfd, 0xfd, 0x02, 0x54, 0xfb, 0x8c, 0xf8, 0xa3, 0x9b,
-	0xff, 0x27, 0xec, 0xb4, 0x09, 0x67, 0x92, 0x7d, 0x30, 0x3d, 0x03, 0x07, 0xa8, 0x2d, 0x93, 0xf4,
-	0xcd, 0xb1, 0x7a, 0x61, 0x7a, 0x8d, 0x00, 0xb5, 0xf5, 0xc5, 0xc3, 0xd4, 0x18, 0x51, 0xdf, 0x85,
-	0x29, 0x11, 0x16, 0x47, 0xb5, 0x45, 0x81, 0xaf, 0x6e, 0x43, 0x39, 0x61, 0x10, 0x64, 0x64, 0x4b,
-	0x2c, 0xc5, 0xac, 0x84, 0x68, 0xe7, 0x62, 0xb1, 0x22, 0xda, 0x02, 0xb1, 0x41, 0xc7, 0xb0, 0xbc,
-	0xd3, 0x73, 0xad, 0x9d, 0x96, 0xe9, 0xdb, 0xa2, 0x39, 0x66, 0xbc, 0x4c, 0x7f, 0x16, 0x66, 0x08,
-	0x25, 0x8e, 0x1a, 0x07, 0xa6, 0xd9, 0xef, 0x86, 0xad, 0x9e, 0x87, 0x62, 0xf4, 0x6e, 0xc6, 0x4f,
-	0xe3, 0xd1, 0x00, 0x0d, 0x6f, 0xa9, 0x99, 0xc5, 0xa2, 0x7e, 0x71, 0x1a, 0x16, 0x29, 0x4c, 0x9e,
-	0x83, 0xc6, 0x30, 0x98, 0x8b, 0x30, 0x1b, 0x1a, 0x4c, 0x78, 0xee, 0x00, 0x39, 0xd4, 0xb0, 0x63,
-	0x77, 0xf1, 0x42, 0xec, 0x2e, 0x1e, 0xcf, 0xf4, 0xa7, 0x93, 0x99, 0x7e, 0x1d, 0x4a, 0xd1, 0xf3,
-	0x5d, 0x74, 0x4c, 0x08, 0xc7, 0x1a, 0x36, 0x53, 0x55, 0xe2, 0x85, 0x4f, 0x9c, 0x16, 0xe6, 0x12,
-	0x8f, 0x79, 0xea, 0x05, 0x00, 0xf9, 0x18, 0x84, 0x79, 0x57, 0x42, 0x41, 0x2f, 0x8a, 0x91, 0x86,
-	0xcd, 0x26, 0x8a, 0x3d, 0x37, 0xb2, 0xc4, 0x4e, 0x27, 0x8a, 0x5e, 0x1a, 0x69, 0x4c, 0x61, 0xed,
-	0x81, 0xd1, 0xeb, 0x05, 0xc3, 0x2c, 0x32, 0xcc, 0x0a, 0x05, 0x85, 0x6f, 0x0a, 0x0c, 0xff, 0x36,
-	0x4c, 0xcb, 0x27, 0x42, 0x18, 0xf1, 0x89, 0x50, 0x12, 0xc4, 0x1f, 0x34, 0x67, 0x93, 0x9f, 0xc7,
-	0x6c, 0x42, 0x89, 0x37, 0x29, 0x8a, 0x0f, 0x4b, 0x4a, 0x23, 0x7e, 0x58, 0x32, 0xcb, 0xfa, 0x17,
-	0xc5, 0x37, 0x25, 0x6f, 0x01, 0xfb, 0x26, 0x84, 0x25, 0x67, 0xe4, 0x1b, 0xd8, 0x46, 0x6e, 0x80,
-	0x83, 0x1e, 0xcb, 0xd1, 0x45, 0x5d, 0xa5, 0xb0, 0x27, 0x0c, 0xd4, 0x10, 0x10, 0xf5, 0x09, 0xcc,
-	0xf7, 0x79, 0xac, 0xe8, 0x5b, 0xab, 0x8f, 0xe7, 0xab, 0x7a, 0x39, 0xe9, 0xa7, 0xda, 0x32, 0x2c,
-	0x25, 0xad, 0x4d, 0x98, 0xe1, 0x8f, 0x14, 0x38, 0x27, 0x6f, 0x2c, 0x27, 0xdc, 0x8f, 0xab, 0xfd,
-	0x89, 0x02, 0xe7, 0xb3, 0xd7, 0x22, 0x2e, 0x4e, 0xb7, 0x60, 0xb9, 0xcd, 0xc7, 0x79, 0xdf, 0x9d,
-	0x81, 0x5d, 0xc3, 0x32, 0xad, 0x16, 0x12, 0xcb, 0x5a, 0x6c, 0xc7, 0xa8, 0x1a, 0xee, 0x26, 0x05,
-	0xa9, 0xef, 0xc1, 0xd9, 0x14, 0x91, 0x6d, 0x06, 0xe6, 0x9e, 0x49, 0x90, 0xf0, 0x9d, 0xe5, 0x24,
-	0xdd, 0x96, 0x80, 0x6a, 0xff, 0xa0, 0xc0, 0xaa, 0x5c, 0x90, 0x50, 0xe4, 0x7d, 0x8f, 0xc4, 0x5f,
-	0xe1, 0x5a, 0x1e, 0x09, 0x0c, 0xd3, 0xb6, 0x7d, 0x44, 0x88, 0xd4, 0x0d, 0x1d, 0xbb, 0xc3, 0x87,
-	0xd4, 0xd7, 0xa1, 0x22, 0x23, 0x87, 0xd1, 0xf4, 0x7c, 0x83, 0xc2, 0xd8, 0xa4, 0x93, 0x7a, 0x59,
-	0x84, 0x90, 0x6d, 0xcf, 0xa7, 0x4c, 0xd5, 0x27, 0xa0, 0x46, 0xd7, 0xb5, 0x10, 0x77, 0xec, 0xbb,
-	0x42, 0x74, 0xb5, 0x14, 0x8c, 0xb5, 0x3f, 0x9b, 0x88, 0xb6, 0x38, 0x21, 0x85, 0xd0, 0xea, 0x06,
-	0x2c, 0xb8, 0xdd, 0xf6, 0x1e, 0xf2, 0x0d, 0xaf, 0x69, 0xb0, 0x45, 0x71, 0x51, 0x26, 0xf5, 0x32,
-	0x1f, 0xff, 0xa0, 0xc9, 0x02, 0x18, 0x51, 0xcf, 0x41, 0x51, 0x4a, 0x43, 0xaa, 0x13, 0x6b, 0x85,
-	0x8d, 0x49, 0x7d, 0x46, 0x48, 0x41, 0xd4, 0xef, 0xc2, 0x7c, 0x64, 0x29, 0x7c, 0x57, 0x06, 0x7d,
-	0xce, 0x16, 0xe2, 0x52, 0x31, 0xc2, 0x17, 0x73, 0xb6, 0x65, 0x2c, 0xfd, 0x96, 0xdd, 0xc4, 0x98,
-	0xfa, 0x36, 0xac, 0xf0, 0xb9, 0x2d, 0xcf, 0x0d, 0x7c, 0xcf, 0x71, 0x90, 0x2f, 0xfb, 0x23, 0x4f,
-	0x33, 0xbd, 0x9f, 0x61, 0xe0, 0xcd, 0x10, 0x2a, 0x9a, 0xf0, 0xa9, 0x8b, 0x8b, 0xfd, 0xe1, 0x2d,
-	0x1d, 0xf2, 0xa7, 0x56, 0x87, 0xca, 0xa6, 0xe3, 0x11, 0xc4, 0x84, 0x93, 0x7b, 0x1a, 0x0f, 0xf5,
-	0x5c, 0x09, 0x32, 0xd4, 0x6b, 0x4b, 0xa0, 0xc6, 0xf1, 0x85, 0x03, 0xfd, 0x5c, 0x81, 0x0a, 0x2f,
-	0x64, 0xc7, 0xcb, 0x62, 0xf9, 0x6c, 0xd4, 0x6d, 0x98, 0xa1, 0x59, 0x6a, 0x9f, 0xfa, 0xf6, 0x04,
-	0xeb, 0xec, 0x7c, 0x63, 0x70, 0xdf, 0x28, 0x7f, 0x82, 0xe2, 0x14, 0x7a, 0x48, 0x1b, 0xef, 0x63,
-	0x29, 0x24, 0xfa, 0x58, 0x6e, 0xc0, 0xd2, 0x21, 0x26, 0x78, 0x0f, 0x3b, 0x38, 0xe8, 0xc5, 0xba,
-	0x3a, 0x78, 0xcc, 0x5f, 0x8c, 0x60, 0x61, 0xf7, 0x05, 0x15, 0x2d, 0x2e, 0x83, 0x10, 0xed, 0xc7,
-	0x0a, 0x5c, 0x78, 0x1f, 0x05, 0xb1, 0x83, 0xd1, 0x43, 0xfe, 0x91, 0x67, 0x98, 0x3f, 0x1f, 0xc0,
-	0x14, 0xeb, 0xad, 0xa2, 0x06, 0x53, 0xc8, 0xdd, 0xea, 0xfc, 0x83, 0x16, 0xeb, 0xc2, 0xd2, 0x05,
-	0x0f, 0xea, 0x4f, 0x22, 0x0d, 0xb3, 0xde, 0x0a, 0x79, 0xa1, 0x16, 0x63, 0xd4, 0x46, 0xb4, 0xbf,
-	0x9c, 0x80, 0x5a, 0xde, 0x92, 0x84, 0x39, 0xff, 0xa1, 0x02, 0x15, 0xf1, 0x31, 0x2a, 0x31, 0xf6,
-	0x7a, 0xdc, 0xa2, 0xc5, 0xfa, 0x9e, 0x8e, 0xfe, 0xb5, 0xca, 0x80, 0x29, 0xea, 0x72, 0xe0, 0x6e,
-	0x8f, 0xd9, 0x82, 0x38, 0xa2, 0xb7, 0x93, 0xa3, 0xab, 0xbf, 0x0d, 0x4b, 0x59, 0x88, 0xf1, 0xe3,
-	0xea, 0x24, 0x3f, 0xae, 0x3e, 0x4c, 0x1e, 0x57, 0xdf, 0x19, 0x53, 0x8b, 0xe1, 0xfa, 0x62, 0x47,
-	0xd6, 0x4f, 0x60, 0xed, 0x7d, 0x14, 0x6c, 0x3d, 0xf8, 0x70, 0xc0, 0xee, 0x7d, 0x24, 0x3a, 0xeb,
-	0xe9, 0xf5, 0x45, 0xee, 0xe0, 0xb8, 0x73, 0x53, 0x83, 0x61, 0xfe, 0xca, 0x9a, 0xed, 0xe9, 0x5f,
-	0x44, 0xfb, 0x03, 0x05, 0xd6, 0x07, 0x4c, 0x2e, 0xf6, 0xe9, 0xfb, 0x50, 0x89, 0xdf, 0xa1, 0x28,
-	0xb9, 0x5c, 0xc4, 0xad, 0xe7, 0x58, 0x84, 0x1e, 0xbf, 0x91, 0xd1, 0x01, 0xa2, 0xfd, 0xb1, 0x02,
-	0x4b, 0xac, 0x83, 0x2d, 0xfc, 0x18, 0x6b, 0xf4, 0xa4, 0xf6, 0x41, 0x7f, 0x99, 0xf0, 0x6b, 0x43,
-	0xcb, 0x84, 0x59, 0x53, 0x45, 0xa5, 0xc1, 0x03, 0x38, 0xd3, 0x87, 0x20, 0xf4, 0xa0, 0xc3, 0x4c,
-	0x5f, 0xdb, 0xcc, 0xdb, 0xe3, 0x4e, 0x25, 0x9a, 0x64, 0x42, 0x3e, 0x34, 0x93, 0x2e, 0xe9, 0xc8,
-	0xec, 0x74, 0x9c, 0xde, 0xd8, 0xd7, 0xe6, 0x9d, 0x7e, 0xc9, 0xdf, 0xcb, 0xdc, 0x8d, 0xf8, 0x47,
-	0xd9, 0x7c, 0x3b, 0xd2, 0xd3, 0x45, 0xd2, 0xaf, 0xc0, 0x99, 0x3e, 0x04, 0xb1, 0xd2, 0xbf, 0x9e,
-	0x80, 0x65, 0x1d, 0x99, 0xf6, 0xd6, 0x83, 0x0f, 0xfb, 0xcd, 0xf3, 0x1e, 0x9c, 0x0e, 0x3b, 0x80,
-	0xcb, 0xf1, 0xd2, 0x68, 0x56, 0x90, 0xdc, 0x42, 0xa6, 0xfd, 0x00, 0x05, 0x01, 0xf2, 0x59, 0xb3,
-	0x28, 0x6b, 0xdd, 0x62, 0xe4, 0xa9, 0xc3, 0x7b, 0x2c, 0x14, 0xa7, 0x8f, 0xff, 0x85, 0xac, 0xe3,
-	0xff, 0x3b, 0x50, 0xc5, 0x2e, 0xc5, 0xc0, 0x87, 0xc8, 0x40, 0xae, 0x6d, 0x08, 0x4f, 0x8f, 0xaa,
-	0x6e, 0x67, 0x42, 0xf8, 0x3d, 0xd7, 0x16, 0x72, 0x34, 0x6c, 0xf5, 0x0d, 0xa8, 0xb4, 0xcd, 0x63,
-	0xdc, 0xee, 0xb6, 0x8d, 0x0e, 0xc5, 0x27, 0xf8, 0x13, 0xc4, 0xb2, 0xd0, 0xa4, 0x3e, 0x2f, 0x00,
-	0x8f, 0xcd, 0x7d, 0xb4, 0x83, 0x3f, 0x41, 0xea, 0x6b, 0xf4, 0x52, 0x7e, 0x1c, 0x70, 0x44, 0xde,
-	0xb0, 0x3a, 0xc5, 0x1a, 0x56, 0x59, 0x05, 0x8f, 0xa2, 0xf1, 0xaf, 0x89, 0xfe, 0x43, 0x81, 0x95,
-	0x94, 0xc2, 0x84, 0x29, 0xbd, 0x24, 0x8d, 0x65, 0x7a, 0xe6, 0xc4, 0x4b, 0xf4, 0xcc, 0x2c, 0x61,
-	0x0b, 0x59, 0xc2, 0xfe, 0xab, 0x02, 0x2b, 0x8f, 0xbb, 0xfe, 0x3e, 0xfa, 0x55, 0x34, 0x0f, 0x6d,
-	0x15, 0xaa, 0x69, 0xe1, 0x64, 0x5b, 0xd0, 0x04, 0xac, 0x3c, 0x44, 0xbf, 0xa2, 0x92, 0xbf, 0x12,
-	0xc7, 0xb8, 0x0b, 0xd5, 0xb4, 0xc2, 0x84, 0x63, 0x64, 0xf0, 0x50, 0xb2, 0x78, 0xfc, 0x39, 0xfb,
-	0x54, 0xa5, 0xe9, 0x23, 0xd2, 0x0a, 0x9b, 0x40, 0xa9, 0xc1, 0x8e, 0x11, 0x3e, 0x9f, 0xf6, 0x87,
-	0xcf, 0xdf, 0x18, 0x31, 0x7c, 0xe6, 0xce, 0x1a, 0x45, 0x51, 0xf6, 0xf5, 0x4a, 0x16, 0x1e, 0x17,
-	0xf3, 0x6e, 0xe7, 0xd3, 0xcf, 0x6b, 0xa7, 0x3e, 0xfb, 0xbc, 0x76, 0xea, 0x97, 0x9f, 0xd7, 0x94,
-	0x1f, 0x3c, 0xab, 0x29, 0x3f, 0x7d, 0x56, 0x53, 0xfe, 0xe9, 0x59, 0x4d, 0xf9, 0xf4, 0x59, 0x4d,
-	0xf9, 0xb7, 0x67, 0x35, 0xe5, 0xdf, 0x9f, 0xd5, 0x4e, 0xfd, 0xf2, 0x59, 0x4d, 0xf9, 0xf1, 0x17,
-	0xb5, 0x53, 0x9f, 0x7e, 0x51, 0x3b, 0xf5, 0xd9, 0x17, 0xb5, 0x53, 0x4f, 0x6f, 0xef, 0x7b, 0xd1,
-	0x12, 0xb1, 0x37, 0xf0, 0xdf, 0xcc, 0x7c, 0x3d, 0x39, 0xb2, 0x37, 0xc5, 0xfe, 0xf5, 0xc6, 0xad,
-	0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x31, 0x70, 0xc8, 0x8a, 0xa5, 0x46, 0x00, 0x00,
+	// 4081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4d, 0x6c, 0x1c, 0x59,
+	0x5a, 0x29, 0x77, 0xda, 0x76, 0x7f, 0xdd, 0x6e, 0x77, 0x97, 0x1d, 0xbb, 0xe3, 0x24, 0x1d, 0xbb,
+	0x12, 0x4f, 0x3c, 0x3b, 0x9b, 0xce, 0x24, 0xd9, 0x9d, 0x99, 0xcd, 0x2e, 0x0b, 0x89, 0x1d, 0x4f,
+	0x5a, 0xca, 0xdf, 0x94, 0xbd, 0x13, 0x94, 0x5d, 0xb6, 0xb6, 0x5c, 0xf5, 0xda, 0x5d, 0xb8, 0xba,
+	0xaa, 0xa7, 0x5e, 0xb5, 0xed, 0x1e, 0x04, 0x2c, 0x5a, 0x81, 0x80, 0x03, 0x5a, 0x09, 0x71, 0x02,
+	0x2e, 0x1c, 0x60, 0x4f, 0x70, 0x40, 0x02, 0xed, 0x81, 0xcb, 0x22, 0x21, 0x8e, 0x03, 0xa7, 0x91,
+	0xb8, 0x30, 0x99, 0x0b, 0x82, 0x3d, 0xec, 0x81, 0x2b, 0x12, 0x7a, 0x7f, 0xf5, 0xd3, 0x55, 0xd5,
+	0x3f, 0x71, 0x82, 0xd1, 0xee, 0xdc, 0xba, 0xde, 0xfb, 0xbe, 0xef, 0xbd, 0xef, 0xff, 0xd5, 0xf7,
+	0xbe, 0x6a, 0xf8, 0x86, 0x8f, 0x3a, 0x5d, 0xd7, 0xd3, 0xed, 0x1b, 0x18, 0x79, 0x87, 0xc8, 0xbb,
+	0xa1, 0x77, 0xad, 0x1b, 0x6d, 0x0b, 0xfb, 0xae, 0xd7, 0x27, 0x23, 0x96, 0x81, 0x6e, 0x1c, 0xde,
+	0xbc, 0xe1, 0xa1, 0x8f, 0x7a, 0x08, 0xfb, 0x9a, 0x87, 0x70, 0xd7, 0x75, 0x30, 0x6a, 0x74, 0x3d,
+	0xd7, 0x77, 0xe5, 0x75, 0x81, 0xdd, 0x60, 0xd8, 0x0d, 0xbd, 0x6b, 0x35, 0xe2, 0xd8, 0x8d, 0xc3,
+	0x9b, 0x2b, 0x57, 0x83, 0x45, 0x08, 0x75, 0xc3, 0xed, 0x74, 0x5c, 0x87, 0x50, 0xed, 0x20, 0x8c,
+	0xf5, 0x7d, 0x4e, 0x6c, 0x65, 0x3d, 0x06, 0xc5, 0xa9, 0x24, 0xc1, 0xae, 0xc5, 0xc0, 0x7c, 0x1d,
+	0x1f, 0x7c, 0xd4, 0x43, 0x3d, 0x94, 0x04, 0x8c, 0xaf, 0x8a, 0x9c, 0x5e, 0x07, 0x13, 0xa0, 0x23,
+	0xd7, 0x3b, 0x68, 0xd9, 0xee, 0x11, 0x87, 0x7a, 0x23, 0x06, 0x25, 0x26, 0x93, 0xd4, 0xae, 0xc4,
+	0xe0, 0x3e, 0xea, 0x21, 0xaf, 0x3f, 0x8a, 0x85, 0x96, 0x6e, 0xd9, 0x3d, 0x2f, 0x65, 0x67, 0x5f,
+	0x1e, 0x22, 0xf4, 0x24, 0xf4, 0x9b, 0x69, 0xd0, 0x01, 0x3b, 0x4c, 0x9a, 0x1c, 0xf4, 0xad, 0xa1,
+	0xa0, 0x03, 0x9c, 0x5f, 0x1b, 0x0a, 0x4c, 0x04, 0xcb, 0x01, 0xaf, 0xa7, 0x01, 0x66, 0x4b, 0xaa,
+	0x91, 0x06, 0xee, 0xe8, 0x1d, 0x84, 0xbb, 0xba, 0x91, 0x22, 0x8d, 0xb7, 0xd3, 0xe0, 0x3d, 0xd4,
+	0xb5, 0x2d, 0x43, 0xf7, 0xad, 0x34, 0x4b, 0x79, 0x27, 0x55, 0x67, 0x23, 0xcd, 0x75, 0xe5, 0x4e,
+	0xda, 0x4a, 0xba, 0xd9, 0xb1, 0x9c, 0x91, 0xb8, 0xca, 0x67, 0x79, 0xb8, 0xb4, 0xe3, 0xeb, 0x9e,
+	0xff, 0x8c, 0x2f, 0x77, 0xff, 0x18, 0x19, 0x3d, 0xb2, 0x3f, 0x95, 0x21, 0xc8, 0x6b, 0x50, 0x0a,
+	0xb8, 0xd4, 0x2c, 0xb3, 0x26, 0xad, 0x4a, 0x1b, 0x05, 0xb5, 0x18, 0x8c, 0x35, 0x4d, 0xd9, 0x80,
+	0x39, 0x4c, 0x68, 0x68, 0x7c, 0x91, 0xda, 0xd4, 0xaa, 0xb4, 0x51, 0xbc, 0xf5, 0xcd, 0x40, 0x64,
+	0xd4, 0x81, 0x06, 0x18, 0x6a, 0x1c, 0xde, 0x6c, 0x0c, 0x5d, 0x59, 0x2d, 0x51, 0xa2, 0x62, 0x1f,
+	0x6d, 0x38, 0xd7, 0xd5, 0x3d, 0xe4, 0xf8, 0x1a, 0x12, 0x80, 0x9a, 0xe5, 0xb4, 0xdc, 0x5a, 0x8e,
+	0x2e, 0xf6, 0x95, 0x46, 0x9a, 0xd3, 0x06, 0xb6, 0x71, 0x78, 0xb3, 0xf1, 0x94, 0x62, 0x07, 0xab,
+	0x34, 0x9d, 0x96, 0xab, 0x2e, 0x74, 0x93, 0x83, 0x72, 0x0d, 0x66, 0x74, 0x9f, 0x50, 0xf3, 0x6b,
+	0x67, 0x57, 0xa5, 0x8d, 0xbc, 0x2a, 0x1e, 0xe5, 0x6f, 0xc1, 0x35, 0x41, 0x31, 0xb2, 0x0b, 0x74,
+	0xdc, 0xb5, 0x3c, 0xaa, 0x53, 0xcd, 0xb7, 0x3a, 0x08, 0xfb, 0x7a, 0xa7, 0x5b, 0xcb, 0xaf, 0x4a,
+	0x1b, 0x39, 0xf5, 0xea, 0xd1, 0x20, 0x73, 0xf7, 0x03, 0xe0, 0x5d, 0x01, 0x2b, 0xb7, 0xe1, 0xbc,
+	0xe1, 0x3a, 0xbe, 0xe5, 0xf4, 0x90, 0xa6, 0x63, 0xcd, 0x41, 0x47, 0x9a, 0xe5, 0x58, 0xbe, 0xa5,
+	0xfb, 0xae, 0x57, 0x9b, 0x5e, 0x95, 0x36, 0xca, 0xb7, 0xae, 0xc7, 0x65, 0x49, 0xed, 0x99, 0x30,
+	0xb5, 0xc9, 0xf1, 0xee, 0xe2, 0xc7, 0xe8, 0xa8, 0x29, 0x90, 0xd4, 0x25, 0x23, 0x75, 0x5c, 0x7e,
+	0x04, 0x55, 0x31, 0x63, 0x6a, 0xdc, 0x91, 0x6b, 0x33, 0x54, 0x80, 0xab, 0xf1, 0x15, 0xf8, 0x24,
+	0x59, 0x63, 0x9b, 0xfd, 0x54, 0x2b, 0x01, 0x2a, 0x1f, 0x91, 0x3f, 0x84, 0x25, 0x5b, 0xc7, 0xbe,
+	0x66, 0xb8, 0x9d, 0xae, 0x8d, 0xa8, 0x04, 0x3c, 0x84, 0x7b, 0xb6, 0x5f, 0x9b, 0x4d, 0xa3, 0xc9,
+	0x9d, 0x9a, 0xea, 0xa2, 0x6f, 0xbb, 0xba, 0x89, 0xd5, 0x45, 0x82, 0xbf, 0x19, 0xa0, 0xab, 0x14,
+	0x5b, 0x7e, 0x08, 0x57, 0x5a, 0x96, 0x87, 0x7d, 0xcd, 0x44, 0x86, 0x85, 0xa9, 0x60, 0x75, 0x7c,
+	0xa0, 0xed, 0xe9, 0xc6, 0x81, 0xdb, 0x6a, 0x69, 0x18, 0x19, 0xae, 0x63, 0xe2, 0x5a, 0x81, 0x6a,
+	0xe7, 0x32, 0x05, 0xdd, 0xe2, 0x90, 0xbb, 0x3a, 0x3e, 0xb8, 0xc7, 0xe0, 0x76, 0x18, 0x98, 0xf2,
+	0x2e, 0xd4, 0xb3, 0x0c, 0x8d, 0xf9, 0x82, 0x7c, 0x0e, 0xa6, 0xbd, 0x9e, 0x13, 0x5a, 0x77, 0xde,
+	0xeb, 0x39, 0x4d, 0x53, 0xf9, 0x2f, 0x09, 0x96, 0xde, 0x47, 0xfe, 0xa3, 0x9e, 0xaf, 0xef, 0xd9,
+	0x68, 0xc7, 0xd7, 0x7d, 0x34, 0x81, 0x57, 0xbc, 0x0f, 0x85, 0xc0, 0x46, 0xb8, 0x47, 0xbc, 0x99,
+	0x25, 0x8f, 0xe4, 0xd6, 0x42, 0x5c, 0xf9, 0x36, 0x2c, 0xa1, 0xe3, 0x2e, 0x32, 0x7c, 0x64, 0x6a,
+	0x0e, 0x3a, 0xf6, 0x35, 0x74, 0x48, 0xdc, 0xc0, 0x32, 0xa9, 0xe9, 0xe7, 0xd4, 0x05, 0x31, 0xfb,
+	0x18, 0x1d, 0xfb, 0xf7, 0xc9, 0x5c, 0xd3, 0x94, 0xdf, 0x86, 0x45, 0xa3, 0xe7, 0x51, 0x7f, 0xd9,
+	0xf3, 0x74, 0xc7, 0x68, 0x6b, 0xbe, 0x7b, 0x80, 0x1c, 0x6a, 0xd1, 0x25, 0x55, 0xe6, 0x73, 0xf7,
+	0xe8, 0xd4, 0x2e, 0x99, 0x51, 0xfe, 0xae, 0x08, 0xcb, 0x09, 0x6e, 0xb9, 0x80, 0x62, 0xbc, 0x48,
+	0x27, 0xe0, 0xa5, 0x09, 0x73, 0x81, 0x07, 0xf9, 0xfd, 0x2e, 0xe2, 0x82, 0xb9, 0x3a, 0x8a, 0xd8,
+	0x6e, 0xbf, 0x8b, 0xd4, 0xd2, 0x51, 0xe4, 0x49, 0x56, 0x60, 0x2e, 0x4d, 0x1a, 0x45, 0x27, 0x22,
+	0x85, 0xaf, 0xc1, 0xf9, 0xae, 0x87, 0x0e, 0x2d, 0xb7, 0x87, 0x35, 0x1a, 0x4d, 0x90, 0x19, 0xc2,
+	0x9f, 0xa5, 0xf0, 0x4b, 0x02, 0x60, 0x87, 0xcd, 0x0b, 0xd4, 0xeb, 0xb0, 0x40, 0x6d, 0x9b, 0x19,
+	0x62, 0x80, 0xc4, 0xfc, 0xba, 0x42, 0xa6, 0xb6, 0xc9, 0x8c, 0x00, 0xdf, 0x04, 0xa0, 0x36, 0x4a,
+	0xb3, 0x76, 0x6d, 0x3a, 0x8d, 0xab, 0x20, 0xa9, 0x13, 0xc6, 0x88, 0x9d, 0x7e, 0x40, 0x1e, 0xd4,
+	0x82, 0x2f, 0x7e, 0xca, 0x4f, 0xa1, 0x8a, 0x7d, 0xcb, 0x38, 0xe8, 0x6b, 0x11, 0x5a, 0x33, 0x13,
+	0xd0, 0x9a, 0x67, 0xe8, 0xc1, 0x80, 0xfc, 0x15, 0x58, 0x32, 0x6c, 0x8b, 0xec, 0xdd, 0xb6, 0xf6,
+	0x3c, 0xdd, 0xeb, 0x6b, 0x87, 0xc8, 0x23, 0x7e, 0x42, 0x3d, 0xb4, 0xa0, 0x2e, 0xb2, 0xd9, 0x87,
+	0x6c, 0xf2, 0x43, 0x36, 0x17, 0xc1, 0x6a, 0x21, 0xdd, 0xef, 0x79, 0x28, 0xc0, 0x2a, 0x44, 0xb1,
+	0xb6, 0xd9, 0xa4, 0xc0, 0xba, 0x0c, 0x45, 0x8e, 0x65, 0x75, 0xba, 0x76, 0x0d, 0x28, 0x28, 0xb0,
+	0xa1, 0x66, 0xa7, 0x6b, 0xcb, 0x0d, 0x58, 0xb0, 0xb0, 0x16, 0xe8, 0xdf, 0xeb, 0x39, 0x8e, 0xe5,
+	0xec, 0xd7, 0x8a, 0xab, 0xd2, 0xc6, 0xac, 0x5a, 0xb5, 0xb0, 0x50, 0xb6, 0xca, 0x26, 0xe4, 0xe7,
+	0xf0, 0x56, 0x42, 0x1c, 0x1a, 0x36, 0xda, 0xc8, 0xec, 0xd9, 0x48, 0xf3, 0x5d, 0xa6, 0x52, 0x1a,
+	0x74, 0xdd, 0x9e, 0x5f, 0x2b, 0xd1, 0x70, 0xb0, 0x3e, 0x20, 0x82, 0x1d, 0x0e, 0xbf, 0xeb, 0x52,
+	0x05, 0xef, 0x32, 0x60, 0xb2, 0x17, 0xa6, 0x53, 0xec, 0xbb, 0x11, 0xfe, 0xe6, 0x28, 0x8d, 0x2a,
+	0x9d, 0xda, 0xf1, 0xdd, 0x90, 0xb9, 0x2c, 0x7f, 0x2a, 0x67, 0xf9, 0x93, 0xfc, 0x5b, 0x50, 0x89,
+	0xa4, 0x7b, 0x96, 0xab, 0xe6, 0x57, 0x73, 0x1b, 0xc5, 0x5b, 0x3b, 0x8d, 0xb1, 0x0e, 0x98, 0x8d,
+	0x0c, 0x6f, 0x6c, 0xa8, 0x21, 0x59, 0x92, 0xb0, 0xee, 0x3b, 0xbe, 0xd7, 0x57, 0xe7, 0xbd, 0xf8,
+	0xa8, 0xfc, 0x6d, 0x28, 0x07, 0xa2, 0xc6, 0x04, 0xbf, 0x56, 0xa1, 0xa9, 0x24, 0x3d, 0x53, 0x06,
+	0x19, 0x25, 0xe1, 0xbe, 0x6c, 0xed, 0xc0, 0x6d, 0xe9, 0xa3, 0xfc, 0x0c, 0xe6, 0x63, 0xc4, 0x7b,
+	0xb8, 0x56, 0xa5, 0xd4, 0x1b, 0x19, 0x89, 0x2a, 0x95, 0x6c, 0x0f, 0xab, 0xe5, 0x28, 0xdd, 0x1e,
+	0x96, 0x7f, 0x0d, 0xaa, 0x5c, 0x17, 0x1a, 0x13, 0x88, 0x85, 0x70, 0x4d, 0xa6, 0x2e, 0xf0, 0xf6,
+	0x30, 0xb1, 0x91, 0x35, 0xb8, 0xae, 0x1e, 0x08, 0x3c, 0xb5, 0x72, 0x38, 0x30, 0x22, 0x7f, 0x13,
+	0x2e, 0x5a, 0x58, 0x63, 0x26, 0x12, 0xb5, 0x2a, 0xe4, 0x10, 0x31, 0x9b, 0xb5, 0x05, 0x6a, 0x8b,
+	0x35, 0x0b, 0xef, 0xc4, 0xad, 0xe8, 0x3e, 0x9b, 0x97, 0xcf, 0xc3, 0xec, 0x5e, 0xcf, 0xb2, 0x4d,
+	0x12, 0x0a, 0x16, 0xa9, 0x81, 0xcf, 0xd0, 0xe7, 0xa6, 0xb9, 0x72, 0x04, 0x8b, 0x69, 0x8a, 0x91,
+	0x2b, 0x90, 0x3b, 0x40, 0x7d, 0x9e, 0x21, 0xc8, 0x4f, 0xb9, 0x09, 0xf9, 0x43, 0xdd, 0xee, 0x89,
+	0xe0, 0x77, 0x3b, 0x95, 0xaf, 0x88, 0x3a, 0x09, 0x6f, 0x03, 0xa4, 0x55, 0x46, 0xe1, 0xce, 0xd4,
+	0x7b, 0x92, 0xf2, 0x53, 0x09, 0x96, 0x9f, 0xba, 0xb6, 0xfd, 0x0b, 0x92, 0xa7, 0xfe, 0xa7, 0x00,
+	0xb5, 0x24, 0xbb, 0x5f, 0x24, 0xaa, 0x2f, 0x12, 0xd5, 0x2b, 0x4b, 0x54, 0x13, 0x26, 0x9e, 0xe2,
+	0x24, 0x89, 0x27, 0xcb, 0xe0, 0x4b, 0x99, 0x89, 0xe4, 0xb7, 0x53, 0x12, 0xc9, 0x1c, 0x4d, 0x24,
+	0xbb, 0x63, 0x26, 0x92, 0x2c, 0x77, 0x19, 0x33, 0x93, 0xa4, 0xc6, 0xe4, 0xf2, 0x2b, 0x8b, 0xc9,
+	0xc9, 0x44, 0x35, 0xff, 0x5a, 0x13, 0x55, 0xe5, 0x55, 0x24, 0xaa, 0xd3, 0x0b, 0xf7, 0x7f, 0x20,
+	0xc1, 0x05, 0x15, 0x61, 0xe4, 0x0f, 0xa4, 0xa8, 0x53, 0x08, 0xf9, 0x4a, 0x1d, 0x2e, 0xa6, 0x6f,
+	0x85, 0xd9, 0x97, 0xf2, 0xe9, 0x14, 0xac, 0xaa, 0xc8, 0x70, 0x3d, 0x33, 0xfa, 0x7e, 0xc6, 0x03,
+	0xd8, 0x04, 0x1b, 0xfe, 0x55, 0x90, 0x93, 0x2f, 0xde, 0x93, 0xef, 0xbc, 0x9a, 0x78, 0x1d, 0x27,
+	0xb1, 0x20, 0x70, 0xec, 0x20, 0x34, 0x83, 0x18, 0x6a, 0x9a, 0xf2, 0x32, 0xcc, 0xd0, 0x20, 0x10,
+	0xc4, 0xe1, 0x69, 0xf2, 0xd8, 0x34, 0xe5, 0x4b, 0x00, 0xa2, 0xa8, 0xc2, 0xc3, 0x6d, 0x41, 0x2d,
+	0xf0, 0x91, 0xa6, 0x29, 0x7f, 0x17, 0x4a, 0x5d, 0xd7, 0xb6, 0x83, 0x9a, 0x08, 0x8b, 0xb4, 0x5f,
+	0x1f, 0x59, 0x13, 0x21, 0xbe, 0xba, 0xed, 0x7a, 0x51, 0x79, 0x89, 0x82, 0x48, 0x91, 0x10, 0xe4,
+	0x0f, 0xca, 0x4f, 0x66, 0x60, 0x6d, 0x88, 0x68, 0x79, 0x3e, 0x4c, 0xa4, 0x31, 0xe9, 0xa5, 0xd3,
+	0xd8, 0xd0, 0x14, 0x35, 0x35, 0x34, 0x45, 0x7d, 0x19, 0x64, 0x21, 0x51, 0x73, 0x30, 0x0d, 0x56,
+	0x82, 0x19, 0x01, 0xbd, 0x01, 0x95, 0x8c, 0x14, 0x58, 0xc6, 0x71, 0xba, 0x89, 0xcc, 0x9a, 0x4f,
+	0x66, 0xd6, 0x48, 0x35, 0x67, 0x9a, 0xce, 0x8a, 0x47, 0xf9, 0x3d, 0xa8, 0xf1, 0x28, 0x1f, 0xa9,
+	0xe5, 0xf0, 0x73, 0xe0, 0x0c, 0x3d, 0x07, 0x2e, 0xb1, 0xf9, 0xb0, 0x78, 0xc3, 0x66, 0xe5, 0xe7,
+	0x30, 0x17, 0x54, 0x26, 0x68, 0x38, 0x66, 0xe5, 0x8e, 0xaf, 0x8e, 0x0a, 0x86, 0xbb, 0x9e, 0xee,
+	0x60, 0x92, 0x65, 0x84, 0xca, 0xa8, 0x6f, 0x97, 0xcc, 0xc8, 0x93, 0xbc, 0x0f, 0x97, 0x52, 0x6a,
+	0x4c, 0x91, 0x34, 0x5b, 0x98, 0x20, 0xcd, 0xae, 0x24, 0x0c, 0x3e, 0x98, 0xcb, 0x7a, 0x03, 0x82,
+	0xac, 0x37, 0xa0, 0x35, 0x28, 0xc5, 0x12, 0x56, 0x91, 0x26, 0xac, 0xe2, 0x5e, 0x24, 0x53, 0xdd,
+	0x80, 0x85, 0x50, 0xcf, 0x61, 0x2d, 0xac, 0x44, 0xe5, 0x1e, 0x9a, 0x40, 0x58, 0xf9, 0x7a, 0x0b,
+	0xaa, 0x5c, 0xa5, 0x11, 0xf0, 0x39, 0x6e, 0x17, 0x6c, 0x22, 0x04, 0x76, 0x61, 0x86, 0x14, 0xa8,
+	0x59, 0xf2, 0x21, 0xe9, 0xef, 0x5b, 0x63, 0xa6, 0xbf, 0x91, 0x6e, 0xd2, 0xf8, 0x80, 0xd1, 0x65,
+	0xf9, 0x4f, 0xac, 0xb2, 0xf2, 0x3d, 0x28, 0x45, 0x27, 0x52, 0x42, 0xfb, 0x9d, 0x78, 0x68, 0x1f,
+	0x50, 0x0a, 0x2d, 0xa7, 0x47, 0xbd, 0x8a, 0x50, 0xeb, 0x47, 0x63, 0x79, 0x18, 0x1f, 0xef, 0x1a,
+	0xbe, 0x75, 0x68, 0xf9, 0xfd, 0x2f, 0xe2, 0xe3, 0x78, 0xf1, 0x31, 0x2a, 0xaf, 0xd4, 0xf8, 0xf8,
+	0x2f, 0x39, 0x11, 0x1f, 0x53, 0x45, 0xcb, 0xe3, 0xe3, 0x63, 0x98, 0x1f, 0x88, 0x4c, 0x3c, 0x42,
+	0xae, 0xc7, 0x37, 0x12, 0x71, 0x62, 0x76, 0x70, 0xe9, 0xd3, 0xf8, 0xa2, 0x96, 0xe3, 0xd1, 0x2b,
+	0xdd, 0xa0, 0xa7, 0x32, 0x0c, 0x3a, 0x12, 0x9a, 0x72, 0xf1, 0xd0, 0xf4, 0x10, 0xae, 0xa4, 0x38,
+	0x92, 0xe6, 0xb6, 0x34, 0xbf, 0x6d, 0x61, 0x2d, 0x5a, 0x9e, 0xce, 0xa9, 0x97, 0x93, 0x8e, 0xf5,
+	0xa4, 0xb5, 0xdb, 0xb6, 0xf0, 0x5d, 0x4e, 0xed, 0x11, 0x54, 0xdb, 0x48, 0xf7, 0xfc, 0x3d, 0xa4,
+	0x93, 0x92, 0xaa, 0xaf, 0x5b, 0x36, 0xae, 0xe5, 0xc7, 0xac, 0xd0, 0x56, 0x02, 0xd4, 0x2d, 0x86,
+	0x99, 0xcc, 0x29, 0xd3, 0x2f, 0x9d, 0x53, 0xae, 0x47, 0xec, 0x36, 0xb0, 0x67, 0x1a, 0x7c, 0x0b,
+	0xa1, 0x31, 0x3e, 0x16, 0x13, 0xca, 0x8f, 0x25, 0xb8, 0xc2, 0x54, 0x17, 0xf3, 0x66, 0x5e, 0x3f,
+	0x9e, 0xc8, 0x63, 0x5c, 0xa8, 0xf0, 0xaa, 0x35, 0x1a, 0xb8, 0xb6, 0xd8, 0x1a, 0x69, 0x82, 0x63,
+	0x6c, 0x41, 0x9d, 0x17, 0xd4, 0x85, 0x3d, 0xfe, 0xa9, 0x04, 0x57, 0x87, 0x23, 0x72, 0x93, 0xc4,
+	0x61, 0xfa, 0x13, 0x97, 0x35, 0xdc, 0x26, 0x1f, 0xbc, 0xaa, 0x78, 0x47, 0x5e, 0xbf, 0x62, 0x03,
+	0xca, 0xdf, 0x48, 0xb0, 0xca, 0x1e, 0x62, 0x78, 0xa4, 0xd0, 0x3f, 0x91, 0x58, 0xdb, 0x50, 0x6e,
+	0x51, 0x9c, 0x01, 0xa1, 0xde, 0x7d, 0x19, 0xa1, 0xc6, 0x56, 0x57, 0xe7, 0x5a, 0xd1, 0x47, 0xe5,
+	0x0a, 0xac, 0x0d, 0x41, 0xe1, 0x6c, 0xfd, 0x58, 0x02, 0x25, 0x19, 0x04, 0x1e, 0x08, 0x8b, 0x9e,
+	0x80, 0xb1, 0x6e, 0xd4, 0x87, 0xe2, 0xbc, 0x6d, 0x8e, 0xc1, 0xdb, 0xa8, 0x2d, 0x44, 0xdc, 0x4c,
+	0x30, 0xf8, 0x14, 0xae, 0x0c, 0xc5, 0xe3, 0xe6, 0xf2, 0x26, 0x54, 0x0c, 0xdd, 0x31, 0x50, 0x10,
+	0x49, 0x11, 0xdb, 0xff, 0xac, 0x3a, 0xcf, 0xc6, 0x55, 0x31, 0x1c, 0x75, 0x9f, 0x28, 0xcd, 0x53,
+	0x72, 0x9f, 0x61, 0x5b, 0x48, 0xba, 0xcf, 0x1b, 0x70, 0x75, 0x38, 0x5e, 0xd2, 0x90, 0xa3, 0x80,
+	0xff, 0xf7, 0x86, 0x9c, 0xb9, 0x7a, 0xb6, 0x21, 0xa7, 0xa1, 0x70, 0xb6, 0xfe, 0x96, 0x1a, 0x72,
+	0x92, 0x7f, 0xaa, 0xe1, 0x89, 0x18, 0xfb, 0x75, 0x28, 0xc7, 0xed, 0x65, 0x02, 0x2b, 0x1e, 0xb5,
+	0xbe, 0x3a, 0x17, 0x33, 0x39, 0x65, 0x3d, 0xdd, 0xde, 0x02, 0x24, 0xce, 0xdc, 0x3f, 0x4d, 0x41,
+	0x7d, 0xc7, 0xda, 0x77, 0x74, 0xfb, 0x24, 0xb7, 0xd0, 0x2d, 0x28, 0x63, 0x4a, 0x64, 0x80, 0xb1,
+	0x5f, 0x1e, 0x7d, 0x0d, 0x3d, 0x74, 0x6d, 0x75, 0x8e, 0x91, 0x15, 0x5b, 0xb1, 0xe0, 0x02, 0x3a,
+	0xf6, 0x91, 0x47, 0x56, 0x4a, 0x39, 0x74, 0xe5, 0x26, 0x3d, 0x74, 0x9d, 0x17, 0xd4, 0x12, 0x53,
+	0xe4, 0x88, 0x6e, 0xb4, 0x49, 0xb5, 0x39, 0x58, 0xc7, 0x75, 0xec, 0x3e, 0x4d, 0xfb, 0xb3, 0x6a,
+	0x95, 0x4e, 0x09, 0xa4, 0x27, 0x8e, 0xdd, 0x57, 0xd6, 0xe0, 0x72, 0x26, 0x2f, 0x5c, 0xd6, 0xff,
+	0x2a, 0xc1, 0x35, 0x0e, 0x63, 0xf9, 0xed, 0x13, 0x5f, 0xfd, 0xff, 0x40, 0x82, 0xf3, 0x5c, 0xea,
+	0x47, 0x96, 0xdf, 0xd6, 0xd2, 0xfa, 0x00, 0x1e, 0x8c, 0xab, 0x80, 0x51, 0x1b, 0x52, 0x97, 0x70,
+	0x1c, 0x50, 0xd8, 0xd9, 0x5d, 0xd8, 0x18, 0x4d, 0x62, 0xf8, 0x5d, 0xef, 0x3f, 0x48, 0x70, 0x59,
+	0x45, 0x1d, 0xf7, 0x10, 0x31, 0x4a, 0x2f, 0x59, 0x4c, 0x7f, 0x7d, 0x07, 0xf1, 0xf8, 0x71, 0x3a,
+	0x37, 0x70, 0x9c, 0x56, 0x14, 0x58, 0xcd, 0xde, 0x3e, 0xd7, 0xfd, 0xdf, 0x4b, 0xb0, 0xb6, 0x8b,
+	0xbc, 0x8e, 0xe5, 0xe8, 0x3e, 0x3a, 0x89, 0xd6, 0x5d, 0xa8, 0xfa, 0x82, 0xce, 0x80, 0xb2, 0xef,
+	0x8d, 0x54, 0xf6, 0xc8, 0x1d, 0xa8, 0x95, 0x80, 0xb8, 0x50, 0xf0, 0x55, 0x50, 0x86, 0xa1, 0x71,
+	0xfe, 0xfe, 0x4a, 0x82, 0x4b, 0xb4, 0x1c, 0x75, 0xc2, 0x66, 0x16, 0x8f, 0xd0, 0x98, 0xb8, 0x99,
+	0x65, 0xe8, 0xca, 0x6a, 0x89, 0x12, 0x15, 0xfc, 0xbc, 0x0b, 0xf5, 0x2c, 0xf0, 0xe1, 0x66, 0xfa,
+	0xc7, 0x39, 0x58, 0xe7, 0x44, 0x58, 0x18, 0x3d, 0x09, 0xab, 0x9d, 0x8c, 0x54, 0xb0, 0x3d, 0x06,
+	0xaf, 0x63, 0x6c, 0x61, 0x20, 0x1b, 0xc8, 0xbf, 0x14, 0x09, 0x9c, 0xbc, 0xbf, 0x25, 0x59, 0x0e,
+	0xaa, 0x09, 0x90, 0xa6, 0x80, 0x10, 0x85, 0x9c, 0x11, 0x71, 0xf7, 0xec, 0xeb, 0x8f, 0xbb, 0xf9,
+	0xac, 0xb8, 0xbb, 0x01, 0x6f, 0x8c, 0x92, 0x88, 0x48, 0x75, 0x12, 0x5c, 0x10, 0x17, 0x03, 0x29,
+	0x25, 0xbe, 0xd3, 0x0d, 0x31, 0x5f, 0x82, 0xaa, 0x85, 0xb5, 0x78, 0xe7, 0x0d, 0x55, 0xcb, 0xac,
+	0x3a, 0x6f, 0xe1, 0xed, 0x68, 0x9b, 0x0d, 0xa9, 0xfc, 0xa6, 0xf3, 0xc1, 0x19, 0xfd, 0xef, 0x29,
+	0xb8, 0xca, 0x8e, 0xaf, 0x9b, 0x44, 0x5c, 0xc1, 0x22, 0x2f, 0x73, 0xd8, 0x7c, 0x7d, 0x1c, 0xaf,
+	0x41, 0x29, 0xb4, 0xc4, 0xf0, 0x66, 0x2e, 0x18, 0x6b, 0x92, 0x5a, 0xdf, 0x82, 0x38, 0x8b, 0x9a,
+	0x27, 0x31, 0x37, 0x39, 0xa0, 0x12, 0x2e, 0xff, 0x34, 0x38, 0x45, 0xd3, 0xda, 0x23, 0x2d, 0x3f,
+	0xe4, 0x27, 0x29, 0x3f, 0xcc, 0x87, 0xe8, 0x74, 0x40, 0xb9, 0x06, 0xeb, 0x23, 0xa4, 0xce, 0xf5,
+	0xf3, 0x17, 0x12, 0xac, 0x6e, 0x21, 0x6c, 0x78, 0xd6, 0xde, 0x89, 0x52, 0xc1, 0xb7, 0x61, 0x66,
+	0xd2, 0x03, 0xf2, 0xa8, 0x65, 0x55, 0x41, 0x51, 0xf9, 0x51, 0x0e, 0xd6, 0x86, 0x40, 0xf3, 0x50,
+	0xf9, 0x1d, 0xa8, 0x84, 0x85, 0x52, 0xc3, 0x75, 0x5a, 0xd6, 0x3e, 0x7f, 0x61, 0xbe, 0x99, 0xbe,
+	0x97, 0x54, 0x05, 0x6d, 0x52, 0x44, 0x75, 0x1e, 0xc5, 0x07, 0xe4, 0x7d, 0x58, 0x4e, 0xa9, 0xc7,
+	0xd2, 0xaa, 0x2f, 0x63, 0xf8, 0xc6, 0x04, 0x8b, 0xd0, 0x7a, 0xef, 0xb9, 0xa3, 0xb4, 0x61, 0xf9,
+	0x3b, 0x20, 0x77, 0x91, 0x63, 0x5a, 0xce, 0xbe, 0xa6, 0xb3, 0xd3, 0xb2, 0x85, 0x70, 0x2d, 0x47,
+	0x2b, 0x9d, 0xd7, 0xb3, 0xd7, 0x78, 0xca, 0x70, 0xc4, 0x01, 0x9b, 0xae, 0x50, 0xed, 0xc6, 0x06,
+	0x2d, 0x84, 0xe5, 0xef, 0x42, 0x45, 0x50, 0xa7, 0xf1, 0xcb, 0xa3, 0x77, 0xec, 0xb9, 0xf8, 0x7d,
+	0x54, 0x06, 0xed, 0xb8, 0x2d, 0xd1, 0x15, 0xe6, 0xbb, 0x91, 0x29, 0x0f, 0x39, 0xca, 0x0f, 0x66,
+	0x60, 0x49, 0x5c, 0x5a, 0x21, 0x6a, 0x8b, 0x58, 0x58, 0xd1, 0x3a, 0x94, 0xb1, 0xdb, 0xf3, 0x0c,
+	0xa4, 0x19, 0x76, 0x0f, 0xfb, 0xc8, 0xe3, 0x76, 0x34, 0xc7, 0x46, 0x37, 0xd9, 0x60, 0xc2, 0xd8,
+	0xa6, 0xc6, 0x0d, 0x04, 0xb9, 0x57, 0x10, 0x08, 0xae, 0x42, 0x79, 0xe0, 0xfe, 0x9c, 0xd5, 0xd6,
+	0x4a, 0xad, 0xe8, 0xdd, 0xf9, 0x98, 0xf7, 0x0d, 0xa2, 0x94, 0xce, 0xef, 0x1b, 0xf8, 0xa3, 0xfc,
+	0x9b, 0x29, 0xf7, 0xb8, 0x33, 0x54, 0x05, 0xea, 0xd8, 0x85, 0x9d, 0x34, 0x01, 0x8f, 0x79, 0x8b,
+	0x7b, 0x07, 0x66, 0x38, 0xc5, 0xf4, 0xee, 0xcc, 0x64, 0x8c, 0x51, 0x05, 0x82, 0xfc, 0x00, 0xe6,
+	0x49, 0x57, 0x2a, 0x39, 0x91, 0x08, 0x1a, 0x85, 0x31, 0x69, 0xcc, 0x39, 0x88, 0x34, 0x74, 0xf1,
+	0x47, 0x92, 0x5a, 0x5b, 0x2e, 0xb1, 0x85, 0xbd, 0x5e, 0xab, 0x85, 0x3c, 0x26, 0x4a, 0x4c, 0x6f,
+	0x1d, 0x66, 0xd5, 0x2a, 0x9d, 0xba, 0x47, 0x67, 0x18, 0x8b, 0x59, 0xb7, 0x14, 0xc5, 0xac, 0x5b,
+	0x8a, 0x6f, 0xc0, 0x05, 0xb1, 0xd3, 0x34, 0x3c, 0xd6, 0x23, 0xb6, 0xcc, 0xf6, 0x74, 0x3f, 0x81,
+	0xbd, 0x0e, 0x65, 0x76, 0xf8, 0x13, 0x16, 0x42, 0x2f, 0x23, 0x66, 0x55, 0x76, 0x24, 0x14, 0x96,
+	0x24, 0xd7, 0xa1, 0x28, 0x16, 0x71, 0x4c, 0x83, 0x5e, 0x85, 0xcf, 0xaa, 0x05, 0x46, 0xf4, 0xb1,
+	0x69, 0x9c, 0xde, 0xdd, 0xf0, 0x79, 0x58, 0x4e, 0xd8, 0x08, 0x0f, 0xf8, 0xbf, 0x9f, 0x87, 0xf3,
+	0xc1, 0x9c, 0xaa, 0x1f, 0xc5, 0x7d, 0xf4, 0x54, 0xb3, 0xf0, 0xf7, 0xa5, 0x14, 0xcf, 0xc8, 0x4d,
+	0x78, 0xc5, 0x93, 0xc1, 0xd9, 0xe4, 0xce, 0x71, 0x76, 0x78, 0x61, 0x7c, 0x4b, 0xf7, 0xf5, 0x7b,
+	0xb6, 0xbb, 0x37, 0xd4, 0x39, 0xf2, 0x63, 0xd2, 0x48, 0x3a, 0x47, 0x9a, 0xd1, 0x4e, 0xbf, 0xa4,
+	0xb1, 0xcf, 0x0c, 0x35, 0xf6, 0xd3, 0xb3, 0xd2, 0x8b, 0xb0, 0x92, 0xa6, 0x2f, 0x6e, 0xa8, 0xbf,
+	0x93, 0x83, 0xda, 0x80, 0x11, 0x7f, 0x78, 0xeb, 0xff, 0x85, 0x9d, 0xb6, 0xe0, 0x5c, 0xbc, 0x0f,
+	0xa6, 0xaf, 0x59, 0x3e, 0xea, 0x88, 0x24, 0x7d, 0x6b, 0xa2, 0x5e, 0x98, 0x7e, 0xd3, 0x47, 0x1d,
+	0x75, 0xe1, 0x30, 0x31, 0x86, 0xe5, 0xf7, 0x60, 0x9a, 0x87, 0xc5, 0x71, 0x6d, 0x91, 0xc3, 0xcb,
+	0xdb, 0x50, 0x8e, 0x19, 0x04, 0x1e, 0xdb, 0x12, 0x4b, 0x11, 0x2b, 0xc1, 0xca, 0x85, 0x48, 0xac,
+	0x08, 0x55, 0xc0, 0x15, 0x74, 0x0c, 0x4b, 0x3b, 0x7d, 0xc7, 0xd8, 0x69, 0xeb, 0x9e, 0xc9, 0x9b,
+	0x63, 0x26, 0xcb, 0xf4, 0xe7, 0x61, 0x16, 0x13, 0xe4, 0xb0, 0x71, 0x60, 0x86, 0x3e, 0x37, 0x4d,
+	0xf9, 0x22, 0x14, 0xc2, 0x7b, 0x33, 0x76, 0x1a, 0x0f, 0x07, 0x48, 0x78, 0x4b, 0xac, 0xcc, 0x37,
+	0xf5, 0xd3, 0xb3, 0xb0, 0x40, 0xe6, 0xc4, 0x39, 0x68, 0x02, 0x83, 0xb9, 0x0c, 0xc5, 0xc0, 0x60,
+	0x82, 0x73, 0x07, 0x88, 0xa1, 0xa6, 0x19, 0x79, 0x17, 0xcf, 0x45, 0xde, 0xc5, 0xa3, 0x99, 0xfe,
+	0x6c, 0x3c, 0xd3, 0xaf, 0x41, 0x29, 0xbc, 0xbe, 0x0b, 0x8f, 0x09, 0xc1, 0x58, 0xd3, 0xa4, 0xa2,
+	0x8a, 0xdd, 0xf0, 0xf1, 0xd3, 0xc2, 0x5c, 0xec, 0x32, 0x8f, 0x54, 0x7d, 0xc4, 0x65, 0x90, 0xc5,
+	0xba, 0x12, 0x72, 0x6a, 0x81, 0x8f, 0x34, 0x4d, 0xba, 0x50, 0xe4, 0xba, 0xb1, 0x36, 0xcb, 0x17,
+	0x0a, 0x6f, 0x1a, 0x49, 0x4c, 0xa1, 0xed, 0x81, 0xe1, 0xed, 0x05, 0x85, 0x2c, 0x50, 0xc8, 0x2a,
+	0x99, 0x0a, 0xee, 0x14, 0x28, 0xfc, 0x1d, 0x98, 0x11, 0x57, 0x84, 0x30, 0xe6, 0x15, 0xa1, 0x40,
+	0x88, 0x5e, 0x68, 0x16, 0xe3, 0x5f, 0xce, 0x6c, 0x42, 0x89, 0xee, 0x42, 0x7c, 0x73, 0x52, 0x1a,
+	0xf3, 0x9b, 0x93, 0x22, 0xed, 0x5f, 0x64, 0x0f, 0xa4, 0x75, 0x8e, 0x12, 0x21, 0xaa, 0x41, 0x9e,
+	0x66, 0x99, 0xc8, 0xf1, 0x2d, 0xbf, 0x4f, 0x73, 0x74, 0x41, 0x95, 0xc9, 0xdc, 0x33, 0x3a, 0xd5,
+	0xe4, 0x33, 0xa4, 0xfb, 0x6b, 0xc0, 0x63, 0x79, 0xdf, 0x5a, 0x63, 0x32, 0x5f, 0x55, 0xcb, 0x71,
+	0x3f, 0x55, 0x96, 0x60, 0x31, 0x6e, 0x6d, 0xdc, 0x0c, 0x49, 0x73, 0x96, 0x78, 0x63, 0x39, 0xe5,
+	0x7e, 0x5c, 0xe5, 0x8f, 0x24, 0xb8, 0x98, 0xbe, 0x17, 0xfe, 0xe2, 0x74, 0x1b, 0x96, 0x3a, 0x6c,
+	0x9c, 0xf5, 0xdd, 0x69, 0x96, 0xa3, 0x19, 0xba, 0xd1, 0x46, 0x7c, 0x5b, 0x0b, 0x9d, 0x08, 0x56,
+	0xd3, 0xd9, 0x24, 0x53, 0xa4, 0x0d, 0x28, 0x81, 0x64, 0xea, 0xbe, 0xbe, 0xa7, 0x63, 0xc4, 0x7d,
+	0x67, 0x29, 0x8e, 0xb7, 0xc5, 0x67, 0x95, 0x7f, 0x94, 0x60, 0x45, 0x6c, 0x88, 0x0b, 0xf2, 0x81,
+	0x8b, 0xa3, 0xb7, 0x70, 0x6d, 0x17, 0xfb, 0x9a, 0x6e, 0x9a, 0x1e, 0xc2, 0x58, 0xc8, 0x86, 0x8c,
+	0xdd, 0x65, 0x43, 0xf2, 0x9b, 0x50, 0x15, 0x91, 0x43, 0x6b, 0xb9, 0x9e, 0x46, 0xe6, 0xe8, 0xa2,
+	0x79, 0xb5, 0xcc, 0x43, 0xc8, 0xb6, 0xeb, 0x11, 0xa2, 0xf2, 0x33, 0x90, 0xc3, 0xd7, 0xb5, 0x00,
+	0x76, 0xe2, 0x77, 0x85, 0xf0, 0xd5, 0x92, 0x13, 0x56, 0xfe, 0x64, 0x2a, 0x54, 0x71, 0x8c, 0x0b,
+	0x2e, 0xd5, 0x0d, 0xa8, 0x38, 0xbd, 0xce, 0x1e, 0xf2, 0xc8, 0x75, 0x3d, 0xdd, 0x14, 0x63, 0x25,
+	0xaf, 0x96, 0xd9, 0xf8, 0x93, 0x16, 0x0d, 0x60, 0x58, 0xbe, 0x00, 0x05, 0xc1, 0x0d, 0xae, 0x4d,
+	0xad, 0xe6, 0x36, 0xf2, 0xea, 0x2c, 0xe7, 0x82, 0x34, 0xc2, 0xcf, 0x87, 0x96, 0xc2, 0xb4, 0x32,
+	0xec, 0x4b, 0xb7, 0x00, 0x96, 0xb0, 0x11, 0xdc, 0x98, 0x53, 0x95, 0xd1, 0xf4, 0x5b, 0x76, 0x62,
+	0x63, 0xf2, 0x3b, 0xb0, 0xcc, 0xd6, 0x36, 0x5c, 0xc7, 0xf7, 0x5c, 0xdb, 0x46, 0x9e, 0xe8, 0x8f,
+	0x3c, 0x4b, 0xe5, 0x7e, 0x8e, 0x4e, 0x6f, 0x06, 0xb3, 0xbc, 0x3f, 0x9f, 0xb8, 0x38, 0xd7, 0x0f,
+	0x6b, 0xe9, 0x10, 0x8f, 0x4a, 0x03, 0xaa, 0x9b, 0xb6, 0x8b, 0x11, 0x65, 0x4e, 0xe8, 0x34, 0x1a,
+	0xea, 0x99, 0x10, 0x44, 0xa8, 0x57, 0x16, 0x41, 0x8e, 0xc2, 0x73, 0x07, 0xfa, 0x89, 0x04, 0x55,
+	0x56, 0xc8, 0x8e, 0x96, 0xc5, 0xb2, 0xc9, 0xc8, 0xdb, 0x30, 0x4b, 0xb2, 0xd4, 0x3e, 0xf1, 0xed,
+	0x29, 0xda, 0xd9, 0xf9, 0xa5, 0xe1, 0x7d, 0xa3, 0xec, 0x0a, 0x8a, 0x61, 0xa8, 0x01, 0x6e, 0xb4,
+	0x8f, 0x25, 0x17, 0xeb, 0x63, 0xb9, 0x09, 0x8b, 0x87, 0x16, 0xb6, 0xf6, 0x2c, 0xdb, 0xf2, 0xfb,
+	0x91, 0xae, 0x0e, 0x16, 0xf3, 0x17, 0xc2, 0xb9, 0xa0, 0xfb, 0x82, 0xb0, 0x16, 0xe5, 0x81, 0xb3,
+	0xf6, 0x43, 0x09, 0x2e, 0xbd, 0x8f, 0xfc, 0xc8, 0xc1, 0xe8, 0x11, 0xfb, 0xfe, 0x33, 0xc8, 0x9f,
+	0x0f, 0x61, 0x9a, 0xf6, 0x56, 0x11, 0x83, 0xc9, 0x65, 0xaa, 0x3a, 0xfb, 0xa0, 0x45, 0xbb, 0xb0,
+	0x54, 0x4e, 0x83, 0xf8, 0x13, 0x4f, 0xc3, 0xb4, 0xb7, 0x42, 0xbc, 0x50, 0xf3, 0x31, 0x62, 0x23,
+	0xca, 0x5f, 0x4e, 0x41, 0x3d, 0x6b, 0x4b, 0xdc, 0x9c, 0x7f, 0x4f, 0x82, 0x2a, 0xff, 0x4e, 0x15,
+	0x6b, 0x7b, 0x7d, 0x66, 0xd1, 0x7c, 0x7f, 0xcf, 0xc7, 0xff, 0x90, 0x65, 0xc8, 0x12, 0x0d, 0x31,
+	0x70, 0xaf, 0x4f, 0x6d, 0x81, 0x1f, 0xd1, 0x3b, 0xf1, 0xd1, 0x95, 0xdf, 0x80, 0xc5, 0x34, 0xc0,
+	0xe8, 0x71, 0x35, 0xcf, 0x8e, 0xab, 0x8f, 0xe2, 0xc7, 0xd5, 0x77, 0x27, 0x94, 0x62, 0xb0, 0xbf,
+	0xc8, 0x91, 0xf5, 0x63, 0x58, 0x7d, 0x1f, 0xf9, 0x5b, 0x0f, 0x3f, 0x18, 0xa2, 0xbd, 0x0f, 0x79,
+	0x67, 0x3d, 0x79, 0x7d, 0x11, 0x1a, 0x9c, 0x74, 0x6d, 0x62, 0x30, 0xd4, 0x5f, 0x0b, 0x3e, 0xff,
+	0x85, 0x95, 0xdf, 0x95, 0x60, 0x6d, 0xc8, 0xe2, 0x5c, 0x4f, 0xdf, 0x83, 0x6a, 0xf4, 0x1d, 0x8a,
+	0xa0, 0x8b, 0x4d, 0xdc, 0x7e, 0x89, 0x4d, 0xa8, 0x15, 0x2f, 0x3e, 0x80, 0x95, 0x3f, 0x94, 0x60,
+	0x91, 0x76, 0xb0, 0x05, 0xdf, 0x69, 0x8d, 0x9f, 0xd4, 0x9e, 0x0c, 0x96, 0x09, 0xbf, 0x3a, 0xb2,
+	0x4c, 0x98, 0xb6, 0x54, 0x58, 0x1a, 0x3c, 0x80, 0x73, 0x03, 0x00, 0x5c, 0x0e, 0x2a, 0xcc, 0x0e,
+	0xb4, 0xcd, 0xbc, 0x33, 0xe9, 0x52, 0x0c, 0x5b, 0x0d, 0xe8, 0x90, 0x4c, 0xba, 0xa8, 0x22, 0xbd,
+	0xdb, 0xb5, 0xfb, 0x13, 0xbf, 0x36, 0xef, 0x0c, 0x72, 0xfe, 0xb5, 0x54, 0x6d, 0x44, 0xbf, 0xd7,
+	0x66, 0xea, 0x48, 0x2e, 0x17, 0x72, 0xbf, 0x0c, 0xe7, 0x06, 0x00, 0xf8, 0x4e, 0xff, 0x7a, 0x8a,
+	0x94, 0xe1, 0x74, 0x73, 0xeb, 0xe1, 0x07, 0x83, 0xe6, 0x79, 0x1f, 0xce, 0x06, 0x1d, 0xc0, 0xe5,
+	0x68, 0x69, 0x34, 0x2d, 0x48, 0x6e, 0x21, 0xdd, 0x7c, 0x88, 0x7c, 0x1f, 0x79, 0xb4, 0x59, 0x94,
+	0xb6, 0x6e, 0x51, 0xf4, 0xc4, 0xe1, 0x3d, 0x12, 0x8a, 0x93, 0xc7, 0xff, 0x5c, 0xda, 0xf1, 0xff,
+	0x5d, 0xa8, 0x59, 0x0e, 0x81, 0xb0, 0x0e, 0x91, 0x86, 0x1c, 0x53, 0xe3, 0x9e, 0x1e, 0x56, 0xdd,
+	0xce, 0x05, 0xf3, 0xf7, 0x1d, 0x93, 0xf3, 0xd1, 0x34, 0xc9, 0xfd, 0x44, 0x47, 0x3f, 0xb6, 0x3a,
+	0xbd, 0x8e, 0xd6, 0x25, 0xf0, 0xd8, 0xfa, 0x18, 0xd1, 0x2c, 0x94, 0x57, 0xe7, 0xf9, 0xc4, 0x53,
+	0x7d, 0x1f, 0xed, 0x58, 0x1f, 0x23, 0xf9, 0x0d, 0xf2, 0x52, 0x7e, 0xec, 0x33, 0x40, 0xd6, 0xb0,
+	0x3a, 0x4d, 0x1b, 0x56, 0x69, 0x05, 0x8f, 0x80, 0xb1, 0xaf, 0x89, 0xfe, 0x53, 0x82, 0xe5, 0x84,
+	0xc0, 0xb8, 0x29, 0xbd, 0x22, 0x89, 0xa5, 0x7a, 0xe6, 0xd4, 0x2b, 0xf4, 0xcc, 0x34, 0x66, 0x73,
+	0x69, 0xcc, 0xfe, 0x1b, 0xf9, 0x52, 0xac, 0xe7, 0xed, 0xa3, 0x9f, 0x47, 0xf3, 0x50, 0x56, 0xa0,
+	0x96, 0x64, 0x4e, 0xb4, 0x05, 0x4d, 0xc1, 0xf2, 0x23, 0xf4, 0x73, 0xca, 0xf9, 0x6b, 0x71, 0x8c,
+	0x7b, 0x50, 0x7b, 0x84, 0xd2, 0xa5, 0x99, 0x46, 0x43, 0x4a, 0xa3, 0xf1, 0x67, 0xf4, 0x53, 0x95,
+	0x96, 0x87, 0x70, 0x3b, 0x68, 0x02, 0x25, 0x06, 0x3b, 0x41, 0xf8, 0x7c, 0x3e, 0x18, 0x3e, 0x7f,
+	0x65, 0xcc, 0xf0, 0x99, 0xb9, 0x6a, 0x18, 0x45, 0xe9, 0xd7, 0x2b, 0x69, 0x70, 0xdc, 0x68, 0x9e,
+	0xc1, 0xc2, 0x03, 0xdd, 0x31, 0xc9, 0x5f, 0x09, 0x8c, 0x77, 0xa6, 0x25, 0x8a, 0x0e, 0xbe, 0x91,
+	0x70, 0x8f, 0x1c, 0xe4, 0xf1, 0x43, 0xd7, 0x9c, 0x18, 0x7d, 0x42, 0x06, 0xc9, 0xdb, 0x63, 0x9c,
+	0x30, 0x5f, 0xf0, 0xcf, 0x25, 0xa8, 0x6f, 0x21, 0x1b, 0x9d, 0xac, 0x3b, 0xe3, 0xb5, 0x15, 0xc0,
+	0x48, 0x7f, 0x51, 0xe6, 0xf6, 0x18, 0x0b, 0xf7, 0xba, 0x9f, 0x7c, 0x56, 0x3f, 0xf3, 0xe9, 0x67,
+	0xf5, 0x33, 0x3f, 0xfb, 0xac, 0x2e, 0x7d, 0xff, 0x45, 0x5d, 0xfa, 0xd1, 0x8b, 0xba, 0xf4, 0xcf,
+	0x2f, 0xea, 0xd2, 0x27, 0x2f, 0xea, 0xd2, 0xbf, 0xbf, 0xa8, 0x4b, 0xff, 0xf1, 0xa2, 0x7e, 0xe6,
+	0x67, 0x2f, 0xea, 0xd2, 0x0f, 0x3f, 0xaf, 0x9f, 0xf9, 0xe4, 0xf3, 0xfa, 0x99, 0x4f, 0x3f, 0xaf,
+	0x9f, 0x79, 0x7e, 0x67, 0xdf, 0x0d, 0x37, 0x66, 0xb9, 0x43, 0xff, 0xb5, 0xe7, 0xeb, 0xf1, 0x91,
+	0xbd, 0x69, 0xfa, 0x4f, 0x26, 0xb7, 0xff, 0x77, 0x00, 0xdb, 0xb7, 0xda, 0x6e, 0xf4, 0x47, 0x00,
+	0x00,
 }
 
 func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
@@ -5075,6 +5263,9 @@ func (this *GetMutableStateResponse) Equal(that interface{}) bool {
 	if this.IsStickyTaskQueueEnabled != that1.IsStickyTaskQueueEnabled {
 		return false
 	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
 	return true
 }
 func (this *PollMutableStateRequest) Equal(that interface{}) bool {
@@ -7239,6 +7430,102 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HandoffShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandoffShardRequest)
+	if !ok {
+		that2, ok := that.(HandoffShardRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.PreviousOwner != that1.PreviousOwner {
+		return false
+	}
+	return true
+}
+func (this *HandoffShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HandoffShardResponse)
+	if !ok {
+		that2, ok := that.(HandoffShardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	return true
+}
+func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *StartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -7294,7 +7581,7 @@ func (this *GetMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 23)
+	s := make([]string, 0, 24)
 	s = append(s, "&historyservice.GetMutableStateResponse{")
 	if this.Execution != nil {
 		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
@@ -7337,6 +7624,7 @@ func (this *GetMutableStateResponse) GoString() string {
 		s = append(s, "VersionHistories: "+fmt.Sprintf("%#v", this.VersionHistories)+",\n")
 	}
 	s = append(s, "IsStickyTaskQueueEnabled: "+fmt.Sprintf("%#v", this.IsStickyTaskQueueEnabled)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8373,6 +8661,48 @@ func (this *RefreshWorkflowTasksResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *HandoffShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.HandoffShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "PreviousOwner: "+fmt.Sprintf("%#v", this.PreviousOwner)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HandoffShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.HandoffShardResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.DeleteWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.DeleteWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringRequestResponse(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -8583,6 +8913,15 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.IsStickyTaskQueueEnabled {
 		i--
 		if m.IsStickyTaskQueueEnabled {
@@ -12136,22 +12475,145 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HandoffShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+
+func (m *HandoffShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandoffShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandoffShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandoffShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandoffShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.NamespaceId)
 	if l > 0 {
@@ -12307,6 +12769,10 @@ func (m *GetMutableStateResponse) Size() (n int) {
 	if m.IsStickyTaskQueueEnabled {
 		n += 3
 	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -13733,6 +14199,57 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
+func (m *HandoffShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *HandoffShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeleteWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovRequestResponse(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -13814,6 +14331,7 @@ func (this *GetMutableStateResponse) String() string {
 		`WorkflowStatus:` + fmt.Sprintf("%v", this.WorkflowStatus) + `,`,
 		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v18.VersionHistories", 1) + `,`,
 		`IsStickyTaskQueueEnabled:` + fmt.Sprintf("%v", this.IsStickyTaskQueueEnabled) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14746,6 +15264,46 @@ func (this *RefreshWorkflowTasksResponse) String() string {
 	}, "")
 	return s
 }
+func (this *HandoffShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HandoffShardRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`PreviousOwner:` + fmt.Sprintf("%v", this.PreviousOwner) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HandoffShardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HandoffShardResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRequestResponse(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -15959,6 +16517,38 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsStickyTaskQueueEnabled = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -25951,6 +26541,337 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HandoffShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandoffShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandoffShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandoffShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandoffShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandoffShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRequestResponse(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
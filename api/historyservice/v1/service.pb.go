@@ -30,75 +30,77 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1075 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0x87, 0x53, 0x17, 0x0f, 0x85, 0x9f, 0xad, 0xf8, 0xb1, 0x6a, 0x23, 0x82, 0xd7, 0x84, 0x99,
-	0xbd, 0xec, 0xee, 0xac, 0xee, 0xce, 0x24, 0x33, 0x99, 0xd9, 0x9d, 0x51, 0x27, 0x59, 0x56, 0xf0,
-	0x22, 0x35, 0x9d, 0x77, 0x27, 0xc5, 0xf4, 0x74, 0xb5, 0xdd, 0xd5, 0x59, 0x73, 0x13, 0x3c, 0x09,
-	0x1e, 0x44, 0x10, 0x04, 0x41, 0xf0, 0xa4, 0x08, 0x82, 0x20, 0x08, 0x82, 0x20, 0x08, 0x82, 0xc7,
-	0x39, 0xee, 0xd1, 0xc9, 0x5c, 0x3c, 0xee, 0x9f, 0x20, 0x49, 0xa7, 0x6a, 0x52, 0xdd, 0xd5, 0xa1,
-	0xaa, 0x3a, 0xb7, 0x99, 0xa4, 0x7e, 0x4f, 0x3f, 0xdd, 0x55, 0x95, 0xf7, 0x4d, 0x05, 0x5f, 0xe5,
-	0x70, 0x1a, 0xb3, 0x84, 0x84, 0xad, 0x14, 0x92, 0x11, 0x24, 0x2d, 0x12, 0xd3, 0xd6, 0x90, 0xa6,
-	0x9c, 0x25, 0xe3, 0xe9, 0x2b, 0x34, 0x80, 0xd6, 0x68, 0xad, 0x35, 0xff, 0xb3, 0x19, 0x27, 0x8c,
-	0x33, 0xef, 0x2d, 0x11, 0x6a, 0xe6, 0xa1, 0x26, 0x89, 0x69, 0x53, 0x0d, 0x35, 0x47, 0x6b, 0x57,
-	0x6e, 0x9a, 0xb1, 0x13, 0xf8, 0x38, 0x83, 0x94, 0x7f, 0x94, 0x40, 0x1a, 0xb3, 0x28, 0x9d, 0x5f,
-	0x64, 0xfd, 0xaf, 0x35, 0xfc, 0xf4, 0x6e, 0x3e, 0xb8, 0x9f, 0x0f, 0xf6, 0x7e, 0x40, 0xf8, 0xc5,
-	0x3e, 0x27, 0x09, 0xff, 0x80, 0x25, 0x27, 0x0f, 0x42, 0xf6, 0x70, 0xfb, 0x13, 0x08, 0x32, 0x4e,
-	0x59, 0xe4, 0x75, 0x9a, 0x46, 0x4e, 0x4d, 0x7d, 0xbc, 0x97, 0x2b, 0x5c, 0xd9, 0xae, 0x49, 0xc9,
-	0x6f, 0xe0, 0xcd, 0x86, 0xf7, 0x15, 0xc2, 0xcf, 0x74, 0x81, 0x1f, 0x64, 0x9c, 0x1c, 0x85, 0xd0,
-	0xe7, 0x84, 0x83, 0xf7, 0xb6, 0x21, 0xbc, 0x90, 0x13, 0x6e, 0xef, 0xb8, 0xc6, 0xa5, 0xd4, 0xd7,
-	0x08, 0x3f, 0xfb, 0x3e, 0x0b, 0x43, 0xc5, 0xca, 0x14, 0x5b, 0x0c, 0x0a, 0xad, 0x5b, 0xce, 0x79,
-	0xe9, 0xf5, 0x3d, 0xc2, 0x2f, 0xf4, 0x20, 0x05, 0xde, 0xe7, 0x34, 0x38, 0x19, 0xdf, 0x23, 0xe9,
-	0xc9, 0x61, 0x06, 0x19, 0x78, 0x5b, 0x86, 0x6c, 0x5d, 0x58, 0xf8, 0xb5, 0x6b, 0x31, 0xa4, 0xe3,
-	0x2f, 0x08, 0xbf, 0xd2, 0x83, 0x80, 0x25, 0x83, 0x0e, 0x04, 0x34, 0xa5, 0x2c, 0x9a, 0x8e, 0x9a,
-	0xad, 0x03, 0x18, 0x78, 0x5d, 0xe3, 0x8b, 0x54, 0x10, 0x84, 0xed, 0x6e, 0x7d, 0x90, 0x46, 0x79,
-	0x33, 0xe0, 0x74, 0x44, 0xf9, 0xd8, 0x5d, 0x59, 0x43, 0x70, 0x53, 0xd6, 0x82, 0xa4, 0xf2, 0xef,
-	0x08, 0xbf, 0x96, 0xff, 0xab, 0xdc, 0x5b, 0x9b, 0x9d, 0xc6, 0x21, 0x4c, 0xad, 0xef, 0x98, 0xcf,
-	0x66, 0x25, 0x44, 0x88, 0xdf, 0x5d, 0x09, 0xab, 0xf0, 0xb8, 0x4b, 0x43, 0x77, 0x08, 0x0d, 0xad,
-	0x1e, 0x77, 0x05, 0xc1, 0xfe, 0x71, 0x57, 0x82, 0xa4, 0xf2, 0x6f, 0x08, 0xbf, 0x5a, 0x9e, 0x96,
-	0x5d, 0x20, 0x09, 0x3f, 0x02, 0xc2, 0xbd, 0x3d, 0xe7, 0xa9, 0x95, 0x0c, 0xa1, 0x7d, 0x67, 0x15,
-	0x28, 0xdd, 0x3a, 0x59, 0x1c, 0xea, 0xbc, 0x4e, 0xb4, 0x10, 0xc7, 0x75, 0x52, 0xc1, 0xd2, 0xad,
-	0x93, 0xc5, 0xa1, 0x6e, 0xeb, 0xa4, 0x4c, 0x70, 0x5c, 0x27, 0x3a, 0x50, 0x61, 0x9d, 0x94, 0xef,
-	0x8e, 0x44, 0x01, 0x4c, 0xa5, 0xf7, 0x6a, 0x3c, 0xa1, 0x39, 0xc3, 0x7e, 0x9d, 0x2c, 0x41, 0x49,
-	0xf1, 0x9f, 0x10, 0x7e, 0xa9, 0x4f, 0x8f, 0x23, 0x12, 0x96, 0x3b, 0x06, 0xe3, 0x5a, 0xaf, 0xcf,
-	0x0b, 0xe1, 0x9d, 0xba, 0x18, 0x29, 0xfb, 0x37, 0xc2, 0x6f, 0xcc, 0x47, 0x51, 0x3e, 0xac, 0xe8,
-	0x73, 0xde, 0xb5, 0xbb, 0x5c, 0x25, 0x48, 0xe8, 0xbf, 0xb7, 0x32, 0x9e, 0xbc, 0x8f, 0x9f, 0x11,
-	0x7e, 0xb9, 0x07, 0xa7, 0x6c, 0x04, 0x79, 0x48, 0x69, 0x37, 0x76, 0x8c, 0xe7, 0x57, 0x0f, 0x10,
-	0xde, 0xdd, 0xda, 0x1c, 0xe9, 0xfb, 0x2b, 0xc2, 0x57, 0xee, 0x41, 0x72, 0x4a, 0x23, 0xc2, 0xa1,
-	0xfc, 0xc4, 0x4d, 0x37, 0x52, 0x35, 0x42, 0x38, 0xef, 0xad, 0x80, 0x24, 0xad, 0xa7, 0xbd, 0xf0,
-	0xac, 0x67, 0x71, 0xef, 0x85, 0xf5, 0x71, 0xdb, 0x5e, 0xb8, 0x8a, 0x22, 0x4d, 0xff, 0x44, 0xd8,
-	0x9f, 0x43, 0xf3, 0x2d, 0x5a, 0x36, 0xde, 0x37, 0xbe, 0xd6, 0x32, 0x8c, 0x30, 0x3f, 0x58, 0x11,
-	0x4d, 0x69, 0x50, 0xfb, 0xc1, 0x10, 0x06, 0x59, 0x08, 0x8b, 0x05, 0xd5, 0xb8, 0x41, 0xd5, 0x85,
-	0x6d, 0x1b, 0x54, 0x3d, 0x43, 0x3a, 0xfe, 0x81, 0xf0, 0xeb, 0x79, 0xf1, 0x6c, 0x0f, 0x69, 0x38,
-	0x90, 0xb7, 0x71, 0x59, 0x13, 0xef, 0x5a, 0x95, 0xe0, 0x0a, 0x8a, 0xb0, 0xde, 0x5f, 0x0d, 0x4c,
-	0xa9, 0x8a, 0x1d, 0x48, 0x83, 0x84, 0x1e, 0x69, 0xf6, 0xa0, 0xe9, 0x6e, 0xaf, 0x24, 0xd8, 0x56,
-	0xc5, 0x25, 0x20, 0xe5, 0x3b, 0x5e, 0x0f, 0xe2, 0x90, 0x06, 0x84, 0xc3, 0xf6, 0x08, 0x22, 0x9e,
-	0x1a, 0x7f, 0xc7, 0x2b, 0xe4, 0x6c, 0xbf, 0xe3, 0x95, 0xe2, 0x52, 0xea, 0x5b, 0x84, 0x3d, 0xf9,
-	0x6e, 0x8f, 0x3c, 0x9c, 0x7b, 0xdd, 0xb6, 0x05, 0xcb, 0xa8, 0x50, 0xdb, 0xac, 0x41, 0x90, 0x76,
-	0xdf, 0x20, 0xfc, 0x5c, 0xc1, 0xfd, 0xfe, 0xba, 0x77, 0xcb, 0xed, 0xae, 0xef, 0xaf, 0x0b, 0xb7,
-	0xdb, 0xee, 0x00, 0x65, 0x36, 0xfb, 0xe3, 0x28, 0xe8, 0x0f, 0x49, 0x32, 0x98, 0x96, 0x88, 0xcc,
-	0x7c, 0x36, 0x0b, 0x39, 0xdb, 0xd9, 0x2c, 0xc5, 0xa5, 0xd4, 0xe7, 0x08, 0x3f, 0x39, 0x7d, 0x57,
-	0xb4, 0x39, 0xde, 0x0d, 0x0b, 0xa4, 0x08, 0x09, 0x9d, 0x0d, 0xa7, 0xac, 0xf2, 0x21, 0x28, 0xb6,
-	0x85, 0x52, 0xd2, 0xb7, 0x2c, 0xf7, 0x94, 0xae, 0x9c, 0xb7, 0x6b, 0x31, 0xa4, 0xe3, 0x77, 0x08,
-	0x3f, 0x2f, 0x86, 0xcc, 0xcf, 0x8e, 0x76, 0x59, 0xca, 0xbd, 0x4d, 0x4b, 0xfc, 0x42, 0x56, 0x18,
-	0x6e, 0xd5, 0x41, 0x48, 0xc1, 0xcf, 0x10, 0xc6, 0xed, 0x90, 0xa5, 0x30, 0x9b, 0x6f, 0xef, 0x9a,
-	0x21, 0xf4, 0x32, 0x22, 0x74, 0xae, 0x3b, 0x24, 0x15, 0x8b, 0xbc, 0x31, 0x9a, 0x55, 0xb1, 0x6b,
-	0x56, 0xbd, 0xd4, 0x62, 0xed, 0xba, 0xee, 0x90, 0x54, 0x3a, 0x98, 0x2e, 0x70, 0xb1, 0x29, 0x29,
-	0x8b, 0x0e, 0x20, 0x4d, 0xc9, 0x31, 0xa4, 0xc6, 0x1d, 0x8c, 0x3e, 0x6e, 0xdb, 0xc1, 0x54, 0x51,
-	0x94, 0xe2, 0xd4, 0x05, 0xde, 0xd9, 0x3f, 0xd4, 0xc9, 0x76, 0xcd, 0x2f, 0xa3, 0x27, 0xd8, 0x16,
-	0xa7, 0x25, 0x20, 0xa9, 0xfc, 0x05, 0xc2, 0x4f, 0x1d, 0x66, 0x90, 0x8c, 0x45, 0x05, 0xf3, 0x4c,
-	0xb7, 0xbf, 0x92, 0x12, 0x6a, 0x37, 0xdd, 0xc2, 0x8a, 0x4e, 0x0f, 0x48, 0x1c, 0x87, 0xe3, 0x79,
-	0x45, 0xda, 0x30, 0x5e, 0x3a, 0x0b, 0x29, 0x5b, 0x9d, 0x42, 0xb8, 0x50, 0xba, 0xc9, 0xa0, 0xb3,
-	0x7f, 0x28, 0xa7, 0xd1, 0xbc, 0x74, 0x2b, 0x39, 0xfb, 0xd2, 0x5d, 0x88, 0xab, 0xc7, 0xb3, 0x59,
-	0x72, 0x0c, 0x8b, 0x56, 0xc6, 0xc7, 0xb3, 0x85, 0xa0, 0xf5, 0xf1, 0x6c, 0x29, 0xaf, 0x78, 0x1d,
-	0x80, 0xa3, 0x57, 0x31, 0x68, 0xeb, 0x55, 0xce, 0x17, 0x8e, 0x8d, 0x1f, 0x24, 0x90, 0x0e, 0xc5,
-	0x8a, 0x9b, 0x7e, 0xc2, 0xa4, 0x16, 0xc7, 0xc6, 0xe5, 0xb0, 0xfd, 0xb1, 0xb1, 0x8e, 0x21, 0x1c,
-	0xb7, 0xe2, 0xb3, 0x73, 0xbf, 0xf1, 0xe8, 0xdc, 0x6f, 0x3c, 0x3e, 0xf7, 0xd1, 0xa7, 0x13, 0x1f,
-	0xfd, 0x38, 0xf1, 0xd1, 0x3f, 0x13, 0x1f, 0x9d, 0x4d, 0x7c, 0xf4, 0xef, 0xc4, 0x47, 0xff, 0x4d,
-	0xfc, 0xc6, 0xe3, 0x89, 0x8f, 0xbe, 0xbc, 0xf0, 0x1b, 0x67, 0x17, 0x7e, 0xe3, 0xd1, 0x85, 0xdf,
-	0xf8, 0xf0, 0xc6, 0x31, 0xbb, 0xbc, 0x3c, 0x65, 0x4b, 0x7f, 0x3e, 0xd9, 0x50, 0x5f, 0x39, 0x7a,
-	0x62, 0xf6, 0xeb, 0xc9, 0xd5, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x22, 0xf9, 0x48, 0x65, 0xd9,
-	0x19, 0x00, 0x00,
+	// 1111 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0x4d, 0x88, 0x23, 0x45,
+	0x14, 0xc7, 0x53, 0x17, 0x0f, 0x85, 0x9f, 0xad, 0xf8, 0x31, 0x6a, 0x23, 0x82, 0xd7, 0x84, 0x9d,
+	0xb9, 0xec, 0xee, 0xac, 0xee, 0xce, 0x24, 0x33, 0xc9, 0xec, 0x4e, 0xd4, 0x49, 0x96, 0x15, 0xbc,
+	0x48, 0x4d, 0xe7, 0xcd, 0xa4, 0x98, 0x4e, 0x57, 0xdb, 0x5d, 0x9d, 0x35, 0x37, 0xc1, 0x93, 0xe0,
+	0x41, 0x04, 0x41, 0x10, 0x04, 0x4f, 0x8a, 0x20, 0x08, 0x82, 0x20, 0x08, 0x9e, 0x04, 0x8f, 0x73,
+	0xdc, 0xa3, 0x93, 0xb9, 0x78, 0xdc, 0xbb, 0x17, 0x49, 0x3a, 0x55, 0x93, 0xea, 0xae, 0x0e, 0x55,
+	0xdd, 0xb9, 0xed, 0x4e, 0xea, 0xff, 0xeb, 0x5f, 0x77, 0x55, 0xe5, 0xbd, 0xae, 0xe0, 0x2d, 0x0e,
+	0xa3, 0x90, 0x45, 0xc4, 0x6f, 0xc4, 0x10, 0x8d, 0x21, 0x6a, 0x90, 0x90, 0x36, 0x86, 0x34, 0xe6,
+	0x2c, 0x9a, 0xcc, 0xfe, 0x42, 0x3d, 0x68, 0x8c, 0xaf, 0x35, 0x16, 0xff, 0xac, 0x87, 0x11, 0xe3,
+	0xcc, 0x79, 0x4b, 0x84, 0xea, 0x69, 0xa8, 0x4e, 0x42, 0x5a, 0x57, 0x43, 0xf5, 0xf1, 0xb5, 0x8d,
+	0x5b, 0x66, 0xec, 0x08, 0x3e, 0x4e, 0x20, 0xe6, 0x1f, 0x45, 0x10, 0x87, 0x2c, 0x88, 0x17, 0x17,
+	0xd9, 0xfc, 0x6f, 0x0b, 0x3f, 0xdd, 0x49, 0x07, 0xf7, 0xd3, 0xc1, 0xce, 0x0f, 0x08, 0xbf, 0xd8,
+	0xe7, 0x24, 0xe2, 0x1f, 0xb0, 0xe8, 0xec, 0xc4, 0x67, 0x0f, 0xf7, 0x3e, 0x01, 0x2f, 0xe1, 0x94,
+	0x05, 0x4e, 0xab, 0x6e, 0xe4, 0x54, 0xd7, 0xc7, 0x7b, 0xa9, 0xc2, 0xc6, 0x5e, 0x45, 0x4a, 0x7a,
+	0x03, 0x6f, 0xd6, 0x9c, 0xaf, 0x10, 0x7e, 0xa6, 0x0d, 0xbc, 0x9b, 0x70, 0x72, 0xec, 0x43, 0x9f,
+	0x13, 0x0e, 0xce, 0xdb, 0x86, 0xf0, 0x4c, 0x4e, 0xb8, 0xbd, 0x53, 0x36, 0x2e, 0xa5, 0xbe, 0x46,
+	0xf8, 0xd9, 0xf7, 0x99, 0xef, 0x2b, 0x56, 0xa6, 0xd8, 0x6c, 0x50, 0x68, 0xdd, 0x2e, 0x9d, 0x97,
+	0x5e, 0xdf, 0x23, 0xfc, 0x42, 0x0f, 0x62, 0xe0, 0x7d, 0x4e, 0xbd, 0xb3, 0xc9, 0x7d, 0x12, 0x9f,
+	0x1d, 0x25, 0x90, 0x80, 0xb3, 0x6b, 0xc8, 0xd6, 0x85, 0x85, 0x5f, 0xb3, 0x12, 0x43, 0x3a, 0xfe,
+	0x82, 0xf0, 0x2b, 0x3d, 0xf0, 0x58, 0x34, 0x68, 0x81, 0x47, 0x63, 0xca, 0x82, 0xd9, 0xa8, 0xf9,
+	0x3a, 0x80, 0x81, 0xd3, 0x36, 0xbe, 0x48, 0x01, 0x41, 0xd8, 0x76, 0xaa, 0x83, 0x34, 0xca, 0x3b,
+	0x1e, 0xa7, 0x63, 0xca, 0x27, 0xe5, 0x95, 0x35, 0x84, 0x72, 0xca, 0x5a, 0x90, 0x54, 0xfe, 0x1d,
+	0xe1, 0xd7, 0xd2, 0xff, 0x2a, 0xf7, 0xd6, 0x64, 0xa3, 0xd0, 0x87, 0x99, 0xf5, 0x5d, 0xf3, 0xd9,
+	0x2c, 0x84, 0x08, 0xf1, 0x7b, 0x6b, 0x61, 0x65, 0x1e, 0x77, 0x6e, 0xe8, 0x3e, 0xa1, 0xbe, 0xd5,
+	0xe3, 0x2e, 0x20, 0xd8, 0x3f, 0xee, 0x42, 0x90, 0x54, 0xfe, 0x0d, 0xe1, 0x57, 0xf3, 0xd3, 0xd2,
+	0x01, 0x12, 0xf1, 0x63, 0x20, 0xdc, 0x39, 0x28, 0x3d, 0xb5, 0x92, 0x21, 0xb4, 0xef, 0xae, 0x03,
+	0xa5, 0x5b, 0x27, 0xcb, 0x43, 0x4b, 0xaf, 0x13, 0x2d, 0xa4, 0xe4, 0x3a, 0x29, 0x60, 0xe9, 0xd6,
+	0xc9, 0xf2, 0xd0, 0x72, 0xeb, 0x24, 0x4f, 0x28, 0xb9, 0x4e, 0x74, 0xa0, 0xcc, 0x3a, 0xc9, 0xdf,
+	0x1d, 0x09, 0x3c, 0x98, 0x49, 0x1f, 0x54, 0x78, 0x42, 0x0b, 0x86, 0xfd, 0x3a, 0x59, 0x81, 0x92,
+	0xe2, 0x3f, 0x21, 0xfc, 0x52, 0x9f, 0x9e, 0x06, 0xc4, 0xcf, 0x77, 0x0c, 0xc6, 0xb5, 0x5e, 0x9f,
+	0x17, 0xc2, 0xfb, 0x55, 0x31, 0x52, 0xf6, 0x2f, 0x84, 0xdf, 0x58, 0x8c, 0xa2, 0x7c, 0x58, 0xd0,
+	0xe7, 0xbc, 0x6b, 0x77, 0xb9, 0x42, 0x90, 0xd0, 0x7f, 0x6f, 0x6d, 0x3c, 0x79, 0x1f, 0x3f, 0x23,
+	0xfc, 0x72, 0x0f, 0x46, 0x6c, 0x0c, 0x69, 0x48, 0x69, 0x37, 0xf6, 0x8d, 0xe7, 0x57, 0x0f, 0x10,
+	0xde, 0xed, 0xca, 0x1c, 0xe9, 0xfb, 0x2b, 0xc2, 0x1b, 0xf7, 0x21, 0x1a, 0xd1, 0x80, 0x70, 0xc8,
+	0x3f, 0x71, 0xd3, 0x8d, 0x54, 0x8c, 0x10, 0xce, 0x07, 0x6b, 0x20, 0x49, 0xeb, 0x59, 0x2f, 0x3c,
+	0xef, 0x59, 0xca, 0xf7, 0xc2, 0xfa, 0xb8, 0x6d, 0x2f, 0x5c, 0x44, 0x91, 0xa6, 0x7f, 0x22, 0xec,
+	0x2e, 0xa0, 0xe9, 0x16, 0xcd, 0x1b, 0x1f, 0x1a, 0x5f, 0x6b, 0x15, 0x46, 0x98, 0x77, 0xd7, 0x44,
+	0x53, 0x1a, 0xd4, 0xbe, 0x37, 0x84, 0x41, 0xe2, 0xc3, 0x72, 0x41, 0x35, 0x6e, 0x50, 0x75, 0x61,
+	0xdb, 0x06, 0x55, 0xcf, 0x90, 0x8e, 0x7f, 0x20, 0xfc, 0x7a, 0x5a, 0x3c, 0x9b, 0x43, 0xea, 0x0f,
+	0xe4, 0x6d, 0x5c, 0xd5, 0xc4, 0x7b, 0x56, 0x25, 0xb8, 0x80, 0x22, 0xac, 0x0f, 0xd7, 0x03, 0x53,
+	0xaa, 0x62, 0x0b, 0x62, 0x2f, 0xa2, 0xc7, 0x9a, 0x3d, 0x68, 0xba, 0xdb, 0x0b, 0x09, 0xb6, 0x55,
+	0x71, 0x05, 0x48, 0x79, 0xc7, 0xeb, 0x41, 0xe8, 0x53, 0x8f, 0x70, 0xd8, 0x1b, 0x43, 0xc0, 0x63,
+	0xe3, 0x77, 0xbc, 0x4c, 0xce, 0xf6, 0x1d, 0x2f, 0x17, 0x97, 0x52, 0xdf, 0x22, 0xec, 0xc8, 0x4f,
+	0x7b, 0xe4, 0xe1, 0xc2, 0xeb, 0x8e, 0x2d, 0x58, 0x46, 0x85, 0xda, 0x4e, 0x05, 0x82, 0xb4, 0xfb,
+	0x06, 0xe1, 0xe7, 0x32, 0xee, 0x0f, 0x36, 0x9d, 0xdb, 0xe5, 0xee, 0xfa, 0xc1, 0xa6, 0x70, 0xbb,
+	0x53, 0x1e, 0xa0, 0xcc, 0x66, 0x7f, 0x12, 0x78, 0xfd, 0x21, 0x89, 0x06, 0xb3, 0x12, 0x91, 0x98,
+	0xcf, 0x66, 0x26, 0x67, 0x3b, 0x9b, 0xb9, 0xb8, 0x94, 0xfa, 0x1c, 0xe1, 0x27, 0x67, 0x9f, 0x8a,
+	0x36, 0xc7, 0xb9, 0x69, 0x81, 0x14, 0x21, 0xa1, 0xb3, 0x5d, 0x2a, 0xab, 0x7c, 0x09, 0x8a, 0x6d,
+	0xa1, 0x94, 0xf4, 0x5d, 0xcb, 0x3d, 0xa5, 0x2b, 0xe7, 0xcd, 0x4a, 0x0c, 0xe9, 0xf8, 0x1d, 0xc2,
+	0xcf, 0x8b, 0x21, 0x8b, 0xb3, 0xa3, 0x0e, 0x8b, 0xb9, 0xb3, 0x63, 0x89, 0x5f, 0xca, 0x0a, 0xc3,
+	0xdd, 0x2a, 0x08, 0x29, 0xf8, 0x19, 0xc2, 0xb8, 0xe9, 0xb3, 0x18, 0xe6, 0xf3, 0xed, 0x5c, 0x37,
+	0x84, 0x5e, 0x45, 0x84, 0xce, 0x8d, 0x12, 0x49, 0xc5, 0x22, 0x6d, 0x8c, 0xe6, 0x55, 0xec, 0xba,
+	0x55, 0x2f, 0xb5, 0x5c, 0xbb, 0x6e, 0x94, 0x48, 0x2a, 0x1d, 0x4c, 0x1b, 0xb8, 0xd8, 0x94, 0x94,
+	0x05, 0x5d, 0x88, 0x63, 0x72, 0x0a, 0xb1, 0x71, 0x07, 0xa3, 0x8f, 0xdb, 0x76, 0x30, 0x45, 0x14,
+	0xa5, 0x38, 0xb5, 0x81, 0xb7, 0x0e, 0x8f, 0x74, 0xb2, 0x6d, 0xf3, 0xcb, 0xe8, 0x09, 0xb6, 0xc5,
+	0x69, 0x05, 0x48, 0x2a, 0x7f, 0x81, 0xf0, 0x53, 0x47, 0x09, 0x44, 0x13, 0x51, 0xc1, 0x1c, 0xd3,
+	0xed, 0xaf, 0xa4, 0x84, 0xda, 0xad, 0x72, 0x61, 0x45, 0xa7, 0x07, 0x24, 0x0c, 0xfd, 0xc9, 0xa2,
+	0x22, 0x6d, 0x1b, 0x2f, 0x9d, 0xa5, 0x94, 0xad, 0x4e, 0x26, 0x9c, 0x29, 0xdd, 0x64, 0xd0, 0x3a,
+	0x3c, 0x92, 0xd3, 0x68, 0x5e, 0xba, 0x95, 0x9c, 0x7d, 0xe9, 0xce, 0xc4, 0xd5, 0xe3, 0xd9, 0x24,
+	0x3a, 0x85, 0x65, 0x2b, 0xe3, 0xe3, 0xd9, 0x4c, 0xd0, 0xfa, 0x78, 0x36, 0x97, 0x57, 0xbc, 0xba,
+	0x50, 0xd2, 0xab, 0x0b, 0xd5, 0xbc, 0xba, 0x50, 0xe8, 0x95, 0x1e, 0x1b, 0x9f, 0x44, 0x10, 0x0f,
+	0xc5, 0x8a, 0x9b, 0x7d, 0xc3, 0xc4, 0x16, 0xc7, 0xc6, 0xf9, 0xb0, 0xfd, 0xb1, 0xb1, 0x8e, 0xa1,
+	0x14, 0xf0, 0x0e, 0x09, 0x06, 0xec, 0xe4, 0x24, 0xfd, 0xc6, 0x37, 0x2d, 0xe0, 0xcb, 0x21, 0xdb,
+	0x02, 0xae, 0x66, 0x95, 0xc3, 0x90, 0x16, 0xf8, 0xa0, 0x7b, 0xc9, 0xdd, 0x33, 0xae, 0x6e, 0xda,
+	0xbc, 0xed, 0x61, 0x48, 0x21, 0x46, 0xc8, 0xee, 0x86, 0xe7, 0x17, 0x6e, 0xed, 0xd1, 0x85, 0x5b,
+	0x7b, 0x7c, 0xe1, 0xa2, 0x4f, 0xa7, 0x2e, 0xfa, 0x71, 0xea, 0xa2, 0xbf, 0xa7, 0x2e, 0x3a, 0x9f,
+	0xba, 0xe8, 0x9f, 0xa9, 0x8b, 0xfe, 0x9d, 0xba, 0xb5, 0xc7, 0x53, 0x17, 0x7d, 0x79, 0xe9, 0xd6,
+	0xce, 0x2f, 0xdd, 0xda, 0xa3, 0x4b, 0xb7, 0xf6, 0xe1, 0xcd, 0x53, 0x76, 0x65, 0x40, 0xd9, 0xca,
+	0xdf, 0x9d, 0xb6, 0xd5, 0xbf, 0x1c, 0x3f, 0x31, 0xff, 0xd9, 0x69, 0xeb, 0xff, 0x01, 0x00, 0x0e,
+	0xee, 0x34, 0xfa, 0x12, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -242,6 +244,12 @@ type HistoryServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// HandoffShard notifies the new owner of a shard that the previous owner has drained and released it.
+	HandoffShard(ctx context.Context, in *HandoffShardRequest, opts ...grpc.CallOption) (*HandoffShardResponse, error)
+	// DeleteWorkflowExecution schedules the deletion of a closed workflow execution on the shard that owns it.
+	// The execution, its history and its visibility record are deleted by the timer queue of the shard
+	// like when the retention period of the workflow expires.
+	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
 }
 
 type historyServiceClient struct {
@@ -594,6 +602,24 @@ func (c *historyServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *historyServiceClient) HandoffShard(ctx context.Context, in *HandoffShardRequest, opts ...grpc.CallOption) (*HandoffShardResponse, error) {
+	out := new(HandoffShardResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/HandoffShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
+	out := new(DeleteWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -725,6 +751,12 @@ type HistoryServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// HandoffShard notifies the new owner of a shard that the previous owner has drained and released it.
+	HandoffShard(context.Context, *HandoffShardRequest) (*HandoffShardResponse, error)
+	// DeleteWorkflowExecution schedules the deletion of a closed workflow execution on the shard that owns it.
+	// The execution, its history and its visibility record are deleted by the timer queue of the shard
+	// like when the retention period of the workflow expires.
+	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -845,6 +877,12 @@ func (*UnimplementedHistoryServiceServer) MergeDLQMessages(ctx context.Context,
 func (*UnimplementedHistoryServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedHistoryServiceServer) HandoffShard(ctx context.Context, req *HandoffShardRequest) (*HandoffShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandoffShard not implemented")
+}
+func (*UnimplementedHistoryServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1534,6 +1572,42 @@ func _HistoryService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_HandoffShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoffShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).HandoffShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/HandoffShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).HandoffShard(ctx, req.(*HandoffShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DeleteWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DeleteWorkflowExecution(ctx, req.(*DeleteWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -1690,6 +1764,14 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _HistoryService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "HandoffShard",
+			Handler:    _HistoryService_HandoffShard_Handler,
+		},
+		{
+			MethodName: "DeleteWorkflowExecution",
+			Handler:    _HistoryService_DeleteWorkflowExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
@@ -796,6 +796,46 @@ func (mr *MockHistoryServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// HandoffShard mocks base method.
+func (m *MockHistoryServiceClient) HandoffShard(ctx context.Context, in *historyservice.HandoffShardRequest, opts ...grpc.CallOption) (*historyservice.HandoffShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HandoffShard", varargs...)
+	ret0, _ := ret[0].(*historyservice.HandoffShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandoffShard indicates an expected call of HandoffShard.
+func (mr *MockHistoryServiceClientMockRecorder) HandoffShard(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandoffShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).HandoffShard), varargs...)
+}
+
+// DeleteWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) DeleteWorkflowExecution(ctx context.Context, in *historyservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.DeleteWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) DeleteWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).DeleteWorkflowExecution), varargs...)
+}
+
 // MockHistoryServiceServer is a mock of HistoryServiceServer interface.
 type MockHistoryServiceServer struct {
 	ctrl     *gomock.Controller
@@ -1388,3 +1428,33 @@ func (mr *MockHistoryServiceServerMockRecorder) RefreshWorkflowTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
+
+// HandoffShard mocks base method.
+func (m *MockHistoryServiceServer) HandoffShard(arg0 context.Context, arg1 *historyservice.HandoffShardRequest) (*historyservice.HandoffShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandoffShard", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.HandoffShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandoffShard indicates an expected call of HandoffShard.
+func (mr *MockHistoryServiceServerMockRecorder) HandoffShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandoffShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).HandoffShard), arg0, arg1)
+}
+
+// DeleteWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DeleteWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) DeleteWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).DeleteWorkflowExecution), arg0, arg1)
+}
@@ -5,6 +5,7 @@ package matchingservice
 
 import (
 	bytes "bytes"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -119,6 +120,7 @@ type PollForDecisionTaskResponse struct {
 	ScheduledTimestamp         int64                         `protobuf:"varint,15,opt,name=scheduled_timestamp,json=scheduledTimestamp,proto3" json:"scheduled_timestamp,omitempty"`
 	StartedTimestamp           int64                         `protobuf:"varint,16,opt,name=started_timestamp,json=startedTimestamp,proto3" json:"started_timestamp,omitempty"`
 	Queries                    map[string]*v12.WorkflowQuery `protobuf:"bytes,17,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Archived                   bool                          `protobuf:"varint,18,opt,name=archived,proto3" json:"archived,omitempty"`
 }
 
 func (m *PollForDecisionTaskResponse) Reset()      { *m = PollForDecisionTaskResponse{} }
@@ -272,6 +274,13 @@ func (m *PollForDecisionTaskResponse) GetQueries() map[string]*v12.WorkflowQuery
 	return nil
 }
 
+func (m *PollForDecisionTaskResponse) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
 type PollForActivityTaskRequest struct {
 	NamespaceId   string                         `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	PollerId      string                         `protobuf:"bytes,2,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
@@ -510,6 +519,9 @@ type AddDecisionTaskRequest struct {
 	ScheduleToStartTimeoutSeconds int32                  `protobuf:"varint,5,opt,name=schedule_to_start_timeout_seconds,json=scheduleToStartTimeoutSeconds,proto3" json:"schedule_to_start_timeout_seconds,omitempty"`
 	ForwardedFrom                 string                 `protobuf:"bytes,6,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	Source                        v15.TaskSource         `protobuf:"varint,7,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
+	Priority                      int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey                   string                 `protobuf:"bytes,9,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	BuildId                       string                 `protobuf:"bytes,10,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
 }
 
 func (m *AddDecisionTaskRequest) Reset()      { *m = AddDecisionTaskRequest{} }
@@ -593,6 +605,27 @@ func (m *AddDecisionTaskRequest) GetSource() v15.TaskSource {
 	return v15.TASK_SOURCE_UNSPECIFIED
 }
 
+func (m *AddDecisionTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddDecisionTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
+func (m *AddDecisionTaskRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
 type AddDecisionTaskResponse struct {
 }
 
@@ -637,6 +670,8 @@ type AddActivityTaskRequest struct {
 	ScheduleToStartTimeoutSeconds int32                  `protobuf:"varint,6,opt,name=schedule_to_start_timeout_seconds,json=scheduleToStartTimeoutSeconds,proto3" json:"schedule_to_start_timeout_seconds,omitempty"`
 	ForwardedFrom                 string                 `protobuf:"bytes,7,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	Source                        v15.TaskSource         `protobuf:"varint,8,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
+	Priority                      int32                  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey                   string                 `protobuf:"bytes,10,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *AddActivityTaskRequest) Reset()      { *m = AddActivityTaskRequest{} }
@@ -727,6 +762,20 @@ func (m *AddActivityTaskRequest) GetSource() v15.TaskSource {
 	return v15.TASK_SOURCE_UNSPECIFIED
 }
 
+func (m *AddActivityTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddActivityTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type AddActivityTaskResponse struct {
 }
 
@@ -767,6 +816,9 @@ type QueryWorkflowRequest struct {
 	TaskQueue     *v14.TaskQueue           `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
 	QueryRequest  *v1.QueryWorkflowRequest `protobuf:"bytes,3,opt,name=query_request,json=queryRequest,proto3" json:"query_request,omitempty"`
 	ForwardedFrom string                   `protobuf:"bytes,4,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	Archived      bool                     `protobuf:"varint,5,opt,name=archived,proto3" json:"archived,omitempty"`
+	WorkflowType  *v11.WorkflowType        `protobuf:"bytes,6,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	BuildId       string                   `protobuf:"bytes,7,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
 }
 
 func (m *QueryWorkflowRequest) Reset()      { *m = QueryWorkflowRequest{} }
@@ -829,6 +881,27 @@ func (m *QueryWorkflowRequest) GetForwardedFrom() string {
 	return ""
 }
 
+func (m *QueryWorkflowRequest) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
+func (m *QueryWorkflowRequest) GetWorkflowType() *v11.WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *QueryWorkflowRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
 type QueryWorkflowResponse struct {
 	QueryResult   *v11.Payloads      `protobuf:"bytes,1,opt,name=query_result,json=queryResult,proto3" json:"query_result,omitempty"`
 	QueryRejected *v12.QueryRejected `protobuf:"bytes,2,opt,name=query_rejected,json=queryRejected,proto3" json:"query_rejected,omitempty"`
@@ -1138,6 +1211,7 @@ func (m *DescribeTaskQueueRequest) GetDescRequest() *v1.DescribeTaskQueueRequest
 type DescribeTaskQueueResponse struct {
 	Pollers         []*v14.PollerInfo    `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
 	TaskQueueStatus *v14.TaskQueueStatus `protobuf:"bytes,2,opt,name=task_queue_status,json=taskQueueStatus,proto3" json:"task_queue_status,omitempty"`
+	TaskQueueStats  *TaskQueueStats      `protobuf:"bytes,3,opt,name=task_queue_stats,json=taskQueueStats,proto3" json:"task_queue_stats,omitempty"`
 }
 
 func (m *DescribeTaskQueueResponse) Reset()      { *m = DescribeTaskQueueResponse{} }
@@ -1186,6 +1260,13 @@ func (m *DescribeTaskQueueResponse) GetTaskQueueStatus() *v14.TaskQueueStatus {
 	return nil
 }
 
+func (m *DescribeTaskQueueResponse) GetTaskQueueStats() *TaskQueueStats {
+	if m != nil {
+		return m.TaskQueueStats
+	}
+	return nil
+}
+
 type ListTaskQueuePartitionsRequest struct {
 	Namespace string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	TaskQueue *v14.TaskQueue `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -1238,8 +1319,10 @@ func (m *ListTaskQueuePartitionsRequest) GetTaskQueue() *v14.TaskQueue {
 }
 
 type ListTaskQueuePartitionsResponse struct {
-	ActivityTaskQueuePartitions []*v14.TaskQueuePartitionMetadata `protobuf:"bytes,1,rep,name=activity_task_queue_partitions,json=activityTaskQueuePartitions,proto3" json:"activity_task_queue_partitions,omitempty"`
-	DecisionTaskQueuePartitions []*v14.TaskQueuePartitionMetadata `protobuf:"bytes,2,rep,name=decision_task_queue_partitions,json=decisionTaskQueuePartitions,proto3" json:"decision_task_queue_partitions,omitempty"`
+	ActivityTaskQueuePartitions      []*v14.TaskQueuePartitionMetadata `protobuf:"bytes,1,rep,name=activity_task_queue_partitions,json=activityTaskQueuePartitions,proto3" json:"activity_task_queue_partitions,omitempty"`
+	DecisionTaskQueuePartitions      []*v14.TaskQueuePartitionMetadata `protobuf:"bytes,2,rep,name=decision_task_queue_partitions,json=decisionTaskQueuePartitions,proto3" json:"decision_task_queue_partitions,omitempty"`
+	ActivityTaskQueueWritePartitions int32                             `protobuf:"varint,3,opt,name=activity_task_queue_write_partitions,json=activityTaskQueueWritePartitions,proto3" json:"activity_task_queue_write_partitions,omitempty"`
+	DecisionTaskQueueWritePartitions int32                             `protobuf:"varint,4,opt,name=decision_task_queue_write_partitions,json=decisionTaskQueueWritePartitions,proto3" json:"decision_task_queue_write_partitions,omitempty"`
 }
 
 func (m *ListTaskQueuePartitionsResponse) Reset()      { *m = ListTaskQueuePartitionsResponse{} }
@@ -1288,6 +1371,154 @@ func (m *ListTaskQueuePartitionsResponse) GetDecisionTaskQueuePartitions() []*v1
 	return nil
 }
 
+func (m *ListTaskQueuePartitionsResponse) GetActivityTaskQueueWritePartitions() int32 {
+	if m != nil {
+		return m.ActivityTaskQueueWritePartitions
+	}
+	return 0
+}
+
+func (m *ListTaskQueuePartitionsResponse) GetDecisionTaskQueueWritePartitions() int32 {
+	if m != nil {
+		return m.DecisionTaskQueueWritePartitions
+	}
+	return 0
+}
+
+type PollerBuildId struct {
+	Identity string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	BuildId  string `protobuf:"bytes,2,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+}
+
+func (m *PollerBuildId) Reset()      { *m = PollerBuildId{} }
+func (*PollerBuildId) ProtoMessage() {}
+func (*PollerBuildId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{18}
+}
+func (m *PollerBuildId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PollerBuildId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PollerBuildId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PollerBuildId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollerBuildId.Merge(m, src)
+}
+func (m *PollerBuildId) XXX_Size() int {
+	return m.Size()
+}
+func (m *PollerBuildId) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollerBuildId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollerBuildId proto.InternalMessageInfo
+
+func (m *PollerBuildId) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *PollerBuildId) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+type TaskQueueStats struct {
+	ApproximateBacklogCount int64            `protobuf:"varint,1,opt,name=approximate_backlog_count,json=approximateBacklogCount,proto3" json:"approximate_backlog_count,omitempty"`
+	OldestTaskCreateTime    int64            `protobuf:"varint,2,opt,name=oldest_task_create_time,json=oldestTaskCreateTime,proto3" json:"oldest_task_create_time,omitempty"`
+	AddRate                 float64          `protobuf:"fixed64,3,opt,name=add_rate,json=addRate,proto3" json:"add_rate,omitempty"`
+	DispatchRate            float64          `protobuf:"fixed64,4,opt,name=dispatch_rate,json=dispatchRate,proto3" json:"dispatch_rate,omitempty"`
+	SyncMatchRate           float64          `protobuf:"fixed64,5,opt,name=sync_match_rate,json=syncMatchRate,proto3" json:"sync_match_rate,omitempty"`
+	PollerBuildIds          []*PollerBuildId `protobuf:"bytes,6,rep,name=poller_build_ids,json=pollerBuildIds,proto3" json:"poller_build_ids,omitempty"`
+}
+
+func (m *TaskQueueStats) Reset()      { *m = TaskQueueStats{} }
+func (*TaskQueueStats) ProtoMessage() {}
+func (*TaskQueueStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{19}
+}
+func (m *TaskQueueStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueueStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueueStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueueStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueueStats.Merge(m, src)
+}
+func (m *TaskQueueStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueueStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueueStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueueStats proto.InternalMessageInfo
+
+func (m *TaskQueueStats) GetApproximateBacklogCount() int64 {
+	if m != nil {
+		return m.ApproximateBacklogCount
+	}
+	return 0
+}
+
+func (m *TaskQueueStats) GetOldestTaskCreateTime() int64 {
+	if m != nil {
+		return m.OldestTaskCreateTime
+	}
+	return 0
+}
+
+func (m *TaskQueueStats) GetAddRate() float64 {
+	if m != nil {
+		return m.AddRate
+	}
+	return 0
+}
+
+func (m *TaskQueueStats) GetDispatchRate() float64 {
+	if m != nil {
+		return m.DispatchRate
+	}
+	return 0
+}
+
+func (m *TaskQueueStats) GetSyncMatchRate() float64 {
+	if m != nil {
+		return m.SyncMatchRate
+	}
+	return 0
+}
+
+func (m *TaskQueueStats) GetPollerBuildIds() []*PollerBuildId {
+	if m != nil {
+		return m.PollerBuildIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PollForDecisionTaskRequest)(nil), "temporal.server.api.matchingservice.v1.PollForDecisionTaskRequest")
 	proto.RegisterType((*PollForDecisionTaskResponse)(nil), "temporal.server.api.matchingservice.v1.PollForDecisionTaskResponse")
@@ -1308,6 +1539,8 @@ func init() {
 	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
 	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
 	proto.RegisterType((*ListTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsResponse")
+	proto.RegisterType((*PollerBuildId)(nil), "temporal.server.api.matchingservice.v1.PollerBuildId")
+	proto.RegisterType((*TaskQueueStats)(nil), "temporal.server.api.matchingservice.v1.TaskQueueStats")
 }
 
 func init() {
@@ -1315,112 +1548,129 @@ func init() {
 }
 
 var fileDescriptor_a429a3813476c583 = []byte{
-	// 1678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0x65, 0x5b, 0xb6, 0x9e, 0x24, 0xc7, 0x62, 0x76, 0x13, 0xda, 0x89, 0x69, 0x47, 0xf9,
-	0xf2, 0xee, 0x66, 0x65, 0xc4, 0x8b, 0x04, 0xf9, 0xd8, 0xc5, 0xae, 0xe3, 0x38, 0x1b, 0x61, 0xf3,
-	0xe1, 0xd0, 0x42, 0xb6, 0x08, 0x8a, 0xb2, 0x63, 0x72, 0x6c, 0x31, 0xa2, 0x38, 0x32, 0x67, 0x28,
-	0x47, 0x3d, 0xb5, 0xe8, 0x3f, 0x50, 0xa0, 0xa7, 0xa2, 0xe8, 0xbd, 0xbd, 0xf7, 0x8f, 0x28, 0x50,
-	0x14, 0xc8, 0x31, 0xc7, 0xc6, 0xbe, 0xf4, 0xd0, 0x43, 0xfa, 0x1f, 0x14, 0x33, 0x43, 0x52, 0x5f,
-	0x94, 0xad, 0x38, 0x46, 0xdb, 0x9b, 0xe6, 0xbd, 0xdf, 0xfb, 0x98, 0xf7, 0xe6, 0x7d, 0x50, 0xf0,
-	0x2f, 0x86, 0xeb, 0x0d, 0xe2, 0x23, 0x77, 0x89, 0x62, 0xbf, 0x89, 0xfd, 0x25, 0xd4, 0x70, 0x96,
-	0xea, 0x88, 0x59, 0x55, 0xc7, 0xdb, 0xe6, 0x24, 0xc7, 0xc2, 0x4b, 0xcd, 0xab, 0x4b, 0x3e, 0xde,
-	0x09, 0x30, 0x65, 0xa6, 0x8f, 0x69, 0x83, 0x78, 0x14, 0x97, 0x1a, 0x3e, 0x61, 0x44, 0xbd, 0x14,
-	0x89, 0x97, 0xa4, 0x78, 0x09, 0x35, 0x9c, 0x52, 0x8f, 0x78, 0xa9, 0x79, 0x75, 0xf6, 0x42, 0x6c,
-	0x86, 0xeb, 0xb7, 0x48, 0xbd, 0x4e, 0x3c, 0xae, 0xb6, 0x8e, 0x29, 0x45, 0xdb, 0xa1, 0xb6, 0xd9,
-	0x4b, 0x5d, 0x28, 0xec, 0x05, 0x75, 0xca, 0x41, 0x0c, 0xd1, 0x9a, 0xb9, 0x13, 0xe0, 0x20, 0xc2,
-	0x5d, 0xee, 0xc2, 0x71, 0xb6, 0xe0, 0xf6, 0x2b, 0x3c, 0xdf, 0x05, 0xdc, 0x09, 0xb0, 0xdf, 0xea,
-	0x07, 0x5d, 0x4e, 0x0a, 0x41, 0x97, 0xf1, 0x10, 0x78, 0x25, 0x09, 0x58, 0x75, 0x28, 0x23, 0x49,
-	0x6a, 0xaf, 0x77, 0xd9, 0xde, 0x25, 0x7e, 0x6d, 0xcb, 0x25, 0xbb, 0x87, 0x86, 0xb4, 0xb8, 0xaf,
-	0xc0, 0xec, 0x3a, 0x71, 0xdd, 0x7b, 0xc4, 0xbf, 0x8b, 0x2d, 0x87, 0x3a, 0xc4, 0xab, 0x20, 0x5a,
-	0x33, 0x24, 0x5a, 0x3d, 0x07, 0x39, 0x0f, 0xd5, 0x31, 0x6d, 0x20, 0x0b, 0x9b, 0x8e, 0xad, 0x29,
-	0x0b, 0xca, 0x62, 0xc6, 0xc8, 0xc6, 0xb4, 0xb2, 0xad, 0x9e, 0x81, 0x4c, 0x83, 0xb8, 0x2e, 0xf6,
-	0x39, 0x3f, 0x25, 0xf8, 0x93, 0x92, 0x50, 0xb6, 0xd5, 0x0f, 0x20, 0xc7, 0x7f, 0x9b, 0xa1, 0x75,
-	0x6d, 0x74, 0x41, 0x59, 0xcc, 0x2e, 0xdf, 0x2e, 0xc5, 0x89, 0xe4, 0x19, 0xec, 0xf1, 0xb6, 0xd4,
-	0xbc, 0x5a, 0x1a, 0xec, 0x92, 0x91, 0xe5, 0x0a, 0x23, 0xff, 0x2e, 0xc2, 0xd4, 0x16, 0xf1, 0x77,
-	0x91, 0x6f, 0x63, 0xdb, 0xdc, 0xf2, 0x49, 0x5d, 0x1b, 0x13, 0x1e, 0xe4, 0x63, 0xea, 0x3d, 0x9f,
-	0xd4, 0x8b, 0x3f, 0x4f, 0xc2, 0x99, 0x44, 0x95, 0x32, 0x16, 0xea, 0x1c, 0x80, 0x48, 0x3b, 0x23,
-	0x35, 0xec, 0x89, 0x4b, 0xe6, 0x8c, 0x0c, 0xa7, 0x54, 0x38, 0x41, 0x7d, 0x0f, 0xd4, 0xc8, 0x47,
-	0x13, 0xbf, 0xc0, 0x56, 0xc0, 0x1c, 0xe2, 0x89, 0xbb, 0x66, 0x97, 0xff, 0xd2, 0x7d, 0x17, 0xf9,
-	0xd8, 0xf8, 0x15, 0xfe, 0x1f, 0x4a, 0xac, 0x45, 0x02, 0x46, 0x61, 0xb7, 0x97, 0xa4, 0x96, 0x21,
-	0x1f, 0x6b, 0x66, 0xad, 0x06, 0x0e, 0x03, 0x74, 0xe1, 0x30, 0xa5, 0x95, 0x56, 0x03, 0x1b, 0xb9,
-	0xdd, 0x8e, 0x93, 0x7a, 0x13, 0x66, 0x1a, 0x3e, 0x6e, 0x3a, 0x24, 0xa0, 0x26, 0x65, 0xc8, 0x67,
-	0xd8, 0x36, 0x71, 0x13, 0x7b, 0x8c, 0xe7, 0x85, 0x47, 0x65, 0xd4, 0x38, 0x15, 0x01, 0x36, 0x24,
-	0x7f, 0x8d, 0xb3, 0xcb, 0xb6, 0xba, 0x08, 0xd3, 0x7d, 0x12, 0xe3, 0x42, 0x62, 0x8a, 0x76, 0x23,
-	0x35, 0x98, 0x40, 0x8c, 0xfb, 0xc6, 0xb4, 0xb4, 0x00, 0x44, 0x47, 0xb5, 0x08, 0x79, 0x0f, 0xbf,
-	0x60, 0x6d, 0x05, 0x13, 0x82, 0x9f, 0xe5, 0xc4, 0x48, 0xfa, 0x0a, 0xa8, 0x9b, 0xc8, 0xaa, 0xb9,
-	0x64, 0xdb, 0xb4, 0x48, 0xe0, 0x31, 0xb3, 0xea, 0x78, 0x4c, 0x9b, 0x14, 0xc0, 0xe9, 0x90, 0xb3,
-	0xca, 0x19, 0xf7, 0x1d, 0x8f, 0xa9, 0x37, 0x40, 0xa3, 0xcc, 0xb1, 0x6a, 0xad, 0x76, 0xcc, 0x4d,
-	0xec, 0xa1, 0x4d, 0x17, 0xdb, 0x5a, 0x66, 0x41, 0x59, 0x9c, 0x34, 0x4e, 0x49, 0x7e, 0x1c, 0xce,
-	0x35, 0xc9, 0x55, 0x6f, 0xc1, 0xb8, 0xa8, 0x3e, 0x0d, 0x92, 0xa2, 0x29, 0x58, 0x9d, 0xc1, 0x7c,
-	0xc2, 0x09, 0x86, 0x14, 0x51, 0x9f, 0x41, 0xde, 0x0e, 0x9f, 0x88, 0xe9, 0x78, 0x5b, 0x44, 0xcb,
-	0x0a, 0x1d, 0xd7, 0x4a, 0x49, 0xbd, 0x27, 0x2c, 0x47, 0xae, 0xac, 0xe2, 0x23, 0x8f, 0x3a, 0xd8,
-	0x63, 0xd1, 0x03, 0x2b, 0x7b, 0x5b, 0xc4, 0xc8, 0xd9, 0x1d, 0x27, 0x75, 0x1b, 0xe6, 0xfa, 0xdf,
-	0x91, 0xd9, 0x6e, 0x38, 0x5a, 0x2e, 0xc9, 0xdf, 0xb8, 0xe3, 0x08, 0x33, 0x88, 0xd6, 0x9e, 0xf0,
-	0x83, 0x31, 0xdb, 0xf7, 0x9a, 0x62, 0x9e, 0x5a, 0x82, 0x93, 0x32, 0x0f, 0xdc, 0x3d, 0x6c, 0x36,
-	0xb1, 0xcf, 0x7d, 0xd0, 0xf2, 0x0b, 0xca, 0xe2, 0xb8, 0x51, 0x10, 0xac, 0x0d, 0xce, 0x79, 0x2a,
-	0x19, 0xbc, 0xcc, 0x37, 0x7d, 0xe4, 0x59, 0xd5, 0xb0, 0x02, 0xa6, 0x44, 0x05, 0x64, 0x25, 0x4d,
-	0xd6, 0xc0, 0x12, 0x9c, 0xa4, 0x56, 0x15, 0xdb, 0x81, 0x8b, 0x6d, 0x93, 0x39, 0x75, 0x4c, 0x19,
-	0xaa, 0x37, 0xb4, 0x13, 0x22, 0x79, 0x6a, 0xcc, 0xaa, 0x44, 0x1c, 0xf5, 0x6f, 0x50, 0x88, 0x1e,
-	0x55, 0x1b, 0x3e, 0x2d, 0x73, 0x1d, 0x32, 0xda, 0xe0, 0xe7, 0x30, 0xc1, 0xc3, 0xef, 0x60, 0xaa,
-	0x15, 0x16, 0x46, 0x17, 0xb3, 0xcb, 0xeb, 0xa5, 0xe1, 0x7a, 0x7d, 0xe9, 0x80, 0xb2, 0x2e, 0x3d,
-	0x91, 0x2a, 0xd7, 0x3c, 0xe6, 0xb7, 0x8c, 0xc8, 0xc0, 0xec, 0x87, 0x90, 0xeb, 0x64, 0xa8, 0xd3,
-	0x30, 0x5a, 0xc3, 0xad, 0xb0, 0xb5, 0xf1, 0x9f, 0xfc, 0xfd, 0x34, 0x91, 0x1b, 0xe0, 0xb0, 0xc4,
-	0x87, 0x7c, 0x3f, 0x42, 0xe4, 0x56, 0xea, 0x86, 0xd2, 0xd9, 0x54, 0x57, 0x2c, 0xe6, 0x34, 0x1d,
-	0xd6, 0xfa, 0x03, 0x35, 0xd5, 0x04, 0x97, 0x8e, 0xd4, 0x54, 0xbf, 0x9f, 0x88, 0x9b, 0x6a, 0xb7,
-	0xca, 0xdf, 0xbb, 0xa9, 0xce, 0x43, 0x16, 0x85, 0x0e, 0xf1, 0xf0, 0x8d, 0x0a, 0xe7, 0x21, 0x22,
-	0x95, 0x6d, 0xde, 0x75, 0x63, 0x80, 0xe8, 0xba, 0x63, 0x07, 0x77, 0xdd, 0xf8, 0x7a, 0xa2, 0xeb,
-	0xa2, 0x8e, 0x93, 0x7a, 0x1d, 0xc6, 0x1d, 0xaf, 0x11, 0x30, 0xd1, 0x2f, 0xb3, 0xcb, 0x0b, 0x83,
-	0x54, 0xac, 0xa3, 0x96, 0x4b, 0x90, 0x4d, 0x0d, 0x09, 0x1f, 0x54, 0x4e, 0xe9, 0x81, 0xe5, 0x74,
-	0x1f, 0xce, 0x45, 0x54, 0x93, 0x11, 0xd3, 0x72, 0x09, 0xc5, 0x42, 0x90, 0x04, 0xcc, 0xa4, 0xd8,
-	0x22, 0x9e, 0x4d, 0x45, 0xcf, 0x1d, 0x37, 0xe6, 0x22, 0x60, 0x85, 0xac, 0x72, 0x58, 0x45, 0xa2,
-	0x36, 0x24, 0x28, 0xb9, 0x30, 0x27, 0x07, 0x14, 0xe6, 0x1d, 0xd0, 0x05, 0x6d, 0xb0, 0xcd, 0x8c,
-	0xb0, 0x39, 0x2b, 0x50, 0xc9, 0x06, 0x6f, 0xc1, 0x4c, 0x15, 0x23, 0x9f, 0x6d, 0x62, 0xc4, 0xfa,
-	0xc4, 0x41, 0x88, 0x9f, 0x8e, 0x01, 0x3d, 0xb2, 0x1d, 0x03, 0x27, 0x2b, 0x90, 0xf1, 0xc0, 0x79,
-	0x00, 0xe7, 0x13, 0x22, 0x68, 0x92, 0x2d, 0x93, 0x55, 0x1d, 0x6a, 0x46, 0x52, 0x39, 0x71, 0xb1,
-	0xf9, 0xfe, 0x88, 0x3e, 0xde, 0xaa, 0x54, 0x1d, 0xba, 0x12, 0x6a, 0x7b, 0x08, 0x85, 0xb6, 0x8f,
-	0x36, 0x66, 0xc8, 0x71, 0xa9, 0xe8, 0x97, 0xc3, 0xe4, 0x74, 0x3a, 0x16, 0xbd, 0x2b, 0x25, 0xfb,
-	0xe7, 0xfa, 0xd4, 0x91, 0xe7, 0xfa, 0xdf, 0x3b, 0xea, 0x24, 0x6e, 0x11, 0xa2, 0xef, 0x66, 0xda,
-	0x8f, 0xff, 0x51, 0xc4, 0x50, 0xaf, 0x43, 0xba, 0x8a, 0x91, 0x8d, 0x7d, 0xd1, 0x6b, 0xb3, 0xcb,
-	0xfa, 0x20, 0x93, 0xf7, 0x05, 0xca, 0x08, 0xd1, 0xc5, 0xaf, 0x46, 0xe1, 0xd4, 0x8a, 0x6d, 0x1f,
-	0x71, 0x09, 0xfc, 0x2f, 0x64, 0xde, 0xa1, 0x86, 0xdb, 0xb2, 0xea, 0x6a, 0xd8, 0x34, 0xe4, 0x3c,
-	0x1c, 0x7d, 0x8b, 0x79, 0x28, 0x5a, 0x8b, 0x1c, 0x7f, 0xf3, 0x90, 0x8d, 0x6b, 0x25, 0x5e, 0x7e,
-	0x20, 0x22, 0x95, 0xed, 0xde, 0x62, 0x0a, 0x5f, 0x78, 0xcf, 0xcb, 0x1c, 0xef, 0x2d, 0x26, 0xb1,
-	0x35, 0xf5, 0xbc, 0xcf, 0xfe, 0x5e, 0x99, 0x4e, 0xe8, 0x95, 0xea, 0x7f, 0x20, 0x4d, 0x49, 0xe0,
-	0x5b, 0x58, 0x94, 0xe8, 0xd4, 0xf2, 0x62, 0xe2, 0x78, 0x13, 0x9f, 0x01, 0xd1, 0xad, 0x36, 0x04,
-	0xde, 0x08, 0xe5, 0x8a, 0x33, 0x70, 0xba, 0x2f, 0x3d, 0xb2, 0xd1, 0x16, 0x7f, 0x90, 0xa9, 0x3b,
-	0xe2, 0xa8, 0x39, 0xb6, 0xd4, 0x95, 0xe0, 0xa4, 0xf4, 0xd5, 0xec, 0x32, 0x29, 0xdb, 0x6f, 0x41,
-	0xb2, 0x1e, 0x75, 0x18, 0xee, 0x4e, 0xf5, 0xd8, 0xb1, 0xa4, 0x7a, 0xfc, 0x68, 0xa9, 0x4e, 0x1f,
-	0x2d, 0xd5, 0x13, 0x07, 0xa7, 0x7a, 0xf2, 0x9d, 0x52, 0x9d, 0x34, 0x53, 0x8b, 0x9f, 0xa4, 0xe0,
-	0x4f, 0x62, 0xdd, 0x88, 0x32, 0xf1, 0x16, 0x89, 0xee, 0x8e, 0x77, 0xea, 0x68, 0xf1, 0x7e, 0x06,
-	0x79, 0xb1, 0xff, 0xf4, 0x2c, 0x1f, 0xd7, 0x0e, 0x5d, 0x3e, 0x92, 0xbc, 0x36, 0x72, 0x42, 0xd7,
-	0x5b, 0xee, 0x1d, 0xdf, 0x28, 0xf0, 0xe7, 0x1e, 0x6d, 0xe1, 0xc6, 0xb1, 0x0a, 0xb9, 0xc8, 0x39,
-	0x1a, 0xb8, 0x4c, 0x04, 0x61, 0x98, 0xfe, 0x9d, 0x0d, 0xdd, 0xe0, 0x42, 0xea, 0xff, 0x60, 0x2a,
-	0x52, 0xf2, 0x1c, 0x5b, 0x0c, 0xdb, 0x87, 0x6c, 0x81, 0x72, 0xfb, 0x0b, 0xb1, 0x46, 0x7e, 0xa7,
-	0xf3, 0x58, 0xfc, 0x3c, 0x05, 0x0b, 0xd2, 0x3d, 0x5b, 0xe0, 0x78, 0x4c, 0x57, 0x49, 0xbd, 0xe1,
-	0x62, 0x0e, 0xfe, 0x8d, 0x73, 0x77, 0x1a, 0x26, 0x84, 0x92, 0xb8, 0x28, 0xd3, 0xfc, 0x58, 0xb6,
-	0x55, 0x0f, 0x0a, 0x56, 0xe4, 0x54, 0x9c, 0x58, 0x59, 0x90, 0x2b, 0x87, 0x26, 0xf6, 0xb0, 0xeb,
-	0x19, 0xd3, 0x56, 0x0f, 0xa5, 0x78, 0x1e, 0xce, 0x1d, 0x20, 0x15, 0x3e, 0xf5, 0x5f, 0x14, 0x38,
-	0xbb, 0x8a, 0x3c, 0x0b, 0xbb, 0x8f, 0x03, 0x46, 0x19, 0xf2, 0x6c, 0xc7, 0xdb, 0x5e, 0xef, 0x58,
-	0x53, 0x87, 0x08, 0xdb, 0x03, 0x38, 0xd1, 0x0e, 0x9b, 0x1c, 0xc4, 0x29, 0x51, 0x94, 0x3d, 0xb1,
-	0xeb, 0xaa, 0x46, 0x11, 0x2c, 0x31, 0x88, 0xf3, 0xac, 0xf3, 0x78, 0x3c, 0xb3, 0xa9, 0x6b, 0xb3,
-	0x1f, 0xeb, 0xde, 0xec, 0x8b, 0xf3, 0x30, 0x37, 0xe0, 0xca, 0x61, 0x50, 0xbe, 0x54, 0x40, 0xbb,
-	0x8b, 0xa9, 0xe5, 0x3b, 0x9b, 0xb8, 0xad, 0x7e, 0xf8, 0x80, 0xbc, 0x0f, 0x39, 0x1b, 0x53, 0x2b,
-	0x4e, 0xb2, 0x7c, 0x49, 0x37, 0x0f, 0x4d, 0xf2, 0x20, 0x9b, 0x46, 0x96, 0xab, 0x8b, 0xf2, 0xfa,
-	0xad, 0x02, 0x33, 0x09, 0xc8, 0xb0, 0x3a, 0xff, 0x0d, 0x13, 0xf2, 0xa2, 0x54, 0x53, 0xc4, 0x37,
-	0xde, 0xc5, 0x03, 0x62, 0xb7, 0x2e, 0x43, 0xc2, 0xbf, 0xa1, 0x23, 0x29, 0xf5, 0x29, 0x14, 0x3a,
-	0xb2, 0x49, 0x19, 0x62, 0x01, 0x0d, 0x6f, 0xf0, 0xd7, 0x61, 0xd2, 0xb0, 0x21, 0x24, 0x8c, 0x13,
-	0xac, 0x9b, 0x50, 0xfc, 0x54, 0x01, 0xfd, 0x81, 0x43, 0x59, 0x0c, 0x5c, 0x47, 0x3e, 0x73, 0xf8,
-	0x4c, 0xa3, 0x51, 0x68, 0xcf, 0x42, 0xa6, 0xbd, 0x7b, 0xc9, 0xb8, 0xb6, 0x09, 0xc7, 0x52, 0x9d,
-	0xc5, 0x2f, 0x52, 0x30, 0x3f, 0xd0, 0x8b, 0x30, 0x84, 0x1f, 0x81, 0xde, 0xfe, 0x70, 0x69, 0x87,
-	0xa2, 0x11, 0x23, 0xc3, 0xc8, 0x5e, 0x1b, 0xc6, 0x78, 0xac, 0xff, 0x21, 0x66, 0xc8, 0x46, 0x0c,
-	0x19, 0x67, 0x50, 0xc7, 0xcc, 0xe9, 0xf1, 0x81, 0xdb, 0x8e, 0xff, 0x18, 0x49, 0xb6, 0x9d, 0x7a,
-	0x27, 0xdb, 0x76, 0xc7, 0x6a, 0xd3, 0x63, 0xfb, 0x8e, 0xff, 0xf2, 0xb5, 0x3e, 0xf2, 0xea, 0xb5,
-	0x3e, 0xf2, 0xe6, 0xb5, 0xae, 0x7c, 0xbc, 0xa7, 0x2b, 0x5f, 0xef, 0xe9, 0xca, 0x77, 0x7b, 0xba,
-	0xf2, 0x72, 0x4f, 0x57, 0x7e, 0xdc, 0xd3, 0x95, 0x9f, 0xf6, 0xf4, 0x91, 0x37, 0x7b, 0xba, 0xf2,
-	0xd9, 0xbe, 0x3e, 0xf2, 0x72, 0x5f, 0x1f, 0x79, 0xb5, 0xaf, 0x8f, 0x3c, 0xfb, 0xe7, 0x36, 0x69,
-	0xfb, 0xe2, 0x90, 0x83, 0xff, 0x73, 0xbe, 0xdd, 0x43, 0xda, 0x4c, 0x8b, 0x3f, 0x48, 0xff, 0xf1,
-	0x6b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x43, 0xeb, 0xb3, 0x7c, 0xb4, 0x16, 0x00, 0x00,
+	// 1946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0xa2, 0x28, 0x3e, 0xfe, 0xb1, 0xb4, 0x4e, 0xad, 0x95, 0x1c, 0xd3, 0x32, 0x9d,
+	0x38, 0x6a, 0x9b, 0x52, 0xb0, 0x0a, 0x1b, 0x89, 0xd3, 0xa2, 0xb5, 0x65, 0xbb, 0x26, 0x62, 0x27,
+	0xf2, 0x4a, 0x48, 0x0b, 0xa3, 0xe8, 0x66, 0xb4, 0x33, 0x12, 0x27, 0x5a, 0xee, 0xac, 0x77, 0x86,
+	0x94, 0xd9, 0x53, 0x81, 0x5c, 0x7a, 0xe8, 0xa1, 0x40, 0x81, 0x02, 0x45, 0xbf, 0x40, 0xfb, 0x4d,
+	0x0a, 0xb4, 0x07, 0x1f, 0x73, 0xac, 0xe5, 0x4b, 0x8f, 0xe9, 0xa9, 0xd7, 0x62, 0x66, 0x76, 0x97,
+	0xbb, 0xe4, 0x52, 0x92, 0x15, 0xa1, 0xcd, 0x4d, 0xf3, 0xde, 0xef, 0xbd, 0x37, 0xf3, 0xfe, 0x2f,
+	0x05, 0x3f, 0x16, 0xa4, 0x17, 0xb0, 0x10, 0x79, 0xeb, 0x9c, 0x84, 0x03, 0x12, 0xae, 0xa3, 0x80,
+	0xae, 0xf7, 0x90, 0x70, 0xbb, 0xd4, 0xdf, 0x97, 0x24, 0xea, 0x92, 0xf5, 0xc1, 0xcd, 0xf5, 0x90,
+	0x3c, 0xef, 0x13, 0x2e, 0x9c, 0x90, 0xf0, 0x80, 0xf9, 0x9c, 0xb4, 0x83, 0x90, 0x09, 0x66, 0xde,
+	0x88, 0xc5, 0xdb, 0x5a, 0xbc, 0x8d, 0x02, 0xda, 0x1e, 0x13, 0x6f, 0x0f, 0x6e, 0xae, 0xbc, 0x93,
+	0x98, 0x91, 0xfa, 0x5d, 0xd6, 0xeb, 0x31, 0x5f, 0xaa, 0xed, 0x11, 0xce, 0xd1, 0x7e, 0xa4, 0x6d,
+	0xe5, 0x46, 0x06, 0x45, 0xfc, 0x7e, 0x8f, 0x4b, 0x90, 0x40, 0xfc, 0xc0, 0x79, 0xde, 0x27, 0xfd,
+	0x18, 0xf7, 0x5e, 0x06, 0x27, 0xd9, 0x8a, 0x3b, 0xa9, 0xf0, 0x7a, 0x06, 0xf8, 0xbc, 0x4f, 0xc2,
+	0xe1, 0x24, 0xe8, 0xbd, 0x3c, 0x17, 0x64, 0x8c, 0x47, 0xc0, 0xf7, 0xf3, 0x80, 0x5d, 0xca, 0x05,
+	0xcb, 0x53, 0x7b, 0x3b, 0x63, 0xfb, 0x90, 0x85, 0x07, 0x7b, 0x1e, 0x3b, 0x3c, 0xd1, 0xa5, 0xad,
+	0xd7, 0x06, 0xac, 0x6c, 0x31, 0xcf, 0x7b, 0xc8, 0xc2, 0xfb, 0xc4, 0xa5, 0x9c, 0x32, 0x7f, 0x07,
+	0xf1, 0x03, 0x5b, 0xa3, 0xcd, 0x6b, 0x50, 0xf3, 0x51, 0x8f, 0xf0, 0x00, 0xb9, 0xc4, 0xa1, 0xd8,
+	0x32, 0x56, 0x8d, 0xb5, 0x8a, 0x5d, 0x4d, 0x68, 0x1d, 0x6c, 0x5e, 0x86, 0x4a, 0xc0, 0x3c, 0x8f,
+	0x84, 0x92, 0x5f, 0x50, 0xfc, 0x79, 0x4d, 0xe8, 0x60, 0xf3, 0x57, 0x50, 0x93, 0x7f, 0x3b, 0x91,
+	0x75, 0xab, 0xb8, 0x6a, 0xac, 0x55, 0x37, 0x3e, 0x6a, 0x27, 0x81, 0x94, 0x11, 0x1c, 0xbb, 0x6d,
+	0x7b, 0x70, 0xb3, 0x3d, 0xfd, 0x4a, 0x76, 0x55, 0x2a, 0x8c, 0xef, 0xf7, 0x2e, 0x34, 0xf6, 0x58,
+	0x78, 0x88, 0x42, 0x4c, 0xb0, 0xb3, 0x17, 0xb2, 0x9e, 0x35, 0xab, 0x6e, 0x50, 0x4f, 0xa8, 0x0f,
+	0x43, 0xd6, 0x6b, 0xfd, 0xb6, 0x02, 0x97, 0x73, 0x55, 0x6a, 0x5f, 0x98, 0x57, 0x00, 0x54, 0xd8,
+	0x05, 0x3b, 0x20, 0xbe, 0x7a, 0x64, 0xcd, 0xae, 0x48, 0xca, 0x8e, 0x24, 0x98, 0xbf, 0x00, 0x33,
+	0xbe, 0xa3, 0x43, 0x5e, 0x10, 0xb7, 0x2f, 0x28, 0xf3, 0xd5, 0x5b, 0xab, 0x1b, 0xdf, 0xcd, 0xbe,
+	0x45, 0x27, 0x9b, 0x7c, 0xc2, 0xcf, 0x23, 0x89, 0x07, 0xb1, 0x80, 0xbd, 0x78, 0x38, 0x4e, 0x32,
+	0x3b, 0x50, 0x4f, 0x34, 0x8b, 0x61, 0x40, 0x22, 0x07, 0xbd, 0x73, 0x92, 0xd2, 0x9d, 0x61, 0x40,
+	0xec, 0xda, 0x61, 0xea, 0x64, 0x7e, 0x08, 0xcb, 0x41, 0x48, 0x06, 0x94, 0xf5, 0xb9, 0xc3, 0x05,
+	0x0a, 0x05, 0xc1, 0x0e, 0x19, 0x10, 0x5f, 0xc8, 0xb8, 0x48, 0xaf, 0x14, 0xed, 0x4b, 0x31, 0x60,
+	0x5b, 0xf3, 0x1f, 0x48, 0x76, 0x07, 0x9b, 0x6b, 0xb0, 0x30, 0x21, 0x51, 0x52, 0x12, 0x0d, 0x9e,
+	0x45, 0x5a, 0x50, 0x46, 0x42, 0xde, 0x4d, 0x58, 0x73, 0x0a, 0x10, 0x1f, 0xcd, 0x16, 0xd4, 0x7d,
+	0xf2, 0x42, 0x8c, 0x14, 0x94, 0x15, 0xbf, 0x2a, 0x89, 0xb1, 0xf4, 0xfb, 0x60, 0xee, 0x22, 0xf7,
+	0xc0, 0x63, 0xfb, 0x8e, 0xcb, 0xfa, 0xbe, 0x70, 0xba, 0xd4, 0x17, 0xd6, 0xbc, 0x02, 0x2e, 0x44,
+	0x9c, 0x4d, 0xc9, 0x78, 0x44, 0x7d, 0x61, 0x7e, 0x00, 0x16, 0x17, 0xd4, 0x3d, 0x18, 0x8e, 0x7c,
+	0xee, 0x10, 0x1f, 0xed, 0x7a, 0x04, 0x5b, 0x95, 0x55, 0x63, 0x6d, 0xde, 0xbe, 0xa4, 0xf9, 0x89,
+	0x3b, 0x1f, 0x68, 0xae, 0x79, 0x07, 0x4a, 0xaa, 0xfa, 0x2c, 0xc8, 0xf3, 0xa6, 0x62, 0xa5, 0x9d,
+	0xf9, 0x54, 0x12, 0x6c, 0x2d, 0x62, 0x3e, 0x83, 0x3a, 0x8e, 0x52, 0xc4, 0xa1, 0xfe, 0x1e, 0xb3,
+	0xaa, 0x4a, 0xc7, 0xad, 0x76, 0x5e, 0xef, 0x89, 0xca, 0x51, 0x2a, 0xdb, 0x09, 0x91, 0xcf, 0x29,
+	0xf1, 0x45, 0x9c, 0x60, 0x1d, 0x7f, 0x8f, 0xd9, 0x35, 0x9c, 0x3a, 0x99, 0xfb, 0x70, 0x65, 0x32,
+	0x8f, 0x9c, 0x51, 0xc3, 0xb1, 0x6a, 0x79, 0xf7, 0x4d, 0x3a, 0x8e, 0x32, 0x83, 0xf8, 0xc1, 0x53,
+	0x79, 0xb0, 0x57, 0x26, 0xb2, 0x29, 0xe1, 0x99, 0x6d, 0xb8, 0xa8, 0xe3, 0x20, 0xaf, 0x47, 0x9c,
+	0x01, 0x09, 0xe5, 0x1d, 0xac, 0xfa, 0xaa, 0xb1, 0x56, 0xb2, 0x17, 0x15, 0x6b, 0x5b, 0x72, 0x3e,
+	0xd3, 0x0c, 0x59, 0xe6, 0xbb, 0x21, 0xf2, 0xdd, 0x6e, 0x54, 0x01, 0x0d, 0x55, 0x01, 0x55, 0x4d,
+	0xd3, 0x35, 0xb0, 0x0e, 0x17, 0xb9, 0xdb, 0x25, 0xb8, 0xef, 0x11, 0xec, 0x08, 0xda, 0x23, 0x5c,
+	0xa0, 0x5e, 0x60, 0x5d, 0x50, 0xc1, 0x33, 0x13, 0xd6, 0x4e, 0xcc, 0x31, 0xbf, 0x0f, 0x8b, 0x71,
+	0x52, 0x8d, 0xe0, 0x0b, 0x3a, 0xd6, 0x11, 0x63, 0x04, 0xfe, 0x02, 0xca, 0xd2, 0xfd, 0x94, 0x70,
+	0x6b, 0x71, 0xb5, 0xb8, 0x56, 0xdd, 0xd8, 0x6a, 0x9f, 0xae, 0xd7, 0xb7, 0x8f, 0x29, 0xeb, 0xf6,
+	0x53, 0xad, 0xf2, 0x81, 0x2f, 0xc2, 0xa1, 0x1d, 0x1b, 0x30, 0x57, 0x60, 0x1e, 0x85, 0x6e, 0x97,
+	0x0e, 0x08, 0xb6, 0x4c, 0x95, 0x47, 0xc9, 0x79, 0xe5, 0x73, 0xa8, 0xa5, 0x85, 0xcc, 0x05, 0x28,
+	0x1e, 0x90, 0x61, 0xd4, 0xf6, 0xe4, 0x9f, 0x32, 0xb7, 0x06, 0xc8, 0xeb, 0x13, 0xab, 0x90, 0x17,
+	0xab, 0x69, 0xb9, 0xa5, 0x44, 0xee, 0x14, 0x3e, 0x30, 0xd2, 0x0d, 0xf7, 0xae, 0x2b, 0xe8, 0x80,
+	0x8a, 0xe1, 0xb7, 0xa8, 0xe1, 0xe6, 0x5c, 0xe9, 0x4c, 0x0d, 0xf7, 0xef, 0x65, 0xb8, 0x9c, 0xab,
+	0xf2, 0xff, 0xdd, 0x70, 0xaf, 0x42, 0x15, 0x45, 0x17, 0x92, 0xee, 0x2b, 0xaa, 0xcb, 0x43, 0x4c,
+	0xea, 0x60, 0xd9, 0x91, 0x13, 0x80, 0xea, 0xc8, 0xb3, 0xc7, 0x77, 0xe4, 0xe4, 0x79, 0xaa, 0x23,
+	0xa3, 0xd4, 0xc9, 0xbc, 0x0d, 0x25, 0xea, 0x07, 0x7d, 0xa1, 0x7a, 0x69, 0x75, 0x63, 0x75, 0x9a,
+	0x8a, 0x2d, 0x34, 0xf4, 0x18, 0xc2, 0xdc, 0xd6, 0xf0, 0x69, 0xa5, 0x36, 0x37, 0xb5, 0xd4, 0x1e,
+	0xc1, 0xb5, 0x98, 0xea, 0x08, 0xe6, 0xb8, 0x1e, 0xe3, 0x44, 0x09, 0xb2, 0xbe, 0x70, 0x38, 0x71,
+	0x99, 0x8f, 0xb9, 0xea, 0xc7, 0x25, 0xfb, 0x4a, 0x0c, 0xdc, 0x61, 0x9b, 0x12, 0xb6, 0xa3, 0x51,
+	0xdb, 0x1a, 0x94, 0x5f, 0xb4, 0xf3, 0x53, 0x8a, 0xf6, 0x1e, 0x34, 0x15, 0x6d, 0xba, 0xcd, 0x8a,
+	0xb2, 0xb9, 0xa2, 0x50, 0xf9, 0x06, 0xef, 0xc0, 0x72, 0x97, 0xa0, 0x50, 0xec, 0x12, 0x24, 0x26,
+	0xc4, 0x41, 0x89, 0x2f, 0x25, 0x80, 0x31, 0xd9, 0xd4, 0x30, 0xaa, 0x2a, 0x64, 0x7c, 0x34, 0x1f,
+	0xc3, 0xf5, 0x1c, 0x0f, 0x3a, 0x6c, 0xcf, 0x11, 0x5d, 0xca, 0x9d, 0x58, 0xaa, 0xa6, 0x1e, 0x76,
+	0x75, 0xd2, 0xa3, 0x9f, 0xee, 0xed, 0x74, 0x29, 0xbf, 0x1b, 0x69, 0x7b, 0x02, 0x8b, 0xa3, 0x3b,
+	0x62, 0x22, 0x10, 0xf5, 0xb8, 0x55, 0x3f, 0x65, 0x4c, 0x17, 0x12, 0xd1, 0xfb, 0x5a, 0x72, 0x72,
+	0xe6, 0x37, 0xce, 0x3c, 0xf3, 0x7f, 0x90, 0xaa, 0x93, 0xa4, 0x45, 0xa8, 0x9e, 0x5c, 0x19, 0x25,
+	0xff, 0x27, 0x31, 0xc3, 0xbc, 0x0d, 0x73, 0x5d, 0x82, 0x30, 0x09, 0x55, 0x1f, 0xae, 0x6e, 0x34,
+	0xa7, 0x99, 0x7c, 0xa4, 0x50, 0x76, 0x84, 0x6e, 0xfd, 0xa7, 0x08, 0x97, 0xee, 0x62, 0x7c, 0xc6,
+	0x05, 0xf1, 0x67, 0x50, 0xf9, 0x06, 0x35, 0x3c, 0x92, 0x35, 0x37, 0xa3, 0xa6, 0xa1, 0x67, 0x65,
+	0xf1, 0x0d, 0x66, 0x65, 0x45, 0xc4, 0x7f, 0xca, 0x06, 0x90, 0xd4, 0x4a, 0xb2, 0x18, 0x41, 0x4c,
+	0xea, 0xe0, 0xf1, 0x62, 0x8a, 0x32, 0x7c, 0x2c, 0x33, 0x4b, 0xe3, 0xc5, 0xa4, 0x36, 0xaa, 0xb1,
+	0xfc, 0x9c, 0xec, 0x95, 0x73, 0x39, 0xbd, 0xd2, 0xfc, 0x29, 0xcc, 0x71, 0xd6, 0x0f, 0x5d, 0xa2,
+	0x4a, 0xb4, 0xb1, 0xb1, 0x96, 0x3b, 0xfa, 0xd4, 0x27, 0x42, 0xfc, 0xaa, 0x6d, 0x85, 0xb7, 0x23,
+	0x39, 0x39, 0xd1, 0x82, 0x90, 0xb2, 0x90, 0x8a, 0xa1, 0x2a, 0xd6, 0x92, 0x9d, 0x9c, 0x65, 0x80,
+	0xf6, 0x10, 0x0d, 0x7d, 0xc2, 0xb9, 0x23, 0x47, 0x59, 0x45, 0x07, 0x28, 0xa6, 0x7d, 0x4c, 0x86,
+	0xe6, 0x32, 0xcc, 0xef, 0xf6, 0xa9, 0x87, 0xa5, 0x3f, 0x40, 0xb1, 0xcb, 0xea, 0xdc, 0xc1, 0xad,
+	0x65, 0x58, 0x9a, 0x08, 0xbc, 0x6e, 0xe1, 0xad, 0xdf, 0xcd, 0xaa, 0xa4, 0x38, 0xe3, 0x10, 0x3b,
+	0xb7, 0xa4, 0x68, 0xc3, 0x45, 0xed, 0x05, 0x27, 0x63, 0x52, 0x37, 0xf6, 0x45, 0xcd, 0xfa, 0x24,
+	0x65, 0x38, 0x9b, 0x44, 0xb3, 0xe7, 0x92, 0x44, 0xa5, 0xb3, 0x25, 0xd1, 0xdc, 0xd9, 0x92, 0xa8,
+	0x7c, 0x7c, 0x12, 0xcd, 0x9f, 0x43, 0x12, 0x55, 0x4e, 0x48, 0x22, 0x98, 0x48, 0xa2, 0x28, 0x53,
+	0xf2, 0x86, 0x7d, 0xeb, 0xcb, 0x22, 0xbc, 0xa5, 0xf6, 0xa0, 0x38, 0x90, 0x6f, 0x90, 0x27, 0xd9,
+	0x70, 0x15, 0xce, 0x16, 0xae, 0x67, 0x50, 0x57, 0x8b, 0xd9, 0xd8, 0x56, 0x74, 0xeb, 0xc4, 0xad,
+	0x28, 0xef, 0xd6, 0x76, 0x4d, 0xe9, 0x7a, 0xb3, 0x85, 0x28, 0xb3, 0x74, 0x96, 0xb2, 0x4b, 0xe7,
+	0xe4, 0x40, 0x98, 0x3b, 0xf3, 0x40, 0x48, 0x97, 0x72, 0x39, 0x5b, 0xca, 0x7f, 0x35, 0xe0, 0x3b,
+	0x63, 0xef, 0x89, 0x96, 0xb1, 0x4d, 0xa8, 0xc5, 0xee, 0xe1, 0x7d, 0x4f, 0x58, 0xc6, 0x29, 0x47,
+	0x5b, 0x35, 0x72, 0x84, 0x14, 0x32, 0x3f, 0x86, 0x46, 0xac, 0xe4, 0x0b, 0xe2, 0x0a, 0x82, 0x4f,
+	0x58, 0x90, 0xf5, 0x62, 0x1c, 0x61, 0xed, 0xfa, 0xf3, 0xf4, 0xb1, 0xf5, 0x87, 0x02, 0xac, 0xea,
+	0xeb, 0x61, 0x85, 0x93, 0x51, 0xdd, 0x64, 0xbd, 0xc0, 0x23, 0x12, 0xfc, 0x3f, 0xce, 0x9e, 0x25,
+	0x28, 0x2b, 0x25, 0x49, 0x57, 0x99, 0x93, 0xc7, 0x0e, 0x36, 0x7d, 0x58, 0x74, 0xe3, 0x4b, 0x25,
+	0xa9, 0xa5, 0x3b, 0xca, 0xdd, 0x13, 0x53, 0xeb, 0xa4, 0xe7, 0xd9, 0x0b, 0xee, 0x18, 0xa5, 0x75,
+	0x1d, 0xae, 0x1d, 0x23, 0x15, 0x15, 0xdb, 0xbf, 0x0d, 0x78, 0x7b, 0x13, 0xf9, 0x2e, 0xf1, 0x3e,
+	0xed, 0x0b, 0x2e, 0x90, 0x8f, 0xa9, 0xbf, 0xbf, 0x95, 0xda, 0xe0, 0x4f, 0xe1, 0xb6, 0xc7, 0x70,
+	0x61, 0xe4, 0x36, 0x9d, 0x92, 0x05, 0xd5, 0x55, 0xc6, 0x7c, 0x97, 0x69, 0x27, 0xca, 0x59, 0x2a,
+	0x25, 0xeb, 0x22, 0x7d, 0x3c, 0x9f, 0xb1, 0x9d, 0xf9, 0xe8, 0x99, 0xcd, 0x7e, 0xf4, 0xb4, 0xae,
+	0xc2, 0x95, 0x29, 0x4f, 0x8e, 0x9c, 0xf2, 0x67, 0x03, 0xac, 0xfb, 0x84, 0xbb, 0x21, 0xdd, 0x25,
+	0x23, 0xf5, 0xa7, 0x77, 0xc8, 0x2f, 0xa1, 0x86, 0x09, 0x77, 0x93, 0x20, 0xeb, 0x4c, 0xfa, 0xf0,
+	0xc4, 0x20, 0x4f, 0xb3, 0x69, 0x57, 0xa5, 0xba, 0x38, 0xae, 0x7f, 0x2c, 0xc0, 0x72, 0x0e, 0x32,
+	0xaa, 0xce, 0x9f, 0x40, 0x59, 0x3f, 0x94, 0x5b, 0x86, 0xfa, 0x34, 0x7e, 0xf7, 0x18, 0xdf, 0x6d,
+	0x69, 0x97, 0xc8, 0x9f, 0x1e, 0x62, 0x29, 0xf3, 0x33, 0x58, 0x4c, 0x45, 0x93, 0x0b, 0x24, 0xfa,
+	0x3c, 0x7a, 0xc1, 0xf7, 0x4e, 0x13, 0x86, 0x6d, 0x25, 0x61, 0x5f, 0x10, 0x59, 0x82, 0xf9, 0x39,
+	0x2c, 0x8c, 0xe9, 0xe5, 0x51, 0x74, 0x6f, 0x9f, 0xf6, 0xe3, 0x3d, 0x63, 0x83, 0xdb, 0x8d, 0x8c,
+	0x09, 0xde, 0xfa, 0xd2, 0x80, 0xe6, 0x63, 0xca, 0x45, 0x02, 0xdb, 0x42, 0xa1, 0xa0, 0x72, 0xec,
+	0xf3, 0x38, 0x78, 0x6f, 0x43, 0x65, 0xb4, 0xf8, 0xea, 0xc8, 0x8d, 0x08, 0xe7, 0x52, 0xff, 0xad,
+	0x3f, 0x15, 0xe0, 0xea, 0xd4, 0x5b, 0x44, 0x41, 0xfa, 0x35, 0x34, 0x47, 0x5f, 0x8d, 0x23, 0xa7,
+	0x04, 0x09, 0x32, 0x8a, 0xdd, 0xad, 0xd3, 0x18, 0x4f, 0xf4, 0x3f, 0x21, 0x02, 0x61, 0x24, 0x90,
+	0x7d, 0x19, 0xa5, 0xe6, 0xea, 0xd8, 0x1d, 0xa4, 0xed, 0xe4, 0x17, 0xab, 0x7c, 0xdb, 0x85, 0x6f,
+	0x64, 0x1b, 0xa7, 0xb6, 0xbf, 0x31, 0xdb, 0xad, 0x87, 0x50, 0xd7, 0x29, 0x77, 0x4f, 0x4f, 0x19,
+	0x39, 0xe7, 0x28, 0x26, 0xbe, 0x90, 0x5b, 0x84, 0x0e, 0x47, 0x72, 0xce, 0x0c, 0xa7, 0x42, 0x76,
+	0x38, 0xfd, 0xa3, 0x00, 0x8d, 0x6c, 0x32, 0xc8, 0x2f, 0x43, 0x14, 0x04, 0x21, 0x7b, 0x41, 0x7b,
+	0x48, 0x10, 0x27, 0xf3, 0xc3, 0xa1, 0x52, 0x5d, 0xb4, 0x97, 0x52, 0x80, 0x7b, 0xa9, 0x9f, 0x0f,
+	0xcd, 0x5b, 0xb0, 0xc4, 0x3c, 0x4c, 0xb8, 0xd0, 0x0e, 0x71, 0x43, 0x22, 0x55, 0x08, 0xda, 0xd3,
+	0x49, 0x50, 0xb4, 0xdf, 0xd2, 0x6c, 0xd5, 0x42, 0x15, 0x53, 0x6e, 0x5d, 0xf2, 0x82, 0x08, 0x63,
+	0x27, 0x44, 0x42, 0xf7, 0x29, 0xc3, 0x2e, 0x23, 0x8c, 0x6d, 0x24, 0x88, 0x79, 0x1d, 0xea, 0x98,
+	0xf2, 0x40, 0xa6, 0xb0, 0xe6, 0xcf, 0x2a, 0x7e, 0x2d, 0x26, 0x2a, 0xd0, 0x0d, 0xb8, 0xc0, 0x87,
+	0xbe, 0xeb, 0xf4, 0x46, 0xb0, 0x92, 0x82, 0xd5, 0x25, 0xf9, 0x49, 0x82, 0x73, 0x60, 0x21, 0x6a,
+	0x66, 0xb1, 0x3f, 0xe4, 0x32, 0x58, 0x9c, 0xfa, 0x33, 0xe3, 0x94, 0x9f, 0xbd, 0x12, 0xaf, 0xdb,
+	0x8d, 0x20, 0x7d, 0xe4, 0xf7, 0xc2, 0x97, 0xaf, 0x9a, 0x33, 0x5f, 0xbd, 0x6a, 0xce, 0x7c, 0xfd,
+	0xaa, 0x69, 0xfc, 0xe6, 0xa8, 0x69, 0xfc, 0xe5, 0xa8, 0x69, 0xfc, 0xed, 0xa8, 0x69, 0xbc, 0x3c,
+	0x6a, 0x1a, 0xff, 0x3c, 0x6a, 0x1a, 0xff, 0x3a, 0x6a, 0xce, 0x7c, 0x7d, 0xd4, 0x34, 0x7e, 0xff,
+	0xba, 0x39, 0xf3, 0xf2, 0x75, 0x73, 0xe6, 0xab, 0xd7, 0xcd, 0x99, 0x67, 0x3f, 0xda, 0x67, 0x23,
+	0xf3, 0x94, 0x1d, 0xff, 0x3f, 0x9a, 0x8f, 0xc6, 0x48, 0xbb, 0x73, 0xea, 0x1f, 0x0a, 0x3f, 0xfc,
+	0xef, 0x00, 0x33, 0x9d, 0x7c, 0xd8, 0xe4, 0x19, 0x00, 0x00,
 }
 
 func (this *PollForDecisionTaskRequest) Equal(that interface{}) bool {
@@ -1531,6 +1781,9 @@ func (this *PollForDecisionTaskResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Archived != that1.Archived {
+		return false
+	}
 	return true
 }
 func (this *PollForActivityTaskRequest) Equal(that interface{}) bool {
@@ -1675,6 +1928,15 @@ func (this *AddDecisionTaskRequest) Equal(that interface{}) bool {
 	if this.Source != that1.Source {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
 	return true
 }
 func (this *AddDecisionTaskResponse) Equal(that interface{}) bool {
@@ -1741,6 +2003,12 @@ func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
 	if this.Source != that1.Source {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *AddActivityTaskResponse) Equal(that interface{}) bool {
@@ -1795,6 +2063,15 @@ func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
 	if this.ForwardedFrom != that1.ForwardedFrom {
 		return false
 	}
+	if this.Archived != that1.Archived {
+		return false
+	}
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
 	return true
 }
 func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
@@ -1989,6 +2266,9 @@ func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
 	if !this.TaskQueueStatus.Equal(that1.TaskQueueStatus) {
 		return false
 	}
+	if !this.TaskQueueStats.Equal(that1.TaskQueueStats) {
+		return false
+	}
 	return true
 }
 func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
@@ -2053,6 +2333,83 @@ func (this *ListTaskQueuePartitionsResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ActivityTaskQueueWritePartitions != that1.ActivityTaskQueueWritePartitions {
+		return false
+	}
+	if this.DecisionTaskQueueWritePartitions != that1.DecisionTaskQueueWritePartitions {
+		return false
+	}
+	return true
+}
+func (this *PollerBuildId) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PollerBuildId)
+	if !ok {
+		that2, ok := that.(PollerBuildId)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	return true
+}
+func (this *TaskQueueStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskQueueStats)
+	if !ok {
+		that2, ok := that.(TaskQueueStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ApproximateBacklogCount != that1.ApproximateBacklogCount {
+		return false
+	}
+	if this.OldestTaskCreateTime != that1.OldestTaskCreateTime {
+		return false
+	}
+	if this.AddRate != that1.AddRate {
+		return false
+	}
+	if this.DispatchRate != that1.DispatchRate {
+		return false
+	}
+	if this.SyncMatchRate != that1.SyncMatchRate {
+		return false
+	}
+	if len(this.PollerBuildIds) != len(that1.PollerBuildIds) {
+		return false
+	}
+	for i := range this.PollerBuildIds {
+		if !this.PollerBuildIds[i].Equal(that1.PollerBuildIds[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *PollForDecisionTaskRequest) GoString() string {
@@ -2074,7 +2431,7 @@ func (this *PollForDecisionTaskResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 21)
+	s := make([]string, 0, 22)
 	s = append(s, "&matchingservice.PollForDecisionTaskResponse{")
 	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
 	if this.WorkflowExecution != nil {
@@ -2115,6 +2472,7 @@ func (this *PollForDecisionTaskResponse) GoString() string {
 	if this.Queries != nil {
 		s = append(s, "Queries: "+mapStringForQueries+",\n")
 	}
+	s = append(s, "Archived: "+fmt.Sprintf("%#v", this.Archived)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2174,7 +2532,7 @@ func (this *AddDecisionTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 14)
 	s = append(s, "&matchingservice.AddDecisionTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -2187,6 +2545,9 @@ func (this *AddDecisionTaskRequest) GoString() string {
 	s = append(s, "ScheduleToStartTimeoutSeconds: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeoutSeconds)+",\n")
 	s = append(s, "ForwardedFrom: "+fmt.Sprintf("%#v", this.ForwardedFrom)+",\n")
 	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2203,7 +2564,7 @@ func (this *AddActivityTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 14)
 	s = append(s, "&matchingservice.AddActivityTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -2217,6 +2578,8 @@ func (this *AddActivityTaskRequest) GoString() string {
 	s = append(s, "ScheduleToStartTimeoutSeconds: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeoutSeconds)+",\n")
 	s = append(s, "ForwardedFrom: "+fmt.Sprintf("%#v", this.ForwardedFrom)+",\n")
 	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2233,7 +2596,7 @@ func (this *QueryWorkflowRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 11)
 	s = append(s, "&matchingservice.QueryWorkflowRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.TaskQueue != nil {
@@ -2243,6 +2606,11 @@ func (this *QueryWorkflowRequest) GoString() string {
 		s = append(s, "QueryRequest: "+fmt.Sprintf("%#v", this.QueryRequest)+",\n")
 	}
 	s = append(s, "ForwardedFrom: "+fmt.Sprintf("%#v", this.ForwardedFrom)+",\n")
+	s = append(s, "Archived: "+fmt.Sprintf("%#v", this.Archived)+",\n")
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2328,7 +2696,7 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
 	if this.Pollers != nil {
 		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
@@ -2336,6 +2704,9 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this.TaskQueueStatus != nil {
 		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
 	}
+	if this.TaskQueueStats != nil {
+		s = append(s, "TaskQueueStats: "+fmt.Sprintf("%#v", this.TaskQueueStats)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2356,7 +2727,7 @@ func (this *ListTaskQueuePartitionsResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&matchingservice.ListTaskQueuePartitionsResponse{")
 	if this.ActivityTaskQueuePartitions != nil {
 		s = append(s, "ActivityTaskQueuePartitions: "+fmt.Sprintf("%#v", this.ActivityTaskQueuePartitions)+",\n")
@@ -2364,6 +2735,36 @@ func (this *ListTaskQueuePartitionsResponse) GoString() string {
 	if this.DecisionTaskQueuePartitions != nil {
 		s = append(s, "DecisionTaskQueuePartitions: "+fmt.Sprintf("%#v", this.DecisionTaskQueuePartitions)+",\n")
 	}
+	s = append(s, "ActivityTaskQueueWritePartitions: "+fmt.Sprintf("%#v", this.ActivityTaskQueueWritePartitions)+",\n")
+	s = append(s, "DecisionTaskQueueWritePartitions: "+fmt.Sprintf("%#v", this.DecisionTaskQueueWritePartitions)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PollerBuildId) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.PollerBuildId{")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskQueueStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&matchingservice.TaskQueueStats{")
+	s = append(s, "ApproximateBacklogCount: "+fmt.Sprintf("%#v", this.ApproximateBacklogCount)+",\n")
+	s = append(s, "OldestTaskCreateTime: "+fmt.Sprintf("%#v", this.OldestTaskCreateTime)+",\n")
+	s = append(s, "AddRate: "+fmt.Sprintf("%#v", this.AddRate)+",\n")
+	s = append(s, "DispatchRate: "+fmt.Sprintf("%#v", this.DispatchRate)+",\n")
+	s = append(s, "SyncMatchRate: "+fmt.Sprintf("%#v", this.SyncMatchRate)+",\n")
+	if this.PollerBuildIds != nil {
+		s = append(s, "PollerBuildIds: "+fmt.Sprintf("%#v", this.PollerBuildIds)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2451,6 +2852,18 @@ func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.Queries) > 0 {
 		for k := range m.Queries {
 			v := m.Queries[k]
@@ -2837,6 +3250,25 @@ func (m *AddDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Source != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
 		i--
@@ -2936,6 +3368,18 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Source != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
 		i--
@@ -3042,6 +3486,35 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.ForwardedFrom) > 0 {
 		i -= len(m.ForwardedFrom)
 		copy(dAtA[i:], m.ForwardedFrom)
@@ -3353,6 +3826,18 @@ func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.TaskQueueStats != nil {
+		{
+			size, err := m.TaskQueueStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TaskQueueStatus != nil {
 		{
 			size, err := m.TaskQueueStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -3444,6 +3929,16 @@ func (m *ListTaskQueuePartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.DecisionTaskQueueWritePartitions != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.DecisionTaskQueueWritePartitions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActivityTaskQueueWritePartitions != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ActivityTaskQueueWritePartitions))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.DecisionTaskQueuePartitions) > 0 {
 		for iNdEx := len(m.DecisionTaskQueuePartitions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3475,6 +3970,108 @@ func (m *ListTaskQueuePartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *PollerBuildId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollerBuildId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollerBuildId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskQueueStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskQueueStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskQueueStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PollerBuildIds) > 0 {
+		for iNdEx := len(m.PollerBuildIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PollerBuildIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SyncMatchRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SyncMatchRate))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.DispatchRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchRate))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.AddRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AddRate))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.OldestTaskCreateTime != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.OldestTaskCreateTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ApproximateBacklogCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ApproximateBacklogCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRequestResponse(v)
 	base := offset
@@ -3585,6 +4182,9 @@ func (m *PollForDecisionTaskResponse) Size() (n int) {
 			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
 		}
 	}
+	if m.Archived {
+		n += 3
+	}
 	return n
 }
 
@@ -3710,6 +4310,17 @@ func (m *AddDecisionTaskRequest) Size() (n int) {
 	if m.Source != 0 {
 		n += 1 + sovRequestResponse(uint64(m.Source))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -3757,6 +4368,13 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 	if m.Source != 0 {
 		n += 1 + sovRequestResponse(uint64(m.Source))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -3791,6 +4409,17 @@ func (m *QueryWorkflowRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Archived {
+		n += 2
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -3911,6 +4540,10 @@ func (m *DescribeTaskQueueResponse) Size() (n int) {
 		l = m.TaskQueueStatus.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.TaskQueueStats != nil {
+		l = m.TaskQueueStats.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -3949,6 +4582,59 @@ func (m *ListTaskQueuePartitionsResponse) Size() (n int) {
 			n += 1 + l + sovRequestResponse(uint64(l))
 		}
 	}
+	if m.ActivityTaskQueueWritePartitions != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ActivityTaskQueueWritePartitions))
+	}
+	if m.DecisionTaskQueueWritePartitions != 0 {
+		n += 1 + sovRequestResponse(uint64(m.DecisionTaskQueueWritePartitions))
+	}
+	return n
+}
+
+func (m *PollerBuildId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskQueueStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApproximateBacklogCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ApproximateBacklogCount))
+	}
+	if m.OldestTaskCreateTime != 0 {
+		n += 1 + sovRequestResponse(uint64(m.OldestTaskCreateTime))
+	}
+	if m.AddRate != 0 {
+		n += 9
+	}
+	if m.DispatchRate != 0 {
+		n += 9
+	}
+	if m.SyncMatchRate != 0 {
+		n += 9
+	}
+	if len(m.PollerBuildIds) > 0 {
+		for _, e := range m.PollerBuildIds {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4003,6 +4689,7 @@ func (this *PollForDecisionTaskResponse) String() string {
 		`ScheduledTimestamp:` + fmt.Sprintf("%v", this.ScheduledTimestamp) + `,`,
 		`StartedTimestamp:` + fmt.Sprintf("%v", this.StartedTimestamp) + `,`,
 		`Queries:` + mapStringForQueries + `,`,
+		`Archived:` + fmt.Sprintf("%v", this.Archived) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4057,6 +4744,9 @@ func (this *AddDecisionTaskRequest) String() string {
 		`ScheduleToStartTimeoutSeconds:` + fmt.Sprintf("%v", this.ScheduleToStartTimeoutSeconds) + `,`,
 		`ForwardedFrom:` + fmt.Sprintf("%v", this.ForwardedFrom) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4083,6 +4773,8 @@ func (this *AddActivityTaskRequest) String() string {
 		`ScheduleToStartTimeoutSeconds:` + fmt.Sprintf("%v", this.ScheduleToStartTimeoutSeconds) + `,`,
 		`ForwardedFrom:` + fmt.Sprintf("%v", this.ForwardedFrom) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4105,6 +4797,9 @@ func (this *QueryWorkflowRequest) String() string {
 		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
 		`QueryRequest:` + strings.Replace(fmt.Sprintf("%v", this.QueryRequest), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
 		`ForwardedFrom:` + fmt.Sprintf("%v", this.ForwardedFrom) + `,`,
+		`Archived:` + fmt.Sprintf("%v", this.Archived) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4187,6 +4882,7 @@ func (this *DescribeTaskQueueResponse) String() string {
 	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
 		`Pollers:` + repeatedStringForPollers + `,`,
 		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
+		`TaskQueueStats:` + strings.Replace(this.TaskQueueStats.String(), "TaskQueueStats", "TaskQueueStats", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4219,6 +4915,39 @@ func (this *ListTaskQueuePartitionsResponse) String() string {
 	s := strings.Join([]string{`&ListTaskQueuePartitionsResponse{`,
 		`ActivityTaskQueuePartitions:` + repeatedStringForActivityTaskQueuePartitions + `,`,
 		`DecisionTaskQueuePartitions:` + repeatedStringForDecisionTaskQueuePartitions + `,`,
+		`ActivityTaskQueueWritePartitions:` + fmt.Sprintf("%v", this.ActivityTaskQueueWritePartitions) + `,`,
+		`DecisionTaskQueueWritePartitions:` + fmt.Sprintf("%v", this.DecisionTaskQueueWritePartitions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollerBuildId) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollerBuildId{`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskQueueStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPollerBuildIds := "[]*PollerBuildId{"
+	for _, f := range this.PollerBuildIds {
+		repeatedStringForPollerBuildIds += strings.Replace(f.String(), "PollerBuildId", "PollerBuildId", 1) + ","
+	}
+	repeatedStringForPollerBuildIds += "}"
+	s := strings.Join([]string{`&TaskQueueStats{`,
+		`ApproximateBacklogCount:` + fmt.Sprintf("%v", this.ApproximateBacklogCount) + `,`,
+		`OldestTaskCreateTime:` + fmt.Sprintf("%v", this.OldestTaskCreateTime) + `,`,
+		`AddRate:` + fmt.Sprintf("%v", this.AddRate) + `,`,
+		`DispatchRate:` + fmt.Sprintf("%v", this.DispatchRate) + `,`,
+		`SyncMatchRate:` + fmt.Sprintf("%v", this.SyncMatchRate) + `,`,
+		`PollerBuildIds:` + repeatedStringForPollerBuildIds + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4994,6 +5723,26 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archived = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -5925,24 +6674,107 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			if skippy < 0 {
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -6256,6 +7088,57 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6498,6 +7381,94 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archived = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v11.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7334,6 +8305,42 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueueStats == nil {
+				m.TaskQueueStats = &TaskQueueStats{}
+			}
+			if err := m.TaskQueueStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7479,6 +8486,281 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PollerBuildId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollerBuildId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollerBuildId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskQueueStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueueStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueueStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateBacklogCount", wireType)
+			}
+			m.ApproximateBacklogCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateBacklogCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestTaskCreateTime", wireType)
+			}
+			m.OldestTaskCreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestTaskCreateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AddRate = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchRate = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatchRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SyncMatchRate = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerBuildIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerBuildIds = append(m.PollerBuildIds, &PollerBuildId{})
+			if err := m.PollerBuildIds[len(m.PollerBuildIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7576,6 +8858,44 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskQueueWritePartitions", wireType)
+			}
+			m.ActivityTaskQueueWritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivityTaskQueueWritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskQueueWritePartitions", wireType)
+			}
+			m.DecisionTaskQueueWritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTaskQueueWritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
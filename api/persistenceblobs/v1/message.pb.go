@@ -408,6 +408,7 @@ type ShardInfo struct {
 	ClusterTimerAckLevel         map[string]*types.Timestamp `protobuf:"bytes,11,rep,name=cluster_timer_ack_level,json=clusterTimerAckLevel,proto3" json:"cluster_timer_ack_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	ClusterReplicationLevel      map[string]int64            `protobuf:"bytes,12,rep,name=cluster_replication_level,json=clusterReplicationLevel,proto3" json:"cluster_replication_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	ReplicationDlqAckLevel       map[string]int64            `protobuf:"bytes,13,rep,name=replication_dlq_ack_level,json=replicationDlqAckLevel,proto3" json:"replication_dlq_ack_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	HandingOff                   bool                        `protobuf:"varint,14,opt,name=handing_off,json=handingOff,proto3" json:"handing_off,omitempty"`
 }
 
 func (m *ShardInfo) Reset()      { *m = ShardInfo{} }
@@ -533,6 +534,13 @@ func (m *ShardInfo) GetReplicationDlqAckLevel() map[string]int64 {
 	return nil
 }
 
+func (m *ShardInfo) GetHandingOff() bool {
+	if m != nil {
+		return m.HandingOff
+	}
+	return false
+}
+
 type ReplicationTaskInfo struct {
 	NamespaceId             string                          `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId              string                          `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -1225,6 +1233,8 @@ type TaskInfo struct {
 	ScheduleId  int64            `protobuf:"varint,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	CreatedTime *types.Timestamp `protobuf:"bytes,5,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
 	Expiry      *types.Timestamp `protobuf:"bytes,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Priority    int32            `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessKey string           `protobuf:"bytes,8,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *TaskInfo) Reset()      { *m = TaskInfo{} }
@@ -1301,6 +1311,20 @@ func (m *TaskInfo) GetExpiry() *types.Timestamp {
 	return nil
 }
 
+func (m *TaskInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *TaskInfo) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type AllocatedTaskInfo struct {
 	Data   *TaskInfo `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	TaskId int64     `protobuf:"varint,8,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -1353,13 +1377,16 @@ func (m *AllocatedTaskInfo) GetTaskId() int64 {
 }
 
 type TaskQueueInfo struct {
-	NamespaceId string            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Name        string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TaskType    v14.TaskQueueType `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_type,omitempty"`
-	Kind        v14.TaskQueueKind `protobuf:"varint,5,opt,name=kind,proto3,enum=temporal.api.enums.v1.TaskQueueKind" json:"kind,omitempty"`
-	AckLevel    int64             `protobuf:"varint,6,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
-	Expiry      *types.Timestamp  `protobuf:"bytes,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	LastUpdated *types.Timestamp  `protobuf:"bytes,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	NamespaceId     string                  `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Name            string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TaskType        v14.TaskQueueType       `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_type,omitempty"`
+	Kind            v14.TaskQueueKind       `protobuf:"varint,5,opt,name=kind,proto3,enum=temporal.api.enums.v1.TaskQueueKind" json:"kind,omitempty"`
+	AckLevel        int64                   `protobuf:"varint,6,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
+	Expiry          *types.Timestamp        `protobuf:"bytes,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	LastUpdated     *types.Timestamp        `protobuf:"bytes,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	BacklogLanes    []*TaskQueueBacklogLane `protobuf:"bytes,9,rep,name=backlog_lanes,json=backlogLanes,proto3" json:"backlog_lanes,omitempty"`
+	ReadPartitions  int32                   `protobuf:"varint,10,opt,name=read_partitions,json=readPartitions,proto3" json:"read_partitions,omitempty"`
+	WritePartitions int32                   `protobuf:"varint,11,opt,name=write_partitions,json=writePartitions,proto3" json:"write_partitions,omitempty"`
 }
 
 func (m *TaskQueueInfo) Reset()      { *m = TaskQueueInfo{} }
@@ -1443,6 +1470,27 @@ func (m *TaskQueueInfo) GetLastUpdated() *types.Timestamp {
 	return nil
 }
 
+func (m *TaskQueueInfo) GetBacklogLanes() []*TaskQueueBacklogLane {
+	if m != nil {
+		return m.BacklogLanes
+	}
+	return nil
+}
+
+func (m *TaskQueueInfo) GetReadPartitions() int32 {
+	if m != nil {
+		return m.ReadPartitions
+	}
+	return 0
+}
+
+func (m *TaskQueueInfo) GetWritePartitions() int32 {
+	if m != nil {
+		return m.WritePartitions
+	}
+	return 0
+}
+
 type SignalInfo struct {
 	Version               int64         `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	InitiatedEventBatchId int64         `protobuf:"varint,2,opt,name=initiated_event_batch_id,json=initiatedEventBatchId,proto3" json:"initiated_event_batch_id,omitempty"`
@@ -2794,6 +2842,57 @@ func (m *ReplicationVersions) GetLastWriteVersion() *types.Int64Value {
 	return nil
 }
 
+type TaskQueueBacklogLane struct {
+	Priority       int32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	FairnessBucket int32 `protobuf:"varint,2,opt,name=fairness_bucket,json=fairnessBucket,proto3" json:"fairness_bucket,omitempty"`
+}
+
+func (m *TaskQueueBacklogLane) Reset()      { *m = TaskQueueBacklogLane{} }
+func (*TaskQueueBacklogLane) ProtoMessage() {}
+func (*TaskQueueBacklogLane) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef806e155800e59a, []int{25}
+}
+func (m *TaskQueueBacklogLane) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueueBacklogLane) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueueBacklogLane.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueueBacklogLane) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueueBacklogLane.Merge(m, src)
+}
+func (m *TaskQueueBacklogLane) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueueBacklogLane) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueueBacklogLane.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueueBacklogLane proto.InternalMessageInfo
+
+func (m *TaskQueueBacklogLane) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *TaskQueueBacklogLane) GetFairnessBucket() int32 {
+	if m != nil {
+		return m.FairnessBucket
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ImmutableClusterMetadata)(nil), "temporal.server.api.persistenceblobs.v1.ImmutableClusterMetadata")
 	proto.RegisterType((*ActivityInfo)(nil), "temporal.server.api.persistenceblobs.v1.ActivityInfo")
@@ -2829,6 +2928,7 @@ func init() {
 	proto.RegisterType((*ReplicationData)(nil), "temporal.server.api.persistenceblobs.v1.ReplicationData")
 	proto.RegisterMapType((map[string]*v13.ReplicationInfo)(nil), "temporal.server.api.persistenceblobs.v1.ReplicationData.LastReplicationInfoEntry")
 	proto.RegisterType((*ReplicationVersions)(nil), "temporal.server.api.persistenceblobs.v1.ReplicationVersions")
+	proto.RegisterType((*TaskQueueBacklogLane)(nil), "temporal.server.api.persistenceblobs.v1.TaskQueueBacklogLane")
 }
 
 func init() {
@@ -2836,266 +2936,273 @@ func init() {
 }
 
 var fileDescriptor_ef806e155800e59a = []byte{
-	// 4142 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4b, 0x6f, 0x1c, 0x47,
-	0x7a, 0x1a, 0xbe, 0xe7, 0x9b, 0x21, 0x39, 0x53, 0x7c, 0x35, 0x47, 0xd2, 0x90, 0x1a, 0x4b, 0x16,
-	0x65, 0x69, 0x47, 0x12, 0x2d, 0xcb, 0xb2, 0xb4, 0x76, 0x96, 0xa4, 0xa8, 0x68, 0x6c, 0x4b, 0xd6,
-	0x36, 0x69, 0x6b, 0x37, 0xd8, 0xa0, 0xd1, 0xec, 0x2e, 0x92, 0x0d, 0xf6, 0x74, 0x8f, 0xbb, 0x7b,
-	0x48, 0x73, 0x73, 0xd9, 0x20, 0x08, 0x92, 0x20, 0x01, 0x92, 0x9f, 0x10, 0x24, 0x08, 0x90, 0x3f,
-	0x10, 0x04, 0x08, 0x90, 0x63, 0x90, 0x1c, 0x0d, 0xe4, 0xb2, 0xc7, 0xb5, 0x8c, 0x00, 0xb9, 0x65,
-	0xaf, 0x41, 0x2e, 0x41, 0x7d, 0xf5, 0xe8, 0xea, 0x9e, 0xe6, 0x43, 0xf2, 0x6e, 0x02, 0xdf, 0x38,
-	0xdf, 0xab, 0xbe, 0xae, 0xfa, 0xea, 0x7b, 0x16, 0xe1, 0xbd, 0x84, 0x76, 0x7b, 0x61, 0x64, 0xfb,
-	0xb7, 0x63, 0x1a, 0x1d, 0xd2, 0xe8, 0xb6, 0xdd, 0xf3, 0x6e, 0xf7, 0x68, 0x14, 0x7b, 0x71, 0x42,
-	0x03, 0x87, 0xee, 0xf8, 0xe1, 0x4e, 0x7c, 0xfb, 0xf0, 0xee, 0xed, 0x2e, 0x8d, 0x63, 0x7b, 0x8f,
-	0xb6, 0x7b, 0x51, 0x98, 0x84, 0xe4, 0xba, 0x64, 0x6b, 0x73, 0xb6, 0xb6, 0xdd, 0xf3, 0xda, 0x79,
-	0xb6, 0xf6, 0xe1, 0xdd, 0xc6, 0xd2, 0x5e, 0x18, 0xee, 0xf9, 0xf4, 0x36, 0xb2, 0xed, 0xf4, 0x77,
-	0x6f, 0x27, 0x5e, 0x97, 0xc6, 0x89, 0xdd, 0xed, 0x71, 0x49, 0x8d, 0x66, 0x9e, 0xe0, 0x28, 0xb2,
-	0x7b, 0x4c, 0x92, 0xc0, 0x5f, 0x55, 0x0a, 0x32, 0xcd, 0x9c, 0xb0, 0xdb, 0x0d, 0x83, 0x01, 0x7d,
-	0x72, 0x54, 0x34, 0xe8, 0x77, 0x51, 0xe9, 0xa3, 0x30, 0x3a, 0xd8, 0xf5, 0xc3, 0x23, 0x41, 0x75,
-	0xad, 0x98, 0x2a, 0xb0, 0xbb, 0x34, 0xee, 0xd9, 0x8e, 0x14, 0x76, 0x3d, 0x43, 0xa6, 0xb0, 0x83,
-	0xab, 0xbe, 0x5d, 0x2c, 0x2f, 0xb1, 0xe3, 0x03, 0xeb, 0xcb, 0x3e, 0xed, 0xd3, 0xc2, 0x75, 0x77,
-	0x6d, 0xcf, 0xef, 0x47, 0x05, 0xe2, 0x6e, 0x14, 0x9d, 0x85, 0x92, 0xca, 0x3f, 0x5d, 0x90, 0xde,
-	0x3c, 0x95, 0x34, 0xf7, 0xd9, 0xd7, 0x4f, 0x25, 0x66, 0xda, 0x0a, 0xc2, 0x3b, 0x45, 0x84, 0x11,
-	0xed, 0xf9, 0x9e, 0x63, 0x27, 0x5e, 0xc1, 0xbe, 0xb7, 0xba, 0x60, 0x74, 0xba, 0xdd, 0x7e, 0x62,
-	0xef, 0xf8, 0x74, 0xc3, 0xef, 0xc7, 0x09, 0x8d, 0x9e, 0xd1, 0xc4, 0x76, 0xed, 0xc4, 0x26, 0x57,
-	0xa0, 0xea, 0x70, 0x90, 0xc5, 0xf6, 0xd0, 0x28, 0x2d, 0x97, 0x56, 0xca, 0x66, 0x45, 0xc0, 0x9e,
-	0xdb, 0x5d, 0x4a, 0xda, 0x30, 0xb3, 0xef, 0xc5, 0x49, 0x18, 0x1d, 0x5b, 0xf1, 0xbe, 0x1d, 0xb9,
-	0x96, 0x13, 0xf6, 0x83, 0xc4, 0x18, 0x5a, 0x2e, 0xad, 0x8c, 0x9a, 0x75, 0x81, 0xda, 0x62, 0x98,
-	0x0d, 0x86, 0x68, 0xfd, 0xe9, 0x14, 0x54, 0xd7, 0x9c, 0xc4, 0x3b, 0xf4, 0x92, 0xe3, 0x4e, 0xb0,
-	0x1b, 0x12, 0x03, 0xc6, 0x0f, 0x99, 0xd5, 0x85, 0x01, 0x8a, 0x1f, 0x36, 0xe5, 0x4f, 0xf2, 0x3e,
-	0x18, 0xb1, 0xb3, 0x4f, 0xdd, 0xbe, 0x4f, 0x5d, 0x8b, 0x1e, 0xd2, 0x20, 0xb1, 0x76, 0xec, 0xc4,
-	0xd9, 0xb7, 0x3c, 0x17, 0xe5, 0x0f, 0x9b, 0x73, 0x0a, 0xbf, 0xc9, 0xd0, 0xeb, 0x0c, 0xdb, 0x71,
-	0xc9, 0x75, 0x98, 0xce, 0x31, 0x1a, 0xc3, 0xcb, 0xa5, 0x95, 0xaa, 0x39, 0x95, 0xa5, 0x27, 0x0f,
-	0x06, 0x57, 0xa0, 0x81, 0x13, 0xba, 0x5e, 0xb0, 0x67, 0x8c, 0xe0, 0xb7, 0xce, 0x67, 0x39, 0x36,
-	0x05, 0x96, 0xdc, 0x81, 0xd9, 0x94, 0x93, 0x5d, 0x08, 0x2b, 0xb0, 0x83, 0x30, 0x36, 0x46, 0x51,
-	0x2f, 0xa2, 0x70, 0xdb, 0x5e, 0x97, 0x3e, 0x67, 0x18, 0x72, 0x19, 0x20, 0x4e, 0xec, 0x28, 0xa1,
-	0x2e, 0xd3, 0x7f, 0x0c, 0xe9, 0xca, 0x02, 0xd2, 0x71, 0xc9, 0x5b, 0x30, 0x29, 0xd1, 0x5c, 0xe3,
-	0x71, 0xd4, 0xb8, 0x2a, 0x80, 0x5c, 0xdf, 0x7b, 0x30, 0x9f, 0x21, 0x4a, 0xb5, 0x9d, 0x40, 0x6d,
-	0x67, 0x75, 0x6a, 0xa5, 0xeb, 0x2d, 0x20, 0x92, 0x4b, 0xd3, 0xb4, 0x8c, 0x1a, 0xd4, 0x04, 0x26,
-	0xd5, 0x73, 0x09, 0x2a, 0xb6, 0x38, 0x1f, 0xa6, 0x28, 0xa0, 0x60, 0x90, 0xa0, 0x8e, 0xcb, 0x3e,
-	0x24, 0xa2, 0x5f, 0xf6, 0x69, 0x9c, 0x30, 0x7c, 0x05, 0xf1, 0x65, 0x01, 0xe9, 0xb8, 0xe4, 0x29,
-	0x5c, 0x91, 0x5f, 0x6f, 0x25, 0xa1, 0x85, 0xf2, 0x71, 0xdd, 0xb0, 0x9f, 0x58, 0x31, 0x75, 0xc2,
-	0xc0, 0x8d, 0x8d, 0x2a, 0x9a, 0xc7, 0x65, 0x49, 0xb8, 0x1d, 0x6e, 0x31, 0xb2, 0x6d, 0x4e, 0xb5,
-	0xc5, 0x89, 0xf2, 0x92, 0x1c, 0x3f, 0x8c, 0xe9, 0x80, 0xa4, 0xc9, 0xbc, 0xa4, 0x0d, 0x46, 0x96,
-	0x93, 0xb4, 0x0e, 0x4d, 0xa1, 0xc7, 0x49, 0x62, 0xa6, 0x50, 0x4c, 0x03, 0xa9, 0x8a, 0x65, 0x3c,
-	0x84, 0xc5, 0x7d, 0x6a, 0x47, 0xc9, 0x0e, 0xb5, 0x07, 0xbf, 0x67, 0x1a, 0xd9, 0x17, 0x14, 0x41,
-	0x8e, 0xf7, 0x06, 0xd4, 0x1c, 0x3b, 0x70, 0xa8, 0x6f, 0x89, 0x7d, 0xa2, 0xae, 0x51, 0x5b, 0x2e,
-	0xad, 0x4c, 0x98, 0xd3, 0x1c, 0x6e, 0x4a, 0x30, 0x79, 0x07, 0xea, 0x59, 0x52, 0xb6, 0xc9, 0x75,
-	0x3c, 0xab, 0x2c, 0x6d, 0x07, 0x69, 0x99, 0x22, 0x91, 0x85, 0xee, 0x2a, 0x4e, 0xec, 0xa4, 0x1f,
-	0x1b, 0x04, 0x55, 0x99, 0x46, 0xc4, 0xb6, 0x1d, 0x1f, 0x6c, 0x21, 0x98, 0x5d, 0x33, 0x3b, 0x61,
-	0xce, 0x21, 0x31, 0x66, 0x90, 0x42, 0xfe, 0x64, 0xe7, 0x99, 0xba, 0x3b, 0x63, 0x96, 0x9f, 0x27,
-	0x83, 0xfc, 0x98, 0x01, 0x98, 0xee, 0xa9, 0xdd, 0xd2, 0x20, 0xf1, 0x92, 0x63, 0x63, 0x0e, 0x89,
-	0xa6, 0x95, 0xf5, 0x72, 0x30, 0x59, 0x81, 0xda, 0xbe, 0x1d, 0x5b, 0x11, 0x4d, 0xa2, 0x63, 0xab,
-	0x17, 0xfa, 0x9e, 0x73, 0x6c, 0xcc, 0xe3, 0x67, 0x4e, 0xed, 0xdb, 0xb1, 0xc9, 0xc0, 0x2f, 0x10,
-	0x4a, 0x36, 0xa0, 0xc9, 0xa9, 0xbc, 0xc0, 0x4b, 0x3c, 0xdb, 0xb7, 0xbc, 0x20, 0xa1, 0xd1, 0xa1,
-	0xed, 0xab, 0x1d, 0x5d, 0x40, 0x25, 0x2f, 0x22, 0x55, 0x87, 0x13, 0x75, 0x04, 0x8d, 0xdc, 0x55,
-	0x25, 0xa4, 0x6b, 0x7f, 0xe5, 0x75, 0xfb, 0xdd, 0x41, 0x21, 0x86, 0x26, 0xe4, 0x19, 0x27, 0xca,
-	0x0b, 0xb9, 0x07, 0xf3, 0x59, 0x21, 0x62, 0x5b, 0x62, 0x63, 0x11, 0x99, 0x67, 0x75, 0xe6, 0x35,
-	0x81, 0x23, 0x1f, 0x02, 0x17, 0x6a, 0xd1, 0xaf, 0x7a, 0x5e, 0x84, 0x9e, 0x55, 0xbf, 0x5b, 0x0d,
-	0x3c, 0x2f, 0x03, 0x49, 0x36, 0x15, 0x45, 0x7a, 0xc7, 0x1e, 0xc2, 0x22, 0x67, 0xdf, 0xb1, 0x9d,
-	0x83, 0x70, 0x77, 0xd7, 0x72, 0x42, 0xba, 0xbb, 0xeb, 0x39, 0x1e, 0xbb, 0xf8, 0x17, 0x97, 0x4b,
-	0x2b, 0x25, 0x73, 0x01, 0x09, 0xd6, 0x39, 0x7e, 0x23, 0x45, 0x93, 0xc7, 0xb0, 0xc4, 0x79, 0x83,
-	0x30, 0xe0, 0x5b, 0xcd, 0x3c, 0xb7, 0x45, 0xa3, 0x28, 0x8c, 0xac, 0xe4, 0xb8, 0x47, 0x63, 0xe3,
-	0xd2, 0xf2, 0xf0, 0x4a, 0x59, 0x7c, 0xf6, 0xf3, 0x30, 0x30, 0x25, 0xd1, 0x26, 0xa3, 0xd9, 0x66,
-	0x24, 0xe4, 0x39, 0x10, 0x2e, 0xc5, 0xb7, 0xe3, 0xc4, 0x12, 0xf1, 0xcc, 0xb8, 0xbc, 0x5c, 0x5a,
-	0xa9, 0xac, 0x2e, 0xb7, 0x55, 0x6a, 0xc0, 0x72, 0x02, 0x81, 0x6c, 0x1f, 0xde, 0x6d, 0x3f, 0xe1,
-	0x7f, 0x9a, 0x35, 0xe4, 0xfd, 0xd4, 0x8e, 0x13, 0x01, 0x21, 0x8f, 0xa0, 0xa1, 0xc9, 0x63, 0xd1,
-	0x8b, 0x46, 0xa9, 0xbd, 0x34, 0xd1, 0x5e, 0x16, 0x14, 0xd7, 0x4b, 0xc4, 0x2b, 0xbb, 0xb9, 0x02,
-	0x55, 0x15, 0xa2, 0x99, 0xb9, 0x2f, 0xf1, 0x30, 0xa3, 0x60, 0x1d, 0x97, 0x79, 0x25, 0xe5, 0x0b,
-	0x3c, 0xd7, 0x58, 0xc6, 0x0d, 0x06, 0x09, 0xea, 0xb8, 0xe4, 0x0b, 0x98, 0xc7, 0xa5, 0xd3, 0x3b,
-	0xea, 0xd2, 0xc4, 0xf6, 0xfc, 0xd8, 0xb8, 0x52, 0xf4, 0x51, 0x22, 0x14, 0x1f, 0xde, 0x6d, 0xbf,
-	0xb0, 0x8f, 0xfd, 0xd0, 0x76, 0x63, 0x73, 0x96, 0xf1, 0x3f, 0x95, 0xec, 0x8f, 0x39, 0x37, 0xf9,
-	0x29, 0x5c, 0xcc, 0xc9, 0xed, 0xf7, 0x5c, 0x5b, 0xfa, 0x52, 0xa3, 0x85, 0xc2, 0x1b, 0x6d, 0x9e,
-	0x02, 0xb5, 0x65, 0x0a, 0xd4, 0xde, 0x96, 0x39, 0x92, 0x69, 0x64, 0xc4, 0x7e, 0xce, 0x99, 0x19,
-	0xba, 0xf5, 0xb7, 0x00, 0x65, 0x8c, 0x8c, 0x18, 0x07, 0x17, 0x61, 0x82, 0x07, 0x50, 0xcf, 0xc5,
-	0x40, 0x38, 0x6a, 0x8e, 0xe3, 0xef, 0x8e, 0xcb, 0x50, 0x91, 0x1d, 0xec, 0xd1, 0x34, 0xf0, 0x8d,
-	0xe3, 0xef, 0x8e, 0x4b, 0x66, 0x61, 0x34, 0x3c, 0x0a, 0x68, 0x84, 0x01, 0xae, 0x6c, 0xf2, 0x1f,
-	0x64, 0x15, 0xe6, 0xb4, 0x98, 0x6f, 0xd9, 0xce, 0x81, 0xe5, 0xd3, 0x43, 0xea, 0x63, 0x50, 0x1b,
-	0x36, 0x67, 0x34, 0xe4, 0x9a, 0x73, 0xf0, 0x29, 0x43, 0xb1, 0x28, 0x91, 0x44, 0x76, 0x10, 0xef,
-	0xd2, 0x48, 0x63, 0xe0, 0xf1, 0xac, 0x26, 0x31, 0x3a, 0x75, 0x9c, 0x84, 0x3e, 0x0d, 0xac, 0xd8,
-	0x0b, 0x1c, 0x6a, 0x45, 0x34, 0xa0, 0x47, 0x18, 0xd5, 0x46, 0x59, 0x4c, 0x61, 0x98, 0x2d, 0x86,
-	0x30, 0x19, 0x9c, 0x7c, 0x00, 0x20, 0x77, 0xcd, 0xe6, 0x91, 0xed, 0xf4, 0x3d, 0x2b, 0x0b, 0xea,
-	0xb5, 0x84, 0xac, 0x03, 0x77, 0x65, 0x9a, 0x4e, 0x13, 0x67, 0xf2, 0x4f, 0x22, 0x8b, 0x52, 0xf6,
-	0x31, 0x34, 0x53, 0xfb, 0x0a, 0xc2, 0xc4, 0xdb, 0x95, 0x3b, 0x23, 0x33, 0x0f, 0x1e, 0x0c, 0x2f,
-	0x29, 0xaa, 0xe7, 0x1a, 0xd1, 0x17, 0x22, 0x1d, 0xf9, 0x8b, 0x12, 0x34, 0x64, 0x36, 0x54, 0xb0,
-	0x53, 0xb0, 0x3c, 0xbc, 0x52, 0x59, 0xfd, 0xac, 0x7d, 0xce, 0xb4, 0xba, 0xad, 0x4e, 0xbe, 0x2d,
-	0xb2, 0xae, 0xed, 0xdc, 0x1e, 0x6f, 0x06, 0x49, 0x74, 0x6c, 0x2e, 0x38, 0xc5, 0x58, 0xf2, 0x47,
-	0x25, 0x58, 0x50, 0xea, 0xe4, 0x76, 0xa8, 0x82, 0xba, 0x7c, 0xfa, 0x1d, 0x74, 0xd1, 0xf7, 0x8f,
-	0x2b, 0x32, 0xeb, 0x14, 0xa0, 0xc8, 0x9f, 0x97, 0x60, 0x51, 0x6a, 0xa1, 0x9b, 0x1c, 0xd7, 0xa3,
-	0xfa, 0x5d, 0xf7, 0xc4, 0x4c, 0x45, 0x16, 0xec, 0x49, 0x1e, 0x4b, 0xfe, 0xac, 0xc4, 0x1c, 0x6b,
-	0xaa, 0x85, 0xeb, 0x7f, 0xa9, 0xed, 0xca, 0x24, 0x6a, 0xf3, 0xfc, 0x0d, 0xb4, 0xd1, 0x16, 0x7a,
-	0xec, 0x7f, 0x99, 0xdd, 0x97, 0xf9, 0xa8, 0x10, 0xd9, 0xf8, 0x18, 0x2e, 0x9d, 0x76, 0xb0, 0xa4,
-	0x06, 0xc3, 0x07, 0xf4, 0x58, 0xa4, 0xd4, 0xec, 0x4f, 0x76, 0x97, 0x0f, 0x6d, 0xbf, 0x4f, 0xc5,
-	0x1d, 0xe7, 0x3f, 0x1e, 0x0e, 0x3d, 0x28, 0x35, 0x1c, 0x58, 0x3c, 0xf1, 0x60, 0x0a, 0x04, 0xdd,
-	0xd1, 0x05, 0x9d, 0x7e, 0x53, 0xb4, 0x45, 0x52, 0x85, 0x0b, 0x77, 0xfd, 0xb5, 0x14, 0xee, 0xc0,
-	0xc5, 0x53, 0xf6, 0xec, 0x75, 0x44, 0xb5, 0xfe, 0x75, 0x0c, 0x66, 0x34, 0x59, 0x2c, 0xa5, 0x41,
-	0x7f, 0x99, 0x0f, 0x1a, 0xa5, 0xc2, 0xa0, 0x21, 0xeb, 0x28, 0xe9, 0x3a, 0xcb, 0x26, 0x48, 0x50,
-	0xc7, 0x25, 0x73, 0x30, 0x16, 0xf5, 0x03, 0x86, 0x13, 0xee, 0x33, 0xea, 0x07, 0x1d, 0x97, 0x6c,
-	0x00, 0xe6, 0x3f, 0x18, 0x4d, 0xd1, 0x65, 0x4e, 0xad, 0xbe, 0x5d, 0x68, 0x35, 0x58, 0x81, 0x31,
-	0x53, 0x61, 0x5a, 0xb1, 0xc0, 0x6a, 0x4e, 0x24, 0xe2, 0x2f, 0xbd, 0xae, 0x19, 0xcd, 0xd6, 0x35,
-	0x57, 0x61, 0x6a, 0xd7, 0x8b, 0xe2, 0x44, 0xe4, 0xf0, 0xaa, 0x1a, 0xa8, 0x22, 0x14, 0x73, 0xf7,
-	0x8e, 0x4b, 0x5a, 0x30, 0x19, 0xd0, 0xaf, 0x34, 0xa2, 0x71, 0x24, 0xaa, 0x30, 0xa0, 0xa4, 0xb9,
-	0x02, 0xd5, 0xb4, 0x0a, 0xf1, 0x5c, 0xf4, 0x8c, 0xc3, 0xa6, 0x8a, 0x94, 0x2c, 0x76, 0xb4, 0x61,
-	0x86, 0x4b, 0x60, 0x85, 0x18, 0xcd, 0x38, 0xbc, 0x51, 0xb3, 0x8e, 0xa8, 0x2d, 0x86, 0x91, 0x5e,
-	0xee, 0x87, 0x70, 0x31, 0xa0, 0x47, 0x16, 0xdb, 0x96, 0x22, 0x3e, 0xe0, 0x89, 0x6e, 0x40, 0x8f,
-	0xcc, 0x7e, 0xb0, 0x39, 0xc0, 0x7d, 0x05, 0xaa, 0x3b, 0x91, 0x1d, 0x38, 0xfb, 0x56, 0x12, 0x1e,
-	0xd0, 0x00, 0xab, 0x83, 0xaa, 0x59, 0xe1, 0xb0, 0x6d, 0x06, 0x62, 0x77, 0x74, 0x0e, 0x23, 0xaa,
-	0x7e, 0x51, 0xbd, 0x60, 0x37, 0x14, 0xde, 0xe2, 0xf3, 0x73, 0xdf, 0xcf, 0x02, 0xab, 0x68, 0xb3,
-	0xec, 0x42, 0x83, 0x33, 0x18, 0xbf, 0xa6, 0x33, 0xfe, 0x20, 0x86, 0xdc, 0x86, 0x59, 0xf9, 0xb1,
-	0x19, 0xb5, 0x27, 0x51, 0xed, 0x3a, 0xff, 0xca, 0x75, 0x4d, 0xf9, 0x6b, 0x30, 0x15, 0xd1, 0x98,
-	0xf2, 0x0c, 0x87, 0x19, 0x11, 0x16, 0x0e, 0x13, 0xe6, 0x24, 0x42, 0x5f, 0x0a, 0x20, 0x59, 0x80,
-	0x71, 0x34, 0x20, 0xcf, 0xc5, 0xca, 0x60, 0xd8, 0x1c, 0x63, 0x3f, 0x3b, 0x6e, 0xe3, 0x0f, 0xc0,
-	0x38, 0x49, 0xc3, 0x82, 0x4b, 0xd1, 0xc9, 0xde, 0xe3, 0x77, 0x0b, 0x77, 0x46, 0xdb, 0xc6, 0xdc,
-	0xa6, 0x30, 0xd1, 0xfa, 0x4d, 0xfa, 0xbb, 0x11, 0x98, 0xdc, 0x96, 0x65, 0xc1, 0xf7, 0xe2, 0x0e,
-	0x6d, 0x42, 0x55, 0x56, 0x5a, 0x28, 0x67, 0x14, 0xe5, 0xb4, 0xb2, 0xa9, 0x5c, 0x2a, 0x80, 0x93,
-	0xa2, 0x8c, 0x4a, 0x92, 0xfe, 0x20, 0x14, 0xe6, 0xd4, 0x37, 0xc8, 0x8c, 0x1b, 0xe5, 0x8d, 0xa1,
-	0xbc, 0xbb, 0xa7, 0xeb, 0x25, 0x4f, 0x55, 0xe4, 0xe2, 0x28, 0x7e, 0xe6, 0x68, 0x10, 0xa8, 0xdf,
-	0xf8, 0xf1, 0xec, 0x8d, 0x67, 0x35, 0x94, 0xcc, 0x5e, 0x65, 0x15, 0xc6, 0xef, 0xaa, 0x6a, 0x54,
-	0x88, 0xd2, 0x82, 0xe5, 0x7a, 0xea, 0xc6, 0xf3, 0xac, 0x64, 0x9c, 0x8a, 0xdb, 0xae, 0x59, 0x15,
-	0xe8, 0x56, 0x45, 0x9e, 0xc1, 0xec, 0xa1, 0x17, 0x7b, 0x3b, 0x9e, 0xcf, 0x8a, 0x76, 0xd5, 0x9e,
-	0xc3, 0xdb, 0x77, 0xba, 0xfb, 0x9f, 0x49, 0xf9, 0x14, 0xb0, 0xf5, 0xab, 0x11, 0xa8, 0xc9, 0x98,
-	0xf5, 0xbd, 0x31, 0x95, 0x36, 0xcc, 0x24, 0x76, 0xb4, 0x47, 0x13, 0x2b, 0xa3, 0xe6, 0x28, 0x2e,
-	0x54, 0xe7, 0xa8, 0xe7, 0x9a, 0xb2, 0x2c, 0xdd, 0xe5, 0xf4, 0xba, 0xce, 0x63, 0x48, 0x5e, 0xe3,
-	0x98, 0x97, 0xa9, 0xe6, 0x2d, 0x98, 0x14, 0xd4, 0xe2, 0x03, 0xc6, 0xf9, 0xe7, 0x73, 0xa0, 0x89,
-	0x9f, 0x91, 0xad, 0xa3, 0x27, 0xf2, 0x75, 0xf4, 0x23, 0x68, 0x08, 0x11, 0xce, 0xbe, 0xe7, 0xbb,
-	0xe9, 0xb2, 0x61, 0xe0, 0x1f, 0xe3, 0x51, 0x4f, 0x98, 0x0b, 0x9c, 0x62, 0x83, 0x11, 0xc8, 0xd5,
-	0x3f, 0x0b, 0xfc, 0xe3, 0x7c, 0xf9, 0x03, 0x03, 0xe5, 0x8f, 0x66, 0x7b, 0x95, 0xac, 0xed, 0x69,
-	0x56, 0x53, 0x3d, 0x97, 0xd5, 0x4c, 0xbe, 0x91, 0xd5, 0x90, 0x9b, 0x50, 0x8f, 0xa8, 0x13, 0x46,
-	0xae, 0x95, 0x62, 0x85, 0x77, 0xac, 0x71, 0xc4, 0x17, 0x0a, 0xde, 0xea, 0x03, 0x79, 0xca, 0x5b,
-	0x83, 0xdc, 0xbb, 0x9a, 0xac, 0x9c, 0x21, 0x17, 0xa1, 0x2c, 0xdc, 0xb0, 0x32, 0xb0, 0x09, 0x0e,
-	0xe0, 0x47, 0xb0, 0x43, 0xf7, 0xbc, 0xc0, 0x0a, 0x42, 0x57, 0xab, 0x84, 0x2a, 0x08, 0x7c, 0x1e,
-	0xba, 0x6c, 0x17, 0x9a, 0x50, 0xa1, 0x81, 0xab, 0x28, 0x86, 0x79, 0x93, 0x8d, 0x06, 0x2e, 0xc7,
-	0xb7, 0xfe, 0xba, 0x04, 0x93, 0x99, 0x75, 0x71, 0x77, 0x22, 0xaa, 0x59, 0xf4, 0x18, 0xfb, 0xd9,
-	0x71, 0xb3, 0xba, 0x0c, 0xe5, 0x74, 0xf9, 0x29, 0x94, 0xed, 0xc0, 0xa1, 0x4c, 0x50, 0x6c, 0x0c,
-	0x63, 0xd8, 0x7a, 0x74, 0xee, 0xb0, 0x35, 0xf8, 0xe1, 0x66, 0x2a, 0xad, 0xf5, 0x8f, 0x25, 0x98,
-	0x16, 0x14, 0xdb, 0x4c, 0x13, 0x76, 0xf7, 0x5e, 0x42, 0x45, 0xea, 0xc2, 0xe2, 0x64, 0x09, 0x0f,
-	0xe8, 0xfe, 0x1b, 0x2e, 0x08, 0xe2, 0x2b, 0x98, 0xe0, 0xf7, 0xa1, 0xbc, 0x1b, 0x46, 0x07, 0xbc,
-	0x94, 0x3d, 0x3b, 0x59, 0x9c, 0x60, 0xc4, 0xec, 0x27, 0x21, 0x30, 0x82, 0xaa, 0xf0, 0x7b, 0x8c,
-	0x7f, 0xb7, 0xfe, 0xb9, 0x04, 0x65, 0x0c, 0x2f, 0x67, 0xb4, 0x75, 0xb3, 0x8d, 0xd0, 0xa1, 0x7c,
-	0x23, 0xf4, 0x11, 0x54, 0xb0, 0xa9, 0xc2, 0x4d, 0x12, 0x57, 0x38, 0x5d, 0x2b, 0xe0, 0xe4, 0xa8,
-	0xd7, 0x12, 0x54, 0xf4, 0x5e, 0x18, 0x2f, 0x77, 0xf1, 0x5a, 0x8a, 0x36, 0xd8, 0x22, 0x4c, 0xf0,
-	0x62, 0x49, 0xf9, 0x86, 0x71, 0xfc, 0xdd, 0x71, 0x5b, 0xff, 0x5d, 0x82, 0x89, 0xff, 0x0b, 0x77,
-	0x97, 0xbb, 0xcb, 0x23, 0x03, 0x77, 0xf9, 0x43, 0xa8, 0x3a, 0x11, 0x4d, 0x7b, 0x0c, 0xa3, 0x67,
-	0x6e, 0x41, 0x45, 0xd0, 0xe3, 0x1e, 0xac, 0xc2, 0x18, 0xdf, 0x11, 0xf4, 0x66, 0xa7, 0x33, 0x0a,
-	0xca, 0x56, 0x0c, 0xf5, 0x35, 0xdf, 0x0f, 0x1d, 0x14, 0x22, 0xf7, 0x60, 0x13, 0x46, 0x5c, 0x3b,
-	0xb1, 0x85, 0xbd, 0xdd, 0x3d, 0xb7, 0xbd, 0x49, 0x01, 0x26, 0xb2, 0xeb, 0x0e, 0x68, 0x42, 0x77,
-	0x40, 0xad, 0x7f, 0x1f, 0x82, 0xc9, 0x6d, 0xe9, 0x1f, 0xcf, 0xbb, 0xeb, 0x04, 0x46, 0x70, 0x14,
-	0xc1, 0xb7, 0x1b, 0xff, 0x26, 0x6b, 0x7a, 0x00, 0x19, 0xc6, 0x00, 0x72, 0xf5, 0xa4, 0x1c, 0x41,
-	0xae, 0x97, 0x0b, 0x1f, 0x0f, 0x60, 0xe4, 0xc0, 0x0b, 0x5c, 0x91, 0x61, 0x9c, 0xc9, 0xfd, 0x89,
-	0x17, 0xb8, 0x26, 0x72, 0x30, 0x47, 0x91, 0x96, 0x98, 0x3c, 0x91, 0x9f, 0xb0, 0x65, 0x79, 0x9c,
-	0x9e, 0xc5, 0xf8, 0x79, 0xcf, 0x82, 0x1d, 0x3f, 0xe6, 0xc7, 0xa2, 0x07, 0x72, 0x8e, 0x76, 0x47,
-	0x85, 0xd1, 0x8b, 0xce, 0x52, 0xeb, 0x4f, 0x86, 0x00, 0xb6, 0xbc, 0xbd, 0xc0, 0xf6, 0xcf, 0x1e,
-	0xaf, 0xf0, 0xee, 0x6b, 0x72, 0xe2, 0x78, 0x45, 0xe1, 0x33, 0xe3, 0x95, 0xec, 0x00, 0x60, 0x38,
-	0x3f, 0x00, 0x90, 0x27, 0x34, 0xa2, 0x9d, 0xd0, 0x7d, 0x18, 0xf5, 0x82, 0x5e, 0x3f, 0x11, 0xb6,
-	0x7c, 0x76, 0x33, 0x8e, 0x93, 0x33, 0xed, 0x9d, 0x30, 0x48, 0xa2, 0xd0, 0x17, 0xa1, 0x59, 0xfe,
-	0x64, 0xa6, 0x92, 0x6a, 0x9f, 0x56, 0x47, 0x0a, 0xd6, 0x71, 0x5b, 0xff, 0x50, 0x82, 0xba, 0x68,
-	0x96, 0x6f, 0x60, 0xe7, 0xfc, 0xb7, 0xb5, 0x21, 0x85, 0x3d, 0x7b, 0xbe, 0x2f, 0x03, 0x3d, 0xfb,
-	0xbc, 0xde, 0x23, 0x83, 0x7a, 0xff, 0x57, 0x09, 0xe6, 0x65, 0xf4, 0xdf, 0xfc, 0x8a, 0x3a, 0x7d,
-	0x96, 0xcc, 0x33, 0xff, 0x45, 0x71, 0x25, 0xbc, 0xea, 0xfa, 0x4a, 0x25, 0xb1, 0x12, 0x22, 0xd2,
-	0x95, 0x52, 0xf7, 0x33, 0xa4, 0xbb, 0x9f, 0x8f, 0x61, 0x94, 0x79, 0x47, 0x79, 0x51, 0xee, 0x9d,
-	0x2f, 0xf9, 0xcd, 0xea, 0x61, 0x72, 0x11, 0xe4, 0x09, 0x8c, 0x69, 0x9e, 0x76, 0x6a, 0xb5, 0x7d,
-	0xc2, 0xbd, 0x29, 0x94, 0xd2, 0x8f, 0x4d, 0xc1, 0xdd, 0xfa, 0x7a, 0x11, 0xe6, 0x06, 0x68, 0x0a,
-	0x3d, 0xc2, 0x87, 0x67, 0xfa, 0xe1, 0x8f, 0x06, 0xfc, 0x70, 0x1b, 0x66, 0x7a, 0x76, 0xc4, 0x8e,
-	0xb3, 0xc0, 0xb9, 0xd4, 0x39, 0x2a, 0x97, 0x1a, 0x0a, 0xfa, 0x41, 0xff, 0x5e, 0xe3, 0x98, 0x6c,
-	0x6a, 0x28, 0xa8, 0x33, 0xce, 0xbe, 0xc2, 0x81, 0x3c, 0x35, 0x3c, 0xfb, 0xd0, 0xc9, 0x07, 0xb0,
-	0xe8, 0x84, 0xdd, 0x9e, 0x4f, 0xb1, 0x1e, 0xce, 0x59, 0x1f, 0x6f, 0x20, 0xcc, 0xa7, 0x04, 0x19,
-	0xf3, 0xbb, 0x01, 0xb5, 0x3c, 0x2b, 0xde, 0x96, 0xaa, 0x39, 0x9d, 0xe3, 0x20, 0x0f, 0x0b, 0x56,
-	0x51, 0x33, 0x44, 0x9e, 0xd3, 0x2e, 0xe4, 0x78, 0xd4, 0x18, 0xf1, 0x8c, 0xfc, 0xf6, 0x16, 0x10,
-	0xb5, 0x5d, 0xcc, 0x11, 0xf3, 0x89, 0x71, 0x99, 0xef, 0x9a, 0xc4, 0x30, 0x5f, 0x8b, 0x63, 0xe3,
-	0x4f, 0xa0, 0xa5, 0xa8, 0xa9, 0x3c, 0xf1, 0x81, 0xb1, 0x1a, 0xef, 0x36, 0x2c, 0x1d, 0xe5, 0x4d,
-	0x23, 0x37, 0x5e, 0xfb, 0x1d, 0xb8, 0xa4, 0x84, 0xb1, 0x43, 0xc8, 0x8b, 0xa9, 0xa0, 0x98, 0x45,
-	0x49, 0x63, 0xf6, 0xf3, 0x02, 0xd6, 0xe0, 0x72, 0xaa, 0x3b, 0x46, 0x92, 0xc2, 0x79, 0x65, 0x43,
-	0x7d, 0x06, 0x0b, 0x1b, 0x59, 0x11, 0x72, 0x7e, 0xab, 0x3a, 0x25, 0xbc, 0xf0, 0xe7, 0xf3, 0x5b,
-	0xd9, 0x1e, 0xb9, 0x0e, 0xd3, 0x4e, 0x3f, 0x42, 0x63, 0x91, 0x64, 0x35, 0x24, 0x9b, 0x12, 0x60,
-	0x49, 0xe8, 0x40, 0x2d, 0xd3, 0xc7, 0x64, 0x61, 0xb8, 0x8e, 0xae, 0xf3, 0xc1, 0x9b, 0xb4, 0x47,
-	0x1e, 0xdb, 0x89, 0x6d, 0x4e, 0x47, 0x59, 0x00, 0xb9, 0x09, 0x04, 0x03, 0x0d, 0x37, 0x03, 0x19,
-	0xa3, 0x67, 0x78, 0x5d, 0xca, 0x30, 0x78, 0xfe, 0xdb, 0xbc, 0x5a, 0xf8, 0x01, 0xcc, 0xf0, 0x51,
-	0x51, 0xb6, 0x73, 0x35, 0xcb, 0xe7, 0x03, 0x0c, 0xf5, 0x44, 0xef, 0x5e, 0xdd, 0x01, 0x1c, 0xa7,
-	0x58, 0xbd, 0x28, 0x74, 0x68, 0x1c, 0xab, 0xa9, 0xf6, 0x1c, 0x9f, 0x8f, 0x33, 0xdc, 0x0b, 0x89,
-	0xe2, 0xa6, 0xb9, 0x22, 0xe6, 0x8c, 0xfa, 0x1c, 0x6d, 0x9e, 0x6f, 0x4e, 0x2c, 0x87, 0xc3, 0x7c,
-	0x7a, 0xf6, 0x1e, 0x2c, 0xe8, 0x01, 0x52, 0x67, 0x58, 0x40, 0x86, 0x59, 0x2d, 0x1e, 0xa6, 0x6c,
-	0x37, 0xa0, 0xe6, 0x52, 0xc7, 0x8b, 0xf5, 0xbe, 0xbf, 0xc1, 0x3f, 0x56, 0xc2, 0xe5, 0xf6, 0xdf,
-	0x81, 0x59, 0x45, 0xaa, 0xe7, 0x6a, 0x8b, 0x5c, 0x7b, 0x89, 0xdb, 0x4a, 0x73, 0xb6, 0x36, 0xcc,
-	0xa4, 0x1c, 0x69, 0x76, 0xcb, 0x07, 0x81, 0x75, 0xc5, 0xa0, 0xb2, 0x5c, 0x5d, 0x19, 0x61, 0x6c,
-	0x38, 0xf8, 0x1b, 0x4d, 0x95, 0x11, 0x06, 0x96, 0x21, 0x95, 0xcd, 0x83, 0x4b, 0x59, 0xbd, 0x65,
-	0xf3, 0xe0, 0x77, 0x61, 0x79, 0x40, 0x0b, 0x55, 0xd8, 0x89, 0x2d, 0xba, 0x8c, 0xac, 0x97, 0x73,
-	0x2a, 0xa9, 0x64, 0x82, 0xef, 0xd5, 0x27, 0xd0, 0x1a, 0xd8, 0x80, 0x41, 0x51, 0x4d, 0x14, 0xb5,
-	0x94, 0xdf, 0x8e, 0xbc, 0xb0, 0xa2, 0xe9, 0xf7, 0x52, 0xf1, 0xf4, 0xfb, 0x67, 0x70, 0x53, 0xad,
-	0x1b, 0x46, 0xde, 0x9e, 0x17, 0xd8, 0xfe, 0x29, 0x0a, 0xf0, 0x31, 0xe0, 0x75, 0xc9, 0xf2, 0x99,
-	0xe0, 0x38, 0x49, 0x11, 0xfd, 0x90, 0xb4, 0xf8, 0xd9, 0xe2, 0x81, 0x40, 0xa2, 0x32, 0xf3, 0xf5,
-	0xc1, 0xb8, 0xfe, 0x56, 0x71, 0x5c, 0x7f, 0x07, 0xea, 0x71, 0xe2, 0x39, 0x07, 0xc7, 0x96, 0xe6,
-	0x24, 0xaf, 0xca, 0x39, 0x39, 0x43, 0xa8, 0xe4, 0x91, 0x3c, 0x81, 0x65, 0x41, 0x7b, 0xe2, 0x4b,
-	0x09, 0xe3, 0x1a, 0x9f, 0x48, 0x71, 0xba, 0xad, 0xc2, 0x77, 0x12, 0xcc, 0xe7, 0xf0, 0xa1, 0xab,
-	0x34, 0x8b, 0xb7, 0xb9, 0xcf, 0x41, 0xa0, 0xb4, 0x89, 0xb3, 0x47, 0xed, 0xd7, 0x7f, 0x13, 0xa3,
-	0xf6, 0x95, 0xef, 0x32, 0x6a, 0xbf, 0x71, 0xca, 0xa8, 0xfd, 0xd4, 0x59, 0xf9, 0x3b, 0xa7, 0xcf,
-	0xca, 0xcf, 0x18, 0xd3, 0xdf, 0x3c, 0x63, 0x4c, 0x7f, 0x8e, 0x51, 0xfb, 0xad, 0xb3, 0x47, 0xed,
-	0x45, 0xaf, 0x22, 0x7e, 0x50, 0xf8, 0x2a, 0xe2, 0x2d, 0x98, 0x74, 0x22, 0xed, 0xc6, 0x19, 0x6d,
-	0xb4, 0x9f, 0x2a, 0x03, 0x4a, 0x13, 0x38, 0xa9, 0xa1, 0x7f, 0xfb, 0xa4, 0x86, 0xfe, 0x2d, 0x20,
-	0x22, 0x9b, 0xd0, 0x3b, 0xdc, 0x77, 0x30, 0x3f, 0xa8, 0x21, 0x46, 0x6f, 0x70, 0x5f, 0x81, 0x6a,
-	0x8c, 0xc5, 0x83, 0x78, 0xc7, 0x75, 0x57, 0x4c, 0x14, 0x10, 0x86, 0x2f, 0xb8, 0x18, 0x89, 0x7a,
-	0xf1, 0xe5, 0xfd, 0x9c, 0x1a, 0xab, 0x9c, 0x44, 0x3e, 0xf5, 0xf2, 0x7e, 0x4e, 0xd9, 0x49, 0x3b,
-	0x3e, 0x3b, 0x01, 0xcb, 0xf7, 0x76, 0x22, 0x3b, 0x3a, 0x56, 0x6a, 0xbe, 0xcb, 0xdf, 0x29, 0x71,
-	0xec, 0xa7, 0x1c, 0x29, 0x35, 0x4d, 0xb9, 0x76, 0xa9, 0x9d, 0xf4, 0xb5, 0x8f, 0xbb, 0xa7, 0x73,
-	0x3d, 0xe1, 0x48, 0xc9, 0xb5, 0x04, 0x15, 0xc1, 0xe5, 0x75, 0x7b, 0xbe, 0xf1, 0x1e, 0x4f, 0xff,
-	0x38, 0xa8, 0xd3, 0xed, 0xf9, 0xec, 0x66, 0xda, 0xfd, 0x24, 0xb4, 0x78, 0xe3, 0xbe, 0x17, 0x7a,
-	0x41, 0x12, 0x1b, 0xf7, 0x79, 0x7e, 0xc4, 0x10, 0x26, 0x83, 0xbf, 0x40, 0x30, 0x79, 0x04, 0x8d,
-	0x01, 0xda, 0x34, 0x41, 0x7a, 0x9f, 0x27, 0x48, 0x39, 0x26, 0x95, 0x20, 0xfd, 0x61, 0x09, 0xea,
-	0x31, 0xb5, 0x23, 0x67, 0x9f, 0x59, 0x76, 0xe4, 0xed, 0xf4, 0x13, 0x1a, 0x1b, 0x0f, 0xb0, 0x3d,
-	0xb4, 0x7d, 0xee, 0xb0, 0x5d, 0x98, 0x07, 0xb7, 0xb7, 0x50, 0xee, 0x9a, 0x12, 0xcb, 0x87, 0x1a,
-	0xb5, 0x38, 0x07, 0x26, 0x3f, 0x83, 0x91, 0x2e, 0xed, 0x86, 0xc6, 0x07, 0xb8, 0xea, 0xd3, 0xef,
-	0xb8, 0xea, 0x33, 0xda, 0x15, 0xe3, 0x13, 0x94, 0x4a, 0x6e, 0x42, 0x5d, 0x1c, 0x89, 0xc5, 0x8f,
-	0xdb, 0xa3, 0xb1, 0xf1, 0x90, 0x9b, 0x92, 0x40, 0x3c, 0x95, 0x70, 0xf2, 0x43, 0x68, 0x0c, 0x10,
-	0xa7, 0x7b, 0xf9, 0x08, 0xf7, 0xd2, 0xc8, 0x73, 0xc9, 0xcd, 0x6c, 0xb8, 0x30, 0x57, 0xf8, 0xcd,
-	0x05, 0x63, 0x92, 0xf7, 0xb2, 0x63, 0x92, 0xa5, 0x33, 0x8a, 0x4b, 0x7d, 0x4e, 0xf9, 0x13, 0x28,
-	0xab, 0x6f, 0xfc, 0x8d, 0x4a, 0x6e, 0xfd, 0xa2, 0x04, 0x13, 0x1b, 0xfb, 0xd4, 0x39, 0x88, 0xfb,
-	0xdd, 0x7c, 0xcd, 0x39, 0x9a, 0xd6, 0x9c, 0x8f, 0x61, 0x6c, 0xd7, 0xb7, 0x0f, 0xc3, 0x08, 0x97,
-	0x98, 0x5a, 0xbd, 0x75, 0x7a, 0x39, 0x26, 0x25, 0x3e, 0x41, 0x1e, 0x53, 0xf0, 0xa6, 0xd3, 0x53,
-	0xfe, 0xcc, 0x91, 0xff, 0x68, 0xfd, 0xe5, 0x28, 0x10, 0x6c, 0x25, 0x67, 0x4b, 0xaa, 0xdf, 0x4e,
-	0x47, 0x40, 0x4b, 0x7a, 0x86, 0xf3, 0x2d, 0xbd, 0xeb, 0x30, 0x9d, 0x93, 0x8b, 0x15, 0x50, 0xd5,
-	0x9c, 0xca, 0x8a, 0x23, 0x0f, 0x06, 0x15, 0x50, 0x06, 0xc3, 0xbb, 0x75, 0xf3, 0x59, 0x0e, 0x75,
-	0xf7, 0xda, 0x30, 0x23, 0x35, 0x18, 0xec, 0xe7, 0xd7, 0x05, 0x4a, 0xab, 0xda, 0xae, 0xc2, 0x94,
-	0xa4, 0xcf, 0x74, 0xf4, 0xe5, 0x7b, 0x4b, 0x5e, 0xb7, 0x0d, 0x3c, 0xca, 0x9c, 0x78, 0xad, 0x47,
-	0x99, 0xe5, 0x53, 0x1e, 0x65, 0x16, 0x56, 0xf2, 0x50, 0x5c, 0xc9, 0x5f, 0x82, 0xb2, 0xaa, 0x5c,
-	0xe5, 0x83, 0x4b, 0x05, 0x38, 0xa1, 0xf0, 0xaa, 0x9e, 0x50, 0x78, 0xfd, 0x44, 0x15, 0xc3, 0xfc,
-	0x21, 0xa4, 0x08, 0x48, 0x93, 0x68, 0x7d, 0x2b, 0x27, 0xd4, 0xef, 0x2f, 0x90, 0x03, 0x5f, 0x45,
-	0xf2, 0x50, 0x25, 0xcb, 0x66, 0x0d, 0x34, 0x50, 0xe4, 0x4e, 0x0d, 0x76, 0x36, 0xfe, 0x63, 0x18,
-	0xa6, 0x55, 0xa5, 0xcd, 0x5f, 0x58, 0x91, 0x8f, 0x45, 0x2b, 0xf9, 0x75, 0xbb, 0xda, 0x69, 0xc5,
-	0x8e, 0xad, 0x46, 0x26, 0x83, 0xbc, 0x80, 0x31, 0x27, 0x0c, 0x76, 0xbd, 0x3d, 0x71, 0x61, 0x1f,
-	0xbc, 0xbe, 0xb4, 0x0d, 0xe4, 0x37, 0x85, 0x1c, 0x12, 0x01, 0xd1, 0x0b, 0x31, 0x21, 0x9d, 0x37,
-	0xa5, 0x37, 0x5e, 0x5f, 0xba, 0x56, 0x93, 0x89, 0x85, 0xea, 0x51, 0x1e, 0x44, 0xae, 0xc1, 0x14,
-	0x5f, 0x27, 0xf7, 0x3c, 0x69, 0x92, 0x43, 0x65, 0xe0, 0x5b, 0x87, 0xcb, 0xbb, 0xb6, 0xe7, 0x87,
-	0x87, 0x34, 0x2a, 0x7e, 0xd4, 0xc4, 0x07, 0x41, 0x17, 0x25, 0x51, 0xd1, 0x9b, 0xa6, 0x1b, 0x50,
-	0x53, 0x32, 0x24, 0x1b, 0x9f, 0x12, 0x4d, 0x4b, 0xb8, 0x24, 0x7d, 0x07, 0xea, 0x8a, 0x94, 0x06,
-	0xa2, 0x35, 0x3d, 0x99, 0xa5, 0xdd, 0x0c, 0xf8, 0xcb, 0xb6, 0x7f, 0x1a, 0x82, 0xc9, 0xcc, 0xf9,
-	0x90, 0x29, 0x18, 0x52, 0x2d, 0x97, 0x21, 0xcf, 0x25, 0x1f, 0xa9, 0xce, 0xd1, 0x50, 0x7e, 0xe0,
-	0x97, 0xb1, 0x3c, 0x25, 0x25, 0xdb, 0x31, 0x52, 0x4d, 0xc6, 0x61, 0xad, 0xc9, 0xb8, 0x0c, 0x15,
-	0x97, 0xc6, 0x4e, 0xe4, 0xf5, 0xd8, 0x27, 0x8a, 0xfe, 0xa3, 0x0e, 0x4a, 0x5f, 0xcb, 0x8d, 0xea,
-	0xaf, 0xe5, 0xb6, 0x45, 0x9f, 0x7b, 0x0c, 0x63, 0xe6, 0x8f, 0xde, 0xcc, 0x02, 0xdb, 0xac, 0xa4,
-	0x16, 0xb1, 0x92, 0x49, 0x6b, 0xbc, 0x0f, 0x65, 0x05, 0x3a, 0xeb, 0xc1, 0x4b, 0x59, 0x8f, 0x1c,
-	0xfb, 0xd0, 0x38, 0xd9, 0x5e, 0x98, 0xa3, 0xc3, 0xb7, 0xd8, 0xd4, 0x2a, 0x78, 0x99, 0x5f, 0xe7,
-	0xa8, 0x0d, 0xed, 0x7d, 0x7e, 0x03, 0x26, 0x04, 0x21, 0xdb, 0x6a, 0x96, 0xac, 0xaa, 0xdf, 0xad,
-	0xff, 0xd1, 0xaf, 0x63, 0x6a, 0x7c, 0x11, 0x4d, 0x68, 0x20, 0xfa, 0x0e, 0xc7, 0xb1, 0x88, 0x58,
-	0x93, 0x0a, 0xfa, 0xd8, 0x3e, 0xc6, 0x57, 0xe2, 0xb4, 0xeb, 0x25, 0x56, 0x97, 0x26, 0x91, 0xe7,
-	0xe0, 0x47, 0x4c, 0x98, 0xc0, 0x40, 0xcf, 0x10, 0xc2, 0x7c, 0x3e, 0x8b, 0xde, 0x1e, 0xab, 0x11,
-	0x76, 0xfa, 0xce, 0x01, 0x4d, 0xc4, 0x59, 0x4d, 0x49, 0xf0, 0x3a, 0x42, 0x49, 0x07, 0xaa, 0x3b,
-	0xb6, 0x6b, 0xed, 0x78, 0x81, 0x8d, 0xe9, 0x44, 0x0d, 0xef, 0x56, 0xce, 0x1e, 0xd2, 0xff, 0xef,
-	0x38, 0xbc, 0xdb, 0x5e, 0xb7, 0xdd, 0x75, 0x41, 0x6d, 0x56, 0x76, 0xd2, 0x1f, 0xe4, 0xf7, 0x61,
-	0x41, 0x66, 0xa6, 0x6a, 0x6d, 0xed, 0x55, 0xf4, 0xd4, 0xea, 0xb5, 0x13, 0xac, 0x6c, 0x4d, 0x50,
-	0x0b, 0x23, 0x9b, 0x13, 0x52, 0xb2, 0x60, 0x72, 0x07, 0x66, 0x07, 0xc4, 0xf7, 0x23, 0x0f, 0x3b,
-	0x26, 0x65, 0x93, 0xe4, 0x98, 0x3e, 0x8f, 0x3c, 0xe2, 0x40, 0x43, 0x1b, 0xb1, 0xe6, 0x75, 0x9a,
-	0x7d, 0x1d, 0x9d, 0x8c, 0x54, 0x50, 0x4e, 0xad, 0xfb, 0xb0, 0x50, 0xb4, 0x08, 0xd3, 0x8c, 0xbf,
-	0xd3, 0x9e, 0x1b, 0x64, 0xfd, 0x3c, 0xf2, 0x5a, 0xff, 0x32, 0x04, 0xd3, 0xb9, 0x1e, 0x91, 0xea,
-	0xf2, 0x1c, 0x45, 0x5e, 0x42, 0xd3, 0x2e, 0x4f, 0x29, 0xed, 0xf2, 0xbc, 0x64, 0x18, 0xd9, 0xe5,
-	0xf9, 0xe3, 0x13, 0xdf, 0xf2, 0x0c, 0xe1, 0x5d, 0xfa, 0xf1, 0x9b, 0x36, 0xab, 0x5e, 0xef, 0x1d,
-	0xcf, 0xff, 0xef, 0xb3, 0x9a, 0xbf, 0x29, 0x65, 0x1e, 0xa8, 0x09, 0x7f, 0x19, 0x93, 0x1f, 0x15,
-	0x75, 0x04, 0x2b, 0xab, 0x17, 0x07, 0x06, 0x39, 0x9d, 0x20, 0xb9, 0x7f, 0xef, 0x0b, 0x26, 0x2f,
-	0xd7, 0x2e, 0xec, 0x88, 0x06, 0x1d, 0x3f, 0x0d, 0xbd, 0x63, 0x78, 0x86, 0x98, 0xf4, 0xa4, 0x84,
-	0xa8, 0xf5, 0xe4, 0xeb, 0x6f, 0x9a, 0x17, 0x7e, 0xf9, 0x4d, 0xf3, 0xc2, 0xaf, 0xbf, 0x69, 0x96,
-	0x7e, 0xf1, 0xaa, 0x59, 0xfa, 0xfb, 0x57, 0xcd, 0xd2, 0xbf, 0xbd, 0x6a, 0x96, 0xbe, 0x7e, 0xd5,
-	0x2c, 0xfd, 0xea, 0x55, 0xb3, 0xf4, 0x9f, 0xaf, 0x9a, 0x17, 0x7e, 0xfd, 0xaa, 0x59, 0xfa, 0xab,
-	0x6f, 0x9b, 0x17, 0xbe, 0xfe, 0xb6, 0x79, 0xe1, 0x97, 0xdf, 0x36, 0x2f, 0xfc, 0xde, 0x47, 0x7b,
-	0x61, 0xba, 0x39, 0x5e, 0x78, 0xc6, 0x3f, 0x98, 0x3d, 0xca, 0xc3, 0x76, 0xc6, 0x50, 0xb9, 0x77,
-	0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x07, 0xc2, 0x30, 0x9d, 0xa3, 0x36, 0x00, 0x00,
+	// 4251 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4b, 0x6f, 0x1b, 0x49,
+	0x7a, 0xa6, 0x9e, 0xe4, 0x47, 0x52, 0xa2, 0x5a, 0xaf, 0x16, 0x6d, 0x53, 0x32, 0xe7, 0x61, 0x79,
+	0xec, 0xa5, 0x6d, 0x8d, 0xc7, 0xf6, 0x8c, 0xd7, 0x93, 0xb5, 0x64, 0x39, 0xe6, 0x8c, 0xed, 0xf1,
+	0xb6, 0x34, 0xe3, 0xdd, 0x64, 0x83, 0x46, 0xb3, 0xbb, 0x28, 0x35, 0xd4, 0xec, 0xe6, 0x74, 0x37,
+	0xa5, 0xd1, 0xe6, 0xb2, 0x41, 0x10, 0x24, 0x41, 0x02, 0x24, 0x3f, 0x21, 0x08, 0x10, 0x20, 0x7f,
+	0x20, 0xd8, 0x20, 0x40, 0x6e, 0x09, 0x92, 0xe3, 0x1c, 0xf7, 0xb8, 0xe3, 0x41, 0x80, 0xdc, 0xb2,
+	0xf7, 0x5c, 0x82, 0xfa, 0xea, 0xd1, 0xd5, 0xcd, 0x96, 0x28, 0x79, 0x76, 0xb3, 0xd8, 0x1b, 0xfb,
+	0x7b, 0xd5, 0x57, 0x55, 0x5f, 0xd5, 0xf7, 0x2a, 0xc2, 0x07, 0x31, 0xe9, 0xf5, 0x83, 0xd0, 0xf2,
+	0x6e, 0x46, 0x24, 0x3c, 0x24, 0xe1, 0x4d, 0xab, 0xef, 0xde, 0xec, 0x93, 0x30, 0x72, 0xa3, 0x98,
+	0xf8, 0x36, 0xe9, 0x78, 0x41, 0x27, 0xba, 0x79, 0x78, 0xfb, 0x66, 0x8f, 0x44, 0x91, 0xb5, 0x47,
+	0x5a, 0xfd, 0x30, 0x88, 0x03, 0xed, 0xaa, 0x60, 0x6b, 0x31, 0xb6, 0x96, 0xd5, 0x77, 0x5b, 0x59,
+	0xb6, 0xd6, 0xe1, 0xed, 0xfa, 0xea, 0x5e, 0x10, 0xec, 0x79, 0xe4, 0x26, 0xb2, 0x75, 0x06, 0xdd,
+	0x9b, 0xb1, 0xdb, 0x23, 0x51, 0x6c, 0xf5, 0xfa, 0x4c, 0x52, 0xbd, 0x91, 0x25, 0x38, 0x0a, 0xad,
+	0x3e, 0x95, 0xc4, 0xf1, 0x6f, 0x4b, 0x05, 0xa9, 0x66, 0x76, 0xd0, 0xeb, 0x05, 0xfe, 0x90, 0x3e,
+	0x19, 0x2a, 0xe2, 0x0f, 0x7a, 0xa8, 0xf4, 0x51, 0x10, 0x1e, 0x74, 0xbd, 0xe0, 0x88, 0x53, 0xbd,
+	0x93, 0x4f, 0xe5, 0x5b, 0x3d, 0x12, 0xf5, 0x2d, 0x5b, 0x08, 0xbb, 0x9a, 0x22, 0x93, 0xd8, 0xe1,
+	0x51, 0xdf, 0xcd, 0x97, 0x17, 0x5b, 0xd1, 0x81, 0xf9, 0xe5, 0x80, 0x0c, 0x48, 0xee, 0xb8, 0x5d,
+	0xcb, 0xf5, 0x06, 0x61, 0x8e, 0xb8, 0x6b, 0x79, 0x7b, 0x21, 0xa5, 0xb2, 0xa9, 0x73, 0xd2, 0xeb,
+	0xa7, 0x92, 0x66, 0xa6, 0x7d, 0xf5, 0x54, 0x62, 0xaa, 0x2d, 0x27, 0xbc, 0x95, 0x47, 0x18, 0x92,
+	0xbe, 0xe7, 0xda, 0x56, 0xec, 0xe6, 0xac, 0x7b, 0xb3, 0x07, 0x7a, 0xbb, 0xd7, 0x1b, 0xc4, 0x56,
+	0xc7, 0x23, 0x5b, 0xde, 0x20, 0x8a, 0x49, 0xf8, 0x9c, 0xc4, 0x96, 0x63, 0xc5, 0x96, 0x76, 0x05,
+	0x2a, 0x36, 0x03, 0x99, 0x74, 0x0d, 0xf5, 0xc2, 0x5a, 0x61, 0xbd, 0x64, 0x94, 0x39, 0xec, 0x85,
+	0xd5, 0x23, 0x5a, 0x0b, 0xe6, 0xf7, 0xdd, 0x28, 0x0e, 0xc2, 0x63, 0x33, 0xda, 0xb7, 0x42, 0xc7,
+	0xb4, 0x83, 0x81, 0x1f, 0xeb, 0x63, 0x6b, 0x85, 0xf5, 0x49, 0x63, 0x8e, 0xa3, 0x76, 0x28, 0x66,
+	0x8b, 0x22, 0x9a, 0x7f, 0x31, 0x03, 0x95, 0x47, 0x76, 0xec, 0x1e, 0xba, 0xf1, 0x71, 0xdb, 0xef,
+	0x06, 0x9a, 0x0e, 0xd3, 0x87, 0xd4, 0xea, 0x02, 0x1f, 0xc5, 0x8f, 0x1b, 0xe2, 0x53, 0xbb, 0x07,
+	0x7a, 0x64, 0xef, 0x13, 0x67, 0xe0, 0x11, 0xc7, 0x24, 0x87, 0xc4, 0x8f, 0xcd, 0x8e, 0x15, 0xdb,
+	0xfb, 0xa6, 0xeb, 0xa0, 0xfc, 0x71, 0x63, 0x51, 0xe2, 0xb7, 0x29, 0x7a, 0x93, 0x62, 0xdb, 0x8e,
+	0x76, 0x15, 0x66, 0x33, 0x8c, 0xfa, 0xf8, 0x5a, 0x61, 0xbd, 0x62, 0xcc, 0xa4, 0xe9, 0xb5, 0xfb,
+	0xc3, 0x23, 0x10, 0xdf, 0x0e, 0x1c, 0xd7, 0xdf, 0xd3, 0x27, 0x70, 0xae, 0x4b, 0x69, 0x8e, 0x6d,
+	0x8e, 0xd5, 0x6e, 0xc1, 0x42, 0xc2, 0x49, 0x0f, 0x84, 0xe9, 0x5b, 0x7e, 0x10, 0xe9, 0x93, 0xa8,
+	0x97, 0x26, 0x71, 0xbb, 0x6e, 0x8f, 0xbc, 0xa0, 0x18, 0xed, 0x32, 0x40, 0x14, 0x5b, 0x61, 0x4c,
+	0x1c, 0xaa, 0xff, 0x14, 0xd2, 0x95, 0x38, 0xa4, 0xed, 0x68, 0x6f, 0x41, 0x55, 0xa0, 0x99, 0xc6,
+	0xd3, 0xa8, 0x71, 0x85, 0x03, 0x99, 0xbe, 0x77, 0x60, 0x29, 0x45, 0x94, 0x68, 0x5b, 0x44, 0x6d,
+	0x17, 0x54, 0x6a, 0xa9, 0xeb, 0x0d, 0xd0, 0x04, 0x97, 0xa2, 0x69, 0x09, 0x35, 0xa8, 0x71, 0x4c,
+	0xa2, 0xe7, 0x2a, 0x94, 0x2d, 0xbe, 0x3f, 0x54, 0x51, 0x40, 0xc1, 0x20, 0x40, 0x6d, 0x87, 0x4e,
+	0x24, 0x24, 0x5f, 0x0e, 0x48, 0x14, 0x53, 0x7c, 0x19, 0xf1, 0x25, 0x0e, 0x69, 0x3b, 0xda, 0x53,
+	0xb8, 0x22, 0x66, 0x6f, 0xc6, 0x81, 0x89, 0xf2, 0x71, 0xdc, 0x60, 0x10, 0x9b, 0x11, 0xb1, 0x03,
+	0xdf, 0x89, 0xf4, 0x0a, 0x9a, 0xc7, 0x65, 0x41, 0xb8, 0x1b, 0xec, 0x50, 0xb2, 0x5d, 0x46, 0xb5,
+	0xc3, 0x88, 0xb2, 0x92, 0x6c, 0x2f, 0x88, 0xc8, 0x90, 0xa4, 0x6a, 0x56, 0xd2, 0x16, 0x25, 0xcb,
+	0x48, 0xda, 0x84, 0x06, 0xd7, 0xe3, 0x24, 0x31, 0x33, 0x28, 0xa6, 0x8e, 0x54, 0xf9, 0x32, 0x3e,
+	0x82, 0x95, 0x7d, 0x62, 0x85, 0x71, 0x87, 0x58, 0xc3, 0xf3, 0x99, 0x45, 0xf6, 0x65, 0x49, 0x90,
+	0xe1, 0xbd, 0x06, 0x35, 0xdb, 0xf2, 0x6d, 0xe2, 0x99, 0x7c, 0x9d, 0x88, 0xa3, 0xd7, 0xd6, 0x0a,
+	0xeb, 0x45, 0x63, 0x96, 0xc1, 0x0d, 0x01, 0xd6, 0xde, 0x83, 0xb9, 0x34, 0x29, 0x5d, 0xe4, 0x39,
+	0xdc, 0xab, 0x34, 0x6d, 0x1b, 0x69, 0xa9, 0x22, 0xa1, 0x89, 0xd7, 0x55, 0x14, 0x5b, 0xf1, 0x20,
+	0xd2, 0x35, 0x54, 0x65, 0x16, 0x11, 0xbb, 0x56, 0x74, 0xb0, 0x83, 0x60, 0x7a, 0xcc, 0xac, 0x98,
+	0x5e, 0x0e, 0xb1, 0x3e, 0x8f, 0x14, 0xe2, 0x93, 0xee, 0x67, 0x72, 0xdd, 0xe9, 0x0b, 0x6c, 0x3f,
+	0x29, 0xe4, 0x87, 0x14, 0x40, 0x75, 0x4f, 0xec, 0x96, 0xf8, 0xb1, 0x1b, 0x1f, 0xeb, 0x8b, 0x48,
+	0x34, 0x2b, 0xad, 0x97, 0x81, 0xb5, 0x75, 0xa8, 0xed, 0x5b, 0x91, 0x19, 0x92, 0x38, 0x3c, 0x36,
+	0xfb, 0x81, 0xe7, 0xda, 0xc7, 0xfa, 0x12, 0x4e, 0x73, 0x66, 0xdf, 0x8a, 0x0c, 0x0a, 0x7e, 0x89,
+	0x50, 0x6d, 0x0b, 0x1a, 0x8c, 0xca, 0xf5, 0xdd, 0xd8, 0xb5, 0x3c, 0xd3, 0xf5, 0x63, 0x12, 0x1e,
+	0x5a, 0x9e, 0x5c, 0xd1, 0x65, 0x54, 0xf2, 0x22, 0x52, 0xb5, 0x19, 0x51, 0x9b, 0xd3, 0x88, 0x55,
+	0x95, 0x42, 0x7a, 0xd6, 0x57, 0x6e, 0x6f, 0xd0, 0x1b, 0x16, 0xa2, 0x2b, 0x42, 0x9e, 0x33, 0xa2,
+	0xac, 0x90, 0x3b, 0xb0, 0x94, 0x16, 0xc2, 0x97, 0x25, 0xd2, 0x57, 0x90, 0x79, 0x41, 0x65, 0x7e,
+	0xc4, 0x71, 0xda, 0x43, 0x60, 0x42, 0x4d, 0xf2, 0x55, 0xdf, 0x0d, 0xf1, 0x66, 0x55, 0xcf, 0x56,
+	0x1d, 0xf7, 0x4b, 0x47, 0x92, 0x6d, 0x49, 0x91, 0x9c, 0xb1, 0x8f, 0x60, 0x85, 0xb1, 0x77, 0x2c,
+	0xfb, 0x20, 0xe8, 0x76, 0x4d, 0x3b, 0x20, 0xdd, 0xae, 0x6b, 0xbb, 0xf4, 0xe0, 0x5f, 0x5c, 0x2b,
+	0xac, 0x17, 0x8c, 0x65, 0x24, 0xd8, 0x64, 0xf8, 0xad, 0x04, 0xad, 0x3d, 0x86, 0x55, 0xc6, 0xeb,
+	0x07, 0x3e, 0x5b, 0x6a, 0x7a, 0x73, 0x9b, 0x24, 0x0c, 0x83, 0xd0, 0x8c, 0x8f, 0xfb, 0x24, 0xd2,
+	0x2f, 0xad, 0x8d, 0xaf, 0x97, 0xf8, 0xb4, 0x5f, 0x04, 0xbe, 0x21, 0x88, 0xb6, 0x29, 0xcd, 0x2e,
+	0x25, 0xd1, 0x5e, 0x80, 0xc6, 0xa4, 0x78, 0x56, 0x14, 0x9b, 0xdc, 0x9f, 0xe9, 0x97, 0xd7, 0x0a,
+	0xeb, 0xe5, 0x8d, 0xb5, 0x96, 0x0c, 0x0d, 0x68, 0x4c, 0xc0, 0x91, 0xad, 0xc3, 0xdb, 0xad, 0x27,
+	0xec, 0xa7, 0x51, 0x43, 0xde, 0x67, 0x56, 0x14, 0x73, 0x88, 0xf6, 0x00, 0xea, 0x8a, 0x3c, 0xea,
+	0xbd, 0x48, 0x98, 0xd8, 0x4b, 0x03, 0xed, 0x65, 0x59, 0x72, 0xbd, 0x42, 0xbc, 0xb4, 0x9b, 0x2b,
+	0x50, 0x91, 0x2e, 0x9a, 0x9a, 0xfb, 0x2a, 0x73, 0x33, 0x12, 0xd6, 0x76, 0xe8, 0xad, 0x24, 0xef,
+	0x02, 0xd7, 0xd1, 0xd7, 0x70, 0x81, 0x41, 0x80, 0xda, 0x8e, 0xf6, 0x05, 0x2c, 0xe1, 0xd0, 0xc9,
+	0x19, 0x75, 0x48, 0x6c, 0xb9, 0x5e, 0xa4, 0x5f, 0xc9, 0x9b, 0x14, 0x77, 0xc5, 0x87, 0xb7, 0x5b,
+	0x2f, 0xad, 0x63, 0x2f, 0xb0, 0x9c, 0xc8, 0x58, 0xa0, 0xfc, 0x4f, 0x05, 0xfb, 0x63, 0xc6, 0xad,
+	0xfd, 0x18, 0x2e, 0x66, 0xe4, 0x0e, 0xfa, 0x8e, 0x25, 0xee, 0x52, 0xbd, 0x89, 0xc2, 0xeb, 0x2d,
+	0x16, 0x02, 0xb5, 0x44, 0x08, 0xd4, 0xda, 0x15, 0x31, 0x92, 0xa1, 0xa7, 0xc4, 0x7e, 0xce, 0x98,
+	0x29, 0xba, 0xf9, 0x6f, 0x00, 0x25, 0xf4, 0x8c, 0xe8, 0x07, 0x57, 0xa0, 0xc8, 0x1c, 0xa8, 0xeb,
+	0xa0, 0x23, 0x9c, 0x34, 0xa6, 0xf1, 0xbb, 0xed, 0x50, 0x54, 0x68, 0xf9, 0x7b, 0x24, 0x71, 0x7c,
+	0xd3, 0xf8, 0xdd, 0x76, 0xb4, 0x05, 0x98, 0x0c, 0x8e, 0x7c, 0x12, 0xa2, 0x83, 0x2b, 0x19, 0xec,
+	0x43, 0xdb, 0x80, 0x45, 0xc5, 0xe7, 0x9b, 0x96, 0x7d, 0x60, 0x7a, 0xe4, 0x90, 0x78, 0xe8, 0xd4,
+	0xc6, 0x8d, 0x79, 0x05, 0xf9, 0xc8, 0x3e, 0x78, 0x46, 0x51, 0xd4, 0x4b, 0xc4, 0xa1, 0xe5, 0x47,
+	0x5d, 0x12, 0x2a, 0x0c, 0xcc, 0x9f, 0xd5, 0x04, 0x46, 0xa5, 0x8e, 0xe2, 0xc0, 0x23, 0xbe, 0x19,
+	0xb9, 0xbe, 0x4d, 0xcc, 0x90, 0xf8, 0xe4, 0x08, 0xbd, 0xda, 0xa4, 0x51, 0x63, 0x98, 0x1d, 0x8a,
+	0x30, 0x28, 0x5c, 0xfb, 0x10, 0x40, 0xac, 0x9a, 0xc5, 0x3c, 0xdb, 0xe9, 0x6b, 0x56, 0xe2, 0xd4,
+	0x8f, 0x62, 0x6d, 0x13, 0xd8, 0x55, 0xa6, 0xe8, 0x54, 0x1c, 0xc9, 0x5f, 0x45, 0x16, 0xa9, 0xec,
+	0x63, 0x68, 0x24, 0xf6, 0xe5, 0x07, 0xb1, 0xdb, 0x15, 0x2b, 0x23, 0x22, 0x0f, 0xe6, 0x0c, 0x2f,
+	0x49, 0xaa, 0x17, 0x0a, 0xd1, 0x17, 0x8c, 0x46, 0xfb, 0xeb, 0x02, 0xd4, 0x45, 0x34, 0x94, 0xb3,
+	0x52, 0xb0, 0x36, 0xbe, 0x5e, 0xde, 0xf8, 0xac, 0x75, 0xc6, 0xb0, 0xba, 0x25, 0x77, 0xbe, 0xc5,
+	0xa3, 0xae, 0xdd, 0xcc, 0x1a, 0x6f, 0xfb, 0x71, 0x78, 0x6c, 0x2c, 0xdb, 0xf9, 0x58, 0xed, 0x4f,
+	0x0b, 0xb0, 0x2c, 0xd5, 0xc9, 0xac, 0x50, 0x19, 0x75, 0x79, 0xf6, 0x1d, 0x74, 0x71, 0x7b, 0x59,
+	0x45, 0x16, 0xec, 0x1c, 0x94, 0xf6, 0x57, 0x05, 0x58, 0x11, 0x5a, 0xa8, 0x26, 0xc7, 0xf4, 0xa8,
+	0x7c, 0xd7, 0x35, 0x31, 0x12, 0x91, 0x39, 0x6b, 0x92, 0xc5, 0x6a, 0x7f, 0x59, 0xa0, 0x17, 0x6b,
+	0xa2, 0x85, 0xe3, 0x7d, 0xa9, 0xac, 0x4a, 0x15, 0xb5, 0x79, 0xf1, 0x06, 0xda, 0x28, 0x03, 0x3d,
+	0xf6, 0xbe, 0x4c, 0xaf, 0xcb, 0x52, 0x98, 0x8b, 0xa4, 0x37, 0xd6, 0xbe, 0xe5, 0xd3, 0x00, 0xcc,
+	0x0c, 0xba, 0x5d, 0x0c, 0x30, 0x8a, 0x06, 0x70, 0xd0, 0x67, 0xdd, 0x6e, 0xfd, 0x13, 0xb8, 0x74,
+	0xda, 0xce, 0x6b, 0x35, 0x18, 0x3f, 0x20, 0xc7, 0x3c, 0xe6, 0xa6, 0x3f, 0xe9, 0x61, 0x3f, 0xb4,
+	0xbc, 0x01, 0xe1, 0x97, 0x00, 0xfb, 0xf8, 0x68, 0xec, 0x7e, 0xa1, 0x6e, 0xc3, 0xca, 0x89, 0x3b,
+	0x97, 0x23, 0xe8, 0x96, 0x2a, 0xe8, 0xf4, 0xa3, 0xa4, 0x0c, 0x92, 0x28, 0x9c, 0xbb, 0x2d, 0xe7,
+	0x52, 0xb8, 0x0d, 0x17, 0x4f, 0x59, 0xd4, 0xf3, 0x88, 0x6a, 0xfe, 0xc7, 0x14, 0xcc, 0x2b, 0xb2,
+	0x68, 0xcc, 0x83, 0x17, 0x6a, 0xd6, 0xab, 0x14, 0x72, 0xbd, 0x8a, 0x48, 0xb4, 0xc4, 0xdd, 0x5a,
+	0x32, 0x40, 0x80, 0xda, 0x8e, 0xb6, 0x08, 0x53, 0xe1, 0xc0, 0xa7, 0x38, 0x7e, 0xbf, 0x86, 0x03,
+	0xbf, 0xed, 0x68, 0x5b, 0x80, 0x01, 0x12, 0xba, 0x5b, 0xbc, 0x53, 0x67, 0x36, 0xde, 0xcd, 0x35,
+	0x2b, 0x4c, 0xd1, 0xa8, 0x2d, 0x51, 0xad, 0xa8, 0xe7, 0x35, 0x8a, 0x31, 0xff, 0xa5, 0x26, 0x3e,
+	0x93, 0xe9, 0xc4, 0xe7, 0x6d, 0x98, 0xe9, 0xba, 0x61, 0x14, 0xf3, 0x20, 0x5f, 0xa6, 0x0b, 0x15,
+	0x84, 0x62, 0x70, 0xdf, 0x76, 0xb4, 0x26, 0x54, 0x7d, 0xf2, 0x95, 0x42, 0x34, 0x8d, 0x44, 0x65,
+	0x0a, 0x14, 0x34, 0x57, 0xa0, 0x92, 0xa4, 0x29, 0xae, 0x83, 0x57, 0xe7, 0xb8, 0x21, 0x5d, 0x29,
+	0x75, 0x2e, 0x2d, 0x98, 0x67, 0x12, 0xa2, 0x38, 0x08, 0x49, 0xea, 0x46, 0x9c, 0x34, 0xe6, 0x10,
+	0xb5, 0x43, 0x31, 0xe2, 0x1a, 0xfc, 0x3e, 0x5c, 0xf4, 0xc9, 0x91, 0x49, 0x97, 0x25, 0x8f, 0x0f,
+	0x58, 0x24, 0xec, 0x93, 0x23, 0x63, 0xe0, 0x6f, 0x0f, 0x71, 0x5f, 0x81, 0x4a, 0x27, 0xb4, 0x7c,
+	0x7b, 0xdf, 0x8c, 0x83, 0x03, 0xe2, 0x63, 0xfa, 0x50, 0x31, 0xca, 0x0c, 0xb6, 0x4b, 0x41, 0xf4,
+	0x10, 0x2f, 0xa2, 0xcb, 0x55, 0x4f, 0xb2, 0xeb, 0x77, 0x03, 0x7e, 0x9d, 0x7c, 0x7e, 0xe6, 0x03,
+	0x9c, 0x63, 0x15, 0x2d, 0x1a, 0x7e, 0x28, 0x70, 0x0a, 0x63, 0xe7, 0x78, 0xde, 0x1b, 0xc6, 0x68,
+	0x37, 0x61, 0x41, 0x4c, 0x36, 0xa5, 0x76, 0x15, 0xd5, 0x9e, 0x63, 0xb3, 0xdc, 0x54, 0x94, 0x7f,
+	0x07, 0x66, 0x42, 0x12, 0x91, 0xd8, 0x14, 0x46, 0xc4, 0x0f, 0x7e, 0x15, 0xa1, 0xaf, 0x38, 0x50,
+	0x5b, 0x86, 0x69, 0x34, 0x20, 0xd7, 0xc1, 0xd4, 0x61, 0xdc, 0x98, 0xa2, 0x9f, 0x6d, 0xa7, 0xfe,
+	0xc7, 0xa0, 0x9f, 0xa4, 0x61, 0xce, 0xa1, 0x68, 0xa7, 0xcf, 0xf1, 0xfb, 0xb9, 0x2b, 0xa3, 0x2c,
+	0x63, 0x66, 0x51, 0xa8, 0x68, 0xf5, 0x24, 0xfd, 0xc3, 0x04, 0x54, 0x77, 0x45, 0xde, 0xf0, 0x3b,
+	0x71, 0x86, 0xb6, 0xa1, 0x22, 0x52, 0x31, 0x94, 0x33, 0x89, 0x72, 0x9a, 0xe9, 0x58, 0x2f, 0x11,
+	0xc0, 0x48, 0x51, 0x46, 0x39, 0x4e, 0x3e, 0x34, 0x02, 0x8b, 0x72, 0x0e, 0x22, 0x24, 0x47, 0x79,
+	0x53, 0x28, 0xef, 0xf6, 0xe9, 0x7a, 0x89, 0x5d, 0xe5, 0xc1, 0x3a, 0x8a, 0x9f, 0x3f, 0x1a, 0x06,
+	0xaa, 0x27, 0x7e, 0x3a, 0x7d, 0xe2, 0x69, 0x92, 0x25, 0xc2, 0x5b, 0x91, 0xa6, 0xb1, 0xb3, 0x2a,
+	0x2b, 0x19, 0x3c, 0xf7, 0xa0, 0xc1, 0xa0, 0x3c, 0xf1, 0x2c, 0x6c, 0x99, 0x26, 0xfc, 0xb4, 0x2b,
+	0x56, 0x05, 0xaa, 0x55, 0x69, 0xcf, 0x61, 0xe1, 0xd0, 0x8d, 0xdc, 0x8e, 0xeb, 0xd1, 0xac, 0x5e,
+	0xd6, 0xef, 0xf4, 0xf2, 0xc8, 0xeb, 0x7f, 0x3e, 0xe1, 0x93, 0xc0, 0xe6, 0x2f, 0x27, 0xa0, 0x26,
+	0x7c, 0xd6, 0xef, 0x8c, 0xa9, 0xb4, 0x60, 0x3e, 0xb6, 0xc2, 0x3d, 0x12, 0x9b, 0x29, 0x35, 0x27,
+	0x71, 0xa0, 0x39, 0x86, 0x7a, 0xa1, 0x28, 0x4b, 0xe3, 0x61, 0x46, 0xaf, 0xea, 0x3c, 0x85, 0xe4,
+	0x35, 0x86, 0x79, 0x95, 0x68, 0xde, 0x84, 0x2a, 0xa7, 0xe6, 0x13, 0x98, 0x66, 0xd3, 0x67, 0x40,
+	0x03, 0xa7, 0x91, 0x4e, 0xb4, 0x8b, 0xd9, 0x44, 0xfb, 0x01, 0xd4, 0xb9, 0x08, 0x7b, 0xdf, 0xf5,
+	0x9c, 0x64, 0xd8, 0xc0, 0xf7, 0x8e, 0x71, 0xab, 0x8b, 0xc6, 0x32, 0xa3, 0xd8, 0xa2, 0x04, 0x62,
+	0xf4, 0xcf, 0x7c, 0xef, 0x38, 0x9b, 0x1f, 0xc1, 0x50, 0x7e, 0xa4, 0xd8, 0x5e, 0x39, 0x6d, 0x7b,
+	0x8a, 0xd5, 0x54, 0xce, 0x64, 0x35, 0xd5, 0x37, 0xb2, 0x1a, 0xed, 0x3a, 0xcc, 0x85, 0xc4, 0x0e,
+	0x42, 0xc7, 0x4c, 0xb0, 0xfc, 0x76, 0xac, 0x31, 0xc4, 0x17, 0x12, 0xde, 0x1c, 0x80, 0xf6, 0x94,
+	0xd5, 0x0e, 0xd9, 0xed, 0x6a, 0xd0, 0x7c, 0x47, 0xbb, 0x08, 0x25, 0x7e, 0x0d, 0x4b, 0x03, 0x2b,
+	0x32, 0x00, 0xdb, 0x82, 0x0e, 0xd9, 0x73, 0x7d, 0xd3, 0x0f, 0x1c, 0x25, 0x55, 0x2a, 0x23, 0xf0,
+	0x45, 0xe0, 0xd0, 0x55, 0x68, 0x40, 0x99, 0xf8, 0x8e, 0xa4, 0x18, 0x47, 0x8a, 0x12, 0xf1, 0x1d,
+	0x86, 0x6f, 0xfe, 0x5d, 0x01, 0xaa, 0xa9, 0x71, 0x71, 0x75, 0x42, 0xa2, 0x58, 0xf4, 0x14, 0xfd,
+	0x6c, 0x3b, 0x69, 0x5d, 0xc6, 0x32, 0xba, 0xfc, 0x18, 0x4a, 0x96, 0x6f, 0x13, 0x2a, 0x28, 0xd2,
+	0xc7, 0xd1, 0x6d, 0x3d, 0x38, 0xb3, 0xdb, 0x1a, 0x9e, 0xb8, 0x91, 0x48, 0x6b, 0xfe, 0xbc, 0x00,
+	0xb3, 0x9c, 0x62, 0x97, 0x6a, 0x42, 0xcf, 0xde, 0x2b, 0x28, 0x0b, 0x5d, 0xa8, 0x9f, 0x2c, 0xe0,
+	0x06, 0xdd, 0x7d, 0xc3, 0x01, 0x81, 0xcf, 0x82, 0x0a, 0xbe, 0x07, 0xa5, 0x6e, 0x10, 0x1e, 0xb0,
+	0x5c, 0x77, 0x74, 0xb0, 0x58, 0xa4, 0xc4, 0xf4, 0x53, 0xd3, 0x60, 0x02, 0x55, 0x61, 0xe7, 0x18,
+	0x7f, 0x37, 0xff, 0xb5, 0x00, 0x25, 0x8a, 0x0c, 0x47, 0xd4, 0x7d, 0xd3, 0x95, 0xd2, 0xb1, 0x6c,
+	0xa5, 0xf4, 0x01, 0x94, 0xb1, 0xea, 0xc2, 0x4c, 0x52, 0x1f, 0x1f, 0xa9, 0x15, 0x30, 0x72, 0xd4,
+	0x6b, 0x15, 0xca, 0x6a, 0xb1, 0x8c, 0xe5, 0xc3, 0x10, 0x27, 0x75, 0xb2, 0x15, 0x28, 0xb2, 0x6c,
+	0x4a, 0xde, 0x0d, 0xd3, 0xf8, 0xdd, 0x76, 0x9a, 0xff, 0x3c, 0x06, 0xc5, 0xff, 0x8f, 0xeb, 0x2e,
+	0x73, 0x96, 0x27, 0x86, 0xce, 0xf2, 0x43, 0xa8, 0xd8, 0x21, 0x49, 0x8a, 0x10, 0x93, 0x23, 0x97,
+	0xa0, 0xcc, 0xe9, 0x71, 0x0d, 0x36, 0x60, 0x8a, 0xad, 0x88, 0x3e, 0x35, 0x92, 0x91, 0x53, 0x6a,
+	0x75, 0x28, 0xf6, 0x43, 0x37, 0x08, 0xe9, 0x99, 0x9d, 0xc6, 0x10, 0x4f, 0x7e, 0xd3, 0xa5, 0xe8,
+	0x5a, 0x6e, 0xe8, 0x93, 0x28, 0x32, 0x69, 0x80, 0xc2, 0x6e, 0xb6, 0xb2, 0x80, 0x7d, 0x4a, 0x8e,
+	0x9b, 0x11, 0xcc, 0x3d, 0xf2, 0xbc, 0xc0, 0x46, 0x1d, 0xc4, 0x12, 0x6e, 0xc3, 0x84, 0x63, 0xc5,
+	0x16, 0x37, 0xd7, 0xdb, 0x67, 0x36, 0x57, 0x21, 0xc0, 0x40, 0x76, 0xf5, 0xfe, 0x2a, 0xaa, 0xf7,
+	0x57, 0xf3, 0xe7, 0xe3, 0x50, 0xdd, 0x15, 0xd7, 0xeb, 0x59, 0x37, 0x4d, 0x83, 0x09, 0xfa, 0xc9,
+	0x77, 0x0b, 0x7f, 0x6b, 0x8f, 0x54, 0xff, 0x33, 0x8e, 0xfe, 0xe7, 0xed, 0x93, 0x42, 0x0c, 0x31,
+	0x5e, 0xc6, 0xfb, 0xdc, 0x87, 0x89, 0x03, 0xd7, 0x77, 0xf4, 0xc9, 0xb3, 0x71, 0x7f, 0xea, 0xfa,
+	0x8e, 0x81, 0x1c, 0xf4, 0x9e, 0x49, 0x52, 0x58, 0x96, 0x07, 0x14, 0x2d, 0x91, 0x64, 0x26, 0x5b,
+	0x39, 0x7d, 0xe6, 0xad, 0x7c, 0x08, 0x15, 0x0c, 0xaf, 0x79, 0x8d, 0xe5, 0x0c, 0xe5, 0x94, 0x32,
+	0xa5, 0xe7, 0x95, 0x2b, 0xad, 0x03, 0x55, 0x1a, 0x22, 0x79, 0xc1, 0x9e, 0xe9, 0x59, 0x3e, 0xa1,
+	0x8d, 0x04, 0x7a, 0xbd, 0x3d, 0x3c, 0xd7, 0xf6, 0xe1, 0x24, 0x37, 0x99, 0x98, 0x67, 0x96, 0x4f,
+	0x8c, 0x4a, 0x27, 0xf9, 0x88, 0x9a, 0x7f, 0x3e, 0x06, 0xb0, 0xe3, 0xee, 0xf9, 0x96, 0x37, 0xe2,
+	0xaa, 0xb8, 0x07, 0x3a, 0xab, 0x20, 0xc7, 0x27, 0xb6, 0x88, 0x24, 0x3e, 0xd5, 0x22, 0x4a, 0x37,
+	0x31, 0xc6, 0xb3, 0x4d, 0x0c, 0x61, 0x05, 0x13, 0x8a, 0x15, 0xdc, 0x85, 0x49, 0xd7, 0xef, 0x0f,
+	0x62, 0x7d, 0xf2, 0x8c, 0x05, 0x45, 0x46, 0x4e, 0xb5, 0xb7, 0x03, 0x3f, 0x0e, 0x03, 0x8f, 0x47,
+	0x0f, 0xe2, 0x93, 0x9a, 0x63, 0xa2, 0x7d, 0x92, 0xc0, 0x49, 0x58, 0xdb, 0x69, 0xfe, 0x53, 0x01,
+	0xe6, 0x78, 0xc1, 0x7f, 0x0b, 0xab, 0xff, 0xbf, 0xa9, 0x05, 0xc9, 0xed, 0x3b, 0xb0, 0x75, 0x19,
+	0xea, 0x3b, 0x64, 0xf5, 0x9e, 0x18, 0xd6, 0xfb, 0x7f, 0x0a, 0xb0, 0x24, 0x02, 0x94, 0xed, 0xaf,
+	0x88, 0x3d, 0x88, 0xdd, 0xc0, 0xa7, 0x57, 0x2c, 0xc1, 0x91, 0xf0, 0x36, 0x52, 0x47, 0x2a, 0xf0,
+	0x91, 0x10, 0x91, 0x8c, 0x94, 0xdc, 0x90, 0x63, 0xea, 0x0d, 0xf9, 0x09, 0x4c, 0xd2, 0x0b, 0x5c,
+	0x1c, 0xc6, 0x3b, 0x67, 0x8b, 0xcf, 0xd3, 0x7a, 0x18, 0x4c, 0x84, 0xf6, 0x04, 0xa6, 0x14, 0x67,
+	0x30, 0xb3, 0xd1, 0x3a, 0xe1, 0x6c, 0xe6, 0x4a, 0x19, 0x44, 0x06, 0xe7, 0x6e, 0x7e, 0xbd, 0x02,
+	0x8b, 0x43, 0x34, 0xb9, 0xb7, 0xce, 0xc3, 0x91, 0xae, 0xe2, 0xe3, 0x21, 0x57, 0xd1, 0x82, 0xf9,
+	0xbe, 0x15, 0xd2, 0xed, 0xcc, 0xb9, 0xc0, 0xe6, 0x18, 0x2a, 0x13, 0xbd, 0x72, 0xfa, 0x61, 0x17,
+	0x54, 0x63, 0x98, 0x74, 0xf4, 0xca, 0xa9, 0x53, 0xfe, 0xa8, 0xcc, 0x80, 0x2c, 0x7a, 0x1d, 0xbd,
+	0xe9, 0xda, 0x87, 0xb0, 0x62, 0x07, 0xbd, 0xbe, 0x47, 0x30, 0x65, 0xcf, 0x58, 0x1f, 0xab, 0x71,
+	0x2c, 0x25, 0x04, 0x29, 0xf3, 0xa3, 0x1d, 0xb2, 0x0c, 0x2b, 0x9e, 0x96, 0x8a, 0x31, 0x9b, 0xe1,
+	0xa0, 0xcd, 0x93, 0xa1, 0x51, 0x64, 0x1f, 0x94, 0x85, 0xdd, 0xcb, 0x19, 0x1e, 0xd9, 0x0a, 0x1d,
+	0x11, 0x82, 0xdf, 0x00, 0x4d, 0x2e, 0x17, 0xbd, 0xec, 0x59, 0xd7, 0xbb, 0xc4, 0x56, 0x4d, 0x60,
+	0xe8, 0x7d, 0x8e, 0xad, 0xef, 0x4f, 0xa1, 0x29, 0xa9, 0x89, 0xd8, 0xf1, 0xa1, 0xd6, 0x20, 0x2b,
+	0x88, 0xac, 0x1e, 0x65, 0x4d, 0x23, 0xd3, 0x22, 0xfc, 0x3d, 0xb8, 0x24, 0x85, 0x85, 0x83, 0x61,
+	0x31, 0x65, 0x14, 0xb3, 0x22, 0x68, 0x8c, 0x41, 0x56, 0xc0, 0x23, 0xb8, 0x9c, 0xe8, 0x8e, 0xde,
+	0x2a, 0xb7, 0xe7, 0x5a, 0x97, 0xd3, 0xa0, 0xae, 0x29, 0x2d, 0x42, 0xf4, 0xa0, 0x65, 0x31, 0x87,
+	0xd5, 0x26, 0x58, 0x0f, 0x5a, 0x54, 0x70, 0xae, 0xc2, 0xac, 0x3d, 0x08, 0xd1, 0x58, 0x04, 0x59,
+	0x0d, 0xc9, 0x66, 0x38, 0x58, 0x10, 0xda, 0x50, 0x4b, 0xd5, 0x62, 0xa9, 0xab, 0x9f, 0xc3, 0xab,
+	0xf3, 0xfe, 0x9b, 0x54, 0x70, 0x1e, 0x5b, 0xb1, 0x65, 0xcc, 0x86, 0x69, 0x80, 0x76, 0x1d, 0x34,
+	0x74, 0x66, 0xcc, 0x0c, 0x44, 0x1c, 0x30, 0xcf, 0x52, 0x67, 0x8a, 0xc1, 0xfd, 0xdf, 0x65, 0x09,
+	0xcd, 0xf7, 0x00, 0x8b, 0x3c, 0x66, 0xa6, 0xb8, 0xb6, 0xc0, 0x7a, 0x1c, 0x14, 0xf5, 0x44, 0x2d,
+	0xb0, 0xdd, 0x02, 0x6c, 0x09, 0x99, 0xfd, 0x30, 0xb0, 0x49, 0x14, 0xc9, 0xce, 0xfc, 0x22, 0xd2,
+	0xe3, 0xb8, 0x2f, 0x05, 0x8a, 0x99, 0xe6, 0x3a, 0xef, 0x95, 0xaa, 0xbd, 0xc0, 0x25, 0xb6, 0x38,
+	0x91, 0x68, 0x70, 0xb3, 0x0e, 0xe0, 0x07, 0xb0, 0xac, 0x3a, 0x61, 0x95, 0x61, 0x19, 0x19, 0x16,
+	0x14, 0x9f, 0x9b, 0xb0, 0x5d, 0x83, 0x9a, 0x43, 0x6c, 0x37, 0x52, 0x7b, 0x17, 0x3a, 0x9b, 0xac,
+	0x80, 0x8b, 0xe5, 0xbf, 0x05, 0x0b, 0x92, 0x54, 0x0d, 0x27, 0x57, 0x98, 0xf6, 0x02, 0xb7, 0x93,
+	0x84, 0x95, 0x2d, 0x98, 0x4f, 0x38, 0x92, 0x00, 0x9c, 0x35, 0x33, 0xe7, 0x24, 0x83, 0x0c, 0xc4,
+	0x55, 0x65, 0xb8, 0xb1, 0x61, 0xf3, 0x72, 0x32, 0x51, 0x86, 0x1b, 0x58, 0x8a, 0x54, 0xd4, 0x37,
+	0x2e, 0xa5, 0xf5, 0x16, 0xf5, 0x8d, 0xdf, 0x87, 0xb5, 0x21, 0x2d, 0x64, 0xee, 0xc9, 0x97, 0xe8,
+	0x32, 0xb2, 0x5e, 0xce, 0xa8, 0x24, 0x03, 0x16, 0xb6, 0x56, 0x9f, 0x42, 0x73, 0x68, 0x01, 0x86,
+	0x45, 0x35, 0x50, 0xd4, 0x6a, 0x76, 0x39, 0xb2, 0xc2, 0xf2, 0x3a, 0xf8, 0xab, 0xf9, 0x1d, 0xfc,
+	0x9f, 0xc0, 0x75, 0x39, 0x6e, 0x10, 0xba, 0x7b, 0xae, 0x4f, 0x3b, 0xd2, 0x27, 0x2a, 0xc0, 0x5a,
+	0x99, 0x57, 0x05, 0xcb, 0x67, 0x9c, 0xe3, 0x24, 0x45, 0xd4, 0x4d, 0x52, 0xfc, 0x67, 0x93, 0x39,
+	0x02, 0x81, 0x4a, 0xbd, 0x11, 0x18, 0xf6, 0xeb, 0x6f, 0xe5, 0xfb, 0xf5, 0xf7, 0x60, 0x2e, 0x8a,
+	0x5d, 0xfb, 0xe0, 0xd8, 0x54, 0x2e, 0xc9, 0xb7, 0x45, 0xaf, 0x9f, 0x22, 0x64, 0xec, 0xa6, 0x3d,
+	0x81, 0x35, 0x4e, 0x7b, 0xe2, 0x6b, 0x0f, 0xfd, 0x1d, 0xd6, 0x55, 0x63, 0x74, 0x3b, 0xb9, 0x6f,
+	0x3d, 0xe8, 0x9d, 0xc3, 0x1a, 0xc7, 0xc2, 0x2c, 0xde, 0x65, 0x77, 0x0e, 0x02, 0x85, 0x4d, 0x8c,
+	0x7e, 0x2e, 0x70, 0xf5, 0xd7, 0xf1, 0x5c, 0x60, 0xfd, 0xbb, 0x3c, 0x17, 0xb8, 0x76, 0xca, 0x73,
+	0x81, 0x53, 0xfb, 0xfd, 0xef, 0x9d, 0xde, 0xef, 0x1f, 0xf1, 0xd4, 0xe0, 0xfa, 0x88, 0xa7, 0x06,
+	0x67, 0x78, 0x2e, 0x70, 0x63, 0xf4, 0x73, 0x81, 0xbc, 0x97, 0x1d, 0xdf, 0xcb, 0x7d, 0xd9, 0xf1,
+	0x16, 0x54, 0xed, 0x50, 0x39, 0x71, 0x7a, 0x0b, 0xed, 0xa7, 0x42, 0x81, 0xc2, 0x04, 0x4e, 0xea,
+	0x39, 0xdc, 0x3c, 0xa9, 0xe7, 0x70, 0x03, 0x34, 0x1e, 0x4d, 0xa8, 0x45, 0xf8, 0x5b, 0x18, 0x1f,
+	0xd4, 0x10, 0xa3, 0xd6, 0xe0, 0x69, 0xd3, 0x03, 0x93, 0x07, 0xfe, 0x16, 0xed, 0x36, 0x6f, 0x7a,
+	0x20, 0x0c, 0x5f, 0xa1, 0x51, 0x12, 0xf9, 0x6a, 0xcd, 0xfd, 0x29, 0xd1, 0x37, 0x18, 0x09, 0x87,
+	0xed, 0xb8, 0x3f, 0x25, 0x74, 0xa7, 0x6d, 0x8f, 0xee, 0x80, 0xe9, 0xb9, 0x9d, 0xd0, 0x0a, 0x8f,
+	0xa5, 0x9a, 0xef, 0xb3, 0xb7, 0x56, 0x0c, 0xfb, 0x8c, 0x21, 0x85, 0xa6, 0x09, 0x57, 0x97, 0x58,
+	0xf1, 0x40, 0x99, 0xdc, 0x1d, 0x95, 0xeb, 0x09, 0x43, 0x0a, 0xae, 0x55, 0x28, 0x73, 0x2e, 0xb7,
+	0xd7, 0xf7, 0xf4, 0x0f, 0x90, 0x14, 0x18, 0xa8, 0xdd, 0xeb, 0x7b, 0xf4, 0x64, 0x5a, 0x83, 0x38,
+	0x30, 0x59, 0x6f, 0xa1, 0x1f, 0xb8, 0x7e, 0x1c, 0xe9, 0x77, 0x59, 0x7c, 0x44, 0x11, 0x06, 0x85,
+	0xbf, 0x44, 0x30, 0xad, 0x23, 0x0e, 0xd1, 0x26, 0x01, 0xd2, 0x3d, 0x16, 0x20, 0x65, 0x98, 0x64,
+	0x80, 0xf4, 0x27, 0x05, 0x98, 0x8b, 0x88, 0x15, 0xda, 0xfb, 0xd4, 0xb2, 0x43, 0xb7, 0x33, 0x88,
+	0x49, 0xa4, 0xdf, 0xc7, 0x14, 0x6f, 0xf7, 0xcc, 0x6e, 0x3b, 0x37, 0x0e, 0x6e, 0xed, 0xa0, 0xdc,
+	0x47, 0x52, 0x2c, 0xeb, 0xbb, 0xd4, 0xa2, 0x0c, 0x58, 0xfb, 0x09, 0x4c, 0xf4, 0x48, 0x2f, 0xd0,
+	0x3f, 0xc4, 0x51, 0x9f, 0x7e, 0xc7, 0x51, 0x9f, 0x93, 0x1e, 0xef, 0xf0, 0xa0, 0x54, 0x5a, 0x86,
+	0xe4, 0x5b, 0x62, 0xb2, 0xed, 0x76, 0x49, 0xa4, 0x7f, 0xc4, 0x4c, 0x89, 0x23, 0x9e, 0x0a, 0xb8,
+	0xf6, 0x7d, 0xa8, 0x0f, 0x11, 0x27, 0x6b, 0xf9, 0x00, 0xd7, 0x52, 0xcf, 0x72, 0x89, 0xc5, 0xac,
+	0x3b, 0xb0, 0x98, 0x3b, 0xe7, 0x9c, 0x4e, 0xce, 0x07, 0xe9, 0x4e, 0xce, 0xea, 0x88, 0xe4, 0x52,
+	0x6d, 0xa5, 0xfe, 0x08, 0x4a, 0x72, 0x8e, 0xbf, 0x56, 0xc9, 0xcd, 0x9f, 0x15, 0xa0, 0xb8, 0xb5,
+	0x4f, 0xec, 0x83, 0x68, 0xd0, 0xcb, 0xe6, 0x9c, 0x93, 0x49, 0xce, 0xf9, 0x18, 0xa6, 0xba, 0x9e,
+	0x75, 0x18, 0x84, 0x38, 0xc4, 0xcc, 0xc6, 0x8d, 0xd3, 0xd3, 0x31, 0x21, 0xf1, 0x09, 0xf2, 0x18,
+	0x9c, 0x37, 0x69, 0xf0, 0xb2, 0xa7, 0x9a, 0xec, 0xa3, 0xf9, 0x37, 0x93, 0xa0, 0x61, 0xb5, 0x3b,
+	0x9d, 0x52, 0xfd, 0x66, 0x2a, 0x02, 0x4a, 0xd0, 0x33, 0x9e, 0xad, 0x3a, 0x5e, 0x85, 0xd9, 0x8c,
+	0x5c, 0xcc, 0x80, 0x2a, 0xc6, 0x4c, 0x5a, 0x1c, 0x7d, 0x53, 0x9a, 0x55, 0x40, 0x1a, 0x0c, 0x2b,
+	0x28, 0x2e, 0xa5, 0x39, 0xe4, 0xd9, 0x6b, 0xc1, 0xbc, 0xd0, 0x60, 0xb8, 0xe5, 0x30, 0xc7, 0x51,
+	0x4a, 0xd6, 0xf6, 0x36, 0xcc, 0x08, 0xfa, 0x54, 0xd3, 0x41, 0xbc, 0x19, 0x65, 0x79, 0xdb, 0xd0,
+	0xc3, 0xd2, 0xe2, 0xb9, 0x1e, 0x96, 0x96, 0x4e, 0x79, 0x58, 0x9a, 0x9b, 0xc9, 0x43, 0x7e, 0x26,
+	0x7f, 0x09, 0x4a, 0x32, 0x73, 0x15, 0x8f, 0x46, 0x25, 0xe0, 0x84, 0xc4, 0xab, 0x72, 0x42, 0xe2,
+	0xf5, 0x23, 0x99, 0x0c, 0xb3, 0xc7, 0x9c, 0xdc, 0x21, 0x55, 0xd1, 0xfa, 0xd6, 0x4f, 0xc8, 0xdf,
+	0x5f, 0x22, 0x07, 0xbe, 0xec, 0x64, 0xae, 0x4a, 0xa4, 0xcd, 0x0a, 0x68, 0x28, 0xc9, 0x9d, 0x19,
+	0xae, 0x6c, 0xfc, 0xd7, 0x38, 0xcc, 0xca, 0x4c, 0x9b, 0xbd, 0x12, 0xd3, 0x3e, 0xe1, 0xd5, 0xee,
+	0xf3, 0x16, 0xde, 0x93, 0x8c, 0x1d, 0xcb, 0x99, 0x54, 0x86, 0xf6, 0x12, 0xa6, 0xec, 0xc0, 0xef,
+	0xba, 0x7b, 0xfa, 0xd8, 0x39, 0x93, 0x25, 0x29, 0x6d, 0x0b, 0xf9, 0x0d, 0x2e, 0x47, 0x0b, 0x41,
+	0x53, 0x13, 0x31, 0x2e, 0x9d, 0xd5, 0xcd, 0xb7, 0xce, 0x2f, 0x5d, 0xc9, 0xc9, 0xf8, 0x40, 0x73,
+	0x61, 0x16, 0x44, 0xfb, 0xe0, 0x6c, 0x9c, 0xcc, 0x13, 0xab, 0x2a, 0x83, 0x0a, 0xc7, 0xb7, 0x09,
+	0x97, 0xbb, 0x96, 0xeb, 0x05, 0x87, 0x24, 0xcc, 0x7f, 0x98, 0xc5, 0x7a, 0x55, 0x17, 0x05, 0x51,
+	0xde, 0xbb, 0xac, 0x6b, 0x50, 0x93, 0x32, 0x04, 0x1b, 0x6b, 0x64, 0xcd, 0x0a, 0xb8, 0x20, 0x7d,
+	0x0f, 0xe6, 0x24, 0x29, 0xed, 0x03, 0x61, 0xf5, 0xbc, 0x9a, 0xa6, 0xdd, 0xf6, 0xd9, 0xeb, 0xbc,
+	0x7f, 0x19, 0x83, 0x6a, 0x6a, 0x7f, 0xb4, 0x19, 0x18, 0x93, 0x25, 0x97, 0x31, 0xd7, 0xd1, 0x3e,
+	0x96, 0x95, 0xa3, 0xb1, 0x6c, 0x4f, 0x32, 0x65, 0x79, 0x52, 0x4a, 0xba, 0x62, 0x24, 0x8b, 0x8c,
+	0xe3, 0x4a, 0x91, 0x71, 0x0d, 0xca, 0x0e, 0x89, 0xec, 0xd0, 0xed, 0xd3, 0x29, 0xf2, 0xfa, 0xa3,
+	0x0a, 0x4a, 0x5e, 0xfc, 0x4d, 0xaa, 0x2f, 0xfe, 0x76, 0x79, 0x2d, 0x7d, 0x0a, 0x7d, 0xe6, 0x0f,
+	0xde, 0xcc, 0x02, 0x5b, 0x34, 0xa5, 0xe6, 0xbe, 0x92, 0x4a, 0xab, 0xdf, 0x83, 0x92, 0x04, 0x8d,
+	0x7a, 0x93, 0x53, 0x52, 0x3d, 0xc7, 0x3e, 0xd4, 0x4f, 0xb6, 0x17, 0x7a, 0xd1, 0xe1, 0x7b, 0x72,
+	0x62, 0xe6, 0xfc, 0xbb, 0x60, 0x8e, 0xa1, 0xb6, 0x94, 0xff, 0x18, 0xd4, 0xa1, 0xc8, 0x09, 0xe9,
+	0x52, 0xd3, 0x60, 0x55, 0x7e, 0x37, 0xff, 0x57, 0x3d, 0x8e, 0x89, 0xf1, 0x85, 0x24, 0x26, 0x3e,
+	0xaf, 0x3b, 0x1c, 0x47, 0xdc, 0x63, 0x55, 0x25, 0xf4, 0xb1, 0x75, 0x8c, 0x2f, 0xdd, 0x49, 0xcf,
+	0x8d, 0xcd, 0x1e, 0x89, 0x43, 0xd7, 0xc6, 0x49, 0x14, 0x0d, 0xa0, 0xa0, 0xe7, 0x08, 0xa1, 0x77,
+	0x3e, 0xf5, 0xde, 0x2e, 0xcd, 0x11, 0x3a, 0x03, 0xfb, 0x80, 0xc4, 0x7c, 0xaf, 0x66, 0x04, 0x78,
+	0x13, 0xa1, 0x5a, 0x1b, 0x2a, 0x1d, 0xcb, 0x31, 0x3b, 0xae, 0x6f, 0x61, 0x38, 0x51, 0xc3, 0xb3,
+	0x95, 0xb1, 0x87, 0xe4, 0x3f, 0x2a, 0x87, 0xb7, 0x5b, 0x9b, 0x96, 0xb3, 0xc9, 0xa9, 0x8d, 0x72,
+	0x27, 0xf9, 0xd0, 0xfe, 0x08, 0x96, 0x45, 0x64, 0x2a, 0xc7, 0x56, 0x5e, 0x76, 0xcf, 0x6c, 0xbc,
+	0x73, 0x82, 0x95, 0x3d, 0xe2, 0xd4, 0xdc, 0xc8, 0x16, 0xb9, 0x94, 0x34, 0x98, 0x56, 0x05, 0x86,
+	0xc4, 0x0f, 0x42, 0x17, 0x2b, 0x26, 0x25, 0x43, 0xcb, 0x30, 0x7d, 0x1e, 0xba, 0x9a, 0x0d, 0x75,
+	0xa5, 0x0b, 0x9c, 0xd5, 0x69, 0xe1, 0x3c, 0x3a, 0xe9, 0x89, 0xa0, 0x8c, 0x5a, 0x77, 0x61, 0x39,
+	0x6f, 0x10, 0xaa, 0x19, 0x7b, 0x6b, 0xbe, 0x38, 0xcc, 0xfa, 0x79, 0xe8, 0x36, 0xff, 0x7d, 0x0c,
+	0x66, 0x33, 0x35, 0x22, 0x59, 0xe5, 0x39, 0x0a, 0xdd, 0x98, 0x24, 0x55, 0x9e, 0x42, 0x52, 0xe5,
+	0x79, 0x45, 0x31, 0xa2, 0xca, 0xf3, 0x67, 0x27, 0x3e, 0x37, 0x1a, 0xc3, 0xb3, 0xf4, 0xc3, 0x37,
+	0x2d, 0x56, 0x9d, 0xef, 0xa9, 0xd1, 0x6f, 0xf7, 0xe5, 0xcf, 0xdf, 0x17, 0x52, 0x6f, 0xe8, 0xf8,
+	0x7d, 0x19, 0x69, 0x3f, 0xc8, 0xab, 0x08, 0x96, 0x37, 0x2e, 0x0e, 0x35, 0x8b, 0xda, 0x7e, 0x7c,
+	0xf7, 0xce, 0x17, 0x54, 0x5e, 0xa6, 0x5c, 0xd8, 0x06, 0x4d, 0xd9, 0x0d, 0xb5, 0x62, 0x38, 0x42,
+	0x4c, 0xb2, 0x53, 0x5c, 0x54, 0xf3, 0x0f, 0x61, 0x21, 0xaf, 0x77, 0x94, 0xea, 0x4d, 0x16, 0x32,
+	0xbd, 0xc9, 0xab, 0x30, 0x2b, 0x7b, 0x93, 0xfc, 0x08, 0xb3, 0xbf, 0x26, 0xcd, 0x08, 0x30, 0x3b,
+	0xc2, 0x9b, 0xf1, 0xd7, 0xdf, 0x34, 0x2e, 0xfc, 0xe2, 0x9b, 0xc6, 0x85, 0x5f, 0x7d, 0xd3, 0x28,
+	0xfc, 0xec, 0x75, 0xa3, 0xf0, 0x8f, 0xaf, 0x1b, 0x85, 0xff, 0x7c, 0xdd, 0x28, 0x7c, 0xfd, 0xba,
+	0x51, 0xf8, 0xe5, 0xeb, 0x46, 0xe1, 0xbf, 0x5f, 0x37, 0x2e, 0xfc, 0xea, 0x75, 0xa3, 0xf0, 0xb7,
+	0xdf, 0x36, 0x2e, 0x7c, 0xfd, 0x6d, 0xe3, 0xc2, 0x2f, 0xbe, 0x6d, 0x5c, 0xf8, 0x83, 0x8f, 0xf7,
+	0x82, 0x64, 0xe5, 0xdd, 0x60, 0xc4, 0x3f, 0xf0, 0x1e, 0x64, 0x61, 0x9d, 0x29, 0x9c, 0xf9, 0xfb,
+	0xff, 0x37, 0x00, 0x14, 0xce, 0x26, 0xee, 0xc4, 0x37, 0x00, 0x00,
 }
 
 func (this *ImmutableClusterMetadata) Equal(that interface{}) bool {
@@ -3331,6 +3438,9 @@ func (this *ShardInfo) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.HandingOff != that1.HandingOff {
+		return false
+	}
 	return true
 }
 func (this *ReplicationTaskInfo) Equal(that interface{}) bool {
@@ -3689,6 +3799,12 @@ func (this *TaskInfo) Equal(that interface{}) bool {
 	if !this.Expiry.Equal(that1.Expiry) {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *AllocatedTaskInfo) Equal(that interface{}) bool {
@@ -3758,6 +3874,20 @@ func (this *TaskQueueInfo) Equal(that interface{}) bool {
 	if !this.LastUpdated.Equal(that1.LastUpdated) {
 		return false
 	}
+	if len(this.BacklogLanes) != len(that1.BacklogLanes) {
+		return false
+	}
+	for i := range this.BacklogLanes {
+		if !this.BacklogLanes[i].Equal(that1.BacklogLanes[i]) {
+			return false
+		}
+	}
+	if this.ReadPartitions != that1.ReadPartitions {
+		return false
+	}
+	if this.WritePartitions != that1.WritePartitions {
+		return false
+	}
 	return true
 }
 func (this *SignalInfo) Equal(that interface{}) bool {
@@ -4390,6 +4520,33 @@ func (this *ReplicationVersions) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TaskQueueBacklogLane) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskQueueBacklogLane)
+	if !ok {
+		that2, ok := that.(TaskQueueBacklogLane)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessBucket != that1.FairnessBucket {
+		return false
+	}
+	return true
+}
 func (this *ImmutableClusterMetadata) GoString() string {
 	if this == nil {
 		return "nil"
@@ -4454,7 +4611,7 @@ func (this *ShardInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 18)
 	s = append(s, "&persistenceblobs.ShardInfo{")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
 	s = append(s, "RangeId: "+fmt.Sprintf("%#v", this.RangeId)+",\n")
@@ -4521,6 +4678,7 @@ func (this *ShardInfo) GoString() string {
 	if this.ReplicationDlqAckLevel != nil {
 		s = append(s, "ReplicationDlqAckLevel: "+mapStringForReplicationDlqAckLevel+",\n")
 	}
+	s = append(s, "HandingOff: "+fmt.Sprintf("%#v", this.HandingOff)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4669,7 +4827,7 @@ func (this *TaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistenceblobs.TaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -4681,6 +4839,8 @@ func (this *TaskInfo) GoString() string {
 	if this.Expiry != nil {
 		s = append(s, "Expiry: "+fmt.Sprintf("%#v", this.Expiry)+",\n")
 	}
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4701,7 +4861,7 @@ func (this *TaskQueueInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 14)
 	s = append(s, "&persistenceblobs.TaskQueueInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
@@ -4714,6 +4874,11 @@ func (this *TaskQueueInfo) GoString() string {
 	if this.LastUpdated != nil {
 		s = append(s, "LastUpdated: "+fmt.Sprintf("%#v", this.LastUpdated)+",\n")
 	}
+	if this.BacklogLanes != nil {
+		s = append(s, "BacklogLanes: "+fmt.Sprintf("%#v", this.BacklogLanes)+",\n")
+	}
+	s = append(s, "ReadPartitions: "+fmt.Sprintf("%#v", this.ReadPartitions)+",\n")
+	s = append(s, "WritePartitions: "+fmt.Sprintf("%#v", this.WritePartitions)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -5005,6 +5170,17 @@ func (this *ReplicationVersions) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TaskQueueBacklogLane) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistenceblobs.TaskQueueBacklogLane{")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessBucket: "+fmt.Sprintf("%#v", this.FairnessBucket)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -5355,6 +5531,16 @@ func (m *ShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HandingOff {
+		i--
+		if m.HandingOff {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.ReplicationDlqAckLevel) > 0 {
 		for k := range m.ReplicationDlqAckLevel {
 			v := m.ReplicationDlqAckLevel[k]
@@ -6067,6 +6253,18 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Priority != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Expiry != nil {
 		{
 			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
@@ -6180,6 +6378,30 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WritePartitions != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.WritePartitions))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ReadPartitions != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ReadPartitions))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.BacklogLanes) > 0 {
+		for iNdEx := len(m.BacklogLanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BacklogLanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.LastUpdated != nil {
 		{
 			size, err := m.LastUpdated.MarshalToSizedBuffer(dAtA[:i])
@@ -7439,6 +7661,39 @@ func (m *ReplicationVersions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TaskQueueBacklogLane) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskQueueBacklogLane) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskQueueBacklogLane) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FairnessBucket != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.FairnessBucket))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Priority != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -7666,6 +7921,9 @@ func (m *ShardInfo) Size() (n int) {
 			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
 		}
 	}
+	if m.HandingOff {
+		n += 2
+	}
 	return n
 }
 
@@ -7961,6 +8219,13 @@ func (m *TaskInfo) Size() (n int) {
 		l = m.Expiry.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovMessage(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -8011,6 +8276,18 @@ func (m *TaskQueueInfo) Size() (n int) {
 		l = m.LastUpdated.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if len(m.BacklogLanes) > 0 {
+		for _, e := range m.BacklogLanes {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.ReadPartitions != 0 {
+		n += 1 + sovMessage(uint64(m.ReadPartitions))
+	}
+	if m.WritePartitions != 0 {
+		n += 1 + sovMessage(uint64(m.WritePartitions))
+	}
 	return n
 }
 
@@ -8564,6 +8841,21 @@ func (m *ReplicationVersions) Size() (n int) {
 	return n
 }
 
+func (m *TaskQueueBacklogLane) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 1 + sovMessage(uint64(m.Priority))
+	}
+	if m.FairnessBucket != 0 {
+		n += 1 + sovMessage(uint64(m.FairnessBucket))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8682,6 +8974,7 @@ func (this *ShardInfo) String() string {
 		`ClusterTimerAckLevel:` + mapStringForClusterTimerAckLevel + `,`,
 		`ClusterReplicationLevel:` + mapStringForClusterReplicationLevel + `,`,
 		`ReplicationDlqAckLevel:` + mapStringForReplicationDlqAckLevel + `,`,
+		`HandingOff:` + fmt.Sprintf("%v", this.HandingOff) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8829,6 +9122,8 @@ func (this *TaskInfo) String() string {
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`CreatedTime:` + strings.Replace(fmt.Sprintf("%v", this.CreatedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Expiry:` + strings.Replace(fmt.Sprintf("%v", this.Expiry), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8848,6 +9143,11 @@ func (this *TaskQueueInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForBacklogLanes := "[]*TaskQueueBacklogLane{"
+	for _, f := range this.BacklogLanes {
+		repeatedStringForBacklogLanes += strings.Replace(f.String(), "TaskQueueBacklogLane", "TaskQueueBacklogLane", 1) + ","
+	}
+	repeatedStringForBacklogLanes += "}"
 	s := strings.Join([]string{`&TaskQueueInfo{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
@@ -8856,6 +9156,9 @@ func (this *TaskQueueInfo) String() string {
 		`AckLevel:` + fmt.Sprintf("%v", this.AckLevel) + `,`,
 		`Expiry:` + strings.Replace(fmt.Sprintf("%v", this.Expiry), "Timestamp", "types.Timestamp", 1) + `,`,
 		`LastUpdated:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdated), "Timestamp", "types.Timestamp", 1) + `,`,
+		`BacklogLanes:` + repeatedStringForBacklogLanes + `,`,
+		`ReadPartitions:` + fmt.Sprintf("%v", this.ReadPartitions) + `,`,
+		`WritePartitions:` + fmt.Sprintf("%v", this.WritePartitions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9124,6 +9427,17 @@ func (this *ReplicationVersions) String() string {
 	}, "")
 	return s
 }
+func (this *TaskQueueBacklogLane) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskQueueBacklogLane{`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessBucket:` + fmt.Sprintf("%v", this.FairnessBucket) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -10842,6 +11156,26 @@ func (m *ShardInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.ReplicationDlqAckLevel[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandingOff", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HandingOff = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -12956,6 +13290,57 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -13310,6 +13695,78 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogLanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BacklogLanes = append(m.BacklogLanes, &TaskQueueBacklogLane{})
+			if err := m.BacklogLanes[len(m.BacklogLanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPartitions", wireType)
+			}
+			m.ReadPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadPartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WritePartitions", wireType)
+			}
+			m.WritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -17368,6 +17825,97 @@ func (m *ReplicationVersions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TaskQueueBacklogLane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueueBacklogLane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueueBacklogLane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessBucket", wireType)
+			}
+			m.FairnessBucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FairnessBucket |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -244,6 +244,7 @@ type Task struct {
 	ActivityId      string `protobuf:"bytes,6,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
 	WorkflowType    string `protobuf:"bytes,7,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
 	ActivityType    string `protobuf:"bytes,8,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	TaskQueue       string `protobuf:"bytes,9,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
 }
 
 func (m *Task) Reset()      { *m = Task{} }
@@ -334,6 +335,13 @@ func (m *Task) GetActivityType() string {
 	return ""
 }
 
+func (m *Task) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
 type QueryTask struct {
 	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -406,55 +414,55 @@ func init() {
 }
 
 var fileDescriptor_020fff7d28118bec = []byte{
-	// 757 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4b, 0x4f, 0xdb, 0x4a,
-	0x14, 0xc7, 0x63, 0x02, 0x21, 0x1e, 0x87, 0x4b, 0x62, 0xee, 0x23, 0x42, 0x5c, 0x93, 0x9b, 0x7b,
-	0x17, 0xb9, 0xb4, 0x72, 0x78, 0xa8, 0x52, 0xd5, 0xae, 0xfa, 0xa0, 0xc2, 0x4b, 0x46, 0x51, 0x2b,
-	0x55, 0x6a, 0xad, 0x21, 0x9e, 0xc0, 0x28, 0x61, 0x6c, 0x66, 0xc6, 0x4e, 0xb3, 0xeb, 0x47, 0xe8,
-	0xb2, 0xab, 0xae, 0xfb, 0x51, 0x58, 0xb2, 0x64, 0x59, 0xc2, 0xa6, 0x4b, 0x3e, 0x42, 0x35, 0x63,
-	0x3b, 0x76, 0x83, 0xfb, 0x50, 0x77, 0xce, 0xff, 0xfc, 0xe6, 0x3f, 0x27, 0xe7, 0xfc, 0x9d, 0x80,
-	0x2d, 0x81, 0x4f, 0x03, 0x9f, 0xa1, 0x51, 0x97, 0x63, 0x16, 0x61, 0xd6, 0x45, 0x01, 0xe9, 0x0a,
-	0x7f, 0x88, 0x69, 0x37, 0xda, 0xe9, 0x9e, 0x62, 0xce, 0xd1, 0x31, 0xb6, 0x03, 0xe6, 0x0b, 0xdf,
-	0xdc, 0x48, 0x59, 0x3b, 0x66, 0x6d, 0x14, 0x10, 0x5b, 0xb1, 0x76, 0xb4, 0xb3, 0x7e, 0xb7, 0xc8,
-	0xe9, 0x84, 0x70, 0xe1, 0xb3, 0xc9, 0x2d, 0xaf, 0xf5, 0xed, 0x22, 0x9a, 0xe1, 0x60, 0x44, 0xfa,
-	0x48, 0x10, 0xff, 0xf6, 0xed, 0xed, 0xf3, 0x45, 0xb0, 0x76, 0x10, 0xdb, 0x3d, 0xf1, 0xa9, 0x20,
-	0x34, 0x54, 0xa0, 0xf9, 0x07, 0xa8, 0xb0, 0x90, 0xba, 0xc4, 0x6b, 0x6a, 0x2d, 0xad, 0xa3, 0xc3,
-	0x25, 0x16, 0x52, 0xc7, 0x33, 0xff, 0x03, 0xbf, 0x0d, 0x08, 0xe3, 0xc2, 0xc5, 0x11, 0xa6, 0x42,
-	0x96, 0x17, 0x5a, 0x5a, 0xa7, 0x0c, 0x6b, 0x4a, 0xdd, 0x97, 0xa2, 0xe3, 0x99, 0x6d, 0xb0, 0x42,
-	0xf1, 0x9b, 0x1c, 0x54, 0x56, 0x90, 0x21, 0xc5, 0x94, 0xb1, 0xc1, 0x1a, 0xe1, 0xee, 0xd8, 0x67,
-	0xc3, 0xc1, 0xc8, 0x1f, 0xbb, 0x2c, 0xa4, 0x94, 0xd0, 0xe3, 0xe6, 0x52, 0x4b, 0xeb, 0x54, 0x61,
-	0x83, 0xf0, 0x17, 0x49, 0x05, 0xc6, 0x05, 0xf3, 0x0e, 0x68, 0x04, 0x98, 0x71, 0xc2, 0x05, 0xa6,
-	0x7d, 0xec, 0xaa, 0x01, 0x35, 0x2b, 0x2d, 0xad, 0x53, 0x83, 0xf5, 0x5c, 0xa1, 0x27, 0x75, 0xd3,
-	0x03, 0xa6, 0x60, 0x88, 0x72, 0x22, 0xef, 0xf7, 0x70, 0x9f, 0x70, 0xe2, 0xd3, 0xe6, 0x72, 0x4b,
-	0xeb, 0x18, 0xbb, 0xf7, 0xec, 0xa2, 0x81, 0x27, 0x23, 0xb5, 0xa3, 0x1d, 0xbb, 0x97, 0x9e, 0x7c,
-	0x9a, 0x1c, 0x74, 0xe8, 0xc0, 0x87, 0x0d, 0x31, 0x2f, 0x9b, 0xff, 0x80, 0xda, 0x11, 0x43, 0xb4,
-	0x7f, 0x92, 0x74, 0x53, 0x55, 0xdd, 0x18, 0xb1, 0x16, 0x37, 0x72, 0x06, 0xea, 0xb9, 0xf1, 0xbb,
-	0x84, 0x0e, 0xfc, 0xa6, 0xde, 0x2a, 0x77, 0x8c, 0xdd, 0x67, 0xf6, 0xf7, 0xf6, 0x6e, 0x17, 0xec,
-	0xc4, 0x86, 0x99, 0x93, 0x6c, 0x69, 0x9f, 0x0a, 0x36, 0x81, 0xab, 0xec, 0x6b, 0x75, 0x7d, 0x0c,
-	0x7e, 0x2f, 0x02, 0xcd, 0x3a, 0x28, 0x0f, 0xf1, 0x24, 0x59, 0xa7, 0x7c, 0x34, 0x1d, 0xb0, 0x14,
-	0xa1, 0x51, 0x88, 0xd5, 0x0e, 0x8d, 0xdd, 0xbd, 0xc2, 0x8e, 0x72, 0xf6, 0xb2, 0xaf, 0x39, 0x6b,
-	0x18, 0x3b, 0x3c, 0x58, 0xb8, 0xaf, 0xb5, 0x3f, 0x94, 0xc1, 0x9f, 0x10, 0x8d, 0x8b, 0xd2, 0xb4,
-	0x01, 0x74, 0x8a, 0x4e, 0x31, 0x0f, 0x50, 0x1f, 0x27, 0x1d, 0x64, 0x82, 0xb9, 0x09, 0x8c, 0x59,
-	0x0e, 0x92, 0x44, 0xe9, 0x10, 0xa4, 0x92, 0xe3, 0xe5, 0xc2, 0x58, 0x9e, 0x0b, 0x23, 0x17, 0x88,
-	0xe5, 0x72, 0xb6, 0x18, 0x87, 0x51, 0xa9, 0xb9, 0xa0, 0xe5, 0xa9, 0x48, 0x66, 0xc5, 0xa7, 0x2a,
-	0x68, 0x65, 0xd8, 0xc8, 0xd0, 0xe7, 0x71, 0xc1, 0x6c, 0x81, 0x1a, 0xa6, 0x5e, 0xe6, 0x59, 0x51,
-	0x20, 0xc0, 0xd4, 0x4b, 0x1d, 0xb7, 0x40, 0x23, 0x23, 0x52, 0xbf, 0x65, 0x85, 0xad, 0xa6, 0x58,
-	0xea, 0x56, 0x18, 0xdb, 0xea, 0x37, 0x62, 0xfb, 0x0a, 0x34, 0x12, 0x3b, 0x37, 0xce, 0x23, 0xc1,
-	0xbc, 0xa9, 0xab, 0xe5, 0x6c, 0xff, 0x28, 0xb5, 0xc9, 0x85, 0x07, 0xe9, 0x39, 0x58, 0x8f, 0xe6,
-	0x94, 0xf6, 0xfb, 0x05, 0xb0, 0xd8, 0x43, 0x7c, 0x28, 0x83, 0x3b, 0x9b, 0x7e, 0xf6, 0x8a, 0x1b,
-	0x33, 0xcd, 0xf1, 0x7e, 0x79, 0x27, 0x9b, 0xc0, 0xe0, 0xfd, 0x13, 0xec, 0x85, 0x23, 0x9c, 0x2d,
-	0x04, 0xa4, 0x92, 0xe3, 0x99, 0xff, 0x83, 0xfa, 0x0c, 0x40, 0x42, 0x7e, 0x29, 0x91, 0xec, 0x62,
-	0x35, 0xd5, 0x1f, 0xc5, 0xb2, 0xf4, 0x42, 0x7d, 0x41, 0x22, 0x22, 0x26, 0xe9, 0x22, 0x74, 0x08,
-	0x52, 0xc9, 0xf1, 0xcc, 0x7f, 0xc1, 0xca, 0xac, 0x49, 0x31, 0x09, 0xb0, 0x5a, 0x82, 0x0e, 0x6b,
-	0xa9, 0xd8, 0x9b, 0x04, 0x58, 0x42, 0x33, 0x17, 0x05, 0x55, 0x63, 0x28, 0x15, 0x25, 0xd4, 0x1e,
-	0x00, 0xfd, 0x30, 0xc4, 0x6c, 0xf2, 0xb3, 0xe3, 0xf9, 0x1b, 0x00, 0x81, 0xf8, 0xd0, 0x3d, 0x0b,
-	0x71, 0xf2, 0xfe, 0xe8, 0x50, 0x97, 0xca, 0xa1, 0x14, 0xcc, 0xbf, 0xc0, 0xb2, 0x2a, 0xcf, 0xa6,
-	0x53, 0x91, 0x1f, 0x1d, 0xef, 0xf1, 0xeb, 0x8b, 0x2b, 0xab, 0x74, 0x79, 0x65, 0x95, 0x6e, 0xae,
-	0x2c, 0xed, 0xed, 0xd4, 0xd2, 0x3e, 0x4e, 0x2d, 0xed, 0x7c, 0x6a, 0x69, 0x17, 0x53, 0x4b, 0xfb,
-	0x34, 0xb5, 0xb4, 0xcf, 0x53, 0xab, 0x74, 0x33, 0xb5, 0xb4, 0x77, 0xd7, 0x56, 0xe9, 0xe2, 0xda,
-	0x2a, 0x5d, 0x5e, 0x5b, 0xa5, 0x97, 0x9d, 0x63, 0x3f, 0x5b, 0x3f, 0xf1, 0x8b, 0xfe, 0x54, 0x1e,
-	0xaa, 0x87, 0xa3, 0x8a, 0xfa, 0x55, 0xdf, 0xfb, 0x12, 0x00, 0x00, 0xff, 0xff, 0x62, 0x32, 0x57,
-	0xcb, 0x81, 0x06, 0x00, 0x00,
+	// 762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x86, 0x45, 0xc9, 0x96, 0xc5, 0xa1, 0x5c, 0x4b, 0x74, 0x2f, 0x82, 0xe1, 0xd2, 0xaa, 0xda,
+	0x85, 0xea, 0x16, 0x94, 0x2f, 0x28, 0x50, 0xb4, 0xab, 0x5c, 0x1c, 0x98, 0x4b, 0x0f, 0x84, 0x04,
+	0x08, 0x90, 0x10, 0x63, 0x71, 0x64, 0x0f, 0x24, 0x0f, 0xe9, 0x99, 0x21, 0x15, 0xed, 0xf2, 0x08,
+	0x79, 0x82, 0xac, 0xf3, 0x06, 0x79, 0x05, 0x2f, 0xbd, 0xf4, 0x32, 0x96, 0x37, 0x59, 0xfa, 0x11,
+	0x82, 0x19, 0x92, 0x22, 0x2d, 0x33, 0x17, 0x64, 0x47, 0xfd, 0xe7, 0x9b, 0x7f, 0x8e, 0xce, 0xf9,
+	0x25, 0x82, 0x6d, 0x81, 0xcf, 0x02, 0x9f, 0xa1, 0x71, 0x8f, 0x63, 0x16, 0x61, 0xd6, 0x43, 0x01,
+	0xe9, 0x09, 0x7f, 0x84, 0x69, 0x2f, 0xda, 0xed, 0x9d, 0x61, 0xce, 0xd1, 0x09, 0xb6, 0x03, 0xe6,
+	0x0b, 0xdf, 0xdc, 0x4c, 0x59, 0x3b, 0x66, 0x6d, 0x14, 0x10, 0x5b, 0xb1, 0x76, 0xb4, 0xbb, 0xf1,
+	0x77, 0x91, 0xd3, 0x29, 0xe1, 0xc2, 0x67, 0xd3, 0x7b, 0x5e, 0x1b, 0x3b, 0x45, 0x34, 0xc3, 0xc1,
+	0x98, 0x0c, 0x90, 0x20, 0xfe, 0xfd, 0xdb, 0x3b, 0x17, 0x4b, 0x60, 0xfd, 0x30, 0xb6, 0x7b, 0xe4,
+	0x53, 0x41, 0x68, 0xa8, 0x40, 0xf3, 0x27, 0x50, 0x65, 0x21, 0x75, 0x89, 0xd7, 0xd2, 0xda, 0x5a,
+	0x57, 0x87, 0xcb, 0x2c, 0xa4, 0x8e, 0x67, 0xfe, 0x01, 0x7e, 0x18, 0x12, 0xc6, 0x85, 0x8b, 0x23,
+	0x4c, 0x85, 0x2c, 0x97, 0xdb, 0x5a, 0xb7, 0x02, 0xeb, 0x4a, 0x3d, 0x90, 0xa2, 0xe3, 0x99, 0x1d,
+	0xb0, 0x4a, 0xf1, 0xab, 0x1c, 0x54, 0x51, 0x90, 0x21, 0xc5, 0x94, 0xb1, 0xc1, 0x3a, 0xe1, 0xee,
+	0xc4, 0x67, 0xa3, 0xe1, 0xd8, 0x9f, 0xb8, 0x2c, 0xa4, 0x94, 0xd0, 0x93, 0xd6, 0x72, 0x5b, 0xeb,
+	0xd6, 0x60, 0x93, 0xf0, 0x67, 0x49, 0x05, 0xc6, 0x05, 0xf3, 0x2f, 0xd0, 0x0c, 0x30, 0xe3, 0x84,
+	0x0b, 0x4c, 0x07, 0xd8, 0x55, 0x03, 0x6a, 0x55, 0xdb, 0x5a, 0xb7, 0x0e, 0x1b, 0xb9, 0x42, 0x5f,
+	0xea, 0xa6, 0x07, 0x4c, 0xc1, 0x10, 0xe5, 0x44, 0xde, 0xef, 0xe1, 0x01, 0xe1, 0xc4, 0xa7, 0xad,
+	0x95, 0xb6, 0xd6, 0x35, 0xf6, 0xfe, 0xb1, 0x8b, 0x06, 0x9e, 0x8c, 0xd4, 0x8e, 0x76, 0xed, 0x7e,
+	0x7a, 0xf2, 0x71, 0x72, 0xd0, 0xa1, 0x43, 0x1f, 0x36, 0xc5, 0xa2, 0x6c, 0xfe, 0x06, 0xea, 0xc7,
+	0x0c, 0xd1, 0xc1, 0x69, 0xd2, 0x4d, 0x4d, 0x75, 0x63, 0xc4, 0x5a, 0xdc, 0xc8, 0x39, 0x68, 0xe4,
+	0xc6, 0xef, 0x12, 0x3a, 0xf4, 0x5b, 0x7a, 0xbb, 0xd2, 0x35, 0xf6, 0x9e, 0xd8, 0x5f, 0xda, 0xbb,
+	0x5d, 0xb0, 0x13, 0x1b, 0x66, 0x4e, 0xb2, 0xa5, 0x03, 0x2a, 0xd8, 0x14, 0xae, 0xb1, 0xbb, 0xea,
+	0xc6, 0x04, 0xfc, 0x58, 0x04, 0x9a, 0x0d, 0x50, 0x19, 0xe1, 0x69, 0xb2, 0x4e, 0xf9, 0x68, 0x3a,
+	0x60, 0x39, 0x42, 0xe3, 0x10, 0xab, 0x1d, 0x1a, 0x7b, 0xfb, 0x85, 0x1d, 0xe5, 0xec, 0x65, 0x5f,
+	0x0b, 0xd6, 0x30, 0x76, 0xf8, 0xaf, 0xfc, 0xaf, 0xd6, 0x79, 0x5b, 0x01, 0x3f, 0x43, 0x34, 0x29,
+	0x4a, 0xd3, 0x26, 0xd0, 0x29, 0x3a, 0xc3, 0x3c, 0x40, 0x03, 0x9c, 0x74, 0x90, 0x09, 0xe6, 0x16,
+	0x30, 0xe6, 0x39, 0x48, 0x12, 0xa5, 0x43, 0x90, 0x4a, 0x8e, 0x97, 0x0b, 0x63, 0x65, 0x21, 0x8c,
+	0x5c, 0x20, 0x96, 0xcb, 0xd9, 0x52, 0x1c, 0x46, 0xa5, 0xe6, 0x82, 0x96, 0xa7, 0x22, 0x99, 0x15,
+	0x9f, 0xaa, 0xa0, 0x55, 0x60, 0x33, 0x43, 0x9f, 0xc6, 0x05, 0xb3, 0x0d, 0xea, 0x98, 0x7a, 0x99,
+	0x67, 0x55, 0x81, 0x00, 0x53, 0x2f, 0x75, 0xdc, 0x06, 0xcd, 0x8c, 0x48, 0xfd, 0x56, 0x14, 0xb6,
+	0x96, 0x62, 0xa9, 0x5b, 0x61, 0x6c, 0x6b, 0x9f, 0x89, 0xed, 0x0b, 0xd0, 0x4c, 0xec, 0xdc, 0x38,
+	0x8f, 0x04, 0xf3, 0x96, 0xae, 0x96, 0xb3, 0xf3, 0xb5, 0xd4, 0x26, 0x17, 0x1e, 0xa6, 0xe7, 0x60,
+	0x23, 0x5a, 0x50, 0x3a, 0xef, 0xcb, 0x60, 0xa9, 0x8f, 0xf8, 0x48, 0x06, 0x77, 0x3e, 0xfd, 0xec,
+	0x27, 0x6e, 0xcc, 0x35, 0xc7, 0xfb, 0xee, 0x9d, 0x6c, 0x01, 0x83, 0x0f, 0x4e, 0xb1, 0x17, 0x8e,
+	0x71, 0xb6, 0x10, 0x90, 0x4a, 0x8e, 0x67, 0xfe, 0x09, 0x1a, 0x73, 0x00, 0x09, 0xf9, 0xa5, 0x44,
+	0xb2, 0x8b, 0xb5, 0x54, 0x7f, 0x10, 0xcb, 0xd2, 0x0b, 0x0d, 0x04, 0x89, 0x88, 0x98, 0xa6, 0x8b,
+	0xd0, 0x21, 0x48, 0x25, 0xc7, 0x33, 0x7f, 0x07, 0xab, 0xf3, 0x26, 0xc5, 0x34, 0xc0, 0x6a, 0x09,
+	0x3a, 0xac, 0xa7, 0x62, 0x7f, 0x1a, 0x60, 0x09, 0xcd, 0x5d, 0x14, 0x54, 0x8b, 0xa1, 0x54, 0x54,
+	0xd0, 0xaf, 0x00, 0x08, 0xc4, 0x47, 0xee, 0x79, 0x88, 0x43, 0xac, 0x46, 0xae, 0x43, 0x5d, 0x2a,
+	0x47, 0x52, 0xe8, 0x0c, 0x81, 0x7e, 0x14, 0x62, 0x36, 0xfd, 0xd6, 0xe9, 0xdd, 0xb5, 0x2b, 0x2f,
+	0xd8, 0x99, 0xbf, 0x80, 0x15, 0x55, 0x9e, 0x0f, 0xaf, 0x2a, 0x3f, 0x3a, 0xde, 0xc3, 0x97, 0x97,
+	0xd7, 0x56, 0xe9, 0xea, 0xda, 0x2a, 0xdd, 0x5e, 0x5b, 0xda, 0xeb, 0x99, 0xa5, 0xbd, 0x9b, 0x59,
+	0xda, 0xc5, 0xcc, 0xd2, 0x2e, 0x67, 0x96, 0xf6, 0x61, 0x66, 0x69, 0x1f, 0x67, 0x56, 0xe9, 0x76,
+	0x66, 0x69, 0x6f, 0x6e, 0xac, 0xd2, 0xe5, 0x8d, 0x55, 0xba, 0xba, 0xb1, 0x4a, 0xcf, 0xbb, 0x27,
+	0x7e, 0x96, 0x0e, 0xe2, 0x17, 0xbd, 0x73, 0xfe, 0x57, 0x0f, 0xc7, 0x55, 0xf5, 0xa7, 0xbf, 0xff,
+	0x69, 0x00, 0xec, 0xde, 0x14, 0x28, 0xa0, 0x06, 0x00, 0x00,
 }
 
 func (this *HistoryContinuation) Equal(that interface{}) bool {
@@ -598,6 +606,9 @@ func (this *Task) Equal(that interface{}) bool {
 	if this.ActivityType != that1.ActivityType {
 		return false
 	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
 	return true
 }
 func (this *QueryTask) Equal(that interface{}) bool {
@@ -685,7 +696,7 @@ func (this *Task) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&token.Task{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -695,6 +706,7 @@ func (this *Task) GoString() string {
 	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
 	s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
 	s = append(s, "ActivityType: "+fmt.Sprintf("%#v", this.ActivityType)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -923,6 +935,13 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ActivityType) > 0 {
 		i -= len(m.ActivityType)
 		copy(dAtA[i:], m.ActivityType)
@@ -1157,6 +1176,10 @@ func (m *Task) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -1245,6 +1268,7 @@ func (this *Task) String() string {
 		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
 		`WorkflowType:` + fmt.Sprintf("%v", this.WorkflowType) + `,`,
 		`ActivityType:` + fmt.Sprintf("%v", this.ActivityType) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2199,6 +2223,38 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			}
 			m.ActivityType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -344,3 +344,120 @@ func (c *metricClient) ResendReplicationTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.GetDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.UpdateDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.ListDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigHistoryScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDynamicConfigHistoryScope, metrics.ClientLatency)
+	resp, err := c.client.ListDynamicConfigHistory(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigHistoryScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DumpDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DumpDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DumpDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDumpDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDumpDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.DumpDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDumpDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+func (c *metricClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+func (c *metricClient) BackfillArchival(
+	ctx context.Context,
+	request *adminservice.BackfillArchivalRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BackfillArchivalResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientBackfillArchivalScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientBackfillArchivalScope, metrics.ClientLatency)
+	resp, err := c.client.BackfillArchival(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientBackfillArchivalScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
@@ -317,3 +317,113 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+
+	var resp *adminservice.GetDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.UpdateDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateDynamicConfigResponse, error) {
+
+	var resp *adminservice.UpdateDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+
+	var resp *adminservice.ListDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigHistoryResponse, error) {
+
+	var resp *adminservice.ListDynamicConfigHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDynamicConfigHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DumpDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DumpDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DumpDynamicConfigResponse, error) {
+
+	var resp *adminservice.DumpDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DumpDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+func (c *retryableClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	var resp *adminservice.DeleteNamespaceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+func (c *retryableClient) BackfillArchival(
+	ctx context.Context,
+	request *adminservice.BackfillArchivalRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.BackfillArchivalResponse, error) {
+
+	var resp *adminservice.BackfillArchivalResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.BackfillArchival(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -153,11 +153,12 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 		return matchingservice.NewMatchingServiceClient(connection), nil
 	}
 
+	clients := common.NewClientCache(keyResolver, clientProvider)
 	client := matching.NewClient(
 		timeout,
 		longPollTimeout,
-		common.NewClientCache(keyResolver, clientProvider),
-		matching.NewLoadBalancer(namespaceIDToName, cf.dynConfig),
+		clients,
+		matching.NewLoadBalancer(namespaceIDToName, cf.dynConfig, clients),
 	)
 
 	if cf.metricsClient != nil {
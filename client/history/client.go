@@ -29,6 +29,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
 	"go.temporal.io/api/serviceerror"
 	"google.golang.org/grpc"
 
@@ -1031,6 +1033,54 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return response, nil
 }
 
+func (c *clientImpl) HandoffShard(
+	ctx context.Context,
+	request *historyservice.HandoffShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.HandoffShardResponse, error) {
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.HandoffShardResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.HandoffShard(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.DeleteWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -1063,6 +1113,9 @@ redirectLoop:
 		if err != nil {
 			if s, ok := err.(*serviceerror.ShardOwnershipLost); ok {
 				// TODO: consider emitting a metric for number of redirects
+				if span := opentracing.SpanFromContext(ctx); span != nil {
+					span.LogFields(otlog.String("event", "shard ownership lost"), otlog.String("owner", s.Owner))
+				}
 				ret, err := c.clients.GetClientForClientKey(s.Owner)
 				if err != nil {
 					return err
@@ -660,3 +660,37 @@ func (c *metricClient) RefreshWorkflowTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) HandoffShard(
+	ctx context.Context,
+	request *historyservice.HandoffShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.HandoffShardResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientHandoffShardScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientHandoffShardScope, metrics.ClientLatency)
+	resp, err := c.client.HandoffShard(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientHandoffShardScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
@@ -28,6 +28,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
 	enumspb "go.temporal.io/api/enums/v1"
 	"google.golang.org/grpc"
 
@@ -77,6 +79,7 @@ func (c *clientImpl) AddActivityTask(
 		request.GetForwardedFrom(),
 	)
 	request.TaskQueue.Name = partition
+	tracePartition(ctx, partition)
 	client, err := c.getClientForTaskqueue(partition)
 	if err != nil {
 		return nil, err
@@ -97,6 +100,7 @@ func (c *clientImpl) AddDecisionTask(
 		request.GetForwardedFrom(),
 	)
 	request.TaskQueue.Name = partition
+	tracePartition(ctx, partition)
 	client, err := c.getClientForTaskqueue(request.TaskQueue.GetName())
 	if err != nil {
 		return nil, err
@@ -117,6 +121,7 @@ func (c *clientImpl) PollForActivityTask(
 		request.GetForwardedFrom(),
 	)
 	request.PollRequest.TaskQueue.Name = partition
+	tracePartition(ctx, partition)
 	client, err := c.getClientForTaskqueue(request.PollRequest.TaskQueue.GetName())
 	if err != nil {
 		return nil, err
@@ -137,6 +142,7 @@ func (c *clientImpl) PollForDecisionTask(
 		request.GetForwardedFrom(),
 	)
 	request.PollRequest.TaskQueue.Name = partition
+	tracePartition(ctx, partition)
 	client, err := c.getClientForTaskqueue(request.PollRequest.TaskQueue.GetName())
 	if err != nil {
 		return nil, err
@@ -154,6 +160,7 @@ func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice
 		request.GetForwardedFrom(),
 	)
 	request.TaskQueue.Name = partition
+	tracePartition(ctx, partition)
 	client, err := c.getClientForTaskqueue(request.TaskQueue.GetName())
 	if err != nil {
 		return nil, err
@@ -203,6 +210,13 @@ func (c *clientImpl) ListTaskQueuePartitions(ctx context.Context, request *match
 	return client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+// tracePartition records the task queue partition picked by the load balancer on the span of the caller
+func tracePartition(ctx context.Context, partition string) {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		span.LogFields(otlog.String("event", "matching partition picked"), otlog.String("partition", partition))
+	}
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -25,13 +25,19 @@
 package matching
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
@@ -65,26 +71,62 @@ type (
 	}
 
 	defaultLoadBalancer struct {
-		nReadPartitions   dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		nWritePartitions  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		namespaceIDToName func(string) (string, error)
+		nReadPartitions            dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		nWritePartitions           dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		enablePartitionAutoscaling dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		namespaceIDToName          func(string) (string, error)
+		clients                    common.ClientCache
+		layouts                    cache.Cache
+	}
+
+	partitionLayoutKey struct {
+		namespace     string
+		taskQueue     string
+		taskQueueType enumspb.TaskQueueType
+	}
+
+	// partitionLayout is the number of read and write partitions of an autoscaled task queue, as listed by
+	// its root partition
+	partitionLayout struct {
+		numReadPartitions  int32
+		numWritePartitions int32
+		refreshedAt        int64
+		refreshing         int32
 	}
 )
 
 const (
 	taskQueuePartitionPrefix = "/__temporal_sys/"
+
+	// partitionLayoutTTL is the time after which the partitions of an autoscaled task queue are listed again
+	partitionLayoutTTL = 10 * time.Second
+	// partitionLayoutRefreshTimeout is the timeout of the call listing the partitions of a task queue
+	partitionLayoutRefreshTimeout = 5 * time.Second
+	// partitionLayoutCacheTTL is the time after which the partitions of an autoscaled task queue are evicted,
+	// the layout is put again in the cache each time it is listed
+	partitionLayoutCacheTTL = 5 * time.Minute
+	// partitionLayoutCacheMaxSize is the maximum number of autoscaled task queues whose partitions are cached
+	partitionLayoutCacheMaxSize = 10000
 )
 
 // NewLoadBalancer returns an instance of matching load balancer that
-// can help distribute api calls across task queue partitions
+// can help distribute api calls across task queue partitions.
+// The partitions of the task queues with partition autoscaling are listed from
+// their root partition, using the given matching client cache
 func NewLoadBalancer(
 	namespaceIDToName func(string) (string, error),
 	dc *dynamicconfig.Collection,
+	clients common.ClientCache,
 ) LoadBalancer {
 	return &defaultLoadBalancer{
-		namespaceIDToName: namespaceIDToName,
-		nReadPartitions:   dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingNumTaskqueueReadPartitions, 1),
-		nWritePartitions:  dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingNumTaskqueueWritePartitions, 1),
+		namespaceIDToName:          namespaceIDToName,
+		nReadPartitions:            dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingNumTaskqueueReadPartitions, 1),
+		nWritePartitions:           dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingNumTaskqueueWritePartitions, 1),
+		enablePartitionAutoscaling: dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnablePartitionAutoscaling, false),
+		clients:                    clients,
+		layouts: cache.New(partitionLayoutCacheMaxSize, &cache.Options{
+			TTL: partitionLayoutCacheTTL,
+		}),
 	}
 }
 
@@ -94,7 +136,7 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nWritePartitions)
+	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nWritePartitions, false)
 }
 
 func (lb *defaultLoadBalancer) PickReadPartition(
@@ -103,7 +145,7 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nReadPartitions)
+	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nReadPartitions, true)
 }
 
 func (lb *defaultLoadBalancer) pickPartition(
@@ -112,6 +154,7 @@ func (lb *defaultLoadBalancer) pickPartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 	nPartitions dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters,
+	isRead bool,
 ) string {
 
 	if forwardedFrom != "" || taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
@@ -129,6 +172,13 @@ func (lb *defaultLoadBalancer) pickPartition(
 	}
 
 	n := nPartitions(namespace, taskQueue.GetName(), taskQueueType)
+	if lb.enablePartitionAutoscaling(namespace, taskQueue.GetName(), taskQueueType) {
+		nRead, nWrite := lb.getPartitions(namespace, taskQueue.GetName(), taskQueueType)
+		n = nWrite
+		if isRead {
+			n = nRead
+		}
+	}
 	if n <= 0 {
 		return taskQueue.GetName()
 	}
@@ -140,3 +190,66 @@ func (lb *defaultLoadBalancer) pickPartition(
 
 	return fmt.Sprintf("%v%v/%v", taskQueuePartitionPrefix, taskQueue.GetName(), p)
 }
+
+// getPartitions returns the number of read and write partitions of an autoscaled task queue. The partitions
+// are listed asynchronously, the partitions of the dynamic config are used until they are listed
+func (lb *defaultLoadBalancer) getPartitions(
+	namespace string,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) (int, int) {
+	key := partitionLayoutKey{namespace: namespace, taskQueue: taskQueue, taskQueueType: taskQueueType}
+	layout, ok := lb.layouts.Get(key).(*partitionLayout)
+	if !ok {
+		value, err := lb.layouts.PutIfNotExist(key, &partitionLayout{
+			numReadPartitions:  int32(lb.nReadPartitions(namespace, taskQueue, taskQueueType)),
+			numWritePartitions: int32(lb.nWritePartitions(namespace, taskQueue, taskQueueType)),
+		})
+		if err != nil {
+			return lb.nReadPartitions(namespace, taskQueue, taskQueueType), lb.nWritePartitions(namespace, taskQueue, taskQueueType)
+		}
+		layout = value.(*partitionLayout)
+	}
+
+	if time.Since(time.Unix(0, atomic.LoadInt64(&layout.refreshedAt))) > partitionLayoutTTL &&
+		atomic.CompareAndSwapInt32(&layout.refreshing, 0, 1) {
+		go lb.refreshLayout(key, layout)
+	}
+	return int(atomic.LoadInt32(&layout.numReadPartitions)), int(atomic.LoadInt32(&layout.numWritePartitions))
+}
+
+func (lb *defaultLoadBalancer) refreshLayout(key partitionLayoutKey, layout *partitionLayout) {
+	defer atomic.StoreInt32(&layout.refreshing, 0)
+
+	client, err := lb.clients.GetClientForKey(key.taskQueue)
+	if err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), partitionLayoutRefreshTimeout)
+	defer cancel()
+	resp, err := client.(matchingservice.MatchingServiceClient).ListTaskQueuePartitions(ctx, &matchingservice.ListTaskQueuePartitionsRequest{
+		Namespace: key.namespace,
+		TaskQueue: &taskqueuepb.TaskQueue{Name: key.taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+	})
+	if err != nil {
+		return
+	}
+
+	// the read partitions are listed, the partitions above the write partitions are only drained
+	partitions := resp.GetDecisionTaskQueuePartitions()
+	writePartitions := resp.GetDecisionTaskQueueWritePartitions()
+	if key.taskQueueType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		partitions = resp.GetActivityTaskQueuePartitions()
+		writePartitions = resp.GetActivityTaskQueueWritePartitions()
+	}
+	if len(partitions) > 0 {
+		if writePartitions <= 0 || int(writePartitions) > len(partitions) {
+			writePartitions = int32(len(partitions))
+		}
+		atomic.StoreInt32(&layout.numReadPartitions, int32(len(partitions)))
+		atomic.StoreInt32(&layout.numWritePartitions, writePartitions)
+	}
+	atomic.StoreInt64(&layout.refreshedAt, time.Now().UnixNano())
+	// the layout of a task queue still in use is not evicted
+	lb.layouts.Put(key, layout)
+}
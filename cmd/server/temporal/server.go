@@ -166,6 +166,11 @@ func (s *server) startService() common.Daemon {
 	// This is to keep this check hidden from independent downstream daemons and keep this in a single place.
 	immutableClusterMetadataInitialization(params.Logger, dc, &params.PersistenceConfig, &params.AbstractDatastoreFactory, &params.MetricsClient, clusterMetadata)
 
+	if s.cfg.DynamicConfigPersistence.Enabled {
+		params.DynamicConfig = s.newPersistenceBasedDynamicConfigClient(params.DynamicConfig, params.Logger, dc, &params.PersistenceConfig, &params.AbstractDatastoreFactory, &params.MetricsClient, clusterMetadata)
+		dc = dynamicconfig.NewCollection(params.DynamicConfig, params.Logger)
+	}
+
 	params.ClusterMetadata = cluster.NewMetadata(
 		params.Logger,
 		dc.GetBoolProperty(dynamicconfig.EnableGlobalNamespace, clusterMetadata.EnableGlobalNamespace),
@@ -206,14 +211,6 @@ func (s *server) startService() common.Daemon {
 		common.GetDefaultAdvancedVisibilityWritingMode(params.PersistenceConfig.IsAdvancedVisibilityConfigExist()),
 	)()
 	isAdvancedVisEnabled := advancedVisMode != common.AdvancedVisibilityWritingModeOff
-	if params.ClusterMetadata.IsGlobalNamespaceEnabled() {
-		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, true, isAdvancedVisEnabled)
-	} else if isAdvancedVisEnabled {
-		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, false, isAdvancedVisEnabled)
-	} else {
-		params.MessagingClient = nil
-	}
-
 	if isAdvancedVisEnabled {
 		// verify config of advanced visibility store
 		advancedVisStoreKey := s.cfg.Persistence.AdvancedVisibilityStore
@@ -236,6 +233,16 @@ func (s *server) startService() common.Daemon {
 		}
 	}
 
+	// kafka is only needed for advanced visibility when visibility messages do not go through the persistence queue
+	isKafkaVisibilityEnabled := isAdvancedVisEnabled && !params.ESConfig.UsePersistenceVisibilityQueue()
+	if params.ClusterMetadata.IsGlobalNamespaceEnabled() {
+		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, true, isKafkaVisibilityEnabled)
+	} else if isKafkaVisibilityEnabled {
+		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, false, isKafkaVisibilityEnabled)
+	} else {
+		params.MessagingClient = nil
+	}
+
 	params.ArchivalMetadata = archiver.NewArchivalMetadata(
 		dc,
 		s.cfg.Archival.History.Status,
@@ -249,7 +256,18 @@ func (s *server) startService() common.Daemon {
 
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
 
-	params.Authorizer = authorization.NewNopAuthorizer()
+	if s.cfg.Global.Authorization.Policy.PolicyFile != "" {
+		params.Authorizer, err = authorization.NewPolicyAuthorizer(&s.cfg.Global.Authorization.Policy, params.Logger, s.doneC)
+		if err != nil {
+			log.Fatalf("error creating policy authorizer: %v", err)
+		}
+	} else {
+		params.Authorizer = authorization.NewNopAuthorizer()
+	}
+	params.ClaimMapper, err = authorization.NewDefaultClaimMapper(&s.cfg.Global.Authorization.ClaimMapper, params.Logger, s.doneC)
+	if err != nil {
+		log.Fatalf("error creating claim mapper: %v", err)
+	}
 
 	params.Logger.Info("Starting service " + s.name)
 
@@ -274,6 +292,60 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// newPersistenceBasedDynamicConfigClient creates the dynamic config client reading the values stored in the
+// persistence store, the values of the fallback client apply to the keys which are not stored
+func (s *server) newPersistenceBasedDynamicConfigClient(
+	fallback dynamicconfig.Client,
+	logger l.Logger,
+	dc *dynamicconfig.Collection,
+	persistenceConfig *config.Persistence,
+	abstractDatastoreFactory *persistenceClient.AbstractDataStoreFactory,
+	metricsClient *metrics.Client,
+	clusterMetadata *config.ClusterMetadata) dynamicconfig.Client {
+
+	logger = logger.WithTags(tag.Service(s.name))
+	factory := persistenceClient.NewFactory(
+		persistenceConfig,
+		dc.GetIntProperty(dynamicconfig.HistoryPersistenceMaxQPS, 3000),
+		*abstractDatastoreFactory,
+		clusterMetadata.CurrentClusterName,
+		*metricsClient,
+		logger,
+	)
+
+	clusterMetadataManager, err := factory.NewClusterMetadataManager()
+	if err != nil {
+		log.Fatalf("Error initializing cluster metadata manager: %v", err)
+	}
+	go func() {
+		<-s.doneC
+		clusterMetadataManager.Close()
+	}()
+
+	loadFn := func() (int64, map[string][]*dynamicconfig.PersistedValue, error) {
+		resp, err := clusterMetadataManager.GetDynamicConfig()
+		if err != nil {
+			return 0, nil, err
+		}
+		values := make(map[string][]*dynamicconfig.PersistedValue, len(resp.Values))
+		for name, persistedValues := range resp.Values {
+			for _, value := range persistedValues {
+				values[name] = append(values[name], &dynamicconfig.PersistedValue{
+					Value:       value.Value,
+					Constraints: value.Constraints,
+				})
+			}
+		}
+		return resp.Version, values, nil
+	}
+
+	client, err := dynamicconfig.NewPersistenceBasedClient(&s.cfg.DynamicConfigPersistence, loadFn, fallback, logger, s.doneC)
+	if err != nil {
+		log.Fatalf("Error creating persistence based dynamic config client: %v", err)
+	}
+	return client
+}
+
 func immutableClusterMetadataInitialization(
 	logger l.Logger,
 	dc *dynamicconfig.Collection,
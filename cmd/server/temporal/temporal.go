@@ -38,6 +38,7 @@ import (
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/tools/cassandra"
 	"go.temporal.io/server/tools/sql"
 )
@@ -78,6 +79,11 @@ func startHandler(c *cli.Context) {
 		log.Fatalf("fail to start PProf: %v", err)
 	}
 
+	tracingCloser, err := tracing.InitGlobalTracer(&cfg.Global.Tracing, loggerimpl.NewLogger(cfg.Log.NewZapLogger()))
+	if err != nil {
+		log.Fatalf("fail to initialize tracing: %v", err)
+	}
+
 	var daemons []common.Daemon
 	services := getServices(c)
 	sigc := make(chan os.Signal, 1)
@@ -98,6 +104,9 @@ func startHandler(c *cli.Context) {
 			for _, daemon := range daemons {
 				daemon.Stop()
 			}
+			if err := tracingCloser.Close(); err != nil {
+				log.Printf("failed to flush traces: %v\n", err)
+			}
 			os.Exit(0)
 		}
 	}
@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Azure Blob History Archiver will archive workflow histories to azure blob storage
+
+package azblob
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/objectstore"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	// URIScheme is the scheme for the azure blob storage implementation
+	URIScheme               = "azblob"
+	defaultBlobstoreTimeout = time.Minute
+)
+
+var (
+	errEmptyAccountName  = errors.New("empty azure storage account name")
+	errInvalidAccountKey = errors.New("azure storage account key must be a non empty base64 string")
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver based on azure blob storage
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.AzblobArchiver,
+) (archiver.HistoryArchiver, error) {
+	store, err := newStore(config.AccountName, config.AccountKey, config.Endpoint, azblob.PipelineOptions{})
+	if err != nil {
+		return nil, err
+	}
+	blobCodec, err := archiver.NewBlobCodec(config.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	return objectstore.NewHistoryArchiver(container, URIScheme, store, blobCodec), nil
+}
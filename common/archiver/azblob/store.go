@@ -0,0 +1,223 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblob
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-pipeline-go/pipeline"
+	"github.com/Azure/azure-storage-blob-go/azblob"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/objectstore"
+)
+
+type (
+	// store is the objectstore.Store of the azure blob archivers, the requests are authorized
+	// with the shared key of the storage account
+	store struct {
+		serviceURL azblob.ServiceURL
+	}
+)
+
+func newStore(accountName, accountKey, endpoint string, options azblob.PipelineOptions) (objectstore.Store, error) {
+	if len(accountName) == 0 {
+		return nil, errEmptyAccountName
+	}
+	credential, err := azblob.NewSharedKeyCredential(accountName, accountKey)
+	if err != nil || len(accountKey) == 0 {
+		return nil, errInvalidAccountKey
+	}
+	if len(endpoint) == 0 {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", accountName)
+	}
+	endpointURL, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	return &store{
+		serviceURL: azblob.NewServiceURL(*endpointURL, azblob.NewPipeline(credential, options)),
+	}, nil
+}
+
+func (s *store) Put(ctx context.Context, URI archiver.URI, key string, data []byte) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	blobURL := s.serviceURL.NewContainerURL(URI.Hostname()).NewBlockBlobURL(key)
+	_, err := blobURL.Upload(
+		ctx,
+		bytes.NewReader(data),
+		azblob.BlobHTTPHeaders{ContentType: "application/octet-stream"},
+		azblob.Metadata{},
+		azblob.BlobAccessConditions{},
+		azblob.DefaultAccessTier,
+		nil,
+	)
+	if err != nil {
+		if isServiceCode(err, azblob.ServiceCodeContainerNotFound) {
+			return objectstore.ErrBucketNotExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *store) Get(ctx context.Context, URI archiver.URI, key string) ([]byte, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	blobURL := s.serviceURL.NewContainerURL(URI.Hostname()).NewBlobURL(key)
+	response, err := blobURL.Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false)
+	if err != nil {
+		if isServiceCode(err, azblob.ServiceCodeContainerNotFound) {
+			return nil, objectstore.ErrBucketNotExists
+		}
+		if isServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+			return nil, objectstore.ErrObjectNotFound
+		}
+		return nil, err
+	}
+	body := response.Body(azblob.RetryReaderOptions{})
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+func (s *store) Exists(ctx context.Context, URI archiver.URI, key string) (bool, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	blobURL := s.serviceURL.NewContainerURL(URI.Hostname()).NewBlobURL(key)
+	if _, err := blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}); err != nil {
+		if isServiceCode(err, azblob.ServiceCodeContainerNotFound) {
+			return false, objectstore.ErrBucketNotExists
+		}
+		if isServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *store) List(ctx context.Context, URI archiver.URI, request *objectstore.ListRequest) (*objectstore.ListResponse, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	containerURL := s.serviceURL.NewContainerURL(URI.Hostname())
+	marker := azblob.Marker{}
+	if len(request.NextPageToken) != 0 {
+		marker.Val = stringPtr(string(request.NextPageToken))
+	}
+	options := azblob.ListBlobsSegmentOptions{Prefix: request.Prefix}
+	if request.PageSize > 0 {
+		options.MaxResults = int32(request.PageSize)
+	}
+
+	listResponse := &objectstore.ListResponse{}
+	var nextMarker azblob.Marker
+	if request.Delimiter != "" {
+		results, err := containerURL.ListBlobsHierarchySegment(ctx, marker, request.Delimiter, options)
+		if err != nil {
+			return nil, convertListError(err)
+		}
+		for _, blob := range results.Segment.BlobItems {
+			listResponse.Keys = append(listResponse.Keys, blob.Name)
+		}
+		for _, blobPrefix := range results.Segment.BlobPrefixes {
+			listResponse.CommonPrefixes = append(listResponse.CommonPrefixes, blobPrefix.Name)
+		}
+		nextMarker = results.NextMarker
+	} else {
+		results, err := containerURL.ListBlobsFlatSegment(ctx, marker, options)
+		if err != nil {
+			return nil, convertListError(err)
+		}
+		for _, blob := range results.Segment.BlobItems {
+			listResponse.Keys = append(listResponse.Keys, blob.Name)
+		}
+		nextMarker = results.NextMarker
+	}
+	if nextMarker.NotDone() {
+		listResponse.NextPageToken = []byte(*nextMarker.Val)
+	}
+	return listResponse, nil
+}
+
+func (s *store) ValidateBucket(ctx context.Context, URI archiver.URI) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	containerURL := s.serviceURL.NewContainerURL(URI.Hostname())
+	if _, err := containerURL.GetProperties(ctx, azblob.LeaseAccessConditions{}); err != nil {
+		if isServiceCode(err, azblob.ServiceCodeContainerNotFound) {
+			return objectstore.ErrBucketNotExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *store) IsRetryableError(err error) bool {
+	if serr, ok := err.(azblob.StorageError); ok {
+		statusCode := serr.Response().StatusCode
+		return statusCode == http.StatusTooManyRequests ||
+			statusCode == http.StatusRequestTimeout ||
+			(statusCode >= http.StatusInternalServerError && statusCode != http.StatusNotImplemented)
+	}
+	// the request failed before the blob service responded
+	_, ok := pipeline.Cause(err).(net.Error)
+	return ok
+}
+
+func convertListError(err error) error {
+	if isServiceCode(err, azblob.ServiceCodeContainerNotFound) {
+		return objectstore.ErrBucketNotExists
+	}
+	return err
+}
+
+func isServiceCode(err error, code azblob.ServiceCodeType) bool {
+	serr, ok := err.(azblob.StorageError)
+	return ok && serr.ServiceCode() == code
+}
+
+func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, defaultBlobstoreTimeout)
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
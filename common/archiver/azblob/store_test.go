@@ -0,0 +1,435 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblob
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.uber.org/zap"
+
+	archiverproto "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/objectstore"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	testAccountName      = "testaccount"
+	testNamespaceID      = "test-namespace-id"
+	testNamespace        = "test-namespace"
+	testWorkflowID       = "test-workflow-id"
+	testRunID            = "test-run-id"
+	testWorkflowTypeName = "test-workflow-type"
+
+	// the tests run against azurite instead of a fake blob service when the endpoint is set
+	azuriteEndpointEnv    = "AZBLOB_TEST_ENDPOINT"
+	azuriteAccountNameEnv = "AZBLOB_TEST_ACCOUNT_NAME"
+	azuriteAccountKeyEnv  = "AZBLOB_TEST_ACCOUNT_KEY"
+)
+
+var testAccountKey = base64.StdEncoding.EncodeToString([]byte("test-account-key"))
+
+type (
+	storeSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		config    *config.AzblobArchiver
+		server    *httptest.Server
+		store     *store
+		container string
+		URI       archiver.URI
+	}
+
+	// fakeBlobService implements the subset of the blob service REST API used by the store
+	fakeBlobService struct {
+		sync.Mutex
+		accountName string
+		containers  map[string]map[string][]byte
+	}
+)
+
+func TestStoreSuite(t *testing.T) {
+	suite.Run(t, new(storeSuite))
+}
+
+func TestAzuriteStoreSuite(t *testing.T) {
+	endpoint := os.Getenv(azuriteEndpointEnv)
+	if endpoint == "" {
+		t.Skipf("%s is not set", azuriteEndpointEnv)
+	}
+	suite.Run(t, &storeSuite{
+		config: &config.AzblobArchiver{
+			AccountName: os.Getenv(azuriteAccountNameEnv),
+			AccountKey:  os.Getenv(azuriteAccountKeyEnv),
+			Endpoint:    endpoint,
+		},
+	})
+}
+
+func (s *storeSuite) SetupSuite() {
+	s.Assertions = require.New(s.T())
+	if s.config == nil {
+		s.server = httptest.NewServer(&fakeBlobService{
+			accountName: testAccountName,
+			containers:  make(map[string]map[string][]byte),
+		})
+		s.config = &config.AzblobArchiver{
+			AccountName: testAccountName,
+			AccountKey:  testAccountKey,
+			Endpoint:    s.server.URL + "/" + testAccountName,
+		}
+	}
+
+	blobStore, err := newStore(s.config.AccountName, s.config.AccountKey, s.config.Endpoint, azblob.PipelineOptions{})
+	s.NoError(err)
+	s.store = blobStore.(*store)
+
+	s.container = fmt.Sprintf("test-container-%d", time.Now().UnixNano())
+	_, err = s.store.serviceURL.NewContainerURL(s.container).Create(context.Background(), azblob.Metadata{}, azblob.PublicAccessNone)
+	s.NoError(err)
+
+	s.URI, err = archiver.NewURI(URIScheme + "://" + s.container + "/test-store")
+	s.NoError(err)
+}
+
+func (s *storeSuite) TearDownSuite() {
+	if s.server != nil {
+		s.server.Close()
+	}
+}
+
+func (s *storeSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *storeSuite) TestNewStore_InvalidConfig() {
+	_, err := newStore("", testAccountKey, "", azblob.PipelineOptions{})
+	s.Equal(errEmptyAccountName, err)
+	_, err = newStore(testAccountName, "not base64", "", azblob.PipelineOptions{})
+	s.Equal(errInvalidAccountKey, err)
+}
+
+func (s *storeSuite) TestNewStore_DefaultEndpoint() {
+	blobStore, err := newStore(testAccountName, testAccountKey, "", azblob.PipelineOptions{})
+	s.NoError(err)
+	serviceURL := blobStore.(*store).serviceURL.URL()
+	s.Equal("https://testaccount.blob.core.windows.net", serviceURL.String())
+}
+
+func (s *storeSuite) TestPutGet() {
+	ctx := context.Background()
+	key := "test-store/put-get/2020-08-01T10:00:00Z/a"
+	s.NoError(s.store.Put(ctx, s.URI, key, []byte("data")))
+
+	data, err := s.store.Get(ctx, s.URI, key)
+	s.NoError(err)
+	s.Equal([]byte("data"), data)
+
+	_, err = s.store.Get(ctx, s.URI, "test-store/put-get/b")
+	s.Equal(objectstore.ErrObjectNotFound, err)
+}
+
+func (s *storeSuite) TestContainerNotExists() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI(URIScheme + "://missing-container/test-store")
+	s.NoError(err)
+
+	s.Equal(objectstore.ErrBucketNotExists, s.store.Put(ctx, URI, "test-store/a", []byte("data")))
+	_, err = s.store.Get(ctx, URI, "test-store/a")
+	s.Equal(objectstore.ErrBucketNotExists, err)
+	_, err = s.store.List(ctx, URI, &objectstore.ListRequest{Prefix: "test-store/"})
+	s.Equal(objectstore.ErrBucketNotExists, err)
+	s.Equal(objectstore.ErrBucketNotExists, s.store.ValidateBucket(ctx, URI))
+	s.NoError(s.store.ValidateBucket(ctx, s.URI))
+}
+
+func (s *storeSuite) TestExists() {
+	ctx := context.Background()
+	s.NoError(s.store.Put(ctx, s.URI, "test-store/exists/a", []byte("data")))
+
+	exists, err := s.store.Exists(ctx, s.URI, "test-store/exists/a")
+	s.NoError(err)
+	s.True(exists)
+
+	exists, err = s.store.Exists(ctx, s.URI, "test-store/exists/b")
+	s.NoError(err)
+	s.False(exists)
+}
+
+func (s *storeSuite) TestList() {
+	ctx := context.Background()
+	for _, key := range []string{"test-store/list/1/0", "test-store/list/1/1", "test-store/list/2/0", "test-store/list/a", "test-store/list/b", "test-store/list/c"} {
+		s.NoError(s.store.Put(ctx, s.URI, key, []byte(key)))
+	}
+
+	response, err := s.store.List(ctx, s.URI, &objectstore.ListRequest{
+		Prefix:    "test-store/list/",
+		Delimiter: "/",
+	})
+	s.NoError(err)
+	s.Equal([]string{"test-store/list/a", "test-store/list/b", "test-store/list/c"}, response.Keys)
+	s.Equal([]string{"test-store/list/1/", "test-store/list/2/"}, response.CommonPrefixes)
+	s.Nil(response.NextPageToken)
+
+	var keys []string
+	listRequest := &objectstore.ListRequest{
+		Prefix:   "test-store/list/",
+		PageSize: 4,
+	}
+	for {
+		response, err := s.store.List(ctx, s.URI, listRequest)
+		s.NoError(err)
+		s.True(len(response.Keys) <= 4)
+		keys = append(keys, response.Keys...)
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		listRequest.NextPageToken = response.NextPageToken
+	}
+	s.Len(keys, 6)
+}
+
+func (s *storeSuite) TestIsRetryableError() {
+	s.False(s.store.IsRetryableError(nil))
+
+	testCases := map[int]bool{
+		http.StatusForbidden:          false,
+		http.StatusConflict:           false,
+		http.StatusNotImplemented:     false,
+		http.StatusTooManyRequests:    true,
+		http.StatusServiceUnavailable: true,
+	}
+	for statusCode, retryable := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(statusCode)
+		}))
+		blobStore, err := newStore(testAccountName, testAccountKey, server.URL, azblob.PipelineOptions{
+			Retry: azblob.RetryOptions{MaxTries: 1},
+		})
+		s.NoError(err)
+		err = blobStore.ValidateBucket(context.Background(), s.URI)
+		s.Error(err)
+		s.Equal(retryable, blobStore.IsRetryableError(err), "status code %v", statusCode)
+		server.Close()
+	}
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	blobStore, err := newStore(testAccountName, testAccountKey, server.URL, azblob.PipelineOptions{
+		Retry: azblob.RetryOptions{MaxTries: 1},
+	})
+	s.NoError(err)
+	err = blobStore.ValidateBucket(context.Background(), s.URI)
+	s.Error(err)
+	s.True(blobStore.IsRetryableError(err))
+}
+
+func (s *storeSuite) TestVisibilityArchiver() {
+	zapLogger := zap.NewNop()
+	container := &archiver.VisibilityBootstrapContainer{
+		Logger:        loggerimpl.NewLogger(zapLogger),
+		MetricsClient: metrics.NewClient(tally.NoopScope, metrics.VisibilityArchiverScope),
+	}
+	visibilityArchiver, err := NewVisibilityArchiver(container, s.config)
+	s.NoError(err)
+
+	URI, err := archiver.NewURI(URIScheme + "://" + s.container + "/test-visibility")
+	s.NoError(err)
+	s.NoError(visibilityArchiver.ValidateURI(URI))
+
+	closeTimestamp := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+	request := &archiverproto.ArchiveVisibilityRequest{
+		NamespaceId:      testNamespaceID,
+		Namespace:        testNamespace,
+		WorkflowId:       testWorkflowID,
+		RunId:            testRunID,
+		WorkflowTypeName: testWorkflowTypeName,
+		StartTimestamp:   closeTimestamp.Add(-time.Hour).UnixNano(),
+		CloseTimestamp:   closeTimestamp.UnixNano(),
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		HistoryLength:    int64(101),
+	}
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, request))
+
+	response, err := visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       fmt.Sprintf("WorkflowId = '%s'", testWorkflowID),
+	})
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+	s.Equal(testRunID, response.Executions[0].GetExecution().GetRunId())
+}
+
+func (f *fakeBlobService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	if !f.authorized(r) {
+		f.writeError(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+
+	// paths are /<account>/<container>[/<blob>]
+	segments := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"+f.accountName+"/"), "/", 2)
+	containerName := segments[0]
+	query := r.URL.Query()
+	if len(segments) == 1 && r.Method == http.MethodPut && query.Get("restype") == "container" {
+		f.containers[containerName] = make(map[string][]byte)
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	blobs, ok := f.containers[containerName]
+	if !ok {
+		f.writeError(w, http.StatusNotFound, azblob.ServiceCodeContainerNotFound)
+		return
+	}
+	if len(segments) == 1 {
+		switch {
+		case r.Method == http.MethodGet && query.Get("comp") == "list":
+			f.list(w, blobs, query)
+		case r.Method == http.MethodGet && query.Get("restype") == "container":
+			w.WriteHeader(http.StatusOK)
+		default:
+			f.writeError(w, http.StatusBadRequest, "UnsupportedHttpVerb")
+		}
+		return
+	}
+
+	key := segments[1]
+	switch r.Method {
+	case http.MethodPut:
+		if r.Header.Get("x-ms-blob-type") != "BlockBlob" {
+			f.writeError(w, http.StatusBadRequest, "MissingRequiredHeader")
+			return
+		}
+		data, _ := ioutil.ReadAll(r.Body)
+		blobs[key] = data
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodGet, http.MethodHead:
+		data, ok := blobs[key]
+		if !ok {
+			f.writeError(w, http.StatusNotFound, azblob.ServiceCodeBlobNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		f.writeError(w, http.StatusBadRequest, "UnsupportedHttpVerb")
+	}
+}
+
+func (f *fakeBlobService) authorized(r *http.Request) bool {
+	// the signature itself is computed by the sdk, only check that the request is signed with the account key
+	return strings.HasPrefix(r.Header.Get("Authorization"), fmt.Sprintf("SharedKey %s:", f.accountName)) &&
+		r.Header.Get("x-ms-version") != "" &&
+		r.Header.Get("x-ms-date") != ""
+}
+
+func (f *fakeBlobService) list(w http.ResponseWriter, blobs map[string][]byte, query url.Values) {
+	get := query.Get
+	prefix, delimiter := get("prefix"), get("delimiter")
+
+	var entries []string
+	blobPrefixes := make(map[string]bool)
+	for key := range blobs {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if delimiter != "" {
+			if index := strings.Index(key[len(prefix):], delimiter); index != -1 {
+				blobPrefix := key[:len(prefix)+index+len(delimiter)]
+				if !blobPrefixes[blobPrefix] {
+					blobPrefixes[blobPrefix] = true
+					entries = append(entries, blobPrefix)
+				}
+				continue
+			}
+		}
+		entries = append(entries, key)
+	}
+	sort.Strings(entries)
+
+	start := 0
+	if marker := get("marker"); marker != "" {
+		start = sort.SearchStrings(entries, marker)
+	}
+	end := len(entries)
+	nextMarker := ""
+	if maxResults, err := strconv.Atoi(get("maxresults")); err == nil && start+maxResults < end {
+		end = start + maxResults
+		nextMarker = entries[end]
+	}
+
+	type name struct {
+		Name string `xml:"Name"`
+	}
+	results := struct {
+		XMLName    xml.Name `xml:"EnumerationResults"`
+		Blob       []name   `xml:"Blobs>Blob"`
+		BlobPrefix []name   `xml:"Blobs>BlobPrefix"`
+		NextMarker string   `xml:"NextMarker"`
+	}{NextMarker: nextMarker}
+	for _, entry := range entries[start:end] {
+		if blobPrefixes[entry] {
+			results.BlobPrefix = append(results.BlobPrefix, name{entry})
+		} else {
+			results.Blob = append(results.Blob, name{entry})
+		}
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(results)
+}
+
+func (f *fakeBlobService) writeError(w http.ResponseWriter, statusCode int, code azblob.ServiceCodeType) {
+	w.Header().Set("x-ms-error-code", string(code))
+	w.WriteHeader(statusCode)
+}
@@ -0,0 +1,49 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblob
+
+import (
+	"github.com/Azure/azure-storage-blob-go/azblob"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/objectstore"
+	"go.temporal.io/server/common/service/config"
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on azure blob storage
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.AzblobArchiver,
+) (archiver.VisibilityArchiver, error) {
+	store, err := newStore(config.AccountName, config.AccountKey, config.Endpoint, azblob.PipelineOptions{})
+	if err != nil {
+		return nil, err
+	}
+	blobCodec, err := archiver.NewBlobCodec(config.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	return objectstore.NewVisibilityArchiver(container, URIScheme, store, blobCodec), nil
+}
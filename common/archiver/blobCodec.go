@@ -0,0 +1,300 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/klauspost/compress/zstd"
+	"gopkg.in/yaml.v2"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+// The archived blobs are wrapped in an envelope when they are compressed or encrypted. The envelope starts
+// with a magic prefix which cannot start a plain encoded blob, followed by the length of a JSON encoded
+// envelopeHeader, the header itself and the transformed payload. Blobs without the prefix are read as is,
+// so that blobs archived before the encoding was configured can still be read.
+
+const (
+	// CompressionNone stores blobs uncompressed
+	CompressionNone = "none"
+	// CompressionGzip compresses blobs with gzip
+	CompressionGzip = "gzip"
+	// CompressionZstd compresses blobs with zstd
+	CompressionZstd = "zstd"
+
+	// EncryptionAESGCM encrypts blobs with AES-GCM
+	EncryptionAESGCM = "aes-gcm"
+
+	envelopeVersion = 1
+)
+
+var (
+	envelopeMagic = []byte{0x00, 'T', 'A', 'E'}
+
+	// ErrUnknownCompression is the error for an unsupported compression of archived blobs
+	ErrUnknownCompression = errors.New("unknown archival compression")
+	// ErrBlobEnvelopeCorrupted is the error for an archived blob whose envelope cannot be read
+	ErrBlobEnvelopeCorrupted = errors.New("archived blob envelope is corrupted")
+	// ErrEncryptionKeyNotFound is the error for an archived blob encrypted with a key missing from the keyring
+	ErrEncryptionKeyNotFound = errors.New("archival encryption key not found in keyring")
+)
+
+type (
+	// BlobCodec encodes the blobs written by the archivers and decodes the blobs they read
+	BlobCodec interface {
+		Encode(data []byte) ([]byte, error)
+		Decode(blob []byte) ([]byte, error)
+	}
+
+	blobCodec struct {
+		compression string
+		keyID       string
+		keyring     map[string][]byte
+	}
+
+	envelopeHeader struct {
+		Compression string `json:"compression,omitempty"`
+		Encryption  string `json:"encryption,omitempty"`
+		KeyID       string `json:"keyId,omitempty"`
+		Nonce       []byte `json:"nonce,omitempty"`
+	}
+)
+
+// NewBlobCodec creates the codec of the archived blobs, blobs are written as is when the encoding is nil.
+// Every key of the keyring can decrypt blobs, the configured key ID selects the key which encrypts new blobs.
+func NewBlobCodec(encoding *config.ArchivalEncoding) (BlobCodec, error) {
+	codec := &blobCodec{compression: CompressionNone}
+	if encoding == nil {
+		return codec, nil
+	}
+
+	switch encoding.Compression {
+	case "", CompressionNone:
+	case CompressionGzip, CompressionZstd:
+		codec.compression = encoding.Compression
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownCompression, encoding.Compression)
+	}
+
+	if encoding.Encryption != nil {
+		keyring, err := LoadKeyring(encoding.Encryption.KeyringPath)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := keyring[encoding.Encryption.KeyID]; !ok {
+			return nil, fmt.Errorf("%w: %v", ErrEncryptionKeyNotFound, encoding.Encryption.KeyID)
+		}
+		codec.keyID = encoding.Encryption.KeyID
+		codec.keyring = keyring
+	}
+	return codec, nil
+}
+
+// LoadKeyring reads a YAML file mapping key IDs to base64 encoded AES keys of 16, 24 or 32 bytes
+func LoadKeyring(path string) (map[string][]byte, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var encodedKeys map[string]string
+	if err := yaml.Unmarshal(content, &encodedKeys); err != nil {
+		return nil, fmt.Errorf("unable to parse archival keyring %v: %v", path, err)
+	}
+	keyring := make(map[string][]byte, len(encodedKeys))
+	for keyID, encodedKey := range encodedKeys {
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("archival key %v is not base64 encoded: %v", keyID, err)
+		}
+		if _, err := aes.NewCipher(key); err != nil {
+			return nil, fmt.Errorf("archival key %v is invalid: %v", keyID, err)
+		}
+		keyring[keyID] = key
+	}
+	return keyring, nil
+}
+
+func (c *blobCodec) Encode(data []byte) ([]byte, error) {
+	if c.compression == CompressionNone && c.keyID == "" {
+		return data, nil
+	}
+
+	header := envelopeHeader{}
+	payload := data
+	if c.compression != CompressionNone {
+		compressed, err := compress(c.compression, data)
+		if err != nil {
+			return nil, err
+		}
+		header.Compression = c.compression
+		payload = compressed
+	}
+	if c.keyID != "" {
+		gcm, err := newGCM(c.keyring[c.keyID])
+		if err != nil {
+			return nil, err
+		}
+		header.Encryption = EncryptionAESGCM
+		header.KeyID = c.keyID
+		header.Nonce = make([]byte, gcm.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, header.Nonce); err != nil {
+			return nil, err
+		}
+		payload = gcm.Seal(nil, header.Nonce, payload, additionalData(header))
+	}
+
+	encodedHeader, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	blob := make([]byte, 0, len(envelopeMagic)+5+len(encodedHeader)+len(payload))
+	blob = append(blob, envelopeMagic...)
+	blob = append(blob, envelopeVersion)
+	blob = append(blob, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(blob[len(blob)-4:], uint32(len(encodedHeader)))
+	blob = append(blob, encodedHeader...)
+	return append(blob, payload...), nil
+}
+
+func (c *blobCodec) Decode(blob []byte) ([]byte, error) {
+	if !bytes.HasPrefix(blob, envelopeMagic) {
+		return blob, nil
+	}
+
+	rest := blob[len(envelopeMagic):]
+	if len(rest) < 5 || rest[0] != envelopeVersion {
+		return nil, ErrBlobEnvelopeCorrupted
+	}
+	headerLength := binary.BigEndian.Uint32(rest[1:5])
+	rest = rest[5:]
+	if uint64(headerLength) > uint64(len(rest)) {
+		return nil, ErrBlobEnvelopeCorrupted
+	}
+	var header envelopeHeader
+	if err := json.Unmarshal(rest[:headerLength], &header); err != nil {
+		return nil, ErrBlobEnvelopeCorrupted
+	}
+	payload := rest[headerLength:]
+
+	switch header.Encryption {
+	case "":
+	case EncryptionAESGCM:
+		key, ok := c.keyring[header.KeyID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v", ErrEncryptionKeyNotFound, header.KeyID)
+		}
+		gcm, err := newGCM(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(header.Nonce) != gcm.NonceSize() {
+			return nil, ErrBlobEnvelopeCorrupted
+		}
+		payload, err = gcm.Open(nil, header.Nonce, payload, additionalData(header))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt archived blob with key %v: %v", header.KeyID, err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown archival encryption: %v", header.Encryption)
+	}
+
+	switch header.Compression {
+	case "":
+		return payload, nil
+	default:
+		return decompress(header.Compression, payload)
+	}
+}
+
+func compress(compression string, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	switch compression {
+	case CompressionGzip:
+		writer = gzip.NewWriter(&buf)
+	case CompressionZstd:
+		zstdWriter, err := zstd.NewWriter(&buf)
+		if err != nil {
+			return nil, err
+		}
+		writer = zstdWriter
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownCompression, compression)
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(compression string, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	case CompressionZstd:
+		reader, err := zstd.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownCompression, compression)
+	}
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// additionalData binds the compression and the key ID to the encrypted payload
+func additionalData(header envelopeHeader) []byte {
+	return []byte(header.Compression + "/" + header.KeyID)
+}
@@ -0,0 +1,203 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	blobCodecSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		keyringDir string
+		blob       []byte
+	}
+)
+
+func TestBlobCodecSuite(t *testing.T) {
+	suite.Run(t, new(blobCodecSuite))
+}
+
+func (s *blobCodecSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "blobCodecSuite")
+	s.NoError(err)
+	s.keyringDir = dir
+	s.blob = []byte(`[{"events":[{"eventId":"1","eventType":"WorkflowExecutionStarted"}]}]`)
+}
+
+func (s *blobCodecSuite) TearDownTest() {
+	s.NoError(os.RemoveAll(s.keyringDir))
+}
+
+func (s *blobCodecSuite) TestNoEncoding() {
+	for _, encoding := range []*config.ArchivalEncoding{nil, {}, {Compression: CompressionNone}} {
+		codec, err := NewBlobCodec(encoding)
+		s.NoError(err)
+		encoded, err := codec.Encode(s.blob)
+		s.NoError(err)
+		s.Equal(s.blob, encoded)
+		decoded, err := codec.Decode(encoded)
+		s.NoError(err)
+		s.Equal(s.blob, decoded)
+	}
+}
+
+func (s *blobCodecSuite) TestCompression() {
+	for _, compression := range []string{CompressionGzip, CompressionZstd} {
+		codec, err := NewBlobCodec(&config.ArchivalEncoding{Compression: compression})
+		s.NoError(err)
+		encoded, err := codec.Encode(s.blob)
+		s.NoError(err)
+		s.NotEqual(s.blob, encoded)
+		decoded, err := codec.Decode(encoded)
+		s.NoError(err)
+		s.Equal(s.blob, decoded)
+
+		// blobs are decoded according to their envelope, not to the current config
+		plainCodec, err := NewBlobCodec(nil)
+		s.NoError(err)
+		decoded, err = plainCodec.Decode(encoded)
+		s.NoError(err)
+		s.Equal(s.blob, decoded)
+	}
+}
+
+func (s *blobCodecSuite) TestUnknownCompression() {
+	_, err := NewBlobCodec(&config.ArchivalEncoding{Compression: "lz4"})
+	s.True(errors.Is(err, ErrUnknownCompression))
+}
+
+func (s *blobCodecSuite) TestEncryption() {
+	keyringPath := s.writeKeyring("key-1")
+	codec, err := NewBlobCodec(&config.ArchivalEncoding{
+		Compression: CompressionZstd,
+		Encryption:  &config.ArchivalEncryption{KeyringPath: keyringPath, KeyID: "key-1"},
+	})
+	s.NoError(err)
+	encoded, err := codec.Encode(s.blob)
+	s.NoError(err)
+	s.NotContains(string(encoded), "WorkflowExecutionStarted")
+	decoded, err := codec.Decode(encoded)
+	s.NoError(err)
+	s.Equal(s.blob, decoded)
+
+	plainCodec, err := NewBlobCodec(nil)
+	s.NoError(err)
+	_, err = plainCodec.Decode(encoded)
+	s.True(errors.Is(err, ErrEncryptionKeyNotFound))
+}
+
+func (s *blobCodecSuite) TestEncryption_KeyRotation() {
+	keyringPath := s.writeKeyring("key-1", "key-2")
+	oldCodec, err := NewBlobCodec(&config.ArchivalEncoding{
+		Encryption: &config.ArchivalEncryption{KeyringPath: keyringPath, KeyID: "key-1"},
+	})
+	s.NoError(err)
+	oldBlob, err := oldCodec.Encode(s.blob)
+	s.NoError(err)
+
+	newCodec, err := NewBlobCodec(&config.ArchivalEncoding{
+		Compression: CompressionGzip,
+		Encryption:  &config.ArchivalEncryption{KeyringPath: keyringPath, KeyID: "key-2"},
+	})
+	s.NoError(err)
+	newBlob, err := newCodec.Encode(s.blob)
+	s.NoError(err)
+
+	for _, blob := range [][]byte{oldBlob, newBlob} {
+		decoded, err := newCodec.Decode(blob)
+		s.NoError(err)
+		s.Equal(s.blob, decoded)
+	}
+}
+
+func (s *blobCodecSuite) TestEncryption_UnknownKey() {
+	keyringPath := s.writeKeyring("key-1")
+	_, err := NewBlobCodec(&config.ArchivalEncoding{
+		Encryption: &config.ArchivalEncryption{KeyringPath: keyringPath, KeyID: "key-2"},
+	})
+	s.True(errors.Is(err, ErrEncryptionKeyNotFound))
+
+	_, err = NewBlobCodec(&config.ArchivalEncoding{
+		Encryption: &config.ArchivalEncryption{KeyringPath: filepath.Join(s.keyringDir, "missing.yaml"), KeyID: "key-1"},
+	})
+	s.Error(err)
+}
+
+func (s *blobCodecSuite) TestEncryption_Tampered() {
+	keyringPath := s.writeKeyring("key-1")
+	codec, err := NewBlobCodec(&config.ArchivalEncoding{
+		Encryption: &config.ArchivalEncryption{KeyringPath: keyringPath, KeyID: "key-1"},
+	})
+	s.NoError(err)
+	encoded, err := codec.Encode(s.blob)
+	s.NoError(err)
+
+	encoded[len(encoded)-1] ^= 0xff
+	_, err = codec.Decode(encoded)
+	s.Error(err)
+}
+
+func (s *blobCodecSuite) TestDecode_CorruptedEnvelope() {
+	codec, err := NewBlobCodec(nil)
+	s.NoError(err)
+	for _, blob := range [][]byte{
+		envelopeMagic,
+		append(append([]byte{}, envelopeMagic...), 2, 0, 0, 0, 0),
+		append(append([]byte{}, envelopeMagic...), envelopeVersion, 0, 0, 1, 0, '{'),
+		append(append([]byte{}, envelopeMagic...), envelopeVersion, 0, 0, 0, 1, '{'),
+	} {
+		_, err := codec.Decode(blob)
+		s.Equal(ErrBlobEnvelopeCorrupted, err)
+	}
+}
+
+func (s *blobCodecSuite) writeKeyring(keyIDs ...string) string {
+	content := ""
+	for _, keyID := range keyIDs {
+		key := make([]byte, 32)
+		_, err := rand.Read(key)
+		s.NoError(err)
+		content += fmt.Sprintf("%v: %v\n", keyID, base64.StdEncoding.EncodeToString(key))
+	}
+	keyringPath := filepath.Join(s.keyringDir, "keyring.yaml")
+	s.NoError(ioutil.WriteFile(keyringPath, []byte(content), 0600))
+	return keyringPath
+}
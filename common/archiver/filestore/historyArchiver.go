@@ -78,6 +78,7 @@ type (
 		container *archiver.HistoryBootstrapContainer
 		fileMode  os.FileMode
 		dirMode   os.FileMode
+		blobCodec archiver.BlobCodec
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -110,10 +111,15 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	blobCodec, err := archiver.NewBlobCodec(config.Encoding)
+	if err != nil {
+		return nil, err
+	}
 	return &historyArchiver{
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		blobCodec:       blobCodec,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -175,6 +181,11 @@ func (h *historyArchiver) Archive(
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 		return err
 	}
+	encodedHistoryBatches, err = h.blobCodec.Encode(encodedHistoryBatches)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
 
 	dirPath := URI.Path()
 	if err = mkdirAll(dirPath, h.dirMode); err != nil {
@@ -249,6 +260,10 @@ func (h *historyArchiver) Get(
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
+	encodedHistoryBatches, err = h.blobCodec.Decode(encodedHistoryBatches)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
 
 	encoder := codec.NewJSONPBEncoder()
 	historyBatches, err := encoder.DecodeHistories(encodedHistoryBatches)
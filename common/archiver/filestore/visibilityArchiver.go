@@ -53,6 +53,7 @@ type (
 		container   *archiver.VisibilityBootstrapContainer
 		fileMode    os.FileMode
 		dirMode     os.FileMode
+		blobCodec   archiver.BlobCodec
 		queryParser QueryParser
 	}
 
@@ -82,10 +83,15 @@ func NewVisibilityArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	blobCodec, err := archiver.NewBlobCodec(config.Encoding)
+	if err != nil {
+		return nil, err
+	}
 	return &visibilityArchiver{
 		container:   container,
 		fileMode:    os.FileMode(fileMode),
 		dirMode:     os.FileMode(dirMode),
+		blobCodec:   blobCodec,
 		queryParser: NewQueryParser(),
 	}, nil
 }
@@ -126,6 +132,11 @@ func (v *visibilityArchiver) Archive(
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
 		return err
 	}
+	encodedVisibilityRecord, err = v.blobCodec.Encode(encodedVisibilityRecord)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
 
 	// The filename has the format: closeTimestamp_hash(runID).visibility
 	// This format allows the archiver to sort all records without reading the file contents
@@ -210,6 +221,10 @@ func (v *visibilityArchiver) query(
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
+		encodedRecord, err = v.blobCodec.Decode(encodedRecord)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
 
 		record, err := decodeVisibilityRecord(encodedRecord)
 		if err != nil {
@@ -31,6 +31,7 @@ import (
 
 	"cloud.google.com/go/storage"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -100,6 +101,7 @@ type (
 	// ObjectIteratorWrapper is an interface that expose some methods from gcloud storage objectIterator
 	ObjectIteratorWrapper interface {
 		Next() (*storage.ObjectAttrs, error)
+		PageInfo() *iterator.PageInfo
 	}
 
 	objectIteratorDelegate struct {
@@ -179,6 +181,11 @@ func (o *objectIteratorDelegate) Next() (*storage.ObjectAttrs, error) {
 	return o.iterator.Next()
 }
 
+// PageInfo supports pagination. See the google.golang.org/api/iterator package for details.
+func (o *objectIteratorDelegate) PageInfo() *iterator.PageInfo {
+	return o.iterator.PageInfo()
+}
+
 // NewWriter returns a storage Writer that writes to the GCS object
 // associated with this ObjectHandle.
 //
@@ -29,6 +29,7 @@ package mocks
 import (
 	storage "cloud.google.com/go/storage"
 	mock "github.com/stretchr/testify/mock"
+	iterator "google.golang.org/api/iterator"
 )
 
 // ObjectIteratorWrapper is an autogenerated mock type for the ObjectIteratorWrapper type
@@ -58,3 +59,19 @@ func (_m *ObjectIteratorWrapper) Next() (*storage.ObjectAttrs, error) {
 
 	return r0, r1
 }
+
+// PageInfo provides a mock function with given fields:
+func (_m *ObjectIteratorWrapper) PageInfo() *iterator.PageInfo {
+	ret := _m.Called()
+
+	var r0 *iterator.PageInfo
+	if rf, ok := ret.Get(0).(func() *iterator.PageInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iterator.PageInfo)
+		}
+	}
+
+	return r0
+}
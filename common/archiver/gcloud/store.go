@@ -0,0 +1,190 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcloud
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+
+	"cloud.google.com/go/storage"
+	"go.uber.org/multierr"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/gcloud/connector"
+	"go.temporal.io/server/common/archiver/objectstore"
+)
+
+const (
+	// defaultListPageSize is the maximum number of objects returned by a single list request of google cloud storage
+	defaultListPageSize = 1000
+)
+
+type (
+	// store is the objectstore.Store of the gcloud archivers
+	store struct {
+		client connector.GcloudStorageClient
+	}
+)
+
+func newStore(client connector.GcloudStorageClient) objectstore.Store {
+	return &store{client: client}
+}
+
+func (s *store) Put(ctx context.Context, URI archiver.URI, key string, data []byte) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	writer := s.client.Bucket(URI.Hostname()).Object(key).NewWriter(ctx)
+	if _, err := io.Copy(writer, bytes.NewReader(data)); err != nil {
+		_ = writer.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		if isNotFoundError(err) {
+			return objectstore.ErrBucketNotExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *store) Get(ctx context.Context, URI archiver.URI, key string) (_ []byte, err error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	reader, err := s.client.Bucket(URI.Hostname()).Object(key).NewReader(ctx)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return nil, objectstore.ErrObjectNotFound
+		}
+		if err == storage.ErrBucketNotExist {
+			return nil, objectstore.ErrBucketNotExists
+		}
+		return nil, err
+	}
+	defer func() {
+		if ierr := reader.Close(); ierr != nil {
+			err = multierr.Append(err, ierr)
+		}
+	}()
+	return ioutil.ReadAll(reader)
+}
+
+func (s *store) Exists(ctx context.Context, URI archiver.URI, key string) (bool, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	if _, err := s.client.Bucket(URI.Hostname()).Object(key).Attrs(ctx); err != nil {
+		if err == storage.ErrObjectNotExist {
+			return false, nil
+		}
+		if err == storage.ErrBucketNotExist {
+			return false, objectstore.ErrBucketNotExists
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *store) List(ctx context.Context, URI archiver.URI, request *objectstore.ListRequest) (*objectstore.ListResponse, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	objects := s.client.Bucket(URI.Hostname()).Objects(ctx, &storage.Query{
+		Prefix:    request.Prefix,
+		Delimiter: request.Delimiter,
+	})
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultListPageSize
+	}
+	var results []*storage.ObjectAttrs
+	nextPageToken, err := iterator.NewPager(objects, pageSize, string(request.NextPageToken)).NextPage(&results)
+	if err != nil {
+		if err == storage.ErrBucketNotExist || isNotFoundError(err) {
+			return nil, objectstore.ErrBucketNotExists
+		}
+		return nil, err
+	}
+
+	response := &objectstore.ListResponse{}
+	for _, attrs := range results {
+		// with a delimiter the grouped keys are returned as objects which only have a prefix
+		if attrs.Prefix != "" {
+			response.CommonPrefixes = append(response.CommonPrefixes, attrs.Prefix)
+			continue
+		}
+		response.Keys = append(response.Keys, attrs.Name)
+	}
+	if nextPageToken != "" {
+		response.NextPageToken = []byte(nextPageToken)
+	}
+	return response, nil
+}
+
+func (s *store) ValidateBucket(ctx context.Context, URI archiver.URI) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	if _, err := s.client.Bucket(URI.Hostname()).Attrs(ctx); err != nil {
+		if err == storage.ErrBucketNotExist {
+			return objectstore.ErrBucketNotExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *store) IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if gerr, ok := err.(*googleapi.Error); ok {
+		return gerr.Code == http.StatusTooManyRequests ||
+			gerr.Code == http.StatusRequestTimeout ||
+			(gerr.Code >= http.StatusInternalServerError && gerr.Code != http.StatusNotImplemented)
+	}
+	// the request failed before google cloud storage responded
+	_, ok := err.(net.Error)
+	return ok
+}
+
+func isNotFoundError(err error) bool {
+	gerr, ok := err.(*googleapi.Error)
+	return ok && gerr.Code == http.StatusNotFound
+}
+
+func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, defaultBlobstoreTimeout)
+}
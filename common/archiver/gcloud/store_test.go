@@ -0,0 +1,362 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcloud
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.uber.org/zap"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
+
+	archiverproto "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/gcloud/connector"
+	"go.temporal.io/server/common/archiver/objectstore"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	testNamespaceID      = "test-namespace-id"
+	testNamespace        = "test-namespace"
+	testWorkflowID       = "test-workflow-id"
+	testRunID            = "test-run-id"
+	testWorkflowTypeName = "test-workflow-type"
+	testBucket           = "test-bucket"
+	testBucketURI        = "gs://" + testBucket
+)
+
+type (
+	storeSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		store objectstore.Store
+		URI   archiver.URI
+	}
+
+	// fakeStorageClient implements the subset of google cloud storage used by the store in memory
+	fakeStorageClient struct {
+		objects map[string][]byte
+	}
+
+	fakeBucket struct {
+		client *fakeStorageClient
+		name   string
+	}
+
+	fakeObject struct {
+		bucket *fakeBucket
+		name   string
+	}
+
+	fakeWriter struct {
+		bytes.Buffer
+		object *fakeObject
+	}
+
+	fakeReader struct {
+		*bytes.Reader
+	}
+
+	fakeObjectIterator struct {
+		pageInfo *iterator.PageInfo
+		nextFunc func() error
+		items    []*storage.ObjectAttrs
+	}
+)
+
+func TestStoreSuite(t *testing.T) {
+	suite.Run(t, new(storeSuite))
+}
+
+func (s *storeSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.store = newStore(&fakeStorageClient{objects: make(map[string][]byte)})
+
+	var err error
+	s.URI, err = archiver.NewURI(testBucketURI + "/test-store")
+	s.NoError(err)
+}
+
+func (s *storeSuite) TestPutGet() {
+	ctx := context.Background()
+	s.NoError(s.store.Put(ctx, s.URI, "test-store/a", []byte("data")))
+
+	data, err := s.store.Get(ctx, s.URI, "test-store/a")
+	s.NoError(err)
+	s.Equal([]byte("data"), data)
+
+	_, err = s.store.Get(ctx, s.URI, "test-store/b")
+	s.Equal(objectstore.ErrObjectNotFound, err)
+}
+
+func (s *storeSuite) TestBucketNotExists() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://missing-bucket/test-store")
+	s.NoError(err)
+
+	s.Equal(objectstore.ErrBucketNotExists, s.store.Put(ctx, URI, "test-store/a", []byte("data")))
+	_, err = s.store.List(ctx, URI, &objectstore.ListRequest{Prefix: "test-store/"})
+	s.Equal(objectstore.ErrBucketNotExists, err)
+}
+
+func (s *storeSuite) TestExists() {
+	ctx := context.Background()
+	s.NoError(s.store.Put(ctx, s.URI, "test-store/a", []byte("data")))
+
+	exists, err := s.store.Exists(ctx, s.URI, "test-store/a")
+	s.NoError(err)
+	s.True(exists)
+
+	exists, err = s.store.Exists(ctx, s.URI, "test-store/b")
+	s.NoError(err)
+	s.False(exists)
+}
+
+func (s *storeSuite) TestList() {
+	ctx := context.Background()
+	for _, key := range []string{"test-store/1/0", "test-store/1/1", "test-store/2/0", "test-store/a", "test-store/b", "test-store/c"} {
+		s.NoError(s.store.Put(ctx, s.URI, key, []byte(key)))
+	}
+
+	response, err := s.store.List(ctx, s.URI, &objectstore.ListRequest{
+		Prefix:    "test-store/",
+		Delimiter: "/",
+	})
+	s.NoError(err)
+	s.Equal([]string{"test-store/a", "test-store/b", "test-store/c"}, response.Keys)
+	s.Equal([]string{"test-store/1/", "test-store/2/"}, response.CommonPrefixes)
+	s.Nil(response.NextPageToken)
+
+	var keys []string
+	listRequest := &objectstore.ListRequest{
+		Prefix:   "test-store/",
+		PageSize: 4,
+	}
+	for {
+		response, err := s.store.List(ctx, s.URI, listRequest)
+		s.NoError(err)
+		s.True(len(response.Keys) <= 4)
+		keys = append(keys, response.Keys...)
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		listRequest.NextPageToken = response.NextPageToken
+	}
+	s.Len(keys, 6)
+}
+
+func (s *storeSuite) TestValidateBucket() {
+	s.NoError(s.store.ValidateBucket(context.Background(), s.URI))
+
+	URI, err := archiver.NewURI("gs://missing-bucket/test-store")
+	s.NoError(err)
+	s.Equal(objectstore.ErrBucketNotExists, s.store.ValidateBucket(context.Background(), URI))
+}
+
+func (s *storeSuite) TestIsRetryableError() {
+	s.False(s.store.IsRetryableError(nil))
+	s.False(s.store.IsRetryableError(storage.ErrObjectNotExist))
+	s.False(s.store.IsRetryableError(&googleapi.Error{Code: http.StatusForbidden}))
+	s.False(s.store.IsRetryableError(&googleapi.Error{Code: http.StatusNotImplemented}))
+	s.True(s.store.IsRetryableError(&googleapi.Error{Code: http.StatusServiceUnavailable}))
+	s.True(s.store.IsRetryableError(&googleapi.Error{Code: http.StatusTooManyRequests}))
+}
+
+func (s *storeSuite) TestVisibilityArchiver() {
+	zapLogger := zap.NewNop()
+	container := &archiver.VisibilityBootstrapContainer{
+		Logger:        loggerimpl.NewLogger(zapLogger),
+		MetricsClient: metrics.NewClient(tally.NoopScope, metrics.VisibilityArchiverScope),
+	}
+	blobCodec, err := archiver.NewBlobCodec(nil)
+	s.NoError(err)
+	visibilityArchiver := objectstore.NewVisibilityArchiver(container, URIScheme, s.store, blobCodec)
+	s.NoError(visibilityArchiver.ValidateURI(s.URI))
+
+	closeTimestamp := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+	request := &archiverproto.ArchiveVisibilityRequest{
+		NamespaceId:      testNamespaceID,
+		Namespace:        testNamespace,
+		WorkflowId:       testWorkflowID,
+		RunId:            testRunID,
+		WorkflowTypeName: testWorkflowTypeName,
+		StartTimestamp:   closeTimestamp.Add(-time.Hour).UnixNano(),
+		CloseTimestamp:   closeTimestamp.UnixNano(),
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		HistoryLength:    int64(101),
+	}
+	s.NoError(visibilityArchiver.Archive(context.Background(), s.URI, request))
+
+	response, err := visibilityArchiver.Query(context.Background(), s.URI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       fmt.Sprintf("WorkflowId = '%s'", testWorkflowID),
+	})
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+	s.Equal(testRunID, response.Executions[0].GetExecution().GetRunId())
+}
+
+func (c *fakeStorageClient) Bucket(name string) connector.BucketHandleWrapper {
+	return &fakeBucket{client: c, name: name}
+}
+
+func (b *fakeBucket) exists() bool {
+	return b.name == testBucket
+}
+
+func (b *fakeBucket) Object(name string) connector.ObjectHandleWrapper {
+	return &fakeObject{bucket: b, name: name}
+}
+
+func (b *fakeBucket) Objects(_ context.Context, q *storage.Query) connector.ObjectIteratorWrapper {
+	it := &fakeObjectIterator{}
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(
+		func(pageSize int, pageToken string) (string, error) {
+			if !b.exists() {
+				return "", &googleapi.Error{Code: http.StatusNotFound}
+			}
+			entries, prefixes := b.list(q)
+			start := 0
+			if pageToken != "" {
+				start, _ = strconv.Atoi(pageToken)
+			}
+			end := len(entries)
+			nextPageToken := ""
+			if pageSize > 0 && end > start+pageSize {
+				end = start + pageSize
+				nextPageToken = strconv.Itoa(end)
+			}
+			for _, entry := range entries[start:end] {
+				if prefixes[entry] {
+					it.items = append(it.items, &storage.ObjectAttrs{Prefix: entry})
+				} else {
+					it.items = append(it.items, &storage.ObjectAttrs{Name: entry})
+				}
+			}
+			return nextPageToken, nil
+		},
+		func() int { return len(it.items) },
+		func() interface{} { items := it.items; it.items = nil; return items },
+	)
+	return it
+}
+
+func (b *fakeBucket) list(q *storage.Query) ([]string, map[string]bool) {
+	var entries []string
+	prefixes := make(map[string]bool)
+	for key := range b.client.objects {
+		if !strings.HasPrefix(key, q.Prefix) {
+			continue
+		}
+		if q.Delimiter != "" {
+			if index := strings.Index(key[len(q.Prefix):], q.Delimiter); index != -1 {
+				prefix := key[:len(q.Prefix)+index+len(q.Delimiter)]
+				if !prefixes[prefix] {
+					prefixes[prefix] = true
+					entries = append(entries, prefix)
+				}
+				continue
+			}
+		}
+		entries = append(entries, key)
+	}
+	sort.Strings(entries)
+	return entries, prefixes
+}
+
+func (b *fakeBucket) Attrs(_ context.Context) (*storage.BucketAttrs, error) {
+	if !b.exists() {
+		return nil, storage.ErrBucketNotExist
+	}
+	return &storage.BucketAttrs{Name: b.name}, nil
+}
+
+func (o *fakeObject) NewWriter(_ context.Context) connector.WriterWrapper {
+	return &fakeWriter{object: o}
+}
+
+func (o *fakeObject) NewReader(_ context.Context) (connector.ReaderWrapper, error) {
+	data, ok := o.bucket.client.objects[o.name]
+	if !o.bucket.exists() || !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return &fakeReader{Reader: bytes.NewReader(data)}, nil
+}
+
+func (o *fakeObject) Attrs(_ context.Context) (*storage.ObjectAttrs, error) {
+	if !o.bucket.exists() {
+		return nil, storage.ErrBucketNotExist
+	}
+	if _, ok := o.bucket.client.objects[o.name]; !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return &storage.ObjectAttrs{Name: o.name}, nil
+}
+
+func (w *fakeWriter) Close() error {
+	if !w.object.bucket.exists() {
+		return &googleapi.Error{Code: http.StatusNotFound}
+	}
+	w.object.bucket.client.objects[w.object.name] = w.Bytes()
+	return nil
+}
+
+func (w *fakeWriter) CloseWithError(err error) error {
+	return nil
+}
+
+func (r *fakeReader) Close() error {
+	return nil
+}
+
+func (it *fakeObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	if err := it.nextFunc(); err != nil {
+		return nil, err
+	}
+	item := it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *fakeObjectIterator) PageInfo() *iterator.PageInfo {
+	return it.pageInfo
+}
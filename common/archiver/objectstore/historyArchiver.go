@@ -0,0 +1,395 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Object store History Archiver will archive workflow histories to any object store implementing Store
+
+package objectstore
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	archiverproto "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	errEncodeHistory      = "failed to encode history batches"
+	errWriteKey           = "failed to write history to object store"
+	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+)
+
+var (
+	errNoBucketSpecified = errors.New("no bucket specified")
+)
+
+type (
+	historyArchiver struct {
+		container *archiver.HistoryBootstrapContainer
+		scheme    string
+		store     Store
+		blobCodec archiver.BlobCodec
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	getHistoryToken struct {
+		CloseFailoverVersion int64
+		BatchIdx             int
+	}
+
+	uploadProgress struct {
+		BatchIdx      int
+		IteratorState []byte
+		uploadedSize  int64
+		historySize   int64
+	}
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver which archives histories
+// to the object store for the URIs of the scheme
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	scheme string,
+	store Store,
+	blobCodec archiver.BlobCodec,
+) archiver.HistoryArchiver {
+	return newHistoryArchiver(container, scheme, store, blobCodec, nil)
+}
+
+func newHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	scheme string,
+	store Store,
+	blobCodec archiver.BlobCodec,
+	historyIterator archiver.HistoryIterator,
+) *historyArchiver {
+	return &historyArchiver{
+		container:       container,
+		scheme:          scheme,
+		store:           store,
+		blobCodec:       blobCodec,
+		historyIterator: historyIterator,
+	}
+}
+
+func (h *historyArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	scope := h.container.MetricsClient.Scope(metrics.HistoryArchiverScope, metrics.NamespaceTag(request.Namespace))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	sw := scope.StartTimer(metrics.ServiceLatency)
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if common.IsPersistenceTransientError(err) || h.store.IsRetryableError(err) {
+				scope.IncCounter(metrics.HistoryArchiverArchiveTransientErrorCount)
+			} else {
+				scope.IncCounter(metrics.HistoryArchiverArchiveNonRetryableErrorCount)
+				if featureCatalog.NonRetryableError != nil {
+					err = featureCatalog.NonRetryableError()
+				}
+			}
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.container.Logger, request, URI.String())
+
+	if err := softValidateURI(h.scheme, URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateHistoryArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	var progress uploadProgress
+	historyIterator := h.historyIterator
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator = loadHistoryIterator(ctx, request, h.container.HistoryV2Manager, featureCatalog, &progress)
+	}
+	for historyIterator.HasNext() {
+		historyBlob, err := getNextHistoryBlob(ctx, historyIterator)
+		if err != nil {
+			logger := logger.WithTags(tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
+			if common.IsPersistenceTransientError(err) {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg)
+			}
+			return err
+		}
+
+		if historyMutated(request, historyBlob.Body, historyBlob.Header.IsLast) {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonHistoryMutated))
+			return archiver.ErrHistoryMutated
+		}
+
+		encoder := codec.NewJSONPBEncoder()
+		encodedHistoryBlob, err := encoder.Encode(historyBlob)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return err
+		}
+		encodedHistoryBlob, err = h.blobCodec.Encode(encodedHistoryBlob)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return err
+		}
+		key := constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, progress.BatchIdx)
+
+		exists, err := h.store.Exists(ctx, URI, key)
+		if err != nil {
+			logger := logger.WithTags(tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+			if h.store.IsRetryableError(err) {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg)
+			}
+			return err
+		}
+		blobSize := int64(binary.Size(encodedHistoryBlob))
+		if exists {
+			scope.IncCounter(metrics.HistoryArchiverBlobExistsCount)
+		} else {
+			if err := h.store.Put(ctx, URI, key, encodedHistoryBlob); err != nil {
+				logger := logger.WithTags(tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+				if h.store.IsRetryableError(err) {
+					logger.Error(archiver.ArchiveTransientErrorMsg)
+				} else {
+					logger.Error(archiver.ArchiveNonRetryableErrorMsg)
+				}
+				return err
+			}
+			progress.uploadedSize += blobSize
+			scope.RecordTimer(metrics.HistoryArchiverBlobSize, time.Duration(blobSize))
+		}
+
+		progress.historySize += blobSize
+		progress.BatchIdx = progress.BatchIdx + 1
+		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, &progress)
+	}
+
+	scope.RecordTimer(metrics.HistoryArchiverTotalUploadSize, time.Duration(progress.uploadedSize))
+	scope.RecordTimer(metrics.HistoryArchiverHistorySize, time.Duration(progress.historySize))
+	scope.IncCounter(metrics.HistoryArchiverArchiveSuccessCount)
+	return nil
+}
+
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, historyManager persistence.HistoryManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
+	if featureCatalog.ProgressManager != nil {
+		if featureCatalog.ProgressManager.HasProgress(ctx) {
+			err := featureCatalog.ProgressManager.LoadProgress(ctx, progress)
+			if err == nil {
+				historyIterator, err := archiver.NewHistoryIteratorFromState(request, historyManager, targetHistoryBlobSize, progress.IteratorState)
+				if err == nil {
+					return historyIterator
+				}
+			}
+			progress.IteratorState = nil
+			progress.BatchIdx = 0
+			progress.historySize = 0
+			progress.uploadedSize = 0
+		}
+	}
+	return archiver.NewHistoryIterator(request, historyManager, targetHistoryBlobSize)
+}
+
+func saveHistoryIteratorState(ctx context.Context, featureCatalog *archiver.ArchiveFeatureCatalog, historyIterator archiver.HistoryIterator, progress *uploadProgress) {
+	// Saving history state is a best effort operation. Ignore errors and continue
+	if featureCatalog.ProgressManager != nil {
+		state, err := historyIterator.GetState()
+		if err != nil {
+			return
+		}
+		progress.IteratorState = state
+		err = featureCatalog.ProgressManager.RecordProgress(ctx, progress)
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (h *historyArchiver) Get(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+) (*archiver.GetHistoryResponse, error) {
+	if err := softValidateURI(h.scheme, URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateGetRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetHistoryRequest.Error())
+	}
+
+	var err error
+	var token *getHistoryToken
+	if request.NextPageToken != nil {
+		token, err = deserializeGetHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	} else if request.CloseFailoverVersion != nil {
+		token = &getHistoryToken{
+			CloseFailoverVersion: *request.CloseFailoverVersion,
+		}
+	} else {
+		highestVersion, err := h.getHighestVersion(ctx, URI, request)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		token = &getHistoryToken{
+			CloseFailoverVersion: *highestVersion,
+		}
+	}
+	encoder := codec.NewJSONPBEncoder()
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	isTruncated := false
+	for {
+		if numOfEvents >= request.PageSize {
+			isTruncated = true
+			break
+		}
+		key := constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion, token.BatchIdx)
+
+		encodedRecord, err := h.store.Get(ctx, URI, key)
+		if err != nil {
+			switch {
+			case errors.Is(err, ErrObjectNotFound):
+				return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+			case errors.Is(err, ErrBucketNotExists):
+				return nil, serviceerror.NewInvalidArgument(ErrBucketNotExists.Error())
+			default:
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+		}
+
+		encodedRecord, err = h.blobCodec.Decode(encodedRecord)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		historyBlob := archiverproto.HistoryBlob{}
+		err = encoder.Decode(encodedRecord, &historyBlob)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		for _, batch := range historyBlob.Body {
+			response.HistoryBatches = append(response.HistoryBatches, batch)
+			numOfEvents += len(batch.Events)
+		}
+
+		if historyBlob.Header.IsLast {
+			break
+		}
+		token.BatchIdx++
+	}
+
+	if isTruncated {
+		nextToken, err := serializeToken(token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
+func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
+	err := softValidateURI(h.scheme, URI)
+	if err != nil {
+		return err
+	}
+	return h.store.ValidateBucket(context.TODO(), URI)
+}
+
+func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIterator) (*archiverproto.HistoryBlob, error) {
+	historyBlob, err := historyIterator.Next()
+	op := func() error {
+		historyBlob, err = historyIterator.Next()
+		return err
+	}
+	for err != nil {
+		if !common.IsPersistenceTransientError(err) {
+			return nil, err
+		}
+		if contextExpired(ctx) {
+			return nil, archiver.ErrContextTimeout
+		}
+		err = backoff.Retry(op, common.CreatePersistanceRetryPolicy(), common.IsPersistenceTransientError)
+	}
+	return historyBlob, nil
+}
+
+func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (*int64, error) {
+	var prefix = constructHistoryKeyPrefix(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID) + "/"
+	var highestVersion *int64
+	listRequest := &ListRequest{
+		Prefix:    prefix,
+		Delimiter: "/",
+	}
+	for {
+		results, err := h.store.List(ctx, URI, listRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, versionPrefix := range results.CommonPrefixes {
+			version, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(versionPrefix, prefix), "/"), 10, 64)
+			if err != nil {
+				continue
+			}
+			if highestVersion == nil || version > *highestVersion {
+				highestVersion = &version
+			}
+		}
+		if len(results.NextPageToken) == 0 {
+			break
+		}
+		listRequest.NextPageToken = results.NextPageToken
+	}
+	if highestVersion == nil {
+		return nil, archiver.ErrHistoryNotExist
+	}
+	return highestVersion, nil
+}
@@ -22,26 +22,15 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-package s3store
+package objectstore
 
 import (
-	"bytes"
 	"context"
 	"errors"
-	"fmt"
-	"io/ioutil"
-	"sort"
-	"strconv"
-	"strings"
 	"testing"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/golang/mock/gomock"
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
@@ -52,9 +41,7 @@ import (
 	archiverproto "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
-	"go.temporal.io/server/common/archiver/s3store/mocks"
 	"go.temporal.io/server/common/codec"
-	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/metrics"
@@ -68,18 +55,20 @@ const (
 	testNextEventID          = 1800
 	testCloseFailoverVersion = int64(100)
 	testPageSize             = 100
+	testScheme               = "test"
 	testBucket               = "test-bucket"
-	testBucketURI            = "s3://test-bucket"
+	testBucketURI            = "test://test-bucket"
 )
 
 var (
-	testBranchToken = []byte{1, 2, 3}
+	testBranchToken  = []byte{1, 2, 3}
+	noopBlobCodec, _ = archiver.NewBlobCodec(nil)
 )
 
 type historyArchiverSuite struct {
 	*require.Assertions
 	suite.Suite
-	s3cli              *mocks.S3API
+	store              *memoryStore
 	container          *archiver.HistoryBootstrapContainer
 	logger             log.Logger
 	testArchivalURI    archiver.URI
@@ -93,12 +82,10 @@ func TestHistoryArchiverSuite(t *testing.T) {
 
 func (s *historyArchiverSuite) SetupSuite() {
 	var err error
-	s.s3cli = &mocks.S3API{}
-	setupFsEmulation(s.s3cli)
-	s.setupHistoryDirectory()
+	s.store = newMemoryStore(testBucket)
 	s.testArchivalURI, err = archiver.NewURI(testBucketURI)
-
 	s.Require().NoError(err)
+	s.setupHistoryDirectory()
 }
 
 func (s *historyArchiverSuite) TearDownSuite() {
@@ -114,113 +101,6 @@ func (s *historyArchiverSuite) SetupTest() {
 	}
 }
 
-func setupFsEmulation(s3cli *mocks.S3API) {
-	fs := make(map[string][]byte)
-
-	putObjectFn := func(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) *s3.PutObjectOutput {
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(input.Body)
-		fs[*input.Bucket+*input.Key] = buf.Bytes()
-		return &s3.PutObjectOutput{}
-	}
-	getObjectFn := func(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) *s3.GetObjectOutput {
-		return &s3.GetObjectOutput{
-			Body: ioutil.NopCloser(bytes.NewReader(fs[*input.Bucket+*input.Key])),
-		}
-	}
-	s3cli.On("ListObjectsV2WithContext", mock.Anything, mock.Anything).
-		Return(func(_ context.Context, input *s3.ListObjectsV2Input, opts ...request.Option) *s3.ListObjectsV2Output {
-			objects := make([]*s3.Object, 0)
-			commonPrefixMap := map[string]bool{}
-			for k := range fs {
-				if strings.HasPrefix(k, *input.Bucket+*input.Prefix) {
-					key := k[len(*input.Bucket):]
-					keyWithoutPrefix := key[len(*input.Prefix):]
-					index := strings.Index(keyWithoutPrefix, "/")
-					if index == -1 || input.Delimiter == nil {
-						objects = append(objects, &s3.Object{
-							Key: aws.String(key),
-						})
-					} else {
-						commonPrefixMap[key[:len(*input.Prefix)+index]] = true
-					}
-				}
-			}
-			commonPrefixes := make([]*s3.CommonPrefix, 0)
-			for k := range commonPrefixMap {
-				commonPrefixes = append(commonPrefixes, &s3.CommonPrefix{
-					Prefix: aws.String(k),
-				})
-			}
-
-			sort.SliceStable(objects, func(i, j int) bool {
-				return *objects[i].Key < *objects[j].Key
-			})
-			maxKeys := 1000
-			if input.MaxKeys != nil {
-				maxKeys = int(*input.MaxKeys)
-			}
-			start := 0
-			if input.ContinuationToken != nil {
-				start, _ = strconv.Atoi(*input.ContinuationToken)
-			}
-
-			if input.StartAfter != nil {
-				for k, v := range objects {
-					if *input.StartAfter == *v.Key {
-						start = k + 1
-					}
-				}
-			}
-
-			isTruncated := false
-			var nextContinuationToken *string
-			if len(objects) > start+maxKeys {
-				isTruncated = true
-				nextContinuationToken = convert.StringPtr(fmt.Sprintf("%d", start+maxKeys))
-				objects = objects[start : start+maxKeys]
-			} else {
-				objects = objects[start:]
-			}
-
-			if input.StartAfter != nil {
-				for k, v := range commonPrefixes {
-					if *input.StartAfter == *v.Prefix {
-						start = k + 1
-					}
-				}
-			}
-
-			if len(commonPrefixes) > start+maxKeys {
-				isTruncated = true
-				nextContinuationToken = convert.StringPtr(fmt.Sprintf("%d", start+maxKeys))
-				commonPrefixes = commonPrefixes[start : start+maxKeys]
-			} else if len(commonPrefixes) > 0 {
-				commonPrefixes = commonPrefixes[start:]
-			}
-
-			return &s3.ListObjectsV2Output{
-				CommonPrefixes:        commonPrefixes,
-				Contents:              objects,
-				IsTruncated:           &isTruncated,
-				NextContinuationToken: nextContinuationToken,
-			}
-		}, nil)
-	s3cli.On("PutObjectWithContext", mock.Anything, mock.Anything).Return(putObjectFn, nil)
-
-	s3cli.On("HeadObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadObjectInput) bool {
-		_, ok := fs[*input.Bucket+*input.Key]
-		return !ok
-	})).Return(nil, awserr.New("NotFound", "", nil))
-	s3cli.On("HeadObjectWithContext", mock.Anything, mock.Anything).Return(&s3.HeadObjectOutput{}, nil)
-
-	s3cli.On("GetObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.GetObjectInput) bool {
-		_, ok := fs[*input.Bucket+*input.Key]
-		return !ok
-	})).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "", nil))
-	s3cli.On("GetObjectWithContext", mock.Anything, mock.Anything).Return(getObjectFn, nil)
-}
-
 func (s *historyArchiverSuite) TestValidateURI() {
 	testCases := []struct {
 		URI         string
@@ -231,12 +111,12 @@ func (s *historyArchiverSuite) TestValidateURI() {
 			expectedErr: archiver.ErrURISchemeMismatch,
 		},
 		{
-			URI:         "s3://",
+			URI:         "test://",
 			expectedErr: errNoBucketSpecified,
 		},
 		{
-			URI:         "s3://bucket/a/b/c",
-			expectedErr: errBucketNotExists,
+			URI:         "test://bucket/a/b/c",
+			expectedErr: ErrBucketNotExists,
 		},
 		{
 			URI:         testBucketURI,
@@ -244,11 +124,6 @@ func (s *historyArchiverSuite) TestValidateURI() {
 		},
 	}
 
-	s.s3cli.On("HeadBucketWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadBucketInput) bool {
-		return *input.Bucket != s.testArchivalURI.Hostname()
-	})).Return(nil, awserr.New("NotFound", "", nil))
-	s.s3cli.On("HeadBucketWithContext", mock.Anything, mock.Anything).Return(&s3.HeadBucketOutput{}, nil)
-
 	historyArchiver := s.newTestHistoryArchiver(nil)
 	for _, tc := range testCases {
 		URI, err := archiver.NewURI(tc.URI)
@@ -515,7 +390,7 @@ func (s *historyArchiverSuite) TestGet_Fail_KeyNotExist() {
 		PageSize:             testPageSize,
 		CloseFailoverVersion: &testCloseFailoverVersion,
 	}
-	URI, err := archiver.NewURI("s3://test-bucket/non-existent")
+	URI, err := archiver.NewURI("test://test-bucket/non-existent")
 	s.NoError(err)
 	response, err := historyArchiver.Get(context.Background(), URI, request)
 	s.Nil(response)
@@ -633,14 +508,7 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 }
 
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
-	//config := &config.S3Archiver{}
-	//archiver, err := newHistoryArchiver(s.container, config, historyIterator)
-	archiver := &historyArchiver{
-		container:       s.container,
-		s3cli:           s.s3cli,
-		historyIterator: historyIterator,
-	}
-	return archiver
+	return newHistoryArchiver(s.container, testScheme, s.store, noopBlobCodec, historyIterator)
 }
 
 func (s *historyArchiverSuite) setupHistoryDirectory() {
@@ -713,21 +581,14 @@ func (s *historyArchiverSuite) writeHistoryBatchesForGetTest(historyBatches []*a
 		data, err := encoder.Encode(batch)
 		s.Require().NoError(err)
 		key := constructHistoryKey("", testNamespaceID, testWorkflowID, testRunID, version, i)
-		_, err = s.s3cli.PutObjectWithContext(context.Background(), &s3.PutObjectInput{
-			Bucket: aws.String(testBucket),
-			Key:    aws.String(key),
-			Body:   bytes.NewReader(data),
-		})
-		s.Require().NoError(err)
+		s.Require().NoError(s.store.Put(context.Background(), s.testArchivalURI, key, data))
 	}
 }
 
 func (s *historyArchiverSuite) assertKeyExists(key string) {
-	_, err := s.s3cli.GetObjectWithContext(context.Background(), &s3.GetObjectInput{
-		Bucket: aws.String(testBucket),
-		Key:    aws.String(key),
-	})
+	exists, err := s.store.Exists(context.Background(), s.testArchivalURI, key)
 	s.NoError(err)
+	s.True(exists)
 }
 
 func getCanceledContext() context.Context {
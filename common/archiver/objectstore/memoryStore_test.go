@@ -0,0 +1,150 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.temporal.io/server/common/archiver"
+)
+
+type (
+	// memoryStore is an in memory Store for the tests, the keys of a bucket are kept in a map
+	memoryStore struct {
+		sync.Mutex
+		buckets map[string]map[string][]byte
+	}
+)
+
+var _ Store = (*memoryStore)(nil)
+
+func newMemoryStore(buckets ...string) *memoryStore {
+	store := &memoryStore{buckets: make(map[string]map[string][]byte)}
+	for _, bucket := range buckets {
+		store.buckets[bucket] = make(map[string][]byte)
+	}
+	return store
+}
+
+func (m *memoryStore) Put(_ context.Context, URI archiver.URI, key string, data []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	objects, ok := m.buckets[URI.Hostname()]
+	if !ok {
+		return ErrBucketNotExists
+	}
+	objects[key] = append([]byte(nil), data...)
+	return nil
+}
+
+func (m *memoryStore) Get(_ context.Context, URI archiver.URI, key string) ([]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	objects, ok := m.buckets[URI.Hostname()]
+	if !ok {
+		return nil, ErrBucketNotExists
+	}
+	data, ok := objects[key]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+	return data, nil
+}
+
+func (m *memoryStore) Exists(ctx context.Context, URI archiver.URI, key string) (bool, error) {
+	_, err := m.Get(ctx, URI, key)
+	if err == ErrObjectNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (m *memoryStore) List(_ context.Context, URI archiver.URI, request *ListRequest) (*ListResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+	objects, ok := m.buckets[URI.Hostname()]
+	if !ok {
+		return nil, ErrBucketNotExists
+	}
+
+	var entries []string
+	commonPrefixes := make(map[string]bool)
+	for key := range objects {
+		if !strings.HasPrefix(key, request.Prefix) {
+			continue
+		}
+		if request.Delimiter != "" {
+			if index := strings.Index(key[len(request.Prefix):], request.Delimiter); index != -1 {
+				commonPrefix := key[:len(request.Prefix)+index+len(request.Delimiter)]
+				if !commonPrefixes[commonPrefix] {
+					commonPrefixes[commonPrefix] = true
+					entries = append(entries, commonPrefix)
+				}
+				continue
+			}
+		}
+		entries = append(entries, key)
+	}
+	sort.Strings(entries)
+
+	start := 0
+	if len(request.NextPageToken) != 0 {
+		start, _ = strconv.Atoi(string(request.NextPageToken))
+	}
+	end := len(entries)
+	if start > end {
+		start = end
+	}
+	response := &ListResponse{}
+	if request.PageSize > 0 && start+request.PageSize < end {
+		end = start + request.PageSize
+		response.NextPageToken = []byte(strconv.Itoa(end))
+	}
+	for _, entry := range entries[start:end] {
+		if commonPrefixes[entry] {
+			response.CommonPrefixes = append(response.CommonPrefixes, entry)
+		} else {
+			response.Keys = append(response.Keys, entry)
+		}
+	}
+	return response, nil
+}
+
+func (m *memoryStore) ValidateBucket(_ context.Context, URI archiver.URI) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.buckets[URI.Hostname()]; !ok {
+		return ErrBucketNotExists
+	}
+	return nil
+}
+
+func (m *memoryStore) IsRetryableError(_ error) bool {
+	return false
+}
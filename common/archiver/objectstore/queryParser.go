@@ -24,7 +24,7 @@
 
 //go:generate mockgen -copyright_file ../../../LICENSE -package $GOPACKAGE -source queryParser.go -destination queryParser_mock.go -mock_names Interface=MockQueryParser
 
-package s3store
+package objectstore
 
 import (
 	"errors"
@@ -75,7 +75,7 @@ const (
 	defaultDateTimeFormat = time.RFC3339
 )
 
-// NewQueryParser creates a new query parser for filestore
+// NewQueryParser creates a new query parser for the object store archivers
 func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
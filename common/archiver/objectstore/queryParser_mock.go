@@ -25,8 +25,8 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: queryParser.go
 
-// Package s3store is a generated GoMock package.
-package s3store
+// Package objectstore is a generated GoMock package.
+package objectstore
 
 import (
 	reflect "reflect"
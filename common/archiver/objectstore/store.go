@@ -0,0 +1,75 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+
+	"go.temporal.io/server/common/archiver"
+)
+
+var (
+	// ErrObjectNotFound is the error returned by a Store for a key which does not exist
+	ErrObjectNotFound = errors.New("object not found")
+	// ErrBucketNotExists is the error returned by a Store for a bucket which does not exist
+	ErrBucketNotExists = errors.New("requested bucket does not exist")
+)
+
+type (
+	// Store is the object storage backend of the object store archivers. The bucket
+	// is the hostname of the archival URI and the keys are built from the URI path.
+	Store interface {
+		// Put writes the data of an object
+		Put(ctx context.Context, URI archiver.URI, key string, data []byte) error
+		// Get reads the data of an object, it returns ErrObjectNotFound if the object does not exist
+		Get(ctx context.Context, URI archiver.URI, key string) ([]byte, error)
+		// Exists checks whether an object exists
+		Exists(ctx context.Context, URI archiver.URI, key string) (bool, error)
+		// List lists the keys starting with a prefix in lexicographical order
+		List(ctx context.Context, URI archiver.URI, request *ListRequest) (*ListResponse, error)
+		// ValidateBucket returns ErrBucketNotExists if the bucket of the URI does not exist
+		ValidateBucket(ctx context.Context, URI archiver.URI) error
+		// IsRetryableError checks whether an error returned by the store is transient
+		IsRetryableError(err error) bool
+	}
+
+	// ListRequest is the request to list keys
+	ListRequest struct {
+		Prefix string
+		// Delimiter groups the keys which contain the delimiter after the prefix into CommonPrefixes when set
+		Delimiter     string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListResponse is the response to list keys
+	ListResponse struct {
+		Keys []string
+		// CommonPrefixes are the prefixes of the grouped keys up to and including the delimiter
+		CommonPrefixes []string
+		NextPageToken  []byte
+	}
+)
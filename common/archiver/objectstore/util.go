@@ -22,28 +22,20 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-package s3store
+package objectstore
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	commonpb "go.temporal.io/api/common/v1"
 	historypb "go.temporal.io/api/history/v1"
-	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
-	"go.uber.org/multierr"
 
 	archiverproto "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
@@ -80,17 +72,9 @@ func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
 	return token, err
 }
 
-func deserializeQueryVisibilityToken(bytes []byte) *string {
-	var ret = string(bytes)
-	return &ret
-}
-func serializeQueryVisibilityToken(token string) []byte {
-	return []byte(token)
-}
-
 // Only validates the scheme and buckets are passed
-func softValidateURI(URI archiver.URI) error {
-	if URI.Scheme() != URIScheme {
+func softValidateURI(scheme string, URI archiver.URI) error {
+	if URI.Scheme() != scheme {
 		return archiver.ErrURISchemeMismatch
 	}
 	if len(URI.Hostname()) == 0 {
@@ -99,42 +83,6 @@ func softValidateURI(URI archiver.URI) error {
 	return nil
 }
 
-func bucketExists(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI) error {
-	ctx, cancel := ensureContextTimeout(ctx)
-	defer cancel()
-	_, err := s3cli.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
-		Bucket: aws.String(URI.Hostname()),
-	})
-	if err == nil {
-		return nil
-	}
-	if isNotFoundError(err) {
-		return errBucketNotExists
-	}
-	return err
-}
-
-func keyExists(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) (bool, error) {
-	ctx, cancel := ensureContextTimeout(ctx)
-	defer cancel()
-	_, err := s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
-		Bucket: aws.String(URI.Hostname()),
-		Key:    aws.String(key),
-	})
-	if err != nil {
-		if isNotFoundError(err) {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
-}
-
-func isNotFoundError(err error) bool {
-	aerr, ok := err.(awserr.Error)
-	return ok && (aerr.Code() == "NotFound")
-}
-
 // Key construction
 func constructHistoryKey(path, namespaceID, workflowID, runID string, version int64, batchIdx int) string {
 	prefix := constructHistoryKeyPrefixWithVersion(path, namespaceID, workflowID, runID, version)
@@ -179,66 +127,6 @@ func constructVisibilitySearchPrefix(path, namespaceID, primaryIndexKey, primary
 	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "visibility", primaryIndexKey, primaryIndexValue, secondaryIndexType}, "/"), "/")
 }
 
-func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
-	if _, ok := ctx.Deadline(); ok {
-		return ctx, func() {}
-	}
-	return context.WithTimeout(ctx, defaultBlobstoreTimeout)
-}
-func upload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, data []byte) error {
-	ctx, cancel := ensureContextTimeout(ctx)
-	defer cancel()
-
-	_, err := s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
-		Bucket: aws.String(URI.Hostname()),
-		Key:    aws.String(key),
-		Body:   bytes.NewReader(data),
-	})
-	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			if aerr.Code() == s3.ErrCodeNoSuchBucket {
-				return serviceerror.NewInvalidArgument(errBucketNotExists.Error())
-			}
-		}
-		return err
-	}
-	return nil
-}
-
-func download(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) ([]byte, error) {
-	ctx, cancel := ensureContextTimeout(ctx)
-	defer cancel()
-	result, err := s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
-		Bucket: aws.String(URI.Hostname()),
-		Key:    aws.String(key),
-	})
-
-	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			if aerr.Code() == s3.ErrCodeNoSuchBucket {
-				return nil, serviceerror.NewInvalidArgument(errBucketNotExists.Error())
-			}
-
-			if aerr.Code() == s3.ErrCodeNoSuchKey {
-				return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
-			}
-		}
-		return nil, err
-	}
-
-	defer func() {
-		if ierr := result.Body.Close(); ierr != nil {
-			err = multierr.Append(err, ierr)
-		}
-	}()
-
-	body, err := ioutil.ReadAll(result.Body)
-	if err != nil {
-		return nil, err
-	}
-	return body, nil
-}
-
 func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*historypb.History, isLast bool) bool {
 	lastBatch := historyBatches[len(historyBatches)-1].Events
 	lastEvent := lastBatch[len(lastBatch)-1]
@@ -0,0 +1,253 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+
+	archiverproto "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	visibilityArchiver struct {
+		container   *archiver.VisibilityBootstrapContainer
+		scheme      string
+		store       Store
+		blobCodec   archiver.BlobCodec
+		queryParser QueryParser
+	}
+
+	queryVisibilityRequest struct {
+		namespaceID   string
+		pageSize      int
+		nextPageToken []byte
+		parsedQuery   *parsedQuery
+	}
+
+	indexToArchive struct {
+		primaryIndex            string
+		primaryIndexValue       string
+		secondaryIndex          string
+		secondaryIndexTimestamp int64
+	}
+)
+
+const (
+	errEncodeVisibilityRecord       = "failed to encode visibility record"
+	secondaryIndexKeyStartTimeout   = "startTimeout"
+	secondaryIndexKeyCloseTimeout   = "closeTimeout"
+	primaryIndexKeyWorkflowTypeName = "workflowTypeName"
+	primaryIndexKeyWorkflowID       = "workflowID"
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver which archives visibility
+// records to the object store for the URIs of the scheme
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	scheme string,
+	store Store,
+	blobCodec archiver.BlobCodec,
+) archiver.VisibilityArchiver {
+	return newVisibilityArchiver(container, scheme, store, blobCodec)
+}
+
+func newVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	scheme string,
+	store Store,
+	blobCodec archiver.BlobCodec,
+) *visibilityArchiver {
+	return &visibilityArchiver{
+		container:   container,
+		scheme:      scheme,
+		store:       store,
+		blobCodec:   blobCodec,
+		queryParser: NewQueryParser(),
+	}
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverproto.ArchiveVisibilityRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	scope := v.container.MetricsClient.Scope(metrics.VisibilityArchiverScope, metrics.NamespaceTag(request.Namespace))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	sw := scope.StartTimer(metrics.ServiceLatency)
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+	archiveFailReason := ""
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if v.store.IsRetryableError(err) {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveTransientErrorCount)
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+			} else {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveNonRetryableErrorCount)
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+				if featureCatalog.NonRetryableError != nil {
+					err = featureCatalog.NonRetryableError()
+				}
+			}
+		}
+	}()
+
+	if err := softValidateURI(v.scheme, URI); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidURI
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidArchiveRequest
+		return err
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		archiveFailReason = errEncodeVisibilityRecord
+		return err
+	}
+	encodedVisibilityRecord, err = v.blobCodec.Encode(encodedVisibilityRecord)
+	if err != nil {
+		archiveFailReason = errEncodeVisibilityRecord
+		return err
+	}
+	indexes := createIndexesToArchive(request)
+	// Upload archive to all indexes
+	for _, element := range indexes {
+		key := constructTimestampIndex(URI.Path(), request.GetNamespaceId(), element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.GetRunId())
+		if err := v.store.Put(ctx, URI, key, encodedVisibilityRecord); err != nil {
+			archiveFailReason = errWriteKey
+			return err
+		}
+	}
+	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
+	return nil
+}
+
+func createIndexesToArchive(request *archiverproto.ArchiveVisibilityRequest) []indexToArchive {
+	return []indexToArchive{
+		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyCloseTimeout, request.CloseTimestamp},
+		{primaryIndexKeyWorkflowTypeName, request.WorkflowTypeName, secondaryIndexKeyStartTimeout, request.StartTimestamp},
+		{primaryIndexKeyWorkflowID, request.GetWorkflowId(), secondaryIndexKeyCloseTimeout, request.CloseTimestamp},
+		{primaryIndexKeyWorkflowID, request.GetWorkflowId(), secondaryIndexKeyStartTimeout, request.StartTimestamp},
+	}
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := softValidateURI(v.scheme, URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	return v.query(ctx, URI, &queryVisibilityRequest{
+		namespaceID:   request.NamespaceID,
+		pageSize:      request.PageSize,
+		nextPageToken: request.NextPageToken,
+		parsedQuery:   parsedQuery,
+	})
+}
+
+func (v *visibilityArchiver) query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	primaryIndex := primaryIndexKeyWorkflowTypeName
+	primaryIndexValue := request.parsedQuery.workflowTypeName
+	if request.parsedQuery.workflowID != nil {
+		primaryIndex = primaryIndexKeyWorkflowID
+		primaryIndexValue = request.parsedQuery.workflowID
+	}
+	var prefix = constructVisibilitySearchPrefix(URI.Path(), request.namespaceID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout) + "/"
+	if request.parsedQuery.closeTime != nil {
+		prefix = constructTimeBasedSearchKey(URI.Path(), request.namespaceID, primaryIndex, *primaryIndexValue, secondaryIndexKeyCloseTimeout, *request.parsedQuery.closeTime, *request.parsedQuery.searchPrecision)
+	}
+	if request.parsedQuery.startTime != nil {
+		prefix = constructTimeBasedSearchKey(URI.Path(), request.namespaceID, primaryIndex, *primaryIndexValue, secondaryIndexKeyStartTimeout, *request.parsedQuery.startTime, *request.parsedQuery.searchPrecision)
+	}
+
+	results, err := v.store.List(ctx, URI, &ListRequest{
+		Prefix:        prefix,
+		PageSize:      request.pageSize,
+		NextPageToken: request.nextPageToken,
+	})
+	if err != nil {
+		if v.store.IsRetryableError(err) {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	if len(results.Keys) == 0 {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	response := &archiver.QueryVisibilityResponse{
+		NextPageToken: results.NextPageToken,
+	}
+	for _, key := range results.Keys {
+		encodedRecord, err := v.store.Get(ctx, URI, key)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		encodedRecord, err = v.blobCodec.Decode(encodedRecord)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+	}
+	return response, nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	err := softValidateURI(v.scheme, URI)
+	if err != nil {
+		return err
+	}
+	return v.store.ValidateBucket(context.TODO(), URI)
+}
@@ -22,7 +22,7 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-package s3store
+package objectstore
 
 import (
 	"context"
@@ -31,17 +31,13 @@ import (
 	"testing"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/golang/mock/gomock"
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
 
 	archiverproto "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
-	"go.temporal.io/server/common/archiver/s3store/mocks"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
@@ -58,7 +54,7 @@ import (
 type visibilityArchiverSuite struct {
 	*require.Assertions
 	suite.Suite
-	s3cli *mocks.S3API
+	store *memoryStore
 
 	container         *archiver.VisibilityBootstrapContainer
 	logger            log.Logger
@@ -82,16 +78,16 @@ func (s *visibilityArchiverSuite) TestValidateURI() {
 			expectedErr: archiver.ErrURISchemeMismatch,
 		},
 		{
-			URI:         "s3://",
+			URI:         "test://",
 			expectedErr: errNoBucketSpecified,
 		},
 		{
-			URI:         "s3:///test",
+			URI:         "test:///test",
 			expectedErr: errNoBucketSpecified,
 		},
 		{
-			URI:         "s3://bucket/a/b/c",
-			expectedErr: errBucketNotExists,
+			URI:         "test://bucket/a/b/c",
+			expectedErr: ErrBucketNotExists,
 		},
 		{
 			URI:         testBucketURI,
@@ -99,11 +95,6 @@ func (s *visibilityArchiverSuite) TestValidateURI() {
 		},
 	}
 
-	s.s3cli.On("HeadBucketWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadBucketInput) bool {
-		return *input.Bucket != s.testArchivalURI.Hostname()
-	})).Return(nil, awserr.New("NotFound", "", nil))
-	s.s3cli.On("HeadBucketWithContext", mock.Anything, mock.Anything).Return(&s3.HeadBucketOutput{}, nil)
-
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	for _, tc := range testCases {
 		URI, err := archiver.NewURI(tc.URI)
@@ -113,12 +104,7 @@ func (s *visibilityArchiverSuite) TestValidateURI() {
 }
 
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
-	archiver := &visibilityArchiver{
-		container:   s.container,
-		s3cli:       s.s3cli,
-		queryParser: NewQueryParser(),
-	}
-	return archiver
+	return newVisibilityArchiver(s.container, testScheme, s.store, noopBlobCodec)
 }
 
 const (
@@ -128,8 +114,7 @@ const (
 func (s *visibilityArchiverSuite) SetupSuite() {
 	var err error
 	scope := tally.NewTestScope("test", nil)
-	s.s3cli = &mocks.S3API{}
-	setupFsEmulation(s.s3cli)
+	s.store = newMemoryStore(testBucket)
 
 	s.testArchivalURI, err = archiver.NewURI(testBucketURI)
 	s.Require().NoError(err)
@@ -223,7 +208,7 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	s.NoError(err)
 
 	expectedKey := constructTimestampIndex(URI.Path(), testNamespaceID, primaryIndexKeyWorkflowID, testWorkflowID, secondaryIndexKeyCloseTimeout, closeTimestamp.UnixNano(), testRunID)
-	data, err := download(context.Background(), visibilityArchiver.s3cli, URI, expectedKey)
+	data, err := s.store.Get(context.Background(), URI, expectedKey)
 	s.NoError(err, expectedKey)
 
 	archivedRecord := &archiverproto.ArchiveVisibilityRequest{}
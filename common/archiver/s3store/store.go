@@ -0,0 +1,230 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"go.uber.org/multierr"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/objectstore"
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	// store is the objectstore.Store of the s3 archivers
+	store struct {
+		s3cli s3iface.S3API
+	}
+)
+
+func newS3Client(config *config.S3Archiver) (s3iface.S3API, error) {
+	s3Config := &aws.Config{
+		Endpoint:         config.Endpoint,
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
+	}
+	sess, err := session.NewSession(s3Config)
+	if err != nil {
+		return nil, err
+	}
+	return s3.New(sess), nil
+}
+
+func newStore(s3cli s3iface.S3API) objectstore.Store {
+	return &store{s3cli: s3cli}
+}
+
+func (s *store) Put(ctx context.Context, URI archiver.URI, key string, data []byte) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	_, err := s.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(URI.Hostname()),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			if aerr.Code() == s3.ErrCodeNoSuchBucket {
+				return objectstore.ErrBucketNotExists
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *store) Get(ctx context.Context, URI archiver.URI, key string) (_ []byte, err error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	result, err := s.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(URI.Hostname()),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			if aerr.Code() == s3.ErrCodeNoSuchBucket {
+				return nil, objectstore.ErrBucketNotExists
+			}
+
+			if aerr.Code() == s3.ErrCodeNoSuchKey {
+				return nil, objectstore.ErrObjectNotFound
+			}
+		}
+		return nil, err
+	}
+
+	defer func() {
+		if ierr := result.Body.Close(); ierr != nil {
+			err = multierr.Append(err, ierr)
+		}
+	}()
+
+	body, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (s *store) Exists(ctx context.Context, URI archiver.URI, key string) (bool, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	_, err := s.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(URI.Hostname()),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *store) List(ctx context.Context, URI archiver.URI, request *objectstore.ListRequest) (*objectstore.ListResponse, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(URI.Hostname()),
+		Prefix: aws.String(request.Prefix),
+	}
+	if request.Delimiter != "" {
+		input.Delimiter = aws.String(request.Delimiter)
+	}
+	if request.PageSize > 0 {
+		input.MaxKeys = aws.Int64(int64(request.PageSize))
+	}
+	if len(request.NextPageToken) != 0 {
+		input.ContinuationToken = aws.String(string(request.NextPageToken))
+	}
+	results, err := s.s3cli.ListObjectsV2WithContext(ctx, input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil, objectstore.ErrBucketNotExists
+		}
+		return nil, err
+	}
+
+	response := &objectstore.ListResponse{}
+	for _, object := range results.Contents {
+		response.Keys = append(response.Keys, *object.Key)
+	}
+	for _, commonPrefix := range results.CommonPrefixes {
+		response.CommonPrefixes = append(response.CommonPrefixes, *commonPrefix.Prefix)
+	}
+	if aws.BoolValue(results.IsTruncated) && results.NextContinuationToken != nil {
+		response.NextPageToken = []byte(*results.NextContinuationToken)
+	}
+	return response, nil
+}
+
+func (s *store) ValidateBucket(ctx context.Context, URI archiver.URI) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	_, err := s.s3cli.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(URI.Hostname()),
+	})
+	if err == nil {
+		return nil
+	}
+	if isNotFoundError(err) {
+		return objectstore.ErrBucketNotExists
+	}
+	return err
+}
+
+func (s *store) IsRetryableError(err error) bool {
+	return isRetryableError(err)
+}
+
+func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, defaultBlobstoreTimeout)
+}
+
+func isNotFoundError(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && (aerr.Code() == "NotFound")
+}
+
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return isStatusCodeRetryable(aerr) || request.IsErrorRetryable(aerr) || request.IsErrorThrottle(aerr)
+	}
+	return false
+}
+
+func isStatusCodeRetryable(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		if rerr, ok := err.(awserr.RequestFailure); ok {
+			if rerr.StatusCode() == 429 {
+				return true
+			}
+			if rerr.StatusCode() >= 500 && rerr.StatusCode() != 501 {
+				return true
+			}
+		}
+		return isStatusCodeRetryable(aerr.OrigErr())
+	}
+	return false
+}
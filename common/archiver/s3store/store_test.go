@@ -0,0 +1,303 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.uber.org/zap"
+
+	archiverproto "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/objectstore"
+	"go.temporal.io/server/common/archiver/s3store/mocks"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	testNamespaceID      = "test-namespace-id"
+	testNamespace        = "test-namespace"
+	testWorkflowID       = "test-workflow-id"
+	testRunID            = "test-run-id"
+	testWorkflowTypeName = "test-workflow-type"
+	testBucket           = "test-bucket"
+	testBucketURI        = "s3://" + testBucket
+)
+
+type storeSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	s3cli *mocks.S3API
+	store objectstore.Store
+	URI   archiver.URI
+}
+
+func TestStoreSuite(t *testing.T) {
+	suite.Run(t, new(storeSuite))
+}
+
+func (s *storeSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.s3cli = &mocks.S3API{}
+	setupFsEmulation(s.s3cli)
+	s.store = newStore(s.s3cli)
+
+	var err error
+	s.URI, err = archiver.NewURI(testBucketURI + "/test-store")
+	s.NoError(err)
+}
+
+func (s *storeSuite) TestPutGet() {
+	ctx := context.Background()
+	s.NoError(s.store.Put(ctx, s.URI, "test-store/a", []byte("data")))
+
+	data, err := s.store.Get(ctx, s.URI, "test-store/a")
+	s.NoError(err)
+	s.Equal([]byte("data"), data)
+
+	_, err = s.store.Get(ctx, s.URI, "test-store/b")
+	s.Equal(objectstore.ErrObjectNotFound, err)
+}
+
+func (s *storeSuite) TestGet_BucketNotExists() {
+	URI, err := archiver.NewURI("s3://missing-bucket/test-store")
+	s.NoError(err)
+	_, err = s.store.Get(context.Background(), URI, "test-store/a")
+	s.Equal(objectstore.ErrBucketNotExists, err)
+}
+
+func (s *storeSuite) TestExists() {
+	ctx := context.Background()
+	s.NoError(s.store.Put(ctx, s.URI, "test-store/a", []byte("data")))
+
+	exists, err := s.store.Exists(ctx, s.URI, "test-store/a")
+	s.NoError(err)
+	s.True(exists)
+
+	exists, err = s.store.Exists(ctx, s.URI, "test-store/b")
+	s.NoError(err)
+	s.False(exists)
+}
+
+func (s *storeSuite) TestList() {
+	ctx := context.Background()
+	for _, key := range []string{"test-store/1/0", "test-store/1/1", "test-store/2/0", "test-store/a", "test-store/b", "test-store/c"} {
+		s.NoError(s.store.Put(ctx, s.URI, key, []byte(key)))
+	}
+
+	response, err := s.store.List(ctx, s.URI, &objectstore.ListRequest{
+		Prefix:    "test-store/",
+		Delimiter: "/",
+	})
+	s.NoError(err)
+	s.Equal([]string{"test-store/a", "test-store/b", "test-store/c"}, response.Keys)
+	s.ElementsMatch([]string{"test-store/1/", "test-store/2/"}, response.CommonPrefixes)
+	s.Nil(response.NextPageToken)
+
+	var keys []string
+	listRequest := &objectstore.ListRequest{
+		Prefix:   "test-store/",
+		PageSize: 4,
+	}
+	for {
+		response, err := s.store.List(ctx, s.URI, listRequest)
+		s.NoError(err)
+		s.True(len(response.Keys) <= 4)
+		keys = append(keys, response.Keys...)
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		listRequest.NextPageToken = response.NextPageToken
+	}
+	s.Len(keys, 6)
+}
+
+func (s *storeSuite) TestValidateBucket() {
+	s.NoError(s.store.ValidateBucket(context.Background(), s.URI))
+
+	URI, err := archiver.NewURI("s3://missing-bucket/test-store")
+	s.NoError(err)
+	s.Equal(objectstore.ErrBucketNotExists, s.store.ValidateBucket(context.Background(), URI))
+}
+
+func (s *storeSuite) TestIsRetryableError() {
+	s.False(s.store.IsRetryableError(nil))
+	s.False(s.store.IsRetryableError(awserr.New(s3.ErrCodeNoSuchKey, "", nil)))
+	s.True(s.store.IsRetryableError(awserr.NewRequestFailure(awserr.New("InternalError", "", nil), 503, "")))
+	s.True(s.store.IsRetryableError(awserr.NewRequestFailure(awserr.New("SlowDown", "", nil), 429, "")))
+}
+
+func (s *storeSuite) TestVisibilityArchiver_KeyLayout() {
+	zapLogger := zap.NewNop()
+	container := &archiver.VisibilityBootstrapContainer{
+		Logger:        loggerimpl.NewLogger(zapLogger),
+		MetricsClient: metrics.NewClient(tally.NoopScope, metrics.VisibilityArchiverScope),
+	}
+	blobCodec, err := archiver.NewBlobCodec(nil)
+	s.NoError(err)
+	visibilityArchiver := objectstore.NewVisibilityArchiver(container, URIScheme, s.store, blobCodec)
+
+	closeTimestamp := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+	request := &archiverproto.ArchiveVisibilityRequest{
+		NamespaceId:      testNamespaceID,
+		Namespace:        testNamespace,
+		WorkflowId:       testWorkflowID,
+		RunId:            testRunID,
+		WorkflowTypeName: testWorkflowTypeName,
+		StartTimestamp:   closeTimestamp.Add(-time.Hour).UnixNano(),
+		CloseTimestamp:   closeTimestamp.UnixNano(),
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		HistoryLength:    int64(101),
+	}
+	s.NoError(visibilityArchiver.Archive(context.Background(), s.URI, request))
+
+	// keys written by the previous s3 archivers remain readable
+	exists, err := s.store.Exists(context.Background(), s.URI, "test-store/test-namespace-id/visibility/workflowID/test-workflow-id/closeTimeout/2020-08-01T10:00:00Z/test-run-id")
+	s.NoError(err)
+	s.True(exists)
+
+	response, err := visibilityArchiver.Query(context.Background(), s.URI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       fmt.Sprintf("WorkflowId = '%s'", testWorkflowID),
+	})
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+	s.Equal(testRunID, response.Executions[0].GetExecution().GetRunId())
+}
+
+func setupFsEmulation(s3cli *mocks.S3API) {
+	fs := make(map[string][]byte)
+
+	isMissingBucket := func(bucket *string) bool {
+		return *bucket != testBucket
+	}
+	putObjectFn := func(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) *s3.PutObjectOutput {
+		buf := new(bytes.Buffer)
+		_, _ = buf.ReadFrom(input.Body)
+		fs[*input.Bucket+*input.Key] = buf.Bytes()
+		return &s3.PutObjectOutput{}
+	}
+	getObjectFn := func(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) *s3.GetObjectOutput {
+		return &s3.GetObjectOutput{
+			Body: ioutil.NopCloser(bytes.NewReader(fs[*input.Bucket+*input.Key])),
+		}
+	}
+	listObjectsFn := func(_ aws.Context, input *s3.ListObjectsV2Input, _ ...request.Option) *s3.ListObjectsV2Output {
+		var entries []string
+		commonPrefixMap := map[string]bool{}
+		for k := range fs {
+			if !strings.HasPrefix(k, *input.Bucket+*input.Prefix) {
+				continue
+			}
+			key := k[len(*input.Bucket):]
+			if input.Delimiter != nil {
+				if index := strings.Index(key[len(*input.Prefix):], *input.Delimiter); index != -1 {
+					commonPrefix := key[:len(*input.Prefix)+index+len(*input.Delimiter)]
+					if !commonPrefixMap[commonPrefix] {
+						commonPrefixMap[commonPrefix] = true
+						entries = append(entries, commonPrefix)
+					}
+					continue
+				}
+			}
+			entries = append(entries, key)
+		}
+		sort.Strings(entries)
+
+		maxKeys := 1000
+		if input.MaxKeys != nil {
+			maxKeys = int(*input.MaxKeys)
+		}
+		start := 0
+		if input.ContinuationToken != nil {
+			start, _ = strconv.Atoi(*input.ContinuationToken)
+		}
+		output := &s3.ListObjectsV2Output{IsTruncated: aws.Bool(false)}
+		end := len(entries)
+		if end > start+maxKeys {
+			end = start + maxKeys
+			output.IsTruncated = aws.Bool(true)
+			output.NextContinuationToken = aws.String(strconv.Itoa(end))
+		}
+		for _, entry := range entries[start:end] {
+			if commonPrefixMap[entry] {
+				output.CommonPrefixes = append(output.CommonPrefixes, &s3.CommonPrefix{Prefix: aws.String(entry)})
+			} else {
+				output.Contents = append(output.Contents, &s3.Object{Key: aws.String(entry)})
+			}
+		}
+		return output
+	}
+
+	s3cli.On("ListObjectsV2WithContext", mock.Anything, mock.MatchedBy(func(input *s3.ListObjectsV2Input) bool {
+		return isMissingBucket(input.Bucket)
+	})).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "", nil))
+	s3cli.On("ListObjectsV2WithContext", mock.Anything, mock.Anything).Return(listObjectsFn, nil)
+
+	s3cli.On("PutObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.PutObjectInput) bool {
+		return isMissingBucket(input.Bucket)
+	})).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "", nil))
+	s3cli.On("PutObjectWithContext", mock.Anything, mock.Anything).Return(putObjectFn, nil)
+
+	s3cli.On("HeadObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadObjectInput) bool {
+		_, ok := fs[*input.Bucket+*input.Key]
+		return !ok
+	})).Return(nil, awserr.New("NotFound", "", nil))
+	s3cli.On("HeadObjectWithContext", mock.Anything, mock.Anything).Return(&s3.HeadObjectOutput{}, nil)
+
+	s3cli.On("GetObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.GetObjectInput) bool {
+		return isMissingBucket(input.Bucket)
+	})).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "", nil))
+	s3cli.On("GetObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.GetObjectInput) bool {
+		_, ok := fs[*input.Bucket+*input.Key]
+		return !ok
+	})).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "", nil))
+	s3cli.On("GetObjectWithContext", mock.Anything, mock.Anything).Return(getObjectFn, nil)
+
+	s3cli.On("HeadBucketWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadBucketInput) bool {
+		return isMissingBucket(input.Bucket)
+	})).Return(nil, awserr.New("NotFound", "", nil))
+	s3cli.On("HeadBucketWithContext", mock.Anything, mock.Anything).Return(&s3.HeadBucketOutput{}, nil)
+}
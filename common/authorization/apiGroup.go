@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+const (
+	// APIGroupRead contains APIs which only read state
+	APIGroupRead APIGroup = "read"
+	// APIGroupWrite contains APIs which start, mutate or process workflows
+	APIGroupWrite APIGroup = "write"
+	// APIGroupAdmin contains APIs which manage namespaces
+	APIGroupAdmin APIGroup = "admin"
+)
+
+type (
+	// APIGroup is a coarse grained category of frontend APIs used for authorization
+	APIGroup string
+)
+
+var apiGroups = map[string]APIGroup{
+	"CountWorkflowExecutions":        APIGroupRead,
+	"DescribeNamespace":              APIGroupRead,
+	"DescribeTaskQueue":              APIGroupRead,
+	"DescribeWorkflowExecution":      APIGroupRead,
+	"GetClusterInfo":                 APIGroupRead,
+	"GetSearchAttributes":            APIGroupRead,
+	"GetWorkflowExecutionHistory":    APIGroupRead,
+	"ListArchivedWorkflowExecutions": APIGroupRead,
+	"ListClosedWorkflowExecutions":   APIGroupRead,
+	"ListNamespaces":                 APIGroupRead,
+	"ListOpenWorkflowExecutions":     APIGroupRead,
+	"ListTaskQueuePartitions":        APIGroupRead,
+	"ListWorkflowExecutions":         APIGroupRead,
+	"QueryWorkflow":                  APIGroupRead,
+	"ScanWorkflowExecutions":         APIGroupRead,
+
+	"PollForActivityTask":              APIGroupWrite,
+	"PollForDecisionTask":              APIGroupWrite,
+	"RecordActivityTaskHeartbeat":      APIGroupWrite,
+	"RecordActivityTaskHeartbeatById":  APIGroupWrite,
+	"RequestCancelWorkflowExecution":   APIGroupWrite,
+	"ResetStickyTaskQueue":             APIGroupWrite,
+	"ResetWorkflowExecution":           APIGroupWrite,
+	"RespondActivityTaskCanceled":      APIGroupWrite,
+	"RespondActivityTaskCanceledById":  APIGroupWrite,
+	"RespondActivityTaskCompleted":     APIGroupWrite,
+	"RespondActivityTaskCompletedById": APIGroupWrite,
+	"RespondActivityTaskFailed":        APIGroupWrite,
+	"RespondActivityTaskFailedById":    APIGroupWrite,
+	"RespondDecisionTaskCompleted":     APIGroupWrite,
+	"RespondDecisionTaskFailed":        APIGroupWrite,
+	"RespondQueryTaskCompleted":        APIGroupWrite,
+	"SignalWithStartWorkflowExecution": APIGroupWrite,
+	"SignalWorkflowExecution":          APIGroupWrite,
+	"StartWorkflowExecution":           APIGroupWrite,
+	"TerminateWorkflowExecution":       APIGroupWrite,
+
+	"DeprecateNamespace": APIGroupAdmin,
+	"RegisterNamespace":  APIGroupAdmin,
+	"UpdateNamespace":    APIGroupAdmin,
+}
+
+// GetAPIGroup returns the API group of a frontend API, unknown APIs belong to the admin group
+func GetAPIGroup(apiName string) APIGroup {
+	if group, ok := apiGroups[apiName]; ok {
+		return group
+	}
+	return APIGroupAdmin
+}
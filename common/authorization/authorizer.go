@@ -37,11 +37,16 @@ const (
 
 type (
 	// Attributes is input for authority to make decision.
-	// It can be extended in future if required auth on resources like WorkflowType and TaskQueue
+	// TaskQueue, WorkflowType and WorkflowID are only set for APIs whose request carries them.
 	Attributes struct {
-		Actor     string
-		APIName   string
-		Namespace string
+		Actor        string
+		Roles        []string
+		APIName      string
+		APIGroup     APIGroup
+		Namespace    string
+		TaskQueue    string
+		WorkflowType string
+		WorkflowID   string
 	}
 
 	// Result is result from authority.
@@ -0,0 +1,213 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerScheme        = "bearer"
+
+	defaultRolesClaim = "roles"
+)
+
+type (
+	// Config is the authorization configuration of the frontend
+	Config struct {
+		// Policy configures the policy authorizer, requests are not authorized if the policy file is empty
+		Policy PolicyAuthorizerConfig `yaml:"policy"`
+		// ClaimMapper configures how the caller identity is extracted from requests
+		ClaimMapper ClaimMapperConfig `yaml:"claimMapper"`
+	}
+
+	// ClaimMapperConfig is the config for the default claim mapper.
+	ClaimMapperConfig struct {
+		// JWKSFile is a local JSON Web Key Set used to verify bearer tokens, bearer tokens are rejected if empty
+		JWKSFile string `yaml:"jwksFile"`
+		// PollInterval is how often the key set file is checked for changes
+		PollInterval time.Duration `yaml:"pollInterval"`
+		// Issuer, if set, must match the iss claim of bearer tokens
+		Issuer string `yaml:"issuer"`
+		// Audience, if set, must be contained in the aud claim of bearer tokens
+		Audience string `yaml:"audience"`
+		// AllowTokensWithoutExpiration accepts bearer tokens which have no exp claim, such tokens never expire
+		AllowTokensWithoutExpiration bool `yaml:"allowTokensWithoutExpiration"`
+		// RolesClaim is the bearer token claim which holds the roles of the caller, defaults to "roles"
+		RolesClaim string `yaml:"rolesClaim"`
+	}
+
+	// Claims is the identity of a caller as extracted by a ClaimMapper
+	Claims struct {
+		// Subject becomes the Actor of Attributes
+		Subject string
+		// Roles are the roles granted to the subject
+		Roles []string
+	}
+
+	// AuthInfo contains the credentials presented with a request
+	AuthInfo struct {
+		// AuthToken is the value of the authorization header
+		AuthToken string
+		// TLSCertificate is the verified client certificate, if the connection uses mutual TLS
+		TLSCertificate *x509.Certificate
+	}
+
+	// ClaimMapper maps the credentials of a request to claims
+	ClaimMapper interface {
+		// GetClaims returns the claims of the caller, nil claims mean the caller is anonymous
+		GetClaims(authInfo *AuthInfo) (*Claims, error)
+	}
+
+	defaultClaimMapper struct {
+		jwt *jwtVerifier
+	}
+
+	claimsContextKey struct{}
+)
+
+var _ ClaimMapper = (*defaultClaimMapper)(nil)
+
+// NewDefaultClaimMapper creates a claim mapper which validates bearer tokens against the
+// configured key set and otherwise falls back to the verified client certificate.
+// A bearer token takes precedence over the client certificate since the certificate usually
+// identifies the client host rather than the caller.
+func NewDefaultClaimMapper(
+	config *ClaimMapperConfig,
+	logger log.Logger,
+	doneCh chan struct{},
+) (ClaimMapper, error) {
+	mapper := &defaultClaimMapper{}
+	if config.JWKSFile != "" {
+		verifier, err := newJWTVerifier(config, logger, doneCh)
+		if err != nil {
+			return nil, err
+		}
+		mapper.jwt = verifier
+	}
+	return mapper, nil
+}
+
+// GetClaims returns the claims of a bearer token if present, otherwise the subject common name
+// and organizational units of the client certificate are used as subject and roles
+func (m *defaultClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	if authInfo.AuthToken != "" {
+		if m.jwt == nil {
+			return nil, status.Error(codes.Unauthenticated, "bearer tokens are not accepted")
+		}
+		token, err := parseBearerToken(authInfo.AuthToken)
+		if err != nil {
+			return nil, err
+		}
+		return m.jwt.verify(token)
+	}
+
+	if authInfo.TLSCertificate != nil {
+		subject := authInfo.TLSCertificate.Subject
+		return &Claims{
+			Subject: subject.CommonName,
+			Roles:   subject.OrganizationalUnit,
+		}, nil
+	}
+	return nil, nil
+}
+
+// NewClaimMapperInterceptor returns a grpc interceptor which maps the credentials of every
+// request to claims and makes them available through ClaimsFromContext
+func NewClaimMapperInterceptor(
+	claimMapper ClaimMapper,
+	logger log.Logger,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		claims, err := claimMapper.GetClaims(authInfoFromContext(ctx))
+		if err != nil {
+			logger.Debug("Failed to map request credentials to claims", tag.Name(info.FullMethod), tag.Error(err))
+			if _, ok := status.FromError(err); !ok {
+				err = status.Error(codes.Unauthenticated, err.Error())
+			}
+			return nil, err
+		}
+		if claims != nil {
+			ctx = NewContextWithClaims(ctx, claims)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// NewContextWithClaims returns a context which carries the claims of the caller
+func NewContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims of the caller, or nil if the caller is anonymous
+func ClaimsFromContext(ctx context.Context) *Claims {
+	claims, _ := ctx.Value(claimsContextKey{}).(*Claims)
+	return claims
+}
+
+func authInfoFromContext(ctx context.Context) *AuthInfo {
+	authInfo := &AuthInfo{}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			authInfo.AuthToken = values[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			chains := tlsInfo.State.VerifiedChains
+			if len(chains) > 0 && len(chains[0]) > 0 {
+				authInfo.TLSCertificate = chains[0][0]
+			}
+		}
+	}
+	return authInfo
+}
+
+func parseBearerToken(header string) (string, error) {
+	parts := strings.SplitN(header, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], bearerScheme) {
+		return "", status.Error(codes.Unauthenticated, "authorization header is not a bearer token")
+	}
+	return strings.TrimSpace(parts[1]), nil
+}
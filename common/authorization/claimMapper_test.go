@@ -0,0 +1,240 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+)
+
+type (
+	claimMapperSuite struct {
+		suite.Suite
+		rsaKey   *rsa.PrivateKey
+		ecKey    *ecdsa.PrivateKey
+		jwksFile string
+		doneCh   chan struct{}
+		mapper   ClaimMapper
+	}
+)
+
+func TestClaimMapperSuite(t *testing.T) {
+	suite.Run(t, new(claimMapperSuite))
+}
+
+func (s *claimMapperSuite) SetupTest() {
+	var err error
+	s.rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	s.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+
+	keySet := jsonWebKeySet{Keys: []jsonWebKey{
+		{
+			Kty: "RSA",
+			Kid: "rsa-key",
+			N:   base64.RawURLEncoding.EncodeToString(s.rsaKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.rsaKey.E)).Bytes()),
+		},
+		{
+			Kty: "EC",
+			Kid: "ec-key",
+			Crv: "P-256",
+			X:   base64.RawURLEncoding.EncodeToString(s.ecKey.X.Bytes()),
+			Y:   base64.RawURLEncoding.EncodeToString(s.ecKey.Y.Bytes()),
+		},
+	}}
+	content, err := json.Marshal(keySet)
+	s.NoError(err)
+	file, err := ioutil.TempFile("", "jwks-*.json")
+	s.NoError(err)
+	_, err = file.Write(content)
+	s.NoError(err)
+	s.NoError(file.Close())
+	s.jwksFile = file.Name()
+
+	s.doneCh = make(chan struct{})
+	s.mapper, err = NewDefaultClaimMapper(&ClaimMapperConfig{
+		JWKSFile:     s.jwksFile,
+		PollInterval: minJWKSPollInterval,
+		Issuer:       "test-issuer",
+		Audience:     "temporal",
+		RolesClaim:   "permissions",
+	}, loggerimpl.NewNopLogger(), s.doneCh)
+	s.NoError(err)
+}
+
+func (s *claimMapperSuite) TearDownTest() {
+	close(s.doneCh)
+	s.NoError(os.Remove(s.jwksFile))
+}
+
+func (s *claimMapperSuite) TestBearerToken() {
+	for _, kid := range []string{"rsa-key", "ec-key"} {
+		token := s.sign(kid, s.validClaims())
+		claims, err := s.mapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+		s.NoError(err, kid)
+		s.Equal(&Claims{Subject: "alice", Roles: []string{"tenant-a:write", "tenant-b:read"}}, claims, kid)
+	}
+}
+
+func (s *claimMapperSuite) TestBearerToken_Invalid() {
+	expired := s.validClaims()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	wrongIssuer := s.validClaims()
+	wrongIssuer["iss"] = "other-issuer"
+	wrongAudience := s.validClaims()
+	wrongAudience["aud"] = []string{"other"}
+	noSubject := s.validClaims()
+	delete(noSubject, "sub")
+	noExpiry := s.validClaims()
+	delete(noExpiry, "exp")
+
+	tampered := s.sign("rsa-key", s.validClaims())
+	tampered = tampered[:len(tampered)-4] + "AAAA"
+
+	tokens := []string{
+		"Bearer " + s.sign("rsa-key", expired),
+		"Bearer " + s.sign("ec-key", wrongIssuer),
+		"Bearer " + s.sign("rsa-key", wrongAudience),
+		"Bearer " + s.sign("rsa-key", noSubject),
+		"Bearer " + s.sign("ec-key", noExpiry),
+		"Bearer " + s.sign("unknown-key", s.validClaims()),
+		"Bearer " + tampered,
+		"Bearer not-a-token",
+		"Basic dXNlcjpwYXNz",
+	}
+	for _, token := range tokens {
+		_, err := s.mapper.GetClaims(&AuthInfo{AuthToken: token})
+		s.Error(err, token)
+		s.Equal(codes.Unauthenticated, status.Code(err), token)
+	}
+}
+
+func (s *claimMapperSuite) TestBearerToken_WithoutExpiration() {
+	mapper, err := NewDefaultClaimMapper(&ClaimMapperConfig{
+		JWKSFile:                     s.jwksFile,
+		PollInterval:                 minJWKSPollInterval,
+		AllowTokensWithoutExpiration: true,
+	}, loggerimpl.NewNopLogger(), s.doneCh)
+	s.NoError(err)
+
+	claims := s.validClaims()
+	delete(claims, "exp")
+	result, err := mapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + s.sign("rsa-key", claims)})
+	s.NoError(err)
+	s.Equal("alice", result.Subject)
+}
+
+func (s *claimMapperSuite) TestBearerToken_NotAccepted() {
+	mapper, err := NewDefaultClaimMapper(&ClaimMapperConfig{}, loggerimpl.NewNopLogger(), s.doneCh)
+	s.NoError(err)
+	_, err = mapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + s.sign("rsa-key", s.validClaims())})
+	s.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func (s *claimMapperSuite) TestTLSCertificate() {
+	claims, err := s.mapper.GetClaims(&AuthInfo{
+		TLSCertificate: &x509.Certificate{
+			Subject: pkix.Name{CommonName: "worker.tenant-a", OrganizationalUnit: []string{"tenant-a"}},
+		},
+	})
+	s.NoError(err)
+	s.Equal(&Claims{Subject: "worker.tenant-a", Roles: []string{"tenant-a"}}, claims)
+
+	claims, err = s.mapper.GetClaims(&AuthInfo{})
+	s.NoError(err)
+	s.Nil(claims)
+}
+
+func (s *claimMapperSuite) TestInterceptor() {
+	interceptor := NewClaimMapperInterceptor(s.mapper, loggerimpl.NewNopLogger())
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+	var received *Claims
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		received = ClaimsFromContext(ctx)
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, "Bearer "+s.sign("ec-key", s.validClaims())))
+	_, err := interceptor(ctx, nil, info, handler)
+	s.NoError(err)
+	s.Equal("alice", received.Subject)
+
+	received = nil
+	_, err = interceptor(context.Background(), nil, info, handler)
+	s.NoError(err)
+	s.Nil(received)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, "Bearer invalid"))
+	_, err = interceptor(ctx, nil, info, handler)
+	s.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func (s *claimMapperSuite) validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub":         "alice",
+		"iss":         "test-issuer",
+		"aud":         []string{"temporal", "other"},
+		"exp":         time.Now().Add(time.Hour).Unix(),
+		"nbf":         time.Now().Add(-time.Hour).Unix(),
+		"permissions": []string{"tenant-a:write", "tenant-b:read"},
+	}
+}
+
+func (s *claimMapperSuite) sign(kid string, claims map[string]interface{}) string {
+	var token *jwt.Token
+	var key interface{}
+	if kid == "ec-key" {
+		token, key = jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims(claims)), s.ecKey
+	} else {
+		token, key = jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims(claims)), s.rsaKey
+	}
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	s.NoError(err)
+	return signed
+}
@@ -0,0 +1,295 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	minJWKSPollInterval = time.Second * 5
+	// jwtClockSkew is the tolerated difference between the clocks of the token issuer and this host
+	jwtClockSkew = time.Minute
+)
+
+type (
+	jwtVerifier struct {
+		keys            atomic.Value // map[string]crypto.PublicKey
+		lastUpdatedTime time.Time
+		config          *ClaimMapperConfig
+		rolesClaim      string
+		doneCh          chan struct{}
+		logger          log.Logger
+		timeSource      func() time.Time
+	}
+
+	jsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+
+	jsonWebKeySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+)
+
+var signingMethods = []string{
+	jwt.SigningMethodRS256.Alg(),
+	jwt.SigningMethodRS384.Alg(),
+	jwt.SigningMethodRS512.Alg(),
+	jwt.SigningMethodES256.Alg(),
+	jwt.SigningMethodES384.Alg(),
+	jwt.SigningMethodES512.Alg(),
+}
+
+func newJWTVerifier(
+	config *ClaimMapperConfig,
+	logger log.Logger,
+	doneCh chan struct{},
+) (*jwtVerifier, error) {
+	if config.PollInterval < minJWKSPollInterval {
+		return nil, fmt.Errorf("poll interval should be at least %v", minJWKSPollInterval)
+	}
+	rolesClaim := config.RolesClaim
+	if rolesClaim == "" {
+		rolesClaim = defaultRolesClaim
+	}
+
+	verifier := &jwtVerifier{
+		config:     config,
+		rolesClaim: rolesClaim,
+		doneCh:     doneCh,
+		logger:     logger,
+		timeSource: time.Now,
+	}
+	if err := verifier.update(); err != nil {
+		return nil, err
+	}
+	go func() {
+		ticker := time.NewTicker(verifier.config.PollInterval)
+		for {
+			select {
+			case <-ticker.C:
+				if err := verifier.update(); err != nil {
+					verifier.logger.Error("Failed to update JSON web key set", tag.Error(err))
+				}
+			case <-verifier.doneCh:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return verifier, nil
+}
+
+// verify validates the signature and the registered claims of the token and returns its claims
+func (v *jwtVerifier) verify(token string) (*Claims, error) {
+	parser := jwt.NewParser(jwt.WithValidMethods(signingMethods), jwt.WithoutClaimsValidation())
+	claims := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(token, claims, v.keyFunc); err != nil {
+		return nil, errInvalidToken("%v", err)
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return nil, err
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errInvalidToken("missing sub claim")
+	}
+	return &Claims{
+		Subject: subject,
+		Roles:   toStrings(claims[v.rolesClaim]),
+	}, nil
+}
+
+// validateClaims validates the registered claims, the library validation is skipped
+// since it neither tolerates clock skew nor requires the exp claim
+func (v *jwtVerifier) validateClaims(claims jwt.MapClaims) error {
+	now := v.timeSource()
+	if _, ok := claims["exp"]; !ok && !v.config.AllowTokensWithoutExpiration {
+		return errInvalidToken("missing exp claim")
+	}
+	if !claims.VerifyExpiresAt(now.Add(-jwtClockSkew).Unix(), false) {
+		return errInvalidToken("token is expired")
+	}
+	if !claims.VerifyNotBefore(now.Add(jwtClockSkew).Unix(), false) {
+		return errInvalidToken("token is not valid yet")
+	}
+	if v.config.Issuer != "" && !claims.VerifyIssuer(v.config.Issuer, true) {
+		issuer, _ := claims["iss"].(string)
+		return errInvalidToken("unexpected issuer %q", issuer)
+	}
+	if v.config.Audience != "" && !claims.VerifyAudience(v.config.Audience, true) {
+		return errInvalidToken("token is not issued for audience %q", v.config.Audience)
+	}
+	return nil
+}
+
+func (v *jwtVerifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	return v.getKey(kid)
+}
+
+func (v *jwtVerifier) getKey(kid string) (crypto.PublicKey, error) {
+	keys := v.keys.Load().(map[string]crypto.PublicKey)
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+	key, ok := keys[kid]
+	if !ok {
+		return nil, errInvalidToken("unknown key id %q", kid)
+	}
+	return key, nil
+}
+
+func (v *jwtVerifier) update() error {
+	info, err := os.Stat(v.config.JWKSFile)
+	if err != nil {
+		return fmt.Errorf("failed to get status of JSON web key set file: %v", err)
+	}
+	if !info.ModTime().After(v.lastUpdatedTime) {
+		return nil
+	}
+	v.lastUpdatedTime = info.ModTime()
+
+	content, err := ioutil.ReadFile(v.config.JWKSFile)
+	if err != nil {
+		return fmt.Errorf("failed to read JSON web key set file %v: %v", v.config.JWKSFile, err)
+	}
+	var keySet jsonWebKeySet
+	if err := json.Unmarshal(content, &keySet); err != nil {
+		return fmt.Errorf("failed to decode JSON web key set: %v", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		key, err := jwk.publicKey()
+		if err != nil {
+			return fmt.Errorf("invalid key %q: %v", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	v.keys.Store(keys)
+	v.logger.Info("Updated JSON web key set", tag.Counter(len(keys)))
+	return nil
+}
+
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve %q", k.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// toStrings converts a claim which is either a list of strings or a space separated string
+func toStrings(claim interface{}) []string {
+	switch value := claim.(type) {
+	case string:
+		return strings.Fields(value)
+	case []interface{}:
+		result := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+func errInvalidToken(format string, args ...interface{}) error {
+	return status.Errorf(codes.Unauthenticated, "invalid bearer token: "+format, args...)
+}
@@ -0,0 +1,284 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	minPolicyPollInterval = time.Second * 5
+
+	policyDecisionAllow = "allow"
+	policyDecisionDeny  = "deny"
+)
+
+type (
+	// PolicyAuthorizerConfig is the config for the policy authorizer.
+	// It specifies where the policy file is stored and how often the file
+	// should be checked for changes.
+	PolicyAuthorizerConfig struct {
+		PolicyFile   string        `yaml:"policyFile"`
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	// Policy is the content of a policy file. Rules are evaluated in order and
+	// the decision of the first matching rule is returned. If no rule matches,
+	// DefaultDecision is returned, which defaults to deny.
+	//
+	// Example:
+	//  defaultDecision: deny
+	//  rules:
+	//    - namespaces: ["tenant-a"]
+	//      apiGroups: ["read"]
+	//      decision: allow
+	//    - namespaces: ["tenant-a"]
+	//      apiGroups: ["write"]
+	//      taskQueues: ["tenant-a-*"]
+	//      decision: allow
+	Policy struct {
+		DefaultDecision string       `yaml:"defaultDecision"`
+		Rules           []PolicyRule `yaml:"rules"`
+	}
+
+	// PolicyRule matches requests by their attributes. Every field is a list of
+	// patterns in path.Match syntax, a request matches a field if it matches any
+	// of its patterns and an empty field matches every request. A request which
+	// does not carry the attribute of a non-empty field, e.g. DescribeNamespace for
+	// a rule with task queues, does not match the rule. The task token APIs carry
+	// the task queue and workflow type of the task the token was issued for.
+	PolicyRule struct {
+		Decision      string   `yaml:"decision"`
+		Actors        []string `yaml:"actors"`
+		Roles         []string `yaml:"roles"`
+		Namespaces    []string `yaml:"namespaces"`
+		APIGroups     []string `yaml:"apiGroups"`
+		APINames      []string `yaml:"apiNames"`
+		TaskQueues    []string `yaml:"taskQueues"`
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		WorkflowIDs   []string `yaml:"workflowIds"`
+	}
+
+	policyAuthorizer struct {
+		policy          atomic.Value // *Policy
+		lastUpdatedTime time.Time
+		config          *PolicyAuthorizerConfig
+		doneCh          chan struct{}
+		logger          log.Logger
+	}
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+// NewPolicyAuthorizer creates an authorizer which evaluates the policy stored in
+// the configured file. The file is reloaded whenever it changes, an invalid file
+// is logged and the previously loaded policy is kept.
+func NewPolicyAuthorizer(
+	config *PolicyAuthorizerConfig,
+	logger log.Logger,
+	doneCh chan struct{},
+) (Authorizer, error) {
+	if err := validatePolicyAuthorizerConfig(config); err != nil {
+		return nil, err
+	}
+
+	authorizer := &policyAuthorizer{
+		config: config,
+		doneCh: doneCh,
+		logger: logger,
+	}
+	if err := authorizer.update(); err != nil {
+		return nil, err
+	}
+	go func() {
+		ticker := time.NewTicker(authorizer.config.PollInterval)
+		for {
+			select {
+			case <-ticker.C:
+				if err := authorizer.update(); err != nil {
+					authorizer.logger.Error("Failed to update authorization policy", tag.Error(err))
+				}
+			case <-authorizer.doneCh:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return authorizer, nil
+}
+
+func (a *policyAuthorizer) Authorize(
+	ctx context.Context,
+	attributes *Attributes,
+) (Result, error) {
+	policy := a.policy.Load().(*Policy)
+	apiGroup := attributes.APIGroup
+	if apiGroup == "" {
+		apiGroup = GetAPIGroup(attributes.APIName)
+	}
+
+	for _, rule := range policy.Rules {
+		if rule.matches(attributes, apiGroup) {
+			return Result{Decision: toDecision(rule.Decision)}, nil
+		}
+	}
+	return Result{Decision: toDecision(policy.DefaultDecision)}, nil
+}
+
+func (a *policyAuthorizer) update() error {
+	info, err := os.Stat(a.config.PolicyFile)
+	if err != nil {
+		return fmt.Errorf("failed to get status of authorization policy file: %v", err)
+	}
+	if !info.ModTime().After(a.lastUpdatedTime) {
+		return nil
+	}
+	a.lastUpdatedTime = info.ModTime()
+
+	content, err := ioutil.ReadFile(a.config.PolicyFile)
+	if err != nil {
+		return fmt.Errorf("failed to read authorization policy file %v: %v", a.config.PolicyFile, err)
+	}
+	policy := &Policy{}
+	if err := yaml.Unmarshal(content, policy); err != nil {
+		return fmt.Errorf("failed to decode authorization policy: %v", err)
+	}
+	if err := policy.validate(); err != nil {
+		return err
+	}
+
+	a.policy.Store(policy)
+	a.logger.Info("Updated authorization policy", tag.Counter(len(policy.Rules)))
+	return nil
+}
+
+func (p *Policy) validate() error {
+	if err := validateDecision(p.DefaultDecision, true); err != nil {
+		return err
+	}
+	for i, rule := range p.Rules {
+		if err := validateDecision(rule.Decision, false); err != nil {
+			return fmt.Errorf("rule %v: %v", i, err)
+		}
+		for _, patterns := range [][]string{
+			rule.Actors,
+			rule.Roles,
+			rule.Namespaces,
+			rule.APIGroups,
+			rule.APINames,
+			rule.TaskQueues,
+			rule.WorkflowTypes,
+			rule.WorkflowIDs,
+		} {
+			for _, pattern := range patterns {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return fmt.Errorf("rule %v: invalid pattern %q: %v", i, pattern, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *PolicyRule) matches(attributes *Attributes, apiGroup APIGroup) bool {
+	return matchAny(r.Actors, attributes.Actor) &&
+		matchAnyOf(r.Roles, attributes.Roles) &&
+		matchAny(r.Namespaces, attributes.Namespace) &&
+		matchAny(r.APIGroups, string(apiGroup)) &&
+		matchAny(r.APINames, attributes.APIName) &&
+		matchAny(r.TaskQueues, attributes.TaskQueue) &&
+		matchAny(r.WorkflowTypes, attributes.WorkflowType) &&
+		matchAny(r.WorkflowIDs, attributes.WorkflowID)
+}
+
+func matchAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	if value == "" {
+		return false
+	}
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAnyOf(patterns []string, values []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, value := range values {
+		if matchAny(patterns, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateDecision(decision string, allowEmpty bool) error {
+	switch decision {
+	case policyDecisionAllow, policyDecisionDeny:
+		return nil
+	case "":
+		if allowEmpty {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid decision %q, must be %q or %q", decision, policyDecisionAllow, policyDecisionDeny)
+}
+
+func toDecision(decision string) Decision {
+	if decision == policyDecisionAllow {
+		return DecisionAllow
+	}
+	return DecisionDeny
+}
+
+func validatePolicyAuthorizerConfig(config *PolicyAuthorizerConfig) error {
+	if config == nil {
+		return fmt.Errorf("configuration for policy authorizer is nil")
+	}
+	if config.PolicyFile == "" {
+		return fmt.Errorf("empty authorization policy file path")
+	}
+	if config.PollInterval < minPolicyPollInterval {
+		return fmt.Errorf("poll interval should be at least %v", minPolicyPollInterval)
+	}
+	return nil
+}
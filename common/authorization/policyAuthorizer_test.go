@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+)
+
+const testPolicy = `
+defaultDecision: deny
+rules:
+  - namespaces: ["tenant-a"]
+    apiGroups: ["write"]
+    taskQueues: ["tenant-b-*"]
+    decision: deny
+  - namespaces: ["tenant-a"]
+    apiGroups: ["read", "write"]
+    decision: allow
+  - namespaces: ["tenant-b"]
+    workflowTypes: ["report-*"]
+    decision: allow
+  - roles: ["admin"]
+    decision: allow
+`
+
+type (
+	policyAuthorizerSuite struct {
+		suite.Suite
+		policyFile string
+		doneCh     chan struct{}
+		authorizer *policyAuthorizer
+	}
+)
+
+func TestPolicyAuthorizerSuite(t *testing.T) {
+	suite.Run(t, new(policyAuthorizerSuite))
+}
+
+func (s *policyAuthorizerSuite) SetupTest() {
+	file, err := ioutil.TempFile("", "policy-*.yaml")
+	s.NoError(err)
+	s.NoError(file.Close())
+	s.policyFile = file.Name()
+	s.writePolicy(testPolicy, time.Now())
+
+	s.doneCh = make(chan struct{})
+	authorizer, err := NewPolicyAuthorizer(&PolicyAuthorizerConfig{
+		PolicyFile:   s.policyFile,
+		PollInterval: minPolicyPollInterval,
+	}, loggerimpl.NewNopLogger(), s.doneCh)
+	s.NoError(err)
+	s.authorizer = authorizer.(*policyAuthorizer)
+}
+
+func (s *policyAuthorizerSuite) TearDownTest() {
+	close(s.doneCh)
+	s.NoError(os.Remove(s.policyFile))
+}
+
+func (s *policyAuthorizerSuite) TestAuthorize() {
+	testCases := []struct {
+		attributes *Attributes
+		decision   Decision
+	}{
+		{&Attributes{APIName: "DescribeNamespace", Namespace: "tenant-a"}, DecisionAllow},
+		{&Attributes{APIName: "StartWorkflowExecution", Namespace: "tenant-a", TaskQueue: "tenant-a-tq"}, DecisionAllow},
+		{&Attributes{APIName: "PollForDecisionTask", Namespace: "tenant-a", TaskQueue: "tenant-b-tq"}, DecisionDeny},
+		// the task token APIs carry the task queue of the token
+		{&Attributes{APIName: "RespondDecisionTaskCompleted", Namespace: "tenant-a", TaskQueue: "tenant-b-tq"}, DecisionDeny},
+		{&Attributes{APIName: "RespondDecisionTaskCompleted", Namespace: "tenant-a", TaskQueue: "tenant-a-tq"}, DecisionAllow},
+		// the rule scoped to task queues does not apply to the requests without a task queue
+		{&Attributes{APIName: "SignalWorkflowExecution", Namespace: "tenant-a"}, DecisionAllow},
+		{&Attributes{APIName: "UpdateNamespace", Namespace: "tenant-a"}, DecisionDeny},
+		{&Attributes{APIName: "StartWorkflowExecution", Namespace: "tenant-b", WorkflowType: "report-daily"}, DecisionAllow},
+		{&Attributes{APIName: "StartWorkflowExecution", Namespace: "tenant-b", WorkflowType: "billing"}, DecisionDeny},
+		{&Attributes{APIName: "DescribeNamespace", Namespace: "tenant-b"}, DecisionDeny},
+		{&Attributes{APIName: "DescribeNamespace", Namespace: "tenant-c"}, DecisionDeny},
+		{&Attributes{APIName: "UpdateNamespace", Namespace: "tenant-c", Actor: "bob", Roles: []string{"dev", "admin"}}, DecisionAllow},
+	}
+	for _, tc := range testCases {
+		result, err := s.authorizer.Authorize(context.Background(), tc.attributes)
+		s.NoError(err)
+		s.Equal(tc.decision, result.Decision, "%+v", tc.attributes)
+	}
+}
+
+func (s *policyAuthorizerSuite) TestReload() {
+	attributes := &Attributes{APIName: "UpdateNamespace", Namespace: "tenant-c"}
+	result, err := s.authorizer.Authorize(context.Background(), attributes)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	s.writePolicy("defaultDecision: allow\n", time.Now().Add(time.Minute))
+	s.NoError(s.authorizer.update())
+	result, err = s.authorizer.Authorize(context.Background(), attributes)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	// an invalid policy is rejected and the previous policy is kept
+	s.writePolicy("rules:\n  - decision: maybe\n", time.Now().Add(2*time.Minute))
+	s.Error(s.authorizer.update())
+	result, err = s.authorizer.Authorize(context.Background(), attributes)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestInvalidConfig() {
+	_, err := NewPolicyAuthorizer(&PolicyAuthorizerConfig{
+		PolicyFile:   s.policyFile,
+		PollInterval: time.Second,
+	}, loggerimpl.NewNopLogger(), s.doneCh)
+	s.Error(err)
+
+	s.writePolicy("rules:\n  - namespaces: [\"[\"]\n    decision: allow\n", time.Now().Add(time.Minute))
+	_, err = NewPolicyAuthorizer(&PolicyAuthorizerConfig{
+		PolicyFile:   s.policyFile,
+		PollInterval: minPolicyPollInterval,
+	}, loggerimpl.NewNopLogger(), s.doneCh)
+	s.Error(err)
+}
+
+func (s *policyAuthorizerSuite) writePolicy(policy string, modTime time.Time) {
+	s.NoError(ioutil.WriteFile(s.policyFile, []byte(policy), 0644))
+	s.NoError(os.Chtimes(s.policyFile, modTime, modTime))
+}
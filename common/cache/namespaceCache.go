@@ -100,10 +100,13 @@ type (
 		GetNamespaceName(id string) (string, error)
 		GetAllNamespace() map[string]*NamespaceCacheEntry
 		GetCacheSize() (sizeOfCacheByName int64, sizeOfCacheByID int64)
+		// IsLoaded returns true once the namespaces have been loaded from persistence
+		IsLoaded() bool
 	}
 
 	namespaceCache struct {
 		status          int32
+		loaded          int32
 		shutdownChan    chan struct{}
 		cacheNameToID   *atomic.Value
 		cacheByID       *atomic.Value
@@ -271,6 +274,11 @@ func (c *namespaceCache) Stop() {
 	close(c.shutdownChan)
 }
 
+// IsLoaded returns true once the initial scan of namespaces has completed
+func (c *namespaceCache) IsLoaded() bool {
+	return atomic.LoadInt32(&c.loaded) == 1
+}
+
 func (c *namespaceCache) GetAllNamespace() map[string]*NamespaceCacheEntry {
 	result := make(map[string]*NamespaceCacheEntry)
 	ite := c.cacheByID.Load().(Cache).Iterator()
@@ -491,6 +499,7 @@ UpdateLoop:
 	c.cacheByID.Store(newCacheByID)
 	c.cacheNameToID.Store(newCacheNameToID)
 	c.triggerNamespaceChangeCallbackLocked(prevEntries, nextEntries)
+	atomic.StoreInt32(&c.loaded, 1)
 	return nil
 }
 
@@ -193,3 +193,17 @@ func (mr *MockNamespaceCacheMockRecorder) GetCacheSize() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCacheSize", reflect.TypeOf((*MockNamespaceCache)(nil).GetCacheSize))
 }
+
+// IsLoaded mocks base method.
+func (m *MockNamespaceCache) IsLoaded() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLoaded")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsLoaded indicates an expected call of IsLoaded.
+func (mr *MockNamespaceCacheMockRecorder) IsLoaded() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLoaded", reflect.TypeOf((*MockNamespaceCache)(nil).IsLoaded))
+}
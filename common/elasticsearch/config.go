@@ -30,11 +30,26 @@ import (
 	"go.temporal.io/server/common"
 )
 
+const (
+	// VisibilityQueueKafka means visibility messages are delivered to the indexer through kafka.
+	VisibilityQueueKafka = "kafka"
+	// VisibilityQueuePersistence means visibility messages are delivered to the indexer through a queue
+	// in the persistence store, so no kafka cluster is needed for advanced visibility.
+	VisibilityQueuePersistence = "persistence"
+
+	// DefaultVisibilityQueuePartitions is the default number of partitions of the persistence visibility queue
+	DefaultVisibilityQueuePartitions = 8
+)
+
 // Config for connecting to ElasticSearch
 type (
 	Config struct {
 		URL     url.URL           `yaml:url`     //nolint:govet
 		Indices map[string]string `yaml:indices` //nolint:govet
+		// VisibilityQueue is the queue between the history and the worker services, kafka by default
+		VisibilityQueue string `yaml:"visibilityQueue"`
+		// VisibilityQueuePartitions is the number of partitions of the persistence visibility queue
+		VisibilityQueuePartitions int `yaml:"visibilityQueuePartitions"`
 	}
 )
 
@@ -42,3 +57,16 @@ type (
 func (cfg *Config) GetVisibilityIndex() string {
 	return cfg.Indices[common.VisibilityAppName]
 }
+
+// UsePersistenceVisibilityQueue returns true if visibility messages go through the queue of the persistence store
+func (cfg *Config) UsePersistenceVisibilityQueue() bool {
+	return cfg.VisibilityQueue == VisibilityQueuePersistence
+}
+
+// GetVisibilityQueuePartitions returns the number of partitions of the persistence visibility queue
+func (cfg *Config) GetVisibilityQueuePartitions() int {
+	if cfg.VisibilityQueuePartitions <= 0 {
+		return DefaultVisibilityQueuePartitions
+	}
+	return cfg.VisibilityQueuePartitions
+}
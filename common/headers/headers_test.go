@@ -26,7 +26,9 @@ package headers
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -127,3 +129,25 @@ func (s *HeadersSuite) TestPropagateHeaders_EmptyIncomingContext() {
 	s.Equal("21.04.16", md.Get(ClientFeatureVersionHeaderName)[0])
 	s.Equal("28.08.14", md.Get(ClientImplHeaderName)[0])
 }
+
+func (s *HeadersSuite) TestTaskQueueStats() {
+	now := time.Now().UTC()
+	stats := &TaskQueueStats{
+		ApproximateBacklogCount: 15,
+		OldestTaskCreateTime:    now.Add(-time.Minute),
+		AddRate:                 2,
+		DispatchRate:            2,
+		SyncMatchRate:           0.5,
+		PollerBuildIDs:          map[string]string{"worker": "1.0"},
+	}
+
+	value, err := json.Marshal(stats)
+	s.NoError(err)
+	parsed, err := GetTaskQueueStats(metadata.Pairs(TaskQueueStatsHeaderName, string(value)))
+	s.NoError(err)
+	s.Equal(stats, parsed)
+
+	parsed, err = GetTaskQueueStats(metadata.MD{})
+	s.NoError(err)
+	s.Nil(parsed)
+}
@@ -0,0 +1,80 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package headers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// TaskQueueStatsHeaderName refers to the name of the gRPC response header that contains the backlog statistics
+	// of a task queue. It is sent by DescribeTaskQueue when the task queue status is requested.
+	TaskQueueStatsHeaderName = "temporal-task-queue-stats"
+)
+
+type (
+	// TaskQueueStats are the backlog statistics of a task queue partition, or of all the partitions of a task queue
+	TaskQueueStats struct {
+		// ApproximateBacklogCount is the approximate number of tasks waiting for a poller
+		ApproximateBacklogCount int64 `json:"approximateBacklogCount"`
+		// OldestTaskCreateTime is the creation time of the oldest task of the backlog, zero if the backlog is empty
+		OldestTaskCreateTime time.Time `json:"oldestTaskCreateTime"`
+		// AddRate, DispatchRate and SyncMatchRate are the recent rates per second of the tasks added to the
+		// task queue, dispatched to pollers and matched with a poller without being persisted
+		AddRate       float64 `json:"addRate"`
+		DispatchRate  float64 `json:"dispatchRate"`
+		SyncMatchRate float64 `json:"syncMatchRate"`
+		// PollerBuildIDs are the build IDs declared by the pollers, by poller identity
+		PollerBuildIDs map[string]string `json:"pollerBuildIds,omitempty"`
+	}
+)
+
+// SetTaskQueueStats sends the task queue statistics in the response header of the gRPC call of the context
+func SetTaskQueueStats(ctx context.Context, stats *TaskQueueStats) error {
+	value, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(TaskQueueStatsHeaderName, string(value)))
+}
+
+// GetTaskQueueStats returns the task queue statistics of a received response header,
+// nil if the header doesn't have them
+func GetTaskQueueStats(md metadata.MD) (*TaskQueueStats, error) {
+	value := getSingleHeaderValue(md, TaskQueueStatsHeaderName)
+	if value == "" {
+		return nil, nil
+	}
+	stats := &TaskQueueStats{}
+	if err := json.Unmarshal([]byte(value), stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
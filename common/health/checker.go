@@ -0,0 +1,254 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	// Check returns an error when a dependency of the service is not ready
+	Check func() error
+
+	// Checker answers gRPC health checks with the readiness of the service dependencies,
+	// and pushes status changes to the watchers of the health stream
+	Checker struct {
+		status      int32
+		serviceName string
+		interval    dynamicconfig.DurationPropertyFn
+		logger      log.Logger
+		shutdownCh  chan struct{}
+		shutdownWG  sync.WaitGroup
+
+		checksLock sync.Mutex
+		checkNames []string
+		checks     []Check
+
+		sync.Mutex
+		servingStatus healthpb.HealthCheckResponse_ServingStatus
+		watchers      map[int64]chan healthpb.HealthCheckResponse_ServingStatus
+		nextWatcherID int64
+	}
+)
+
+var _ healthpb.HealthServer = (*Checker)(nil)
+
+// NewChecker creates a health checker which reports NOT_SERVING until it is started
+// and all of its checks pass
+func NewChecker(
+	serviceName string,
+	interval dynamicconfig.DurationPropertyFn,
+	logger log.Logger,
+) *Checker {
+	return &Checker{
+		status:        common.DaemonStatusInitialized,
+		serviceName:   serviceName,
+		interval:      interval,
+		logger:        logger,
+		shutdownCh:    make(chan struct{}),
+		servingStatus: healthpb.HealthCheckResponse_NOT_SERVING,
+		watchers:      make(map[int64]chan healthpb.HealthCheckResponse_ServingStatus),
+	}
+}
+
+// AddCheck registers a readiness check, checks run in the order they were added
+// and the first failing one makes the service NOT_SERVING
+func (c *Checker) AddCheck(name string, check Check) {
+	c.checksLock.Lock()
+	defer c.checksLock.Unlock()
+
+	c.checkNames = append(c.checkNames, name)
+	c.checks = append(c.checks, check)
+}
+
+// Start runs the checks and keeps re-running them periodically
+func (c *Checker) Start() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	c.Refresh()
+	c.shutdownWG.Add(1)
+	go c.checkLoop()
+}
+
+// Stop reports NOT_SERVING and closes the health streams
+func (c *Checker) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	// wait for the checks in flight so that they cannot flip the status back to SERVING
+	c.checksLock.Lock()
+	c.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	c.checksLock.Unlock()
+
+	close(c.shutdownCh)
+	if success := common.AwaitWaitGroup(&c.shutdownWG, time.Minute); !success {
+		c.logger.Warn("health checker timed out on shutdown.", tag.Service(c.serviceName))
+	}
+}
+
+// Refresh runs the checks right away, it is used to propagate a status change without waiting
+// for the next periodic check, e.g. when the service starts draining
+func (c *Checker) Refresh() {
+	c.checksLock.Lock()
+	defer c.checksLock.Unlock()
+
+	if atomic.LoadInt32(&c.status) != common.DaemonStatusStarted {
+		return
+	}
+
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+	for i, check := range c.checks {
+		if err := check(); err != nil {
+			if c.ServingStatus() == healthpb.HealthCheckResponse_SERVING {
+				c.logger.Warn("Health check failed",
+					tag.Service(c.serviceName),
+					tag.Name(c.checkNames[i]),
+					tag.Error(err))
+			}
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+			break
+		}
+	}
+	c.setServingStatus(servingStatus)
+}
+
+// ServingStatus returns the status computed by the last run of the checks
+func (c *Checker) ServingStatus() healthpb.HealthCheckResponse_ServingStatus {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.servingStatus
+}
+
+// https://github.com/grpc/grpc/blob/master/doc/health-checking.md
+func (c *Checker) Check(context.Context, *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	return &healthpb.HealthCheckResponse{
+		Status: c.ServingStatus(),
+	}, nil
+}
+
+// Watch sends the current status, then every status change until the client goes away or the checker is stopped
+func (c *Checker) Watch(_ *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	watcherID, updateCh := c.addWatcher()
+	defer c.removeWatcher(watcherID)
+
+	for {
+		select {
+		case servingStatus := <-updateCh:
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: servingStatus}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return serviceerror.NewCanceled("Health stream closed by the client.")
+		case <-c.shutdownCh:
+			// let the watcher know about the final NOT_SERVING status before closing the stream
+			select {
+			case servingStatus := <-updateCh:
+				return stream.Send(&healthpb.HealthCheckResponse{Status: servingStatus})
+			default:
+				return nil
+			}
+		}
+	}
+}
+
+func (c *Checker) checkLoop() {
+	defer c.shutdownWG.Done()
+
+	timer := time.NewTimer(c.interval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-timer.C:
+			c.Refresh()
+			timer.Reset(c.interval())
+		}
+	}
+}
+
+func (c *Checker) setServingStatus(servingStatus healthpb.HealthCheckResponse_ServingStatus) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.servingStatus == servingStatus {
+		return
+	}
+	c.logger.Info("Health status changed",
+		tag.Service(c.serviceName),
+		tag.Value(servingStatus.String()))
+	c.servingStatus = servingStatus
+	for _, updateCh := range c.watchers {
+		pushServingStatus(updateCh, servingStatus)
+	}
+}
+
+func (c *Checker) addWatcher() (int64, <-chan healthpb.HealthCheckResponse_ServingStatus) {
+	c.Lock()
+	defer c.Unlock()
+
+	watcherID := c.nextWatcherID
+	c.nextWatcherID++
+	updateCh := make(chan healthpb.HealthCheckResponse_ServingStatus, 1)
+	updateCh <- c.servingStatus
+	c.watchers[watcherID] = updateCh
+	return watcherID, updateCh
+}
+
+func (c *Checker) removeWatcher(watcherID int64) {
+	c.Lock()
+	defer c.Unlock()
+
+	delete(c.watchers, watcherID)
+}
+
+// pushServingStatus replaces a status the watcher has not consumed yet, so that slow watchers
+// only ever receive the latest status and never block the checker
+func pushServingStatus(
+	updateCh chan healthpb.HealthCheckResponse_ServingStatus,
+	servingStatus healthpb.HealthCheckResponse_ServingStatus,
+) {
+	select {
+	case <-updateCh:
+	default:
+	}
+	updateCh <- servingStatus
+}
@@ -0,0 +1,181 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	checkerSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		ready   int32
+		checker *Checker
+	}
+
+	testWatchServer struct {
+		grpc.ServerStream
+		ctx        context.Context
+		responseCh chan *healthpb.HealthCheckResponse
+	}
+)
+
+func TestCheckerSuite(t *testing.T) {
+	suite.Run(t, new(checkerSuite))
+}
+
+func (s *checkerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	atomic.StoreInt32(&s.ready, 1)
+	s.checker = NewChecker("test", dynamicconfig.GetDurationPropertyFn(time.Hour), loggerimpl.NewDevelopmentForTest(s.Suite))
+	s.checker.AddCheck("ready", func() error {
+		if atomic.LoadInt32(&s.ready) == 0 {
+			return errors.New("not ready")
+		}
+		return nil
+	})
+}
+
+func (s *checkerSuite) TearDownTest() {
+	s.checker.Stop()
+}
+
+func (s *checkerSuite) TestCheck() {
+	s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.check())
+
+	s.checker.Start()
+	s.Equal(healthpb.HealthCheckResponse_SERVING, s.check())
+
+	atomic.StoreInt32(&s.ready, 0)
+	s.Equal(healthpb.HealthCheckResponse_SERVING, s.check())
+	s.checker.Refresh()
+	s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.check())
+
+	atomic.StoreInt32(&s.ready, 1)
+	s.checker.Refresh()
+	s.Equal(healthpb.HealthCheckResponse_SERVING, s.check())
+
+	s.checker.Stop()
+	s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.check())
+	s.checker.Refresh()
+	s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.check())
+}
+
+func (s *checkerSuite) TestFirstFailingCheck() {
+	var lastCheckRun int32
+	s.checker.AddCheck("failing", func() error { return errors.New("failed") })
+	s.checker.AddCheck("skipped", func() error {
+		atomic.StoreInt32(&lastCheckRun, 1)
+		return nil
+	})
+
+	s.checker.Start()
+	s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.check())
+	s.Equal(int32(0), atomic.LoadInt32(&lastCheckRun))
+}
+
+func (s *checkerSuite) TestWatch() {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testWatchServer{
+		ctx:        ctx,
+		responseCh: make(chan *healthpb.HealthCheckResponse, 10),
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.checker.Watch(&healthpb.HealthCheckRequest{}, stream)
+	}()
+	s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.receive(stream))
+
+	s.checker.Start()
+	s.Equal(healthpb.HealthCheckResponse_SERVING, s.receive(stream))
+
+	atomic.StoreInt32(&s.ready, 0)
+	s.checker.Refresh()
+	s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.receive(stream))
+
+	// unchanged status is not pushed again
+	s.checker.Refresh()
+	s.Empty(stream.responseCh)
+
+	cancel()
+	s.Error(<-errCh)
+}
+
+func (s *checkerSuite) TestWatchEndsOnStop() {
+	stream := &testWatchServer{
+		ctx:        context.Background(),
+		responseCh: make(chan *healthpb.HealthCheckResponse, 10),
+	}
+	s.checker.Start()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.checker.Watch(&healthpb.HealthCheckRequest{}, stream)
+	}()
+	s.Equal(healthpb.HealthCheckResponse_SERVING, s.receive(stream))
+
+	s.checker.Stop()
+	s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.receive(stream))
+	s.NoError(<-errCh)
+}
+
+func (s *checkerSuite) check() healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := s.checker.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	s.NoError(err)
+	return resp.Status
+}
+
+func (s *checkerSuite) receive(stream *testWatchServer) healthpb.HealthCheckResponse_ServingStatus {
+	select {
+	case resp := <-stream.responseCh:
+		return resp.Status
+	case <-time.After(time.Second):
+		s.FailNow("health status was not pushed")
+		return healthpb.HealthCheckResponse_UNKNOWN
+	}
+}
+
+func (w *testWatchServer) Context() context.Context {
+	return w.ctx
+}
+
+func (w *testWatchServer) Send(resp *healthpb.HealthCheckResponse) error {
+	w.responseCh <- resp
+	return nil
+}
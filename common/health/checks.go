@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package health
+
+import (
+	"errors"
+	"fmt"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// CheckShutdown is the name of the check for the draining of the service
+	CheckShutdown = "shutdown"
+	// CheckPersistence is the name of the check for persistence reachability
+	CheckPersistence = "persistence"
+	// CheckMembership is the name of the check for the membership of the host
+	CheckMembership = "membership"
+	// CheckNamespaceCache is the name of the check for the namespace cache
+	CheckNamespaceCache = "namespaceCache"
+)
+
+var (
+	// ErrShuttingDown is returned by the checks of services which are draining
+	ErrShuttingDown = errors.New("service is shutting down")
+
+	errNamespaceCacheNotLoaded = errors.New("namespace cache is not loaded")
+)
+
+// NewPersistenceCheck returns a check which reads the cluster metadata record to make sure
+// the persistence store is reachable
+func NewPersistenceCheck(metadataManager persistence.MetadataManager) Check {
+	return func() error {
+		_, err := metadataManager.GetMetadata()
+		return err
+	}
+}
+
+// NewMembershipCheck returns a check which makes sure the host has joined the membership ring of its service,
+// it fails once the host has evicted itself
+func NewMembershipCheck(monitor membership.Monitor, serviceName string) Check {
+	return func() error {
+		self, err := monitor.WhoAmI()
+		if err != nil {
+			return err
+		}
+		resolver, err := monitor.GetResolver(serviceName)
+		if err != nil {
+			return err
+		}
+		for _, member := range resolver.Members() {
+			if member.Identity() == self.Identity() {
+				return nil
+			}
+		}
+		return fmt.Errorf("host %v is not a member of the %v ring", self.Identity(), serviceName)
+	}
+}
+
+// NewNamespaceCacheCheck returns a check which makes sure the namespaces have been loaded
+func NewNamespaceCacheCheck(namespaceCache cache.NamespaceCache) Check {
+	return func() error {
+		if !namespaceCache.IsLoaded() {
+			return errNamespaceCacheNotLoaded
+		}
+		return nil
+	}
+}
+
+// NewShutdownCheck returns a check which fails once the service starts draining
+func NewShutdownCheck(isShuttingDown func() bool) Check {
+	return func() error {
+		if isShuttingDown() {
+			return ErrShuttingDown
+		}
+		return nil
+	}
+}
+
+// AddServiceChecks registers the checks shared by all the services, after the shutdown check
+// so that draining is reported without waiting on the dependencies
+func AddServiceChecks(
+	checker *Checker,
+	serviceName string,
+	isShuttingDown func() bool,
+	monitor membership.Monitor,
+	namespaceCache cache.NamespaceCache,
+	metadataManager persistence.MetadataManager,
+) {
+	checker.AddCheck(CheckShutdown, NewShutdownCheck(isShuttingDown))
+	checker.AddCheck(CheckMembership, NewMembershipCheck(monitor, serviceName))
+	checker.AddCheck(CheckNamespaceCache, NewNamespaceCacheCheck(namespaceCache))
+	checker.AddCheck(CheckPersistence, NewPersistenceCheck(metadataManager))
+}
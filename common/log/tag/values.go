@@ -123,6 +123,9 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentScheduler                = component("scheduler")
+	ComponentNamespaceDeleter         = component("namespace-deleter")
+	ComponentArchivalBackfiller       = component("archival-backfiller")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
 	ComponentMetadataInitializer      = component("metadata-initializer")
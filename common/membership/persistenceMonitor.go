@@ -0,0 +1,381 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	defaultHeartbeatInterval = 5 * time.Second
+	// members missing this many heartbeats in a row are considered gone
+	missedHeartbeatsCutoff = 3
+	// records of evicted members expire right away, cassandra does not support a shorter TTL
+	evictedMembershipRecordExpiry = time.Second
+
+	getClusterMembersPageSize = 1000
+)
+
+type persistenceMonitor struct {
+	status int32
+
+	serviceName               string
+	services                  map[string]int
+	rings                     map[string]*persistenceServiceResolver
+	logger                    log.Logger
+	metadataManager           persistence.ClusterMetadataManager
+	broadcastHostPortResolver func() (string, error)
+	heartbeatInterval         time.Duration
+	hostID                    uuid.UUID
+	shutdownCh                chan struct{}
+	shutdownWG                sync.WaitGroup
+
+	sync.Mutex
+	selfAddress string
+	request     *persistence.UpsertClusterMembershipRequest
+	evicted     bool
+}
+
+var _ Monitor = (*persistenceMonitor)(nil)
+
+// NewPersistenceMonitor returns a membership monitor which discovers members from the heartbeats
+// they write to the cluster_membership table, instead of gossiping with them
+func NewPersistenceMonitor(
+	serviceName string,
+	services map[string]int,
+	logger log.Logger,
+	metadataManager persistence.ClusterMetadataManager,
+	broadcastHostPortResolver func() (string, error),
+	heartbeatInterval time.Duration,
+) Monitor {
+
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = defaultHeartbeatInterval
+	}
+	pm := &persistenceMonitor{
+		status:                    common.DaemonStatusInitialized,
+		serviceName:               serviceName,
+		services:                  services,
+		rings:                     make(map[string]*persistenceServiceResolver),
+		logger:                    logger,
+		metadataManager:           metadataManager,
+		broadcastHostPortResolver: broadcastHostPortResolver,
+		heartbeatInterval:         heartbeatInterval,
+		hostID:                    uuid.NewUUID(),
+		shutdownCh:                make(chan struct{}),
+	}
+	for service := range services {
+		pm.rings[service] = newPersistenceServiceResolver(service, logger)
+	}
+	return pm
+}
+
+func (pm *persistenceMonitor) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&pm.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	if err := pm.startHeartbeat(); err != nil {
+		pm.logger.Fatal("unable to initialize membership heartbeats", tag.Error(err))
+	}
+	if err := pm.refresh(); err != nil {
+		pm.logger.Fatal("unable to load cluster members", tag.Error(err))
+	}
+
+	pm.shutdownWG.Add(1)
+	go pm.heartbeatLoop()
+}
+
+func (pm *persistenceMonitor) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&pm.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	close(pm.shutdownCh)
+	if success := common.AwaitWaitGroup(&pm.shutdownWG, time.Minute); !success {
+		pm.logger.Warn("persistence membership monitor timed out on shutdown.")
+	}
+	for _, ring := range pm.rings {
+		ring.stop()
+	}
+}
+
+func (pm *persistenceMonitor) startHeartbeat() error {
+	// Start by cleaning up expired records to avoid growth
+	if err := pm.metadataManager.PruneClusterMembership(&persistence.PruneClusterMembershipRequest{MaxRecordsPruned: 10}); err != nil {
+		pm.logger.Warn("Failed to prune cluster membership", tag.Error(err))
+	}
+
+	broadcastHostPort, err := pm.broadcastHostPortResolver()
+	if err != nil {
+		return err
+	}
+	servicePort, ok := pm.services[pm.serviceName]
+	if !ok {
+		return ErrUnknownService
+	}
+	// members are registered with their service port, so that lookups do not need to translate addresses
+	selfAddress, err := replaceServicePort(broadcastHostPort, servicePort)
+	if err != nil {
+		return err
+	}
+	broadcastAddress, broadcastPort, err := SplitHostPortTyped(selfAddress)
+	if err != nil {
+		return err
+	}
+	role, err := ServiceNameToServiceTypeEnum(pm.serviceName)
+	if err != nil {
+		return err
+	}
+
+	pm.Lock()
+	defer pm.Unlock()
+
+	pm.selfAddress = selfAddress
+	pm.request = &persistence.UpsertClusterMembershipRequest{
+		Role:         role,
+		RPCAddress:   broadcastAddress,
+		RPCPort:      broadcastPort,
+		SessionStart: time.Now().UTC(),
+		RecordExpiry: upsertMembershipRecordExpiryDefault,
+		HostID:       pm.hostID,
+	}
+	if err := pm.metadataManager.UpsertClusterMembership(pm.request); err != nil {
+		return err
+	}
+
+	pm.logger.Info("Membership heartbeat upserted successfully",
+		tag.Address(broadcastAddress.String()),
+		tag.Port(int(broadcastPort)),
+		tag.HostID(pm.hostID.String()))
+	return nil
+}
+
+func (pm *persistenceMonitor) heartbeat() error {
+	pm.Lock()
+	defer pm.Unlock()
+
+	if pm.evicted {
+		return nil
+	}
+	return pm.metadataManager.UpsertClusterMembership(pm.request)
+}
+
+func (pm *persistenceMonitor) heartbeatLoop() {
+	defer pm.shutdownWG.Done()
+
+	timer := time.NewTimer(pm.nextHeartbeatInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-pm.shutdownCh:
+			return
+		case <-timer.C:
+			if err := pm.heartbeat(); err != nil {
+				pm.logger.Error("Membership upsert failed.", tag.Error(err))
+			}
+			if err := pm.refresh(); err != nil {
+				pm.logger.Error("error refreshing cluster members", tag.Error(err))
+			}
+			timer.Reset(pm.nextHeartbeatInterval())
+		}
+	}
+}
+
+func (pm *persistenceMonitor) nextHeartbeatInterval() time.Duration {
+	// up to 20% jitter so that members do not heartbeat in lockstep
+	jitter := time.Duration(rand.Int63n(int64(pm.heartbeatInterval)/5 + 1))
+	return pm.heartbeatInterval - jitter
+}
+
+// refresh loads the members which heartbeated recently and rebuilds the ring of each service
+func (pm *persistenceMonitor) refresh() error {
+	members, err := pm.getActiveMembers()
+	if err != nil {
+		return err
+	}
+
+	pm.Lock()
+	evicted := pm.evicted
+	pm.Unlock()
+
+	addrsByService := make(map[string][]string, len(pm.rings))
+	for _, member := range members {
+		if evicted && uuid.Equal(member.HostID, pm.hostID) {
+			continue
+		}
+		service, err := serviceTypeToServiceName(member.Role)
+		if err != nil {
+			continue
+		}
+		addr := net.JoinHostPort(member.RPCAddress.String(), strconv.Itoa(int(member.RPCPort)))
+		addrsByService[service] = append(addrsByService[service], addr)
+	}
+
+	for service, ring := range pm.rings {
+		ring.update(addrsByService[service])
+	}
+	return nil
+}
+
+func (pm *persistenceMonitor) getActiveMembers() ([]*persistence.ClusterMember, error) {
+	var members []*persistence.ClusterMember
+	var nextPageToken []byte
+	for {
+		resp, err := pm.metadataManager.GetClusterMembers(&persistence.GetClusterMembersRequest{
+			LastHeartbeatWithin: missedHeartbeatsCutoff * pm.heartbeatInterval,
+			PageSize:            getClusterMembersPageSize,
+			NextPageToken:       nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, resp.ActiveMembers...)
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return members, nil
+		}
+	}
+}
+
+// WhoAmI returns the address (host:port) of this member, which is the address its service listens on
+func (pm *persistenceMonitor) WhoAmI() (*HostInfo, error) {
+	pm.Lock()
+	defer pm.Unlock()
+
+	if pm.selfAddress == "" {
+		return nil, fmt.Errorf("persistence membership monitor for %v not started", pm.serviceName)
+	}
+	return NewHostInfo(pm.selfAddress, map[string]string{RoleKey: pm.serviceName}), nil
+}
+
+// EvictSelf stops heartbeating and lets the membership record expire, so that other members
+// drop this one on their next refresh. This member drops itself from its own view right away.
+func (pm *persistenceMonitor) EvictSelf() error {
+	pm.Lock()
+	if pm.evicted || pm.request == nil {
+		pm.Unlock()
+		return nil
+	}
+	pm.evicted = true
+	request := *pm.request
+	request.RecordExpiry = evictedMembershipRecordExpiry
+	err := pm.metadataManager.UpsertClusterMembership(&request)
+	pm.Unlock()
+
+	if err != nil {
+		return err
+	}
+	return pm.refresh()
+}
+
+func (pm *persistenceMonitor) GetResolver(service string) (ServiceResolver, error) {
+	ring, found := pm.rings[service]
+	if !found {
+		return nil, ErrUnknownService
+	}
+	return ring, nil
+}
+
+func (pm *persistenceMonitor) Lookup(service string, key string) (*HostInfo, error) {
+	ring, err := pm.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return ring.Lookup(key)
+}
+
+func (pm *persistenceMonitor) AddListener(service string, name string, notifyChannel chan<- *ChangedEvent) error {
+	ring, err := pm.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.AddListener(name, notifyChannel)
+}
+
+func (pm *persistenceMonitor) RemoveListener(service string, name string) error {
+	ring, err := pm.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.RemoveListener(name)
+}
+
+func (pm *persistenceMonitor) GetReachableMembers() ([]string, error) {
+	var addrs []string
+	for _, ring := range pm.rings {
+		for _, host := range ring.Members() {
+			addrs = append(addrs, host.GetAddress())
+		}
+	}
+	return addrs, nil
+}
+
+func (pm *persistenceMonitor) GetMemberCount(service string) (int, error) {
+	ring, err := pm.GetResolver(service)
+	if err != nil {
+		return 0, err
+	}
+	return ring.MemberCount(), nil
+}
+
+func serviceTypeToServiceName(role persistence.ServiceType) (string, error) {
+	switch role {
+	case persistence.Frontend:
+		return primitives.FrontendService, nil
+	case persistence.History:
+		return primitives.HistoryService, nil
+	case persistence.Matching:
+		return primitives.MatchingService, nil
+	case persistence.Worker:
+		return primitives.WorkerService, nil
+	default:
+		return "", fmt.Errorf("unable to map service type '%v' to a service", role)
+	}
+}
@@ -0,0 +1,192 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	persistenceMonitorSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller  *gomock.Controller
+		metadataMgr *mocks.MockClusterMetadataManager
+
+		sync.Mutex
+		records map[string]*persistence.ClusterMember
+	}
+)
+
+func TestPersistenceMonitorSuite(t *testing.T) {
+	suite.Run(t, new(persistenceMonitorSuite))
+}
+
+func (s *persistenceMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.records = make(map[string]*persistence.ClusterMember)
+
+	// the mock behaves like the cluster_membership table
+	s.metadataMgr = mocks.NewMockClusterMetadataManager(s.controller)
+	s.metadataMgr.EXPECT().PruneClusterMembership(gomock.Any()).Return(nil).AnyTimes()
+	s.metadataMgr.EXPECT().UpsertClusterMembership(gomock.Any()).DoAndReturn(
+		func(request *persistence.UpsertClusterMembershipRequest) error {
+			s.Lock()
+			defer s.Unlock()
+			now := time.Now().UTC()
+			s.records[request.HostID.String()] = &persistence.ClusterMember{
+				Role:          request.Role,
+				HostID:        request.HostID,
+				RPCAddress:    request.RPCAddress,
+				RPCPort:       request.RPCPort,
+				SessionStart:  request.SessionStart,
+				LastHeartbeat: now,
+				RecordExpiry:  now.Add(request.RecordExpiry),
+			}
+			return nil
+		}).AnyTimes()
+	s.metadataMgr.EXPECT().GetClusterMembers(gomock.Any()).DoAndReturn(
+		func(request *persistence.GetClusterMembersRequest) (*persistence.GetClusterMembersResponse, error) {
+			s.Lock()
+			defer s.Unlock()
+			now := time.Now().UTC()
+			var members []*persistence.ClusterMember
+			for _, member := range s.records {
+				if member.RecordExpiry.After(now) && member.LastHeartbeat.After(now.Add(-request.LastHeartbeatWithin)) {
+					members = append(members, member)
+				}
+			}
+			return &persistence.GetClusterMembersResponse{ActiveMembers: members}, nil
+		}).AnyTimes()
+}
+
+func (s *persistenceMonitorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *persistenceMonitorSuite) newMonitor(broadcastHostPort string) Monitor {
+	services := map[string]int{
+		primitives.FrontendService: 7233,
+		primitives.HistoryService:  7234,
+	}
+	return NewPersistenceMonitor(
+		primitives.HistoryService,
+		services,
+		loggerimpl.NewNopLogger(),
+		s.metadataMgr,
+		func() (string, error) { return broadcastHostPort, nil },
+		50*time.Millisecond,
+	)
+}
+
+func (s *persistenceMonitorSuite) TestMembership() {
+	monitor1 := s.newMonitor("127.0.0.1:6934")
+	monitor1.Start()
+	defer monitor1.Stop()
+
+	self, err := monitor1.WhoAmI()
+	s.NoError(err)
+	s.Equal("127.0.0.1:7234", self.GetAddress())
+	host, err := monitor1.Lookup(primitives.HistoryService, "key")
+	s.NoError(err)
+	s.Equal(self.GetAddress(), host.GetAddress())
+	_, err = monitor1.Lookup(primitives.FrontendService, "key")
+	s.Equal(ErrInsufficientHosts, err)
+
+	listenCh := make(chan *ChangedEvent, 5)
+	s.NoError(monitor1.AddListener(primitives.HistoryService, "test-listener", listenCh))
+
+	monitor2 := s.newMonitor("127.0.0.2:6934")
+	monitor2.Start()
+	defer monitor2.Stop()
+
+	select {
+	case e := <-listenCh:
+		s.Len(e.HostsAdded, 1)
+		s.Equal("127.0.0.2:7234", e.HostsAdded[0].GetAddress())
+		s.Empty(e.HostsRemoved)
+	case <-time.After(5 * time.Second):
+		s.Fail("Timed out waiting for membership change")
+	}
+	count, err := monitor1.GetMemberCount(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal(2, count)
+
+	// both members agree on the owner of every key
+	for _, key := range []string{"1", "2", "3", "4", "5"} {
+		host1, err := monitor1.Lookup(primitives.HistoryService, key)
+		s.NoError(err)
+		host2, err := monitor2.Lookup(primitives.HistoryService, key)
+		s.NoError(err)
+		s.Equal(host1.GetAddress(), host2.GetAddress())
+	}
+
+	s.NoError(monitor2.EvictSelf())
+	count, err = monitor2.GetMemberCount(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal(1, count)
+
+	select {
+	case e := <-listenCh:
+		s.Len(e.HostsRemoved, 1)
+		s.Equal("127.0.0.2:7234", e.HostsRemoved[0].GetAddress())
+		s.Empty(e.HostsAdded)
+	case <-time.After(5 * time.Second):
+		s.Fail("Timed out waiting for membership change")
+	}
+}
+
+func (s *persistenceMonitorSuite) TestMemberExpires() {
+	monitor1 := s.newMonitor("127.0.0.1:6934")
+	monitor1.Start()
+	defer monitor1.Stop()
+	monitor2 := s.newMonitor("127.0.0.2:6934")
+	monitor2.Start()
+
+	s.Eventually(func() bool {
+		count, err := monitor1.GetMemberCount(primitives.HistoryService)
+		return err == nil && count == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// a member which stops heartbeating is dropped once its heartbeats are overdue
+	monitor2.Stop()
+	s.Eventually(func() bool {
+		count, err := monitor1.GetMemberCount(primitives.HistoryService)
+		return err == nil && count == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
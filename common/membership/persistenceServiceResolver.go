@@ -0,0 +1,201 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/temporalio/ringpop-go/hashring"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+// persistenceServiceResolver is a consistent hash ring of the members of a service,
+// it is kept up to date by the persistenceMonitor
+type persistenceServiceResolver struct {
+	service string
+	logger  log.Logger
+
+	ringValue atomic.Value // this stores the current hashring
+
+	updateLock sync.Mutex
+	membersMap map[string]struct{}
+
+	listenerLock sync.RWMutex
+	listeners    map[string]chan<- *ChangedEvent
+}
+
+var _ ServiceResolver = (*persistenceServiceResolver)(nil)
+
+func newPersistenceServiceResolver(
+	service string,
+	logger log.Logger,
+) *persistenceServiceResolver {
+
+	resolver := &persistenceServiceResolver{
+		service:    service,
+		logger:     logger.WithTags(tag.ComponentServiceResolver, tag.Service(service)),
+		membersMap: make(map[string]struct{}),
+		listeners:  make(map[string]chan<- *ChangedEvent),
+	}
+	resolver.ringValue.Store(newHashRing())
+	return resolver
+}
+
+func (r *persistenceServiceResolver) stop() {
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	r.ringValue.Store(newHashRing())
+	r.listeners = make(map[string]chan<- *ChangedEvent)
+}
+
+// Lookup finds the host in the ring responsible for serving the given key
+func (r *persistenceServiceResolver) Lookup(
+	key string,
+) (*HostInfo, error) {
+
+	addr, found := r.ring().Lookup(key)
+	if !found {
+		return nil, ErrInsufficientHosts
+	}
+	return NewHostInfo(addr, r.getLabelsMap()), nil
+}
+
+func (r *persistenceServiceResolver) AddListener(
+	name string,
+	notifyChannel chan<- *ChangedEvent,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if ok {
+		return ErrListenerAlreadyExist
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *persistenceServiceResolver) RemoveListener(
+	name string,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	delete(r.listeners, name)
+	return nil
+}
+
+func (r *persistenceServiceResolver) MemberCount() int {
+	return r.ring().ServerCount()
+}
+
+func (r *persistenceServiceResolver) Members() []*HostInfo {
+	var servers []*HostInfo
+	for _, s := range r.ring().Servers() {
+		servers = append(servers, NewHostInfo(s, r.getLabelsMap()))
+	}
+
+	return servers
+}
+
+// update rebuilds the ring from the given member addresses and notifies listeners if they changed
+func (r *persistenceServiceResolver) update(
+	addrs []string,
+) {
+
+	r.updateLock.Lock()
+	defer r.updateLock.Unlock()
+
+	event := &ChangedEvent{}
+	newMembersMap := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := newMembersMap[addr]; ok {
+			continue
+		}
+		newMembersMap[addr] = struct{}{}
+		if _, ok := r.membersMap[addr]; !ok {
+			event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	var removed []string
+	for addr := range r.membersMap {
+		if _, ok := newMembersMap[addr]; !ok {
+			removed = append(removed, addr)
+		}
+	}
+	sort.Strings(removed)
+	for _, addr := range removed {
+		event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
+	}
+	if len(event.HostsAdded) == 0 && len(event.HostsRemoved) == 0 {
+		return
+	}
+
+	ring := newHashRing()
+	for addr := range newMembersMap {
+		ring.AddMembers(NewHostInfo(addr, r.getLabelsMap()))
+	}
+	r.membersMap = newMembersMap
+	r.ringValue.Store(ring)
+
+	members := make([]string, 0, len(newMembersMap))
+	for addr := range newMembersMap {
+		members = append(members, addr)
+	}
+	sort.Strings(members)
+	r.logger.Info("Current reachable members", tag.Addresses(members))
+
+	r.emitEvent(event)
+}
+
+func (r *persistenceServiceResolver) emitEvent(
+	event *ChangedEvent,
+) {
+
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
+		}
+	}
+}
+
+func (r *persistenceServiceResolver) ring() *hashring.HashRing {
+	return r.ringValue.Load().(*hashring.HashRing)
+}
+
+func (r *persistenceServiceResolver) getLabelsMap() map[string]string {
+	labels := make(map[string]string)
+	labels[RoleKey] = r.service
+	return labels
+}
@@ -284,6 +284,12 @@ const (
 	PersistenceUpsertClusterMembershipScope
 	// PersistencePruneClusterMembershipScope tracks PruneClusterMembership calls made by service to persistence layer
 	PersistencePruneClusterMembershipScope
+	// PersistenceGetDynamicConfigScope tracks GetDynamicConfig calls made by service to persistence layer
+	PersistenceGetDynamicConfigScope
+	// PersistenceUpdateDynamicConfigScope tracks UpdateDynamicConfig calls made by service to persistence layer
+	PersistenceUpdateDynamicConfigScope
+	// PersistenceListDynamicConfigHistoryScope tracks ListDynamicConfigHistory calls made by service to persistence layer
+	PersistenceListDynamicConfigHistoryScope
 	// PersistenceGetClusterMembersScope tracks GetClusterMembers calls made by service to persistence layer
 	PersistenceGetClusterMembersScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
@@ -354,6 +360,10 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientHandoffShardScope tracks RPC calls to history service
+	HistoryClientHandoffShardScope
+	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowExecutionScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -484,6 +494,20 @@ const (
 	AdminClientRefreshWorkflowTasksScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
+	// AdminClientGetDynamicConfigScope tracks RPC calls to admin service
+	AdminClientGetDynamicConfigScope
+	// AdminClientUpdateDynamicConfigScope tracks RPC calls to admin service
+	AdminClientUpdateDynamicConfigScope
+	// AdminClientListDynamicConfigScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigScope
+	// AdminClientListDynamicConfigHistoryScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigHistoryScope
+	// AdminClientDumpDynamicConfigScope tracks RPC calls to admin service
+	AdminClientDumpDynamicConfigScope
+	// AdminClientDeleteNamespaceScope tracks RPC calls to admin service
+	AdminClientDeleteNamespaceScope
+	// AdminClientBackfillArchivalScope tracks RPC calls to admin service
+	AdminClientBackfillArchivalScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateNamespaceScope
 	// DCRedirectionDescribeNamespaceScope tracks RPC calls for dc redirection
@@ -687,6 +711,20 @@ const (
 	AdminRefreshWorkflowTasksScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope
+	// AdminGetDynamicConfigScope is the metric scope for admin.GetDynamicConfig
+	AdminGetDynamicConfigScope
+	// AdminUpdateDynamicConfigScope is the metric scope for admin.UpdateDynamicConfig
+	AdminUpdateDynamicConfigScope
+	// AdminListDynamicConfigScope is the metric scope for admin.ListDynamicConfig
+	AdminListDynamicConfigScope
+	// AdminListDynamicConfigHistoryScope is the metric scope for admin.ListDynamicConfigHistory
+	AdminListDynamicConfigHistoryScope
+	// AdminDumpDynamicConfigScope is the metric scope for admin.DumpDynamicConfig
+	AdminDumpDynamicConfigScope
+	// AdminDeleteNamespaceScope is the metric scope for admin.DeleteNamespace
+	AdminDeleteNamespaceScope
+	// AdminBackfillArchivalScope is the metric scope for admin.BackfillArchival
+	AdminBackfillArchivalScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminRemoveTaskScope
 	//AdminCloseShardTaskScope is the metric scope for admin.AdminRemoveTaskScope
@@ -781,6 +819,12 @@ const (
 	FrontendResetWorkflowExecutionScope
 	// FrontendGetSearchAttributesScope is the metric scope for frontend.GetSearchAttributes
 	FrontendGetSearchAttributesScope
+	// FrontendPriorityWorkerScope is the metric scope for the rate limit of worker task processing requests
+	FrontendPriorityWorkerScope
+	// FrontendPriorityExecutionScope is the metric scope for the rate limit of requests starting and changing workflows
+	FrontendPriorityExecutionScope
+	// FrontendPriorityVisibilityScope is the metric scope for the rate limit of visibility requests and queries
+	FrontendPriorityVisibilityScope
 
 	NumFrontendScopes
 )
@@ -855,6 +899,10 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope is the scope used by refresh workflow tasks API
 	HistoryRefreshWorkflowTasksScope
+	// HistoryHandoffShardScope is the scope used by handoff shard API
+	HistoryHandoffShardScope
+	// HistoryDeleteWorkflowExecutionScope is the scope used by delete workflow execution API
+	HistoryDeleteWorkflowExecutionScope
 	// TaskPriorityAssignerScope is the scope used by all metric emitted by task priority assigner
 	TaskPriorityAssignerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -1055,6 +1103,8 @@ const (
 	ExecutionsScavengerScope
 	// BatcherScope is scope used by all metrics emitted by worker.Batcher module
 	BatcherScope
+	// SchedulerScope is scope used by all metrics emitted by worker.Scheduler module
+	SchedulerScope
 	// HistoryScavengerScope is scope used by all metrics emitted by worker.history.Scavenger module
 	HistoryScavengerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
@@ -1151,6 +1201,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceInitImmutableClusterMetadataScope:             {operation: "InitializeImmutableClusterMetadata"},
 		PersistenceGetImmutableClusterMetadataScope:              {operation: "GetImmutableClusterMetadata"},
 		PersistencePruneClusterMembershipScope:                   {operation: "PruneClusterMembership"},
+		PersistenceGetDynamicConfigScope:                         {operation: "GetDynamicConfig"},
+		PersistenceUpdateDynamicConfigScope:                      {operation: "UpdateDynamicConfig"},
+		PersistenceListDynamicConfigHistoryScope:                 {operation: "ListDynamicConfigHistory"},
 		PersistenceGetClusterMembersScope:                        {operation: "GetClusterMembership"},
 		PersistenceUpsertClusterMembershipScope:                  {operation: "UpsertClusterMembership"},
 
@@ -1190,6 +1243,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientHandoffShardScope:                        {operation: "HistoryClientHandoffShardScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDeleteWorkflowExecutionScope:             {operation: "HistoryClientDeleteWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:                {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:                {operation: "MatchingClientPollForActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
@@ -1251,6 +1306,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetDynamicConfigScope:                      {operation: "AdminClientGetDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateDynamicConfigScope:                   {operation: "AdminClientUpdateDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListDynamicConfigScope:                     {operation: "AdminClientListDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListDynamicConfigHistoryScope:              {operation: "AdminClientListDynamicConfigHistory", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDumpDynamicConfigScope:                     {operation: "AdminClientDumpDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteNamespaceScope:                       {operation: "AdminClientDeleteNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientBackfillArchivalScope:                      {operation: "AdminClientBackfillArchival", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientReadDLQMessagesScope:                       {operation: "AdminClientReadDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1353,6 +1415,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminReapplyEventsScope:                    {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
 		AdminResendReplicationTasksScope:           {operation: "ResendReplicationTasks"},
+		AdminGetDynamicConfigScope:                 {operation: "GetDynamicConfig"},
+		AdminUpdateDynamicConfigScope:              {operation: "UpdateDynamicConfig"},
+		AdminListDynamicConfigScope:                {operation: "ListDynamicConfig"},
+		AdminListDynamicConfigHistoryScope:         {operation: "ListDynamicConfigHistory"},
+		AdminDumpDynamicConfigScope:                {operation: "DumpDynamicConfig"},
+		AdminDeleteNamespaceScope:                  {operation: "AdminDeleteNamespace"},
+		AdminBackfillArchivalScope:                 {operation: "AdminBackfillArchival"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:                {operation: "PollForDecisionTask"},
@@ -1393,6 +1462,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendDescribeTaskQueueScope:                  {operation: "DescribeTaskQueue"},
 		FrontendResetStickyTaskQueueScope:               {operation: "ResetStickyTaskQueue"},
 		FrontendGetSearchAttributesScope:                {operation: "GetSearchAttributes"},
+		FrontendPriorityWorkerScope:                     {operation: "PriorityWorker"},
+		FrontendPriorityExecutionScope:                  {operation: "PriorityExecution"},
+		FrontendPriorityVisibilityScope:                 {operation: "PriorityVisibility"},
 	},
 	// History Scope Names
 	History: {
@@ -1433,6 +1505,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		HistoryReapplyEventsScope:                              {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                       {operation: "RefreshWorkflowTasks"},
+		HistoryHandoffShardScope:                               {operation: "HandoffShard"},
+		HistoryDeleteWorkflowExecutionScope:                    {operation: "DeleteWorkflowExecution"},
 		TaskPriorityAssignerScope:                              {operation: "TaskPriorityAssigner"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
@@ -1531,6 +1605,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ExecutionsScavengerScope:               {operation: "executionsscavenger"},
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
 		BatcherScope:                           {operation: "batcher"},
+		SchedulerScope:                         {operation: "scheduler"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 	},
 }
@@ -1742,6 +1817,11 @@ const (
 	GetEngineForShardErrorCounter
 	GetEngineForShardLatency
 	RemoveEngineForShardLatency
+	ShardHandoffCounter
+	ShardHandoffFailedCounter
+	ShardHandoffLatency
+	ShardHandoffWaitLatency
+	ShardHandoffWaitTimeoutCounter
 	CompleteDecisionWithStickyEnabledCounter
 	CompleteDecisionWithStickyDisabledCounter
 	DecisionHeartbeatTimeoutCounter
@@ -1866,6 +1946,11 @@ const (
 	LocalToRemoteMatchPerTaskQueueCounter
 	RemoteToLocalMatchPerTaskQueueCounter
 	RemoteToRemoteMatchPerTaskQueueCounter
+	ApproximateBacklogCountPerTaskQueueGauge
+	OldestTaskAgePerTaskQueueGauge
+	AddRatePerTaskQueueGauge
+	DispatchRatePerTaskQueueGauge
+	SyncMatchRatePerTaskQueueGauge
 
 	NumMatchingMetrics
 )
@@ -1916,12 +2001,19 @@ const (
 	TaskQueueDeletedCount
 	TaskQueueOutstandingCount
 	ExecutionsOutstandingCount
+	ExecutionsProcessedCount
+	ExecutionsCorruptedCount
+	ExecutionsCheckFailedCount
+	ExecutionsFixedCount
+	ExecutionsFixFailedCount
 	StartedCount
 	StoppedCount
 	ExecutorTasksDeferredCount
 	ExecutorTasksDroppedCount
 	BatcherProcessorSuccess
 	BatcherProcessorFailures
+	SchedulerStartWorkflowSuccess
+	SchedulerStartWorkflowFailures
 	HistoryScavengerSuccessCount
 	HistoryScavengerErrorCount
 	HistoryScavengerSkipCount
@@ -2157,6 +2249,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		GetEngineForShardErrorCounter:                     {metricName: "get_engine_for_shard_errors", metricType: Counter},
 		GetEngineForShardLatency:                          {metricName: "get_engine_for_shard_latency", metricType: Timer},
 		RemoveEngineForShardLatency:                       {metricName: "remove_engine_for_shard_latency", metricType: Timer},
+		ShardHandoffCounter:                               {metricName: "shard_handoff_count", metricType: Counter},
+		ShardHandoffFailedCounter:                         {metricName: "shard_handoff_failed", metricType: Counter},
+		ShardHandoffLatency:                               {metricName: "shard_handoff_latency", metricType: Timer},
+		ShardHandoffWaitLatency:                           {metricName: "shard_handoff_wait_latency", metricType: Timer},
+		ShardHandoffWaitTimeoutCounter:                    {metricName: "shard_handoff_wait_timeout", metricType: Counter},
 		CompleteDecisionWithStickyEnabledCounter:          {metricName: "complete_decision_sticky_enabled_count", metricType: Counter},
 		CompleteDecisionWithStickyDisabledCounter:         {metricName: "complete_decision_sticky_disabled_count", metricType: Counter},
 		DecisionHeartbeatTimeoutCounter:                   {metricName: "decision_heartbeat_timeout_count", metricType: Counter},
@@ -2277,6 +2374,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		LocalToRemoteMatchPerTaskQueueCounter:     {metricName: "local_to_remote_matches_per_tl", metricRollupName: "local_to_remote_matches"},
 		RemoteToLocalMatchPerTaskQueueCounter:     {metricName: "remote_to_local_matches_per_tl", metricRollupName: "remote_to_local_matches"},
 		RemoteToRemoteMatchPerTaskQueueCounter:    {metricName: "remote_to_remote_matches_per_tl", metricRollupName: "remote_to_remote_matches"},
+		ApproximateBacklogCountPerTaskQueueGauge:  {metricName: "approximate_backlog_count_per_tl", metricType: Gauge},
+		OldestTaskAgePerTaskQueueGauge:            {metricName: "oldest_task_age_seconds_per_tl", metricType: Gauge},
+		AddRatePerTaskQueueGauge:                  {metricName: "add_rate_per_tl", metricType: Gauge},
+		DispatchRatePerTaskQueueGauge:             {metricName: "dispatch_rate_per_tl", metricType: Gauge},
+		SyncMatchRatePerTaskQueueGauge:            {metricName: "sync_match_rate_per_tl", metricType: Gauge},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -2323,12 +2425,19 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskQueueDeletedCount:                         {metricName: "taskqueue_deleted", metricType: Gauge},
 		TaskQueueOutstandingCount:                     {metricName: "taskqueue_outstanding", metricType: Gauge},
 		ExecutionsOutstandingCount:                    {metricName: "executions_outstanding", metricType: Gauge},
+		ExecutionsProcessedCount:                      {metricName: "executions_processed", metricType: Gauge},
+		ExecutionsCorruptedCount:                      {metricName: "executions_corrupted", metricType: Gauge},
+		ExecutionsCheckFailedCount:                    {metricName: "executions_check_failed", metricType: Gauge},
+		ExecutionsFixedCount:                          {metricName: "executions_fixed", metricType: Gauge},
+		ExecutionsFixFailedCount:                      {metricName: "executions_fix_failed", metricType: Gauge},
 		StartedCount:                                  {metricName: "started", metricType: Counter},
 		StoppedCount:                                  {metricName: "stopped", metricType: Counter},
 		ExecutorTasksDeferredCount:                    {metricName: "executor_deferred", metricType: Counter},
 		ExecutorTasksDroppedCount:                     {metricName: "executor_dropped", metricType: Counter},
 		BatcherProcessorSuccess:                       {metricName: "batcher_processor_requests", metricType: Counter},
 		BatcherProcessorFailures:                      {metricName: "batcher_processor_errors", metricType: Counter},
+		SchedulerStartWorkflowSuccess:                 {metricName: "scheduler_start_workflow_requests", metricType: Counter},
+		SchedulerStartWorkflowFailures:                {metricName: "scheduler_start_workflow_errors", metricType: Counter},
 		HistoryScavengerSuccessCount:                  {metricName: "scavenger_success", metricType: Counter},
 		HistoryScavengerErrorCount:                    {metricName: "scavenger_errors", metricType: Counter},
 		HistoryScavengerSkipCount:                     {metricName: "scavenger_skips", metricType: Counter},
@@ -190,3 +190,47 @@ func (mr *MockClusterMetadataManagerMockRecorder) PruneClusterMembership(request
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneClusterMembership", reflect.TypeOf((*MockClusterMetadataManager)(nil).PruneClusterMembership), request)
 }
+
+// GetDynamicConfig mocks base method
+func (m *MockClusterMetadataManager) GetDynamicConfig() (*persistence.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig")
+	ret0, _ := ret[0].(*persistence.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig
+func (mr *MockClusterMetadataManagerMockRecorder) GetDynamicConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockClusterMetadataManager)(nil).GetDynamicConfig))
+}
+
+// UpdateDynamicConfig mocks base method
+func (m *MockClusterMetadataManager) UpdateDynamicConfig(request *persistence.UpdateDynamicConfigRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDynamicConfig", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDynamicConfig indicates an expected call of UpdateDynamicConfig
+func (mr *MockClusterMetadataManagerMockRecorder) UpdateDynamicConfig(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockClusterMetadataManager)(nil).UpdateDynamicConfig), request)
+}
+
+// ListDynamicConfigHistory mocks base method
+func (m *MockClusterMetadataManager) ListDynamicConfigHistory(request *persistence.ListDynamicConfigHistoryRequest) (*persistence.ListDynamicConfigHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigHistory", request)
+	ret0, _ := ret[0].(*persistence.ListDynamicConfigHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigHistory indicates an expected call of ListDynamicConfigHistory
+func (mr *MockClusterMetadataManagerMockRecorder) ListDynamicConfigHistory(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigHistory", reflect.TypeOf((*MockClusterMetadataManager)(nil).ListDynamicConfigHistory), request)
+}
@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/common/versioning"
 )
 
 type (
@@ -433,6 +434,9 @@ func (d *HandlerImpl) UpdateNamespace(
 			info.Owner = updatedInfo.GetOwnerEmail()
 		}
 		if updatedInfo.Data != nil {
+			if err := versioning.ValidateNamespaceData(updatedInfo.Data); err != nil {
+				return nil, serviceerror.NewInvalidArgument(err.Error())
+			}
 			configurationChanged = true
 			// only do merging
 			info.Data = d.mergeNamespaceData(info.Data, updatedInfo.Data)
@@ -25,6 +25,7 @@
 package cassandra
 
 import (
+	"fmt"
 	"net"
 	"strings"
 	"time"
@@ -42,6 +43,7 @@ import (
 
 const constMetadataPartition = 0
 const constMembershipPartition = 0
+const constDynamicConfigPartition = 0
 
 const (
 	// ****** CLUSTER_METADATA TABLE ******
@@ -74,6 +76,20 @@ WHERE membership_partition = ?`
 	templateWithHostIDSuffix         = ` AND host_id = ?`
 	templateAllowFiltering           = ` ALLOW FILTERING`
 	templateWithSessionSuffix        = ` AND session_start > ?`
+
+	// ****** DYNAMIC_CONFIG TABLE ******
+	// This should insert the version only if it does not exist, concurrent updates of the same version fail.
+	templateInsertDynamicConfig = `INSERT INTO 
+dynamic_config (config_partition, version, data, data_encoding) 
+VALUES(?, ?, ?, ?) IF NOT EXISTS`
+
+	// the versions are ordered from the most recent
+	templateGetDynamicConfig = `SELECT version, data, data_encoding FROM 
+dynamic_config 
+WHERE config_partition = ?`
+
+	templateWithVersionBeforeSuffix = ` AND version <= ?`
+	templateLimitOneSuffix          = ` LIMIT 1`
 )
 
 type (
@@ -274,3 +290,69 @@ func (m *cassandraClusterMetadata) UpsertClusterMembership(request *p.UpsertClus
 func (m *cassandraClusterMetadata) PruneClusterMembership(request *p.PruneClusterMembershipRequest) error {
 	return nil
 }
+
+func (m *cassandraClusterMetadata) GetDynamicConfig() (*p.InternalGetDynamicConfigResponse, error) {
+	query := m.session.Query(templateGetDynamicConfig+templateLimitOneSuffix, constDynamicConfigPartition)
+	var version int64
+	var data []byte
+	var encoding string
+	err := query.Scan(&version, &data, &encoding)
+	if err == gocql.ErrNotFound {
+		return &p.InternalGetDynamicConfigResponse{}, nil
+	}
+	if err != nil {
+		return nil, convertCommonErrors("GetDynamicConfig", err)
+	}
+
+	return &p.InternalGetDynamicConfigResponse{
+		Version:       version,
+		DynamicConfig: p.NewDataBlob(data, common.EncodingType(encoding)),
+	}, nil
+}
+
+func (m *cassandraClusterMetadata) UpdateDynamicConfig(request *p.InternalUpdateDynamicConfigRequest) error {
+	query := m.session.Query(templateInsertDynamicConfig, constDynamicConfigPartition, request.PreviousVersion+1,
+		request.DynamicConfig.Data, request.DynamicConfig.Encoding)
+
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return convertCommonErrors("UpdateDynamicConfig", err)
+	}
+	if !applied {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("dynamic config was updated concurrently, version %v already exists", request.PreviousVersion+1),
+		}
+	}
+	return nil
+}
+
+func (m *cassandraClusterMetadata) ListDynamicConfigHistory(
+	request *p.InternalListDynamicConfigHistoryRequest) (*p.InternalListDynamicConfigHistoryResponse, error) {
+	queryString := templateGetDynamicConfig
+	operands := []interface{}{constDynamicConfigPartition}
+	if request.MaxVersion > 0 {
+		queryString += templateWithVersionBeforeSuffix
+		operands = append(operands, request.MaxVersion)
+	}
+	iter := m.session.Query(queryString, operands...).PageSize(request.PageSize).Iter()
+	if iter == nil {
+		return nil, serviceerror.NewInternal("ListDynamicConfigHistory operation failed.  Not able to create query iterator.")
+	}
+
+	resp := &p.InternalListDynamicConfigHistoryResponse{}
+	var version int64
+	var data []byte
+	var encoding string
+	for len(resp.DynamicConfigs) < request.PageSize && iter.Scan(&version, &data, &encoding) {
+		resp.DynamicConfigs = append(resp.DynamicConfigs, &p.InternalGetDynamicConfigResponse{
+			Version:       version,
+			DynamicConfig: p.NewDataBlob(data, common.EncodingType(encoding)),
+		})
+		data = nil
+	}
+	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("ListDynamicConfigHistory", err)
+	}
+	return resp, nil
+}
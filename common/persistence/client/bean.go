@@ -52,6 +52,9 @@ type (
 		GetNamespaceReplicationQueue() persistence.NamespaceReplicationQueue
 		SetNamespaceReplicationQueue(persistence.NamespaceReplicationQueue)
 
+		GetVisibilityQueue() persistence.VisibilityQueue
+		SetVisibilityQueue(persistence.VisibilityQueue)
+
 		GetShardManager() persistence.ShardManager
 		SetShardManager(persistence.ShardManager)
 
@@ -69,6 +72,7 @@ type (
 		taskManager               persistence.TaskManager
 		visibilityManager         persistence.VisibilityManager
 		namespaceReplicationQueue persistence.NamespaceReplicationQueue
+		visibilityQueue           persistence.VisibilityQueue
 		shardManager              persistence.ShardManager
 		historyManager            persistence.HistoryManager
 		executionManagerFactory   persistence.ExecutionManagerFactory
@@ -109,6 +113,11 @@ func NewBeanFromFactory(
 		return nil, err
 	}
 
+	visibilityQueue, err := factory.NewVisibilityQueue()
+	if err != nil {
+		return nil, err
+	}
+
 	shardMgr, err := factory.NewShardManager()
 	if err != nil {
 		return nil, err
@@ -125,6 +134,7 @@ func NewBeanFromFactory(
 		taskMgr,
 		visibilityMgr,
 		namespaceReplicationQueue,
+		visibilityQueue,
 		shardMgr,
 		historyMgr,
 		factory,
@@ -138,6 +148,7 @@ func NewBean(
 	taskManager persistence.TaskManager,
 	visibilityManager persistence.VisibilityManager,
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
+	visibilityQueue persistence.VisibilityQueue,
 	shardManager persistence.ShardManager,
 	historyManager persistence.HistoryManager,
 	executionManagerFactory persistence.ExecutionManagerFactory,
@@ -148,6 +159,7 @@ func NewBean(
 		taskManager:               taskManager,
 		visibilityManager:         visibilityManager,
 		namespaceReplicationQueue: namespaceReplicationQueue,
+		visibilityQueue:           visibilityQueue,
 		shardManager:              shardManager,
 		historyManager:            historyManager,
 		executionManagerFactory:   executionManagerFactory,
@@ -255,6 +267,26 @@ func (s *BeanImpl) SetNamespaceReplicationQueue(
 	s.namespaceReplicationQueue = namespaceReplicationQueue
 }
 
+// GetVisibilityQueue get VisibilityQueue
+func (s *BeanImpl) GetVisibilityQueue() persistence.VisibilityQueue {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.visibilityQueue
+}
+
+// SetVisibilityQueue set VisibilityQueue
+func (s *BeanImpl) SetVisibilityQueue(
+	visibilityQueue persistence.VisibilityQueue,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.visibilityQueue = visibilityQueue
+}
+
 // GetShardManager get ShardManager
 func (s *BeanImpl) GetShardManager() persistence.ShardManager {
 
@@ -347,6 +379,7 @@ func (s *BeanImpl) Close() {
 	s.taskManager.Close()
 	s.visibilityManager.Close()
 	s.namespaceReplicationQueue.Stop()
+	s.visibilityQueue.Close()
 	s.shardManager.Close()
 	s.historyManager.Close()
 	s.executionManagerFactory.Close()
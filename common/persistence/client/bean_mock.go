@@ -200,6 +200,32 @@ func (mr *MockBeanMockRecorder) SetNamespaceReplicationQueue(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespaceReplicationQueue", reflect.TypeOf((*MockBean)(nil).SetNamespaceReplicationQueue), arg0)
 }
 
+// GetVisibilityQueue mocks base method.
+func (m *MockBean) GetVisibilityQueue() persistence.VisibilityQueue {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVisibilityQueue")
+	ret0, _ := ret[0].(persistence.VisibilityQueue)
+	return ret0
+}
+
+// GetVisibilityQueue indicates an expected call of GetVisibilityQueue.
+func (mr *MockBeanMockRecorder) GetVisibilityQueue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityQueue", reflect.TypeOf((*MockBean)(nil).GetVisibilityQueue))
+}
+
+// SetVisibilityQueue mocks base method.
+func (m *MockBean) SetVisibilityQueue(arg0 persistence.VisibilityQueue) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetVisibilityQueue", arg0)
+}
+
+// SetVisibilityQueue indicates an expected call of SetVisibilityQueue.
+func (mr *MockBeanMockRecorder) SetVisibilityQueue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVisibilityQueue", reflect.TypeOf((*MockBean)(nil).SetVisibilityQueue), arg0)
+}
+
 // GetShardManager mocks base method.
 func (m *MockBean) GetShardManager() persistence.ShardManager {
 	m.ctrl.T.Helper()
@@ -58,6 +58,8 @@ type (
 		NewVisibilityManager() (p.VisibilityManager, error)
 		// NewNamespaceReplicationQueue returns a new queue for namespace replication
 		NewNamespaceReplicationQueue() (p.NamespaceReplicationQueue, error)
+		// NewVisibilityQueue returns a new queue for the messages of the advanced visibility store
+		NewVisibilityQueue() (p.VisibilityQueue, error)
 		// NewClusterMetadata returns a new manager for cluster specific metadata
 		NewClusterMetadataManager() (p.ClusterMetadataManager, error)
 	}
@@ -168,6 +170,7 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if f.metricsClient != nil {
 		result = p.NewTaskPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewTaskPersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -184,6 +187,7 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if f.metricsClient != nil {
 		result = p.NewShardPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewShardPersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -201,6 +205,7 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if f.metricsClient != nil {
 		result = p.NewHistoryV2PersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewHistoryV2PersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -221,6 +226,7 @@ func (f *factoryImpl) NewMetadataManager() (p.MetadataManager, error) {
 	if f.metricsClient != nil {
 		result = p.NewMetadataPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewMetadataPersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -241,6 +247,7 @@ func (f *factoryImpl) NewClusterMetadataManager() (p.ClusterMetadataManager, err
 	if f.metricsClient != nil {
 		result = p.NewClusterMetadataPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewClusterMetadataPersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -258,6 +265,7 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if f.metricsClient != nil {
 		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewWorkflowExecutionPersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -283,6 +291,7 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 	if f.metricsClient != nil {
 		result = p.NewVisibilityPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewVisibilityPersistenceTracingClient(result)
 
 	return result, nil
 }
@@ -299,10 +308,41 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 	if f.metricsClient != nil {
 		result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewQueuePersistenceTracingClient(result)
 
 	return p.NewNamespaceReplicationQueue(result, f.clusterName, f.metricsClient, f.logger), nil
 }
 
+func (f *factoryImpl) NewVisibilityQueue() (p.VisibilityQueue, error) {
+	// the queue has no partitions unless the advanced visibility store is configured to use it
+	numPartitions := 0
+	if esConfig := f.config.DataStores[f.config.AdvancedVisibilityStore].ElasticSearch; esConfig != nil && esConfig.UsePersistenceVisibilityQueue() {
+		numPartitions = esConfig.GetVisibilityQueuePartitions()
+	}
+
+	ds := f.datastores[storeTypeQueue]
+	partitions := make([]p.Queue, 0, numPartitions)
+	for partition := 0; partition < numPartitions; partition++ {
+		result, err := ds.factory.NewQueue(p.VisibilityQueueType + p.QueueType(partition))
+		if err != nil {
+			for _, queue := range partitions {
+				queue.Close()
+			}
+			return nil, err
+		}
+		if ds.ratelimit != nil {
+			result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		}
+		if f.metricsClient != nil {
+			result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
+		}
+		result = p.NewQueuePersistenceTracingClient(result)
+		partitions = append(partitions, result)
+	}
+
+	return p.NewVisibilityQueue(partitions), nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
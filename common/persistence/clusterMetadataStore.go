@@ -25,14 +25,20 @@
 package persistence
 
 import (
+	"encoding/binary"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 const (
 	clusterMetadataEncoding = common.EncodingTypeProto3
+	dynamicConfigEncoding   = common.EncodingTypeJSON
 )
 
 var (
@@ -50,6 +56,12 @@ type (
 		persistence ClusterMetadataStore
 		logger      log.Logger
 	}
+
+	// dynamicConfigDocument is the serialized dynamic config of a version, along with the change that produced it
+	dynamicConfigDocument struct {
+		Values map[string][]*DynamicConfigValue `json:"values"`
+		Change *DynamicConfigChange             `json:"change"`
+	}
 )
 
 var _ ClusterMetadataManager = (*clusterMetadataManagerImpl)(nil)
@@ -138,3 +150,105 @@ func (m *clusterMetadataManagerImpl) UpsertClusterMembership(request *UpsertClus
 func (m *clusterMetadataManagerImpl) PruneClusterMembership(request *PruneClusterMembershipRequest) error {
 	return m.persistence.PruneClusterMembership(request)
 }
+
+func (m *clusterMetadataManagerImpl) GetDynamicConfig() (*GetDynamicConfigResponse, error) {
+	resp, err := m.persistence.GetDynamicConfig()
+	if err != nil {
+		return nil, err
+	}
+	if resp.Version == 0 {
+		return &GetDynamicConfigResponse{Values: make(map[string][]*DynamicConfigValue)}, nil
+	}
+
+	document, err := deserializeDynamicConfig(resp.DynamicConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &GetDynamicConfigResponse{
+		Version: resp.Version,
+		Values:  document.Values,
+	}, nil
+}
+
+func (m *clusterMetadataManagerImpl) UpdateDynamicConfig(request *UpdateDynamicConfigRequest) error {
+	if request.Change == nil {
+		return errors.New("dynamic config update requires a change")
+	}
+	change := *request.Change
+	change.Version = request.PreviousVersion + 1
+	if change.UpdateTime.IsZero() {
+		change.UpdateTime = time.Now().UTC()
+	}
+	values := request.Values
+	if values == nil {
+		values = make(map[string][]*DynamicConfigValue)
+	}
+
+	data, err := json.Marshal(&dynamicConfigDocument{Values: values, Change: &change})
+	if err != nil {
+		return err
+	}
+	return m.persistence.UpdateDynamicConfig(&InternalUpdateDynamicConfigRequest{
+		PreviousVersion: request.PreviousVersion,
+		DynamicConfig:   NewDataBlob(data, dynamicConfigEncoding),
+	})
+}
+
+func (m *clusterMetadataManagerImpl) ListDynamicConfigHistory(request *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("invalid page size %v", request.PageSize)
+	}
+	var maxVersion int64
+	if len(request.NextPageToken) > 0 {
+		if len(request.NextPageToken) != 8 {
+			return nil, errors.New("invalid dynamic config history page token")
+		}
+		maxVersion = int64(binary.BigEndian.Uint64(request.NextPageToken))
+	}
+
+	resp, err := m.persistence.ListDynamicConfigHistory(&InternalListDynamicConfigHistoryRequest{
+		MaxVersion: maxVersion,
+		PageSize:   request.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListDynamicConfigHistoryResponse{}
+	for _, config := range resp.DynamicConfigs {
+		document, err := deserializeDynamicConfig(config.DynamicConfig)
+		if err != nil {
+			return nil, err
+		}
+		change := document.Change
+		if change == nil {
+			change = &DynamicConfigChange{}
+		}
+		change.Version = config.Version
+		result.Changes = append(result.Changes, change)
+	}
+	if len(resp.DynamicConfigs) == request.PageSize {
+		if last := resp.DynamicConfigs[len(resp.DynamicConfigs)-1].Version; last > 1 {
+			result.NextPageToken = make([]byte, 8)
+			binary.BigEndian.PutUint64(result.NextPageToken, uint64(last-1))
+		}
+	}
+	return result, nil
+}
+
+func deserializeDynamicConfig(blob *serialization.DataBlob) (*dynamicConfigDocument, error) {
+	document := &dynamicConfigDocument{}
+	if blob == nil {
+		return document, nil
+	}
+	if blob.Encoding != dynamicConfigEncoding {
+		return nil, NewUnknownEncodingTypeError(blob.Encoding)
+	}
+	if err := json.Unmarshal(blob.Data, document); err != nil {
+		return nil, err
+	}
+	if document.Values == nil {
+		document.Values = make(map[string][]*DynamicConfigValue)
+	}
+	return document, nil
+}
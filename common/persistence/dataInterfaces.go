@@ -68,6 +68,10 @@ const (
 	NamespaceReplicationQueueType QueueType = iota + 1
 )
 
+// VisibilityQueueType is the queue type of the first partition of the visibility queue,
+// the partition N uses the queue type VisibilityQueueType + N
+const VisibilityQueueType QueueType = 1000
+
 // Create Workflow Execution Mode
 const (
 	// Fail if current record exists
@@ -1333,6 +1337,52 @@ type (
 		MaxRecordsPruned int
 	}
 
+	// DynamicConfigValue is a value of a dynamic config key, it applies when its constraints match the filters
+	DynamicConfigValue struct {
+		// Value is the YAML encoded value
+		Value       string            `json:"value"`
+		Constraints map[string]string `json:"constraints,omitempty"`
+	}
+
+	// DynamicConfigChange is a change of the values of a dynamic config key, recorded in the audit trail
+	DynamicConfigChange struct {
+		Version    int64                 `json:"version"`
+		Name       string                `json:"name"`
+		Values     []*DynamicConfigValue `json:"values"`
+		Identity   string                `json:"identity"`
+		Reason     string                `json:"reason"`
+		UpdateTime time.Time             `json:"updateTime"`
+	}
+
+	// GetDynamicConfigResponse is the response to GetDynamicConfig
+	GetDynamicConfigResponse struct {
+		// Version is 0 if the dynamic config was never updated
+		Version int64
+		// Values are the values of the dynamic config keys, by key name
+		Values map[string][]*DynamicConfigValue
+	}
+
+	// UpdateDynamicConfigRequest is the request to UpdateDynamicConfig, the update fails with a
+	// ConditionFailedError if the dynamic config version is not the previous version anymore
+	UpdateDynamicConfigRequest struct {
+		PreviousVersion int64
+		Values          map[string][]*DynamicConfigValue
+		Change          *DynamicConfigChange
+	}
+
+	// ListDynamicConfigHistoryRequest is the request to ListDynamicConfigHistory
+	ListDynamicConfigHistoryRequest struct {
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListDynamicConfigHistoryResponse is the response to ListDynamicConfigHistory, the changes are
+	// ordered from the most recent
+	ListDynamicConfigHistoryResponse struct {
+		Changes       []*DynamicConfigChange
+		NextPageToken []byte
+	}
+
 	// Closeable is an interface for any entity that supports a close operation to release resources
 	Closeable interface {
 		Close()
@@ -1469,6 +1519,9 @@ type (
 		GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error)
 		UpsertClusterMembership(request *UpsertClusterMembershipRequest) error
 		PruneClusterMembership(request *PruneClusterMembershipRequest) error
+		GetDynamicConfig() (*GetDynamicConfigResponse, error)
+		UpdateDynamicConfig(request *UpdateDynamicConfigRequest) error
+		ListDynamicConfigHistory(request *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error)
 	}
 )
 
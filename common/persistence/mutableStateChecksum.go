@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	checksumproto "go.temporal.io/server/api/checksum/v1"
+	"go.temporal.io/server/common"
+)
+
+const (
+	// MutableStateChecksumPayloadV1 is the version of the mutable state checksum payload
+	MutableStateChecksumPayloadV1 = 1
+)
+
+// NewMutableStateChecksumPayload generates the payload the mutable state checksum is computed on
+func NewMutableStateChecksumPayload(state *WorkflowMutableState) *checksumproto.MutableStateChecksumPayload {
+	executionInfo := state.ExecutionInfo
+	payload := &checksumproto.MutableStateChecksumPayload{
+		CancelRequested:      executionInfo.CancelRequested,
+		State:                executionInfo.State,
+		LastFirstEventId:     executionInfo.LastFirstEventID,
+		NextEventId:          executionInfo.NextEventID,
+		LastProcessedEventId: executionInfo.LastProcessedEvent,
+		SignalCount:          int64(executionInfo.SignalCount),
+		DecisionAttempt:      int32(executionInfo.DecisionAttempt),
+		DecisionScheduledId:  executionInfo.DecisionScheduleID,
+		DecisionStartedId:    executionInfo.DecisionStartedID,
+		DecisionVersion:      executionInfo.DecisionVersion,
+		StickyTaskQueueName:  executionInfo.StickyTaskQueue,
+	}
+
+	if state.ReplicationState != nil {
+		payload.LastWriteVersion = state.ReplicationState.LastWriteVersion
+		payload.LastWriteEventId = state.ReplicationState.LastWriteEventID
+	}
+
+	if state.VersionHistories != nil {
+		payload.VersionHistories = state.VersionHistories.ToProto()
+	}
+
+	// for each of the pendingXXX ids below, sorting is needed to guarantee that
+	// same serialized bytes can be generated during verification
+	pendingTimerIDs := make([]int64, 0, len(state.TimerInfos))
+	for _, ti := range state.TimerInfos {
+		pendingTimerIDs = append(pendingTimerIDs, ti.GetStartedId())
+	}
+	common.SortInt64Slice(pendingTimerIDs)
+	payload.PendingTimerStartedIds = pendingTimerIDs
+
+	pendingActivityIDs := make([]int64, 0, len(state.ActivityInfos))
+	for id := range state.ActivityInfos {
+		pendingActivityIDs = append(pendingActivityIDs, id)
+	}
+	common.SortInt64Slice(pendingActivityIDs)
+	payload.PendingActivityScheduledIds = pendingActivityIDs
+
+	pendingChildIDs := make([]int64, 0, len(state.ChildExecutionInfos))
+	for id := range state.ChildExecutionInfos {
+		pendingChildIDs = append(pendingChildIDs, id)
+	}
+	common.SortInt64Slice(pendingChildIDs)
+	payload.PendingChildInitiatedIds = pendingChildIDs
+
+	signalIDs := make([]int64, 0, len(state.SignalInfos))
+	for id := range state.SignalInfos {
+		signalIDs = append(signalIDs, id)
+	}
+	common.SortInt64Slice(signalIDs)
+	payload.PendingSignalInitiatedIds = signalIDs
+
+	requestCancelIDs := make([]int64, 0, len(state.RequestCancelInfos))
+	for id := range state.RequestCancelInfos {
+		requestCancelIDs = append(requestCancelIDs, id)
+	}
+	common.SortInt64Slice(requestCancelIDs)
+	payload.PendingReqCancelInitiatedIds = requestCancelIDs
+	return payload
+}
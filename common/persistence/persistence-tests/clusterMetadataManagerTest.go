@@ -27,6 +27,7 @@ package persistencetests
 import (
 	"net"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -329,3 +330,63 @@ func (s *ClusterMetadataManagerSuite) TestInitImmutableMetadataReadWrite() {
 	s.Equal(getResp.ClusterName, clusterNameToPersist)
 	s.Equal(getResp.HistoryShardCount, historyShardsToPersist)
 }
+
+// TestDynamicConfigReadWrite verifies the dynamic config updates, their version conflicts and their history
+func (s *ClusterMetadataManagerSuite) TestDynamicConfigReadWrite() {
+	getResp, err := s.ClusterMetadataManager.GetDynamicConfig()
+	s.NoError(err)
+	s.Equal(int64(0), getResp.Version)
+	s.Empty(getResp.Values)
+
+	for i := 0; i < 3; i++ {
+		values := map[string][]*p.DynamicConfigValue{
+			"matching.numTaskqueueReadPartitions": {
+				{Value: "4", Constraints: map[string]string{"taskQueueName": "tq"}},
+				{Value: strconv.Itoa(i)},
+			},
+		}
+		err = s.ClusterMetadataManager.UpdateDynamicConfig(&p.UpdateDynamicConfigRequest{
+			PreviousVersion: int64(i),
+			Values:          values,
+			Change: &p.DynamicConfigChange{
+				Name:     "matching.numTaskqueueReadPartitions",
+				Values:   values["matching.numTaskqueueReadPartitions"],
+				Identity: "tester",
+				Reason:   "test " + strconv.Itoa(i),
+			},
+		})
+		s.NoError(err)
+	}
+
+	// the version was updated concurrently
+	err = s.ClusterMetadataManager.UpdateDynamicConfig(&p.UpdateDynamicConfigRequest{
+		PreviousVersion: 1,
+		Change:          &p.DynamicConfigChange{Name: "matching.numTaskqueueReadPartitions"},
+	})
+	s.IsType(&p.ConditionFailedError{}, err)
+
+	getResp, err = s.ClusterMetadataManager.GetDynamicConfig()
+	s.NoError(err)
+	s.Equal(int64(3), getResp.Version)
+	s.Len(getResp.Values["matching.numTaskqueueReadPartitions"], 2)
+	s.Equal("2", getResp.Values["matching.numTaskqueueReadPartitions"][1].Value)
+	s.Equal("tq", getResp.Values["matching.numTaskqueueReadPartitions"][0].Constraints["taskQueueName"])
+
+	historyResp, err := s.ClusterMetadataManager.ListDynamicConfigHistory(&p.ListDynamicConfigHistoryRequest{PageSize: 2})
+	s.NoError(err)
+	s.Len(historyResp.Changes, 2)
+	s.Equal(int64(3), historyResp.Changes[0].Version)
+	s.Equal("test 2", historyResp.Changes[0].Reason)
+	s.Equal("tester", historyResp.Changes[0].Identity)
+	s.Equal(int64(2), historyResp.Changes[1].Version)
+	s.NotEmpty(historyResp.NextPageToken)
+
+	historyResp, err = s.ClusterMetadataManager.ListDynamicConfigHistory(&p.ListDynamicConfigHistoryRequest{
+		PageSize:      2,
+		NextPageToken: historyResp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Len(historyResp.Changes, 1)
+	s.Equal(int64(1), historyResp.Changes[0].Version)
+	s.Empty(historyResp.NextPageToken)
+}
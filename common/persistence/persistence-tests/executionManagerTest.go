@@ -739,6 +739,42 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionBrandNew() {
 	s.Equal(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, alreadyStartedErr.State)
 }
 
+// TestListConcreteExecutions test
+func (s *ExecutionManagerSuite) TestListConcreteExecutions() {
+	namespaceID := uuid.New()
+	expected := make(map[string]struct{})
+	for i := 0; i < 5; i++ {
+		workflowExecution := commonpb.WorkflowExecution{
+			WorkflowId: fmt.Sprintf("list-concrete-executions-test-%v", i),
+			RunId:      uuid.New(),
+		}
+		_, err := s.CreateWorkflowExecution(namespaceID, workflowExecution, "queue1", "wType", 20, 13, 3, 0, 2, nil)
+		s.NoError(err)
+		expected[workflowExecution.GetRunId()] = struct{}{}
+	}
+
+	// other tests may have created executions in the same shard
+	var pageToken []byte
+	for {
+		resp, err := s.ExecutionManager.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  2,
+			PageToken: pageToken,
+		})
+		s.NoError(err)
+		s.True(len(resp.ExecutionInfos) <= 2)
+		for _, info := range resp.ExecutionInfos {
+			if info.NamespaceID == namespaceID {
+				delete(expected, info.RunID)
+			}
+		}
+		pageToken = resp.PageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	s.Empty(expected)
+}
+
 // TestCreateWorkflowExecutionRunIDReuseWithReplication test
 func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionRunIDReuseWithReplication() {
 	namespaceID := uuid.New()
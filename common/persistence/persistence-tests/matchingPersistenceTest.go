@@ -142,6 +142,44 @@ func (s *MatchingPersistenceSuite) TestGetDecisionTasks() {
 	s.Equal(int64(5), tasks1Response.Tasks[0].Data.GetScheduleId())
 }
 
+// TestGetTasksWithPriority test
+func (s *MatchingPersistenceSuite) TestGetTasksWithPriority() {
+	namespaceID := primitives.MustValidateUUID("0c41a5a2-6b8b-4bfb-a1f2-0e0a4b0d4c7e")
+	taskQueue := "priority-task-queue"
+	leaseResponse, err := s.TaskMgr.LeaseTaskQueue(&p.LeaseTaskQueueRequest{
+		NamespaceID: namespaceID,
+		TaskQueue:   taskQueue,
+		TaskType:    enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	s.NoError(err)
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(&p.CreateTasksRequest{
+		TaskQueueInfo: leaseResponse.TaskQueueInfo,
+		Tasks: []*persistenceblobs.AllocatedTaskInfo{
+			{
+				TaskId: taskID,
+				Data: &persistenceblobs.TaskInfo{
+					NamespaceId: namespaceID,
+					WorkflowId:  "get-tasks-with-priority-test",
+					RunId:       "2d4a1b1c-9a6b-4e0e-8d8e-0d3f4c3e9a11",
+					ScheduleId:  5,
+					CreatedTime: types.TimestampNow(),
+					Priority:    3,
+					FairnessKey: "tenant-a",
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	response, err := s.GetTasks(namespaceID, taskQueue, enumspb.TASK_QUEUE_TYPE_ACTIVITY, 1)
+	s.NoError(err)
+	s.Equal(1, len(response.Tasks))
+	s.Equal(int32(3), response.Tasks[0].Data.GetPriority())
+	s.Equal("tenant-a", response.Tasks[0].Data.GetFairnessKey())
+}
+
 // TestGetTasksWithNoMaxReadLevel test
 func (s *MatchingPersistenceSuite) TestGetTasksWithNoMaxReadLevel() {
 	if s.TaskMgr.GetName() == "cassandra" {
@@ -25,6 +25,7 @@
 package persistencetests
 
 import (
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -677,6 +678,59 @@ func (s *VisibilityPersistenceSuite) TestDelete() {
 	}
 }
 
+// TestListWorkflowExecutionsByQuery test
+func (s *VisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	if s.VisibilityMgr.GetName() == "cassandra" {
+		s.T().Skip("this test is not applicable for cassandra")
+	}
+	testNamespaceUUID := uuid.New()
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	for i := 0; i < 3; i++ {
+		intField, err := payload.Encode(i)
+		s.Nil(err)
+		err = s.VisibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+			NamespaceID: testNamespaceUUID,
+			Execution: commonpb.WorkflowExecution{
+				WorkflowId: uuid.New(),
+				RunId:      uuid.New(),
+			},
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime + int64(i),
+			SearchAttributes: map[string]*commonpb.Payload{
+				definition.CustomIntField: intField,
+			},
+		})
+		s.Nil(err)
+	}
+
+	query := fmt.Sprintf("WorkflowType = 'visibility-workflow' and `%s.%s` >= 1", definition.Attr, definition.CustomIntField)
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceUUID,
+		PageSize:    1,
+		Query:       query,
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.NotNil(resp.NextPageToken)
+
+	resp, err = s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   testNamespaceUUID,
+		PageSize:      10,
+		NextPageToken: resp.NextPageToken,
+		Query:         query,
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Nil(resp.NextPageToken)
+
+	countResp, err := s.VisibilityMgr.CountWorkflowExecutions(&p.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceUUID,
+		Query:       "CloseTime = missing",
+	})
+	s.Nil(err)
+	s.Equal(int64(3), countResp.Count)
+}
+
 // TestUpsertWorkflowExecution test
 func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	tests := []struct {
@@ -718,10 +772,84 @@ func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	}
 
 	for _, test := range tests {
+		if test.expected != nil && s.VisibilityMgr.GetName() != "cassandra" {
+			// only cassandra doesn't support upserting search attributes
+			continue
+		}
 		s.Equal(test.expected, s.VisibilityMgr.UpsertWorkflowExecution(test.request))
 	}
 }
 
+// TestUpsertWorkflowExecutionThenQuery test
+func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecutionThenQuery() {
+	if s.VisibilityMgr.GetName() == "cassandra" {
+		s.T().Skip("this test is not applicable for cassandra")
+	}
+	testNamespaceUUID := uuid.New()
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "visibility-upsert-workflow-test",
+		RunId:      "a3dbc7bf-deb1-4946-b57c-cf0615ea553f",
+	}
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	keywordField, err := payload.Encode("before")
+	s.Nil(err)
+	err = s.VisibilityMgr.RecordWorkflowExecutionStarted(&p.RecordWorkflowExecutionStartedRequest{
+		NamespaceID:      testNamespaceUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string]*commonpb.Payload{
+			definition.CustomKeywordField: keywordField,
+		},
+	})
+	s.Nil(err)
+
+	keywordField, err = payload.Encode("after")
+	s.Nil(err)
+	err = s.VisibilityMgr.UpsertWorkflowExecution(&p.UpsertWorkflowExecutionRequest{
+		NamespaceID:      testNamespaceUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string]*commonpb.Payload{
+			definition.CustomKeywordField: keywordField,
+		},
+	})
+	s.Nil(err)
+
+	for value, expected := range map[string]int{"before": 0, "after": 1} {
+		resp, err := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+			NamespaceID: testNamespaceUUID,
+			PageSize:    10,
+			Query:       fmt.Sprintf("`%s.%s` = '%s'", definition.Attr, definition.CustomKeywordField, value),
+		})
+		s.Nil(err)
+		s.Equal(expected, len(resp.Executions))
+	}
+
+	// upserting before the started record is written creates the row
+	otherExecution := commonpb.WorkflowExecution{
+		WorkflowId: "visibility-upsert-workflow-test-2",
+		RunId:      "4f8dbd2a-0b6b-4b6f-a2b5-4c69d0e3f0a6",
+	}
+	err = s.VisibilityMgr.UpsertWorkflowExecution(&p.UpsertWorkflowExecutionRequest{
+		NamespaceID:      testNamespaceUUID,
+		Execution:        otherExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string]*commonpb.Payload{
+			definition.CustomKeywordField: keywordField,
+		},
+	})
+	s.Nil(err)
+	countResp, err := s.VisibilityMgr.CountWorkflowExecutions(&p.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceUUID,
+		Query:       fmt.Sprintf("`%s.%s` = 'after'", definition.Attr, definition.CustomKeywordField),
+	})
+	s.Nil(err)
+	s.Equal(int64(2), countResp.Count)
+}
+
 func (s *VisibilityPersistenceSuite) assertClosedExecutionEquals(
 	req *p.RecordWorkflowExecutionClosedRequest, resp *workflowpb.WorkflowExecutionInfo) {
 	s.Equal(req.Execution.RunId, resp.Execution.RunId)
@@ -79,6 +79,10 @@ type (
 		GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error)
 		UpsertClusterMembership(request *UpsertClusterMembershipRequest) error
 		PruneClusterMembership(request *PruneClusterMembershipRequest) error
+		// Dynamic config APIs
+		GetDynamicConfig() (*InternalGetDynamicConfigResponse, error)
+		UpdateDynamicConfig(request *InternalUpdateDynamicConfigRequest) error
+		ListDynamicConfigHistory(request *InternalListDynamicConfigHistoryRequest) (*InternalListDynamicConfigHistoryResponse, error)
 	}
 
 	// ExecutionStore is used to manage workflow executions for Persistence layer
@@ -683,6 +687,34 @@ type (
 		ImmutableClusterMetadata *serialization.DataBlob
 	}
 
+	// InternalGetDynamicConfigResponse is the response to GetDynamicConfig
+	InternalGetDynamicConfigResponse struct {
+		// Version is 0 and DynamicConfig is nil if the dynamic config was never updated
+		Version       int64
+		DynamicConfig *serialization.DataBlob
+	}
+
+	// InternalUpdateDynamicConfigRequest is the request to UpdateDynamicConfig. The new version
+	// of the dynamic config is PreviousVersion+1, it is inserted if it doesn't exist yet.
+	InternalUpdateDynamicConfigRequest struct {
+		PreviousVersion int64
+		// Serialized dynamic config, including the change of the version
+		DynamicConfig *serialization.DataBlob
+	}
+
+	// InternalListDynamicConfigHistoryRequest is the request to ListDynamicConfigHistory
+	InternalListDynamicConfigHistoryRequest struct {
+		// MaxVersion is the version of the first listed dynamic config, the most recent if 0
+		MaxVersion int64
+		PageSize   int
+	}
+
+	// InternalListDynamicConfigHistoryResponse is the response to ListDynamicConfigHistory,
+	// the dynamic config versions are ordered from the most recent
+	InternalListDynamicConfigHistoryResponse struct {
+		DynamicConfigs []*InternalGetDynamicConfigResponse
+	}
+
 	// InternalUpsertClusterMembershipRequest is the request to UpsertClusterMembership
 	InternalUpsertClusterMembershipRequest struct {
 		ClusterMember
@@ -1473,6 +1473,48 @@ func (c *clusterMetadataPersistenceClient) PruneClusterMembership(request *Prune
 	return err
 }
 
+func (c *clusterMetadataPersistenceClient) GetDynamicConfig() (*GetDynamicConfigResponse, error) {
+	c.metricClient.IncCounter(metrics.PersistenceGetDynamicConfigScope, metrics.PersistenceRequests)
+
+	sw := c.metricClient.StartTimer(metrics.PersistenceGetDynamicConfigScope, metrics.PersistenceLatency)
+	result, err := c.persistence.GetDynamicConfig()
+	sw.Stop()
+
+	if err != nil {
+		c.metricClient.IncCounter(metrics.PersistenceGetDynamicConfigScope, metrics.PersistenceFailures)
+	}
+
+	return result, err
+}
+
+func (c *clusterMetadataPersistenceClient) UpdateDynamicConfig(request *UpdateDynamicConfigRequest) error {
+	c.metricClient.IncCounter(metrics.PersistenceUpdateDynamicConfigScope, metrics.PersistenceRequests)
+
+	sw := c.metricClient.StartTimer(metrics.PersistenceUpdateDynamicConfigScope, metrics.PersistenceLatency)
+	err := c.persistence.UpdateDynamicConfig(request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricClient.IncCounter(metrics.PersistenceUpdateDynamicConfigScope, metrics.PersistenceFailures)
+	}
+
+	return err
+}
+
+func (c *clusterMetadataPersistenceClient) ListDynamicConfigHistory(request *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error) {
+	c.metricClient.IncCounter(metrics.PersistenceListDynamicConfigHistoryScope, metrics.PersistenceRequests)
+
+	sw := c.metricClient.StartTimer(metrics.PersistenceListDynamicConfigHistoryScope, metrics.PersistenceLatency)
+	result, err := c.persistence.ListDynamicConfigHistory(request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricClient.IncCounter(metrics.PersistenceListDynamicConfigHistoryScope, metrics.PersistenceFailures)
+	}
+
+	return result, err
+}
+
 func (c *metadataPersistenceClient) InitializeSystemNamespaces(currentClusterName string) error {
 	c.metricClient.IncCounter(metrics.PersistenceInitializeSystemNamespaceScope, metrics.PersistenceRequests)
 
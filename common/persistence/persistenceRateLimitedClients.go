@@ -937,6 +937,27 @@ func (c *clusterMetadataRateLimitedPersistenceClient) PruneClusterMembership(req
 	return c.persistence.PruneClusterMembership(request)
 }
 
+func (c *clusterMetadataRateLimitedPersistenceClient) GetDynamicConfig() (*GetDynamicConfigResponse, error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return c.persistence.GetDynamicConfig()
+}
+
+func (c *clusterMetadataRateLimitedPersistenceClient) UpdateDynamicConfig(request *UpdateDynamicConfigRequest) error {
+	if ok := c.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	return c.persistence.UpdateDynamicConfig(request)
+}
+
+func (c *clusterMetadataRateLimitedPersistenceClient) ListDynamicConfigHistory(request *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return c.persistence.ListDynamicConfigHistory(request)
+}
+
 func (c *metadataRateLimitedPersistenceClient) InitializeSystemNamespaces(currentClusterName string) error {
 	if ok := c.rateLimiter.Allow(); !ok {
 		return ErrPersistenceLimitExceeded
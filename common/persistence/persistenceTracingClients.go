@@ -0,0 +1,968 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+)
+
+// Persistence APIs do not take a context, so these clients only record spans once they
+// are bound to the context of a request with one of the *WithContext functions. A span
+// is recorded as a child of the span in that context and no span is recorded if the
+// context has none, e.g. for calls made by background processing.
+const (
+	persistenceSpanPrefix = "persistence."
+
+	persistenceManagerTag = "persistence.manager"
+	persistenceShardIDTag = "persistence.shard_id"
+)
+
+type (
+	shardTracingClient struct {
+		ctx         context.Context
+		persistence ShardManager
+	}
+
+	workflowExecutionTracingClient struct {
+		ctx         context.Context
+		persistence ExecutionManager
+	}
+
+	taskTracingClient struct {
+		ctx         context.Context
+		persistence TaskManager
+	}
+
+	historyV2TracingClient struct {
+		ctx         context.Context
+		persistence HistoryManager
+	}
+
+	metadataTracingClient struct {
+		ctx         context.Context
+		persistence MetadataManager
+	}
+
+	clusterMetadataTracingClient struct {
+		ctx         context.Context
+		persistence ClusterMetadataManager
+	}
+
+	visibilityTracingClient struct {
+		ctx         context.Context
+		persistence VisibilityManager
+	}
+
+	queueTracingClient struct {
+		ctx         context.Context
+		persistence Queue
+	}
+)
+
+var _ ShardManager = (*shardTracingClient)(nil)
+var _ ExecutionManager = (*workflowExecutionTracingClient)(nil)
+var _ TaskManager = (*taskTracingClient)(nil)
+var _ HistoryManager = (*historyV2TracingClient)(nil)
+var _ MetadataManager = (*metadataTracingClient)(nil)
+var _ ClusterMetadataManager = (*clusterMetadataTracingClient)(nil)
+var _ VisibilityManager = (*visibilityTracingClient)(nil)
+var _ Queue = (*queueTracingClient)(nil)
+
+// NewShardPersistenceTracingClient creates a client to manage shards which records spans once bound to a request context
+func NewShardPersistenceTracingClient(persistence ShardManager) ShardManager {
+	return &shardTracingClient{
+		persistence: persistence,
+	}
+}
+
+// NewWorkflowExecutionPersistenceTracingClient creates a client to manage executions which records spans once bound to a request context
+func NewWorkflowExecutionPersistenceTracingClient(persistence ExecutionManager) ExecutionManager {
+	return &workflowExecutionTracingClient{
+		persistence: persistence,
+	}
+}
+
+// NewTaskPersistenceTracingClient creates a client to manage tasks which records spans once bound to a request context
+func NewTaskPersistenceTracingClient(persistence TaskManager) TaskManager {
+	return &taskTracingClient{
+		persistence: persistence,
+	}
+}
+
+// NewHistoryV2PersistenceTracingClient creates a HistoryManager client to manage workflow execution history which records spans once bound to a request context
+func NewHistoryV2PersistenceTracingClient(persistence HistoryManager) HistoryManager {
+	return &historyV2TracingClient{
+		persistence: persistence,
+	}
+}
+
+// NewMetadataPersistenceTracingClient creates a MetadataManager client to manage metadata which records spans once bound to a request context
+func NewMetadataPersistenceTracingClient(persistence MetadataManager) MetadataManager {
+	return &metadataTracingClient{
+		persistence: persistence,
+	}
+}
+
+// NewClusterMetadataPersistenceTracingClient creates a ClusterMetadataManager client to manage cluster metadata which records spans once bound to a request context
+func NewClusterMetadataPersistenceTracingClient(persistence ClusterMetadataManager) ClusterMetadataManager {
+	return &clusterMetadataTracingClient{
+		persistence: persistence,
+	}
+}
+
+// NewVisibilityPersistenceTracingClient creates a client to manage visibility which records spans once bound to a request context
+func NewVisibilityPersistenceTracingClient(persistence VisibilityManager) VisibilityManager {
+	return &visibilityTracingClient{
+		persistence: persistence,
+	}
+}
+
+// NewQueuePersistenceTracingClient creates a client to manage queue which records spans once bound to a request context
+func NewQueuePersistenceTracingClient(persistence Queue) Queue {
+	return &queueTracingClient{
+		persistence: persistence,
+	}
+}
+
+// ShardManagerWithContext returns a shard manager which records its calls as children of the span in ctx,
+// the manager is returned as is if it does not record spans
+func ShardManagerWithContext(ctx context.Context, persistence ShardManager) ShardManager {
+	if client, ok := persistence.(*shardTracingClient); ok {
+		return &shardTracingClient{ctx: ctx, persistence: client.persistence}
+	}
+	return persistence
+}
+
+// ExecutionManagerWithContext returns an execution manager which records its calls as children of the span in ctx,
+// the manager is returned as is if it does not record spans
+func ExecutionManagerWithContext(ctx context.Context, persistence ExecutionManager) ExecutionManager {
+	if client, ok := persistence.(*workflowExecutionTracingClient); ok {
+		return &workflowExecutionTracingClient{ctx: ctx, persistence: client.persistence}
+	}
+	return persistence
+}
+
+// TaskManagerWithContext returns a task manager which records its calls as children of the span in ctx,
+// the manager is returned as is if it does not record spans
+func TaskManagerWithContext(ctx context.Context, persistence TaskManager) TaskManager {
+	if client, ok := persistence.(*taskTracingClient); ok {
+		return &taskTracingClient{ctx: ctx, persistence: client.persistence}
+	}
+	return persistence
+}
+
+// HistoryManagerWithContext returns a history manager which records its calls as children of the span in ctx,
+// the manager is returned as is if it does not record spans
+func HistoryManagerWithContext(ctx context.Context, persistence HistoryManager) HistoryManager {
+	if client, ok := persistence.(*historyV2TracingClient); ok {
+		return &historyV2TracingClient{ctx: ctx, persistence: client.persistence}
+	}
+	return persistence
+}
+
+// MetadataManagerWithContext returns a metadata manager which records its calls as children of the span in ctx,
+// the manager is returned as is if it does not record spans
+func MetadataManagerWithContext(ctx context.Context, persistence MetadataManager) MetadataManager {
+	if client, ok := persistence.(*metadataTracingClient); ok {
+		return &metadataTracingClient{ctx: ctx, persistence: client.persistence}
+	}
+	return persistence
+}
+
+// ClusterMetadataManagerWithContext returns a cluster metadata manager which records its calls as children of the span in ctx,
+// the manager is returned as is if it does not record spans
+func ClusterMetadataManagerWithContext(ctx context.Context, persistence ClusterMetadataManager) ClusterMetadataManager {
+	if client, ok := persistence.(*clusterMetadataTracingClient); ok {
+		return &clusterMetadataTracingClient{ctx: ctx, persistence: client.persistence}
+	}
+	return persistence
+}
+
+// VisibilityManagerWithContext returns a visibility manager which records its calls as children of the span in ctx,
+// the manager is returned as is if it does not record spans
+func VisibilityManagerWithContext(ctx context.Context, persistence VisibilityManager) VisibilityManager {
+	if client, ok := persistence.(*visibilityTracingClient); ok {
+		return &visibilityTracingClient{ctx: ctx, persistence: client.persistence}
+	}
+	if wrapper, ok := persistence.(*visibilityManagerWrapper); ok {
+		bound := *wrapper
+		bound.visibilityManager = VisibilityManagerWithContext(ctx, wrapper.visibilityManager)
+		bound.esVisibilityManager = VisibilityManagerWithContext(ctx, wrapper.esVisibilityManager)
+		return &bound
+	}
+	return persistence
+}
+
+// QueueWithContext returns a queue which records its calls as children of the span in ctx,
+// the queue is returned as is if it does not record spans
+func QueueWithContext(ctx context.Context, persistence Queue) Queue {
+	if client, ok := persistence.(*queueTracingClient); ok {
+		return &queueTracingClient{ctx: ctx, persistence: client.persistence}
+	}
+	return persistence
+}
+
+func (p *shardTracingClient) startSpan(operation string) opentracing.Span {
+	return startPersistenceSpan(p.ctx, operation, opentracing.Tags{
+		persistenceManagerTag: "ShardManager",
+	})
+}
+
+func (p *shardTracingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardTracingClient) CreateShard(request *CreateShardRequest) error {
+	span := p.startSpan("CreateShard")
+	err := p.persistence.CreateShard(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *shardTracingClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	span := p.startSpan("GetShard")
+	response, err := p.persistence.GetShard(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *shardTracingClient) UpdateShard(request *UpdateShardRequest) error {
+	span := p.startSpan("UpdateShard")
+	err := p.persistence.UpdateShard(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *shardTracingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionTracingClient) startSpan(operation string) opentracing.Span {
+	return startPersistenceSpan(p.ctx, operation, opentracing.Tags{
+		persistenceManagerTag: "ExecutionManager",
+		persistenceShardIDTag: p.persistence.GetShardID(),
+	})
+}
+
+func (p *workflowExecutionTracingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionTracingClient) GetShardID() int {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionTracingClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	span := p.startSpan("CreateWorkflowExecution")
+	response, err := p.persistence.CreateWorkflowExecution(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	span := p.startSpan("GetWorkflowExecution")
+	response, err := p.persistence.GetWorkflowExecution(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	span := p.startSpan("UpdateWorkflowExecution")
+	response, err := p.persistence.UpdateWorkflowExecution(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
+	span := p.startSpan("ConflictResolveWorkflowExecution")
+	err := p.persistence.ConflictResolveWorkflowExecution(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+	span := p.startSpan("ResetWorkflowExecution")
+	err := p.persistence.ResetWorkflowExecution(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	span := p.startSpan("DeleteWorkflowExecution")
+	err := p.persistence.DeleteWorkflowExecution(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	span := p.startSpan("DeleteCurrentWorkflowExecution")
+	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	span := p.startSpan("GetCurrentExecution")
+	response, err := p.persistence.GetCurrentExecution(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	span := p.startSpan("ListConcreteExecutions")
+	response, err := p.persistence.ListConcreteExecutions(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) GetTransferTask(request *GetTransferTaskRequest) (*GetTransferTaskResponse, error) {
+	span := p.startSpan("GetTransferTask")
+	response, err := p.persistence.GetTransferTask(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	span := p.startSpan("GetTransferTasks")
+	response, err := p.persistence.GetTransferTasks(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) GetReplicationTask(request *GetReplicationTaskRequest) (*GetReplicationTaskResponse, error) {
+	span := p.startSpan("GetReplicationTask")
+	response, err := p.persistence.GetReplicationTask(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	span := p.startSpan("GetReplicationTasks")
+	response, err := p.persistence.GetReplicationTasks(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	span := p.startSpan("CompleteTransferTask")
+	err := p.persistence.CompleteTransferTask(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	span := p.startSpan("RangeCompleteTransferTask")
+	err := p.persistence.RangeCompleteTransferTask(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	span := p.startSpan("CompleteReplicationTask")
+	err := p.persistence.CompleteReplicationTask(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) RangeCompleteReplicationTask(request *RangeCompleteReplicationTaskRequest) error {
+	span := p.startSpan("RangeCompleteReplicationTask")
+	err := p.persistence.RangeCompleteReplicationTask(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error {
+	span := p.startSpan("PutReplicationTaskToDLQ")
+	err := p.persistence.PutReplicationTaskToDLQ(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) GetReplicationTasksFromDLQ(request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error) {
+	span := p.startSpan("GetReplicationTasksFromDLQ")
+	response, err := p.persistence.GetReplicationTasksFromDLQ(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error {
+	span := p.startSpan("DeleteReplicationTaskFromDLQ")
+	err := p.persistence.DeleteReplicationTaskFromDLQ(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) RangeDeleteReplicationTaskFromDLQ(request *RangeDeleteReplicationTaskFromDLQRequest) error {
+	span := p.startSpan("RangeDeleteReplicationTaskFromDLQ")
+	err := p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) GetTimerTask(request *GetTimerTaskRequest) (*GetTimerTaskResponse, error) {
+	span := p.startSpan("GetTimerTask")
+	response, err := p.persistence.GetTimerTask(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	span := p.startSpan("GetTimerIndexTasks")
+	response, err := p.persistence.GetTimerIndexTasks(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	span := p.startSpan("CompleteTimerTask")
+	err := p.persistence.CompleteTimerTask(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	span := p.startSpan("RangeCompleteTimerTask")
+	err := p.persistence.RangeCompleteTimerTask(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskTracingClient) startSpan(operation string) opentracing.Span {
+	return startPersistenceSpan(p.ctx, operation, opentracing.Tags{
+		persistenceManagerTag: "TaskManager",
+	})
+}
+
+func (p *taskTracingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskTracingClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	span := p.startSpan("CreateTasks")
+	response, err := p.persistence.CreateTasks(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	span := p.startSpan("GetTasks")
+	response, err := p.persistence.GetTasks(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingClient) CompleteTask(request *CompleteTaskRequest) error {
+	span := p.startSpan("CompleteTask")
+	err := p.persistence.CompleteTask(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *taskTracingClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	span := p.startSpan("CompleteTasksLessThan")
+	response, err := p.persistence.CompleteTasksLessThan(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingClient) LeaseTaskQueue(request *LeaseTaskQueueRequest) (*LeaseTaskQueueResponse, error) {
+	span := p.startSpan("LeaseTaskQueue")
+	response, err := p.persistence.LeaseTaskQueue(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingClient) ListTaskQueue(request *ListTaskQueueRequest) (*ListTaskQueueResponse, error) {
+	span := p.startSpan("ListTaskQueue")
+	response, err := p.persistence.ListTaskQueue(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingClient) DeleteTaskQueue(request *DeleteTaskQueueRequest) error {
+	span := p.startSpan("DeleteTaskQueue")
+	err := p.persistence.DeleteTaskQueue(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *taskTracingClient) UpdateTaskQueue(request *UpdateTaskQueueRequest) (*UpdateTaskQueueResponse, error) {
+	span := p.startSpan("UpdateTaskQueue")
+	response, err := p.persistence.UpdateTaskQueue(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2TracingClient) startSpan(operation string) opentracing.Span {
+	return startPersistenceSpan(p.ctx, operation, opentracing.Tags{
+		persistenceManagerTag: "HistoryManager",
+	})
+}
+
+func (p *historyV2TracingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyV2TracingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2TracingClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	span := p.startSpan("AppendHistoryNodes")
+	response, err := p.persistence.AppendHistoryNodes(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *historyV2TracingClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	span := p.startSpan("ReadHistoryBranch")
+	response, err := p.persistence.ReadHistoryBranch(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *historyV2TracingClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	span := p.startSpan("ReadHistoryBranchByBatch")
+	response, err := p.persistence.ReadHistoryBranchByBatch(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *historyV2TracingClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	span := p.startSpan("ReadRawHistoryBranch")
+	response, err := p.persistence.ReadRawHistoryBranch(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *historyV2TracingClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	span := p.startSpan("ForkHistoryBranch")
+	response, err := p.persistence.ForkHistoryBranch(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *historyV2TracingClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	span := p.startSpan("DeleteHistoryBranch")
+	err := p.persistence.DeleteHistoryBranch(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *historyV2TracingClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	span := p.startSpan("GetAllHistoryTreeBranches")
+	response, err := p.persistence.GetAllHistoryTreeBranches(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *historyV2TracingClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	span := p.startSpan("GetHistoryTree")
+	response, err := p.persistence.GetHistoryTree(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *metadataTracingClient) startSpan(operation string) opentracing.Span {
+	return startPersistenceSpan(p.ctx, operation, opentracing.Tags{
+		persistenceManagerTag: "MetadataManager",
+	})
+}
+
+func (p *metadataTracingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataTracingClient) CreateNamespace(request *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	span := p.startSpan("CreateNamespace")
+	response, err := p.persistence.CreateNamespace(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *metadataTracingClient) GetNamespace(request *GetNamespaceRequest) (*GetNamespaceResponse, error) {
+	span := p.startSpan("GetNamespace")
+	response, err := p.persistence.GetNamespace(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *metadataTracingClient) UpdateNamespace(request *UpdateNamespaceRequest) error {
+	span := p.startSpan("UpdateNamespace")
+	err := p.persistence.UpdateNamespace(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *metadataTracingClient) DeleteNamespace(request *DeleteNamespaceRequest) error {
+	span := p.startSpan("DeleteNamespace")
+	err := p.persistence.DeleteNamespace(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *metadataTracingClient) DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error {
+	span := p.startSpan("DeleteNamespaceByName")
+	err := p.persistence.DeleteNamespaceByName(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *metadataTracingClient) ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	span := p.startSpan("ListNamespaces")
+	response, err := p.persistence.ListNamespaces(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *metadataTracingClient) GetMetadata() (*GetMetadataResponse, error) {
+	span := p.startSpan("GetMetadata")
+	response, err := p.persistence.GetMetadata()
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *metadataTracingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataTracingClient) InitializeSystemNamespaces(currentClusterName string) error {
+	span := p.startSpan("InitializeSystemNamespaces")
+	err := p.persistence.InitializeSystemNamespaces(currentClusterName)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *clusterMetadataTracingClient) startSpan(operation string) opentracing.Span {
+	return startPersistenceSpan(p.ctx, operation, opentracing.Tags{
+		persistenceManagerTag: "ClusterMetadataManager",
+	})
+}
+
+func (p *clusterMetadataTracingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *clusterMetadataTracingClient) GetImmutableClusterMetadata() (*GetImmutableClusterMetadataResponse, error) {
+	span := p.startSpan("GetImmutableClusterMetadata")
+	response, err := p.persistence.GetImmutableClusterMetadata()
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *clusterMetadataTracingClient) InitializeImmutableClusterMetadata(request *InitializeImmutableClusterMetadataRequest) (*InitializeImmutableClusterMetadataResponse, error) {
+	span := p.startSpan("InitializeImmutableClusterMetadata")
+	response, err := p.persistence.InitializeImmutableClusterMetadata(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingClient) GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error) {
+	span := p.startSpan("GetClusterMembers")
+	response, err := p.persistence.GetClusterMembers(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingClient) UpsertClusterMembership(request *UpsertClusterMembershipRequest) error {
+	span := p.startSpan("UpsertClusterMembership")
+	err := p.persistence.UpsertClusterMembership(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *clusterMetadataTracingClient) PruneClusterMembership(request *PruneClusterMembershipRequest) error {
+	span := p.startSpan("PruneClusterMembership")
+	err := p.persistence.PruneClusterMembership(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *clusterMetadataTracingClient) GetDynamicConfig() (*GetDynamicConfigResponse, error) {
+	span := p.startSpan("GetDynamicConfig")
+	resp, err := p.persistence.GetDynamicConfig()
+	finishSpan(span, err)
+	return resp, err
+}
+
+func (p *clusterMetadataTracingClient) UpdateDynamicConfig(request *UpdateDynamicConfigRequest) error {
+	span := p.startSpan("UpdateDynamicConfig")
+	err := p.persistence.UpdateDynamicConfig(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *clusterMetadataTracingClient) ListDynamicConfigHistory(request *ListDynamicConfigHistoryRequest) (*ListDynamicConfigHistoryResponse, error) {
+	span := p.startSpan("ListDynamicConfigHistory")
+	resp, err := p.persistence.ListDynamicConfigHistory(request)
+	finishSpan(span, err)
+	return resp, err
+}
+
+func (p *visibilityTracingClient) startSpan(operation string) opentracing.Span {
+	return startPersistenceSpan(p.ctx, operation, opentracing.Tags{
+		persistenceManagerTag: "VisibilityManager",
+	})
+}
+
+func (p *visibilityTracingClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityTracingClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	span := p.startSpan("RecordWorkflowExecutionStarted")
+	err := p.persistence.RecordWorkflowExecutionStarted(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *visibilityTracingClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	span := p.startSpan("RecordWorkflowExecutionClosed")
+	err := p.persistence.RecordWorkflowExecutionClosed(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *visibilityTracingClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	span := p.startSpan("UpsertWorkflowExecution")
+	err := p.persistence.UpsertWorkflowExecution(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *visibilityTracingClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListOpenWorkflowExecutions")
+	response, err := p.persistence.ListOpenWorkflowExecutions(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListClosedWorkflowExecutions")
+	response, err := p.persistence.ListClosedWorkflowExecutions(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListOpenWorkflowExecutionsByType")
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListClosedWorkflowExecutionsByType")
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListOpenWorkflowExecutionsByWorkflowID")
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListClosedWorkflowExecutionsByWorkflowID")
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListClosedWorkflowExecutionsByStatus")
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	span := p.startSpan("GetClosedWorkflowExecution")
+	response, err := p.persistence.GetClosedWorkflowExecution(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	span := p.startSpan("DeleteWorkflowExecution")
+	err := p.persistence.DeleteWorkflowExecution(request)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *visibilityTracingClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListWorkflowExecutions")
+	response, err := p.persistence.ListWorkflowExecutions(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ScanWorkflowExecutions")
+	response, err := p.persistence.ScanWorkflowExecutions(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	span := p.startSpan("CountWorkflowExecutions")
+	response, err := p.persistence.CountWorkflowExecutions(request)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *queueTracingClient) startSpan(operation string) opentracing.Span {
+	return startPersistenceSpan(p.ctx, operation, opentracing.Tags{
+		persistenceManagerTag: "Queue",
+	})
+}
+
+func (p *queueTracingClient) EnqueueMessage(message []byte) error {
+	span := p.startSpan("EnqueueMessage")
+	err := p.persistence.EnqueueMessage(message)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *queueTracingClient) ReadMessages(lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
+	span := p.startSpan("ReadMessages")
+	response, err := p.persistence.ReadMessages(lastMessageID, maxCount)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *queueTracingClient) UpdateAckLevel(messageID int64, clusterName string) error {
+	span := p.startSpan("UpdateAckLevel")
+	err := p.persistence.UpdateAckLevel(messageID, clusterName)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *queueTracingClient) GetAckLevels() (map[string]int64, error) {
+	span := p.startSpan("GetAckLevels")
+	response, err := p.persistence.GetAckLevels()
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *queueTracingClient) DeleteMessagesBefore(messageID int64) error {
+	span := p.startSpan("DeleteMessagesBefore")
+	err := p.persistence.DeleteMessagesBefore(messageID)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *queueTracingClient) EnqueueMessageToDLQ(message []byte) (int64, error) {
+	span := p.startSpan("EnqueueMessageToDLQ")
+	response, err := p.persistence.EnqueueMessageToDLQ(message)
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *queueTracingClient) ReadMessagesFromDLQ(firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*QueueMessage, []byte, error) {
+	span := p.startSpan("ReadMessagesFromDLQ")
+	response, token, err := p.persistence.ReadMessagesFromDLQ(firstMessageID, lastMessageID, pageSize, pageToken)
+	finishSpan(span, err)
+	return response, token, err
+}
+
+func (p *queueTracingClient) DeleteMessageFromDLQ(messageID int64) error {
+	span := p.startSpan("DeleteMessageFromDLQ")
+	err := p.persistence.DeleteMessageFromDLQ(messageID)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *queueTracingClient) RangeDeleteMessagesFromDLQ(firstMessageID int64, lastMessageID int64) error {
+	span := p.startSpan("RangeDeleteMessagesFromDLQ")
+	err := p.persistence.RangeDeleteMessagesFromDLQ(firstMessageID, lastMessageID)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *queueTracingClient) UpdateDLQAckLevel(messageID int64, clusterName string) error {
+	span := p.startSpan("UpdateDLQAckLevel")
+	err := p.persistence.UpdateDLQAckLevel(messageID, clusterName)
+	finishSpan(span, err)
+	return err
+}
+
+func (p *queueTracingClient) GetDLQAckLevels() (map[string]int64, error) {
+	span := p.startSpan("GetDLQAckLevels")
+	response, err := p.persistence.GetDLQAckLevels()
+	finishSpan(span, err)
+	return response, err
+}
+
+func (p *queueTracingClient) Close() {
+	p.persistence.Close()
+}
+
+func startPersistenceSpan(ctx context.Context, operation string, tags opentracing.Tags) opentracing.Span {
+	if ctx == nil {
+		return nil
+	}
+	parent := opentracing.SpanFromContext(ctx)
+	if parent == nil {
+		return nil
+	}
+	span := opentracing.StartSpan(persistenceSpanPrefix+operation, opentracing.ChildOf(parent.Context()), tags, ext.SpanKindRPCClient)
+	ext.Component.Set(span, "persistence")
+	return span
+}
+
+func finishSpan(span opentracing.Span, err error) {
+	if span == nil {
+		return
+	}
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogFields(otlog.Error(err))
+	}
+	span.Finish()
+}
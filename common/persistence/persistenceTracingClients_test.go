@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	persistenceTracingClientSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		tracer *mocktracer.MockTracer
+		client ShardManager
+	}
+
+	fakeShardManager struct {
+		err error
+	}
+)
+
+func TestPersistenceTracingClientSuite(t *testing.T) {
+	s := new(persistenceTracingClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *persistenceTracingClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.tracer = mocktracer.New()
+	opentracing.SetGlobalTracer(s.tracer)
+	s.client = NewShardPersistenceTracingClient(&fakeShardManager{})
+}
+
+func (s *persistenceTracingClientSuite) TearDownTest() {
+	opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+}
+
+func (s *persistenceTracingClientSuite) TestNoSpanWithoutParent() {
+	s.NoError(s.client.UpdateShard(&UpdateShardRequest{}))
+	s.NoError(ShardManagerWithContext(context.Background(), s.client).UpdateShard(&UpdateShardRequest{}))
+	s.Empty(s.tracer.FinishedSpans())
+}
+
+func (s *persistenceTracingClientSuite) TestChildOfRequestSpan() {
+	parent := s.tracer.StartSpan("request")
+	ctx := opentracing.ContextWithSpan(context.Background(), parent)
+
+	s.NoError(ShardManagerWithContext(ctx, s.client).UpdateShard(&UpdateShardRequest{}))
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 1)
+	s.Equal("persistence.UpdateShard", spans[0].OperationName)
+	s.Equal(parent.(*mocktracer.MockSpan).SpanContext.SpanID, spans[0].ParentID)
+	s.Equal("ShardManager", spans[0].Tag(persistenceManagerTag))
+	s.Nil(spans[0].Tag(string(ext.Error)))
+}
+
+func (s *persistenceTracingClientSuite) TestError() {
+	s.client = NewShardPersistenceTracingClient(&fakeShardManager{err: errors.New("shard ownership lost")})
+	ctx := opentracing.ContextWithSpan(context.Background(), s.tracer.StartSpan("request"))
+
+	s.Error(ShardManagerWithContext(ctx, s.client).UpdateShard(&UpdateShardRequest{}))
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 1)
+	s.Equal(true, spans[0].Tag(string(ext.Error)))
+}
+
+func (m *fakeShardManager) GetName() string {
+	return "fake"
+}
+
+func (m *fakeShardManager) CreateShard(_ *CreateShardRequest) error {
+	return m.err
+}
+
+func (m *fakeShardManager) GetShard(_ *GetShardRequest) (*GetShardResponse, error) {
+	return nil, m.err
+}
+
+func (m *fakeShardManager) UpdateShard(_ *UpdateShardRequest) error {
+	return m.err
+}
+
+func (m *fakeShardManager) Close() {}
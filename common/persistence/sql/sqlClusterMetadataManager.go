@@ -26,6 +26,7 @@ package sql
 
 import (
 	"encoding/binary"
+	"fmt"
 	"net"
 	"time"
 
@@ -181,6 +182,59 @@ func (s *sqlClusterMetadataManager) PruneClusterMembership(request *p.PruneClust
 	return nil
 }
 
+func (s *sqlClusterMetadataManager) GetDynamicConfig() (*p.InternalGetDynamicConfigResponse, error) {
+	rows, err := s.db.SelectFromDynamicConfig(&sqlplugin.DynamicConfigFilter{PageSize: 1})
+	if err != nil {
+		return nil, convertCommonErrors("GetDynamicConfig", err)
+	}
+	if len(rows) == 0 {
+		return &p.InternalGetDynamicConfigResponse{}, nil
+	}
+
+	return &p.InternalGetDynamicConfigResponse{
+		Version:       rows[0].Version,
+		DynamicConfig: p.NewDataBlob(rows[0].Data, common.EncodingType(rows[0].DataEncoding)),
+	}, nil
+}
+
+func (s *sqlClusterMetadataManager) UpdateDynamicConfig(request *p.InternalUpdateDynamicConfigRequest) error {
+	_, err := s.db.InsertIntoDynamicConfig(&sqlplugin.DynamicConfigRow{
+		Version:      request.PreviousVersion + 1,
+		Data:         request.DynamicConfig.Data,
+		DataEncoding: string(request.DynamicConfig.Encoding),
+	})
+
+	if err != nil {
+		if s.db.IsDupEntryError(err) {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("dynamic config was updated concurrently, version %v already exists", request.PreviousVersion+1),
+			}
+		}
+		return convertCommonErrors("UpdateDynamicConfig", err)
+	}
+
+	return nil
+}
+
+func (s *sqlClusterMetadataManager) ListDynamicConfigHistory(request *p.InternalListDynamicConfigHistoryRequest) (*p.InternalListDynamicConfigHistoryResponse, error) {
+	rows, err := s.db.SelectFromDynamicConfig(&sqlplugin.DynamicConfigFilter{
+		MaxVersion: request.MaxVersion,
+		PageSize:   request.PageSize,
+	})
+	if err != nil {
+		return nil, convertCommonErrors("ListDynamicConfigHistory", err)
+	}
+
+	resp := &p.InternalListDynamicConfigHistoryResponse{}
+	for _, row := range rows {
+		resp.DynamicConfigs = append(resp.DynamicConfigs, &p.InternalGetDynamicConfigResponse{
+			Version:       row.Version,
+			DynamicConfig: p.NewDataBlob(row.Data, common.EncodingType(row.DataEncoding)),
+		})
+	}
+	return resp, nil
+}
+
 func newClusterMetadataPersistence(db sqlplugin.DB,
 	logger log.Logger) (p.ClusterMetadataStore, error) {
 	return &sqlClusterMetadataManager{
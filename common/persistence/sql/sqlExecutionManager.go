@@ -723,10 +723,68 @@ func (m *sqlExecutionManager) GetCurrentExecution(
 	}, nil
 }
 
+type concreteExecutionsPageToken struct {
+	NamespaceID primitives.UUID
+	WorkflowID  string
+	RunID       primitives.UUID
+}
+
+func (t *concreteExecutionsPageToken) serialize() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (t *concreteExecutionsPageToken) deserialize(payload []byte) error {
+	return json.Unmarshal(payload, t)
+}
+
 func (m *sqlExecutionManager) ListConcreteExecutions(
-	_ *p.ListConcreteExecutionsRequest,
+	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
-	return nil, serviceerror.NewUnimplemented("ListConcreteExecutions is not implemented")
+
+	filter := &sqlplugin.ExecutionsRangeFilter{
+		ShardID:  m.shardID,
+		PageSize: request.PageSize,
+	}
+	if len(request.PageToken) > 0 {
+		pageToken := &concreteExecutionsPageToken{}
+		if err := pageToken.deserialize(request.PageToken); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("ListConcreteExecutions operation failed. Invalid page token: %v", err))
+		}
+		filter.NamespaceID = pageToken.NamespaceID
+		filter.WorkflowID = pageToken.WorkflowID
+		filter.RunID = pageToken.RunID
+	}
+
+	rows, err := m.db.RangeSelectFromExecutions(filter)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("ListConcreteExecutions operation failed. Select failed: %v", err))
+	}
+
+	response := &p.InternalListConcreteExecutionsResponse{}
+	for _, row := range rows {
+		info, err := serialization.WorkflowExecutionInfoFromBlob(row.Data, row.DataEncoding)
+		if err != nil {
+			return nil, err
+		}
+		executionState, err := serialization.WorkflowExecutionStateFromBlob(row.State, row.StateEncoding)
+		if err != nil {
+			return nil, err
+		}
+		response.ExecutionInfos = append(response.ExecutionInfos, p.ProtoWorkflowExecutionToPartialInternalExecution(info, executionState, row.NextEventID))
+	}
+
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		pageToken := &concreteExecutionsPageToken{
+			NamespaceID: lastRow.NamespaceID,
+			WorkflowID:  lastRow.WorkflowID,
+			RunID:       lastRow.RunID,
+		}
+		if response.NextPageToken, err = pageToken.serialize(); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("ListConcreteExecutions operation failed. Unable to serialize page token: %v", err))
+		}
+	}
+	return response, nil
 }
 
 func (m *sqlExecutionManager) GetTransferTask(request *persistence.GetTransferTaskRequest) (*persistence.GetTransferTaskResponse, error) {
@@ -30,11 +30,13 @@ import (
 	"fmt"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/service/config"
@@ -76,6 +78,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalR
 		Status:           int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), // Underlying value (1) is hardcoded in SQL queries.
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: s.serializeSearchAttributes(request.SearchAttributes),
 	})
 
 	return err
@@ -95,6 +98,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: s.serializeSearchAttributes(request.SearchAttributes),
 	})
 	if err != nil {
 		return err
@@ -113,7 +117,32 @@ func (s *sqlVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWo
 	if p.IsNopUpsertWorkflowRequest(request) {
 		return nil
 	}
-	return p.NewOperationNotSupportErrorForVis()
+	row := &sqlplugin.VisibilityRow{
+		NamespaceID:      request.NamespaceID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		StartTime:        time.Unix(0, request.StartTimestamp),
+		ExecutionTime:    time.Unix(0, request.ExecutionTimestamp),
+		WorkflowTypeName: request.WorkflowTypeName,
+		Status:           int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), // Underlying value (1) is hardcoded in SQL queries.
+		Memo:             request.Memo.Data,
+		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: s.serializeSearchAttributes(request.SearchAttributes),
+	}
+	result, err := s.db.UpdateVisibility(row)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("UpsertWorkflowExecution rowsAffected error: %v", err)
+	}
+	if rowsAffected == 0 {
+		// the started record hasn't been written yet (or the search attributes didn't change),
+		// insert the row, this is a no-op if the row already exists
+		_, err = s.db.InsertIntoVisibility(row)
+	}
+	return err
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
@@ -253,15 +282,70 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDelete
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return s.listWorkflowExecutionsByQuery("ListWorkflowExecutions", request)
 }
 
+// ScanWorkflowExecutions has the same semantics as ListWorkflowExecutions, rows are always returned in a stable order
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return s.listWorkflowExecutionsByQuery("ScanWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	count, err := s.db.CountFromVisibilityByQuery(&sqlplugin.VisibilityQueryFilter{
+		NamespaceID: request.NamespaceID,
+		Query:       request.Query,
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.InvalidArgument); ok {
+			return nil, err
+		}
+		return nil, serviceerror.NewInternal(fmt.Sprintf("CountWorkflowExecutions operation failed. Select failed: %v", err))
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *sqlVisibilityStore) listWorkflowExecutionsByQuery(opName string, request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	filter := &sqlplugin.VisibilityQueryFilter{
+		NamespaceID: request.NamespaceID,
+		Query:       request.Query,
+		PageSize:    &request.PageSize,
+	}
+	if len(request.NextPageToken) > 0 {
+		readLevel, err := s.deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("%v operation failed. Invalid page token: %v", opName, err))
+		}
+		filter.MaxStartTime = &readLevel.Time
+		filter.RunID = &readLevel.RunID
+	}
+
+	rows, err := s.db.SelectFromVisibilityByQuery(filter)
+	if err != nil {
+		if _, ok := err.(*serviceerror.InvalidArgument); ok {
+			return nil, err
+		}
+		return nil, serviceerror.NewInternal(fmt.Sprintf("%v operation failed. Select failed: %v", opName, err))
+	}
+
+	infos := make([]*p.VisibilityWorkflowExecutionInfo, len(rows))
+	for i, row := range rows {
+		infos[i] = s.rowToInfo(&row)
+	}
+	var nextPageToken []byte
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		nextPageToken, err = s.serializePageToken(&visibilityPageToken{
+			Time:  lastRow.StartTime,
+			RunID: lastRow.RunID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.VisibilityWorkflowExecutionInfo {
@@ -284,9 +368,40 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Visibili
 	if row.HistoryLength != nil {
 		info.HistoryLength = *row.HistoryLength
 	}
+	if len(row.SearchAttributes) > 0 {
+		if err := json.Unmarshal(row.SearchAttributes, &info.SearchAttributes); err != nil {
+			s.logger.Error("Unable to decode search attributes", tag.WorkflowID(row.WorkflowID), tag.WorkflowRunID(row.RunID), tag.Error(err))
+		}
+	}
 	return info
 }
 
+// serializeSearchAttributes encodes search attributes as a single JSON object so they can be queried by ListWorkflowExecutions.
+// Search attribute payloads are always JSON encoded, invalid values are skipped.
+func (s *sqlVisibilityStore) serializeSearchAttributes(searchAttributes map[string]*commonpb.Payload) []byte {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	fields := make(map[string]json.RawMessage, len(searchAttributes))
+	for key, value := range searchAttributes {
+		data := value.GetData()
+		if !json.Valid(data) {
+			s.logger.Warn("Skipping search attribute which is not valid JSON", tag.Key(key))
+			continue
+		}
+		fields[key] = data
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		s.logger.Error("Unable to encode search attributes", tag.Error(err))
+		return nil
+	}
+	return data
+}
+
 func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []byte, earliestTime int64, latestTime int64, selectOp func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error)) (*p.InternalListWorkflowExecutionsResponse, error) {
 	var readLevel *visibilityPageToken
 	var err error
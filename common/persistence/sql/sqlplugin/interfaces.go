@@ -49,6 +49,20 @@ type (
 		ImmutableDataEncoding string
 	}
 
+	// DynamicConfigRow represents a row in the dynamic_config table
+	DynamicConfigRow struct {
+		Version      int64
+		Data         []byte
+		DataEncoding string
+	}
+
+	// DynamicConfigFilter is used for SelectFromDynamicConfig queries
+	DynamicConfigFilter struct {
+		// MaxVersion is the version of the first selected row, the most recent if 0
+		MaxVersion int64
+		PageSize   int
+	}
+
 	// ClusterMembershipRow represents a row in the cluster_membership table
 	ClusterMembershipRow struct {
 		Role           persistence.ServiceType
@@ -162,6 +176,17 @@ type (
 		RunID       primitives.UUID
 	}
 
+	// ExecutionsRangeFilter contains the column names within executions table that
+	// can be used to page through all executions of a shard. NamespaceID, WorkflowID and
+	// RunID identify the last row of the previous page and are nil / empty for the first page
+	ExecutionsRangeFilter struct {
+		ShardID     int
+		NamespaceID primitives.UUID
+		WorkflowID  string
+		RunID       primitives.UUID
+		PageSize    int
+	}
+
 	// CurrentExecutionsRow represents a row in current_executions table
 	CurrentExecutionsRow struct {
 		ShardID          int64
@@ -510,6 +535,7 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		SearchAttributes []byte
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -525,6 +551,16 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains the visibility query along with the
+	// pagination params used to select rows from executions_visibility table
+	VisibilityQueryFilter struct {
+		NamespaceID  string
+		Query        string
+		MaxStartTime *time.Time
+		RunID        *string
+		PageSize     *int
+	}
+
 	// QueueRow represents a row in queue table
 	QueueRow struct {
 		QueueType      persistence.QueueType
@@ -545,6 +581,10 @@ type (
 		GetClusterMembers(filter *ClusterMembershipFilter) ([]ClusterMembershipRow, error)
 		UpsertClusterMembership(row *ClusterMembershipRow) (sql.Result, error)
 		PruneClusterMembership(filter *PruneClusterMembershipFilter) (sql.Result, error)
+		// InsertIntoDynamicConfig fails with a duplicate entry error if the version already exists
+		InsertIntoDynamicConfig(row *DynamicConfigRow) (sql.Result, error)
+		// SelectFromDynamicConfig returns the dynamic config versions ordered from the most recent
+		SelectFromDynamicConfig(filter *DynamicConfigFilter) ([]DynamicConfigRow, error)
 
 		InsertIntoNamespace(rows *NamespaceRow) (sql.Result, error)
 		UpdateNamespace(row *NamespaceRow) (sql.Result, error)
@@ -600,6 +640,10 @@ type (
 		InsertIntoExecutions(row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(filter *ExecutionsFilter) (*ExecutionsRow, error)
+		// RangeSelectFromExecutions returns one page of rows from executions table, ordered by primary key
+		// Required filter params - {shardID, pageSize}
+		// Optional filter params - {namespaceID, workflowID, runID} of the last row of previous page
+		RangeSelectFromExecutions(filter *ExecutionsRangeFilter) ([]ExecutionsRow, error)
 		DeleteFromExecutions(filter *ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(filter *ExecutionsFilter) (int, error)
 		WriteLockExecutions(filter *ExecutionsFilter) (int, error)
@@ -729,6 +773,9 @@ type (
 		InsertIntoVisibility(row *VisibilityRow) (sql.Result, error)
 		// ReplaceIntoVisibility deletes old row (if it exist) and inserts new row into visibility table
 		ReplaceIntoVisibility(row *VisibilityRow) (sql.Result, error)
+		// UpdateVisibility updates the memo and search attributes of an existing row in visibility table
+		// Required row params - {namespaceID, runID, memo, encoding, searchAttributes}
+		UpdateVisibility(row *VisibilityRow) (sql.Result, error)
 		// SelectFromVisibility returns one or more rows from visibility table
 		// Required filter params:
 		// - getClosedWorkflowExecution - retrieves single row - {namespaceID, runID, closed=true}
@@ -738,6 +785,13 @@ type (
 		//   - OPTIONALLY specify one of following params
 		//     - workflowID, workflowTypeName, status (along with closed=true)
 		SelectFromVisibility(filter *VisibilityFilter) ([]VisibilityRow, error)
+		// SelectFromVisibilityByQuery returns one or more rows matching a visibility query
+		// Required filter params - {namespaceID, query, pageSize}
+		// Optional filter params - {maxStartTime, runID} both taken from previous page token
+		SelectFromVisibilityByQuery(filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows matching a visibility query
+		// Required filter params - {namespaceID, query}
+		CountFromVisibilityByQuery(filter *VisibilityQueryFilter) (int64, error)
 		DeleteFromVisibility(filter *VisibilityFilter) (sql.Result, error)
 
 		InsertIntoQueue(row *QueueRow) (sql.Result, error)
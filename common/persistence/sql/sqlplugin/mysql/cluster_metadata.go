@@ -34,6 +34,7 @@ import (
 )
 
 const constMetadataPartition = 0
+const constDynamicConfigPartition = 0
 const (
 	// ****** CLUSTER_METADATA TABLE ******
 	// One time only for the immutable data, so lets just use insert ignore
@@ -44,6 +45,17 @@ VALUES(?, ?, ?)`
 	getImmutableClusterMetadataQry = `SELECT immutable_data, immutable_data_encoding FROM 
 cluster_metadata WHERE metadata_partition = ?`
 
+	// ****** DYNAMIC_CONFIG TABLE ******
+	insertDynamicConfigQry = `INSERT INTO 
+dynamic_config (config_partition, version, data, data_encoding)
+VALUES(?, ?, ?, ?)`
+
+	getDynamicConfigQry = `SELECT version, data, data_encoding FROM 
+dynamic_config WHERE config_partition = ? ORDER BY version DESC LIMIT ?`
+
+	getDynamicConfigBeforeVersionQry = `SELECT version, data, data_encoding FROM 
+dynamic_config WHERE config_partition = ? AND version <= ? ORDER BY version DESC LIMIT ?`
+
 	// ****** CLUSTER_MEMBERSHIP TABLE ******
 	templateUpsertActiveClusterMembership = `REPLACE INTO
 cluster_membership (host_id, rpc_address, rpc_port, role, session_start, last_heartbeat, record_expiry)
@@ -163,3 +175,22 @@ func (mdb *db) PruneClusterMembership(filter *sqlplugin.PruneClusterMembershipFi
 		mdb.converter.ToMySQLDateTime(filter.PruneRecordsBefore),
 		filter.MaxRecordsAffected)
 }
+
+func (mdb *db) InsertIntoDynamicConfig(row *sqlplugin.DynamicConfigRow) (sql.Result, error) {
+	return mdb.conn.Exec(insertDynamicConfigQry,
+		constDynamicConfigPartition,
+		row.Version,
+		row.Data,
+		row.DataEncoding)
+}
+
+func (mdb *db) SelectFromDynamicConfig(filter *sqlplugin.DynamicConfigFilter) ([]sqlplugin.DynamicConfigRow, error) {
+	var rows []sqlplugin.DynamicConfigRow
+	var err error
+	if filter.MaxVersion > 0 {
+		err = mdb.conn.Select(&rows, getDynamicConfigBeforeVersionQry, constDynamicConfigPartition, filter.MaxVersion, filter.PageSize)
+	} else {
+		err = mdb.conn.Select(&rows, getDynamicConfigQry, constDynamicConfigPartition, filter.PageSize)
+	}
+	return rows, err
+}
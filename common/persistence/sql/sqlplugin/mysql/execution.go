@@ -43,6 +43,14 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
+	listExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ?
+ ORDER BY namespace_id, workflow_id, run_id LIMIT ?`
+
+	listExecutionsNextPageQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND (namespace_id, workflow_id, run_id) > (?, ?, ?)
+ ORDER BY namespace_id, workflow_id, run_id LIMIT ?`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
@@ -178,6 +186,26 @@ func (mdb *db) SelectFromExecutions(filter *sqlplugin.ExecutionsFilter) (*sqlplu
 	return &row, err
 }
 
+// RangeSelectFromExecutions reads one page of rows from executions table
+func (mdb *db) RangeSelectFromExecutions(filter *sqlplugin.ExecutionsRangeFilter) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	var err error
+	if len(filter.NamespaceID) == 0 {
+		err = mdb.conn.Select(&rows, listExecutionsQuery, filter.ShardID, filter.PageSize)
+	} else {
+		err = mdb.conn.Select(&rows, listExecutionsNextPageQuery,
+			filter.ShardID,
+			filter.NamespaceID,
+			filter.WorkflowID,
+			filter.RunID,
+			filter.PageSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (mdb *db) DeleteFromExecutions(filter *sqlplugin.ExecutionsFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteExecutionQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
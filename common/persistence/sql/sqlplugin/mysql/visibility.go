@@ -30,16 +30,17 @@ import (
 	"fmt"
 
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/visibilityquery"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND namespace_id = ?
@@ -49,7 +50,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT ?`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status = 1 `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, history_length
@@ -69,14 +70,29 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = ?` + templateConditions
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, search_attributes
 		 FROM executions_visibility
 		 WHERE namespace_id = ? AND status != 1
 		 AND run_id = ?`
 
+	templateUpdateWorkflowExecution = `UPDATE executions_visibility SET memo = ?, encoding = ?, search_attributes = ?
+		 WHERE namespace_id = ? AND run_id = ?`
+
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id=? AND run_id=?"
+
+	templateQuerySelect = `SELECT ` + templateOpenFieldNames + `, close_time, history_length
+		 FROM executions_visibility WHERE namespace_id = ?`
+
+	templateQueryCount = `SELECT COUNT(*) FROM executions_visibility WHERE namespace_id = ?`
+
+	// RunID condition is needed for correct pagination
+	templateQueryPageConditions = ` AND (start_time < ? OR (start_time = ? AND run_id > ?))`
+
+	templateQueryOrderBy = ` ORDER BY start_time DESC, run_id LIMIT ?`
 )
 
+var queryConverter = visibilityquery.NewConverter(visibilityquery.NewMySQLDialect(), (&converter{}).ToMySQLDateTime)
+
 var errCloseParams = errors.New("missing one of {closeTime, historyLength} params")
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
@@ -92,7 +108,8 @@ func (mdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, e
 		row.WorkflowTypeName,
 		row.Status,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		searchAttributesParam(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -112,12 +129,23 @@ func (mdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result,
 			row.Status,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			searchAttributesParam(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// UpdateVisibility updates the memo and search attributes of an existing row in visibility table
+func (mdb *db) UpdateVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	return mdb.conn.Exec(templateUpdateWorkflowExecution,
+		row.Memo,
+		row.Encoding,
+		searchAttributesParam(row.SearchAttributes),
+		row.NamespaceID,
+		row.RunID)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *db) DeleteFromVisibility(filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	return mdb.conn.Exec(templateDeleteWorkflowExecution, filter.NamespaceID, filter.RunID)
@@ -208,3 +236,69 @@ func (mdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlpl
 	}
 	return rows, err
 }
+
+// SelectFromVisibilityByQuery reads one or more rows matching the visibility query from visibility table
+func (mdb *db) SelectFromVisibilityByQuery(filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	condition, err := queryConverter.Convert(filter.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	query := templateQuerySelect
+	args := []interface{}{filter.NamespaceID}
+	if condition.Where != "" {
+		query += " AND " + condition.Where
+		args = append(args, condition.Args...)
+	}
+	if filter.MaxStartTime != nil && filter.RunID != nil {
+		maxStartTime := mdb.converter.ToMySQLDateTime(*filter.MaxStartTime)
+		query += templateQueryPageConditions
+		args = append(args, maxStartTime, maxStartTime, *filter.RunID)
+	}
+	query += templateQueryOrderBy
+	args = append(args, *filter.PageSize)
+
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.conn.Select(&rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromMySQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows matching the visibility query
+func (mdb *db) CountFromVisibilityByQuery(filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	condition, err := queryConverter.Convert(filter.Query)
+	if err != nil {
+		return 0, err
+	}
+
+	query := templateQueryCount
+	args := []interface{}{filter.NamespaceID}
+	if condition.Where != "" {
+		query += " AND " + condition.Where
+		args = append(args, condition.Args...)
+	}
+
+	var count int64
+	if err := mdb.conn.Get(&count, query, args...); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// searchAttributesParam converts the JSON encoded search attributes into a bind parameter,
+// JSON columns can't be populated from binary strings
+func searchAttributesParam(searchAttributes []byte) interface{} {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	return string(searchAttributes)
+}
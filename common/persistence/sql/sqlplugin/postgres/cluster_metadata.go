@@ -35,6 +35,7 @@ import (
 )
 
 const constMetadataPartition = 0
+const constDynamicConfigPartition = 0
 const (
 	// ****** CLUSTER_METADATA TABLE ******
 	// One time only for the immutable data, so just use idempotent insert that does nothing if a record exists
@@ -48,6 +49,17 @@ ON CONFLICT DO NOTHING`
 	getImmutableClusterMetadataQry = `SELECT immutable_data, immutable_data_encoding FROM 
 cluster_metadata WHERE metadata_partition = $1`
 
+	// ****** DYNAMIC_CONFIG TABLE ******
+	insertDynamicConfigQry = `INSERT INTO 
+dynamic_config (config_partition, version, data, data_encoding)
+VALUES($1, $2, $3, $4)`
+
+	getDynamicConfigQry = `SELECT version, data, data_encoding FROM 
+dynamic_config WHERE config_partition = $1 ORDER BY version DESC LIMIT $2`
+
+	getDynamicConfigBeforeVersionQry = `SELECT version, data, data_encoding FROM 
+dynamic_config WHERE config_partition = $1 AND version <= $2 ORDER BY version DESC LIMIT $3`
+
 	// ****** CLUSTER_MEMBERSHIP TABLE ******
 	templateUpsertActiveClusterMembership = `INSERT INTO
 cluster_membership (host_id, rpc_address, rpc_port, role, session_start, last_heartbeat, record_expiry)
@@ -186,3 +198,22 @@ func (pdb *db) PruneClusterMembership(filter *sqlplugin.PruneClusterMembershipFi
 		filter.PruneRecordsBefore,
 		filter.MaxRecordsAffected)
 }
+
+func (pdb *db) InsertIntoDynamicConfig(row *sqlplugin.DynamicConfigRow) (sql.Result, error) {
+	return pdb.conn.Exec(insertDynamicConfigQry,
+		constDynamicConfigPartition,
+		row.Version,
+		row.Data,
+		row.DataEncoding)
+}
+
+func (pdb *db) SelectFromDynamicConfig(filter *sqlplugin.DynamicConfigFilter) ([]sqlplugin.DynamicConfigRow, error) {
+	var rows []sqlplugin.DynamicConfigRow
+	var err error
+	if filter.MaxVersion > 0 {
+		err = pdb.conn.Select(&rows, getDynamicConfigBeforeVersionQry, constDynamicConfigPartition, filter.MaxVersion, filter.PageSize)
+	} else {
+		err = pdb.conn.Select(&rows, getDynamicConfigQry, constDynamicConfigPartition, filter.PageSize)
+	}
+	return rows, err
+}
@@ -43,6 +43,14 @@ const (
 	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
+	listExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1
+ ORDER BY namespace_id, workflow_id, run_id LIMIT $2`
+
+	listExecutionsNextPageQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1 AND (namespace_id, workflow_id, run_id) > ($2, $3, $4)
+ ORDER BY namespace_id, workflow_id, run_id LIMIT $5`
+
 	deleteExecutionQuery = `DELETE FROM executions 
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
@@ -177,6 +185,26 @@ func (pdb *db) SelectFromExecutions(filter *sqlplugin.ExecutionsFilter) (*sqlplu
 	return &row, err
 }
 
+// RangeSelectFromExecutions reads one page of rows from executions table
+func (pdb *db) RangeSelectFromExecutions(filter *sqlplugin.ExecutionsRangeFilter) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	var err error
+	if len(filter.NamespaceID) == 0 {
+		err = pdb.conn.Select(&rows, listExecutionsQuery, filter.ShardID, filter.PageSize)
+	} else {
+		err = pdb.conn.Select(&rows, listExecutionsNextPageQuery,
+			filter.ShardID,
+			filter.NamespaceID,
+			filter.WorkflowID,
+			filter.RunID,
+			filter.PageSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (pdb *db) DeleteFromExecutions(filter *sqlplugin.ExecutionsFilter) (sql.Result, error) {
 	return pdb.conn.Exec(deleteExecutionQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
@@ -30,18 +30,21 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jmoiron/sqlx"
+
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/visibilityquery"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
          ON CONFLICT (namespace_id, run_id) DO NOTHING`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		ON CONFLICT (namespace_id, run_id) DO UPDATE 
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -51,7 +54,8 @@ const (
 			  status = excluded.status,
 			  history_length = excluded.history_length,
 			  memo = excluded.memo,
-			  encoding = excluded.encoding`
+			  encoding = excluded.encoding,
+			  search_attributes = excluded.search_attributes`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND namespace_id = $1
@@ -68,7 +72,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT $7`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status = 1 `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, history_length
@@ -88,14 +92,30 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = $1` + templateConditions2
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, search_attributes
 		 FROM executions_visibility
 		 WHERE namespace_id = $1 AND status != 1
 		 AND run_id = $2`
 
+	templateUpdateWorkflowExecution = `UPDATE executions_visibility SET memo = $1, encoding = $2, search_attributes = $3
+		 WHERE namespace_id = $4 AND run_id = $5`
+
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id=$1 AND run_id=$2"
+
+	// Query templates use ? placeholders, they are rebound once the visibility query condition is appended
+	templateQuerySelect = `SELECT ` + templateOpenFieldNames + `, close_time, history_length
+		 FROM executions_visibility WHERE namespace_id = ?`
+
+	templateQueryCount = `SELECT COUNT(*) FROM executions_visibility WHERE namespace_id = ?`
+
+	// RunID condition is needed for correct pagination
+	templateQueryPageConditions = ` AND (start_time < ? OR (start_time = ? AND run_id > ?))`
+
+	templateQueryOrderBy = ` ORDER BY start_time DESC, run_id LIMIT ?`
 )
 
+var queryConverter = visibilityquery.NewConverter(visibilityquery.NewPostgresDialect(), (&converter{}).ToPostgresDateTime)
+
 var errCloseParams = errors.New("missing one of {closeTime, historyLength} params")
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
@@ -111,7 +131,8 @@ func (pdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, e
 		row.WorkflowTypeName,
 		row.Status,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		searchAttributesParam(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -131,12 +152,23 @@ func (pdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result,
 			row.Status,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			searchAttributesParam(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// UpdateVisibility updates the memo and search attributes of an existing row in visibility table
+func (pdb *db) UpdateVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	return pdb.conn.Exec(templateUpdateWorkflowExecution,
+		row.Memo,
+		row.Encoding,
+		searchAttributesParam(row.SearchAttributes),
+		row.NamespaceID,
+		row.RunID)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (pdb *db) DeleteFromVisibility(filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	return pdb.conn.Exec(templateDeleteWorkflowExecution, filter.NamespaceID, filter.RunID)
@@ -231,3 +263,71 @@ func (pdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlpl
 	}
 	return rows, err
 }
+
+// SelectFromVisibilityByQuery reads one or more rows matching the visibility query from visibility table
+func (pdb *db) SelectFromVisibilityByQuery(filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	condition, err := queryConverter.Convert(filter.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	query := templateQuerySelect
+	args := []interface{}{filter.NamespaceID}
+	if condition.Where != "" {
+		query += " AND " + condition.Where
+		args = append(args, condition.Args...)
+	}
+	if filter.MaxStartTime != nil && filter.RunID != nil {
+		maxStartTime := pdb.converter.ToPostgresDateTime(*filter.MaxStartTime)
+		query += templateQueryPageConditions
+		args = append(args, maxStartTime, maxStartTime, *filter.RunID)
+	}
+	query += templateQueryOrderBy
+	args = append(args, *filter.PageSize)
+
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.conn.Select(&rows, sqlx.Rebind(sqlx.DOLLAR, query), args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := pdb.converter.FromPostgresDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows matching the visibility query
+func (pdb *db) CountFromVisibilityByQuery(filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	condition, err := queryConverter.Convert(filter.Query)
+	if err != nil {
+		return 0, err
+	}
+
+	query := templateQueryCount
+	args := []interface{}{filter.NamespaceID}
+	if condition.Where != "" {
+		query += " AND " + condition.Where
+		args = append(args, condition.Args...)
+	}
+
+	var count int64
+	if err := pdb.conn.Get(&count, sqlx.Rebind(sqlx.DOLLAR, query), args...); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// searchAttributesParam converts the JSON encoded search attributes into a bind parameter,
+// []byte would be sent as bytea which can't be cast to jsonb
+func searchAttributesParam(searchAttributes []byte) interface{} {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	return string(searchAttributes)
+}
@@ -0,0 +1,456 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+)
+
+type (
+	// Dialect captures the differences between the SQL flavours a visibility query can be converted to.
+	// All expressions returned by a Dialect use ? as bind parameter placeholder.
+	Dialect interface {
+		// JSONField returns an expression which extracts the value of key from the search attributes column
+		JSONField(key string) string
+		// JSONParam returns an expression which turns a JSON encoded bind parameter into a JSON value
+		JSONParam() string
+		// JSONContains returns a condition which is true when field equals value or,
+		// if field is an array, when the array contains value
+		JSONContains(field string, value string) string
+	}
+
+	// TimeConverterFn converts a time value into the representation expected by the database driver
+	TimeConverterFn func(time.Time) time.Time
+
+	// Converter translates the visibility query language (the where clause accepted by ListWorkflowExecutions,
+	// ScanWorkflowExecutions and CountWorkflowExecutions) into a parameterized SQL condition
+	// over the executions_visibility table.
+	Converter struct {
+		dialect     Dialect
+		convertTime TimeConverterFn
+	}
+
+	// Condition is the result of converting a visibility query
+	Condition struct {
+		// Where is the SQL condition, empty if the query doesn't filter anything
+		Where string
+		// Args are the values of the bind parameters in Where
+		Args []interface{}
+	}
+
+	columnType int
+
+	column struct {
+		name    string
+		colType columnType
+	}
+)
+
+const (
+	columnTypeString columnType = iota
+	columnTypeInt
+	columnTypeTime
+	columnTypeStatus
+	columnTypeJSON
+)
+
+const (
+	queryTemplate        = "select * from dummy where %s"
+	orderByQueryTemplate = "select * from dummy %s"
+
+	// missingValue is the special value used to look for open workflows, e.g. CloseTime = missing
+	missingValue = "missing"
+
+	defaultDateTimeFormat = time.RFC3339
+)
+
+var (
+	systemColumns = map[string]column{
+		definition.WorkflowID:      {name: "workflow_id", colType: columnTypeString},
+		definition.RunID:           {name: "run_id", colType: columnTypeString},
+		definition.WorkflowType:    {name: "workflow_type_name", colType: columnTypeString},
+		definition.TaskQueue:       {name: "task_queue", colType: columnTypeString},
+		definition.StartTime:       {name: "start_time", colType: columnTypeTime},
+		definition.ExecutionTime:   {name: "execution_time", colType: columnTypeTime},
+		definition.CloseTime:       {name: "close_time", colType: columnTypeTime},
+		definition.ExecutionStatus: {name: "status", colType: columnTypeStatus},
+		definition.HistoryLength:   {name: "history_length", colType: columnTypeInt},
+	}
+
+	searchAttributeKeyRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	errInvalidQuery = errors.New("invalid query")
+)
+
+// NewConverter creates a new visibility query converter for the given dialect
+func NewConverter(dialect Dialect, convertTime TimeConverterFn) *Converter {
+	return &Converter{
+		dialect:     dialect,
+		convertTime: convertTime,
+	}
+}
+
+// Convert translates the query into a SQL condition. Custom search attributes are expected to be
+// prefixed with definition.Attr, as done by the frontend query validator.
+// Returned errors are always of type *serviceerror.InvalidArgument.
+func (c *Converter) Convert(query string) (*Condition, error) {
+	condition, err := c.convert(query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid visibility query: %v", err))
+	}
+	return condition, nil
+}
+
+func (c *Converter) convert(query string) (*Condition, error) {
+	query = strings.TrimSpace(query)
+	condition := &Condition{}
+	if len(query) == 0 {
+		return condition, nil
+	}
+
+	var sql string
+	if common.IsJustOrderByClause(query) {
+		sql = fmt.Sprintf(orderByQueryTemplate, query)
+	} else {
+		sql = fmt.Sprintf(queryTemplate, query)
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errInvalidQuery
+	}
+	if err := c.validateOrderBy(sel.OrderBy); err != nil {
+		return nil, err
+	}
+	if sel.Where == nil {
+		return condition, nil
+	}
+
+	where, err := c.convertWhereExpr(sel.Where.Expr, condition)
+	if err != nil {
+		return nil, err
+	}
+	condition.Where = where
+	return condition, nil
+}
+
+// validateOrderBy only allows the default order, because pagination relies on (start_time, run_id)
+func (c *Converter) validateOrderBy(orderBy sqlparser.OrderBy) error {
+	switch len(orderBy) {
+	case 0:
+		return nil
+	case 1:
+		colName, ok := orderBy[0].Expr.(*sqlparser.ColName)
+		if ok && colName.Name.String() == definition.StartTime && orderBy[0].Direction == sqlparser.DescScr {
+			return nil
+		}
+	}
+	return fmt.Errorf("only order by %s desc is supported", definition.StartTime)
+}
+
+func (c *Converter) convertWhereExpr(expr sqlparser.Expr, condition *Condition) (string, error) {
+	if expr == nil {
+		return "", errors.New("where expression is nil")
+	}
+
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertBinaryExpr(expr.Left, expr.Right, "AND", condition)
+	case *sqlparser.OrExpr:
+		return c.convertBinaryExpr(expr.Left, expr.Right, "OR", condition)
+	case *sqlparser.ParenExpr:
+		inner, err := c.convertWhereExpr(expr.Expr, condition)
+		if err != nil {
+			return "", err
+		}
+		return "(" + inner + ")", nil
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(expr, condition)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(expr, condition)
+	default:
+		return "", fmt.Errorf("unsupported expression: %s", sqlparser.String(expr))
+	}
+}
+
+func (c *Converter) convertBinaryExpr(left sqlparser.Expr, right sqlparser.Expr, op string, condition *Condition) (string, error) {
+	leftStr, err := c.convertWhereExpr(left, condition)
+	if err != nil {
+		return "", err
+	}
+	rightStr, err := c.convertWhereExpr(right, condition)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", leftStr, op, rightStr), nil
+}
+
+func (c *Converter) convertComparisonExpr(expr *sqlparser.ComparisonExpr, condition *Condition) (string, error) {
+	col, err := c.resolveColumn(expr.Left)
+	if err != nil {
+		return "", err
+	}
+
+	if missing, ok := expr.Right.(*sqlparser.ColName); ok && missing.Name.String() == missingValue {
+		return c.convertMissing(col, expr.Operator)
+	}
+
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return "", fmt.Errorf("invalid value for %s operator: %s", expr.Operator, sqlparser.String(expr.Right))
+		}
+		return c.convertInExpr(col, expr.Operator == sqlparser.NotInStr, tuple, condition)
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		if col.colType == columnTypeJSON {
+			// keyword list attributes, e.g. BinaryChecksums, match if any element matches
+			value, err := c.convertValue(col, expr.Right)
+			if err != nil {
+				return "", err
+			}
+			condition.Args = append(condition.Args, value)
+			contains := c.dialect.JSONContains(col.name, c.dialect.JSONParam())
+			if expr.Operator == sqlparser.NotEqualStr {
+				return fmt.Sprintf("NOT %s", contains), nil
+			}
+			return contains, nil
+		}
+	case sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+	default:
+		return "", fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+
+	value, err := c.convertValue(col, expr.Right)
+	if err != nil {
+		return "", err
+	}
+	condition.Args = append(condition.Args, value)
+	return fmt.Sprintf("%s %s %s", col.name, expr.Operator, c.param(col)), nil
+}
+
+func (c *Converter) convertInExpr(col column, negate bool, tuple sqlparser.ValTuple, condition *Condition) (string, error) {
+	if len(tuple) == 0 {
+		return "", errors.New("empty value list")
+	}
+	conditions := make([]string, 0, len(tuple))
+	for _, valExpr := range tuple {
+		value, err := c.convertValue(col, valExpr)
+		if err != nil {
+			return "", err
+		}
+		condition.Args = append(condition.Args, value)
+		if col.colType == columnTypeJSON {
+			conditions = append(conditions, c.dialect.JSONContains(col.name, c.dialect.JSONParam()))
+		} else {
+			conditions = append(conditions, c.param(col))
+		}
+	}
+
+	if col.colType == columnTypeJSON {
+		result := "(" + strings.Join(conditions, " OR ") + ")"
+		if negate {
+			return "NOT " + result, nil
+		}
+		return result, nil
+	}
+	op := "IN"
+	if negate {
+		op = "NOT IN"
+	}
+	return fmt.Sprintf("%s %s (%s)", col.name, op, strings.Join(conditions, ", ")), nil
+}
+
+func (c *Converter) convertRangeCond(expr *sqlparser.RangeCond, condition *Condition) (string, error) {
+	col, err := c.resolveColumn(expr.Left)
+	if err != nil {
+		return "", err
+	}
+	var op string
+	switch expr.Operator {
+	case sqlparser.BetweenStr:
+		op = "BETWEEN"
+	case sqlparser.NotBetweenStr:
+		op = "NOT BETWEEN"
+	default:
+		return "", fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+	from, err := c.convertValue(col, expr.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := c.convertValue(col, expr.To)
+	if err != nil {
+		return "", err
+	}
+	condition.Args = append(condition.Args, from, to)
+	return fmt.Sprintf("%s %s %s AND %s", col.name, op, c.param(col), c.param(col)), nil
+}
+
+func (c *Converter) convertMissing(col column, op string) (string, error) {
+	switch op {
+	case sqlparser.EqualStr:
+		return fmt.Sprintf("%s IS NULL", col.name), nil
+	case sqlparser.NotEqualStr:
+		return fmt.Sprintf("%s IS NOT NULL", col.name), nil
+	default:
+		return "", fmt.Errorf("operator %s is not supported for %s", op, missingValue)
+	}
+}
+
+func (c *Converter) resolveColumn(expr sqlparser.Expr) (column, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return column{}, fmt.Errorf("invalid filter name: %s", sqlparser.String(expr))
+	}
+	name := colName.Name.String()
+	if col, ok := systemColumns[name]; ok {
+		return col, nil
+	}
+
+	attrPrefix := definition.Attr + "."
+	if !strings.HasPrefix(name, attrPrefix) {
+		return column{}, fmt.Errorf("unknown filter name: %s", name)
+	}
+	key := strings.TrimPrefix(name, attrPrefix)
+	if !searchAttributeKeyRegex.MatchString(key) {
+		return column{}, fmt.Errorf("invalid search attribute name: %s", key)
+	}
+	return column{name: c.dialect.JSONField(key), colType: columnTypeJSON}, nil
+}
+
+func (c *Converter) param(col column) string {
+	if col.colType == columnTypeJSON {
+		return c.dialect.JSONParam()
+	}
+	return "?"
+}
+
+func (c *Converter) convertValue(col column, expr sqlparser.Expr) (interface{}, error) {
+	switch col.colType {
+	case columnTypeString:
+		val, ok := expr.(*sqlparser.SQLVal)
+		if !ok || (val.Type != sqlparser.StrVal && val.Type != sqlparser.IntVal) {
+			return nil, fmt.Errorf("invalid string value: %s", sqlparser.String(expr))
+		}
+		return string(val.Val), nil
+	case columnTypeInt:
+		val, ok := expr.(*sqlparser.SQLVal)
+		if !ok || val.Type != sqlparser.IntVal {
+			return nil, fmt.Errorf("invalid integer value: %s", sqlparser.String(expr))
+		}
+		return strconv.ParseInt(string(val.Val), 10, 64)
+	case columnTypeTime:
+		val, ok := expr.(*sqlparser.SQLVal)
+		if !ok {
+			return nil, fmt.Errorf("invalid time value: %s", sqlparser.String(expr))
+		}
+		t, err := convertTimeValue(val)
+		if err != nil {
+			return nil, err
+		}
+		return c.convertTime(t), nil
+	case columnTypeStatus:
+		val, ok := expr.(*sqlparser.SQLVal)
+		if !ok {
+			return nil, fmt.Errorf("invalid status value: %s", sqlparser.String(expr))
+		}
+		return convertStatusValue(val)
+	case columnTypeJSON:
+		return convertJSONValue(expr)
+	default:
+		return nil, fmt.Errorf("unknown column type: %v", col.colType)
+	}
+}
+
+func convertTimeValue(val *sqlparser.SQLVal) (time.Time, error) {
+	valStr := string(val.Val)
+	if timestamp, err := strconv.ParseInt(valStr, 10, 64); err == nil {
+		return time.Unix(0, timestamp).UTC(), nil
+	}
+	if val.Type != sqlparser.StrVal {
+		return time.Time{}, fmt.Errorf("invalid time value: %s", valStr)
+	}
+	t, err := time.Parse(defaultDateTimeFormat, valStr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
+
+func convertStatusValue(val *sqlparser.SQLVal) (int32, error) {
+	valStr := string(val.Val)
+	if status, err := strconv.ParseInt(valStr, 10, 32); err == nil {
+		if _, ok := enumspb.WorkflowExecutionStatus_name[int32(status)]; ok {
+			return int32(status), nil
+		}
+		return 0, fmt.Errorf("unknown workflow execution status: %s", valStr)
+	}
+
+	// accept both ContinuedAsNew and continued_as_new
+	name := strings.ReplaceAll(strings.TrimSpace(valStr), "_", "")
+	for statusName, status := range enumspb.WorkflowExecutionStatus_value {
+		if status != int32(enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED) && strings.EqualFold(name, statusName) {
+			return status, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown workflow execution status: %s", valStr)
+}
+
+// convertJSONValue returns the JSON encoding of the literal, which is compared against the search attributes column
+func convertJSONValue(expr sqlparser.Expr) (string, error) {
+	switch val := expr.(type) {
+	case sqlparser.BoolVal:
+		return strconv.FormatBool(bool(val)), nil
+	case *sqlparser.SQLVal:
+		switch val.Type {
+		case sqlparser.StrVal:
+			data, err := json.Marshal(string(val.Val))
+			if err != nil {
+				return "", err
+			}
+			return string(data), nil
+		case sqlparser.IntVal, sqlparser.FloatVal:
+			return string(val.Val), nil
+		}
+	}
+	return "", fmt.Errorf("invalid search attribute value: %s", sqlparser.String(expr))
+}
@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+)
+
+type (
+	converterSuite struct {
+		suite.Suite
+		mysql    *Converter
+		postgres *Converter
+	}
+)
+
+func TestConverterSuite(t *testing.T) {
+	suite.Run(t, new(converterSuite))
+}
+
+func (s *converterSuite) SetupTest() {
+	identity := func(t time.Time) time.Time { return t }
+	s.mysql = NewConverter(NewMySQLDialect(), identity)
+	s.postgres = NewConverter(NewPostgresDialect(), identity)
+}
+
+func (s *converterSuite) TestConvert_Empty() {
+	condition, err := s.mysql.Convert("")
+	s.NoError(err)
+	s.Empty(condition.Where)
+	s.Empty(condition.Args)
+
+	condition, err = s.mysql.Convert("order by StartTime desc")
+	s.NoError(err)
+	s.Empty(condition.Where)
+}
+
+func (s *converterSuite) TestConvert_SystemFields() {
+	condition, err := s.mysql.Convert("WorkflowId = 'wid' and (WorkflowType = 'type' or ExecutionStatus = 'Completed') and HistoryLength > 10")
+	s.NoError(err)
+	s.Equal("((workflow_id = ? AND ((workflow_type_name = ? OR status = ?))) AND history_length > ?)", condition.Where)
+	s.Equal([]interface{}{"wid", "type", int32(2), int64(10)}, condition.Args)
+}
+
+func (s *converterSuite) TestConvert_Time() {
+	condition, err := s.mysql.Convert("StartTime between '2020-01-01T00:00:00Z' and 1577923200000000000")
+	s.NoError(err)
+	s.Equal("start_time BETWEEN ? AND ?", condition.Where)
+	s.Equal([]interface{}{
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, condition.Args)
+}
+
+func (s *converterSuite) TestConvert_Missing() {
+	condition, err := s.mysql.Convert("CloseTime = missing")
+	s.NoError(err)
+	s.Equal("close_time IS NULL", condition.Where)
+	s.Empty(condition.Args)
+}
+
+func (s *converterSuite) TestConvert_In() {
+	condition, err := s.mysql.Convert("RunId not in ('a', 'b')")
+	s.NoError(err)
+	s.Equal("run_id NOT IN (?, ?)", condition.Where)
+	s.Equal([]interface{}{"a", "b"}, condition.Args)
+}
+
+func (s *converterSuite) TestConvert_SearchAttributes_MySQL() {
+	condition, err := s.mysql.Convert("`Attr.CustomKeywordField` = 'keyword' and `Attr.CustomIntField` >= 5")
+	s.NoError(err)
+	s.Equal(`(JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$."CustomKeywordField"'), CAST(? AS JSON)) AND JSON_EXTRACT(search_attributes, '$."CustomIntField"') >= CAST(? AS JSON))`, condition.Where)
+	s.Equal([]interface{}{`"keyword"`, "5"}, condition.Args)
+}
+
+func (s *converterSuite) TestConvert_SearchAttributes_Postgres() {
+	condition, err := s.postgres.Convert("`Attr.CustomBoolField` = true or `Attr.BinaryChecksums` in ('a', 'b')")
+	s.NoError(err)
+	s.Equal(`(((search_attributes->'CustomBoolField') @> CAST(? AS jsonb)) OR (((search_attributes->'BinaryChecksums') @> CAST(? AS jsonb)) OR ((search_attributes->'BinaryChecksums') @> CAST(? AS jsonb))))`, condition.Where)
+	s.Equal([]interface{}{"true", `"a"`, `"b"`}, condition.Args)
+}
+
+func (s *converterSuite) TestConvert_Invalid() {
+	queries := []string{
+		"UnknownField = 'a'",
+		"NamespaceId = 'a'",
+		"`Attr.Bad'Name` = 'a'",
+		"WorkflowId like 'a%'",
+		"HistoryLength = 'ten'",
+		"ExecutionStatus = 'Unknown'",
+		"order by CloseTime desc",
+		"WorkflowId = 'a' order by StartTime asc",
+	}
+	for _, query := range queries {
+		_, err := s.mysql.Convert(query)
+		s.Error(err, query)
+		s.IsType(&serviceerror.InvalidArgument{}, err, query)
+	}
+}
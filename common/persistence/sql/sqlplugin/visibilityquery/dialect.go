@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"fmt"
+)
+
+type (
+	mysqlDialect struct{}
+
+	postgresDialect struct{}
+)
+
+const searchAttributesColumn = "search_attributes"
+
+var _ Dialect = (*mysqlDialect)(nil)
+var _ Dialect = (*postgresDialect)(nil)
+
+// NewMySQLDialect returns the dialect for MySQL 5.7+
+func NewMySQLDialect() Dialect {
+	return &mysqlDialect{}
+}
+
+// NewPostgresDialect returns the dialect for PostgreSQL 9.6+
+func NewPostgresDialect() Dialect {
+	return &postgresDialect{}
+}
+
+func (d *mysqlDialect) JSONField(key string) string {
+	return fmt.Sprintf(`JSON_EXTRACT(%s, '$."%s"')`, searchAttributesColumn, key)
+}
+
+func (d *mysqlDialect) JSONParam() string {
+	return "CAST(? AS JSON)"
+}
+
+func (d *mysqlDialect) JSONContains(field string, value string) string {
+	return fmt.Sprintf("JSON_CONTAINS(%s, %s)", field, value)
+}
+
+func (d *postgresDialect) JSONField(key string) string {
+	return fmt.Sprintf(`(%s->'%s')`, searchAttributesColumn, key)
+}
+
+func (d *postgresDialect) JSONParam() string {
+	return "CAST(? AS jsonb)"
+}
+
+func (d *postgresDialect) JSONContains(field string, value string) string {
+	return fmt.Sprintf("(%s @> %s)", field, value)
+}
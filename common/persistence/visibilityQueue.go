@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination visibilityQueue_mock.go -self_package go.temporal.io/server/common/persistence
+
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	indexerspb "go.temporal.io/server/api/indexer/v1"
+	"go.temporal.io/server/common"
+)
+
+type (
+	// VisibilityQueue is used to publish the messages of the advanced visibility store to the queue of the
+	// persistence store and to consume them, it replaces Kafka between the history and the worker services.
+	// The queue is split in partitions, the messages of a workflow always go to the same partition.
+	VisibilityQueue interface {
+		Close()
+		Publish(message interface{}) error
+		NumPartitions() int
+		PublishToDLQ(partition int, messagePayload []byte) error
+		ReadMessages(partition int, lastMessageID int64, maxCount int) ([]*QueueMessage, error)
+		UpdateAckLevel(partition int, lastProcessedMessageID int64, consumerName string) error
+		GetAckLevel(partition int, consumerName string) (int64, error)
+	}
+
+	visibilityQueueImpl struct {
+		partitions []Queue
+	}
+)
+
+var _ VisibilityQueue = (*visibilityQueueImpl)(nil)
+
+// NewVisibilityQueue creates a new VisibilityQueue instance, partitions are the queues of the partitions
+// in order, see VisibilityQueueType
+func NewVisibilityQueue(partitions []Queue) VisibilityQueue {
+	return &visibilityQueueImpl{
+		partitions: partitions,
+	}
+}
+
+func (q *visibilityQueueImpl) Close() {
+	for _, partition := range q.partitions {
+		partition.Close()
+	}
+}
+
+func (q *visibilityQueueImpl) Publish(message interface{}) error {
+	if len(q.partitions) == 0 {
+		return errors.New("visibility queue is not configured")
+	}
+	msg, ok := message.(*indexerspb.Message)
+	if !ok {
+		return errors.New("wrong message type")
+	}
+
+	bytes, err := msg.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %v", err)
+	}
+	partition := common.WorkflowIDToHistoryShard(msg.GetWorkflowId(), len(q.partitions))
+	return q.partitions[partition].EnqueueMessage(bytes)
+}
+
+func (q *visibilityQueueImpl) NumPartitions() int {
+	return len(q.partitions)
+}
+
+func (q *visibilityQueueImpl) PublishToDLQ(partition int, messagePayload []byte) error {
+	_, err := q.partitions[partition].EnqueueMessageToDLQ(messagePayload)
+	return err
+}
+
+func (q *visibilityQueueImpl) ReadMessages(
+	partition int,
+	lastMessageID int64,
+	maxCount int,
+) ([]*QueueMessage, error) {
+
+	return q.partitions[partition].ReadMessages(lastMessageID, maxCount)
+}
+
+// UpdateAckLevel records the last message of a partition processed by a consumer, then deletes the messages
+// of the partition processed by every consumer
+func (q *visibilityQueueImpl) UpdateAckLevel(
+	partition int,
+	lastProcessedMessageID int64,
+	consumerName string,
+) error {
+
+	queue := q.partitions[partition]
+	if err := queue.UpdateAckLevel(lastProcessedMessageID, consumerName); err != nil {
+		return fmt.Errorf("failed to update ack level: %v", err)
+	}
+
+	ackLevels, err := queue.GetAckLevels()
+	if err != nil {
+		return fmt.Errorf("failed to purge messages: %v", err)
+	}
+	minAckLevel := int64(math.MaxInt64)
+	for _, ackLevel := range ackLevels {
+		if ackLevel < minAckLevel {
+			minAckLevel = ackLevel
+		}
+	}
+	if minAckLevel == math.MaxInt64 {
+		return nil
+	}
+	if err := queue.DeleteMessagesBefore(minAckLevel); err != nil {
+		return fmt.Errorf("failed to purge messages: %v", err)
+	}
+	return nil
+}
+
+func (q *visibilityQueueImpl) GetAckLevel(partition int, consumerName string) (int64, error) {
+	ackLevels, err := q.partitions[partition].GetAckLevels()
+	if err != nil {
+		return emptyMessageID, err
+	}
+
+	ackLevel, ok := ackLevels[consumerName]
+	if !ok {
+		return emptyMessageID, nil
+	}
+	return ackLevel, nil
+}
@@ -0,0 +1,82 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package priority
+
+import (
+	"github.com/dgryski/go-farm"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// PriorityKey is the key of the activity header field, or of the workflow memo field, holding the
+	// priority of the tasks. The tasks of a higher priority are dispatched first, the default priority is 0.
+	PriorityKey = "temporal-priority"
+	// FairnessKeyKey is the key of the activity header field, or of the workflow memo field, holding the
+	// fairness key of the tasks. The tasks of the same priority are dispatched round-robin across fairness keys.
+	FairnessKeyKey = "temporal-fairness-key"
+
+	// MinPriority and MaxPriority are the bounds of the task priorities
+	MinPriority = 0
+	MaxPriority = 100
+)
+
+// FromFields returns the priority and the fairness key of the fields of an activity header or of a workflow memo.
+// The fields which are not set or can't be decoded are ignored.
+func FromFields(fields map[string]*commonpb.Payload) (priority int32, fairnessKey string, ok bool) {
+	if p, found := fields[PriorityKey]; found {
+		if err := payload.Decode(p, &priority); err == nil {
+			ok = true
+		}
+	}
+	if p, found := fields[FairnessKeyKey]; found {
+		if err := payload.Decode(p, &fairnessKey); err == nil {
+			ok = true
+		}
+	}
+	return Clamp(priority), fairnessKey, ok
+}
+
+// Clamp returns the priority within the task priority bounds
+func Clamp(priority int32) int32 {
+	if priority < MinPriority {
+		return MinPriority
+	}
+	if priority > MaxPriority {
+		return MaxPriority
+	}
+	return priority
+}
+
+// FairnessBucket returns the bucket of a fairness key among the given number of buckets, the tasks without a
+// fairness key are in the bucket 0. The backlog of each bucket is stored and read separately, so a key with a
+// large backlog only delays the keys of its bucket.
+func FairnessBucket(fairnessKey string, numBuckets int) int32 {
+	if fairnessKey == "" || numBuckets <= 1 {
+		return 0
+	}
+	return int32(farm.Fingerprint32([]byte(fairnessKey)) % uint32(numBuckets))
+}
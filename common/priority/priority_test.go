@@ -0,0 +1,72 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package priority
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+func TestFromFields(t *testing.T) {
+	_, _, ok := FromFields(nil)
+	assert.False(t, ok)
+
+	p, err := payload.Encode(7)
+	assert.NoError(t, err)
+	priority, fairnessKey, ok := FromFields(map[string]*commonpb.Payload{
+		PriorityKey:    p,
+		FairnessKeyKey: payload.EncodeString("tenant-a"),
+	})
+	assert.True(t, ok)
+	assert.Equal(t, int32(7), priority)
+	assert.Equal(t, "tenant-a", fairnessKey)
+
+	p, err = payload.Encode(1000)
+	assert.NoError(t, err)
+	priority, _, ok = FromFields(map[string]*commonpb.Payload{PriorityKey: p})
+	assert.True(t, ok)
+	assert.Equal(t, int32(MaxPriority), priority)
+
+	_, _, ok = FromFields(map[string]*commonpb.Payload{PriorityKey: payload.EncodeString("high")})
+	assert.False(t, ok)
+}
+
+func TestFairnessBucket(t *testing.T) {
+	assert.Equal(t, int32(0), FairnessBucket("", 4))
+	assert.Equal(t, int32(0), FairnessBucket("tenant-a", 1))
+
+	buckets := make(map[int32]struct{})
+	for _, key := range []string{"tenant-a", "tenant-b", "tenant-c", "tenant-d", "tenant-e", "tenant-f"} {
+		bucket := FairnessBucket(key, 4)
+		assert.True(t, bucket >= 0 && bucket < 4)
+		assert.Equal(t, bucket, FairnessBucket(key, 4))
+		buckets[bucket] = struct{}{}
+	}
+	assert.True(t, len(buckets) > 1)
+}
@@ -35,6 +35,7 @@ type RPSKeyFunc func(key string) float64
 // Info corresponds to information required to determine rate limits
 type Info struct {
 	Namespace string
+	Priority  Priority
 }
 
 // Limiter corresponds to basic rate limiting functionality.
@@ -90,6 +90,42 @@ func TestMultiStageRateLimiterBlockedByGlobalRps(t *testing.T) {
 	assert.Equal(t, 2, numAllowed)
 }
 
+func TestPriorityRateLimiterThrottleLowerPrioritiesFirst(t *testing.T) {
+	policy := newFixedRpsPriorityRateLimiter(100, 3, 0)
+	for n := 0; n < 3; n++ {
+		assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityWorker}))
+	}
+	// the budget of the namespace is used up by the workers
+	assert.False(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityExecution}))
+	assert.False(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityVisibility}))
+	// but the workers are never throttled
+	assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityWorker}))
+}
+
+func TestPriorityRateLimiterLowerPrioritiesDoNotStarveHigherOnes(t *testing.T) {
+	policy := newFixedRpsPriorityRateLimiter(100, 3, 0)
+	for n := 0; n < 3; n++ {
+		assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityVisibility}))
+	}
+	assert.False(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityVisibility}))
+	assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityExecution}))
+}
+
+func TestPriorityRateLimiterBlockedByPriorityRps(t *testing.T) {
+	policy := newFixedRpsPriorityRateLimiter(100, 100, 1)
+	assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityVisibility}))
+	assert.False(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityVisibility}))
+	assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityExecution}))
+}
+
+func TestPriorityRateLimiterBlockedByGlobalRps(t *testing.T) {
+	policy := newFixedRpsPriorityRateLimiter(2, 100, 0)
+	assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityExecution}))
+	assert.True(t, policy.Allow(Info{Namespace: "another-namespace", Priority: PriorityExecution}))
+	assert.False(t, policy.Allow(Info{Namespace: defaultNamespace, Priority: PriorityExecution}))
+	assert.False(t, policy.Allow(Info{Priority: PriorityVisibility}))
+}
+
 func BenchmarkRateLimiter(b *testing.B) {
 	rps := float64(defaultRps)
 	limiter := NewRateLimiter(&rps, 2*time.Minute, defaultRps)
@@ -149,3 +185,20 @@ func getNamespaces(n int) []string {
 	}
 	return namespaces
 }
+
+func newFixedRpsPriorityRateLimiter(globalRps, namespaceRps, visibilityRps float64) Policy {
+	return NewPriorityRateLimiter(
+		func() float64 {
+			return globalRps
+		},
+		func(namespace string) float64 {
+			return namespaceRps
+		},
+		func(namespace string, priority Priority) float64 {
+			if priority == PriorityVisibility {
+				return visibilityRps
+			}
+			return 0
+		},
+	)
+}
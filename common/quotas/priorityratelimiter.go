@@ -0,0 +1,176 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// Priority is the priority class of a request, the requests of lower priority classes are throttled first
+type Priority int
+
+const (
+	// PriorityWorker is the priority of worker task processing, e.g. responding to and heartbeating tasks
+	PriorityWorker Priority = iota
+	// PriorityExecution is the priority of the requests starting and changing workflows, e.g. start and signal
+	PriorityExecution
+	// PriorityVisibility is the priority of visibility requests and queries
+	PriorityVisibility
+
+	numPriorities
+)
+
+// Priorities are all the priority classes from the highest to the lowest
+var Priorities = []Priority{PriorityWorker, PriorityExecution, PriorityVisibility}
+
+type (
+	// PriorityRPSFunc returns the RPS of a priority class for the given namespace
+	PriorityRPSFunc func(namespace string, priority Priority) float64
+
+	// PriorityRateLimiter is a namespace rate limit policy with priority classes.
+	// Each priority class of a namespace has its own budget, and the requests of all the priority classes
+	// share the budget of the namespace and the global budget. The shared budgets have a limiter per priority,
+	// a request is admitted by the limiter of its priority and counted by the limiters of the lower priorities,
+	// so the load of the higher priorities leaves less room to the lower ones. The requests of PriorityWorker
+	// are counted but never rejected by the shared budgets, so the workers can make progress on the workflows.
+	PriorityRateLimiter struct {
+		sync.RWMutex
+		namespaceRPS      RPSKeyFunc
+		priorityRPS       PriorityRPSFunc
+		namespaceLimiters map[string]*namespacePriorityLimiters
+		globalLimiters    [numPriorities]*DynamicRateLimiter
+	}
+
+	namespacePriorityLimiters struct {
+		sharedLimiters   [numPriorities]*DynamicRateLimiter
+		priorityLimiters [numPriorities]*DynamicRateLimiter
+	}
+)
+
+// String returns the name of the priority
+func (p Priority) String() string {
+	switch p {
+	case PriorityWorker:
+		return "worker"
+	case PriorityExecution:
+		return "execution"
+	case PriorityVisibility:
+		return "visibility"
+	default:
+		return "unknown"
+	}
+}
+
+// NewPriorityRateLimiter returns a new namespace quota rate limiter with priority classes.
+// The budget of a priority class is not enforced if priorityRPS returns zero or less.
+func NewPriorityRateLimiter(rps RPSFunc, namespaceRPS RPSKeyFunc, priorityRPS PriorityRPSFunc) *PriorityRateLimiter {
+	rl := &PriorityRateLimiter{
+		namespaceRPS:      namespaceRPS,
+		priorityRPS:       priorityRPS,
+		namespaceLimiters: map[string]*namespacePriorityLimiters{},
+	}
+	for _, priority := range Priorities {
+		rl.globalLimiters[priority] = NewDynamicRateLimiter(rps)
+	}
+	return rl
+}
+
+// Allow attempts to allow a request to go through. The method returns
+// immediately with a true or false indicating if the request can make
+// progress
+func (d *PriorityRateLimiter) Allow(info Info) bool {
+	priority := info.Priority
+	if priority < 0 || priority >= numPriorities {
+		priority = PriorityVisibility
+	}
+
+	var reservations []*rate.Reservation
+	reject := func() bool {
+		for _, rsv := range reservations {
+			rsv.Cancel()
+		}
+		return false
+	}
+
+	stages := [][numPriorities]*DynamicRateLimiter{d.globalLimiters}
+	if len(info.Namespace) != 0 {
+		limiters := d.getNamespaceLimiters(info.Namespace)
+		if d.priorityRPS(info.Namespace, priority) > 0 {
+			rsv := limiters.priorityLimiters[priority].Reserve()
+			reservations = append(reservations, rsv)
+			if !rsv.OK() || rsv.Delay() != 0 {
+				return reject()
+			}
+		}
+		stages = append(stages, limiters.sharedLimiters)
+	}
+
+	for _, stage := range stages {
+		for level := priority; level < numPriorities; level++ {
+			rsv := stage[level].Reserve()
+			if rsv.OK() && rsv.Delay() == 0 {
+				reservations = append(reservations, rsv)
+				continue
+			}
+			// the request is only counted if there is room, so the limiter doesn't go into debt
+			rsv.Cancel()
+			if level == priority && priority != PriorityWorker {
+				return reject()
+			}
+		}
+	}
+	return true
+}
+
+func (d *PriorityRateLimiter) getNamespaceLimiters(namespace string) *namespacePriorityLimiters {
+	d.RLock()
+	limiters, ok := d.namespaceLimiters[namespace]
+	d.RUnlock()
+	if ok {
+		return limiters
+	}
+
+	limiters = &namespacePriorityLimiters{}
+	for _, priority := range Priorities {
+		priority := priority
+		limiters.sharedLimiters[priority] = NewDynamicRateLimiter(func() float64 {
+			return d.namespaceRPS(namespace)
+		})
+		limiters.priorityLimiters[priority] = NewDynamicRateLimiter(func() float64 {
+			return d.priorityRPS(namespace, priority)
+		})
+	}
+
+	// verify that it is needed and add to map
+	d.Lock()
+	defer d.Unlock()
+	if existing, ok := d.namespaceLimiters[namespace]; ok {
+		return existing
+	}
+	d.namespaceLimiters[namespace] = limiters
+	return limiters
+}
@@ -57,6 +57,7 @@ func Dial(hostName string, tlsConfig *tls.Config) (*grpc.ClientConn, error) {
 	return grpc.Dial(hostName,
 		grpcSecureOpt,
 		grpc.WithChainUnaryInterceptor(
+			tracingClientInterceptor,
 			versionHeadersInterceptor,
 			errorInterceptor),
 		grpc.WithDefaultServiceConfig(DefaultServiceConfig),
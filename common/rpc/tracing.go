@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	grpcComponent  = "gRPC"
+	grpcStatusCode = "rpc.grpc.status_code"
+)
+
+// metadataCarrier adapts gRPC metadata to the opentracing text map carrier
+type metadataCarrier metadata.MD
+
+var _ opentracing.TextMapWriter = metadataCarrier{}
+var _ opentracing.TextMapReader = metadataCarrier{}
+
+// TracingServerInterceptor starts a server span for every call, the span continues
+// the trace of the caller if the request metadata carries a trace context. The span
+// is available to the handler through opentracing.SpanFromContext.
+func TracingServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	tracer := opentracing.GlobalTracer()
+	var parent opentracing.SpanContext
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		// a missing or corrupted trace context starts a new trace
+		parent, _ = tracer.Extract(opentracing.HTTPHeaders, metadataCarrier(md))
+	}
+
+	span := tracer.StartSpan(
+		info.FullMethod,
+		ext.RPCServerOption(parent),
+		opentracing.Tag{Key: string(ext.Component), Value: grpcComponent},
+	)
+	defer span.Finish()
+
+	resp, err := handler(opentracing.ContextWithSpan(ctx, span), req)
+	finishRPCSpan(span, err)
+	return resp, err
+}
+
+// tracingClientInterceptor starts a client span for every outgoing call as a child of the
+// span of the context, if any, and injects its trace context into the request metadata
+func tracingClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	tracer := opentracing.GlobalTracer()
+	var parent opentracing.SpanContext
+	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
+		parent = parentSpan.Context()
+	}
+
+	span := tracer.StartSpan(
+		method,
+		opentracing.ChildOf(parent),
+		ext.SpanKindRPCClient,
+		opentracing.Tag{Key: string(ext.Component), Value: grpcComponent},
+	)
+	defer span.Finish()
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	if err := tracer.Inject(span.Context(), opentracing.HTTPHeaders, metadataCarrier(md)); err == nil {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	finishRPCSpan(span, err)
+	return err
+}
+
+func finishRPCSpan(span opentracing.Span, err error) {
+	span.SetTag(grpcStatusCode, serviceerror.ToStatus(err).Code().String())
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogFields(otlog.Error(err))
+	}
+}
+
+// Set implements opentracing.TextMapWriter
+func (c metadataCarrier) Set(key, val string) {
+	// gRPC metadata keys are lower case, an existing trace context is replaced
+	c[strings.ToLower(key)] = []string{val}
+}
+
+// ForeachKey implements opentracing.TextMapReader
+func (c metadataCarrier) ForeachKey(handler func(key, val string) error) error {
+	for key, values := range c {
+		for _, value := range values {
+			if err := handler(key, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
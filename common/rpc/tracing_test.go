@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type (
+	tracingSuite struct {
+		suite.Suite
+		tracer *mocktracer.MockTracer
+	}
+)
+
+func TestTracingSuite(t *testing.T) {
+	suite.Run(t, new(tracingSuite))
+}
+
+func (s *tracingSuite) SetupTest() {
+	s.tracer = mocktracer.New()
+	opentracing.SetGlobalTracer(s.tracer)
+}
+
+func (s *tracingSuite) TearDownTest() {
+	opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+}
+
+func (s *tracingSuite) TestPropagation() {
+	parent := s.tracer.StartSpan("parent")
+	ctx := opentracing.ContextWithSpan(context.Background(), parent)
+	ctx = metadata.AppendToOutgoingContext(ctx, "client-name", "test")
+
+	// the outgoing metadata of the client becomes the incoming metadata of the server
+	var serverCtx context.Context
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		s.True(ok)
+		s.Equal([]string{"test"}, md.Get("client-name"))
+		serverCtx = metadata.NewIncomingContext(context.Background(), md)
+		return nil
+	}
+	s.NoError(tracingClientInterceptor(ctx, "/test/Method", nil, nil, nil, invoker))
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		s.NotNil(opentracing.SpanFromContext(ctx))
+		return nil, serviceerror.NewNotFound("not found")
+	}
+	_, err := TracingServerInterceptor(serverCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"}, handler)
+	s.Error(err)
+	parent.Finish()
+
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 3)
+	clientSpan, serverSpan, parentSpan := spans[0], spans[1], spans[2]
+	s.Equal(parentSpan.SpanContext.TraceID, clientSpan.SpanContext.TraceID)
+	s.Equal(parentSpan.SpanContext.SpanID, clientSpan.ParentID)
+	s.Equal(clientSpan.SpanContext.TraceID, serverSpan.SpanContext.TraceID)
+	s.Equal(clientSpan.SpanContext.SpanID, serverSpan.ParentID)
+	s.Equal("OK", clientSpan.Tag(grpcStatusCode))
+	s.Equal("NotFound", serverSpan.Tag(grpcStatusCode))
+	s.Equal(true, serverSpan.Tag("error"))
+}
+
+func (s *tracingSuite) TestNewTrace() {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	_, err := TracingServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"}, handler)
+	s.NoError(err)
+
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 1)
+	s.Equal(0, spans[0].ParentID)
+}
@@ -32,9 +32,11 @@ import (
 	"github.com/uber-go/tally/prometheus"
 
 	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/elasticsearch"
 	"go.temporal.io/server/common/messaging"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/common/tracing"
 )
 
 const (
@@ -42,6 +44,11 @@ const (
 	ReplicationConsumerTypeKafka = "kafka"
 	// ReplicationConsumerTypeRPC means pulling source DC for replication tasks.
 	ReplicationConsumerTypeRPC = "rpc"
+
+	// MembershipProviderRingpop means members discover each other through ringpop gossip.
+	MembershipProviderRingpop = "ringpop"
+	// MembershipProviderPersistence means members discover each other through heartbeats in the persistence store.
+	MembershipProviderPersistence = "persistence"
 )
 
 type (
@@ -68,6 +75,9 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// DynamicConfigPersistence is the config for the dynamic config stored in the persistence store,
+		// its values override the values of the file based dynamic config client
+		DynamicConfigPersistence dynamicconfig.PersistenceBasedClientConfig `yaml:"dynamicConfigPersistence"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
 	}
@@ -112,6 +122,10 @@ type (
 		PProf PProf `yaml:"pprof"`
 		// TLS controls the communication encryption configuration
 		TLS RootTLS `yaml:"tls"`
+		// Authorization controls the frontend authorizer and claim mapper
+		Authorization authorization.Config `yaml:"authorization"`
+		// Tracing controls where distributed tracing spans are exported to
+		Tracing tracing.Config `yaml:"tracing"`
 	}
 
 	// RootTLS contains all TLS settings for the Temporal server
@@ -164,6 +178,11 @@ type (
 		// This is generally used when BindOnIP would be the same across several nodes (ie: 0.0.0.0)
 		// and for nat traversal scenarios. Check net.ParseIP for supported syntax, only IPv4 is supported.
 		BroadcastAddress string `yaml:"broadcastAddress"`
+		// Provider selects the membership implementation, either "ringpop" (default) or "persistence"
+		// which discovers members from the heartbeats they write to the cluster_membership table
+		Provider string `yaml:"provider"`
+		// HeartbeatInterval is how often members heartbeat and refresh their view with the "persistence" provider
+		HeartbeatInterval time.Duration `yaml:"heartbeatInterval"`
 	}
 
 	// Persistence contains the configuration for data store / persistence layer
@@ -389,6 +408,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -406,24 +426,55 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
 	FilestoreArchiver struct {
-		FileMode string `yaml:"fileMode"`
-		DirMode  string `yaml:"dirMode"`
+		FileMode string            `yaml:"fileMode"`
+		DirMode  string            `yaml:"dirMode"`
+		Encoding *ArchivalEncoding `yaml:"encoding"`
 	}
 
 	// GstorageArchiver contain the config for google storage archiver
 	GstorageArchiver struct {
-		CredentialsPath string `yaml:"credentialsPath"`
+		CredentialsPath string            `yaml:"credentialsPath"`
+		Encoding        *ArchivalEncoding `yaml:"encoding"`
 	}
 
 	// S3Archiver contains the config for S3 archiver
 	S3Archiver struct {
-		Region           string  `yaml:"region"`
-		Endpoint         *string `yaml:"endpoint"`
-		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+		Region           string            `yaml:"region"`
+		Endpoint         *string           `yaml:"endpoint"`
+		S3ForcePathStyle bool              `yaml:"s3ForcePathStyle"`
+		Encoding         *ArchivalEncoding `yaml:"encoding"`
+	}
+
+	// AzblobArchiver contains the config for azure blob storage archiver
+	AzblobArchiver struct {
+		AccountName string `yaml:"accountName"`
+		// AccountKey is the base64 encoded shared key of the storage account
+		AccountKey string `yaml:"accountKey"`
+		// Endpoint is the blob service endpoint, defaults to https://<accountName>.blob.core.windows.net
+		Endpoint string            `yaml:"endpoint"`
+		Encoding *ArchivalEncoding `yaml:"encoding"`
+	}
+
+	// ArchivalEncoding contains the config for the compression and the encryption of archived blobs
+	ArchivalEncoding struct {
+		// Compression is the compression of new blobs: none, gzip or zstd
+		Compression string `yaml:"compression"`
+		// Encryption enables the encryption of new blobs when set
+		Encryption *ArchivalEncryption `yaml:"encryption"`
+	}
+
+	// ArchivalEncryption contains the config for the AES-GCM encryption of archived blobs
+	ArchivalEncryption struct {
+		// KeyringPath is the path of a YAML file mapping key IDs to base64 encoded AES keys,
+		// blobs encrypted with any key of the keyring can be read
+		KeyringPath string `yaml:"keyringPath"`
+		// KeyID is the ID of the key which encrypts new blobs
+		KeyID string `yaml:"keyID"`
 	}
 
 	// PublicClient is config for connecting to temporal frontend
@@ -78,6 +78,14 @@ func ValidateRingpopConfig(rpConfig *config.Membership) error {
 	if rpConfig.BroadcastAddress != "" && net.ParseIP(rpConfig.BroadcastAddress) == nil {
 		return fmt.Errorf("ringpop config malformed `broadcastAddress` param")
 	}
+	switch rpConfig.Provider {
+	case "", config.MembershipProviderRingpop, config.MembershipProviderPersistence:
+	default:
+		return fmt.Errorf("ringpop config unknown `provider` param: %v", rpConfig.Provider)
+	}
+	if rpConfig.HeartbeatInterval < 0 {
+		return fmt.Errorf("ringpop config malformed `heartbeatInterval` param")
+	}
 	return nil
 }
 
@@ -128,6 +136,11 @@ func (factory *RingpopFactory) getMembership() (membership.Monitor, error) {
 }
 
 func (factory *RingpopFactory) createMembership() (membership.Monitor, error) {
+	if factory.config.Provider == config.MembershipProviderPersistence {
+		return membership.NewPersistenceMonitor(factory.serviceName, factory.servicePortMap, factory.logger,
+			factory.metadataManager, factory.broadcastAddressResolver, factory.config.HeartbeatInterval), nil
+	}
+
 	// use actual listen port (in case service is bound to :0 or 0.0.0.0:0)
 	rp, err := factory.getRingpop()
 	if err != nil {
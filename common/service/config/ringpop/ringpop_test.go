@@ -83,6 +83,11 @@ func (s *RingpopSuite) TestInvalidConfig() {
 	s.Error(ValidateRingpopConfig(&cfg))
 	cfg.Name = "test"
 	s.NoError(ValidateRingpopConfig(&cfg))
+	cfg.Provider = config.MembershipProviderPersistence
+	s.NoError(ValidateRingpopConfig(&cfg))
+	cfg.Provider = "gossip"
+	s.Error(ValidateRingpopConfig(&cfg))
+	cfg.Provider = config.MembershipProviderRingpop
 	cfg.BroadcastAddress = "sjhdfskdjhf"
 	s.Error(ValidateRingpopConfig(&cfg))
 }
@@ -68,6 +68,12 @@ func (c *Collection) logError(key Key, err error) {
 	}
 }
 
+func (c *Collection) registerDefault(key Key, valueType ValueType, defaultValue interface{}) {
+	if err := registerDefault(key, valueType, defaultValue); err != nil {
+		c.logger.Error("Invalid dynamic config key", tag.Key(key.String()), tag.Error(err))
+	}
+}
+
 func (c *Collection) logValue(
 	key Key,
 	value, defaultValue interface{},
@@ -139,6 +145,7 @@ type BoolPropertyFnWithTaskQueueInfoFilters func(namespace string, taskQueue str
 
 // GetProperty gets a interface property and returns defaultValue if property is not found
 func (c *Collection) GetProperty(key Key, defaultValue interface{}) PropertyFn {
+	c.registerDefault(key, TypeAny, defaultValue)
 	return func() interface{} {
 		val, err := c.client.GetValue(key, defaultValue)
 		if err != nil {
@@ -160,6 +167,7 @@ func getFilterMap(opts ...FilterOption) map[Filter]interface{} {
 
 // GetIntProperty gets property and asserts that it's an integer
 func (c *Collection) GetIntProperty(key Key, defaultValue int) IntPropertyFn {
+	c.registerDefault(key, TypeInt, defaultValue)
 	return func(opts ...FilterOption) int {
 		val, err := c.client.GetIntValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -172,6 +180,7 @@ func (c *Collection) GetIntProperty(key Key, defaultValue int) IntPropertyFn {
 
 // GetIntPropertyFilteredByNamespace gets property with namespace filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByNamespace(key Key, defaultValue int) IntPropertyFnWithNamespaceFilter {
+	c.registerDefault(key, TypeInt, defaultValue)
 	return func(namespace string) int {
 		val, err := c.client.GetIntValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
 		if err != nil {
@@ -184,6 +193,7 @@ func (c *Collection) GetIntPropertyFilteredByNamespace(key Key, defaultValue int
 
 // GetIntPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByTaskQueueInfo(key Key, defaultValue int) IntPropertyFnWithTaskQueueInfoFilters {
+	c.registerDefault(key, TypeInt, defaultValue)
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) int {
 		val, err := c.client.GetIntValue(
 			key,
@@ -200,6 +210,7 @@ func (c *Collection) GetIntPropertyFilteredByTaskQueueInfo(key Key, defaultValue
 
 // GetIntPropertyFilteredByShardID gets property with shardID as filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByShardID(key Key, defaultValue int) IntPropertyFnWithShardIDFilter {
+	c.registerDefault(key, TypeInt, defaultValue)
 	return func(shardID int) int {
 		val, err := c.client.GetIntValue(
 			key,
@@ -216,6 +227,7 @@ func (c *Collection) GetIntPropertyFilteredByShardID(key Key, defaultValue int)
 
 // GetFloat64Property gets property and asserts that it's a float64
 func (c *Collection) GetFloat64Property(key Key, defaultValue float64) FloatPropertyFn {
+	c.registerDefault(key, TypeFloat, defaultValue)
 	return func(opts ...FilterOption) float64 {
 		val, err := c.client.GetFloatValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -228,6 +240,7 @@ func (c *Collection) GetFloat64Property(key Key, defaultValue float64) FloatProp
 
 // GetFloat64PropertyFilteredByShardID gets property with shardID filter and asserts that it's a float64
 func (c *Collection) GetFloat64PropertyFilteredByShardID(key Key, defaultValue float64) FloatPropertyFnWithShardIDFilter {
+	c.registerDefault(key, TypeFloat, defaultValue)
 	return func(shardID int) float64 {
 		val, err := c.client.GetFloatValue(
 			key,
@@ -244,6 +257,7 @@ func (c *Collection) GetFloat64PropertyFilteredByShardID(key Key, defaultValue f
 
 // GetDurationProperty gets property and asserts that it's a duration
 func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) DurationPropertyFn {
+	c.registerDefault(key, TypeDuration, defaultValue)
 	return func(opts ...FilterOption) time.Duration {
 		val, err := c.client.GetDurationValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -256,6 +270,7 @@ func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) Du
 
 // GetDurationPropertyFilteredByNamespace gets property with namespace filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByNamespace(key Key, defaultValue time.Duration) DurationPropertyFnWithNamespaceFilter {
+	c.registerDefault(key, TypeDuration, defaultValue)
 	return func(namespace string) time.Duration {
 		val, err := c.client.GetDurationValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
 		if err != nil {
@@ -268,6 +283,7 @@ func (c *Collection) GetDurationPropertyFilteredByNamespace(key Key, defaultValu
 
 // GetDurationPropertyFilteredByNamespaceID gets property with namespaceID filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByNamespaceID(key Key, defaultValue time.Duration) DurationPropertyFnWithNamespaceIDFilter {
+	c.registerDefault(key, TypeDuration, defaultValue)
 	return func(namespaceID string) time.Duration {
 		val, err := c.client.GetDurationValue(key, getFilterMap(NamespaceIDFilter(namespaceID)), defaultValue)
 		if err != nil {
@@ -280,6 +296,7 @@ func (c *Collection) GetDurationPropertyFilteredByNamespaceID(key Key, defaultVa
 
 // GetDurationPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByTaskQueueInfo(key Key, defaultValue time.Duration) DurationPropertyFnWithTaskQueueInfoFilters {
+	c.registerDefault(key, TypeDuration, defaultValue)
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) time.Duration {
 		val, err := c.client.GetDurationValue(
 			key,
@@ -296,6 +313,7 @@ func (c *Collection) GetDurationPropertyFilteredByTaskQueueInfo(key Key, default
 
 // GetDurationPropertyFilteredByShardID gets property with shardID id as filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByShardID(key Key, defaultValue time.Duration) DurationPropertyFnWithShardIDFilter {
+	c.registerDefault(key, TypeDuration, defaultValue)
 	return func(shardID int) time.Duration {
 		val, err := c.client.GetDurationValue(
 			key,
@@ -312,6 +330,7 @@ func (c *Collection) GetDurationPropertyFilteredByShardID(key Key, defaultValue
 
 // GetBoolProperty gets property and asserts that it's an bool
 func (c *Collection) GetBoolProperty(key Key, defaultValue bool) BoolPropertyFn {
+	c.registerDefault(key, TypeBool, defaultValue)
 	return func(opts ...FilterOption) bool {
 		val, err := c.client.GetBoolValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -324,6 +343,7 @@ func (c *Collection) GetBoolProperty(key Key, defaultValue bool) BoolPropertyFn
 
 // GetStringProperty gets property and asserts that it's an string
 func (c *Collection) GetStringProperty(key Key, defaultValue string) StringPropertyFn {
+	c.registerDefault(key, TypeString, defaultValue)
 	return func(opts ...FilterOption) string {
 		val, err := c.client.GetStringValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -336,6 +356,7 @@ func (c *Collection) GetStringProperty(key Key, defaultValue string) StringPrope
 
 // GetMapProperty gets property and asserts that it's a map
 func (c *Collection) GetMapProperty(key Key, defaultValue map[string]interface{}) MapPropertyFn {
+	c.registerDefault(key, TypeMap, defaultValue)
 	return func(opts ...FilterOption) map[string]interface{} {
 		val, err := c.client.GetMapValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -348,6 +369,7 @@ func (c *Collection) GetMapProperty(key Key, defaultValue map[string]interface{}
 
 // GetStringPropertyFnWithNamespaceFilter gets property with namespace filter and asserts that its namespace
 func (c *Collection) GetStringPropertyFnWithNamespaceFilter(key Key, defaultValue string) StringPropertyFnWithNamespaceFilter {
+	c.registerDefault(key, TypeString, defaultValue)
 	return func(namespace string) string {
 		val, err := c.client.GetStringValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
 		if err != nil {
@@ -360,6 +382,7 @@ func (c *Collection) GetStringPropertyFnWithNamespaceFilter(key Key, defaultValu
 
 // GetBoolPropertyFnWithNamespaceFilter gets property with namespace filter and asserts that its namespace
 func (c *Collection) GetBoolPropertyFnWithNamespaceFilter(key Key, defaultValue bool) BoolPropertyFnWithNamespaceFilter {
+	c.registerDefault(key, TypeBool, defaultValue)
 	return func(namespace string) bool {
 		val, err := c.client.GetBoolValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
 		if err != nil {
@@ -372,6 +395,7 @@ func (c *Collection) GetBoolPropertyFnWithNamespaceFilter(key Key, defaultValue
 
 // GetBoolPropertyFnWithNamespaceIDFilter gets property with namespaceID filter and asserts that it's a bool
 func (c *Collection) GetBoolPropertyFnWithNamespaceIDFilter(key Key, defaultValue bool) BoolPropertyFnWithNamespaceIDFilter {
+	c.registerDefault(key, TypeBool, defaultValue)
 	return func(id string) bool {
 		val, err := c.client.GetBoolValue(key, getFilterMap(NamespaceIDFilter(id)), defaultValue)
 		if err != nil {
@@ -384,6 +408,7 @@ func (c *Collection) GetBoolPropertyFnWithNamespaceIDFilter(key Key, defaultValu
 
 // GetBoolPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's an bool
 func (c *Collection) GetBoolPropertyFilteredByTaskQueueInfo(key Key, defaultValue bool) BoolPropertyFnWithTaskQueueInfoFilters {
+	c.registerDefault(key, TypeBool, defaultValue)
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) bool {
 		val, err := c.client.GetBoolValue(
 			key,
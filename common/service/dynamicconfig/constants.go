@@ -76,7 +76,12 @@ var keys = map[Key]string{
 	MaxWorkflowTaskTimeout:                 "system.maxWorkflowTaskTimeout",
 	DisallowQuery:                          "system.disallowQuery",
 	EnableBatcher:                          "worker.enableBatcher",
+	EnableScheduler:                        "worker.enableScheduler",
+	BatcherRPS:                             "worker.batcherRPS",
+	BatcherLatencyThreshold:                "worker.batcherLatencyThreshold",
 	EnableParentClosePolicyWorker:          "system.enableParentClosePolicyWorker",
+	DeleteNamespaceRPS:                     "worker.deleteNamespaceRPS",
+	ArchivalBackfillRPS:                    "worker.archivalBackfillRPS",
 	EnableStickyQuery:                      "system.enableStickyQuery",
 	EnablePriorityTaskProcessor:            "system.enablePriorityTaskProcessor",
 	EnableAuthorization:                    "system.enableAuthorization",
@@ -102,8 +107,11 @@ var keys = map[Key]string{
 	FrontendRPS:                           "frontend.rps",
 	FrontendMaxNamespaceRPSPerInstance:    "frontend.namespacerps",
 	FrontendGlobalNamespaceRPS:            "frontend.globalNamespacerps",
+	FrontendNamespaceExecutionRPS:         "frontend.namespaceExecutionRPS",
+	FrontendNamespaceVisibilityRPS:        "frontend.namespaceVisibilityRPS",
 	FrontendHistoryMgrNumConns:            "frontend.historyMgrNumConns",
 	FrontendShutdownDrainDuration:         "frontend.shutdownDrainDuration",
+	FrontendHealthCheckInterval:           "frontend.healthCheckInterval",
 	DisableListVisibilityByFilter:         "frontend.disableListVisibilityByFilter",
 	FrontendThrottledLogRPS:               "frontend.throttledLogRPS",
 	EnableClientVersionCheck:              "frontend.enableClientVersionCheck",
@@ -117,6 +125,8 @@ var keys = map[Key]string{
 	VisibilityArchivalQueryMaxPageSize:    "frontend.visibilityArchivalQueryMaxPageSize",
 	VisibilityArchivalQueryMaxRangeInDays: "frontend.visibilityArchivalQueryMaxRangeInDays",
 	VisibilityArchivalQueryMaxQPS:         "frontend.visibilityArchivalQueryMaxQPS",
+	ArchivalQueryTaskQueue:                "frontend.archivalQueryTaskQueue",
+	EnableArchivalListFallback:            "frontend.enableArchivalListFallback",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -135,11 +145,18 @@ var keys = map[Key]string{
 	MatchingThrottledLogRPS:                 "matching.throttledLogRPS",
 	MatchingNumTaskqueueWritePartitions:     "matching.numTaskqueueWritePartitions",
 	MatchingNumTaskqueueReadPartitions:      "matching.numTaskqueueReadPartitions",
+	MatchingEnablePartitionAutoscaling:      "matching.enablePartitionAutoscaling",
+	MatchingMaxTaskqueuePartitions:          "matching.maxTaskqueuePartitions",
+	MatchingPartitionTargetAddRate:          "matching.partitionTargetAddRate",
+	MatchingPartitionBacklogThreshold:       "matching.partitionBacklogThreshold",
+	MatchingPartitionScalingInterval:        "matching.partitionScalingInterval",
+	MatchingBacklogFairnessBuckets:          "matching.backlogFairnessBuckets",
 	MatchingForwarderMaxOutstandingPolls:    "matching.forwarderMaxOutstandingPolls",
 	MatchingForwarderMaxOutstandingTasks:    "matching.forwarderMaxOutstandingTasks",
 	MatchingForwarderMaxRatePerSecond:       "matching.forwarderMaxRatePerSecond",
 	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
 	MatchingShutdownDrainDuration:           "matching.shutdownDrainDuration",
+	MatchingHealthCheckInterval:             "matching.healthCheckInterval",
 
 	// history settings
 	HistoryRPS:                                             "history.rps",
@@ -153,11 +170,14 @@ var keys = map[Key]string{
 	HistoryCacheMaxSize:                                    "history.cacheMaxSize",
 	HistoryCacheTTL:                                        "history.cacheTTL",
 	HistoryShutdownDrainDuration:                           "history.shutdownDrainDuration",
+	HistoryHealthCheckInterval:                             "history.healthCheckInterval",
 	EventsCacheInitialSize:                                 "history.eventsCacheInitialSize",
 	EventsCacheMaxSize:                                     "history.eventsCacheMaxSize",
 	EventsCacheTTL:                                         "history.eventsCacheTTL",
 	AcquireShardInterval:                                   "history.acquireShardInterval",
 	AcquireShardConcurrency:                                "history.acquireShardConcurrency",
+	EnableGracefulShardHandoff:                             "history.enableGracefulShardHandoff",
+	ShardHandoffTimeout:                                    "history.shardHandoffTimeout",
 	StandbyClusterDelay:                                    "history.standbyClusterDelay",
 	StandbyTaskMissingEventsResendDelay:                    "history.standbyTaskMissingEventsResendDelay",
 	StandbyTaskMissingEventsDiscardDelay:                   "history.standbyTaskMissingEventsDiscardDelay",
@@ -273,6 +293,8 @@ var keys = map[Key]string{
 	WorkerReReplicationContextTimeout:               "worker.workerReReplicationContextTimeout",
 	WorkerEnableRPCReplication:                      "worker.enableWorkerRPCReplication",
 	WorkerIndexerConcurrency:                        "worker.indexerConcurrency",
+	WorkerIndexerQueuePollInterval:                  "worker.indexerQueuePollInterval",
+	WorkerIndexerQueueBatchSize:                     "worker.indexerQueueBatchSize",
 	WorkerESProcessorNumOfWorkers:                   "worker.ESProcessorNumOfWorkers",
 	WorkerESProcessorBulkActions:                    "worker.ESProcessorBulkActions",
 	WorkerESProcessorBulkSize:                       "worker.ESProcessorBulkSize",
@@ -390,12 +412,20 @@ const (
 	FrontendMaxNamespaceRPSPerInstance
 	// FrontendGlobalNamespaceRPS is workflow namespace rate limit per second for the whole cluster
 	FrontendGlobalNamespaceRPS
+	// FrontendNamespaceExecutionRPS is the per instance rate limit per second of the namespace requests starting and
+	// changing workflows, zero means the requests are only limited by the namespace rate limit
+	FrontendNamespaceExecutionRPS
+	// FrontendNamespaceVisibilityRPS is the per instance rate limit per second of the namespace visibility requests and
+	// queries, zero means the requests are only limited by the namespace rate limit
+	FrontendNamespaceVisibilityRPS
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
 	FrontendHistoryMgrNumConns
 	// FrontendThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	FrontendThrottledLogRPS
 	// FrontendShutdownDrainDuration is the duration of traffic drain during shutdown
 	FrontendShutdownDrainDuration
+	// FrontendHealthCheckInterval is the interval at which the readiness of the service dependencies is checked
+	FrontendHealthCheckInterval
 	// EnableClientVersionCheck enables client version check for frontend
 	EnableClientVersionCheck
 
@@ -421,6 +451,12 @@ const (
 	VisibilityArchivalQueryMaxRangeInDays
 	// VisibilityArchivalQueryMaxQPS is the timeout for a visibility archival query
 	VisibilityArchivalQueryMaxQPS
+	// ArchivalQueryTaskQueue is the task queue of the workers which replay the archived history to answer
+	// queries on archived workflows, the task queue of the workflow is used when empty
+	ArchivalQueryTaskQueue
+	// EnableArchivalListFallback is whether ListWorkflowExecutions looks up the archived executions when
+	// none is found in the visibility store
+	EnableArchivalListFallback
 
 	// key for matching
 
@@ -456,6 +492,20 @@ const (
 	MatchingNumTaskqueueWritePartitions
 	// MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue
 	MatchingNumTaskqueueReadPartitions
+	// MatchingEnablePartitionAutoscaling indicates if the partitions of a task queue are scaled from its load,
+	// the number of write partitions is then the min number of partitions
+	MatchingEnablePartitionAutoscaling
+	// MatchingMaxTaskqueuePartitions is the max number of partitions of a task queue with partition autoscaling
+	MatchingMaxTaskqueuePartitions
+	// MatchingPartitionTargetAddRate is the rate of added tasks per second a partition is scaled for
+	MatchingPartitionTargetAddRate
+	// MatchingPartitionBacklogThreshold is the backlog per partition above which a task queue is scaled up
+	MatchingPartitionBacklogThreshold
+	// MatchingPartitionScalingInterval is the interval at which the partitions of a task queue are scaled
+	MatchingPartitionScalingInterval
+	// MatchingBacklogFairnessBuckets is the number of buckets the fairness keys of the tasks of a priority
+	// are hashed to, the backlog of each bucket is stored and read separately
+	MatchingBacklogFairnessBuckets
 	// MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder
 	MatchingForwarderMaxOutstandingPolls
 	// MatchingForwarderMaxOutstandingTasks is the max number of inflight addTask/queryTask from the forwarder
@@ -466,6 +516,8 @@ const (
 	MatchingForwarderMaxChildrenPerNode
 	// MatchingShutdownDrainDuration is the duration of traffic drain during shutdown
 	MatchingShutdownDrainDuration
+	// MatchingHealthCheckInterval is the interval at which the readiness of the service dependencies is checked
+	MatchingHealthCheckInterval
 
 	// key for history
 
@@ -489,6 +541,8 @@ const (
 	HistoryCacheTTL
 	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown
 	HistoryShutdownDrainDuration
+	// HistoryHealthCheckInterval is the interval at which the readiness of the service dependencies is checked
+	HistoryHealthCheckInterval
 	// EventsCacheInitialSize is initial size of events cache
 	EventsCacheInitialSize
 	// EventsCacheMaxSize is max size of events cache
@@ -499,6 +553,10 @@ const (
 	AcquireShardInterval
 	// AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.
 	AcquireShardConcurrency
+	// EnableGracefulShardHandoff is whether shards moving to another host are drained and handed over to the new owner
+	EnableGracefulShardHandoff
+	// ShardHandoffTimeout is the max time a shard handoff may take, including the time requests are held for it
+	ShardHandoffTimeout
 	// StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay
 	// StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing)
@@ -703,6 +761,10 @@ const (
 	WorkerEnableRPCReplication
 	// WorkerIndexerConcurrency is the max concurrent messages to be processed at any given time
 	WorkerIndexerConcurrency
+	// WorkerIndexerQueuePollInterval is the interval at which the indexer polls the visibility queue of the persistence store
+	WorkerIndexerQueuePollInterval
+	// WorkerIndexerQueueBatchSize is the max number of messages the indexer reads from the visibility queue at a time
+	WorkerIndexerQueueBatchSize
 	// WorkerESProcessorNumOfWorkers is num of workers for esProcessor
 	WorkerESProcessorNumOfWorkers
 	// WorkerESProcessorBulkActions is max number of requests in bulk for esProcessor
@@ -739,8 +801,18 @@ const (
 	ExecutionsScannerEnabled
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher
+	// EnableScheduler decides whether start scheduler in our worker
+	EnableScheduler
+	// BatcherRPS is the rate limit per second of all batch operations for the whole cluster
+	BatcherRPS
+	// BatcherLatencyThreshold is the latency of batch operation requests above which batch operations slow down
+	BatcherLatencyThreshold
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	EnableParentClosePolicyWorker
+	// DeleteNamespaceRPS is the rate limit per second of the executions and task queues deleted by the namespace deletion workflows
+	DeleteNamespaceRPS
+	// ArchivalBackfillRPS is the rate limit per second of the closed executions checked and archived by the archival backfill workflows
+	ArchivalBackfillRPS
 	// EnableStickyQuery indicates if sticky query should be enabled per namespace
 	EnableStickyQuery
 
@@ -191,6 +191,9 @@ func (fc *fileBasedClient) GetDurationValue(
 
 func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
 	keyName := keys[name]
+	if err := ValidateValue(keyName, value, nil); err != nil {
+		return err
+	}
 	currentValues := make(map[string][]*constrainedValue)
 
 	confContent, err := ioutil.ReadFile(fc.config.Filepath)
@@ -257,6 +260,10 @@ func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue)
 			}
 		}
 	}
+	// the whole file is rejected if any of its values is invalid, the previous values keep applying
+	if err := validateValues(newValues); err != nil {
+		return fmt.Errorf("invalid dynamic config: %v", err)
+	}
 
 	fc.values.Store(newValues)
 	fc.logger.Info("Updated dynamic config")
@@ -25,6 +25,7 @@
 package dynamicconfig
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -126,32 +127,12 @@ func (s *fileBasedClientSuite) TestGetIntValue_FilterNotMatch() {
 	s.Equal(1000, v)
 }
 
-func (s *fileBasedClientSuite) TestGetIntValue_WrongType() {
-	defaultValue := 2000
-	filters := map[Filter]interface{}{
-		Namespace: "global-samples-namespace",
-	}
-	v, err := s.client.GetIntValue(testGetIntPropertyKey, filters, defaultValue)
-	s.Error(err)
-	s.Equal(defaultValue, v)
-}
-
 func (s *fileBasedClientSuite) TestGetFloatValue() {
 	v, err := s.client.GetFloatValue(testGetFloat64PropertyKey, nil, 1)
 	s.NoError(err)
 	s.Equal(12.0, v)
 }
 
-func (s *fileBasedClientSuite) TestGetFloatValue_WrongType() {
-	filters := map[Filter]interface{}{
-		Namespace: "samples-namespace",
-	}
-	defaultValue := 1.0
-	v, err := s.client.GetFloatValue(testGetFloat64PropertyKey, filters, defaultValue)
-	s.Error(err)
-	s.Equal(defaultValue, v)
-}
-
 func (s *fileBasedClientSuite) TestGetBoolValue() {
 	v, err := s.client.GetBoolValue(testGetBoolPropertyKey, nil, true)
 	s.NoError(err)
@@ -185,39 +166,29 @@ func (s *fileBasedClientSuite) TestGetMapValue() {
 	s.Equal(expectedVal, v)
 }
 
-func (s *fileBasedClientSuite) TestGetMapValue_WrongType() {
-	var defaultVal map[string]interface{}
-	filters := map[Filter]interface{}{
-		TaskQueueName: "random taskqueue",
-	}
-	v, err := s.client.GetMapValue(testGetMapPropertyKey, filters, defaultVal)
-	s.Error(err)
-	s.Equal(defaultVal, v)
-}
-
 func (s *fileBasedClientSuite) TestGetDurationValue() {
 	v, err := s.client.GetDurationValue(testGetDurationPropertyKey, nil, time.Second)
 	s.NoError(err)
 	s.Equal(time.Minute, v)
 }
 
-func (s *fileBasedClientSuite) TestGetDurationValue_NotStringRepresentation() {
-	filters := map[Filter]interface{}{
-		Namespace: "samples-namespace",
-	}
-	v, err := s.client.GetDurationValue(testGetDurationPropertyKey, filters, time.Second)
+func (s *fileBasedClientSuite) TestValidateValues_InvalidFile() {
+	_, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     "config/testInvalidConfig.yaml",
+		PollInterval: time.Second * 5,
+	}, log.NewNoop(), nil)
 	s.Error(err)
-	s.Equal(time.Second, v)
+	s.Contains(err.Error(), "unknown dynamic config key testGetMisspelledPropertyKey")
+	s.Contains(err.Error(), "invalid value of dynamic config key testGetIntPropertyKey")
+	s.Contains(err.Error(), "invalid value of dynamic config key testGetFloat64PropertyKey")
+	s.Contains(err.Error(), "invalid value of dynamic config key testGetMapPropertyKey")
+	s.Contains(err.Error(), "dynamic config key testGetIntPropertyFilteredByNamespaceKey can't be constrained by taskQueueName")
+	s.Equal(2, strings.Count(err.Error(), "invalid value of dynamic config key testGetDurationPropertyKey"))
 }
 
-func (s *fileBasedClientSuite) TestGetDurationValue_ParseFailed() {
-	filters := map[Filter]interface{}{
-		Namespace:     "samples-namespace",
-		TaskQueueName: "longIdleTimeTaskqueue",
-	}
-	v, err := s.client.GetDurationValue(testGetDurationPropertyKey, filters, time.Second)
+func (s *fileBasedClientSuite) TestUpdateValue_WrongType() {
+	err := s.client.UpdateValue(ValidSearchAttributes, "not a map")
 	s.Error(err)
-	s.Equal(time.Second, v)
 }
 
 func (s *fileBasedClientSuite) TestValidateConfig_ConfigNotExist() {
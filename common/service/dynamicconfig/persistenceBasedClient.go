@@ -0,0 +1,319 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var _ Client = (*persistenceBasedClient)(nil)
+
+const (
+	defaultPersistencePollInterval = time.Second * 10
+	minPersistencePollInterval     = time.Second
+)
+
+type (
+	// PersistenceBasedClientConfig is the config for the dynamic config client backed by the persistence store.
+	// The values stored in the persistence store override the values of the file based client.
+	PersistenceBasedClientConfig struct {
+		Enabled      bool          `yaml:"enabled"`
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	// PersistedValue is a value of a dynamic config key stored in the persistence store
+	PersistedValue struct {
+		// Value is the YAML encoded value
+		Value string
+		// Constraints are the filters the value applies to, by filter name
+		Constraints map[string]string
+	}
+
+	// PersistenceLoadFn loads the version and the values of the dynamic config from the persistence store
+	PersistenceLoadFn func() (int64, map[string][]*PersistedValue, error)
+
+	persistenceBasedClient struct {
+		values   atomic.Value
+		version  int64
+		config   *PersistenceBasedClientConfig
+		loadFn   PersistenceLoadFn
+		fallback Client
+		doneCh   chan struct{}
+		logger   log.Logger
+	}
+
+	persistedConstrainedValue struct {
+		value       interface{}
+		constraints map[string]string
+	}
+)
+
+// NewPersistenceBasedClient creates a dynamic config client which polls the values stored in the persistence store.
+// The keys which are not in the persistence store are read from the fallback client.
+func NewPersistenceBasedClient(
+	config *PersistenceBasedClientConfig,
+	loadFn PersistenceLoadFn,
+	fallback Client,
+	logger log.Logger,
+	doneCh chan struct{},
+) (Client, error) {
+	if config == nil {
+		return nil, errors.New("no config found for persistence based dynamic config client")
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultPersistencePollInterval
+	}
+	if config.PollInterval < minPersistencePollInterval {
+		return nil, fmt.Errorf("poll interval should be at least %v", minPersistencePollInterval)
+	}
+
+	client := &persistenceBasedClient{
+		config:   config,
+		loadFn:   loadFn,
+		fallback: fallback,
+		doneCh:   doneCh,
+		logger:   logger,
+	}
+	client.values.Store(make(map[string][]*persistedConstrainedValue))
+	if err := client.update(); err != nil {
+		// the values of the fallback client are used until the persistence store can be read
+		client.logger.Error("Failed to load dynamic config from persistence", tag.Error(err))
+	}
+	go func() {
+		ticker := time.NewTicker(client.config.PollInterval)
+		for {
+			select {
+			case <-ticker.C:
+				if err := client.update(); err != nil {
+					client.logger.Error("Failed to load dynamic config from persistence", tag.Error(err))
+				}
+			case <-client.doneCh:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return client, nil
+}
+
+// ValidatePersistedValue returns an error if a value can't be stored in the persistence store for the key
+func ValidatePersistedValue(name string, value *PersistedValue) error {
+	_, err := parseAndValidatePersistedValue(name, value)
+	return err
+}
+
+func (pc *persistenceBasedClient) GetValue(name Key, defaultValue interface{}) (interface{}, error) {
+	return pc.GetValueWithFilters(name, nil, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetValueWithFilters(name Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error) {
+	if val, ok := pc.getValueWithFilters(name, filters); ok {
+		return val, nil
+	}
+	return pc.fallback.GetValueWithFilters(name, filters, defaultValue)
+}
+
+func (pc *persistenceBasedClient) GetIntValue(name Key, filters map[Filter]interface{}, defaultValue int) (int, error) {
+	val, ok := pc.getValueWithFilters(name, filters)
+	if !ok {
+		return pc.fallback.GetIntValue(name, filters, defaultValue)
+	}
+	if intVal, ok := val.(int); ok {
+		return intVal, nil
+	}
+	return defaultValue, errors.New("value type is not int")
+}
+
+func (pc *persistenceBasedClient) GetFloatValue(name Key, filters map[Filter]interface{}, defaultValue float64) (float64, error) {
+	val, ok := pc.getValueWithFilters(name, filters)
+	if !ok {
+		return pc.fallback.GetFloatValue(name, filters, defaultValue)
+	}
+	if floatVal, ok := val.(float64); ok {
+		return floatVal, nil
+	} else if intVal, ok := val.(int); ok {
+		return float64(intVal), nil
+	}
+	return defaultValue, errors.New("value type is not float64")
+}
+
+func (pc *persistenceBasedClient) GetBoolValue(name Key, filters map[Filter]interface{}, defaultValue bool) (bool, error) {
+	val, ok := pc.getValueWithFilters(name, filters)
+	if !ok {
+		return pc.fallback.GetBoolValue(name, filters, defaultValue)
+	}
+	if boolVal, ok := val.(bool); ok {
+		return boolVal, nil
+	}
+	return defaultValue, errors.New("value type is not bool")
+}
+
+func (pc *persistenceBasedClient) GetStringValue(name Key, filters map[Filter]interface{}, defaultValue string) (string, error) {
+	val, ok := pc.getValueWithFilters(name, filters)
+	if !ok {
+		return pc.fallback.GetStringValue(name, filters, defaultValue)
+	}
+	if stringVal, ok := val.(string); ok {
+		return stringVal, nil
+	}
+	return defaultValue, errors.New("value type is not string")
+}
+
+func (pc *persistenceBasedClient) GetMapValue(
+	name Key, filters map[Filter]interface{}, defaultValue map[string]interface{},
+) (map[string]interface{}, error) {
+	val, ok := pc.getValueWithFilters(name, filters)
+	if !ok {
+		return pc.fallback.GetMapValue(name, filters, defaultValue)
+	}
+	if mapVal, ok := val.(map[string]interface{}); ok {
+		return mapVal, nil
+	}
+	return defaultValue, errors.New("value type is not map")
+}
+
+func (pc *persistenceBasedClient) GetDurationValue(
+	name Key, filters map[Filter]interface{}, defaultValue time.Duration,
+) (time.Duration, error) {
+	val, ok := pc.getValueWithFilters(name, filters)
+	if !ok {
+		return pc.fallback.GetDurationValue(name, filters, defaultValue)
+	}
+	durationString, ok := val.(string)
+	if !ok {
+		return defaultValue, errors.New("value type is not string")
+	}
+	durationVal, err := time.ParseDuration(durationString)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to parse duration: %v", err)
+	}
+	return durationVal, nil
+}
+
+// UpdateValue updates the value of the fallback client, the values of the persistence store are updated
+// through the admin API
+func (pc *persistenceBasedClient) UpdateValue(name Key, value interface{}) error {
+	return pc.fallback.UpdateValue(name, value)
+}
+
+func (pc *persistenceBasedClient) update() error {
+	version, persistedValues, err := pc.loadFn()
+	if err != nil {
+		return err
+	}
+	if version == atomic.LoadInt64(&pc.version) {
+		return nil
+	}
+
+	newValues := make(map[string][]*persistedConstrainedValue, len(persistedValues))
+	for name, values := range persistedValues {
+		for _, value := range values {
+			parsed, err := parseAndValidatePersistedValue(name, value)
+			if err != nil {
+				// an invalid value is skipped, the others still apply
+				pc.logger.Warn("Invalid dynamic config value in persistence", tag.Key(name), tag.Error(err))
+				continue
+			}
+			newValues[name] = append(newValues[name], &persistedConstrainedValue{
+				value:       parsed,
+				constraints: value.Constraints,
+			})
+		}
+	}
+
+	pc.values.Store(newValues)
+	atomic.StoreInt64(&pc.version, version)
+	pc.logger.Info("Updated dynamic config from persistence", tag.Counter(int(version)))
+	return nil
+}
+
+// getValueWithFilters returns false if no value of the persistence store applies to the filters
+func (pc *persistenceBasedClient) getValueWithFilters(key Key, filters map[Filter]interface{}) (interface{}, bool) {
+	values := pc.values.Load().(map[string][]*persistedConstrainedValue)
+	var defaultValue interface{}
+	found := false
+	for _, constrainedValue := range values[keys[key]] {
+		if len(constrainedValue.constraints) == 0 {
+			// special handling for default value (value without any constraints)
+			defaultValue = constrainedValue.value
+			found = true
+			continue
+		}
+		if matchPersisted(constrainedValue, filters) {
+			return constrainedValue.value, true
+		}
+	}
+	return defaultValue, found
+}
+
+// matchPersisted will return true if the constraints matches the filters exactly. The constraints are stored as
+// strings, the task type can be either the name or the number of the enum.
+func matchPersisted(v *persistedConstrainedValue, filters map[Filter]interface{}) bool {
+	if len(v.constraints) != len(filters) {
+		return false
+	}
+
+	for filter, filterValue := range filters {
+		constraint, ok := v.constraints[filter.String()]
+		if !ok {
+			return false
+		}
+		if constraint != fmt.Sprint(filterValue) && constraint != fmt.Sprintf("%d", filterValue) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseAndValidatePersistedValue(name string, value *PersistedValue) (interface{}, error) {
+	parsed, err := parsePersistedValue(value.Value)
+	if err != nil {
+		return nil, err
+	}
+	constraints := make(map[string]interface{}, len(value.Constraints))
+	for constraint, constraintValue := range value.Constraints {
+		constraints[constraint] = constraintValue
+	}
+	if err := ValidateValue(name, parsed, constraints); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+func parsePersistedValue(value string) (interface{}, error) {
+	var parsed interface{}
+	if err := yaml.Unmarshal([]byte(value), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode dynamic config value: %v", err)
+	}
+	return convertKeyTypeToString(parsed)
+}
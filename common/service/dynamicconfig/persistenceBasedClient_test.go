@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/log"
+)
+
+type testPersistedValues struct {
+	version int64
+	values  map[string][]*PersistedValue
+	err     error
+}
+
+func (v *testPersistedValues) load() (int64, map[string][]*PersistedValue, error) {
+	return v.version, v.values, v.err
+}
+
+func newTestPersistenceBasedClient(t *testing.T, persisted *testPersistedValues) *persistenceBasedClient {
+	doneCh := make(chan struct{})
+	t.Cleanup(func() { close(doneCh) })
+	client, err := NewPersistenceBasedClient(
+		&PersistenceBasedClientConfig{Enabled: true, PollInterval: time.Hour},
+		persisted.load,
+		newInMemoryClient(),
+		log.NewNoop(),
+		doneCh,
+	)
+	require.NoError(t, err)
+	return client.(*persistenceBasedClient)
+}
+
+func TestPersistenceBasedClient_OverridesFallback(t *testing.T) {
+	persisted := &testPersistedValues{
+		version: 1,
+		values: map[string][]*PersistedValue{
+			keys[testGetIntPropertyKey]: {
+				{Value: "100"},
+				{Value: "200", Constraints: map[string]string{"namespace": "samples"}},
+				{Value: "300", Constraints: map[string]string{"taskQueueName": "tq", "taskType": "Activity"}},
+			},
+			keys[testGetMapPropertyKey]: {{Value: "key: value"}},
+		},
+	}
+	client := newTestPersistenceBasedClient(t, persisted)
+	client.fallback.(*inMemoryClient).SetValue(testGetBoolPropertyKey, true)
+
+	v, err := client.GetIntValue(testGetIntPropertyKey, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, 100, v)
+
+	v, err = client.GetIntValue(testGetIntPropertyKey, getFilterMap(NamespaceFilter("samples")), 0)
+	require.NoError(t, err)
+	require.Equal(t, 200, v)
+
+	v, err = client.GetIntValue(testGetIntPropertyKey, getFilterMap(
+		TaskQueueFilter("tq"), TaskTypeFilter(enumspb.TASK_QUEUE_TYPE_ACTIVITY)), 0)
+	require.NoError(t, err)
+	require.Equal(t, 300, v)
+
+	m, err := client.GetMapValue(testGetMapPropertyKey, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"key": "value"}, m)
+
+	b, err := client.GetBoolValue(testGetBoolPropertyKey, nil, false)
+	require.NoError(t, err)
+	require.True(t, b, "keys not in persistence are read from the fallback client")
+}
+
+func TestPersistenceBasedClient_Update(t *testing.T) {
+	persisted := &testPersistedValues{err: errors.New("persistence unavailable")}
+	client := newTestPersistenceBasedClient(t, persisted)
+
+	v, err := client.GetIntValue(testGetIntPropertyKey, nil, 10)
+	require.Error(t, err)
+	require.Equal(t, 10, v)
+
+	persisted.err = nil
+	persisted.version = 2
+	persisted.values = map[string][]*PersistedValue{
+		keys[testGetIntPropertyKey]:      {{Value: "20"}},
+		keys[testGetDurationPropertyKey]: {{Value: "["}},
+	}
+	require.NoError(t, client.update())
+
+	v, err = client.GetIntValue(testGetIntPropertyKey, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, 20, v)
+
+	// an invalid value is skipped
+	d, err := client.GetDurationValue(testGetDurationPropertyKey, nil, time.Second)
+	require.Error(t, err)
+	require.Equal(t, time.Second, d)
+}
+
+func TestValidatePersistedValue(t *testing.T) {
+	require.NoError(t, ValidatePersistedValue(keys[testGetIntPropertyKey], &PersistedValue{
+		Value:       "10",
+		Constraints: map[string]string{"namespace": "samples"},
+	}))
+	require.Error(t, ValidatePersistedValue("unknown.key", &PersistedValue{Value: "10"}))
+	require.Error(t, ValidatePersistedValue(keys[testGetIntPropertyKey], &PersistedValue{
+		Value:       "10",
+		Constraints: map[string]string{"cluster": "active"},
+	}))
+	require.Error(t, ValidatePersistedValue(keys[testGetIntPropertyKey], &PersistedValue{Value: "["}))
+}
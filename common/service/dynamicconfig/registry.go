@@ -0,0 +1,578 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v2"
+)
+
+// ValueType is the type of the value of a dynamic config key
+type ValueType int
+
+const (
+	// TypeAny is the type of the keys whose value is not checked
+	TypeAny ValueType = iota
+	// TypeInt is the type of the integer keys
+	TypeInt
+	// TypeFloat is the type of the float keys, integers are accepted as well
+	TypeFloat
+	// TypeBool is the type of the boolean keys
+	TypeBool
+	// TypeString is the type of the string keys
+	TypeString
+	// TypeDuration is the type of the duration keys, their value is a duration string such as "1m30s"
+	TypeDuration
+	// TypeMap is the type of the map keys
+	TypeMap
+)
+
+var valueTypes = []string{
+	"any",
+	"int",
+	"float",
+	"bool",
+	"string",
+	"duration",
+	"map",
+}
+
+func (t ValueType) String() string {
+	if t < TypeAny || t > TypeMap {
+		return valueTypes[TypeAny]
+	}
+	return valueTypes[t]
+}
+
+type (
+	// KeyInfo describes a dynamic config key
+	KeyInfo struct {
+		// Type is the type of the value of the key
+		Type ValueType
+		// Filters are the filters the values of the key can be constrained by
+		Filters []Filter
+		// Description explains what the key controls
+		Description string
+	}
+
+	// KeyValue is the effective value of a dynamic config key for a set of filters
+	KeyValue struct {
+		Key  Key
+		Info KeyInfo
+		// Value is the effective value of the key
+		Value interface{}
+		// DefaultValue is the default value used by the services of this process, nil if none of them reads the key
+		DefaultValue interface{}
+		// IsDefault is true if no value is configured for the key and the filters
+		IsDefault bool
+	}
+)
+
+// registeredDefaults are the default values the services of this process read the keys with, by Key
+var registeredDefaults sync.Map
+
+// Mapping from Key to the description of the key. Every key of keys must be described.
+var keyInfos = map[Key]KeyInfo{
+	// keys for tests
+	testGetPropertyKey:                                {Type: TypeAny, Filters: []Filter{Namespace, TaskQueueName, TaskType}},
+	testGetIntPropertyKey:                             {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}},
+	testGetFloat64PropertyKey:                         {Type: TypeFloat, Filters: []Filter{Namespace, TaskQueueName, TaskType}},
+	testGetDurationPropertyKey:                        {Type: TypeDuration, Filters: []Filter{Namespace, TaskQueueName, TaskType}},
+	testGetBoolPropertyKey:                            {Type: TypeBool, Filters: []Filter{Namespace, TaskQueueName, TaskType}},
+	testGetStringPropertyKey:                          {Type: TypeString, Filters: []Filter{Namespace, TaskQueueName, TaskType}},
+	testGetMapPropertyKey:                             {Type: TypeMap, Filters: []Filter{Namespace, TaskQueueName, TaskType}},
+	testGetIntPropertyFilteredByNamespaceKey:          {Type: TypeInt, Filters: []Filter{Namespace}},
+	testGetDurationPropertyFilteredByNamespaceKey:     {Type: TypeDuration, Filters: []Filter{Namespace}},
+	testGetIntPropertyFilteredByTaskQueueInfoKey:      {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}},
+	testGetDurationPropertyFilteredByTaskQueueInfoKey: {Type: TypeDuration, Filters: []Filter{Namespace, TaskQueueName, TaskType}},
+	testGetBoolPropertyFilteredByNamespaceIDKey:       {Type: TypeBool, Filters: []Filter{NamespaceID}},
+	testGetBoolPropertyFilteredByTaskQueueInfoKey:     {Type: TypeBool, Filters: []Filter{Namespace, TaskQueueName, TaskType}},
+
+	EnableGlobalNamespace:                  {Type: TypeBool, Description: "Enable global namespace"},
+	EnableNDC:                              {Type: TypeBool, Filters: []Filter{Namespace}, Description: "Enable N data center events replication"},
+	EnableNewKafkaClient:                   {Type: TypeBool, Description: "Use the new Kafka client"},
+	EnableVisibilitySampling:               {Type: TypeBool, Description: "Enable visibility sampling"},
+	EnableReadFromClosedExecutionV2:        {Type: TypeBool, Description: "Enable read from temporal_visibility.closed_executions_v2"},
+	AdvancedVisibilityWritingMode:          {Type: TypeString, Description: "How to write to advanced visibility"},
+	EmitShardDiffLog:                       {Type: TypeBool, Description: "Whether emit the shard diff log"},
+	EnableReadVisibilityFromES:             {Type: TypeBool, Filters: []Filter{Namespace}, Description: "Enable read from elastic search"},
+	DisableListVisibilityByFilter:          {Type: TypeBool, Filters: []Filter{Namespace}, Description: "Config to disable list open/close workflow using filter"},
+	HistoryArchivalStatus:                  {Type: TypeString, Description: "The status of history archival"},
+	EnableReadFromHistoryArchival:          {Type: TypeBool, Description: "Enabling reading history from archival store"},
+	VisibilityArchivalStatus:               {Type: TypeString, Description: "The status of visibility archival"},
+	EnableReadFromVisibilityArchival:       {Type: TypeBool, Description: "Enabling reading visibility from archival store"},
+	EnableNamespaceNotActiveAutoForwarding: {Type: TypeBool, Filters: []Filter{Namespace}, Description: "Whether enabling DC auto forwarding to active cluster for signal / start / signal with start API if namespace is not active"},
+	TransactionSizeLimit:                   {Type: TypeInt, Description: "The largest allowed transaction size to persistence"},
+	MinRetentionDays:                       {Type: TypeInt, Description: "The minimal allowed retention days for namespace"},
+	MaxWorkflowTaskTimeout:                 {Type: TypeDuration, Filters: []Filter{Namespace}, Description: "The maximum allowed decision start to close timeout"},
+	DisallowQuery:                          {Type: TypeBool, Filters: []Filter{Namespace}, Description: "Disallow query for a namespace"},
+	EnablePriorityTaskProcessor:            {Type: TypeBool, Description: "Enabling priority task processor"},
+	EnableAuthorization:                    {Type: TypeBool, Description: "Enable authorization for a namespace"},
+	BlobSizeLimitError:                     {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The per event blob size limit"},
+	BlobSizeLimitWarn:                      {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The per event blob size limit for warning"},
+	HistorySizeLimitError:                  {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The per workflow execution history size limit"},
+	HistorySizeLimitWarn:                   {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The per workflow execution history size limit for warning"},
+	HistoryCountLimitError:                 {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The per workflow execution history event count limit"},
+	HistoryCountLimitWarn:                  {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The per workflow execution history event count limit for warning"},
+
+	MaxIDLengthLimit: {Type: TypeInt, Description: "The length limit for various IDs, including: Namespace, TaskQueue, WorkflowID, ActivityID, TimerID, WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID"},
+
+	FrontendPersistenceMaxQPS:          {Type: TypeInt, Description: "The max qps frontend host can query DB"},
+	FrontendPersistenceGlobalMaxQPS:    {Type: TypeInt, Description: "The max qps frontend cluster can query DB"},
+	FrontendVisibilityMaxPageSize:      {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Default max size for ListWorkflowExecutions in one page"},
+	FrontendVisibilityListMaxQPS:       {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Max qps frontend can list open/close workflows"},
+	FrontendESVisibilityListMaxQPS:     {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Max qps frontend can list open/close workflows from ElasticSearch"},
+	FrontendESIndexMaxResultWindow:     {Type: TypeInt, Description: "ElasticSearch index setting max_result_window"},
+	FrontendHistoryMaxPageSize:         {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Default max size for GetWorkflowExecutionHistory in one page"},
+	FrontendRPS:                        {Type: TypeInt, Description: "Workflow rate limit per second"},
+	FrontendMaxNamespaceRPSPerInstance: {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Workflow namespace rate limit per second"},
+	FrontendGlobalNamespaceRPS:         {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Workflow namespace rate limit per second for the whole cluster"},
+	FrontendNamespaceExecutionRPS:      {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The per instance rate limit per second of the namespace requests starting and changing workflows, zero means the requests are only limited by the namespace rate limit"},
+	FrontendNamespaceVisibilityRPS:     {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The per instance rate limit per second of the namespace visibility requests and queries, zero means the requests are only limited by the namespace rate limit"},
+	FrontendHistoryMgrNumConns:         {Type: TypeInt, Description: "For persistence cluster.NumConns"},
+	FrontendThrottledLogRPS:            {Type: TypeInt, Description: "The rate limit on number of log messages emitted per second for throttled logger"},
+	FrontendShutdownDrainDuration:      {Type: TypeDuration, Description: "The duration of traffic drain during shutdown"},
+	FrontendHealthCheckInterval:        {Type: TypeDuration, Description: "The interval at which the readiness of the service dependencies is checked"},
+	EnableClientVersionCheck:           {Type: TypeBool, Description: "Enables client version check for frontend"},
+
+	FrontendMaxBadBinaries:                {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The max number of bad binaries in namespace config"},
+	ValidSearchAttributes:                 {Type: TypeMap, Description: "Legal indexed keys that can be used in list APIs"},
+	SendRawWorkflowHistory:                {Type: TypeBool, Filters: []Filter{Namespace}, Description: "Whether to enable raw history retrieving"},
+	FrontendEnableRPCReplication:          {Type: TypeBool, Description: "A feature flag for rpc replication"},
+	FrontendEnableCleanupReplicationTask:  {Type: TypeBool, Description: "A feature flag for rpc replication cleanup"},
+	SearchAttributesNumberOfKeysLimit:     {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The limit of number of keys"},
+	SearchAttributesSizeOfValueLimit:      {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The size limit of each value"},
+	SearchAttributesTotalSizeLimit:        {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The size limit of the whole map"},
+	VisibilityArchivalQueryMaxPageSize:    {Type: TypeInt, Description: "The maximum page size for a visibility archival query"},
+	VisibilityArchivalQueryMaxRangeInDays: {Type: TypeInt, Description: "The maximum number of days for a visibility archival query"},
+	VisibilityArchivalQueryMaxQPS:         {Type: TypeInt, Description: "The timeout for a visibility archival query"},
+	ArchivalQueryTaskQueue:                {Type: TypeString, Filters: []Filter{Namespace}, Description: "The task queue of the workers which answer queries on archived workflows"},
+	EnableArchivalListFallback:            {Type: TypeBool, Filters: []Filter{Namespace}, Description: "Whether to list the archived executions when none is found in the visibility store"},
+
+	MatchingRPS:                             {Type: TypeInt, Description: "Request rate per second for each matching host"},
+	MatchingPersistenceMaxQPS:               {Type: TypeInt, Description: "The max qps matching host can query DB"},
+	MatchingPersistenceGlobalMaxQPS:         {Type: TypeInt, Description: "The max qps matching cluster can query DB"},
+	MatchingMinTaskThrottlingBurstSize:      {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The minimum burst size for task queue throttling"},
+	MatchingGetTasksBatchSize:               {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The maximum batch size to fetch from the task buffer"},
+	MatchingLongPollExpirationInterval:      {Type: TypeDuration, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The long poll expiration interval in the matching service"},
+	MatchingEnableSyncMatch:                 {Type: TypeBool, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "To enable sync match"},
+	MatchingUpdateAckInterval:               {Type: TypeDuration, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The interval for update ack"},
+	MatchingIdleTaskqueueCheckInterval:      {Type: TypeDuration, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The IdleTaskqueueCheckInterval"},
+	MaxTaskqueueIdleTime:                    {Type: TypeDuration, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The max time taskqueue being idle"},
+	MatchingOutstandingTaskAppendsThreshold: {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The threshold for outstanding task appends"},
+	MatchingMaxTaskBatchSize:                {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "Max batch size for task writer"},
+	MatchingMaxTaskDeleteBatchSize:          {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The max batch size for range deletion of tasks"},
+	MatchingThrottledLogRPS:                 {Type: TypeInt, Description: "The rate limit on number of log messages emitted per second for throttled logger"},
+	MatchingNumTaskqueueWritePartitions:     {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The number of write partitions for a task queue"},
+	MatchingNumTaskqueueReadPartitions:      {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The number of read partitions for a task queue"},
+	MatchingEnablePartitionAutoscaling:      {Type: TypeBool, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "Indicates if the partitions of a task queue are scaled from its load, the number of write partitions is then the min number of partitions"},
+	MatchingMaxTaskqueuePartitions:          {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The max number of partitions of a task queue with partition autoscaling"},
+	MatchingPartitionTargetAddRate:          {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The rate of added tasks per second a partition is scaled for"},
+	MatchingPartitionBacklogThreshold:       {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The backlog per partition above which a task queue is scaled up"},
+	MatchingPartitionScalingInterval:        {Type: TypeDuration, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The interval at which the partitions of a task queue are scaled"},
+	MatchingBacklogFairnessBuckets:          {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The number of buckets of the fairness keys whose backlogs are read separately"},
+	MatchingForwarderMaxOutstandingPolls:    {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The max number of inflight polls from the forwarder"},
+	MatchingForwarderMaxOutstandingTasks:    {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The max number of inflight addTask/queryTask from the forwarder"},
+	MatchingForwarderMaxRatePerSecond:       {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The max rate at which add/query can be forwarded"},
+	MatchingForwarderMaxChildrenPerNode:     {Type: TypeInt, Filters: []Filter{Namespace, TaskQueueName, TaskType}, Description: "The max number of children per node in the task queue partition tree"},
+	MatchingShutdownDrainDuration:           {Type: TypeDuration, Description: "The duration of traffic drain during shutdown"},
+	MatchingHealthCheckInterval:             {Type: TypeDuration, Description: "The interval at which the readiness of the service dependencies is checked"},
+
+	HistoryRPS:                                             {Type: TypeInt, Description: "Request rate per second for each history host"},
+	HistoryPersistenceMaxQPS:                               {Type: TypeInt, Description: "The max qps history host can query DB"},
+	HistoryPersistenceGlobalMaxQPS:                         {Type: TypeInt, Description: "The max qps history cluster can query DB"},
+	HistoryVisibilityOpenMaxQPS:                            {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Max qps one history host can write visibility open_executions"},
+	HistoryVisibilityClosedMaxQPS:                          {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Max qps one history host can write visibility closed_executions"},
+	HistoryLongPollExpirationInterval:                      {Type: TypeDuration, Filters: []Filter{Namespace}, Description: "The long poll expiration interval in the history service"},
+	HistoryCacheInitialSize:                                {Type: TypeInt, Description: "Initial size of history cache"},
+	HistoryCacheMaxSize:                                    {Type: TypeInt, Description: "Max size of history cache"},
+	HistoryCacheTTL:                                        {Type: TypeDuration, Description: "TTL of history cache"},
+	HistoryShutdownDrainDuration:                           {Type: TypeDuration, Description: "The duration of traffic drain during shutdown"},
+	HistoryHealthCheckInterval:                             {Type: TypeDuration, Description: "The interval at which the readiness of the service dependencies is checked"},
+	EventsCacheInitialSize:                                 {Type: TypeInt, Description: "Initial size of events cache"},
+	EventsCacheMaxSize:                                     {Type: TypeInt, Description: "Max size of events cache"},
+	EventsCacheTTL:                                         {Type: TypeDuration, Description: "TTL of events cache"},
+	AcquireShardInterval:                                   {Type: TypeDuration, Description: "Interval that timer used to acquire shard"},
+	AcquireShardConcurrency:                                {Type: TypeInt, Description: "Number of goroutines that can be used to acquire shards in the shard controller"},
+	EnableGracefulShardHandoff:                             {Type: TypeBool, Description: "Whether shards moving to another host are drained and handed over to the new owner"},
+	ShardHandoffTimeout:                                    {Type: TypeDuration, Description: "Max time a shard handoff may take, including the time requests are held for it"},
+	StandbyClusterDelay:                                    {Type: TypeDuration, Description: "The artificial delay added to standby cluster's view of active cluster's time"},
+	StandbyTaskMissingEventsResendDelay:                    {Type: TypeDuration, Description: "The amount of time standby cluster's will wait (if events are missing) before calling remote for missing events"},
+	StandbyTaskMissingEventsDiscardDelay:                   {Type: TypeDuration, Description: "The amount of time standby cluster's will wait (if events are missing) before discarding the task"},
+	TaskProcessRPS:                                         {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The task processing rate per second for each namespace"},
+	TaskSchedulerType:                                      {Type: TypeInt, Description: "The task scheduler type for priority task processor"},
+	TaskSchedulerWorkerCount:                               {Type: TypeInt, Description: "The number of workers per shard in task scheduler"},
+	TaskSchedulerQueueSize:                                 {Type: TypeInt, Description: "The size of task channel size in task scheduler"},
+	TaskSchedulerRoundRobinWeights:                         {Type: TypeMap, Description: "The priority weight for weighted round robin task scheduler"},
+	TimerTaskBatchSize:                                     {Type: TypeInt, Description: "Batch size for timer processor to process tasks"},
+	TimerTaskWorkerCount:                                   {Type: TypeInt, Description: "Number of task workers for timer processor"},
+	TimerTaskMaxRetryCount:                                 {Type: TypeInt, Description: "Max retry count for timer processor"},
+	TimerProcessorGetFailureRetryCount:                     {Type: TypeInt, Description: "Retry count for timer processor get failure operation"},
+	TimerProcessorCompleteTimerFailureRetryCount:           {Type: TypeInt, Description: "Retry count for timer processor complete timer operation"},
+	TimerProcessorUpdateShardTaskCount:                     {Type: TypeInt, Description: "Update shard count for timer processor"},
+	TimerProcessorUpdateAckInterval:                        {Type: TypeDuration, Description: "Update interval for timer processor"},
+	TimerProcessorUpdateAckIntervalJitterCoefficient:       {Type: TypeFloat, Description: "The update interval jitter coefficient"},
+	TimerProcessorCompleteTimerInterval:                    {Type: TypeDuration, Description: "Complete timer interval for timer processor"},
+	TimerProcessorFailoverMaxPollRPS:                       {Type: TypeInt, Description: "Max poll rate per second for timer processor"},
+	TimerProcessorMaxPollRPS:                               {Type: TypeInt, Description: "Max poll rate per second for timer processor"},
+	TimerProcessorMaxPollInterval:                          {Type: TypeDuration, Description: "Max poll interval for timer processor"},
+	TimerProcessorMaxPollIntervalJitterCoefficient:         {Type: TypeFloat, Description: "The max poll interval jitter coefficient"},
+	TimerProcessorRedispatchInterval:                       {Type: TypeDuration, Description: "The redispatch interval for timer processor"},
+	TimerProcessorRedispatchIntervalJitterCoefficient:      {Type: TypeFloat, Description: "The redispatch interval jitter coefficient"},
+	TimerProcessorMaxRedispatchQueueSize:                   {Type: TypeInt, Description: "The threshold of the number of tasks in the redispatch queue for timer processor"},
+	TimerProcessorEnablePriorityTaskProcessor:              {Type: TypeBool, Description: "Indicates whether priority task processor should be used for timer processor"},
+	TimerProcessorMaxTimeShift:                             {Type: TypeDuration, Description: "The max shift timer processor can have"},
+	TimerProcessorHistoryArchivalSizeLimit:                 {Type: TypeInt, Description: "The max history size for inline archival"},
+	TimerProcessorArchivalTimeLimit:                        {Type: TypeDuration, Description: "The upper time limit for inline history archival"},
+	TransferTaskBatchSize:                                  {Type: TypeInt, Description: "Batch size for transferQueueProcessor"},
+	TransferProcessorFailoverMaxPollRPS:                    {Type: TypeInt, Description: "Max poll rate per second for transferQueueProcessor"},
+	TransferProcessorMaxPollRPS:                            {Type: TypeInt, Description: "Max poll rate per second for transferQueueProcessor"},
+	TransferTaskWorkerCount:                                {Type: TypeInt, Description: "Number of worker for transferQueueProcessor"},
+	TransferTaskMaxRetryCount:                              {Type: TypeInt, Description: "Max times of retry for transferQueueProcessor"},
+	TransferProcessorCompleteTransferFailureRetryCount:     {Type: TypeInt, Description: "Times of retry for failure"},
+	TransferProcessorUpdateShardTaskCount:                  {Type: TypeInt, Description: "Update shard count for transferQueueProcessor"},
+	TransferProcessorMaxPollInterval:                       {Type: TypeDuration, Description: "Max poll interval for transferQueueProcessor"},
+	TransferProcessorMaxPollIntervalJitterCoefficient:      {Type: TypeFloat, Description: "The max poll interval jitter coefficient"},
+	TransferProcessorUpdateAckInterval:                     {Type: TypeDuration, Description: "Update interval for transferQueueProcessor"},
+	TransferProcessorUpdateAckIntervalJitterCoefficient:    {Type: TypeFloat, Description: "The update interval jitter coefficient"},
+	TransferProcessorCompleteTransferInterval:              {Type: TypeDuration, Description: "Complete timer interval for transferQueueProcessor"},
+	TransferProcessorRedispatchInterval:                    {Type: TypeDuration, Description: "The redispatch interval for transferQueueProcessor"},
+	TransferProcessorRedispatchIntervalJitterCoefficient:   {Type: TypeFloat, Description: "The redispatch interval jitter coefficient"},
+	TransferProcessorMaxRedispatchQueueSize:                {Type: TypeInt, Description: "The threshold of the number of tasks in the redispatch queue for transferQueueProcessor"},
+	TransferProcessorEnablePriorityTaskProcessor:           {Type: TypeBool, Description: "Indicates whether priority task processor should be used for transferQueueProcessor"},
+	TransferProcessorVisibilityArchivalTimeLimit:           {Type: TypeDuration, Description: "The upper time limit for archiving visibility records"},
+	ReplicatorTaskBatchSize:                                {Type: TypeInt, Description: "Batch size for ReplicatorProcessor"},
+	ReplicatorTaskWorkerCount:                              {Type: TypeInt, Description: "Number of worker for ReplicatorProcessor"},
+	ReplicatorTaskMaxRetryCount:                            {Type: TypeInt, Description: "Max times of retry for ReplicatorProcessor"},
+	ReplicatorProcessorMaxPollRPS:                          {Type: TypeInt, Description: "Max poll rate per second for ReplicatorProcessor"},
+	ReplicatorProcessorUpdateShardTaskCount:                {Type: TypeInt, Description: "Update shard count for ReplicatorProcessor"},
+	ReplicatorProcessorMaxPollInterval:                     {Type: TypeDuration, Description: "Max poll interval for ReplicatorProcessor"},
+	ReplicatorProcessorMaxPollIntervalJitterCoefficient:    {Type: TypeFloat, Description: "The max poll interval jitter coefficient"},
+	ReplicatorProcessorUpdateAckInterval:                   {Type: TypeDuration, Description: "Update interval for ReplicatorProcessor"},
+	ReplicatorProcessorUpdateAckIntervalJitterCoefficient:  {Type: TypeFloat, Description: "The update interval jitter coefficient"},
+	ReplicatorProcessorRedispatchInterval:                  {Type: TypeDuration, Description: "The redispatch interval for ReplicatorProcessor"},
+	ReplicatorProcessorRedispatchIntervalJitterCoefficient: {Type: TypeFloat, Description: "The redispatch interval jitter coefficient"},
+	ReplicatorProcessorMaxRedispatchQueueSize:              {Type: TypeInt, Description: "The threshold of the number of tasks in the redispatch queue for ReplicatorProcessor"},
+	ReplicatorProcessorEnablePriorityTaskProcessor:         {Type: TypeBool, Description: "Indicates whether priority task processor should be used for ReplicatorProcessor"},
+	ExecutionMgrNumConns:                                   {Type: TypeInt, Description: "Persistence connections number for ExecutionManager"},
+	HistoryMgrNumConns:                                     {Type: TypeInt, Description: "Persistence connections number for HistoryManager"},
+	MaximumBufferedEventsBatch:                             {Type: TypeInt, Description: "Max number of buffer event in mutable state"},
+	MaximumSignalsPerExecution:                             {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Max number of signals supported by single execution"},
+	ShardUpdateMinInterval:                                 {Type: TypeDuration, Description: "The minimal time interval which the shard info can be updated"},
+	ShardSyncMinInterval:                                   {Type: TypeDuration, Description: "The minimal time interval which the shard info should be sync to remote"},
+	ShardSyncTimerJitterCoefficient:                        {Type: TypeFloat, Description: "The sync shard jitter coefficient"},
+	DefaultEventEncoding:                                   {Type: TypeString, Filters: []Filter{Namespace}, Description: "The encoding type for history events"},
+	NumArchiveSystemWorkflows:                              {Type: TypeInt, Description: "Number of archive system workflows running in total"},
+	ArchiveRequestRPS:                                      {Type: TypeInt, Description: "The rate limit on the number of archive request per second"},
+
+	EnableAdminProtection:     {Type: TypeBool, Description: "Whether to enable admin checking"},
+	AdminOperationToken:       {Type: TypeString, Description: "The token to pass admin checking"},
+	HistoryMaxAutoResetPoints: {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Max number of auto reset points stored in mutableState"},
+
+	EnableParentClosePolicy:             {Type: TypeBool, Filters: []Filter{Namespace}, Description: "Whether to  ParentClosePolicy"},
+	ParentClosePolicyThreshold:          {Type: TypeInt, Filters: []Filter{Namespace}, Description: "Decides that parent close policy will be processed by sys workers(if enabled) if the number of children greater than or equal to this threshold"},
+	NumParentClosePolicySystemWorkflows: {Type: TypeInt, Description: "Number of parentClosePolicy system workflows running in total"},
+
+	HistoryThrottledLogRPS:          {Type: TypeInt, Description: "The rate limit on number of log messages emitted per second for throttled logger"},
+	StickyTTL:                       {Type: TypeDuration, Filters: []Filter{Namespace}, Description: "To expire a sticky taskqueue if no update more than this duration"},
+	DecisionHeartbeatTimeout:        {Type: TypeDuration, Filters: []Filter{Namespace}, Description: "For decision heartbeat"},
+	DefaultWorkflowExecutionTimeout: {Type: TypeDuration, Filters: []Filter{Namespace}, Description: "For a workflow execution"},
+	DefaultWorkflowRunTimeout:       {Type: TypeDuration, Filters: []Filter{Namespace}, Description: "For a workflow run"},
+	MaxWorkflowExecutionTimeout:     {Type: TypeDuration, Filters: []Filter{Namespace}, Description: "Maximum allowed workflow execution timeout"},
+	MaxWorkflowRunTimeout:           {Type: TypeDuration, Filters: []Filter{Namespace}, Description: "Maximum allowed workflow run timeout"},
+	DefaultWorkflowTaskTimeout:      {Type: TypeDuration, Filters: []Filter{Namespace}, Description: "For a decision task"},
+
+	EnableDropStuckTaskByNamespaceID: {Type: TypeBool, Filters: []Filter{NamespaceID}, Description: "Whether stuck timer/transfer task should be dropped for a namespace"},
+	SkipReapplicationByNamespaceId:   {Type: TypeBool, Filters: []Filter{NamespaceID}, Description: "SkipReapplicationByNameSpaceId is whether skipping a event re-application for a namespace"},
+
+	WorkerPersistenceMaxQPS:                         {Type: TypeInt, Description: "The max qps worker host can query DB"},
+	WorkerPersistenceGlobalMaxQPS:                   {Type: TypeInt, Description: "The max qps worker cluster can query DB"},
+	WorkerReplicatorMetaTaskConcurrency:             {Type: TypeInt, Description: "The number of coroutine handling metadata related tasks"},
+	WorkerReplicatorTaskConcurrency:                 {Type: TypeInt, Description: "The number of coroutine handling non metadata related tasks"},
+	WorkerReplicatorMessageConcurrency:              {Type: TypeInt, Description: "The max concurrent tasks provided by messaging client"},
+	WorkerReplicatorActivityBufferRetryCount:        {Type: TypeInt, Description: "The retry attempt when encounter retry error on activity"},
+	WorkerReplicatorHistoryBufferRetryCount:         {Type: TypeInt, Description: "The retry attempt when encounter retry error on history"},
+	WorkerReplicationTaskMaxRetryCount:              {Type: TypeInt, Description: "The max retry count for any task"},
+	WorkerReplicationTaskMaxRetryDuration:           {Type: TypeDuration, Description: "The max retry duration for any task"},
+	WorkerReplicationTaskContextDuration:            {Type: TypeDuration, Description: "The context timeout for apply replication tasks"},
+	WorkerReReplicationContextTimeout:               {Type: TypeDuration, Filters: []Filter{NamespaceID}, Description: "The context timeout for end to end  re-replication process"},
+	WorkerEnableRPCReplication:                      {Type: TypeBool, Description: "The feature flag for RPC replication"},
+	WorkerIndexerConcurrency:                        {Type: TypeInt, Description: "The max concurrent messages to be processed at any given time"},
+	WorkerIndexerQueuePollInterval:                  {Type: TypeDuration, Description: "The interval at which the indexer polls the visibility queue of the persistence store"},
+	WorkerIndexerQueueBatchSize:                     {Type: TypeInt, Description: "The max number of messages the indexer reads from the visibility queue at a time"},
+	WorkerESProcessorNumOfWorkers:                   {Type: TypeInt, Description: "Num of workers for esProcessor"},
+	WorkerESProcessorBulkActions:                    {Type: TypeInt, Description: "Max number of requests in bulk for esProcessor"},
+	WorkerESProcessorBulkSize:                       {Type: TypeInt, Description: "Max total size of bulk in bytes for esProcessor"},
+	WorkerESProcessorFlushInterval:                  {Type: TypeDuration, Description: "Flush interval for esProcessor"},
+	EnableArchivalCompression:                       {Type: TypeBool, Description: "Indicates whether blobs are compressed before they are archived"},
+	WorkerHistoryPageSize:                           {Type: TypeInt, Description: "Indicates the page size of history fetched from persistence for archival"},
+	WorkerTargetArchivalBlobSize:                    {Type: TypeInt, Description: "Indicates the target blob size in bytes for archival, actual blob size may vary"},
+	WorkerArchiverConcurrency:                       {Type: TypeInt, Description: "Controls the number of coroutines handling archival work per archival workflow"},
+	WorkerArchivalsPerIteration:                     {Type: TypeInt, Description: "Controls the number of archivals handled in each iteration of archival workflow"},
+	WorkerDeterministicConstructionCheckProbability: {Type: TypeFloat, Description: "Controls the probability of running a deterministic construction check for any given archival"},
+	WorkerBlobIntegrityCheckProbability:             {Type: TypeFloat, Description: "Controls the probability of running an integrity check for any given archival"},
+	WorkerTimeLimitPerArchivalIteration:             {Type: TypeDuration, Description: "Controls the time limit of each iteration of archival workflow"},
+	WorkerThrottledLogRPS:                           {Type: TypeInt, Description: "The rate limit on number of log messages emitted per second for throttled logger"},
+	ScannerPersistenceMaxQPS:                        {Type: TypeInt, Description: "The maximum rate of persistence calls from worker.Scanner"},
+	TaskQueueScannerEnabled:                         {Type: TypeBool, Description: "Indicates if task queue scanner should be started as part of worker.Scanner"},
+	HistoryScannerEnabled:                           {Type: TypeBool, Description: "Indicates if history scanner should be started as part of worker.Scanner"},
+	ExecutionsScannerEnabled:                        {Type: TypeBool, Description: "Indicates if executions scanner should be started as part of worker.Scanner"},
+	EnableBatcher:                                   {Type: TypeBool, Description: "Decides whether start batcher in our worker"},
+	EnableScheduler:                                 {Type: TypeBool, Description: "Decides whether start scheduler in our worker"},
+	BatcherRPS:                                      {Type: TypeInt, Description: "The rate limit per second of all batch operations for the whole cluster"},
+	BatcherLatencyThreshold:                         {Type: TypeDuration, Description: "The latency of batch operation requests above which batch operations slow down"},
+	EnableParentClosePolicyWorker:                   {Type: TypeBool, Description: "Decides whether or not enable system workers for processing parent close policy task"},
+	DeleteNamespaceRPS:                              {Type: TypeInt, Description: "The rate limit per second of the executions and task queues deleted by the namespace deletion workflows"},
+	ArchivalBackfillRPS:                             {Type: TypeInt, Description: "The rate limit per second of the closed executions checked and archived by the archival backfill workflows"},
+	EnableStickyQuery:                               {Type: TypeBool, Filters: []Filter{Namespace}, Description: "Indicates if sticky query should be enabled per namespace"},
+
+	ReplicationTaskFetcherParallelism:                {Type: TypeInt, Description: "Determines how many go routines we spin up for fetching tasks"},
+	ReplicationTaskFetcherAggregationInterval:        {Type: TypeDuration, Description: "Determines how frequently the fetch requests are sent"},
+	ReplicationTaskFetcherTimerJitterCoefficient:     {Type: TypeFloat, Description: "The jitter for fetcher timer"},
+	ReplicationTaskFetcherErrorRetryWait:             {Type: TypeDuration, Description: "The wait time when fetcher encounters error"},
+	ReplicationTaskProcessorErrorRetryWait:           {Type: TypeDuration, Filters: []Filter{ShardID}, Description: "The initial retry wait when we see errors in applying replication tasks"},
+	ReplicationTaskProcessorErrorRetryMaxAttempts:    {Type: TypeInt, Filters: []Filter{ShardID}, Description: "The max retry attempts for applying replication tasks"},
+	ReplicationTaskProcessorNoTaskInitialWait:        {Type: TypeDuration, Filters: []Filter{ShardID}, Description: "The wait time when not ask is returned"},
+	ReplicationTaskProcessorCleanupInterval:          {Type: TypeDuration, Filters: []Filter{ShardID}, Description: "Determines how frequently the cleanup replication queue"},
+	ReplicationTaskProcessorCleanupJitterCoefficient: {Type: TypeFloat, Filters: []Filter{ShardID}, Description: "The jitter for cleanup timer"},
+	HistoryEnableRPCReplication:                      {Type: TypeBool, Description: "The feature flag for RPC replication"},
+	HistoryEnableKafkaReplication:                    {Type: TypeBool, Description: "The migration flag for Kafka replication"},
+	HistoryEnableCleanupReplicationTask:              {Type: TypeBool, Description: "The migration flag for Kafka replication"},
+	MaxBufferedQueryCount:                            {Type: TypeInt, Description: "EnableConsistentQuery indicates if consistent query is enabled for the cluster"},
+	MutableStateChecksumGenProbability:               {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The probability [0-100] that checksum will be generated for mutable state"},
+	MutableStateChecksumVerifyProbability:            {Type: TypeInt, Filters: []Filter{Namespace}, Description: "The probability [0-100] that checksum will be verified for mutable state"},
+	MutableStateChecksumInvalidateBefore:             {Type: TypeFloat, Description: "The epoch timestamp before which all checksums are to be discarded"},
+
+	ReplicationEventsFromCurrentCluster: {Type: TypeBool, Filters: []Filter{Namespace}, Description: "A feature flag to allow cross DC replicate events that generated from the current cluster"},
+}
+
+// GetKeyInfo returns the description of a key
+func GetKeyInfo(key Key) (KeyInfo, bool) {
+	info, ok := keyInfos[key]
+	return info, ok
+}
+
+// KeyFromName returns the key of a key name
+func KeyFromName(name string) (Key, bool) {
+	for key, keyName := range keys {
+		if keyName == name && key != unknownKey {
+			return key, true
+		}
+	}
+	return unknownKey, false
+}
+
+// FilterFromName returns the filter of a filter name
+func FilterFromName(name string) (Filter, bool) {
+	for filter := unknownFilter + 1; filter < lastFilterTypeForTest; filter++ {
+		if filter.String() == name {
+			return filter, true
+		}
+	}
+	return unknownFilter, false
+}
+
+// ListKeys returns all the described keys sorted by name, the keys for tests excluded
+func ListKeys() []Key {
+	result := make([]Key, 0, len(keyInfos))
+	for key := range keyInfos {
+		if key < EnableGlobalNamespace {
+			continue
+		}
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+	return result
+}
+
+// ValidateValue returns an error if the value can't be set for the key name with the constraints.
+// The value is expected as decoded from YAML, with the keys of the maps converted to strings.
+func ValidateValue(name string, value interface{}, constraints map[string]interface{}) error {
+	key, ok := KeyFromName(name)
+	if !ok {
+		return fmt.Errorf("unknown dynamic config key %v", name)
+	}
+	info := keyInfos[key]
+	for constraint := range constraints {
+		filter, ok := FilterFromName(constraint)
+		if !ok {
+			return fmt.Errorf("unknown constraint %v of dynamic config key %v", constraint, name)
+		}
+		if !info.hasFilter(filter) {
+			return fmt.Errorf("dynamic config key %v can't be constrained by %v", name, constraint)
+		}
+	}
+	if err := info.Type.validate(value); err != nil {
+		return fmt.Errorf("invalid value of dynamic config key %v: %v", name, err)
+	}
+	return nil
+}
+
+// DumpValues returns the effective values of all the keys for the filters. Only the filters a key can be
+// constrained by are applied to it.
+func DumpValues(client Client, filters map[Filter]interface{}) []*KeyValue {
+	result := make([]*KeyValue, 0, len(keyInfos))
+	for _, key := range ListKeys() {
+		info := keyInfos[key]
+		keyFilters := make(map[Filter]interface{}, len(info.Filters))
+		for _, filter := range info.Filters {
+			if value, ok := filters[filter]; ok {
+				keyFilters[filter] = value
+			}
+		}
+		defaultValue, _ := registeredDefaults.Load(key)
+		value, err := getTypedValue(client, key, info.Type, keyFilters, defaultValue)
+		result = append(result, &KeyValue{
+			Key:          key,
+			Info:         info,
+			Value:        value,
+			DefaultValue: defaultValue,
+			IsDefault:    err != nil,
+		})
+	}
+	return result
+}
+
+// EncodeValue returns the YAML encoding of a value, the durations are encoded as duration strings.
+// It returns an empty string for a nil value.
+func EncodeValue(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	if duration, ok := value.(time.Duration); ok {
+		value = duration.String()
+	}
+	encoded, err := yaml.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(encoded), "\n"), nil
+}
+
+// registerDefault records the default value a key is read with and returns an error
+// if the key is read with another type than its own
+func registerDefault(key Key, valueType ValueType, defaultValue interface{}) error {
+	info, ok := keyInfos[key]
+	if !ok {
+		return errors.New("dynamic config key is not described")
+	}
+	registeredDefaults.Store(key, defaultValue)
+	if info.Type != TypeAny && valueType != TypeAny && info.Type != valueType {
+		return fmt.Errorf("dynamic config key of type %v read as %v", info.Type, valueType)
+	}
+	return nil
+}
+
+// validateValues returns the errors of all the values which can't be set, nil if they are all valid
+func validateValues(values map[string][]*constrainedValue) error {
+	var result error
+	for name, constrainedValues := range values {
+		for _, value := range constrainedValues {
+			if err := ValidateValue(name, value.Value, value.Constraints); err != nil {
+				result = multierr.Append(result, err)
+			}
+		}
+	}
+	return result
+}
+
+func getTypedValue(client Client, key Key, valueType ValueType, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error) {
+	switch valueType {
+	case TypeInt:
+		value, _ := defaultValue.(int)
+		return client.GetIntValue(key, filters, value)
+	case TypeFloat:
+		value, _ := defaultValue.(float64)
+		return client.GetFloatValue(key, filters, value)
+	case TypeBool:
+		value, _ := defaultValue.(bool)
+		return client.GetBoolValue(key, filters, value)
+	case TypeString:
+		value, _ := defaultValue.(string)
+		return client.GetStringValue(key, filters, value)
+	case TypeDuration:
+		value, _ := defaultValue.(time.Duration)
+		return client.GetDurationValue(key, filters, value)
+	case TypeMap:
+		value, _ := defaultValue.(map[string]interface{})
+		return client.GetMapValue(key, filters, value)
+	default:
+		return client.GetValueWithFilters(key, filters, defaultValue)
+	}
+}
+
+func (i KeyInfo) hasFilter(filter Filter) bool {
+	for _, f := range i.Filters {
+		if f == filter {
+			return true
+		}
+	}
+	return false
+}
+
+func (t ValueType) validate(value interface{}) error {
+	switch t {
+	case TypeInt:
+		if _, ok := value.(int); !ok {
+			return fmt.Errorf("%v is not an int", value)
+		}
+	case TypeFloat:
+		_, isFloat := value.(float64)
+		_, isInt := value.(int)
+		if !isFloat && !isInt {
+			return fmt.Errorf("%v is not a float", value)
+		}
+	case TypeBool:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%v is not a bool", value)
+		}
+	case TypeString:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%v is not a string", value)
+		}
+	case TypeDuration:
+		durationString, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a duration string", value)
+		}
+		if _, err := time.ParseDuration(durationString); err != nil {
+			return err
+		}
+	case TypeMap:
+		if _, ok := value.(map[string]interface{}); !ok {
+			return fmt.Errorf("%v is not a map", value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+func TestKeyInfos_AllKeysDescribed(t *testing.T) {
+	for key, name := range keys {
+		if key == unknownKey {
+			continue
+		}
+		info, ok := GetKeyInfo(key)
+		require.True(t, ok, "key %v is not described", name)
+		if key >= EnableGlobalNamespace {
+			assert.NotEmpty(t, info.Description, "key %v has no description", name)
+		}
+	}
+	for key := range keyInfos {
+		_, ok := keys[key]
+		assert.True(t, ok, "described key %v has no name", key)
+	}
+}
+
+func TestValidateValue(t *testing.T) {
+	testCases := []struct {
+		name        string
+		value       interface{}
+		constraints map[string]interface{}
+		valid       bool
+	}{
+		{name: "history.cacheTTL", value: "1h", valid: true},
+		{name: "history.cacheTTL", value: 3600, valid: false},
+		{name: "history.cacheTTL", value: "1 hour", valid: false},
+		{name: "matching.rps", value: 100, valid: true},
+		{name: "matching.rps", value: "100", valid: false},
+		{name: "history.rps", value: 1.5, valid: false},
+		{name: "history.taskProcessRPS", value: 1, constraints: map[string]interface{}{"namespace": "ns"}, valid: true},
+		{name: "history.taskProcessRPS", value: 1, constraints: map[string]interface{}{"shardID": 1}, valid: false},
+		{name: "history.taskProcessRPS", value: 1, constraints: map[string]interface{}{"unknown": 1}, valid: false},
+		{name: "frontend.validSearchAttributes", value: map[string]interface{}{"CustomField": "Keyword"}, valid: true},
+		{name: "frontend.validSearchAttributes", value: []interface{}{"CustomField"}, valid: false},
+		{name: "system.enableNDC", value: true, constraints: map[string]interface{}{"namespace": "ns"}, valid: true},
+		{name: "system.enableNCD", value: true, valid: false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateValue(tc.name, tc.value, tc.constraints)
+		if tc.valid {
+			assert.NoError(t, err, "%v: %v", tc.name, tc.value)
+		} else {
+			assert.Error(t, err, "%v: %v", tc.name, tc.value)
+		}
+	}
+}
+
+func TestDumpValues(t *testing.T) {
+	client := newInMemoryClient()
+	client.SetValue(MatchingNumTaskqueueReadPartitions, 4)
+	dc := NewCollection(client, log.NewNoop())
+	dc.GetDurationPropertyFilteredByTaskQueueInfo(MatchingLongPollExpirationInterval, time.Minute)
+
+	values := DumpValues(client, map[Filter]interface{}{Namespace: "ns", TaskQueueName: "tq"})
+	require.Len(t, values, len(ListKeys()))
+	byKey := make(map[Key]*KeyValue, len(values))
+	for _, value := range values {
+		byKey[value.Key] = value
+	}
+
+	partitions := byKey[MatchingNumTaskqueueReadPartitions]
+	assert.Equal(t, 4, partitions.Value)
+	assert.False(t, partitions.IsDefault)
+
+	interval := byKey[MatchingLongPollExpirationInterval]
+	assert.Equal(t, time.Minute, interval.Value)
+	assert.Equal(t, time.Minute, interval.DefaultValue)
+	assert.True(t, interval.IsDefault)
+	assert.Equal(t, TypeDuration, interval.Info.Type)
+}
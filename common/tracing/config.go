@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// ExporterNone disables tracing, spans are still created but never recorded
+	ExporterNone = ""
+	// ExporterFile appends finished spans to a local file
+	ExporterFile = "file"
+	// ExporterOTLP sends finished spans to an OpenTelemetry collector using OTLP/HTTP with JSON encoding
+	ExporterOTLP = "otlp"
+
+	defaultServiceName   = "temporal"
+	defaultFlushInterval = time.Second * 5
+	defaultMaxQueueSize  = 2048
+	defaultMaxBatchSize  = 512
+	defaultOTLPTimeout   = time.Second * 10
+)
+
+type (
+	// Config is the distributed tracing configuration of the server
+	Config struct {
+		// Exporter is where finished spans are sent to, one of "", "file" or "otlp"
+		Exporter string `yaml:"exporter"`
+		// ServiceName is reported as the service.name resource attribute, defaults to "temporal"
+		ServiceName string `yaml:"serviceName"`
+		// SampleRate is the fraction of traces started by this server which are recorded,
+		// defaults to 1. Traces started by a caller follow the sampling decision of the caller.
+		SampleRate float64 `yaml:"sampleRate"`
+		// FlushInterval is how often finished spans are exported
+		FlushInterval time.Duration `yaml:"flushInterval"`
+		// MaxQueueSize is the number of finished spans buffered for export, spans are dropped when full
+		MaxQueueSize int `yaml:"maxQueueSize"`
+		// File configures the file exporter
+		File FileExporterConfig `yaml:"file"`
+		// OTLP configures the OTLP exporter
+		OTLP OTLPExporterConfig `yaml:"otlp"`
+	}
+
+	// FileExporterConfig is the config of the file exporter. Every export appends a line
+	// with an OTLP JSON encoded ExportTraceServiceRequest to the file.
+	FileExporterConfig struct {
+		Path string `yaml:"path"`
+	}
+
+	// OTLPExporterConfig is the config of the OTLP exporter
+	OTLPExporterConfig struct {
+		// Endpoint is the traces URL of the collector, e.g. http://localhost:4318/v1/traces
+		Endpoint string `yaml:"endpoint"`
+		// Headers are added to every export request
+		Headers map[string]string `yaml:"headers"`
+		// Timeout of a single export request
+		Timeout time.Duration `yaml:"timeout"`
+	}
+)
+
+// Validate validates the tracing config
+func (c *Config) Validate() error {
+	switch c.Exporter {
+	case ExporterNone:
+		return nil
+	case ExporterFile:
+		if c.File.Path == "" {
+			return fmt.Errorf("file exporter requires a path")
+		}
+	case ExporterOTLP:
+		if c.OTLP.Endpoint == "" {
+			return fmt.Errorf("otlp exporter requires an endpoint")
+		}
+	default:
+		return fmt.Errorf("unknown trace exporter %q", c.Exporter)
+	}
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("sample rate must be between 0 and 1")
+	}
+	return nil
+}
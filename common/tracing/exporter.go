@@ -0,0 +1,274 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// SpanData is a finished span
+	SpanData struct {
+		TraceID      traceID
+		SpanID       spanID
+		ParentSpanID spanID
+		Name         string
+		StartTime    time.Time
+		EndTime      time.Time
+		Tags         map[string]interface{}
+		Logs         []opentracing.LogRecord
+	}
+
+	// Exporter sends finished spans to a tracing backend
+	Exporter interface {
+		Export(spans []*SpanData) error
+		Close() error
+	}
+
+	fileExporter struct {
+		sync.Mutex
+		serviceName string
+		file        *os.File
+	}
+
+	otlpExporter struct {
+		serviceName string
+		endpoint    string
+		headers     map[string]string
+		client      *http.Client
+	}
+
+	// batchProcessor buffers finished spans and exports them in batches from a single goroutine
+	batchProcessor struct {
+		exporter      Exporter
+		flushInterval time.Duration
+		logger        log.Logger
+
+		spanCh    chan *SpanData
+		closeCh   chan struct{}
+		closeOnce sync.Once
+		closeWG   sync.WaitGroup
+
+		droppedLock sync.Mutex
+		dropped     int
+	}
+)
+
+var _ Exporter = (*fileExporter)(nil)
+var _ Exporter = (*otlpExporter)(nil)
+
+func newExporter(config *Config) (Exporter, error) {
+	serviceName := config.ServiceName
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+	switch config.Exporter {
+	case ExporterFile:
+		return newFileExporter(config.File.Path, serviceName)
+	case ExporterOTLP:
+		return newOTLPExporter(&config.OTLP, serviceName), nil
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", config.Exporter)
+	}
+}
+
+func newFileExporter(path string, serviceName string) (*fileExporter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trace file: %v", err)
+	}
+	return &fileExporter{
+		serviceName: serviceName,
+		file:        file,
+	}, nil
+}
+
+// Export appends the spans as one line of OTLP JSON, the same format the file
+// exporter of the OpenTelemetry collector writes
+func (e *fileExporter) Export(spans []*SpanData) error {
+	payload, err := encodeOTLPJSON(e.serviceName, spans)
+	if err != nil {
+		return err
+	}
+	e.Lock()
+	defer e.Unlock()
+	_, err = e.file.Write(append(payload, '\n'))
+	return err
+}
+
+func (e *fileExporter) Close() error {
+	e.Lock()
+	defer e.Unlock()
+	return e.file.Close()
+}
+
+func newOTLPExporter(config *OTLPExporterConfig, serviceName string) *otlpExporter {
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = defaultOTLPTimeout
+	}
+	return &otlpExporter{
+		serviceName: serviceName,
+		endpoint:    config.Endpoint,
+		headers:     config.Headers,
+		client:      &http.Client{Timeout: timeout},
+	}
+}
+
+func (e *otlpExporter) Export(spans []*SpanData) error {
+	payload, err := encodeOTLPJSON(e.serviceName, spans)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, e.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		request.Header.Set(k, v)
+	}
+	response, err := e.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(response.Body)
+		return fmt.Errorf("collector responded with %v: %s", response.Status, body)
+	}
+	return nil
+}
+
+func (e *otlpExporter) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+func newBatchProcessor(config *Config, exporter Exporter, logger log.Logger) *batchProcessor {
+	flushInterval := config.FlushInterval
+	if flushInterval == 0 {
+		flushInterval = defaultFlushInterval
+	}
+	maxQueueSize := config.MaxQueueSize
+	if maxQueueSize == 0 {
+		maxQueueSize = defaultMaxQueueSize
+	}
+	p := &batchProcessor{
+		exporter:      exporter,
+		flushInterval: flushInterval,
+		logger:        logger,
+		spanCh:        make(chan *SpanData, maxQueueSize),
+		closeCh:       make(chan struct{}),
+	}
+	p.closeWG.Add(1)
+	go p.run()
+	return p
+}
+
+// enqueue never blocks the caller, spans are dropped if the exporter falls behind
+func (p *batchProcessor) enqueue(span *SpanData) {
+	select {
+	case <-p.closeCh:
+		return
+	default:
+	}
+	select {
+	case p.spanCh <- span:
+	default:
+		p.droppedLock.Lock()
+		p.dropped++
+		p.droppedLock.Unlock()
+	}
+}
+
+// Close exports the pending spans and closes the exporter
+func (p *batchProcessor) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.closeCh)
+	})
+	p.closeWG.Wait()
+	return p.exporter.Close()
+}
+
+func (p *batchProcessor) run() {
+	defer p.closeWG.Done()
+
+	ticker := time.NewTicker(p.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*SpanData, 0, defaultMaxBatchSize)
+	for {
+		select {
+		case span := <-p.spanCh:
+			batch = append(batch, span)
+			if len(batch) >= defaultMaxBatchSize {
+				batch = p.export(batch)
+			}
+		case <-ticker.C:
+			batch = p.export(batch)
+		case <-p.closeCh:
+			for {
+				select {
+				case span := <-p.spanCh:
+					batch = append(batch, span)
+				default:
+					p.export(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *batchProcessor) export(batch []*SpanData) []*SpanData {
+	p.droppedLock.Lock()
+	dropped := p.dropped
+	p.dropped = 0
+	p.droppedLock.Unlock()
+	if dropped > 0 {
+		p.logger.Warn("Dropped spans because the export queue is full", tag.Counter(dropped))
+	}
+
+	if len(batch) == 0 {
+		return batch
+	}
+	if err := p.exporter.Export(batch); err != nil {
+		p.logger.Warn("Failed to export spans", tag.Error(err), tag.Counter(len(batch)))
+	}
+	return batch[:0]
+}
@@ -0,0 +1,295 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+)
+
+// OTLP span kinds and status codes, see opentelemetry/proto/trace/v1/trace.proto
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindServer   = 2
+	otlpSpanKindClient   = 3
+	otlpSpanKindProducer = 4
+	otlpSpanKindConsumer = 5
+
+	otlpStatusCodeError = 2
+
+	instrumentationScopeName = "go.temporal.io/server"
+)
+
+type (
+	otlpTraceRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	}
+
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+
+	otlpSpan struct {
+		TraceID           string         `json:"traceId"`
+		SpanID            string         `json:"spanId"`
+		ParentSpanID      string         `json:"parentSpanId,omitempty"`
+		Name              string         `json:"name"`
+		Kind              int            `json:"kind"`
+		StartTimeUnixNano string         `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+		Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+		Events            []otlpEvent    `json:"events,omitempty"`
+		Status            *otlpStatus    `json:"status,omitempty"`
+	}
+
+	otlpEvent struct {
+		TimeUnixNano string         `json:"timeUnixNano"`
+		Name         string         `json:"name"`
+		Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+	}
+
+	otlpStatus struct {
+		Code    int    `json:"code"`
+		Message string `json:"message,omitempty"`
+	}
+
+	otlpKeyValue struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+
+	otlpValue struct {
+		StringValue *string  `json:"stringValue,omitempty"`
+		BoolValue   *bool    `json:"boolValue,omitempty"`
+		IntValue    *string  `json:"intValue,omitempty"`
+		DoubleValue *float64 `json:"doubleValue,omitempty"`
+	}
+
+	// fieldEncoder converts opentracing log fields to OTLP attributes
+	fieldEncoder struct {
+		attributes []otlpKeyValue
+		event      string
+		errMessage string
+	}
+)
+
+var _ otlog.Encoder = (*fieldEncoder)(nil)
+
+// encodeOTLPJSON encodes the spans as an ExportTraceServiceRequest in the JSON
+// encoding of OTLP, which is accepted by OpenTelemetry collectors on /v1/traces
+func encodeOTLPJSON(serviceName string, spans []*SpanData) ([]byte, error) {
+	otlpSpans := make([]otlpSpan, 0, len(spans))
+	for _, span := range spans {
+		otlpSpans = append(otlpSpans, toOTLPSpan(span))
+	}
+	return json.Marshal(otlpTraceRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpKeyValue{toOTLPKeyValue("service.name", serviceName)},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: instrumentationScopeName},
+				Spans: otlpSpans,
+			}},
+		}},
+	})
+}
+
+func toOTLPSpan(span *SpanData) otlpSpan {
+	result := otlpSpan{
+		TraceID:           span.TraceID.String(),
+		SpanID:            span.SpanID.String(),
+		Name:              span.Name,
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(span.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+	}
+	if span.ParentSpanID.isValid() {
+		result.ParentSpanID = span.ParentSpanID.String()
+	}
+
+	isError := false
+	for k, v := range span.Tags {
+		switch k {
+		case string(ext.SpanKind):
+			result.Kind = toOTLPSpanKind(v)
+		case string(ext.Error):
+			isError, _ = v.(bool)
+		default:
+			result.Attributes = append(result.Attributes, toOTLPKeyValue(k, v))
+		}
+	}
+
+	errMessage := ""
+	for _, record := range span.Logs {
+		encoder := &fieldEncoder{event: "log"}
+		for _, field := range record.Fields {
+			field.Marshal(encoder)
+		}
+		if encoder.errMessage != "" {
+			errMessage = encoder.errMessage
+		}
+		result.Events = append(result.Events, otlpEvent{
+			TimeUnixNano: strconv.FormatInt(record.Timestamp.UnixNano(), 10),
+			Name:         encoder.event,
+			Attributes:   encoder.attributes,
+		})
+	}
+	if isError {
+		result.Status = &otlpStatus{Code: otlpStatusCodeError, Message: errMessage}
+	}
+	return result
+}
+
+func toOTLPSpanKind(kind interface{}) int {
+	var value string
+	switch k := kind.(type) {
+	case ext.SpanKindEnum:
+		value = string(k)
+	case string:
+		value = k
+	}
+	switch ext.SpanKindEnum(value) {
+	case ext.SpanKindRPCServerEnum:
+		return otlpSpanKindServer
+	case ext.SpanKindRPCClientEnum:
+		return otlpSpanKindClient
+	case ext.SpanKindProducerEnum:
+		return otlpSpanKindProducer
+	case ext.SpanKindConsumerEnum:
+		return otlpSpanKindConsumer
+	default:
+		return otlpSpanKindInternal
+	}
+}
+
+func toOTLPKeyValue(key string, value interface{}) otlpKeyValue {
+	var v otlpValue
+	switch val := value.(type) {
+	case string:
+		v.StringValue = &val
+	case bool:
+		v.BoolValue = &val
+	case int:
+		v.IntValue = formatInt(int64(val))
+	case int32:
+		v.IntValue = formatInt(int64(val))
+	case int64:
+		v.IntValue = formatInt(val)
+	case uint16:
+		v.IntValue = formatInt(int64(val))
+	case uint32:
+		v.IntValue = formatInt(int64(val))
+	case float32:
+		f := float64(val)
+		v.DoubleValue = &f
+	case float64:
+		v.DoubleValue = &val
+	default:
+		s := fmt.Sprint(val)
+		v.StringValue = &s
+	}
+	return otlpKeyValue{Key: key, Value: v}
+}
+
+func formatInt(value int64) *string {
+	s := strconv.FormatInt(value, 10)
+	return &s
+}
+
+func (e *fieldEncoder) add(key string, value interface{}) {
+	e.attributes = append(e.attributes, toOTLPKeyValue(key, value))
+}
+
+func (e *fieldEncoder) EmitString(key, value string) {
+	switch key {
+	case "event":
+		e.event = value
+	case "error.object":
+		e.event = "exception"
+		e.errMessage = value
+		e.add("exception.message", value)
+	default:
+		e.add(key, value)
+	}
+}
+
+func (e *fieldEncoder) EmitBool(key string, value bool) {
+	e.add(key, value)
+}
+
+func (e *fieldEncoder) EmitInt(key string, value int) {
+	e.add(key, value)
+}
+
+func (e *fieldEncoder) EmitInt32(key string, value int32) {
+	e.add(key, value)
+}
+
+func (e *fieldEncoder) EmitInt64(key string, value int64) {
+	e.add(key, value)
+}
+
+func (e *fieldEncoder) EmitUint32(key string, value uint32) {
+	e.add(key, value)
+}
+
+func (e *fieldEncoder) EmitUint64(key string, value uint64) {
+	e.add(key, strconv.FormatUint(value, 10))
+}
+
+func (e *fieldEncoder) EmitFloat32(key string, value float32) {
+	e.add(key, value)
+}
+
+func (e *fieldEncoder) EmitFloat64(key string, value float64) {
+	e.add(key, value)
+}
+
+func (e *fieldEncoder) EmitObject(key string, value interface{}) {
+	e.add(key, value)
+}
+
+func (e *fieldEncoder) EmitLazyLogger(value otlog.LazyLogger) {
+	value(e)
+}
@@ -0,0 +1,150 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/stretchr/testify/require"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// decodeOTLPJSON decodes a request with the official OTLP protos. The JSON encoding of OTLP differs from the
+// protobuf JSON mapping only by the hex encoding of the trace and span IDs, which are converted first.
+func decodeOTLPJSON(t *testing.T, payload []byte) *coltracepb.ExportTraceServiceRequest {
+	var request map[string]interface{}
+	require.NoError(t, json.Unmarshal(payload, &request))
+	for _, resourceSpans := range request["resourceSpans"].([]interface{}) {
+		for _, scopeSpans := range resourceSpans.(map[string]interface{})["scopeSpans"].([]interface{}) {
+			for _, span := range scopeSpans.(map[string]interface{})["spans"].([]interface{}) {
+				span := span.(map[string]interface{})
+				for _, key := range []string{"traceId", "spanId", "parentSpanId"} {
+					if id, ok := span[key]; ok {
+						value, err := hex.DecodeString(id.(string))
+						require.NoError(t, err)
+						span[key] = base64.StdEncoding.EncodeToString(value)
+					}
+				}
+			}
+		}
+	}
+	payload, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	result := &coltracepb.ExportTraceServiceRequest{}
+	require.NoError(t, protojson.Unmarshal(payload, result))
+	return result
+}
+
+func TestEncodeOTLPJSON(t *testing.T) {
+	startTime := time.Unix(1600000000, 123)
+	parent := &SpanData{
+		TraceID:   traceID{1, 2, 3},
+		SpanID:    spanID{4, 5, 6},
+		Name:      "/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution",
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Second),
+		Tags:      map[string]interface{}{string(ext.SpanKind): ext.SpanKindRPCServerEnum},
+	}
+	child := &SpanData{
+		TraceID:      parent.TraceID,
+		SpanID:       spanID{7, 8, 9},
+		ParentSpanID: parent.SpanID,
+		Name:         "persistence.CreateWorkflowExecution",
+		StartTime:    startTime,
+		EndTime:      startTime.Add(time.Millisecond),
+		Tags: map[string]interface{}{
+			string(ext.SpanKind):   ext.SpanKindRPCClientEnum,
+			string(ext.Error):      true,
+			"persistence.shard_id": int32(3),
+			"persistence.retry":    false,
+			"persistence.ratio":    0.5,
+		},
+		Logs: []opentracing.LogRecord{{
+			Timestamp: startTime,
+			Fields:    []otlog.Field{otlog.Error(errors.New("condition failed"))},
+		}},
+	}
+
+	payload, err := encodeOTLPJSON("test", []*SpanData{parent, child})
+	require.NoError(t, err)
+	request := decodeOTLPJSON(t, payload)
+
+	require.Len(t, request.ResourceSpans, 1)
+	resourceSpans := request.ResourceSpans[0]
+	require.Equal(t, "service.name", resourceSpans.Resource.Attributes[0].Key)
+	require.Equal(t, "test", resourceSpans.Resource.Attributes[0].Value.GetStringValue())
+	require.Len(t, resourceSpans.ScopeSpans, 1)
+	require.Equal(t, instrumentationScopeName, resourceSpans.ScopeSpans[0].Scope.Name)
+	spans := resourceSpans.ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+
+	parentSpan, childSpan := spans[0], spans[1]
+	require.Equal(t, parent.TraceID[:], parentSpan.TraceId)
+	require.Equal(t, parent.SpanID[:], parentSpan.SpanId)
+	require.Empty(t, parentSpan.ParentSpanId)
+	require.Equal(t, parent.Name, parentSpan.Name)
+	require.Equal(t, tracepb.Span_SPAN_KIND_SERVER, parentSpan.Kind)
+	require.Equal(t, uint64(startTime.UnixNano()), parentSpan.StartTimeUnixNano)
+	require.Equal(t, uint64(startTime.Add(time.Second).UnixNano()), parentSpan.EndTimeUnixNano)
+	require.Nil(t, parentSpan.Status)
+
+	require.Equal(t, parent.TraceID[:], childSpan.TraceId)
+	require.Equal(t, child.SpanID[:], childSpan.SpanId)
+	require.Equal(t, parent.SpanID[:], childSpan.ParentSpanId)
+	require.Equal(t, tracepb.Span_SPAN_KIND_CLIENT, childSpan.Kind)
+	require.Equal(t, tracepb.Status_STATUS_CODE_ERROR, childSpan.Status.Code)
+	require.Equal(t, "condition failed", childSpan.Status.Message)
+	attributes := make(map[string]interface{})
+	for _, attribute := range childSpan.Attributes {
+		switch value := attribute.Value.Value.(type) {
+		case *commonpb.AnyValue_IntValue:
+			attributes[attribute.Key] = value.IntValue
+		case *commonpb.AnyValue_BoolValue:
+			attributes[attribute.Key] = value.BoolValue
+		case *commonpb.AnyValue_DoubleValue:
+			attributes[attribute.Key] = value.DoubleValue
+		}
+	}
+	require.Equal(t, map[string]interface{}{
+		"persistence.shard_id": int64(3),
+		"persistence.retry":    false,
+		"persistence.ratio":    0.5,
+	}, attributes)
+	require.Len(t, childSpan.Events, 1)
+	require.Equal(t, "exception", childSpan.Events[0].Name)
+	require.Equal(t, uint64(startTime.UnixNano()), childSpan.Events[0].TimeUnixNano)
+}
@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	// traceParentHeader carries the trace context in the W3C Trace Context format,
+	// which is also the default propagation format of OpenTelemetry
+	traceParentHeader = "traceparent"
+	// baggageHeaderPrefix prefixes the baggage items of a span context
+	baggageHeaderPrefix = "ot-baggage-"
+
+	traceParentVersion = "00"
+	traceFlagSampled   = 0x01
+)
+
+type (
+	traceID [16]byte
+	spanID  [8]byte
+)
+
+func (id traceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id traceID) isValid() bool {
+	return id != traceID{}
+}
+
+func (id spanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id spanID) isValid() bool {
+	return id != spanID{}
+}
+
+func injectTextMap(ctx spanContext, writer opentracing.TextMapWriter) {
+	flags := 0
+	if ctx.sampled {
+		flags |= traceFlagSampled
+	}
+	writer.Set(traceParentHeader, fmt.Sprintf("%s-%s-%s-%02x", traceParentVersion, ctx.traceID, ctx.spanID, flags))
+	for k, v := range ctx.baggage {
+		writer.Set(baggageHeaderPrefix+k, v)
+	}
+}
+
+func extractTextMap(reader opentracing.TextMapReader) (opentracing.SpanContext, error) {
+	var ctx spanContext
+	found := false
+	err := reader.ForeachKey(func(key, value string) error {
+		key = strings.ToLower(key)
+		switch {
+		case key == traceParentHeader:
+			if err := parseTraceParent(value, &ctx); err != nil {
+				return err
+			}
+			found = true
+		case strings.HasPrefix(key, baggageHeaderPrefix):
+			if ctx.baggage == nil {
+				ctx.baggage = make(map[string]string)
+			}
+			ctx.baggage[strings.TrimPrefix(key, baggageHeaderPrefix)] = value
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, opentracing.ErrSpanContextNotFound
+	}
+	return ctx, nil
+}
+
+// parseTraceParent parses a header of the form version-traceid-parentid-flags,
+// e.g. 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+func parseTraceParent(value string, ctx *spanContext) error {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return opentracing.ErrSpanContextCorrupted
+	}
+	// future versions may append fields, but the first four are fixed
+	if parts[0] == traceParentVersion && len(parts) != 4 {
+		return opentracing.ErrSpanContextCorrupted
+	}
+	if err := decodeHex(parts[1], ctx.traceID[:]); err != nil || !ctx.traceID.isValid() {
+		return opentracing.ErrSpanContextCorrupted
+	}
+	if err := decodeHex(parts[2], ctx.spanID[:]); err != nil || !ctx.spanID.isValid() {
+		return opentracing.ErrSpanContextCorrupted
+	}
+	var flags [1]byte
+	if err := decodeHex(parts[3], flags[:]); err != nil {
+		return opentracing.ErrSpanContextCorrupted
+	}
+	ctx.sampled = flags[0]&traceFlagSampled != 0
+	return nil
+}
+
+func decodeHex(value string, dst []byte) error {
+	if len(value) != hex.EncodedLen(len(dst)) {
+		return fmt.Errorf("expected %v hex characters", hex.EncodedLen(len(dst)))
+	}
+	_, err := hex.Decode(dst, []byte(value))
+	return err
+}
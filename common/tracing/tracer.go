@@ -0,0 +1,345 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	tracer struct {
+		sampleRate float64
+		processor  *batchProcessor
+
+		randLock sync.Mutex
+		rand     *rand.Rand
+	}
+
+	spanContext struct {
+		traceID traceID
+		spanID  spanID
+		sampled bool
+		baggage map[string]string
+	}
+
+	span struct {
+		tracer *tracer
+
+		sync.Mutex
+		context       spanContext
+		parentID      spanID
+		operationName string
+		startTime     time.Time
+		tags          map[string]interface{}
+		logs          []opentracing.LogRecord
+		finished      bool
+	}
+)
+
+var _ opentracing.Tracer = (*tracer)(nil)
+var _ opentracing.Span = (*span)(nil)
+var _ opentracing.SpanContext = spanContext{}
+
+// NewTracer creates an opentracing tracer which propagates the W3C trace context and
+// exports sampled spans with the configured exporter. The returned closer flushes the
+// pending spans and must be called on shutdown. If no exporter is configured, a no-op
+// tracer is returned.
+func NewTracer(config *Config, logger log.Logger) (opentracing.Tracer, io.Closer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if config.Exporter == ExporterNone {
+		return opentracing.NoopTracer{}, nopCloser{}, nil
+	}
+
+	exporter, err := newExporter(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	sampleRate := config.SampleRate
+	if sampleRate == 0 {
+		sampleRate = 1
+	}
+	t := &tracer{
+		sampleRate: sampleRate,
+		processor:  newBatchProcessor(config, exporter, logger),
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	return t, t.processor, nil
+}
+
+// InitGlobalTracer creates a tracer from the config and registers it as the opentracing global
+// tracer, which is used by the gRPC interceptors and the persistence clients
+func InitGlobalTracer(config *Config, logger log.Logger) (io.Closer, error) {
+	t, closer, err := NewTracer(config, logger)
+	if err != nil {
+		return nil, err
+	}
+	opentracing.SetGlobalTracer(t)
+	return closer, nil
+}
+
+func (t *tracer) StartSpan(operationName string, opts ...opentracing.StartSpanOption) opentracing.Span {
+	options := opentracing.StartSpanOptions{}
+	for _, opt := range opts {
+		opt.Apply(&options)
+	}
+
+	s := &span{
+		tracer:        t,
+		operationName: operationName,
+		startTime:     options.StartTime,
+		tags:          make(map[string]interface{}, len(options.Tags)),
+	}
+	if s.startTime.IsZero() {
+		s.startTime = time.Now()
+	}
+	for k, v := range options.Tags {
+		s.tags[k] = v
+	}
+
+	var parent *spanContext
+	for _, ref := range options.References {
+		if ctx, ok := ref.ReferencedContext.(spanContext); ok {
+			parent = &ctx
+			if ref.Type == opentracing.ChildOfRef {
+				break
+			}
+		}
+	}
+
+	if parent != nil {
+		s.context = spanContext{
+			traceID: parent.traceID,
+			spanID:  t.newSpanID(),
+			sampled: parent.sampled,
+			baggage: parent.baggage,
+		}
+		s.parentID = parent.spanID
+	} else {
+		s.context = spanContext{
+			traceID: t.newTraceID(),
+			spanID:  t.newSpanID(),
+			sampled: t.sample(),
+		}
+	}
+	return s
+}
+
+func (t *tracer) Inject(sc opentracing.SpanContext, format interface{}, carrier interface{}) error {
+	ctx, ok := sc.(spanContext)
+	if !ok {
+		return opentracing.ErrInvalidSpanContext
+	}
+	switch format {
+	case opentracing.TextMap, opentracing.HTTPHeaders:
+		writer, ok := carrier.(opentracing.TextMapWriter)
+		if !ok {
+			return opentracing.ErrInvalidCarrier
+		}
+		injectTextMap(ctx, writer)
+		return nil
+	default:
+		return opentracing.ErrUnsupportedFormat
+	}
+}
+
+func (t *tracer) Extract(format interface{}, carrier interface{}) (opentracing.SpanContext, error) {
+	switch format {
+	case opentracing.TextMap, opentracing.HTTPHeaders:
+		reader, ok := carrier.(opentracing.TextMapReader)
+		if !ok {
+			return nil, opentracing.ErrInvalidCarrier
+		}
+		return extractTextMap(reader)
+	default:
+		return nil, opentracing.ErrUnsupportedFormat
+	}
+}
+
+func (t *tracer) sample() bool {
+	if t.sampleRate >= 1 {
+		return true
+	}
+	t.randLock.Lock()
+	defer t.randLock.Unlock()
+	return t.rand.Float64() < t.sampleRate
+}
+
+func (t *tracer) newTraceID() traceID {
+	t.randLock.Lock()
+	defer t.randLock.Unlock()
+	var id traceID
+	t.rand.Read(id[:])
+	return id
+}
+
+func (t *tracer) newSpanID() spanID {
+	t.randLock.Lock()
+	defer t.randLock.Unlock()
+	var id spanID
+	t.rand.Read(id[:])
+	return id
+}
+
+func (c spanContext) ForeachBaggageItem(handler func(k, v string) bool) {
+	for k, v := range c.baggage {
+		if !handler(k, v) {
+			return
+		}
+	}
+}
+
+func (s *span) Finish() {
+	s.FinishWithOptions(opentracing.FinishOptions{})
+}
+
+func (s *span) FinishWithOptions(opts opentracing.FinishOptions) {
+	finishTime := opts.FinishTime
+	if finishTime.IsZero() {
+		finishTime = time.Now()
+	}
+
+	s.Lock()
+	if s.finished {
+		s.Unlock()
+		return
+	}
+	s.finished = true
+	s.logs = append(s.logs, opts.LogRecords...)
+	for _, ld := range opts.BulkLogData {
+		s.logs = append(s.logs, ld.ToLogRecord())
+	}
+	if !s.context.sampled {
+		s.Unlock()
+		return
+	}
+	data := &SpanData{
+		TraceID:      s.context.traceID,
+		SpanID:       s.context.spanID,
+		ParentSpanID: s.parentID,
+		Name:         s.operationName,
+		StartTime:    s.startTime,
+		EndTime:      finishTime,
+		Tags:         s.tags,
+		Logs:         s.logs,
+	}
+	s.Unlock()
+
+	s.tracer.processor.enqueue(data)
+}
+
+func (s *span) Context() opentracing.SpanContext {
+	s.Lock()
+	defer s.Unlock()
+	return s.context
+}
+
+func (s *span) SetOperationName(operationName string) opentracing.Span {
+	s.Lock()
+	defer s.Unlock()
+	s.operationName = operationName
+	return s
+}
+
+func (s *span) SetTag(key string, value interface{}) opentracing.Span {
+	s.Lock()
+	defer s.Unlock()
+	if key == string(ext.SamplingPriority) {
+		if priority, ok := value.(uint16); ok {
+			s.context.sampled = priority > 0
+		}
+		return s
+	}
+	s.tags[key] = value
+	return s
+}
+
+func (s *span) LogFields(fields ...otlog.Field) {
+	s.Lock()
+	defer s.Unlock()
+	s.logs = append(s.logs, opentracing.LogRecord{Timestamp: time.Now(), Fields: fields})
+}
+
+func (s *span) LogKV(alternatingKeyValues ...interface{}) {
+	fields, err := otlog.InterleavedKVToFields(alternatingKeyValues...)
+	if err != nil {
+		s.LogFields(otlog.Error(err), otlog.String("function", "LogKV"))
+		return
+	}
+	s.LogFields(fields...)
+}
+
+func (s *span) SetBaggageItem(restrictedKey, value string) opentracing.Span {
+	s.Lock()
+	defer s.Unlock()
+	// baggage is shared with the parent context, so it is copied on write
+	baggage := make(map[string]string, len(s.context.baggage)+1)
+	for k, v := range s.context.baggage {
+		baggage[k] = v
+	}
+	baggage[restrictedKey] = value
+	s.context.baggage = baggage
+	return s
+}
+
+func (s *span) BaggageItem(restrictedKey string) string {
+	s.Lock()
+	defer s.Unlock()
+	return s.context.baggage[restrictedKey]
+}
+
+func (s *span) Tracer() opentracing.Tracer {
+	return s.tracer
+}
+
+func (s *span) LogEvent(event string) {
+	s.Log(opentracing.LogData{Event: event})
+}
+
+func (s *span) LogEventWithPayload(event string, payload interface{}) {
+	s.Log(opentracing.LogData{Event: event, Payload: payload})
+}
+
+func (s *span) Log(data opentracing.LogData) {
+	s.Lock()
+	defer s.Unlock()
+	s.logs = append(s.logs, data.ToLogRecord())
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error {
+	return nil
+}
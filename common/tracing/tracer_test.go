@@ -0,0 +1,187 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+)
+
+type (
+	tracerSuite struct {
+		suite.Suite
+		traceFile string
+	}
+)
+
+func TestTracerSuite(t *testing.T) {
+	suite.Run(t, new(tracerSuite))
+}
+
+func (s *tracerSuite) SetupTest() {
+	file, err := ioutil.TempFile("", "traces-*.json")
+	s.NoError(err)
+	s.NoError(file.Close())
+	s.traceFile = file.Name()
+}
+
+func (s *tracerSuite) TearDownTest() {
+	s.NoError(os.Remove(s.traceFile))
+}
+
+func (s *tracerSuite) TestNoopTracer() {
+	tracer, closer, err := NewTracer(&Config{}, loggerimpl.NewNopLogger())
+	s.NoError(err)
+	s.Equal(opentracing.NoopTracer{}, tracer)
+	s.NoError(closer.Close())
+}
+
+func (s *tracerSuite) TestInvalidConfig() {
+	for _, config := range []*Config{
+		{Exporter: "zipkin"},
+		{Exporter: ExporterFile},
+		{Exporter: ExporterOTLP},
+		{Exporter: ExporterFile, File: FileExporterConfig{Path: s.traceFile}, SampleRate: 2},
+	} {
+		_, _, err := NewTracer(config, loggerimpl.NewNopLogger())
+		s.Error(err, "%+v", config)
+	}
+}
+
+func (s *tracerSuite) TestPropagation() {
+	tracer := s.newTracer(1)
+
+	parent := tracer.StartSpan("parent")
+	parent.SetBaggageItem("namespace", "tenant-a")
+	carrier := opentracing.TextMapCarrier{}
+	s.NoError(tracer.Inject(parent.Context(), opentracing.TextMap, carrier))
+	s.Regexp("^00-[0-9a-f]{32}-[0-9a-f]{16}-01$", carrier[traceParentHeader])
+
+	extracted, err := tracer.Extract(opentracing.TextMap, carrier)
+	s.NoError(err)
+	child := tracer.StartSpan("child", opentracing.ChildOf(extracted))
+	parentContext := parent.Context().(spanContext)
+	childContext := child.Context().(spanContext)
+	s.Equal(parentContext.traceID, childContext.traceID)
+	s.NotEqual(parentContext.spanID, childContext.spanID)
+	s.Equal(parentContext.spanID, child.(*span).parentID)
+	s.Equal("tenant-a", child.BaggageItem("namespace"))
+
+	_, err = tracer.Extract(opentracing.TextMap, opentracing.TextMapCarrier{})
+	s.Equal(opentracing.ErrSpanContextNotFound, err)
+	for _, value := range []string{
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	} {
+		_, err = tracer.Extract(opentracing.TextMap, opentracing.TextMapCarrier{traceParentHeader: value})
+		s.Equal(opentracing.ErrSpanContextCorrupted, err, value)
+	}
+}
+
+func (s *tracerSuite) TestSampling() {
+	tracer := s.newTracer(0.000001)
+
+	// the sampling decision of the caller is followed
+	extracted, err := tracer.Extract(opentracing.TextMap, opentracing.TextMapCarrier{
+		traceParentHeader: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	})
+	s.NoError(err)
+	sampled := tracer.StartSpan("sampled", opentracing.ChildOf(extracted))
+	s.True(sampled.Context().(spanContext).sampled)
+
+	extracted, err = tracer.Extract(opentracing.TextMap, opentracing.TextMapCarrier{
+		traceParentHeader: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+	})
+	s.NoError(err)
+	notSampled := tracer.StartSpan("not-sampled", opentracing.ChildOf(extracted))
+	s.False(notSampled.Context().(spanContext).sampled)
+}
+
+func (s *tracerSuite) TestFileExporter() {
+	t := s.newTracer(1)
+
+	parent := t.StartSpan("/temporal.server.api.historyservice.v1.HistoryService/StartWorkflowExecution", ext.SpanKindRPCServer)
+	child := t.StartSpan("persistence.CreateWorkflowExecution", opentracing.ChildOf(parent.Context()), ext.SpanKindRPCClient)
+	child.SetTag("persistence.shard_id", 3)
+	ext.Error.Set(child, true)
+	child.LogFields(otlog.Error(errors.New("condition failed")))
+	child.Finish()
+	parent.Finish()
+	s.NoError(t.(*tracer).processor.Close())
+
+	file, err := os.Open(s.traceFile)
+	s.NoError(err)
+	defer file.Close()
+	var spans []otlpSpan
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var request otlpTraceRequest
+		s.NoError(json.Unmarshal(scanner.Bytes(), &request))
+		s.Len(request.ResourceSpans, 1)
+		s.Equal("service.name", request.ResourceSpans[0].Resource.Attributes[0].Key)
+		s.Equal("test", *request.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+		for _, scopeSpans := range request.ResourceSpans[0].ScopeSpans {
+			spans = append(spans, scopeSpans.Spans...)
+		}
+	}
+	s.Len(spans, 2)
+
+	childSpan, parentSpan := spans[0], spans[1]
+	s.Equal(otlpSpanKindServer, parentSpan.Kind)
+	s.Empty(parentSpan.ParentSpanID)
+	s.Nil(parentSpan.Status)
+	s.Equal(otlpSpanKindClient, childSpan.Kind)
+	s.Equal(parentSpan.TraceID, childSpan.TraceID)
+	s.Equal(parentSpan.SpanID, childSpan.ParentSpanID)
+	s.Equal(&otlpStatus{Code: otlpStatusCodeError, Message: "condition failed"}, childSpan.Status)
+	s.Equal([]otlpKeyValue{{Key: "persistence.shard_id", Value: otlpValue{IntValue: formatInt(3)}}}, childSpan.Attributes)
+	s.Len(childSpan.Events, 1)
+	s.Equal("exception", childSpan.Events[0].Name)
+}
+
+func (s *tracerSuite) newTracer(sampleRate float64) opentracing.Tracer {
+	tracer, _, err := NewTracer(&Config{
+		Exporter:    ExporterFile,
+		ServiceName: "test",
+		SampleRate:  sampleRate,
+		File:        FileExporterConfig{Path: s.traceFile},
+	}, loggerimpl.NewNopLogger())
+	s.NoError(err)
+	return tracer
+}
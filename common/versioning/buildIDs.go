@@ -0,0 +1,226 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versioning
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	workflowpb "go.temporal.io/api/workflow/v1"
+)
+
+const (
+	// namespaceDataKeyPrefix is the prefix of the keys of the namespace data storing the build ID sets of a task queue
+	namespaceDataKeyPrefix = "temporal.buildIds."
+	// subQueueSeparator separates the name of a versioned task queue from the key of a build ID set
+	// in the name of the sub-queue of the set
+	subQueueSeparator = "/__build_set/"
+)
+
+type (
+	// BuildIDSets are the compatible build ID sets of a task queue. The build IDs of a set are compatible with
+	// each other, i.e. a worker of any of them can process the workflows started by the others. The last set is
+	// the default one, new workflows are processed by its workers.
+	BuildIDSets [][]string
+)
+
+// NamespaceDataKey returns the key of the namespace data storing the build ID sets of a task queue
+func NamespaceDataKey(taskQueue string) string {
+	return namespaceDataKeyPrefix + taskQueue
+}
+
+// GetBuildIDSets returns the build ID sets of a task queue from the namespace data,
+// nil if the task queue is not versioned
+func GetBuildIDSets(data map[string]string, taskQueue string) (BuildIDSets, error) {
+	value, ok := data[NamespaceDataKey(taskQueue)]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var sets BuildIDSets
+	if err := json.Unmarshal([]byte(value), &sets); err != nil {
+		return nil, fmt.Errorf("invalid build ID sets of task queue %v: %v", taskQueue, err)
+	}
+	return sets, nil
+}
+
+// GetWorkflowBuildID returns the build ID of the worker which last processed a workflow from its auto reset points
+func GetWorkflowBuildID(resetPoints *workflowpb.ResetPoints) string {
+	points := resetPoints.GetPoints()
+	if len(points) == 0 {
+		return ""
+	}
+	return points[len(points)-1].GetBinaryChecksum()
+}
+
+// SubQueueName returns the name of the sub-queue of a versioned task queue holding the decision tasks of a build
+// ID set, see BuildIDSets.SetKey
+func SubQueueName(taskQueue string, setKey string) string {
+	return taskQueue + subQueueSeparator + setKey
+}
+
+// IsSubQueueName returns true if the task queue name is the name of the sub-queue of a build ID set
+func IsSubQueueName(taskQueue string) bool {
+	return strings.Contains(taskQueue, subQueueSeparator)
+}
+
+// ValidateNamespaceData returns an error if the build ID sets of the namespace data are invalid
+func ValidateNamespaceData(data map[string]string) error {
+	for key := range data {
+		if !strings.HasPrefix(key, namespaceDataKeyPrefix) {
+			continue
+		}
+		sets, err := GetBuildIDSets(data, strings.TrimPrefix(key, namespaceDataKeyPrefix))
+		if err != nil {
+			return err
+		}
+		seen := make(map[string]struct{})
+		for _, set := range sets {
+			if len(set) == 0 {
+				return fmt.Errorf("empty build ID set in %v", key)
+			}
+			for _, buildID := range set {
+				if _, ok := seen[buildID]; ok || buildID == "" {
+					return fmt.Errorf("invalid or duplicate build ID %q in %v", buildID, key)
+				}
+				seen[buildID] = struct{}{}
+			}
+		}
+	}
+	return nil
+}
+
+// Encode returns the value of the build ID sets in the namespace data
+func (s BuildIDSets) Encode() (string, error) {
+	value, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// Default returns the default build ID set, nil if there is none
+func (s BuildIDSets) Default() []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return s[len(s)-1]
+}
+
+// IsKnown returns true if the build ID is in one of the sets, or if the task queue is not versioned
+func (s BuildIDSets) IsKnown(buildID string) bool {
+	return len(s) == 0 || s.indexOf(buildID) >= 0
+}
+
+// SetKey returns the key of the set of the build ID, or of the default set if the build ID is not in any set.
+// The key of a set is its first build ID, it does not change when build IDs are added to the set or when the
+// set is promoted. It returns an empty key if the task queue is not versioned.
+func (s BuildIDSets) SetKey(buildID string) string {
+	set := s.Default()
+	if index := s.indexOf(buildID); index >= 0 {
+		set = s[index]
+	}
+	if len(set) == 0 {
+		return ""
+	}
+	return set[0]
+}
+
+// AddNewDefault returns the sets with a new default set of the build ID, which is not compatible with the others
+func (s BuildIDSets) AddNewDefault(buildID string) (BuildIDSets, error) {
+	if err := s.validateNew(buildID); err != nil {
+		return nil, err
+	}
+	return append(s.clone(), []string{buildID}), nil
+}
+
+// AddCompatible returns the sets with the build ID added to the set of an existing build ID.
+// The set becomes the default one if promote is true.
+func (s BuildIDSets) AddCompatible(buildID string, existingBuildID string, promote bool) (BuildIDSets, error) {
+	if err := s.validateNew(buildID); err != nil {
+		return nil, err
+	}
+	index := s.indexOf(existingBuildID)
+	if index < 0 {
+		return nil, fmt.Errorf("build ID %v not found", existingBuildID)
+	}
+	sets := s.clone()
+	sets[index] = append(sets[index], buildID)
+	if promote {
+		return sets.Promote(buildID)
+	}
+	return sets, nil
+}
+
+// Promote returns the sets with the set of the build ID as the default one
+func (s BuildIDSets) Promote(buildID string) (BuildIDSets, error) {
+	index := s.indexOf(buildID)
+	if index < 0 {
+		return nil, fmt.Errorf("build ID %v not found", buildID)
+	}
+	sets := s.clone()
+	promoted := sets[index]
+	sets = append(sets[:index], sets[index+1:]...)
+	return append(sets, promoted), nil
+}
+
+func (s BuildIDSets) validateNew(buildID string) error {
+	if buildID == "" {
+		return fmt.Errorf("build ID is not set")
+	}
+	if s.indexOf(buildID) >= 0 {
+		return fmt.Errorf("build ID %v already exists", buildID)
+	}
+	return nil
+}
+
+func (s BuildIDSets) indexOf(buildID string) int {
+	if buildID == "" {
+		return -1
+	}
+	for i, set := range s {
+		if contains(set, buildID) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s BuildIDSets) clone() BuildIDSets {
+	sets := make(BuildIDSets, 0, len(s)+1)
+	for _, set := range s {
+		sets = append(sets, append([]string(nil), set...))
+	}
+	return sets
+}
+
+func contains(set []string, buildID string) bool {
+	for _, id := range set {
+		if id == buildID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBuildIDSets(t *testing.T) {
+	sets, err := GetBuildIDSets(nil, "tq")
+	require.NoError(t, err)
+	assert.Nil(t, sets)
+
+	data := map[string]string{NamespaceDataKey("tq"): `[["1.0","1.1"],["2.0"]]`}
+	sets, err = GetBuildIDSets(data, "tq")
+	require.NoError(t, err)
+	assert.Equal(t, BuildIDSets{{"1.0", "1.1"}, {"2.0"}}, sets)
+
+	data[NamespaceDataKey("tq")] = "invalid"
+	_, err = GetBuildIDSets(data, "tq")
+	assert.Error(t, err)
+}
+
+func TestBuildIDSets_SetKey(t *testing.T) {
+	var unversioned BuildIDSets
+	assert.Equal(t, "", unversioned.SetKey("1.0"))
+
+	sets := BuildIDSets{{"1.0", "1.1"}, {"2.0"}}
+	assert.Equal(t, "1.0", sets.SetKey("1.1"))
+	assert.Equal(t, "2.0", sets.SetKey("2.0"))
+	// the workflows without a known build ID go to the default set
+	assert.Equal(t, "2.0", sets.SetKey(""))
+	assert.Equal(t, "2.0", sets.SetKey("0.9"))
+
+	// the key of a set does not change when the set is updated
+	updated, err := sets.AddCompatible("1.2", "1.1", true)
+	require.NoError(t, err)
+	assert.Equal(t, "1.0", updated.SetKey("1.2"))
+	assert.Equal(t, "1.0", updated.SetKey(""))
+
+	assert.Equal(t, "tq/__build_set/1.0", SubQueueName("tq", "1.0"))
+	assert.True(t, IsSubQueueName(SubQueueName("tq", "1.0")))
+	assert.False(t, IsSubQueueName("tq"))
+}
+
+func TestBuildIDSets_Update(t *testing.T) {
+	sets, err := BuildIDSets(nil).AddNewDefault("1.0")
+	require.NoError(t, err)
+	sets, err = sets.AddNewDefault("2.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2.0"}, sets.Default())
+
+	_, err = sets.AddNewDefault("1.0")
+	assert.Error(t, err)
+
+	updated, err := sets.AddCompatible("1.1", "1.0", false)
+	require.NoError(t, err)
+	assert.Equal(t, BuildIDSets{{"1.0", "1.1"}, {"2.0"}}, updated)
+	assert.Equal(t, BuildIDSets{{"1.0"}, {"2.0"}}, sets, "the sets are not modified")
+
+	updated, err = sets.AddCompatible("1.1", "1.0", true)
+	require.NoError(t, err)
+	assert.Equal(t, BuildIDSets{{"2.0"}, {"1.0", "1.1"}}, updated)
+
+	_, err = sets.AddCompatible("1.1", "0.9", false)
+	assert.Error(t, err)
+
+	updated, err = sets.Promote("1.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0"}, updated.Default())
+}
+
+func TestValidateNamespaceData(t *testing.T) {
+	assert.NoError(t, ValidateNamespaceData(map[string]string{
+		"other":               "value",
+		NamespaceDataKey("a"): `[["1.0"],["2.0"]]`,
+	}))
+	assert.Error(t, ValidateNamespaceData(map[string]string{NamespaceDataKey("a"): `[["1.0"],["1.0"]]`}))
+	assert.Error(t, ValidateNamespaceData(map[string]string{NamespaceDataKey("a"): `[[]]`}))
+	assert.Error(t, ValidateNamespaceData(map[string]string{NamespaceDataKey("a"): `{}`}))
+}
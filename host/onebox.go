@@ -691,6 +691,9 @@ func (c *temporalImpl) startWorkerIndexer(params *resource.BootstrapParams, serv
 	c.indexer = indexer.NewIndexer(
 		workerConfig.IndexerCfg,
 		c.messagingClient,
+		service.GetPersistenceBean().GetVisibilityQueue(),
+		service.GetWorkerServiceResolver(),
+		service.GetHostInfo(),
 		c.esClient,
 		c.esConfig,
 		c.logger,
@@ -264,3 +264,11 @@ CREATE TABLE cluster_membership
     PRIMARY KEY (host_id)
 );
 
+
+CREATE TABLE dynamic_config (
+  config_partition INT NOT NULL,
+  version          BIGINT NOT NULL,
+  data             MEDIUMBLOB NOT NULL,
+  data_encoding    VARCHAR(16) NOT NULL,
+  PRIMARY KEY(config_partition, version)
+);
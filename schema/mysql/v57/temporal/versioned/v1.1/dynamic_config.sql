@@ -0,0 +1,7 @@
+CREATE TABLE dynamic_config (
+  config_partition INT NOT NULL,
+  version          BIGINT NOT NULL,
+  data             MEDIUMBLOB NOT NULL,
+  data_encoding    VARCHAR(16) NOT NULL,
+  PRIMARY KEY(config_partition, version)
+);
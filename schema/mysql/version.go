@@ -27,7 +27,7 @@ package mysql
 // NOTE: whenever there is a new data base schema update, plz update the following versions
 
 // Version is the MySQL database release version
-const Version = "1.0"
+const Version = "1.1"
 
 // VisibilityVersion is the MySQL visibility database release version
-const VisibilityVersion = "1.0"
+const VisibilityVersion = "1.1"
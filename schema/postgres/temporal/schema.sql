@@ -263,4 +263,11 @@ CREATE UNIQUE INDEX cm_idx_rolehost ON cluster_membership (role, host_id);
 CREATE INDEX cm_idx_rolelasthb ON cluster_membership (role, last_heartbeat);
 CREATE INDEX cm_idx_rpchost ON cluster_membership (rpc_address, role);
 CREATE INDEX cm_idx_lasthb ON cluster_membership (last_heartbeat);
-CREATE INDEX cm_idx_recordexpiry ON cluster_membership (record_expiry);
\ No newline at end of file
+CREATE INDEX cm_idx_recordexpiry ON cluster_membership (record_expiry);
+CREATE TABLE dynamic_config (
+  config_partition INTEGER NOT NULL,
+  version          BIGINT NOT NULL,
+  data             BYTEA NOT NULL,
+  data_encoding    VARCHAR(16) NOT NULL,
+  PRIMARY KEY(config_partition, version)
+);
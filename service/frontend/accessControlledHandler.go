@@ -30,6 +30,8 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/resource"
@@ -41,6 +43,7 @@ import (
 type AccessControlledWorkflowHandler struct {
 	frontendHandler Handler
 	authorizer      authorization.Authorizer
+	tokenSerializer common.TaskTokenSerializer
 }
 
 var _ Handler = (*AccessControlledWorkflowHandler)(nil)
@@ -54,6 +57,7 @@ func NewAccessControlledHandlerImpl(wfHandler Handler, authorizer authorization.
 	return &AccessControlledWorkflowHandler{
 		frontendHandler: wfHandler,
 		authorizer:      authorizer,
+		tokenSerializer: common.NewProtoTaskTokenSerializer(),
 	}
 }
 
@@ -172,6 +176,7 @@ func (a *AccessControlledWorkflowHandler) DescribeTaskQueue(
 	attr := &authorization.Attributes{
 		APIName:   "DescribeTaskQueue",
 		Namespace: request.GetNamespace(),
+		TaskQueue: request.GetTaskQueue().GetName(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -193,8 +198,9 @@ func (a *AccessControlledWorkflowHandler) DescribeWorkflowExecution(
 	scope := a.getMetricsScopeWithNamespace(metrics.FrontendDescribeWorkflowExecutionScope, request.GetNamespace())
 
 	attr := &authorization.Attributes{
-		APIName:   "DescribeWorkflowExecution",
-		Namespace: request.GetNamespace(),
+		APIName:    "DescribeWorkflowExecution",
+		Namespace:  request.GetNamespace(),
+		WorkflowID: request.GetExecution().GetWorkflowId(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -224,8 +230,9 @@ func (a *AccessControlledWorkflowHandler) GetWorkflowExecutionHistory(
 	scope := a.getMetricsScopeWithNamespace(metrics.FrontendGetWorkflowExecutionHistoryScope, request.GetNamespace())
 
 	attr := &authorization.Attributes{
-		APIName:   "GetWorkflowExecutionHistory",
-		Namespace: request.GetNamespace(),
+		APIName:    "GetWorkflowExecutionHistory",
+		Namespace:  request.GetNamespace(),
+		WorkflowID: request.GetExecution().GetWorkflowId(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -363,6 +370,7 @@ func (a *AccessControlledWorkflowHandler) PollForActivityTask(
 	attr := &authorization.Attributes{
 		APIName:   "PollForActivityTask",
 		Namespace: request.GetNamespace(),
+		TaskQueue: request.GetTaskQueue().GetName(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -386,6 +394,7 @@ func (a *AccessControlledWorkflowHandler) PollForDecisionTask(
 	attr := &authorization.Attributes{
 		APIName:   "PollForDecisionTask",
 		Namespace: request.GetNamespace(),
+		TaskQueue: request.GetTaskQueue().GetName(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -407,8 +416,9 @@ func (a *AccessControlledWorkflowHandler) QueryWorkflow(
 	scope := a.getMetricsScopeWithNamespace(metrics.FrontendQueryWorkflowScope, request.GetNamespace())
 
 	attr := &authorization.Attributes{
-		APIName:   "QueryWorkflow",
-		Namespace: request.GetNamespace(),
+		APIName:    "QueryWorkflow",
+		Namespace:  request.GetNamespace(),
+		WorkflowID: request.GetExecution().GetWorkflowId(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -434,7 +444,28 @@ func (a *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *workflowservice.RecordActivityTaskHeartbeatRequest,
 ) (*workflowservice.RecordActivityTaskHeartbeatResponse, error) {
-	// TODO(vancexu): add auth check for service API
+
+	token, namespace, err := a.deserializeTaskToken(request.GetTaskToken())
+	if err != nil {
+		return nil, err
+	}
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRecordActivityTaskHeartbeatScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:      "RecordActivityTaskHeartbeat",
+		Namespace:    namespace,
+		TaskQueue:    token.GetTaskQueue(),
+		WorkflowType: token.GetWorkflowType(),
+		WorkflowID:   token.GetWorkflowId(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RecordActivityTaskHeartbeat(ctx, request)
 }
 
@@ -443,6 +474,21 @@ func (a *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeatById(
 	ctx context.Context,
 	request *workflowservice.RecordActivityTaskHeartbeatByIdRequest,
 ) (*workflowservice.RecordActivityTaskHeartbeatByIdResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRecordActivityTaskHeartbeatByIdScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "RecordActivityTaskHeartbeatById",
+		Namespace: request.GetNamespace(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RecordActivityTaskHeartbeatById(ctx, request)
 }
 
@@ -478,8 +524,9 @@ func (a *AccessControlledWorkflowHandler) RequestCancelWorkflowExecution(
 	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRequestCancelWorkflowExecutionScope, request.GetNamespace())
 
 	attr := &authorization.Attributes{
-		APIName:   "RequestCancelWorkflowExecution",
-		Namespace: request.GetNamespace(),
+		APIName:    "RequestCancelWorkflowExecution",
+		Namespace:  request.GetNamespace(),
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowId(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -501,8 +548,9 @@ func (a *AccessControlledWorkflowHandler) ResetStickyTaskQueue(
 	scope := a.getMetricsScopeWithNamespace(metrics.FrontendResetStickyTaskQueueScope, request.GetNamespace())
 
 	attr := &authorization.Attributes{
-		APIName:   "ResetStickyTaskQueue",
-		Namespace: request.GetNamespace(),
+		APIName:    "ResetStickyTaskQueue",
+		Namespace:  request.GetNamespace(),
+		WorkflowID: request.GetExecution().GetWorkflowId(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -524,8 +572,9 @@ func (a *AccessControlledWorkflowHandler) ResetWorkflowExecution(
 	scope := a.getMetricsScopeWithNamespace(metrics.FrontendResetWorkflowExecutionScope, request.GetNamespace())
 
 	attr := &authorization.Attributes{
-		APIName:   "ResetWorkflowExecution",
-		Namespace: request.GetNamespace(),
+		APIName:    "ResetWorkflowExecution",
+		Namespace:  request.GetNamespace(),
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowId(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -543,6 +592,28 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCanceled(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskCanceledRequest,
 ) (*workflowservice.RespondActivityTaskCanceledResponse, error) {
+
+	token, namespace, err := a.deserializeTaskToken(request.GetTaskToken())
+	if err != nil {
+		return nil, err
+	}
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskCanceledScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:      "RespondActivityTaskCanceled",
+		Namespace:    namespace,
+		TaskQueue:    token.GetTaskQueue(),
+		WorkflowType: token.GetWorkflowType(),
+		WorkflowID:   token.GetWorkflowId(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCanceled(ctx, request)
 }
 
@@ -551,6 +622,21 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCanceledById(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskCanceledByIdRequest,
 ) (*workflowservice.RespondActivityTaskCanceledByIdResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskCanceledByIdScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondActivityTaskCanceledById",
+		Namespace: request.GetNamespace(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCanceledById(ctx, request)
 }
 
@@ -559,6 +645,28 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCompleted(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskCompletedRequest,
 ) (*workflowservice.RespondActivityTaskCompletedResponse, error) {
+
+	token, namespace, err := a.deserializeTaskToken(request.GetTaskToken())
+	if err != nil {
+		return nil, err
+	}
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskCompletedScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:      "RespondActivityTaskCompleted",
+		Namespace:    namespace,
+		TaskQueue:    token.GetTaskQueue(),
+		WorkflowType: token.GetWorkflowType(),
+		WorkflowID:   token.GetWorkflowId(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCompleted(ctx, request)
 }
 
@@ -567,6 +675,21 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCompletedById(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskCompletedByIdRequest,
 ) (*workflowservice.RespondActivityTaskCompletedByIdResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskCompletedByIdScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondActivityTaskCompletedById",
+		Namespace: request.GetNamespace(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCompletedById(ctx, request)
 }
 
@@ -575,6 +698,28 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskFailed(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskFailedRequest,
 ) (*workflowservice.RespondActivityTaskFailedResponse, error) {
+
+	token, namespace, err := a.deserializeTaskToken(request.GetTaskToken())
+	if err != nil {
+		return nil, err
+	}
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskFailedScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:      "RespondActivityTaskFailed",
+		Namespace:    namespace,
+		TaskQueue:    token.GetTaskQueue(),
+		WorkflowType: token.GetWorkflowType(),
+		WorkflowID:   token.GetWorkflowId(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskFailed(ctx, request)
 }
 
@@ -583,6 +728,21 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskFailedById(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskFailedByIdRequest,
 ) (*workflowservice.RespondActivityTaskFailedByIdResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskFailedByIdScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondActivityTaskFailedById",
+		Namespace: request.GetNamespace(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskFailedById(ctx, request)
 }
 
@@ -591,6 +751,28 @@ func (a *AccessControlledWorkflowHandler) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	request *workflowservice.RespondDecisionTaskCompletedRequest,
 ) (*workflowservice.RespondDecisionTaskCompletedResponse, error) {
+
+	token, namespace, err := a.deserializeTaskToken(request.GetTaskToken())
+	if err != nil {
+		return nil, err
+	}
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondDecisionTaskCompletedScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:      "RespondDecisionTaskCompleted",
+		Namespace:    namespace,
+		TaskQueue:    token.GetTaskQueue(),
+		WorkflowType: token.GetWorkflowType(),
+		WorkflowID:   token.GetWorkflowId(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondDecisionTaskCompleted(ctx, request)
 }
 
@@ -599,6 +781,28 @@ func (a *AccessControlledWorkflowHandler) RespondDecisionTaskFailed(
 	ctx context.Context,
 	request *workflowservice.RespondDecisionTaskFailedRequest,
 ) (*workflowservice.RespondDecisionTaskFailedResponse, error) {
+
+	token, namespace, err := a.deserializeTaskToken(request.GetTaskToken())
+	if err != nil {
+		return nil, err
+	}
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondDecisionTaskFailedScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:      "RespondDecisionTaskFailed",
+		Namespace:    namespace,
+		TaskQueue:    token.GetTaskQueue(),
+		WorkflowType: token.GetWorkflowType(),
+		WorkflowID:   token.GetWorkflowId(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondDecisionTaskFailed(ctx, request)
 }
 
@@ -607,6 +811,26 @@ func (a *AccessControlledWorkflowHandler) RespondQueryTaskCompleted(
 	ctx context.Context,
 	request *workflowservice.RespondQueryTaskCompletedRequest,
 ) (*workflowservice.RespondQueryTaskCompletedResponse, error) {
+
+	namespace, taskQueue, err := a.getNamespaceFromQueryTaskToken(request.GetTaskToken())
+	if err != nil {
+		return nil, err
+	}
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondQueryTaskCompletedScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondQueryTaskCompleted",
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondQueryTaskCompleted(ctx, request)
 }
 
@@ -642,8 +866,11 @@ func (a *AccessControlledWorkflowHandler) SignalWithStartWorkflowExecution(
 	scope := a.getMetricsScopeWithNamespace(metrics.FrontendSignalWithStartWorkflowExecutionScope, request.GetNamespace())
 
 	attr := &authorization.Attributes{
-		APIName:   "SignalWithStartWorkflowExecution",
-		Namespace: request.GetNamespace(),
+		APIName:      "SignalWithStartWorkflowExecution",
+		Namespace:    request.GetNamespace(),
+		WorkflowID:   request.GetWorkflowId(),
+		WorkflowType: request.GetWorkflowType().GetName(),
+		TaskQueue:    request.GetTaskQueue().GetName(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -665,8 +892,9 @@ func (a *AccessControlledWorkflowHandler) SignalWorkflowExecution(
 	scope := a.getMetricsScopeWithNamespace(metrics.FrontendSignalWorkflowExecutionScope, request.GetNamespace())
 
 	attr := &authorization.Attributes{
-		APIName:   "SignalWorkflowExecution",
-		Namespace: request.GetNamespace(),
+		APIName:    "SignalWorkflowExecution",
+		Namespace:  request.GetNamespace(),
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowId(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -688,8 +916,11 @@ func (a *AccessControlledWorkflowHandler) StartWorkflowExecution(
 	scope := a.getMetricsScopeWithNamespace(metrics.FrontendStartWorkflowExecutionScope, request.GetNamespace())
 
 	attr := &authorization.Attributes{
-		APIName:   "StartWorkflowExecution",
-		Namespace: request.GetNamespace(),
+		APIName:      "StartWorkflowExecution",
+		Namespace:    request.GetNamespace(),
+		WorkflowID:   request.GetWorkflowId(),
+		WorkflowType: request.GetWorkflowType().GetName(),
+		TaskQueue:    request.GetTaskQueue().GetName(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -711,8 +942,9 @@ func (a *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
 	scope := a.getMetricsScopeWithNamespace(metrics.FrontendTerminateWorkflowExecutionScope, request.GetNamespace())
 
 	attr := &authorization.Attributes{
-		APIName:   "TerminateWorkflowExecution",
-		Namespace: request.GetNamespace(),
+		APIName:    "TerminateWorkflowExecution",
+		Namespace:  request.GetNamespace(),
+		WorkflowID: request.GetWorkflowExecution().GetWorkflowId(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -736,6 +968,7 @@ func (a *AccessControlledWorkflowHandler) ListTaskQueuePartitions(
 	attr := &authorization.Attributes{
 		APIName:   "ListTaskQueuePartitions",
 		Namespace: request.GetNamespace(),
+		TaskQueue: request.GetTaskQueue().GetName(),
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -779,6 +1012,11 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	sw := scope.StartTimer(metrics.ServiceAuthorizationLatency)
 	defer sw.Stop()
 
+	attr.APIGroup = authorization.GetAPIGroup(attr.APIName)
+	if claims := authorization.ClaimsFromContext(ctx); claims != nil {
+		attr.Actor = claims.Subject
+		attr.Roles = claims.Roles
+	}
 	result, err := a.authorizer.Authorize(ctx, attr)
 	if err != nil {
 		scope.IncCounter(metrics.ServiceErrAuthorizeFailedCounter)
@@ -791,6 +1029,45 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	return isAuth, nil
 }
 
+// deserializeTaskToken returns a task token and the name of the namespace of its task,
+// the wrapped handler validates the rest of the token
+func (a *AccessControlledWorkflowHandler) deserializeTaskToken(taskToken []byte) (*tokenspb.Task, string, error) {
+	if taskToken == nil {
+		return nil, "", errTaskTokenNotSet
+	}
+	token, err := a.tokenSerializer.Deserialize(taskToken)
+	if err != nil {
+		return nil, "", errDeserializingToken
+	}
+	if token.GetNamespaceId() == "" {
+		return nil, "", errNamespaceNotSet
+	}
+	namespace, err := a.GetResource().GetNamespaceCache().GetNamespaceName(token.GetNamespaceId())
+	if err != nil {
+		return nil, "", err
+	}
+	return token, namespace, nil
+}
+
+// getNamespaceFromQueryTaskToken returns the name of the namespace and the task queue of the query task of a token
+func (a *AccessControlledWorkflowHandler) getNamespaceFromQueryTaskToken(taskToken []byte) (string, string, error) {
+	if taskToken == nil {
+		return "", "", errTaskTokenNotSet
+	}
+	token, err := a.tokenSerializer.DeserializeQueryTaskToken(taskToken)
+	if err != nil {
+		return "", "", errDeserializingToken
+	}
+	if token.GetNamespaceId() == "" {
+		return "", "", errInvalidTaskToken
+	}
+	namespace, err := a.GetResource().GetNamespaceCache().GetNamespaceName(token.GetNamespaceId())
+	if err != nil {
+		return "", "", err
+	}
+	return namespace, token.GetTaskQueue(), nil
+}
+
 // getMetricsScopeWithNamespace return metrics scope with namespace tag
 func (a *AccessControlledWorkflowHandler) getMetricsScopeWithNamespace(
 	scope int,
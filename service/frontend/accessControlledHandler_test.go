@@ -32,8 +32,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
 
+	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/metrics/mocks"
@@ -47,6 +50,7 @@ type (
 		*require.Assertions
 
 		controller          *gomock.Controller
+		mockResource        *resource.Test
 		mockFrontendHandler *workflowservicemock.MockWorkflowServiceServer
 		mockAuthorizer      *authorization.MockAuthorizer
 		mockMetricsScope    *mocks.Scope
@@ -64,10 +68,10 @@ func (s *accessControlledHandlerSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.controller = gomock.NewController(s.T())
 
-	mockResource := resource.NewTest(s.controller, metrics.Frontend)
-	config := NewConfig(dynamicconfig.NewCollection(dynamicconfig.NewNopClient(), mockResource.GetLogger()), 0, false)
+	s.mockResource = resource.NewTest(s.controller, metrics.Frontend)
+	config := NewConfig(dynamicconfig.NewCollection(dynamicconfig.NewNopClient(), s.mockResource.GetLogger()), 0, false)
 
-	frontendHandlerGRPC := NewWorkflowHandler(mockResource, config, nil)
+	frontendHandlerGRPC := NewWorkflowHandler(s.mockResource, config, nil)
 	s.mockFrontendHandler = workflowservicemock.NewMockWorkflowServiceServer(s.controller)
 	s.mockAuthorizer = authorization.NewMockAuthorizer(s.controller)
 	s.mockMetricsScope = &mocks.Scope{}
@@ -124,3 +128,45 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized_Unauthorized() {
 	s.False(res)
 	s.NoError(err)
 }
+
+func (s *accessControlledHandlerSuite) TestRespondDecisionTaskCompleted_Unauthorized() {
+	ctx := context.Background()
+	taskToken, err := common.NewProtoTaskTokenSerializer().Serialize(&tokenspb.Task{NamespaceId: "test-namespace-id"})
+	s.NoError(err)
+
+	s.mockResource.NamespaceCache.EXPECT().GetNamespaceName("test-namespace-id").Return("test-namespace", nil).Times(1)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, &authorization.Attributes{
+		APIName:   "RespondDecisionTaskCompleted",
+		APIGroup:  authorization.APIGroupWrite,
+		Namespace: "test-namespace",
+	}).Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(1)
+
+	_, err = s.handler.RespondDecisionTaskCompleted(ctx, &workflowservice.RespondDecisionTaskCompletedRequest{TaskToken: taskToken})
+	s.Equal(errUnauthorized, err)
+}
+
+func (s *accessControlledHandlerSuite) TestRespondQueryTaskCompleted_Unauthorized() {
+	ctx := context.Background()
+	taskToken, err := common.NewProtoTaskTokenSerializer().SerializeQueryTaskToken(&tokenspb.QueryTask{
+		NamespaceId: "test-namespace-id",
+		TaskQueue:   "test-task-queue",
+		TaskId:      "test-task-id",
+	})
+	s.NoError(err)
+
+	s.mockResource.NamespaceCache.EXPECT().GetNamespaceName("test-namespace-id").Return("test-namespace", nil).Times(1)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, &authorization.Attributes{
+		APIName:   "RespondQueryTaskCompleted",
+		APIGroup:  authorization.APIGroupWrite,
+		Namespace: "test-namespace",
+		TaskQueue: "test-task-queue",
+	}).Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(1)
+
+	_, err = s.handler.RespondQueryTaskCompleted(ctx, &workflowservice.RespondQueryTaskCompletedRequest{TaskToken: taskToken})
+	s.Equal(errUnauthorized, err)
+}
+
+func (s *accessControlledHandlerSuite) TestRecordActivityTaskHeartbeat_TaskTokenNotSet() {
+	_, err := s.handler.RecordActivityTaskHeartbeat(context.Background(), &workflowservice.RecordActivityTaskHeartbeatRequest{})
+	s.Equal(errTaskTokenNotSet, err)
+}
@@ -27,6 +27,7 @@ package frontend
 import (
 	"context"
 	"errors"
+	"sort"
 	"strconv"
 	"time"
 
@@ -36,7 +37,9 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	versionpb "go.temporal.io/api/version/v1"
+	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	clusterspb "go.temporal.io/server/api/cluster/v1"
@@ -52,16 +55,27 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history"
+	"go.temporal.io/server/service/worker/archivalbackfill"
+	"go.temporal.io/server/service/worker/deletenamespace"
 )
 
 const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
+	// dynamicConfigUpdateAttempts is the number of attempts of a dynamic config update conflicting with others
+	dynamicConfigUpdateAttempts = 5
+	// defaultDynamicConfigHistoryPageSize is the page size of the dynamic config history if not set on request
+	defaultDynamicConfigHistoryPageSize = 100
+	// deleteNamespaceWorkflowTaskTimeoutSeconds is the workflow task timeout of the namespace deletion workflow
+	deleteNamespaceWorkflowTaskTimeoutSeconds = 10
+	// backfillArchivalWorkflowTaskTimeoutSeconds is the workflow task timeout of the archival backfill workflow
+	backfillArchivalWorkflowTaskTimeoutSeconds = 10
 )
 
 type (
@@ -1030,6 +1044,382 @@ func (adh *AdminHandler) ResendReplicationTasks(
 	)
 }
 
+// GetDynamicConfig returns the values of a dynamic config key stored in the persistence store
+func (adh *AdminHandler) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+) (_ *adminservice.GetDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetName() == "" {
+		return nil, adh.error(errDynamicConfigNameNotSet, scope)
+	}
+
+	resp, err := adh.GetPersistenceBean().GetClusterMetadataManager().GetDynamicConfig()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetDynamicConfigResponse{
+		Values:  toAdminDynamicConfigValues(resp.Values[request.GetName()]),
+		Version: resp.Version,
+	}, nil
+}
+
+// UpdateDynamicConfig replaces the values of a dynamic config key stored in the persistence store,
+// the key is removed from the persistence store if no value is set on request
+func (adh *AdminHandler) UpdateDynamicConfig(
+	ctx context.Context,
+	request *adminservice.UpdateDynamicConfigRequest,
+) (_ *adminservice.UpdateDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetName() == "" {
+		return nil, adh.error(errDynamicConfigNameNotSet, scope)
+	}
+	values := toPersistenceDynamicConfigValues(request.GetValues())
+	for _, value := range values {
+		if err := dynamicconfig.ValidatePersistedValue(request.GetName(), &dynamicconfig.PersistedValue{
+			Value:       value.Value,
+			Constraints: value.Constraints,
+		}); err != nil {
+			return nil, adh.error(errInvalidDynamicConfigValue.MessageArgs(err), scope)
+		}
+	}
+
+	clusterMetadataManager := adh.GetPersistenceBean().GetClusterMetadataManager()
+	var err error
+	for attempt := 0; attempt < dynamicConfigUpdateAttempts; attempt++ {
+		var resp *persistence.GetDynamicConfigResponse
+		resp, err = clusterMetadataManager.GetDynamicConfig()
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+
+		newValues := make(map[string][]*persistence.DynamicConfigValue, len(resp.Values)+1)
+		for name, currentValues := range resp.Values {
+			newValues[name] = currentValues
+		}
+		if len(values) > 0 {
+			newValues[request.GetName()] = values
+		} else {
+			delete(newValues, request.GetName())
+		}
+
+		err = clusterMetadataManager.UpdateDynamicConfig(&persistence.UpdateDynamicConfigRequest{
+			PreviousVersion: resp.Version,
+			Values:          newValues,
+			Change: &persistence.DynamicConfigChange{
+				Name:     request.GetName(),
+				Values:   values,
+				Identity: request.GetIdentity(),
+				Reason:   request.GetReason(),
+			},
+		})
+		if err == nil {
+			adh.GetLogger().Info("Dynamic config updated",
+				tag.Key(request.GetName()),
+				tag.Value(request.GetReason()),
+				tag.Counter(int(resp.Version+1)))
+			return &adminservice.UpdateDynamicConfigResponse{Version: resp.Version + 1}, nil
+		}
+		if _, ok := err.(*persistence.ConditionFailedError); !ok {
+			return nil, adh.error(err, scope)
+		}
+		// the dynamic config was updated concurrently, apply the update on the new version
+	}
+	return nil, adh.error(errFailedUpdateDynamicConfig.MessageArgs(err), scope)
+}
+
+// ListDynamicConfig returns all the dynamic config values stored in the persistence store
+func (adh *AdminHandler) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+) (_ *adminservice.ListDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminListDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.GetPersistenceBean().GetClusterMetadataManager().GetDynamicConfig()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	names := make([]string, 0, len(resp.Values))
+	for name := range resp.Values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	entries := make([]*adminservice.DynamicConfigEntry, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, &adminservice.DynamicConfigEntry{
+			Name:   name,
+			Values: toAdminDynamicConfigValues(resp.Values[name]),
+		})
+	}
+	return &adminservice.ListDynamicConfigResponse{
+		Entries: entries,
+		Version: resp.Version,
+	}, nil
+}
+
+// ListDynamicConfigHistory returns the changes of the dynamic config stored in the persistence store,
+// from the most recent
+func (adh *AdminHandler) ListDynamicConfigHistory(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigHistoryRequest,
+) (_ *adminservice.ListDynamicConfigHistoryResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminListDynamicConfigHistoryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize < 0 {
+		return nil, adh.error(errInvalidPageSize, scope)
+	}
+	if pageSize == 0 {
+		pageSize = defaultDynamicConfigHistoryPageSize
+	}
+
+	resp, err := adh.GetPersistenceBean().GetClusterMetadataManager().ListDynamicConfigHistory(
+		&persistence.ListDynamicConfigHistoryRequest{
+			PageSize:      pageSize,
+			NextPageToken: request.GetNextPageToken(),
+		})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	changes := make([]*adminservice.DynamicConfigChange, 0, len(resp.Changes))
+	for _, change := range resp.Changes {
+		changes = append(changes, &adminservice.DynamicConfigChange{
+			Version:    change.Version,
+			Name:       change.Name,
+			Values:     toAdminDynamicConfigValues(change.Values),
+			Identity:   change.Identity,
+			Reason:     change.Reason,
+			UpdateTime: change.UpdateTime.UnixNano(),
+		})
+	}
+	return &adminservice.ListDynamicConfigHistoryResponse{
+		Changes:       changes,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// DumpDynamicConfig returns the effective value of every dynamic config key for the namespace, task queue and
+// shard of the request
+func (adh *AdminHandler) DumpDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DumpDynamicConfigRequest,
+) (_ *adminservice.DumpDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminDumpDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	filters := map[dynamicconfig.Filter]interface{}{
+		dynamicconfig.ShardID: int(request.GetShardId()),
+	}
+	if request.GetNamespace() != "" {
+		namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		filters[dynamicconfig.Namespace] = request.GetNamespace()
+		filters[dynamicconfig.NamespaceID] = namespaceEntry.GetInfo().Id
+	}
+	if request.GetTaskQueue() != "" {
+		filters[dynamicconfig.TaskQueueName] = request.GetTaskQueue()
+		filters[dynamicconfig.TaskType] = request.GetTaskQueueType()
+	}
+
+	values := dynamicconfig.DumpValues(adh.params.DynamicConfig, filters)
+	keys := make([]*adminservice.DynamicConfigKeyDump, 0, len(values))
+	for _, value := range values {
+		encodedValue, err := dynamicconfig.EncodeValue(value.Value)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		encodedDefault, err := dynamicconfig.EncodeValue(value.DefaultValue)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		filterNames := make([]string, 0, len(value.Info.Filters))
+		for _, filter := range value.Info.Filters {
+			filterNames = append(filterNames, filter.String())
+		}
+		keys = append(keys, &adminservice.DynamicConfigKeyDump{
+			Name:         value.Key.String(),
+			Type:         value.Info.Type.String(),
+			Filters:      filterNames,
+			Description:  value.Info.Description,
+			Value:        encodedValue,
+			DefaultValue: encodedDefault,
+			IsDefault:    value.IsDefault,
+		})
+	}
+	return &adminservice.DumpDynamicConfigResponse{Keys: keys}, nil
+}
+
+// DeleteNamespace marks the namespace as deleted and starts the system workflow which deletes
+// all of its data, then the namespace record itself
+func (adh *AdminHandler) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+) (_ *adminservice.DeleteNamespaceResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetNamespace() == common.SystemLocalNamespace {
+		return nil, adh.error(errCannotDeleteSystemNamespace, scope)
+	}
+
+	getResponse, err := adh.GetMetadataManager().GetNamespace(&persistence.GetNamespaceRequest{Name: request.GetNamespace()})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	// the deletion is not replicated, deleting a global namespace would leave the other clusters inconsistent
+	if getResponse.IsGlobalNamespace {
+		return nil, adh.error(errCannotDeleteGlobalNamespace, scope)
+	}
+	systemNamespaceEntry, err := adh.GetNamespaceCache().GetNamespace(common.SystemLocalNamespace)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	input, err := payloads.Encode(deletenamespace.DeleteNamespaceParams{
+		Namespace:   request.GetNamespace(),
+		NamespaceID: getResponse.Namespace.Info.Id,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	workflowID := deletenamespace.GetWorkflowID(request.GetNamespace())
+	workflowTimeoutSeconds := int32(deletenamespace.InfiniteDuration.Seconds())
+	startRequest := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                       common.SystemLocalNamespace,
+		WorkflowId:                      workflowID,
+		WorkflowType:                    &commonpb.WorkflowType{Name: deletenamespace.WorkflowTypeName},
+		TaskQueue:                       &taskqueuepb.TaskQueue{Name: deletenamespace.TaskQueueName},
+		Input:                           input,
+		WorkflowExecutionTimeoutSeconds: workflowTimeoutSeconds,
+		WorkflowRunTimeoutSeconds:       workflowTimeoutSeconds,
+		WorkflowTaskTimeoutSeconds:      deleteNamespaceWorkflowTaskTimeoutSeconds,
+		Identity:                        deletenamespace.WorkflowTypeName,
+		RequestId:                       uuid.New(),
+		WorkflowIdReusePolicy:           enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	}
+	resp, err := adh.GetHistoryClient().StartWorkflowExecution(
+		ctx,
+		common.CreateHistoryStartWorkflowRequest(systemNamespaceEntry.GetInfo().Id, startRequest),
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DeleteNamespaceResponse{
+		WorkflowId: workflowID,
+		RunId:      resp.GetRunId(),
+	}, nil
+}
+
+// BackfillArchival starts the system workflow which archives the closed executions of a namespace missing
+// from its archival
+func (adh *AdminHandler) BackfillArchival(
+	ctx context.Context,
+	request *adminservice.BackfillArchivalRequest,
+) (_ *adminservice.BackfillArchivalResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminBackfillArchivalScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	archivalMetadata := adh.GetArchivalMetadata()
+	config := namespaceEntry.GetConfig()
+	historyEnabled := archivalMetadata.GetHistoryConfig().ClusterConfiguredForArchival() &&
+		config.HistoryArchivalStatus == enumspb.ARCHIVAL_STATUS_ENABLED
+	visibilityEnabled := archivalMetadata.GetVisibilityConfig().ClusterConfiguredForArchival() &&
+		config.VisibilityArchivalStatus == enumspb.ARCHIVAL_STATUS_ENABLED
+	if !historyEnabled && !visibilityEnabled {
+		return nil, adh.error(errArchivalNotEnabledForNamespace, scope)
+	}
+	systemNamespaceEntry, err := adh.GetNamespaceCache().GetNamespace(common.SystemLocalNamespace)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	input, err := payloads.Encode(archivalbackfill.BackfillParams{
+		Namespace:   request.GetNamespace(),
+		NamespaceID: namespaceEntry.GetInfo().Id,
+		DryRun:      request.GetDryRun(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	workflowID := archivalbackfill.GetWorkflowID(request.GetNamespace())
+	workflowTimeoutSeconds := int32(archivalbackfill.InfiniteDuration.Seconds())
+	startRequest := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                       common.SystemLocalNamespace,
+		WorkflowId:                      workflowID,
+		WorkflowType:                    &commonpb.WorkflowType{Name: archivalbackfill.WorkflowTypeName},
+		TaskQueue:                       &taskqueuepb.TaskQueue{Name: archivalbackfill.TaskQueueName},
+		Input:                           input,
+		WorkflowExecutionTimeoutSeconds: workflowTimeoutSeconds,
+		WorkflowRunTimeoutSeconds:       workflowTimeoutSeconds,
+		WorkflowTaskTimeoutSeconds:      backfillArchivalWorkflowTaskTimeoutSeconds,
+		Identity:                        archivalbackfill.WorkflowTypeName,
+		RequestId:                       uuid.New(),
+		WorkflowIdReusePolicy:           enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	}
+	resp, err := adh.GetHistoryClient().StartWorkflowExecution(
+		ctx,
+		common.CreateHistoryStartWorkflowRequest(systemNamespaceEntry.GetInfo().Id, startRequest),
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.BackfillArchivalResponse{
+		WorkflowId: workflowID,
+		RunId:      resp.GetRunId(),
+	}, nil
+}
+
 func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
 ) error {
@@ -1220,3 +1610,44 @@ func (adh *AdminHandler) checkPermission(
 	}
 	return nil
 }
+
+func toAdminDynamicConfigValues(values []*persistence.DynamicConfigValue) []*adminservice.DynamicConfigValue {
+	result := make([]*adminservice.DynamicConfigValue, 0, len(values))
+	for _, value := range values {
+		names := make([]string, 0, len(value.Constraints))
+		for name := range value.Constraints {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		constraints := make([]*adminservice.DynamicConfigConstraint, 0, len(names))
+		for _, name := range names {
+			constraints = append(constraints, &adminservice.DynamicConfigConstraint{
+				Name:  name,
+				Value: value.Constraints[name],
+			})
+		}
+		result = append(result, &adminservice.DynamicConfigValue{
+			Value:       value.Value,
+			Constraints: constraints,
+		})
+	}
+	return result
+}
+
+func toPersistenceDynamicConfigValues(values []*adminservice.DynamicConfigValue) []*persistence.DynamicConfigValue {
+	result := make([]*persistence.DynamicConfigValue, 0, len(values))
+	for _, value := range values {
+		var constraints map[string]string
+		if len(value.GetConstraints()) > 0 {
+			constraints = make(map[string]string, len(value.GetConstraints()))
+			for _, constraint := range value.GetConstraints() {
+				constraints[constraint.GetName()] = constraint.GetValue()
+			}
+		}
+		result = append(result, &persistence.DynamicConfigValue{
+			Value:       value.GetValue(),
+			Constraints: constraints,
+		})
+	}
+	return result
+}
@@ -29,9 +29,11 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 
+	"go.temporal.io/server/api/persistenceblobs/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/persistence/serialization"
 
@@ -47,16 +49,20 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/elasticsearch"
 	esmock "go.temporal.io/server/common/elasticsearch/mocks"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/worker/archivalbackfill"
+	"go.temporal.io/server/service/worker/deletenamespace"
 )
 
 type (
@@ -607,3 +613,194 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
 		s.Nil(resp)
 	}
 }
+
+func (s *adminHandlerSuite) Test_UpdateDynamicConfig_RetryOnConflict() {
+	clusterMetadataManager := mocks.NewMockClusterMetadataManager(s.controller)
+	s.mockResource.PersistenceBean.EXPECT().GetClusterMetadataManager().Return(clusterMetadataManager).AnyTimes()
+
+	existing := []*persistence.DynamicConfigValue{{Value: "true"}}
+	clusterMetadataManager.EXPECT().GetDynamicConfig().Return(&persistence.GetDynamicConfigResponse{
+		Version: 1,
+		Values:  map[string][]*persistence.DynamicConfigValue{"system.enableNDC": existing},
+	}, nil)
+	clusterMetadataManager.EXPECT().UpdateDynamicConfig(gomock.Any()).Return(&persistence.ConditionFailedError{})
+	clusterMetadataManager.EXPECT().GetDynamicConfig().Return(&persistence.GetDynamicConfigResponse{
+		Version: 2,
+		Values:  map[string][]*persistence.DynamicConfigValue{"system.enableNDC": existing},
+	}, nil)
+	clusterMetadataManager.EXPECT().UpdateDynamicConfig(gomock.Any()).DoAndReturn(
+		func(request *persistence.UpdateDynamicConfigRequest) error {
+			s.Equal(int64(2), request.PreviousVersion)
+			s.Equal(existing, request.Values["system.enableNDC"])
+			s.Equal([]*persistence.DynamicConfigValue{
+				{Value: "4", Constraints: map[string]string{"taskQueueName": "tq"}},
+			}, request.Values["matching.numTaskqueueReadPartitions"])
+			s.Equal("tester", request.Change.Identity)
+			return nil
+		})
+
+	resp, err := s.handler.UpdateDynamicConfig(context.Background(), &adminservice.UpdateDynamicConfigRequest{
+		Name: "matching.numTaskqueueReadPartitions",
+		Values: []*adminservice.DynamicConfigValue{{
+			Value:       "4",
+			Constraints: []*adminservice.DynamicConfigConstraint{{Name: "taskQueueName", Value: "tq"}},
+		}},
+		Identity: "tester",
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.GetVersion())
+}
+
+func (s *adminHandlerSuite) Test_UpdateDynamicConfig_InvalidValue() {
+	_, err := s.handler.UpdateDynamicConfig(context.Background(), &adminservice.UpdateDynamicConfigRequest{
+		Name:   "unknown.key",
+		Values: []*adminservice.DynamicConfigValue{{Value: "4"}},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.UpdateDynamicConfig(context.Background(), &adminservice.UpdateDynamicConfigRequest{
+		Name: "matching.numTaskqueueReadPartitions",
+		Values: []*adminservice.DynamicConfigValue{{
+			Value:       "4",
+			Constraints: []*adminservice.DynamicConfigConstraint{{Name: "cluster", Value: "active"}},
+		}},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) Test_DumpDynamicConfig() {
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Name: s.namespace, Id: s.namespaceID}, nil, "", nil), nil)
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	s.handler.params.DynamicConfig = dynamicConfig
+
+	dynamicConfig.EXPECT().GetIntValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, map[dynamicconfig.Filter]interface{}{
+		dynamicconfig.Namespace:     s.namespace,
+		dynamicconfig.TaskQueueName: "tq",
+		dynamicconfig.TaskType:      enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}, gomock.Any()).Return(4, nil)
+	dynamicConfig.EXPECT().GetBoolValue(dynamicconfig.EnableNDC, map[dynamicconfig.Filter]interface{}{
+		dynamicconfig.Namespace: s.namespace,
+	}, gomock.Any()).Return(true, nil)
+	notFound := errors.New("unable to find key")
+	dynamicConfig.EXPECT().GetIntValue(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, notFound).AnyTimes()
+	dynamicConfig.EXPECT().GetFloatValue(gomock.Any(), gomock.Any(), gomock.Any()).Return(0.0, notFound).AnyTimes()
+	dynamicConfig.EXPECT().GetBoolValue(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, notFound).AnyTimes()
+	dynamicConfig.EXPECT().GetStringValue(gomock.Any(), gomock.Any(), gomock.Any()).Return("", notFound).AnyTimes()
+	dynamicConfig.EXPECT().GetDurationValue(gomock.Any(), gomock.Any(), gomock.Any()).Return(time.Minute, notFound).AnyTimes()
+	dynamicConfig.EXPECT().GetMapValue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, notFound).AnyTimes()
+	dynamicConfig.EXPECT().GetValueWithFilters(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, notFound).AnyTimes()
+
+	resp, err := s.handler.DumpDynamicConfig(context.Background(), &adminservice.DumpDynamicConfigRequest{
+		Namespace:     s.namespace,
+		TaskQueue:     "tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	s.NoError(err)
+	keys := make(map[string]*adminservice.DynamicConfigKeyDump, len(resp.GetKeys()))
+	for _, key := range resp.GetKeys() {
+		keys[key.GetName()] = key
+	}
+	s.Equal(&adminservice.DynamicConfigKeyDump{
+		Name:        "matching.numTaskqueueReadPartitions",
+		Type:        "int",
+		Filters:     []string{"namespace", "taskQueueName", "taskType"},
+		Description: keys["matching.numTaskqueueReadPartitions"].GetDescription(),
+		Value:       "4",
+	}, keys["matching.numTaskqueueReadPartitions"])
+	s.Equal("true", keys["system.enableNDC"].GetValue())
+	s.False(keys["system.enableNDC"].GetIsDefault())
+	s.Equal("1m0s", keys["history.cacheTTL"].GetValue())
+	s.True(keys["history.cacheTTL"].GetIsDefault())
+}
+
+func (s *adminHandlerSuite) Test_DeleteNamespace() {
+	systemNamespaceID := "deadd0d0-c001-face-d00d-000000000001"
+	s.mockResource.MetadataMgr.On("GetNamespace", &persistence.GetNamespaceRequest{Name: s.namespace}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info: &persistenceblobs.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		},
+	}, nil).Once()
+	s.mockNamespaceCache.EXPECT().GetNamespace(common.SystemLocalNamespace).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Name: common.SystemLocalNamespace, Id: systemNamespaceID}, nil, "", nil), nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.StartWorkflowExecutionRequest, _ ...interface{}) (*historyservice.StartWorkflowExecutionResponse, error) {
+			s.Equal(systemNamespaceID, request.GetNamespaceId())
+			startRequest := request.GetStartRequest()
+			s.Equal(deletenamespace.GetWorkflowID(s.namespace), startRequest.GetWorkflowId())
+			s.Equal(deletenamespace.WorkflowTypeName, startRequest.GetWorkflowType().GetName())
+			s.Equal(deletenamespace.TaskQueueName, startRequest.GetTaskQueue().GetName())
+			var params deletenamespace.DeleteNamespaceParams
+			s.NoError(payloads.Decode(startRequest.GetInput(), &params))
+			s.Equal(deletenamespace.DeleteNamespaceParams{Namespace: s.namespace, NamespaceID: s.namespaceID}, params)
+			return &historyservice.StartWorkflowExecutionResponse{RunId: "run-id"}, nil
+		})
+
+	resp, err := s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{Namespace: s.namespace})
+	s.NoError(err)
+	s.Equal(deletenamespace.GetWorkflowID(s.namespace), resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) Test_DeleteNamespace_Rejected() {
+	_, err := s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	_, err = s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{Namespace: common.SystemLocalNamespace})
+	s.Equal(errCannotDeleteSystemNamespace, err)
+
+	s.mockResource.MetadataMgr.On("GetNamespace", &persistence.GetNamespaceRequest{Name: s.namespace}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info: &persistenceblobs.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		},
+		IsGlobalNamespace: true,
+	}, nil).Once()
+	_, err = s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{Namespace: s.namespace})
+	s.Equal(errCannotDeleteGlobalNamespace, err)
+}
+
+func (s *adminHandlerSuite) Test_BackfillArchival() {
+	systemNamespaceID := "deadd0d0-c001-face-d00d-000000000001"
+	s.mockResource.ArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig(
+		"enabled", dynamicconfig.GetStringPropertyFn("enabled"), dynamicconfig.GetBoolPropertyFn(true), "disabled", ""))
+	s.mockResource.ArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig(
+		"enabled", dynamicconfig.GetStringPropertyFn("enabled"), dynamicconfig.GetBoolPropertyFn(true), "disabled", ""))
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Name: s.namespace, Id: s.namespaceID},
+		&persistenceblobs.NamespaceConfig{
+			HistoryArchivalStatus: enumspb.ARCHIVAL_STATUS_ENABLED,
+			HistoryArchivalUri:    "file:///tmp/history",
+		}, "", nil), nil)
+	s.mockNamespaceCache.EXPECT().GetNamespace(common.SystemLocalNamespace).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Name: common.SystemLocalNamespace, Id: systemNamespaceID}, nil, "", nil), nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.StartWorkflowExecutionRequest, _ ...interface{}) (*historyservice.StartWorkflowExecutionResponse, error) {
+			s.Equal(systemNamespaceID, request.GetNamespaceId())
+			startRequest := request.GetStartRequest()
+			s.Equal(archivalbackfill.GetWorkflowID(s.namespace), startRequest.GetWorkflowId())
+			s.Equal(archivalbackfill.WorkflowTypeName, startRequest.GetWorkflowType().GetName())
+			s.Equal(archivalbackfill.TaskQueueName, startRequest.GetTaskQueue().GetName())
+			var params archivalbackfill.BackfillParams
+			s.NoError(payloads.Decode(startRequest.GetInput(), &params))
+			s.Equal(archivalbackfill.BackfillParams{Namespace: s.namespace, NamespaceID: s.namespaceID, DryRun: true}, params)
+			return &historyservice.StartWorkflowExecutionResponse{RunId: "run-id"}, nil
+		})
+
+	resp, err := s.handler.BackfillArchival(context.Background(), &adminservice.BackfillArchivalRequest{Namespace: s.namespace, DryRun: true})
+	s.NoError(err)
+	s.Equal(archivalbackfill.GetWorkflowID(s.namespace), resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) Test_BackfillArchival_Rejected() {
+	_, err := s.handler.BackfillArchival(context.Background(), &adminservice.BackfillArchivalRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	s.mockResource.ArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewDisabledArchvialConfig())
+	s.mockResource.ArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewDisabledArchvialConfig())
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Name: s.namespace, Id: s.namespaceID},
+		&persistenceblobs.NamespaceConfig{HistoryArchivalStatus: enumspb.ARCHIVAL_STATUS_ENABLED}, "", nil), nil)
+	_, err = s.handler.BackfillArchival(context.Background(), &adminservice.BackfillArchivalRequest{Namespace: s.namespace})
+	s.Equal(errArchivalNotEnabledForNamespace, err)
+}
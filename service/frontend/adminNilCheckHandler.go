@@ -206,3 +206,66 @@ func (adh *AdminNilCheckHandler) ResendReplicationTasks(ctx context.Context, req
 	}
 	return resp, err
 }
+
+// GetDynamicConfig returns the values of a dynamic config key
+func (adh *AdminNilCheckHandler) GetDynamicConfig(ctx context.Context, request *adminservice.GetDynamicConfigRequest) (_ *adminservice.GetDynamicConfigResponse, err error) {
+	resp, err := adh.parentHandler.GetDynamicConfig(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.GetDynamicConfigResponse{}
+	}
+	return resp, err
+}
+
+// UpdateDynamicConfig replaces the values of a dynamic config key
+func (adh *AdminNilCheckHandler) UpdateDynamicConfig(ctx context.Context, request *adminservice.UpdateDynamicConfigRequest) (_ *adminservice.UpdateDynamicConfigResponse, err error) {
+	resp, err := adh.parentHandler.UpdateDynamicConfig(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpdateDynamicConfigResponse{}
+	}
+	return resp, err
+}
+
+// ListDynamicConfig returns all the dynamic config values
+func (adh *AdminNilCheckHandler) ListDynamicConfig(ctx context.Context, request *adminservice.ListDynamicConfigRequest) (_ *adminservice.ListDynamicConfigResponse, err error) {
+	resp, err := adh.parentHandler.ListDynamicConfig(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ListDynamicConfigResponse{}
+	}
+	return resp, err
+}
+
+// ListDynamicConfigHistory returns the changes of the dynamic config
+func (adh *AdminNilCheckHandler) ListDynamicConfigHistory(ctx context.Context, request *adminservice.ListDynamicConfigHistoryRequest) (_ *adminservice.ListDynamicConfigHistoryResponse, err error) {
+	resp, err := adh.parentHandler.ListDynamicConfigHistory(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ListDynamicConfigHistoryResponse{}
+	}
+	return resp, err
+}
+
+// DumpDynamicConfig returns the effective value of every dynamic config key
+func (adh *AdminNilCheckHandler) DumpDynamicConfig(ctx context.Context, request *adminservice.DumpDynamicConfigRequest) (_ *adminservice.DumpDynamicConfigResponse, err error) {
+	resp, err := adh.parentHandler.DumpDynamicConfig(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DumpDynamicConfigResponse{}
+	}
+	return resp, err
+}
+
+// DeleteNamespace starts the deletion of a namespace
+func (adh *AdminNilCheckHandler) DeleteNamespace(ctx context.Context, request *adminservice.DeleteNamespaceRequest) (_ *adminservice.DeleteNamespaceResponse, err error) {
+	resp, err := adh.parentHandler.DeleteNamespace(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DeleteNamespaceResponse{}
+	}
+	return resp, err
+}
+
+// BackfillArchival starts the archival backfill of a namespace
+func (adh *AdminNilCheckHandler) BackfillArchival(ctx context.Context, request *adminservice.BackfillArchivalRequest) (_ *adminservice.BackfillArchivalResponse, err error) {
+	resp, err := adh.parentHandler.BackfillArchival(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.BackfillArchivalResponse{}
+	}
+	return resp, err
+}
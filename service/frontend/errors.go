@@ -30,6 +30,7 @@ var (
 	errNamespaceNotSet                                    = serviceerror.NewInvalidArgument("Namespace not set on request.")
 	errTaskTokenNotSet                                    = serviceerror.NewInvalidArgument("Task token not set on request.")
 	errInvalidTaskToken                                   = serviceerror.NewInvalidArgument("Invalid TaskToken.")
+	errDeserializingToken                                 = serviceerror.NewInvalidArgument("Error deserializing task token.")
 	errTaskQueueNotSet                                    = serviceerror.NewInvalidArgument("TaskQueue is not set on request.")
 	errTaskQueueTypeNotSet                                = serviceerror.NewInvalidArgument("TaskQueueType is not set on request.")
 	errExecutionNotSet                                    = serviceerror.NewInvalidArgument("Execution is not set on request.")
@@ -80,6 +81,11 @@ var (
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
+	errDynamicConfigNameNotSet                            = serviceerror.NewInvalidArgument("Dynamic config name is not set on request.")
+	errInvalidDynamicConfigValue                          = serviceerror.NewInvalidArgument("Invalid dynamic config value, %v.")
+	errCannotDeleteSystemNamespace                        = serviceerror.NewInvalidArgument("System namespace cannot be deleted.")
+	errCannotDeleteGlobalNamespace                        = serviceerror.NewInvalidArgument("Global namespace cannot be deleted.")
+	errArchivalNotEnabledForNamespace                     = serviceerror.NewInvalidArgument("Archival is not enabled for the namespace.")
 	errShuttingDown                                       = serviceerror.NewInternal("Shutting down")
 
 	errFailedUpdateDynamicConfig = serviceerror.NewInternal("Failed to update dynamic config, err: %v.")
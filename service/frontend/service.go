@@ -37,6 +37,7 @@ import (
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -47,6 +48,7 @@ import (
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	espersistence "go.temporal.io/server/common/persistence/elasticsearch"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
@@ -67,11 +69,14 @@ type Config struct {
 	RPS                             dynamicconfig.IntPropertyFn
 	MaxNamespaceRPSPerInstance      dynamicconfig.IntPropertyFnWithNamespaceFilter
 	GlobalNamespaceRPS              dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NamespaceExecutionRPS           dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NamespaceVisibilityRPS          dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxIDLengthLimit                dynamicconfig.IntPropertyFn
 	EnableClientVersionCheck        dynamicconfig.BoolPropertyFn
 	MinRetentionDays                dynamicconfig.IntPropertyFn
 	DisallowQuery                   dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ShutdownDrainDuration           dynamicconfig.DurationPropertyFn
+	HealthCheckInterval             dynamicconfig.DurationPropertyFn
 
 	// Persistence settings
 	HistoryMgrNumConns dynamicconfig.IntPropertyFn
@@ -101,6 +106,11 @@ type Config struct {
 	// VisibilityArchival system protection
 	VisibilityArchivalQueryMaxPageSize dynamicconfig.IntPropertyFn
 
+	// ArchivalQueryTaskQueue is the task queue of the workers which answer queries on archived workflows
+	ArchivalQueryTaskQueue dynamicconfig.StringPropertyFnWithNamespaceFilter
+	// EnableArchivalListFallback is whether ListWorkflowExecutions lists the archived executions when none is found
+	EnableArchivalListFallback dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
 	SendRawWorkflowHistory dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	EnableRPCReplication         dynamicconfig.BoolPropertyFn
@@ -124,6 +134,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		RPS:                                    dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
 		MaxNamespaceRPSPerInstance:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceRPSPerInstance, 1200),
 		GlobalNamespaceRPS:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalNamespaceRPS, 0),
+		NamespaceExecutionRPS:                  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendNamespaceExecutionRPS, 0),
+		NamespaceVisibilityRPS:                 dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendNamespaceVisibilityRPS, 0),
 		MaxIDLengthLimit:                       dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		HistoryMgrNumConns:                     dc.GetIntProperty(dynamicconfig.FrontendHistoryMgrNumConns, 10),
 		MaxBadBinaries:                         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxBadBinaries, namespace.MaxBadBinaries),
@@ -134,6 +146,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		BlobSizeLimitWarn:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 256*1024),
 		ThrottledLogRPS:                        dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                  dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0),
+		HealthCheckInterval:                    dc.GetDurationProperty(dynamicconfig.FrontendHealthCheckInterval, 10*time.Second),
 		EnableNamespaceNotActiveAutoForwarding: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableNamespaceNotActiveAutoForwarding, true),
 		EnableClientVersionCheck:               dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck, false),
 		ValidSearchAttributes:                  dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
@@ -143,6 +156,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		MinRetentionDays:                       dc.GetIntProperty(dynamicconfig.MinRetentionDays, namespace.MinRetentionDays),
 		VisibilityArchivalQueryMaxPageSize:     dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize, 10000),
 		DisallowQuery:                          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisallowQuery, false),
+		ArchivalQueryTaskQueue:                 dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.ArchivalQueryTaskQueue, ""),
+		EnableArchivalListFallback:             dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableArchivalListFallback, false),
 		SendRawWorkflowHistory:                 dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.SendRawWorkflowHistory, false),
 		EnableRPCReplication:                   dc.GetBoolProperty(dynamicconfig.FrontendEnableRPCReplication, false),
 		EnableCleanupReplicationTask:           dc.GetBoolProperty(dynamicconfig.FrontendEnableCleanupReplicationTask, true),
@@ -257,7 +272,12 @@ func (s *Service) Start() {
 	if err != nil {
 		logger.Fatal("creating grpc server options failed", tag.Error(err))
 	}
-	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	interceptors := []grpc.UnaryServerInterceptor{rpc.TracingServerInterceptor}
+	if s.params.ClaimMapper != nil {
+		interceptors = append(interceptors, authorization.NewClaimMapperInterceptor(s.params.ClaimMapper, logger))
+	}
+	interceptors = append(interceptors, interceptor)
+	opts = append(opts, grpc.ChainUnaryInterceptor(interceptors...))
 	s.server = grpc.NewServer(opts...)
 
 	wfHandler := NewWorkflowHandler(s, s.config, replicationMessageSink)
@@ -277,6 +297,7 @@ func (s *Service) Start() {
 
 	// must start resource first
 	s.Resource.Start()
+	s.handler.Start()
 	s.adminHandler.Start()
 
 	listener := s.GetGRPCListener()
@@ -315,6 +336,7 @@ func (s *Service) Stop() {
 
 	// TODO: Change this to GracefulStop when integration tests are refactored.
 	s.server.Stop()
+	s.handler.Stop()
 	s.Resource.Stop()
 	s.params.Logger.Info("frontend stopped")
 }
@@ -25,18 +25,23 @@
 package frontend
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	querypb "go.temporal.io/api/query/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	versionpb "go.temporal.io/api/version/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -52,6 +57,7 @@ import (
 	"go.temporal.io/server/common/enums"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/health"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/messaging"
@@ -71,6 +77,11 @@ const (
 	HealthStatusShuttingDown
 )
 
+const (
+	// archivedVisibilityLookupMaxPages bounds the pages of archived visibility records scanned to look up a workflow execution
+	archivedVisibilityLookupMaxPages = 10
+)
+
 var _ Handler = (*WorkflowHandler)(nil)
 
 type (
@@ -87,14 +98,31 @@ type (
 		namespaceHandler          namespace.Handler
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
+		healthChecker             *health.Checker
 	}
 
 	// HealthStatus is an enum that refers to the rpc handler health status
 	HealthStatus int32
+
+	// archivedExecution is a workflow execution read back from the archival,
+	// config is only set if the history of the execution is archived
+	archivedExecution struct {
+		info   *workflowpb.WorkflowExecutionInfo
+		config *workflowpb.WorkflowExecutionConfig
+	}
 )
 
 var (
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
+
+	priorityScopes = map[quotas.Priority]int{
+		quotas.PriorityWorker:     metrics.FrontendPriorityWorkerScope,
+		quotas.PriorityExecution:  metrics.FrontendPriorityExecutionScope,
+		quotas.PriorityVisibility: metrics.FrontendPriorityVisibilityScope,
+	}
+
+	// archivedListPageTokenPrefix marks the page tokens of ListWorkflowExecutions which continue listing from the archival
+	archivedListPageTokenPrefix = []byte("archived:")
 )
 
 // NewWorkflowHandler creates a gRPC handler for workflowservice
@@ -108,7 +136,7 @@ func NewWorkflowHandler(
 		config:          config,
 		healthStatus:    int32(HealthStatusOK),
 		tokenSerializer: common.NewProtoTaskTokenSerializer(),
-		rateLimiter: quotas.NewMultiStageRateLimiter(
+		rateLimiter: quotas.NewPriorityRateLimiter(
 			func() float64 {
 				return float64(config.RPS())
 			},
@@ -122,6 +150,16 @@ func NewWorkflowHandler(
 				}
 				return float64(config.MaxNamespaceRPSPerInstance(namespace))
 			},
+			func(namespace string, priority quotas.Priority) float64 {
+				switch priority {
+				case quotas.PriorityExecution:
+					return float64(config.NamespaceExecutionRPS(namespace))
+				case quotas.PriorityVisibility:
+					return float64(config.NamespaceVisibilityRPS(namespace))
+				default:
+					return 0
+				}
+			},
 		),
 		versionChecker: headers.NewVersionChecker(),
 		namespaceHandler: namespace.NewHandler(
@@ -144,28 +182,45 @@ func NewWorkflowHandler(
 		),
 	}
 
+	handler.healthChecker = health.NewChecker(common.FrontendServiceName, config.HealthCheckInterval, resource.GetLogger())
+	health.AddServiceChecks(
+		handler.healthChecker,
+		common.FrontendServiceName,
+		handler.isHealthDraining,
+		resource.GetMembershipMonitor(),
+		resource.GetNamespaceCache(),
+		resource.GetMetadataManager(),
+	)
+
 	return handler
 }
 
 // Start starts the handler
 func (wh *WorkflowHandler) Start() {
+	wh.healthChecker.Start()
 }
 
 // Stop stops the handler
 func (wh *WorkflowHandler) Stop() {
 	atomic.StoreInt32(&wh.shuttingDown, 1)
+	wh.healthChecker.Stop()
 }
 
 // UpdateHealthStatus sets the health status for this rpc handler.
 // This health status will be used within the rpc health check handler
 func (wh *WorkflowHandler) UpdateHealthStatus(status HealthStatus) {
 	atomic.StoreInt32(&wh.healthStatus, int32(status))
+	wh.healthChecker.Refresh()
 }
 
 func (wh *WorkflowHandler) isShuttingDown() bool {
 	return atomic.LoadInt32(&wh.shuttingDown) != 0
 }
 
+func (wh *WorkflowHandler) isHealthDraining() bool {
+	return HealthStatus(atomic.LoadInt32(&wh.healthStatus)) != HealthStatusOK || wh.isShuttingDown()
+}
+
 // GetResource return resource
 func (wh *WorkflowHandler) GetResource() resource.Resource {
 	return wh.Resource
@@ -177,22 +232,14 @@ func (wh *WorkflowHandler) GetConfig() *Config {
 }
 
 // https://github.com/grpc/grpc/blob/master/doc/health-checking.md
-func (wh *WorkflowHandler) Check(context.Context, *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+func (wh *WorkflowHandler) Check(ctx context.Context, request *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
 	wh.GetLogger().Debug("Frontend service health check endpoint (gRPC) reached.")
-	status := HealthStatus(atomic.LoadInt32(&wh.healthStatus))
-	if status == HealthStatusOK {
-		return &healthpb.HealthCheckResponse{
-			Status: healthpb.HealthCheckResponse_SERVING,
-		}, nil
-	}
-
-	return &healthpb.HealthCheckResponse{
-		Status: healthpb.HealthCheckResponse_NOT_SERVING,
-	}, nil
+	return wh.healthChecker.Check(ctx, request)
 }
 
-func (wh *WorkflowHandler) Watch(*healthpb.HealthCheckRequest, healthpb.Health_WatchServer) error {
-	return serviceerror.NewUnimplemented("Watch is not implemented.")
+// Watch streams the health status of the service whenever it changes
+func (wh *WorkflowHandler) Watch(request *healthpb.HealthCheckRequest, server healthpb.Health_WatchServer) error {
+	return wh.healthChecker.Watch(request, server)
 }
 
 // RegisterNamespace creates a new namespace which can be used as a container for all resources.  Namespace is a top level
@@ -388,7 +435,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityExecution); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -517,7 +564,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityExecution); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -634,6 +681,12 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		continuationToken.BranchToken, runID, lastFirstEventID, nextEventID, isWorkflowRunning, err =
 			queryHistory(namespaceID, execution, queryNextEventID, nil)
 		if err != nil {
+			if _, ok := err.(*serviceerror.NotFound); ok && execution.GetRunId() == "" && !request.GetSkipArchival() &&
+				wh.GetArchivalMetadata().GetHistoryConfig().ReadEnabled() &&
+				wh.resolveArchivedRunID(ctx, namespaceID, execution) {
+				// the workflow has no current run left, read the history of its latest archived run
+				return wh.getArchivedHistory(ctx, request, namespaceID, scope)
+			}
 			return nil, wh.error(err, scope)
 		}
 
@@ -654,6 +707,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		if !isWorkflowRunning {
 			if rawHistoryQueryEnabled {
 				historyBlob, _, err = wh.getRawHistory(
+					ctx,
 					scope,
 					namespaceID,
 					*execution,
@@ -672,6 +726,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 				historyBlob = historyBlob[len(historyBlob)-1 : len(historyBlob)]
 			} else {
 				history, _, err = wh.getHistory(
+					ctx,
 					scope,
 					namespaceID,
 					*execution,
@@ -706,6 +761,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		} else {
 			if rawHistoryQueryEnabled {
 				historyBlob, continuationToken.PersistenceToken, err = wh.getRawHistory(
+					ctx,
 					scope,
 					namespaceID,
 					*execution,
@@ -718,6 +774,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 				)
 			} else {
 				history, continuationToken.PersistenceToken, err = wh.getHistory(
+					ctx,
 					scope,
 					namespaceID,
 					*execution,
@@ -874,9 +931,6 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(ctx context.Context, req
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
-
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
 	}
@@ -900,6 +954,9 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(ctx context.Context, req
 	)
 	defer sw.Stop()
 
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	wh.allow(namespaceEntry.GetInfo().Name, quotas.PriorityWorker)
+
 	if wh.isShuttingDown() {
 		return nil, errShuttingDown
 	}
@@ -958,9 +1015,6 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(ctx context.Context, reques
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
-
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
 	}
@@ -983,6 +1037,9 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(ctx context.Context, reques
 	)
 	defer sw.Stop()
 
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	wh.allow(namespaceEntry.GetInfo().Name, quotas.PriorityWorker)
+
 	if wh.isShuttingDown() {
 		return nil, errShuttingDown
 	}
@@ -1145,9 +1202,6 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(ctx context.Context, requ
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
-
 	wh.GetLogger().Debug("Received RecordActivityTaskHeartbeat")
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
@@ -1171,6 +1225,9 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(ctx context.Context, requ
 	)
 	defer sw.Stop()
 
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	wh.allow(namespaceEntry.GetInfo().Name, quotas.PriorityWorker)
+
 	if wh.isShuttingDown() {
 		return nil, errShuttingDown
 	}
@@ -1240,7 +1297,7 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatById(ctx context.Context,
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow(request.GetNamespace(), quotas.PriorityWorker)
 
 	wh.GetLogger().Debug("Received RecordActivityTaskHeartbeatById")
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
@@ -1344,9 +1401,6 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(ctx context.Context, req
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
-
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
 	}
@@ -1373,6 +1427,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(ctx context.Context, req
 	)
 	defer sw.Stop()
 
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	wh.allow(namespaceEntry.GetInfo().Name, quotas.PriorityWorker)
+
 	if wh.isShuttingDown() {
 		return nil, errShuttingDown
 	}
@@ -1441,7 +1498,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow(request.GetNamespace(), quotas.PriorityWorker)
 
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
 	if err != nil {
@@ -1548,9 +1605,6 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(ctx context.Context, reques
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
-
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
 	}
@@ -1578,6 +1632,9 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(ctx context.Context, reques
 	)
 	defer sw.Stop()
 
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	wh.allow(namespaceEntry.GetInfo().Name, quotas.PriorityWorker)
+
 	if wh.isShuttingDown() {
 		return nil, errShuttingDown
 	}
@@ -1639,7 +1696,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow(request.GetNamespace(), quotas.PriorityWorker)
 
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
 	if err != nil {
@@ -1734,9 +1791,6 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(ctx context.Context, requ
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
-
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
 	}
@@ -1762,6 +1816,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(ctx context.Context, requ
 	)
 	defer sw.Stop()
 
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	wh.allow(namespaceEntry.GetInfo().Name, quotas.PriorityWorker)
+
 	if wh.isShuttingDown() {
 		return nil, errShuttingDown
 	}
@@ -1834,7 +1891,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow(request.GetNamespace(), quotas.PriorityWorker)
 
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
 	if err != nil {
@@ -1945,7 +2002,7 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(ctx context.Context, r
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityExecution); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -1993,7 +2050,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityExecution); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -2076,7 +2133,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityExecution); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -2221,7 +2278,7 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context, request *
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityExecution); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -2269,7 +2326,7 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context, reque
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityExecution); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -2316,7 +2373,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context, reque
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityVisibility); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -2360,7 +2417,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context, reque
 		if wh.config.DisableListVisibilityByFilter(namespace) {
 			err = errNoPermission
 		} else {
-			persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutionsByWorkflowID(
+			persistenceResp, err = persistence.VisibilityManagerWithContext(ctx, wh.GetVisibilityManager()).ListOpenWorkflowExecutionsByWorkflowID(
 				&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
 					ListWorkflowExecutionsRequest: baseReq,
 					WorkflowID:                    request.GetExecutionFilter().GetWorkflowId(),
@@ -2372,7 +2429,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context, reque
 		if wh.config.DisableListVisibilityByFilter(namespace) {
 			err = errNoPermission
 		} else {
-			persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
+			persistenceResp, err = persistence.VisibilityManagerWithContext(ctx, wh.GetVisibilityManager()).ListOpenWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
 				ListWorkflowExecutionsRequest: baseReq,
 				WorkflowTypeName:              request.GetTypeFilter().GetName(),
 			})
@@ -2380,7 +2437,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context, reque
 		wh.GetLogger().Info("List open workflow with filter",
 			tag.WorkflowNamespace(request.GetNamespace()), tag.WorkflowListWorkflowFilterByType)
 	} else {
-		persistenceResp, err = wh.GetVisibilityManager().ListOpenWorkflowExecutions(&baseReq)
+		persistenceResp, err = persistence.VisibilityManagerWithContext(ctx, wh.GetVisibilityManager()).ListOpenWorkflowExecutions(&baseReq)
 	}
 
 	if err != nil {
@@ -2412,7 +2469,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, req
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityVisibility); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -2456,7 +2513,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, req
 		if wh.config.DisableListVisibilityByFilter(namespace) {
 			err = errNoPermission
 		} else {
-			persistenceResp, err = wh.GetVisibilityManager().ListClosedWorkflowExecutionsByWorkflowID(
+			persistenceResp, err = persistence.VisibilityManagerWithContext(ctx, wh.GetVisibilityManager()).ListClosedWorkflowExecutionsByWorkflowID(
 				&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
 					ListWorkflowExecutionsRequest: baseReq,
 					WorkflowID:                    request.GetExecutionFilter().GetWorkflowId(),
@@ -2468,7 +2525,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, req
 		if wh.config.DisableListVisibilityByFilter(namespace) {
 			err = errNoPermission
 		} else {
-			persistenceResp, err = wh.GetVisibilityManager().ListClosedWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
+			persistenceResp, err = persistence.VisibilityManagerWithContext(ctx, wh.GetVisibilityManager()).ListClosedWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
 				ListWorkflowExecutionsRequest: baseReq,
 				WorkflowTypeName:              request.GetTypeFilter().GetName(),
 			})
@@ -2482,7 +2539,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, req
 			if request.GetStatusFilter().GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED || request.GetStatusFilter().GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
 				err = errStatusFilterMustBeNotRunning
 			} else {
-				persistenceResp, err = wh.GetVisibilityManager().ListClosedWorkflowExecutionsByStatus(&persistence.ListClosedWorkflowExecutionsByStatusRequest{
+				persistenceResp, err = persistence.VisibilityManagerWithContext(ctx, wh.GetVisibilityManager()).ListClosedWorkflowExecutionsByStatus(&persistence.ListClosedWorkflowExecutionsByStatusRequest{
 					ListWorkflowExecutionsRequest: baseReq,
 					Status:                        request.GetStatusFilter().GetStatus(),
 				})
@@ -2491,7 +2548,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, req
 		wh.GetLogger().Info("List closed workflow with filter",
 			tag.WorkflowNamespace(request.GetNamespace()), tag.WorkflowListWorkflowFilterByStatus)
 	} else {
-		persistenceResp, err = wh.GetVisibilityManager().ListClosedWorkflowExecutions(&baseReq)
+		persistenceResp, err = persistence.VisibilityManagerWithContext(ctx, wh.GetVisibilityManager()).ListClosedWorkflowExecutions(&baseReq)
 	}
 
 	if err != nil {
@@ -2523,7 +2580,7 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityVisibility); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -2539,6 +2596,8 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		return nil, wh.error(errPageSizeTooBig.MessageArgs(wh.config.ESIndexMaxResultWindow()), scope)
 	}
 
+	// the validator rewrites the query for the visibility store, archivers take the query as given
+	query := request.GetQuery()
 	if err := wh.visibilityQueryValidator.ValidateListRequestForQuery(request); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2549,6 +2608,17 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		return nil, wh.error(err, scope)
 	}
 
+	if isArchivedListPageToken(request.NextPageToken) {
+		archivedResp, err := wh.listArchivedWorkflowExecutions(ctx, namespaceID, request, query)
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+		if archivedResp == nil {
+			return nil, wh.error(errInvalidNextPageToken, scope)
+		}
+		return archivedResp, nil
+	}
+
 	req := &persistence.ListWorkflowExecutionsRequestV2{
 		NamespaceID:   namespaceID,
 		Namespace:     namespace,
@@ -2556,11 +2626,23 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		NextPageToken: request.NextPageToken,
 		Query:         request.GetQuery(),
 	}
-	persistenceResp, err := wh.GetVisibilityManager().ListWorkflowExecutions(req)
+	persistenceResp, err := persistence.VisibilityManagerWithContext(ctx, wh.GetVisibilityManager()).ListWorkflowExecutions(req)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
 
+	if wh.config.EnableArchivalListFallback(namespace) &&
+		len(request.NextPageToken) == 0 && len(persistenceResp.Executions) == 0 && len(persistenceResp.NextPageToken) == 0 {
+		// the executions may have passed retention, look them up from the archival
+		archivedResp, err := wh.listArchivedWorkflowExecutions(ctx, namespaceID, request, query)
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+		if archivedResp != nil {
+			return archivedResp, nil
+		}
+	}
+
 	return &workflowservice.ListWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
 		NextPageToken: persistenceResp.NextPageToken,
@@ -2586,7 +2668,7 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(ctx context.Context, r
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityVisibility); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -2674,7 +2756,7 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, request *
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityVisibility); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -2707,7 +2789,7 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, request *
 		NextPageToken: request.NextPageToken,
 		Query:         request.GetQuery(),
 	}
-	persistenceResp, err := wh.GetVisibilityManager().ScanWorkflowExecutions(req)
+	persistenceResp, err := persistence.VisibilityManagerWithContext(ctx, wh.GetVisibilityManager()).ScanWorkflowExecutions(req)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2738,7 +2820,7 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, request
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityVisibility); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -2761,7 +2843,7 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, request
 		Namespace:   namespace,
 		Query:       request.GetQuery(),
 	}
-	persistenceResp, err := wh.GetVisibilityManager().CountWorkflowExecutions(req)
+	persistenceResp, err := persistence.VisibilityManagerWithContext(ctx, wh.GetVisibilityManager()).CountWorkflowExecutions(req)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2809,9 +2891,6 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(ctx context.Context, reques
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
-
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
 	}
@@ -2834,6 +2913,9 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(ctx context.Context, reques
 	)
 	defer sw.Stop()
 
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	wh.allow(namespaceEntry.GetInfo().Name, quotas.PriorityWorker)
+
 	if wh.isShuttingDown() {
 		return nil, errShuttingDown
 	}
@@ -2950,6 +3032,10 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityVisibility); !ok {
+		return nil, wh.error(errServiceBusy, scope)
+	}
+
 	if request.GetNamespace() == "" {
 		return nil, wh.error(errNamespaceNotSet, scope)
 	}
@@ -2994,6 +3080,16 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 	}
 	hResponse, err := wh.GetHistoryClient().QueryWorkflow(ctx, req)
 	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			// the execution may have passed retention, replay the query on its archived history
+			archivedResp, archivalErr := wh.queryArchivedWorkflow(ctx, namespaceID, request)
+			if archivalErr != nil {
+				return nil, wh.error(archivalErr, scope)
+			}
+			if archivedResp != nil {
+				return archivedResp, nil
+			}
+		}
 		return nil, wh.error(err, scope)
 	}
 	return hResponse.GetResponse(), nil
@@ -3018,7 +3114,7 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityVisibility); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -3040,6 +3136,19 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 	})
 
 	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			// the execution may have passed retention, describe it from the archival
+			archived, archivalErr := wh.getArchivedExecution(ctx, namespaceID, request.Execution)
+			if archivalErr != nil {
+				return nil, wh.error(archivalErr, scope)
+			}
+			if archived != nil {
+				return &workflowservice.DescribeWorkflowExecutionResponse{
+					ExecutionConfig:       archived.config,
+					WorkflowExecutionInfo: archived.info,
+				}, nil
+			}
+		}
 		return nil, wh.error(err, scope)
 	}
 
@@ -3071,7 +3180,7 @@ func (wh *WorkflowHandler) DescribeTaskQueue(ctx context.Context, request *workf
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityVisibility); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -3106,18 +3215,44 @@ func (wh *WorkflowHandler) DescribeTaskQueue(ctx context.Context, request *workf
 		return nil, wh.error(err, scope)
 	}
 
+	// the backlog statistics are sent to the client in the response header
+	if stats := matchingResponse.GetTaskQueueStats(); stats != nil {
+		if err := headers.SetTaskQueueStats(ctx, toTaskQueueStatsHeader(stats)); err != nil {
+			wh.GetLogger().Debug("Failed to send task queue stats", tag.Error(err))
+		}
+	}
+
 	return &workflowservice.DescribeTaskQueueResponse{
 		Pollers:         matchingResponse.Pollers,
 		TaskQueueStatus: matchingResponse.TaskQueueStatus,
 	}, nil
 }
 
+func toTaskQueueStatsHeader(stats *matchingservice.TaskQueueStats) *headers.TaskQueueStats {
+	result := &headers.TaskQueueStats{
+		ApproximateBacklogCount: stats.GetApproximateBacklogCount(),
+		AddRate:                 stats.GetAddRate(),
+		DispatchRate:            stats.GetDispatchRate(),
+		SyncMatchRate:           stats.GetSyncMatchRate(),
+	}
+	if stats.GetOldestTaskCreateTime() != 0 {
+		result.OldestTaskCreateTime = time.Unix(0, stats.GetOldestTaskCreateTime()).UTC()
+	}
+	if len(stats.GetPollerBuildIds()) > 0 {
+		result.PollerBuildIDs = make(map[string]string, len(stats.GetPollerBuildIds()))
+		for _, poller := range stats.GetPollerBuildIds() {
+			result.PollerBuildIDs[poller.GetIdentity()] = poller.GetBuildId()
+		}
+	}
+	return result
+}
+
 // GetClusterInfo return information about Temporal deployment.
 func (wh *WorkflowHandler) GetClusterInfo(ctx context.Context, _ *workflowservice.GetClusterInfoRequest) (_ *workflowservice.GetClusterInfoResponse, retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope := wh.getDefaultScope(metrics.FrontendClientGetClusterInfoScope)
-	if ok := wh.allow(""); !ok {
+	if ok := wh.allow("", quotas.PriorityVisibility); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -3144,7 +3279,7 @@ func (wh *WorkflowHandler) ListTaskQueuePartitions(ctx context.Context, request
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
+	if ok := wh.allow(request.GetNamespace(), quotas.PriorityVisibility); !ok {
 		return nil, wh.error(errServiceBusy, scope)
 	}
 
@@ -3172,6 +3307,7 @@ func (wh *WorkflowHandler) ListTaskQueuePartitions(ctx context.Context, request
 }
 
 func (wh *WorkflowHandler) getRawHistory(
+	ctx context.Context,
 	scope metrics.Scope,
 	namespaceID string,
 	execution commonpb.WorkflowExecution,
@@ -3185,7 +3321,7 @@ func (wh *WorkflowHandler) getRawHistory(
 	var rawHistory []*commonpb.DataBlob
 	shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowId(), wh.config.NumHistoryShards)
 
-	resp, err := wh.GetHistoryManager().ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
+	resp, err := persistence.HistoryManagerWithContext(ctx, wh.GetHistoryManager()).ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
 		BranchToken:   branchToken,
 		MinEventID:    firstEventID,
 		MaxEventID:    nextEventID,
@@ -3246,6 +3382,7 @@ func (wh *WorkflowHandler) getRawHistory(
 }
 
 func (wh *WorkflowHandler) getHistory(
+	ctx context.Context,
 	scope metrics.Scope,
 	namespaceID string,
 	execution commonpb.WorkflowExecution,
@@ -3262,7 +3399,7 @@ func (wh *WorkflowHandler) getHistory(
 	shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowId(), wh.config.NumHistoryShards)
 	var err error
 	var historyEvents []*historypb.HistoryEvent
-	historyEvents, size, nextPageToken, err = persistence.ReadFullPageV2Events(wh.GetHistoryManager(), &persistence.ReadHistoryBranchRequest{
+	historyEvents, size, nextPageToken, err = persistence.ReadFullPageV2Events(persistence.HistoryManagerWithContext(ctx, wh.GetHistoryManager()), &persistence.ReadHistoryBranchRequest{
 		BranchToken:   branchToken,
 		MinEventID:    firstEventID,
 		MaxEventID:    nextEventID,
@@ -3436,7 +3573,24 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 	var continuation []byte
 	var err error
 
-	if matchingResp.GetStickyExecutionEnabled() && matchingResp.Query != nil {
+	if matchingResp.GetArchived() {
+		// query on the history of an execution which has passed retention,
+		// the remaining pages are read back from the archival by GetWorkflowExecutionHistory
+		namespace, dErr := wh.GetNamespaceCache().GetNamespaceByID(namespaceID)
+		if dErr != nil {
+			return nil, dErr
+		}
+		history, continuation, err = wh.readArchivedHistory(
+			ctx,
+			namespaceID,
+			matchingResp.GetWorkflowExecution(),
+			wh.config.HistoryMaxPageSize(namespace.GetInfo().Name),
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else if matchingResp.GetStickyExecutionEnabled() && matchingResp.Query != nil {
 		// meaning sticky query, we should not return any events to worker
 		// since query task only check the current status
 		history = &historypb.History{
@@ -3463,6 +3617,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		}
 		scope = scope.Tagged(metrics.NamespaceTag(namespace.GetInfo().Name))
 		history, persistenceToken, err = wh.getHistory(
+			ctx,
 			scope,
 			namespaceID,
 			*matchingResp.GetWorkflowExecution(),
@@ -3599,51 +3754,447 @@ func (wh *WorkflowHandler) getArchivedHistory(
 	namespaceID string,
 	scope metrics.Scope,
 ) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
-	entry, err := wh.GetNamespaceCache().GetNamespaceByID(namespaceID)
+	history, nextPageToken, err := wh.readArchivedHistory(
+		ctx,
+		namespaceID,
+		request.GetExecution(),
+		int(request.GetMaximumPageSize()),
+		request.GetNextPageToken(),
+	)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
 
+	return &workflowservice.GetWorkflowExecutionHistoryResponse{
+		History:       history,
+		NextPageToken: nextPageToken,
+		Archived:      true,
+	}, nil
+}
+
+func (wh *WorkflowHandler) readArchivedHistory(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+	pageSize int,
+	nextPageToken []byte,
+) (*historypb.History, []byte, error) {
+	entry, err := wh.GetNamespaceCache().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	URIString := entry.GetConfig().HistoryArchivalUri
 	if URIString == "" {
 		// if URI is empty, it means the namespace has never enabled for archival.
 		// the error is not "workflow has passed retention period", because
 		// we have no way to tell if the requested workflow exists or not.
-		return nil, wh.error(errHistoryNotFound, scope)
+		return nil, nil, errHistoryNotFound
 	}
 
 	URI, err := archiver.NewURI(URIString)
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return nil, nil, err
 	}
 
 	historyArchiver, err := wh.GetArchiverProvider().GetHistoryArchiver(URI.Scheme(), common.FrontendServiceName)
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return nil, nil, err
 	}
 
 	resp, err := historyArchiver.Get(ctx, URI, &archiver.GetHistoryRequest{
 		NamespaceID:   namespaceID,
-		WorkflowID:    request.GetExecution().GetWorkflowId(),
-		RunID:         request.GetExecution().GetRunId(),
-		NextPageToken: request.GetNextPageToken(),
-		PageSize:      int(request.GetMaximumPageSize()),
+		WorkflowID:    execution.GetWorkflowId(),
+		RunID:         execution.GetRunId(),
+		NextPageToken: nextPageToken,
+		PageSize:      pageSize,
 	})
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return nil, nil, err
 	}
 
 	history := &historypb.History{}
 	for _, batch := range resp.HistoryBatches {
 		history.Events = append(history.Events, batch.Events...)
 	}
-	return &workflowservice.GetWorkflowExecutionHistoryResponse{
-		History:       history,
-		NextPageToken: resp.NextPageToken,
-		Archived:      true,
+	return history, resp.NextPageToken, nil
+}
+
+// getArchivedExecution reads back a workflow execution which has passed retention from the archival,
+// the latest archived run is used if the run ID is empty. Nil is returned if the execution is not archived.
+func (wh *WorkflowHandler) getArchivedExecution(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+) (*archivedExecution, error) {
+	record, err := wh.getArchivedVisibilityRecord(ctx, namespaceID, execution)
+	if err != nil {
+		return nil, err
+	}
+
+	runID := execution.GetRunId()
+	if runID == "" && record != nil {
+		runID = record.GetExecution().GetRunId()
+	}
+
+	var events []*historypb.HistoryEvent
+	if runID != "" && wh.GetArchivalMetadata().GetHistoryConfig().ReadEnabled() {
+		// the start event is enough with the visibility record, the close event is read otherwise
+		events, err = wh.getArchivedHistoryEvents(ctx, namespaceID, &commonpb.WorkflowExecution{
+			WorkflowId: execution.GetWorkflowId(),
+			RunId:      runID,
+		}, record != nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if record == nil && len(events) == 0 {
+		return nil, nil
+	}
+
+	archived := &archivedExecution{info: record}
+	if len(events) != 0 {
+		startEvent := events[0]
+		attributes := startEvent.GetWorkflowExecutionStartedEventAttributes()
+		if attributes == nil {
+			return nil, serviceerror.NewDataLoss("Archived history does not start with WorkflowExecutionStarted event.")
+		}
+		archived.config = &workflowpb.WorkflowExecutionConfig{
+			TaskQueue:                       attributes.GetTaskQueue(),
+			WorkflowExecutionTimeoutSeconds: attributes.GetWorkflowExecutionTimeoutSeconds(),
+			WorkflowRunTimeoutSeconds:       attributes.GetWorkflowRunTimeoutSeconds(),
+			WorkflowTaskTimeoutSeconds:      attributes.GetWorkflowTaskTimeoutSeconds(),
+		}
+		if archived.info == nil {
+			archived.info = wh.archivedExecutionInfoFromHistory(execution.GetWorkflowId(), runID, events)
+		}
+	}
+
+	// special handling of ExecutionTime for cron or retry
+	if archived.info.GetExecutionTime() == 0 {
+		archived.info.ExecutionTime = archived.info.GetStartTime().GetValue()
+	}
+	return archived, nil
+}
+
+// getArchivedVisibilityRecord looks up the archived visibility record of a workflow execution,
+// the record of the latest closed run is returned if the run ID is empty.
+func (wh *WorkflowHandler) getArchivedVisibilityRecord(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+) (*workflowpb.WorkflowExecutionInfo, error) {
+	if !wh.GetArchivalMetadata().GetVisibilityConfig().ReadEnabled() {
+		return nil, nil
+	}
+
+	entry, err := wh.GetNamespaceCache().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	URIString := entry.GetConfig().VisibilityArchivalUri
+	if URIString == "" {
+		return nil, nil
+	}
+
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+
+	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), common.FrontendServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("WorkflowId = '%s'", strings.ReplaceAll(execution.GetWorkflowId(), "'", "\\'"))
+	if execution.GetRunId() != "" {
+		// look up the record of the run directly, the records of the workflow are scanned if the
+		// archiver can not look up executions by run ID
+		response, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
+			NamespaceID: namespaceID,
+			PageSize:    1,
+			Query:       fmt.Sprintf("%s AND RunId = '%s'", query, strings.ReplaceAll(execution.GetRunId(), "'", "\\'")),
+		})
+		if err == nil {
+			for _, record := range response.Executions {
+				if record.GetExecution().GetRunId() == execution.GetRunId() {
+					return record, nil
+				}
+			}
+			return nil, nil
+		}
+		if _, ok := err.(*serviceerror.InvalidArgument); !ok {
+			return nil, err
+		}
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: namespaceID,
+		PageSize:    wh.config.VisibilityMaxPageSize(entry.GetInfo().Name),
+		Query:       query,
+	}
+
+	var latest *workflowpb.WorkflowExecutionInfo
+	for page := 0; page < archivedVisibilityLookupMaxPages; page++ {
+		response, err := visibilityArchiver.Query(ctx, URI, request)
+		if err != nil {
+			if _, ok := err.(*serviceerror.InvalidArgument); ok {
+				// the archiver can not look up executions by workflow ID alone
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		for _, record := range response.Executions {
+			if execution.GetRunId() != "" {
+				if record.GetExecution().GetRunId() == execution.GetRunId() {
+					return record, nil
+				}
+				continue
+			}
+			if latest == nil || record.GetCloseTime().GetValue() > latest.GetCloseTime().GetValue() {
+				latest = record
+			}
+		}
+
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	return latest, nil
+}
+
+// getArchivedHistoryEvents reads the archived history of a workflow execution, only its first page
+// is read if firstPageOnly is set. Nil is returned if the history is not archived.
+func (wh *WorkflowHandler) getArchivedHistoryEvents(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+	firstPageOnly bool,
+) ([]*historypb.HistoryEvent, error) {
+	entry, err := wh.GetNamespaceCache().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*historypb.HistoryEvent
+	var nextPageToken []byte
+	for {
+		history, token, err := wh.readArchivedHistory(
+			ctx,
+			namespaceID,
+			execution,
+			wh.config.HistoryMaxPageSize(entry.GetInfo().Name),
+			nextPageToken,
+		)
+		if err != nil {
+			if _, ok := err.(*serviceerror.NotFound); ok || err == errHistoryNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		events = append(events, history.GetEvents()...)
+		if len(token) == 0 || firstPageOnly {
+			return events, nil
+		}
+		nextPageToken = token
+	}
+}
+
+func (wh *WorkflowHandler) archivedExecutionInfoFromHistory(
+	workflowID string,
+	runID string,
+	events []*historypb.HistoryEvent,
+) *workflowpb.WorkflowExecutionInfo {
+	startEvent := events[0]
+	lastEvent := events[len(events)-1]
+	attributes := startEvent.GetWorkflowExecutionStartedEventAttributes()
+
+	info := &workflowpb.WorkflowExecutionInfo{
+		Execution:        &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+		Type:             attributes.GetWorkflowType(),
+		StartTime:        &types.Int64Value{Value: startEvent.GetTimestamp()},
+		CloseTime:        &types.Int64Value{Value: lastEvent.GetTimestamp()},
+		Status:           workflowStatusFromCloseEvent(lastEvent.GetEventType()),
+		HistoryLength:    lastEvent.GetEventId(),
+		ParentExecution:  attributes.GetParentWorkflowExecution(),
+		ExecutionTime:    startEvent.GetTimestamp() + int64(attributes.GetFirstDecisionTaskBackoffSeconds())*int64(time.Second),
+		Memo:             attributes.GetMemo(),
+		SearchAttributes: attributes.GetSearchAttributes(),
+		TaskQueue:        attributes.GetTaskQueue().GetName(),
+	}
+	if parentNamespace := attributes.GetParentWorkflowNamespace(); parentNamespace != "" {
+		if parentNamespaceID, err := wh.GetNamespaceCache().GetNamespaceID(parentNamespace); err == nil {
+			info.ParentNamespaceId = parentNamespaceID
+		}
+	}
+	return info
+}
+
+// resolveArchivedRunID sets the run ID of the latest archived run on an execution without run ID,
+// false is returned if the workflow has no archived run
+func (wh *WorkflowHandler) resolveArchivedRunID(
+	ctx context.Context,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+) bool {
+	record, err := wh.getArchivedVisibilityRecord(ctx, namespaceID, execution)
+	if err != nil {
+		wh.GetLogger().Warn("Failed to look up archived visibility record.",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.Error(err))
+		return false
+	}
+	if record == nil {
+		return false
+	}
+	execution.RunId = record.GetExecution().GetRunId()
+	return true
+}
+
+// queryArchivedWorkflow replays the archived history of a workflow execution on a query worker,
+// nil is returned if the execution is not archived.
+func (wh *WorkflowHandler) queryArchivedWorkflow(
+	ctx context.Context,
+	namespaceID string,
+	request *workflowservice.QueryWorkflowRequest,
+) (*workflowservice.QueryWorkflowResponse, error) {
+	archived, err := wh.getArchivedExecution(ctx, namespaceID, request.GetExecution())
+	if err != nil || archived == nil || archived.config == nil {
+		// the archived history is required to replay the query
+		return nil, err
+	}
+
+	// archived executions are always closed
+	status := archived.info.GetStatus()
+	notOpenReject := request.GetQueryRejectCondition() == enumspb.QUERY_REJECT_CONDITION_NOT_OPEN
+	notCompletedCleanlyReject := request.GetQueryRejectCondition() == enumspb.QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY && status != enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	if notOpenReject || notCompletedCleanlyReject {
+		return &workflowservice.QueryWorkflowResponse{
+			QueryRejected: &querypb.QueryRejected{
+				Status: status,
+			},
+		}, nil
+	}
+
+	taskQueue := archived.config.GetTaskQueue()
+	if name := wh.config.ArchivalQueryTaskQueue(request.GetNamespace()); name != "" {
+		taskQueue = &taskqueuepb.TaskQueue{Name: name, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	}
+
+	queryRequest := *request
+	queryRequest.Execution = archived.info.GetExecution()
+	matchingResp, err := wh.GetMatchingClient().QueryWorkflow(ctx, &matchingservice.QueryWorkflowRequest{
+		NamespaceId:  namespaceID,
+		TaskQueue:    taskQueue,
+		QueryRequest: &queryRequest,
+		Archived:     true,
+		WorkflowType: archived.info.GetType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &workflowservice.QueryWorkflowResponse{
+		QueryResult:   matchingResp.GetQueryResult(),
+		QueryRejected: matchingResp.GetQueryRejected(),
+	}, nil
+}
+
+// listArchivedWorkflowExecutions lists the executions matching a visibility query from the archival,
+// nil is returned if the namespace does not archive visibility or the archiver does not support the query.
+func (wh *WorkflowHandler) listArchivedWorkflowExecutions(
+	ctx context.Context,
+	namespaceID string,
+	request *workflowservice.ListWorkflowExecutionsRequest,
+	query string,
+) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+	if !wh.GetArchivalMetadata().GetVisibilityConfig().ReadEnabled() {
+		return nil, nil
+	}
+
+	entry, err := wh.GetNamespaceCache().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	URIString := entry.GetConfig().VisibilityArchivalUri
+	if URIString == "" {
+		return nil, nil
+	}
+
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+
+	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), common.FrontendServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(request.GetPageSize())
+	if maxPageSize := wh.config.VisibilityArchivalQueryMaxPageSize(); pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	archiverResponse, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
+		NamespaceID:   namespaceID,
+		PageSize:      pageSize,
+		NextPageToken: bytes.TrimPrefix(request.GetNextPageToken(), archivedListPageTokenPrefix),
+		Query:         query,
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.InvalidArgument); ok && !isArchivedListPageToken(request.GetNextPageToken()) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// special handling of ExecutionTime for cron or retry
+	for _, execution := range archiverResponse.Executions {
+		if execution.GetExecutionTime() == 0 {
+			execution.ExecutionTime = execution.GetStartTime().GetValue()
+		}
+	}
+
+	var nextPageToken []byte
+	if len(archiverResponse.NextPageToken) != 0 {
+		nextPageToken = append(append([]byte{}, archivedListPageTokenPrefix...), archiverResponse.NextPageToken...)
+	}
+	return &workflowservice.ListWorkflowExecutionsResponse{
+		Executions:    archiverResponse.Executions,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+func isArchivedListPageToken(token []byte) bool {
+	return bytes.HasPrefix(token, archivedListPageTokenPrefix)
+}
+
+func workflowStatusFromCloseEvent(eventType enumspb.EventType) enumspb.WorkflowExecutionStatus {
+	switch eventType {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW
+	default:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+	}
+}
+
 func (wh *WorkflowHandler) convertIndexedKeyToProto(keys map[string]interface{}) map[string]enumspb.IndexedValueType {
 	converted := make(map[string]enumspb.IndexedValueType)
 	for k, v := range keys {
@@ -3657,9 +4208,16 @@ func (wh *WorkflowHandler) isListRequestPageSizeTooLarge(pageSize int32, namespa
 		pageSize > int32(wh.config.ESIndexMaxResultWindow())
 }
 
-func (wh *WorkflowHandler) allow(namespace string) bool {
-	return wh.rateLimiter.Allow(quotas.Info{Namespace: namespace})
+func (wh *WorkflowHandler) allow(namespace string, priority quotas.Priority) bool {
+	scope := wh.GetMetricsClient().Scope(priorityScopes[priority]).Tagged(metrics.NamespaceTag(namespace))
+	scope.IncCounter(metrics.ServiceRequests)
+	if !wh.rateLimiter.Allow(quotas.Info{Namespace: namespace, Priority: priority}) {
+		scope.IncCounter(metrics.ServiceErrResourceExhaustedCounter)
+		return false
+	}
+	return true
 }
+
 func (wh *WorkflowHandler) checkPermission(
 	config *Config,
 	securityToken string,
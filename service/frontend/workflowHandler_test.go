@@ -27,9 +27,11 @@ package frontend
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/mock"
@@ -40,12 +42,15 @@ import (
 	filterpb "go.temporal.io/api/filter/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	querypb "go.temporal.io/api/query/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
@@ -57,10 +62,12 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/mocks"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resource"
 	dc "go.temporal.io/server/common/service/dynamicconfig"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -1030,7 +1037,7 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	wh := s.getWorkflowHandler(s.newConfig())
 
 	scope := metrics.NoopScope(metrics.Frontend)
-	history, token, err := wh.getHistory(scope, namespaceID, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken)
+	history, token, err := wh.getHistory(context.Background(), scope, namespaceID, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken)
 	s.NoError(err)
 	s.NotNil(history)
 	s.Equal([]byte{}, token)
@@ -1123,6 +1130,234 @@ func (s *workflowHandlerSuite) TestListArchivedVisibility_Success() {
 	s.NoError(err)
 }
 
+func (s *workflowHandlerSuite) TestDescribeWorkflowExecution_Archived() {
+	s.setupArchivedExecutionTest()
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	resp, err := wh.DescribeWorkflowExecution(context.Background(), &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: s.testNamespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID},
+	})
+	s.NoError(err)
+	s.Equal(testRunID, resp.WorkflowExecutionInfo.GetExecution().GetRunId())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, resp.WorkflowExecutionInfo.GetStatus())
+	s.Equal("some random task queue", resp.ExecutionConfig.GetTaskQueue().GetName())
+	s.Equal(int32(10), resp.ExecutionConfig.GetWorkflowRunTimeoutSeconds())
+}
+
+func (s *workflowHandlerSuite) TestDescribeWorkflowExecution_Archived_WithRunID() {
+	s.setupArchivedExecutionTest()
+	runID := uuid.New()
+	s.mockVisibilityArchiver.On("Query", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.QueryVisibilityRequest) bool {
+		return request.Query == fmt.Sprintf("WorkflowId = '%s' AND RunId = '%s'", testWorkflowID, runID)
+	})).Return(&archiver.QueryVisibilityResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: runID},
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+			},
+		},
+	}, nil).Once()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return request.RunID == runID
+	})).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*historypb.History{
+			{
+				Events: []*historypb.HistoryEvent{
+					{
+						EventId:   1,
+						EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+						Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+							TaskQueue: &taskqueuepb.TaskQueue{Name: "some random task queue"},
+						}},
+					},
+				},
+			},
+		},
+		NextPageToken: []byte("next page"),
+	}, nil).Once()
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	resp, err := wh.DescribeWorkflowExecution(context.Background(), &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: s.testNamespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: runID},
+	})
+	s.NoError(err)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, resp.WorkflowExecutionInfo.GetStatus())
+	s.Equal("some random task queue", resp.ExecutionConfig.GetTaskQueue().GetName())
+	// the record is looked up by run ID and only the first page of the history is read
+	s.mockVisibilityArchiver.AssertNumberOfCalls(s.T(), "Query", 1)
+	s.mockHistoryArchiver.AssertNumberOfCalls(s.T(), "Get", 1)
+}
+
+func (s *workflowHandlerSuite) TestDescribeWorkflowExecution_NotArchived() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewDisabledArchvialConfig())
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewDisabledArchvialConfig())
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	resp, err := wh.DescribeWorkflowExecution(context.Background(), &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: s.testNamespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: uuid.New()},
+	})
+	s.Nil(resp)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *workflowHandlerSuite) TestQueryWorkflow_Archived() {
+	s.setupArchivedExecutionTest()
+	s.mockHistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found"))
+	s.mockResource.MatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.QueryWorkflowRequest, _ ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
+			s.True(request.GetArchived())
+			s.Equal("some random task queue", request.GetTaskQueue().GetName())
+			s.Equal("some random workflow type", request.GetWorkflowType().GetName())
+			s.Equal(testRunID, request.GetQueryRequest().GetExecution().GetRunId())
+			return &matchingservice.QueryWorkflowResponse{QueryResult: payloads.EncodeString("query result")}, nil
+		})
+
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	resp, err := wh.QueryWorkflow(context.Background(), &workflowservice.QueryWorkflowRequest{
+		Namespace: s.testNamespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID},
+		Query:     &querypb.WorkflowQuery{QueryType: "some random query type"},
+	})
+	s.NoError(err)
+	s.Equal(payloads.EncodeString("query result"), resp.GetQueryResult())
+}
+
+func (s *workflowHandlerSuite) TestQueryWorkflow_Archived_Rejected() {
+	s.setupArchivedExecutionTest()
+	s.mockHistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	resp, err := wh.QueryWorkflow(context.Background(), &workflowservice.QueryWorkflowRequest{
+		Namespace:            s.testNamespace,
+		Execution:            &commonpb.WorkflowExecution{WorkflowId: testWorkflowID},
+		Query:                &querypb.WorkflowQuery{QueryType: "some random query type"},
+		QueryRejectCondition: enumspb.QUERY_REJECT_CONDITION_NOT_OPEN,
+	})
+	s.NoError(err)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, resp.GetQueryRejected().GetStatus())
+}
+
+func (s *workflowHandlerSuite) TestListWorkflowExecutions_Archived() {
+	s.setupArchivedExecutionTest()
+	s.mockVisibilityMgr.On("ListWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+
+	config := s.newConfig()
+	config.EnableArchivalListFallback = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	wh := s.getWorkflowHandler(config)
+
+	resp, err := wh.ListWorkflowExecutions(context.Background(), &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: s.testNamespace,
+		Query:     fmt.Sprintf("WorkflowId = '%s'", testWorkflowID),
+	})
+	s.NoError(err)
+	s.Len(resp.GetExecutions(), 1)
+	s.Equal(testRunID, resp.GetExecutions()[0].GetExecution().GetRunId())
+	s.Equal(resp.GetExecutions()[0].GetStartTime().GetValue(), resp.GetExecutions()[0].GetExecutionTime())
+}
+
+func (s *workflowHandlerSuite) TestListWorkflowExecutions_ArchivalFallbackDisabled() {
+	s.setupArchivedExecutionTest()
+	s.mockVisibilityMgr.On("ListWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	resp, err := wh.ListWorkflowExecutions(context.Background(), &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: s.testNamespace,
+		Query:     fmt.Sprintf("WorkflowId = '%s'", testWorkflowID),
+	})
+	s.NoError(err)
+	s.Empty(resp.GetExecutions())
+	s.mockVisibilityArchiver.AssertNotCalled(s.T(), "Query", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *workflowHandlerSuite) TestGetWorkflowExecutionHistory_ArchivedWithoutRunID() {
+	s.setupArchivedExecutionTest()
+	s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	resp, err := wh.GetWorkflowExecutionHistory(context.Background(), &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: s.testNamespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID},
+	})
+	s.NoError(err)
+	s.True(resp.GetArchived())
+	s.Len(resp.GetHistory().GetEvents(), 2)
+}
+
+// setupArchivedExecutionTest archives the visibility record and history of a completed execution
+func (s *workflowHandlerSuite) setupArchivedExecutionTest() {
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: s.testNamespaceID, Name: s.testNamespace},
+		&persistenceblobs.NamespaceConfig{
+			HistoryArchivalStatus:    enumspb.ARCHIVAL_STATUS_ENABLED,
+			HistoryArchivalUri:       testHistoryArchivalURI,
+			VisibilityArchivalStatus: enumspb.ARCHIVAL_STATUS_ENABLED,
+			VisibilityArchivalUri:    testVisibilityArchivalURI,
+		},
+		"",
+		nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(namespaceEntry, nil).AnyTimes()
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI")).Maybe()
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI")).Maybe()
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil).Maybe()
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil).Maybe()
+
+	startTime := time.Now().Add(-time.Hour).UnixNano()
+	closeTime := time.Now().Add(-time.Minute).UnixNano()
+	s.mockVisibilityArchiver.On("Query", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.QueryVisibilityRequest) bool {
+		return request.Query == fmt.Sprintf("WorkflowId = '%s'", testWorkflowID)
+	})).Return(&archiver.QueryVisibilityResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: testRunID},
+				Type:      &commonpb.WorkflowType{Name: "some random workflow type"},
+				StartTime: &types.Int64Value{Value: startTime},
+				CloseTime: &types.Int64Value{Value: closeTime},
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			},
+		},
+	}, nil).Maybe()
+	s.mockHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return request.WorkflowID == testWorkflowID && request.RunID == testRunID
+	})).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*historypb.History{
+			{
+				Events: []*historypb.HistoryEvent{
+					{
+						EventId:   1,
+						Timestamp: startTime,
+						EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+						Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+							WorkflowType:              &commonpb.WorkflowType{Name: "some random workflow type"},
+							TaskQueue:                 &taskqueuepb.TaskQueue{Name: "some random task queue"},
+							WorkflowRunTimeoutSeconds: 10,
+						}},
+					},
+					{
+						EventId:   2,
+						Timestamp: closeTime,
+						EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+					},
+				},
+			},
+		},
+	}, nil).Maybe()
+}
+
 func (s *workflowHandlerSuite) TestGetSearchAttributes() {
 	wh := s.getWorkflowHandler(s.newConfig())
 
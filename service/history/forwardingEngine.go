@@ -0,0 +1,277 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/client/history"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// forwardingEngine serves the requests held while a shard is handed off, it forwards them to the
+	// new owner of the shard through the history client once the handoff is over.
+	forwardingEngine struct {
+		shardID       int32
+		historyClient history.Client
+		serializer    persistence.PayloadSerializer
+	}
+)
+
+var _ Engine = (*forwardingEngine)(nil)
+
+func newForwardingEngine(
+	shardID int,
+	historyClient history.Client,
+	serializer persistence.PayloadSerializer,
+) *forwardingEngine {
+	return &forwardingEngine{
+		shardID:       int32(shardID),
+		historyClient: historyClient,
+		serializer:    serializer,
+	}
+}
+
+func (e *forwardingEngine) Start() {}
+
+func (e *forwardingEngine) Stop() {}
+
+func (e *forwardingEngine) StartWorkflowExecution(ctx context.Context, request *historyservice.StartWorkflowExecutionRequest) (*historyservice.StartWorkflowExecutionResponse, error) {
+	return e.historyClient.StartWorkflowExecution(ctx, request)
+}
+
+func (e *forwardingEngine) GetMutableState(ctx context.Context, request *historyservice.GetMutableStateRequest) (*historyservice.GetMutableStateResponse, error) {
+	return e.historyClient.GetMutableState(ctx, request)
+}
+
+func (e *forwardingEngine) PollMutableState(ctx context.Context, request *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
+	return e.historyClient.PollMutableState(ctx, request)
+}
+
+func (e *forwardingEngine) DescribeMutableState(ctx context.Context, request *historyservice.DescribeMutableStateRequest) (*historyservice.DescribeMutableStateResponse, error) {
+	return e.historyClient.DescribeMutableState(ctx, request)
+}
+
+func (e *forwardingEngine) ResetStickyTaskQueue(ctx context.Context, request *historyservice.ResetStickyTaskQueueRequest) (*historyservice.ResetStickyTaskQueueResponse, error) {
+	return e.historyClient.ResetStickyTaskQueue(ctx, request)
+}
+
+func (e *forwardingEngine) DescribeWorkflowExecution(ctx context.Context, request *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
+	return e.historyClient.DescribeWorkflowExecution(ctx, request)
+}
+
+func (e *forwardingEngine) RecordDecisionTaskStarted(ctx context.Context, request *historyservice.RecordDecisionTaskStartedRequest) (*historyservice.RecordDecisionTaskStartedResponse, error) {
+	return e.historyClient.RecordDecisionTaskStarted(ctx, request)
+}
+
+func (e *forwardingEngine) RecordActivityTaskStarted(ctx context.Context, request *historyservice.RecordActivityTaskStartedRequest) (*historyservice.RecordActivityTaskStartedResponse, error) {
+	return e.historyClient.RecordActivityTaskStarted(ctx, request)
+}
+
+func (e *forwardingEngine) RespondDecisionTaskCompleted(ctx context.Context, request *historyservice.RespondDecisionTaskCompletedRequest) (*historyservice.RespondDecisionTaskCompletedResponse, error) {
+	return e.historyClient.RespondDecisionTaskCompleted(ctx, request)
+}
+
+func (e *forwardingEngine) RespondDecisionTaskFailed(ctx context.Context, request *historyservice.RespondDecisionTaskFailedRequest) error {
+	_, err := e.historyClient.RespondDecisionTaskFailed(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) RespondActivityTaskCompleted(ctx context.Context, request *historyservice.RespondActivityTaskCompletedRequest) error {
+	_, err := e.historyClient.RespondActivityTaskCompleted(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) RespondActivityTaskFailed(ctx context.Context, request *historyservice.RespondActivityTaskFailedRequest) error {
+	_, err := e.historyClient.RespondActivityTaskFailed(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) RespondActivityTaskCanceled(ctx context.Context, request *historyservice.RespondActivityTaskCanceledRequest) error {
+	_, err := e.historyClient.RespondActivityTaskCanceled(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) RecordActivityTaskHeartbeat(ctx context.Context, request *historyservice.RecordActivityTaskHeartbeatRequest) (*historyservice.RecordActivityTaskHeartbeatResponse, error) {
+	return e.historyClient.RecordActivityTaskHeartbeat(ctx, request)
+}
+
+func (e *forwardingEngine) RequestCancelWorkflowExecution(ctx context.Context, request *historyservice.RequestCancelWorkflowExecutionRequest) error {
+	_, err := e.historyClient.RequestCancelWorkflowExecution(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) SignalWorkflowExecution(ctx context.Context, request *historyservice.SignalWorkflowExecutionRequest) error {
+	_, err := e.historyClient.SignalWorkflowExecution(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) SignalWithStartWorkflowExecution(ctx context.Context, request *historyservice.SignalWithStartWorkflowExecutionRequest) (*historyservice.SignalWithStartWorkflowExecutionResponse, error) {
+	return e.historyClient.SignalWithStartWorkflowExecution(ctx, request)
+}
+
+func (e *forwardingEngine) RemoveSignalMutableState(ctx context.Context, request *historyservice.RemoveSignalMutableStateRequest) error {
+	_, err := e.historyClient.RemoveSignalMutableState(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) TerminateWorkflowExecution(ctx context.Context, request *historyservice.TerminateWorkflowExecutionRequest) error {
+	_, err := e.historyClient.TerminateWorkflowExecution(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) ResetWorkflowExecution(ctx context.Context, request *historyservice.ResetWorkflowExecutionRequest) (*historyservice.ResetWorkflowExecutionResponse, error) {
+	return e.historyClient.ResetWorkflowExecution(ctx, request)
+}
+
+func (e *forwardingEngine) ScheduleDecisionTask(ctx context.Context, request *historyservice.ScheduleDecisionTaskRequest) error {
+	_, err := e.historyClient.ScheduleDecisionTask(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) RecordChildExecutionCompleted(ctx context.Context, request *historyservice.RecordChildExecutionCompletedRequest) error {
+	_, err := e.historyClient.RecordChildExecutionCompleted(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) ReplicateEvents(ctx context.Context, request *historyservice.ReplicateEventsRequest) error {
+	_, err := e.historyClient.ReplicateEvents(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) ReplicateRawEvents(ctx context.Context, request *historyservice.ReplicateRawEventsRequest) error {
+	_, err := e.historyClient.ReplicateRawEvents(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) ReplicateEventsV2(ctx context.Context, request *historyservice.ReplicateEventsV2Request) error {
+	_, err := e.historyClient.ReplicateEventsV2(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) SyncShardStatus(ctx context.Context, request *historyservice.SyncShardStatusRequest) error {
+	_, err := e.historyClient.SyncShardStatus(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) SyncActivity(ctx context.Context, request *historyservice.SyncActivityRequest) error {
+	_, err := e.historyClient.SyncActivity(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID int64) (*replicationspb.ReplicationMessages, error) {
+	response, err := e.historyClient.GetReplicationMessages(ctx, &historyservice.GetReplicationMessagesRequest{
+		Tokens: []*replicationspb.ReplicationToken{{
+			ShardId:                e.shardID,
+			LastRetrievedMessageId: lastReadMessageID,
+		}},
+		ClusterName: pollingCluster,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.GetMessagesByShard()[e.shardID], nil
+}
+
+func (e *forwardingEngine) GetDLQReplicationMessages(ctx context.Context, taskInfos []*replicationspb.ReplicationTaskInfo) ([]*replicationspb.ReplicationTask, error) {
+	response, err := e.historyClient.GetDLQReplicationMessages(ctx, &historyservice.GetDLQReplicationMessagesRequest{
+		TaskInfos: taskInfos,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.GetReplicationTasks(), nil
+}
+
+func (e *forwardingEngine) QueryWorkflow(ctx context.Context, request *historyservice.QueryWorkflowRequest) (*historyservice.QueryWorkflowResponse, error) {
+	return e.historyClient.QueryWorkflow(ctx, request)
+}
+
+func (e *forwardingEngine) ReapplyEvents(ctx context.Context, namespaceUUID string, workflowID string, runID string, events []*historypb.HistoryEvent) error {
+	blob, err := e.serializer.SerializeBatchEvents(events, common.EncodingTypeProto3)
+	if err != nil {
+		return err
+	}
+	_, err = e.historyClient.ReapplyEvents(ctx, &historyservice.ReapplyEventsRequest{
+		NamespaceId: namespaceUUID,
+		Request: &adminservice.ReapplyEventsRequest{
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			Events: blob.ToProto(),
+		},
+	})
+	return err
+}
+
+func (e *forwardingEngine) ReadDLQMessages(ctx context.Context, request *historyservice.ReadDLQMessagesRequest) (*historyservice.ReadDLQMessagesResponse, error) {
+	return e.historyClient.ReadDLQMessages(ctx, request)
+}
+
+func (e *forwardingEngine) PurgeDLQMessages(ctx context.Context, request *historyservice.PurgeDLQMessagesRequest) error {
+	_, err := e.historyClient.PurgeDLQMessages(ctx, request)
+	return err
+}
+
+func (e *forwardingEngine) MergeDLQMessages(ctx context.Context, request *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error) {
+	return e.historyClient.MergeDLQMessages(ctx, request)
+}
+
+func (e *forwardingEngine) RefreshWorkflowTasks(ctx context.Context, namespaceUUID string, execution commonpb.WorkflowExecution) error {
+	_, err := e.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: namespaceUUID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			Execution: &execution,
+		},
+	})
+	return err
+}
+
+func (e *forwardingEngine) DeleteWorkflowExecution(ctx context.Context, namespaceUUID string, execution commonpb.WorkflowExecution) error {
+	_, err := e.historyClient.DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       namespaceUUID,
+		WorkflowExecution: &execution,
+	})
+	return err
+}
+
+// the new owner notifies itself about the changes of its shard
+
+func (e *forwardingEngine) NotifyNewHistoryEvent(_ *historyEventNotification) {}
+
+func (e *forwardingEngine) NotifyNewTransferTasks(_ []persistence.Task) {}
+
+func (e *forwardingEngine) NotifyNewReplicationTasks(_ []persistence.Task) {}
+
+func (e *forwardingEngine) NotifyNewTimerTasks(_ []persistence.Task) {}
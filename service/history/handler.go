@@ -26,6 +26,7 @@ package history
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -44,6 +45,7 @@ import (
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/health"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/messaging"
@@ -62,6 +64,7 @@ type (
 		resource.Resource
 
 		shuttingDown            int32
+		healthDraining          int32
 		controller              *shardController
 		tokenSerializer         common.TaskTokenSerializer
 		startWG                 sync.WaitGroup
@@ -71,9 +74,15 @@ type (
 		rateLimiter             quotas.Limiter
 		replicationTaskFetchers ReplicationTaskFetchers
 		queueTaskProcessor      queueTaskProcessor
+		healthChecker           *health.Checker
 	}
 )
 
+const (
+	// healthCheckShards is the name of the health check for the acquisition of the shards owned by the host
+	healthCheckShards = "shards"
+)
+
 var (
 	_ EngineFactory                       = (*Handler)(nil)
 	_ historyservice.HistoryServiceServer = (*Handler)(nil)
@@ -92,6 +101,7 @@ var (
 
 	errHistoryHostThrottle = serviceerror.NewResourceExhausted("History host RPS exceeded.")
 	errShuttingDown        = serviceerror.NewInternal("Shutting down")
+	errShardsNotAcquired   = errors.New("shards are not acquired yet")
 )
 
 // NewHandler creates a thrift handler for the history service
@@ -110,6 +120,17 @@ func NewHandler(
 		),
 	}
 
+	handler.healthChecker = health.NewChecker(common.HistoryServiceName, config.HealthCheckInterval, resource.GetLogger())
+	health.AddServiceChecks(
+		handler.healthChecker,
+		common.HistoryServiceName,
+		handler.isHealthDraining,
+		resource.GetMembershipMonitor(),
+		resource.GetNamespaceCache(),
+		resource.GetMetadataManager(),
+	)
+	handler.healthChecker.AddCheck(healthCheckShards, handler.checkShardsAcquired)
+
 	// prevent us from trying to serve requests before shard controller is started and ready
 	handler.startWG.Add(1)
 	return handler
@@ -189,11 +210,13 @@ func (h *Handler) Start() {
 	h.controller.Start()
 
 	h.startWG.Done()
+	h.healthChecker.Start()
 }
 
 // Stop stops the handler
 func (h *Handler) Stop() {
 	h.PrepareToStop()
+	h.healthChecker.Stop()
 	h.replicationTaskFetchers.Stop()
 	if h.queueTaskProcessor != nil {
 		h.queueTaskProcessor.Stop()
@@ -205,12 +228,24 @@ func (h *Handler) Stop() {
 // PrepareToStop starts graceful traffic drain in preparation for shutdown
 func (h *Handler) PrepareToStop() {
 	atomic.StoreInt32(&h.shuttingDown, 1)
+	h.healthChecker.Refresh()
 }
 
 func (h *Handler) isShuttingDown() bool {
 	return atomic.LoadInt32(&h.shuttingDown) != 0
 }
 
+// DrainHealthStatus reports the host as NOT_SERVING so that clients stop sending requests to it,
+// requests keep being served until PrepareToStop is called
+func (h *Handler) DrainHealthStatus() {
+	atomic.StoreInt32(&h.healthDraining, 1)
+	h.healthChecker.Refresh()
+}
+
+func (h *Handler) isHealthDraining() bool {
+	return atomic.LoadInt32(&h.healthDraining) != 0 || h.isShuttingDown()
+}
+
 // CreateEngine is implementation for HistoryEngineFactory used for creating the engine instance for shard
 func (h *Handler) CreateEngine(
 	shardContext ShardContext,
@@ -231,16 +266,26 @@ func (h *Handler) CreateEngine(
 }
 
 // https://github.com/grpc/grpc/blob/master/doc/health-checking.md
-func (h *Handler) Check(context.Context, *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
-	h.startWG.Wait()
+func (h *Handler) Check(ctx context.Context, request *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
 	h.GetLogger().Debug("History service health check endpoint (gRPC) reached.")
-	hs := &healthpb.HealthCheckResponse{
-		Status: healthpb.HealthCheckResponse_SERVING,
-	}
-	return hs, nil
+	return h.healthChecker.Check(ctx, request)
 }
-func (h *Handler) Watch(*healthpb.HealthCheckRequest, healthpb.Health_WatchServer) error {
-	return serviceerror.NewUnimplemented("Watch is not implemented.")
+
+// Watch streams the health status of the service whenever it changes
+func (h *Handler) Watch(request *healthpb.HealthCheckRequest, server healthpb.Health_WatchServer) error {
+	return h.healthChecker.Watch(request, server)
+}
+
+// checkShardsAcquired fails until the shard controller has gone through its initial shard acquisition,
+// and once it stops acquiring shards
+func (h *Handler) checkShardsAcquired() error {
+	if h.controller == nil || !h.controller.isInitialAcquisitionDone() {
+		return errShardsNotAcquired
+	}
+	if h.controller.isShuttingDown() {
+		return health.ErrShuttingDown
+	}
+	return nil
 }
 
 // RecordActivityTaskHeartbeat - Record Activity Task Heart beat.
@@ -1769,6 +1814,73 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// DeleteWorkflowExecution deletes a closed workflow execution on the shard that owns it
+func (h *Handler) DeleteWorkflowExecution(ctx context.Context, request *historyservice.DeleteWorkflowExecutionRequest) (_ *historyservice.DeleteWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDeleteWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+	execution := request.GetWorkflowExecution()
+	workflowID := execution.GetWorkflowId()
+	if workflowID == "" {
+		return nil, h.error(errWorkflowIDNotSet, scope, namespaceID, workflowID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, namespaceID, workflowID)
+	}
+
+	err = engine.DeleteWorkflowExecution(
+		ctx,
+		namespaceID,
+		commonpb.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      execution.RunId,
+		},
+	)
+	if err != nil {
+		return nil, h.error(err, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.DeleteWorkflowExecutionResponse{}, nil
+}
+
+// HandoffShard acquires a shard that was just handed over by its previous owner
+func (h *Handler) HandoffShard(_ context.Context, request *historyservice.HandoffShardRequest) (_ *historyservice.HandoffShardResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryHandoffShardScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	h.GetLogger().Info("Accepting shard handoff", tag.ShardID(int(request.GetShardId())), tag.Address(request.GetPreviousOwner()))
+	if err := h.controller.acceptShardHandoff(int(request.GetShardId())); err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return &historyservice.HandoffShardResponse{}, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
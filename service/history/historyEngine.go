@@ -67,6 +67,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/versioning"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/worker/archiver"
 )
@@ -120,6 +121,7 @@ type (
 		PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) error
 		MergeDLQMessages(ctx context.Context, messagesRequest *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error)
 		RefreshWorkflowTasks(ctx context.Context, namespaceUUID string, execution commonpb.WorkflowExecution) error
+		DeleteWorkflowExecution(ctx context.Context, namespaceUUID string, execution commonpb.WorkflowExecution) error
 
 		NotifyNewHistoryEvent(event *historyEventNotification)
 		NotifyNewTransferTasks(tasks []persistence.Task)
@@ -200,6 +202,10 @@ var (
 	ErrQueryWorkflowBeforeFirstDecision = serviceerror.NewQueryFailed("workflow must handle at least one decision task before it can be queried")
 	// ErrConsistentQueryBufferExceeded is error indicating that too many consistent queries have been buffered and until buffered queries are finished new consistent queries cannot be buffered
 	ErrConsistentQueryBufferExceeded = serviceerror.NewInternal("consistent query buffer is full, cannot accept new consistent queries")
+	// ErrNamespaceDeleted is error indicating that the namespace is being deleted and no new workflow execution can be started
+	ErrNamespaceDeleted = serviceerror.NewInvalidArgument("namespace is deleted, no new workflow execution can be started")
+	// ErrWorkflowRunning is error indicating that a workflow execution must be closed before it can be deleted
+	ErrWorkflowRunning = serviceerror.NewInvalidArgument("workflow execution is still running, it must be closed before it can be deleted")
 
 	// FailedWorkflowStatuses is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
@@ -555,6 +561,9 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 		return nil, err
 	}
 	namespaceID := namespaceEntry.GetInfo().Id
+	if namespaceEntry.GetInfo().Status == enumspb.NAMESPACE_STATUS_DELETED {
+		return nil, ErrNamespaceDeleted
+	}
 
 	request := startRequest.StartRequest
 	err = validateStartWorkflowExecutionRequest(request, e.config.MaxIDLengthLimit())
@@ -1027,6 +1036,7 @@ func (e *historyEngineImpl) queryDirectlyThroughMatching(
 		NamespaceId:  namespaceID,
 		QueryRequest: queryRequest,
 		TaskQueue:    msResp.TaskQueue,
+		BuildId:      msResp.BuildId,
 	}
 
 	nonStickyStopWatch := scope.StartTimer(metrics.DirectQueryDispatchNonStickyLatency)
@@ -1091,6 +1101,7 @@ func (e *historyEngineImpl) getMutableState(
 		WorkflowState:                         workflowState,
 		WorkflowStatus:                        workflowStatus,
 		IsStickyTaskQueueEnabled:              mutableState.IsStickyTaskQueueEnabled(),
+		BuildId:                               versioning.GetWorkflowBuildID(executionInfo.AutoResetPoints),
 	}
 	replicationState := mutableState.GetReplicationState()
 	if replicationState != nil {
@@ -1983,6 +1994,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	}
 
 	// Start workflow and signal
+	if namespaceEntry.GetInfo().Status == enumspb.NAMESPACE_STATUS_DELETED {
+		return nil, ErrNamespaceDeleted
+	}
 	startRequest := getStartRequest(namespaceID, sRequest)
 	request := startRequest.StartRequest
 	err = validateStartWorkflowExecutionRequest(request, e.config.MaxIDLengthLimit())
@@ -2311,7 +2325,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	}
 
 	// also load the current run of the workflow, it can be different from the base runID
-	resp, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+	resp, err := persistence.ExecutionManagerWithContext(ctx, e.executionManager).GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
 		NamespaceID: namespaceID,
 		WorkflowID:  request.WorkflowExecution.GetWorkflowId(),
 	})
@@ -3153,6 +3167,45 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// DeleteWorkflowExecution deletes a closed workflow execution by scheduling a delete history event timer task
+// which fires immediately, so the execution is deleted by the timer queue of the shard like when its retention
+// period expires, including the archival of its history when the namespace is configured for it
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	namespaceUUID string,
+	execution commonpb.WorkflowExecution,
+) (retError error) {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(namespaceUUID)
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, namespaceID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := context.loadWorkflowExecution()
+	if err != nil {
+		return err
+	}
+
+	if mutableState.IsWorkflowExecutionRunning() {
+		return ErrWorkflowRunning
+	}
+
+	now := e.shard.GetTimeSource().Now()
+	mutableState.AddTimerTasks(&persistence.DeleteHistoryEventTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: now,
+		Version:             mutableState.GetCurrentVersion(),
+	})
+	return context.updateWorkflowExecutionAsActive(now)
+}
+
 func (e *historyEngineImpl) loadWorkflowOnce(
 	ctx context.Context,
 	namespaceID string,
@@ -3204,7 +3257,7 @@ func (e *historyEngineImpl) loadWorkflow(
 		}
 
 		// workflow not running, need to check current record
-		resp, err := e.shard.GetExecutionManager().GetCurrentExecution(
+		resp, err := persistence.ExecutionManagerWithContext(ctx, e.shard.GetExecutionManager()).GetCurrentExecution(
 			&persistence.GetCurrentExecutionRequest{
 				NamespaceID: namespaceID,
 				WorkflowID:  workflowID,
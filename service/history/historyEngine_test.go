@@ -4723,6 +4723,56 @@ func (s *engineSuite) TestRemoveSignalMutableState() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestDeleteWorkflowExecution_Running() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), testRunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", "testTaskQueue", payloads.EncodeString("input"), 100, 50, 200, "testIdentity")
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), testNamespaceID, execution)
+	s.Equal(ErrWorkflowRunning, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Closed() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), testRunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", "testTaskQueue", payloads.EncodeString("input"), 100, 50, 200, "testIdentity")
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, "testTaskQueue", "testIdentity")
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.GetEventId(), "testIdentity")
+	completionEvent := addCompleteWorkflowEvent(msBuilder, decisionCompletedEvent.GetEventId(), nil)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{completionEvent},
+	}, nil).Maybe()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		timerTasks := request.UpdateWorkflowMutation.TimerTasks
+		if len(timerTasks) != 1 {
+			return false
+		}
+		_, ok := timerTasks[0].(*persistence.DeleteHistoryEventTask)
+		return ok
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), testNamespaceID, execution)
+	s.NoError(err)
+}
+
 func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
 	workflowExecution := commonpb.WorkflowExecution{
 		WorkflowId: "test-reapply",
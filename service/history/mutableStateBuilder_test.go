@@ -419,7 +419,7 @@ func (s *mutableStateSuite) TestChecksum() {
 			s.Nil(err)
 			s.NotNil(csum.Value)
 			s.Equal(checksum.FlavorIEEECRC32OverProto3Binary, csum.Flavor)
-			s.Equal(mutableStateChecksumPayloadV1, csum.Version)
+			s.Equal(persistence.MutableStateChecksumPayloadV1, csum.Version)
 			s.EqualValues(csum, s.msBuilder.checksum)
 
 			// verify checksum is verified on Load
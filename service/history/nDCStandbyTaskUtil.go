@@ -118,11 +118,14 @@ type (
 
 	pushActivityToMatchingInfo struct {
 		activityScheduleToStartTimeout int32
+		taskPriority                   matchingTaskPriority
 	}
 
 	pushDecisionToMatchingInfo struct {
 		decisionScheduleToStartTimeout int32
 		taskqueue                      taskqueuepb.TaskQueue
+		taskPriority                   matchingTaskPriority
+		buildID                        string
 	}
 )
 
@@ -149,21 +152,27 @@ func newHistoryResendInfoFor2DC(
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
+	taskPriority matchingTaskPriority,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
+		taskPriority:                   taskPriority,
 	}
 }
 
 func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	taskqueue taskqueuepb.TaskQueue,
+	taskPriority matchingTaskPriority,
+	buildID string,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		taskqueue:                      taskqueue,
+		taskPriority:                   taskPriority,
+		buildID:                        buildID,
 	}
 }
 
@@ -356,3 +356,19 @@ func (h *NilCheckHandler) RefreshWorkflowTasks(ctx context.Context, request *his
 	}
 	return resp, err
 }
+
+func (h *NilCheckHandler) DeleteWorkflowExecution(ctx context.Context, request *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.DeleteWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.DeleteWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) HandoffShard(ctx context.Context, request *historyservice.HandoffShardRequest) (*historyservice.HandoffShardResponse, error) {
+	resp, err := h.parentHandler.HandoffShard(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.HandoffShardResponse{}
+	}
+	return resp, err
+}
@@ -38,10 +38,12 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/messaging"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	espersistence "go.temporal.io/server/common/persistence/elasticsearch"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/common/task"
@@ -66,6 +68,7 @@ type Config struct {
 	ThrottledLogRPS                 dynamicconfig.IntPropertyFn
 	EnableStickyQuery               dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ShutdownDrainDuration           dynamicconfig.DurationPropertyFn
+	HealthCheckInterval             dynamicconfig.DurationPropertyFn
 
 	// HistoryCache settings
 	// Change of these configs require shard restart
@@ -80,9 +83,11 @@ type Config struct {
 	EventsCacheTTL         dynamicconfig.DurationPropertyFn
 
 	// ShardController settings
-	RangeSizeBits           uint
-	AcquireShardInterval    dynamicconfig.DurationPropertyFn
-	AcquireShardConcurrency dynamicconfig.IntPropertyFn
+	RangeSizeBits              uint
+	AcquireShardInterval       dynamicconfig.DurationPropertyFn
+	AcquireShardConcurrency    dynamicconfig.IntPropertyFn
+	EnableGracefulShardHandoff dynamicconfig.BoolPropertyFn
+	ShardHandoffTimeout        dynamicconfig.DurationPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay                  dynamicconfig.DurationPropertyFn
@@ -263,6 +268,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		PersistenceMaxQPS:                    dc.GetIntProperty(dynamicconfig.HistoryPersistenceMaxQPS, 9000),
 		PersistenceGlobalMaxQPS:              dc.GetIntProperty(dynamicconfig.HistoryPersistenceGlobalMaxQPS, 0),
 		ShutdownDrainDuration:                dc.GetDurationProperty(dynamicconfig.HistoryShutdownDrainDuration, 0),
+		HealthCheckInterval:                  dc.GetDurationProperty(dynamicconfig.HistoryHealthCheckInterval, 10*time.Second),
 		EnableVisibilitySampling:             dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		EnableReadFromClosedExecutionV2:      dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
 		VisibilityOpenMaxQPS:                 dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryVisibilityOpenMaxQPS, 300),
@@ -281,6 +287,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		RangeSizeBits:                        20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                 dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
 		AcquireShardConcurrency:              dc.GetIntProperty(dynamicconfig.AcquireShardConcurrency, 1),
+		EnableGracefulShardHandoff:           dc.GetBoolProperty(dynamicconfig.EnableGracefulShardHandoff, true),
+		ShardHandoffTimeout:                  dc.GetDurationProperty(dynamicconfig.ShardHandoffTimeout, 5*time.Second),
 		StandbyClusterDelay:                  dc.GetDurationProperty(dynamicconfig.StandbyClusterDelay, 5*time.Minute),
 		StandbyTaskMissingEventsResendDelay:  dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsResendDelay, 15*time.Minute),
 		StandbyTaskMissingEventsDiscardDelay: dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsDiscardDelay, 25*time.Minute),
@@ -453,9 +461,15 @@ func NewService(
 
 		var visibilityFromES persistence.VisibilityManager
 		if params.ESConfig != nil {
-			visibilityProducer, err := params.MessagingClient.NewProducer(common.VisibilityAppName)
-			if err != nil {
-				logger.Fatal("Creating visibility producer failed", tag.Error(err))
+			var visibilityProducer messaging.Producer
+			if params.ESConfig.UsePersistenceVisibilityQueue() {
+				visibilityProducer = persistenceBean.GetVisibilityQueue()
+			} else {
+				producer, err := params.MessagingClient.NewProducer(common.VisibilityAppName)
+				if err != nil {
+					logger.Fatal("Creating visibility producer failed", tag.Error(err))
+				}
+				visibilityProducer = producer
 			}
 			visibilityFromES = espersistence.NewESVisibilityManager("", nil, nil, visibilityProducer,
 				params.MetricsClient, logger)
@@ -508,7 +522,7 @@ func (s *Service) Start() {
 	if err != nil {
 		logger.Fatal("creating grpc server options failed", tag.Error(err))
 	}
-	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	opts = append(opts, grpc.ChainUnaryInterceptor(rpc.TracingServerInterceptor, interceptor))
 	s.server = grpc.NewServer(opts...)
 	nilCheckHandler := NewNilCheckHandler(s.handler)
 	historyservice.RegisterHistoryServiceServer(s.server, nilCheckHandler)
@@ -528,6 +542,7 @@ func (s *Service) Stop() {
 	}
 
 	// initiate graceful shutdown :
+	// 0. fail rpc health check, this will cause load balancers to stop forwarding requests to this node
 	// 1. remove self from the membership ring
 	// 2. wait for other members to discover we are going down
 	// 3. stop acquiring new shards (periodically or based on other membership changes)
@@ -544,6 +559,12 @@ func (s *Service) Stop() {
 
 	remainingTime := s.config.ShutdownDrainDuration()
 
+	s.GetLogger().Info("ShutdownHandler: Updating rpc health status to NOT_SERVING")
+	s.handler.DrainHealthStatus()
+
+	s.GetLogger().Info("ShutdownHandler: Recording the handoff of the shards")
+	s.handler.controller.PrepareToHandoff()
+
 	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
 	s.GetMembershipMonitor().EvictSelf()
 
@@ -907,6 +907,73 @@ func (s *shardContextImpl) closeShard() {
 	atomic.StoreInt64(&s.rangeID, s.shardInfo.RangeId)
 }
 
+// startHandoff records in the shard info that the shard is about to be handed off, the new owner waits
+// for the handoff instead of stealing the shard then.
+func (s *shardContextImpl) startHandoff() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.isClosed() {
+		return ErrShardClosed
+	}
+	if s.shardInfo.HandingOff {
+		return nil
+	}
+
+	updatedShardInfo := copyShardInfo(s.shardInfo)
+	updatedShardInfo.HandingOff = true
+	err := s.GetShardManager().UpdateShard(&persistence.UpdateShardRequest{
+		ShardInfo:       updatedShardInfo.ShardInfo,
+		PreviousRangeID: s.shardInfo.GetRangeId(),
+	})
+	if err != nil {
+		// Shard is stolen, trigger history engine shutdown
+		if _, ok := err.(*persistence.ShardOwnershipLostError); ok {
+			s.closeShard()
+		}
+		return err
+	}
+
+	s.shardInfo.HandingOff = true
+	s.lastUpdated = clock.NewRealTimeSource().Now()
+	return nil
+}
+
+// handoff flushes the shard info with newOwner recorded as the owner and fences the shard,
+// the shard lock makes sure in-flight transactions are drained before the flush.
+// Unlike closeShard, it does not invoke the close callback.
+func (s *shardContextImpl) handoff(newOwner string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.isClosed() {
+		return ErrShardClosed
+	}
+
+	updatedShardInfo := copyShardInfo(s.shardInfo)
+	updatedShardInfo.Owner = newOwner
+	updatedShardInfo.HandingOff = false
+	err := s.GetShardManager().UpdateShard(&persistence.UpdateShardRequest{
+		ShardInfo:       updatedShardInfo.ShardInfo,
+		PreviousRangeID: s.shardInfo.GetRangeId(),
+	})
+	if err != nil {
+		// Shard is stolen, trigger history engine shutdown
+		if _, ok := err.(*persistence.ShardOwnershipLostError); ok {
+			s.closeShard()
+		}
+		return err
+	}
+
+	s.logger.Info("Handed off shard", tag.ShardRangeID(updatedShardInfo.GetRangeId()), tag.Address(newOwner))
+	s.lastUpdated = clock.NewRealTimeSource().Now()
+	atomic.StoreInt32(&s.closed, 1)
+	// fails any writes that may start after this point.
+	s.shardInfo.RangeId = -1
+	atomic.StoreInt64(&s.rangeID, s.shardInfo.RangeId)
+	return nil
+}
+
 func (s *shardContextImpl) generateTransferTaskIDLocked() (int64, error) {
 	if err := s.updateRangeIfNeededLocked(); err != nil {
 		return -1, err
@@ -1184,7 +1251,7 @@ func (s *shardContextImpl) GetLastUpdatedTime() time.Time {
 func acquireShard(
 	shardItem *historyShardsItem,
 	closeCallback func(int, *historyShardsItem),
-) (ShardContext, error) {
+) (*shardContextImpl, error) {
 
 	var shardInfo *persistence.ShardInfoWithFailover
 
@@ -1230,9 +1297,18 @@ func acquireShard(
 		return nil, err
 	}
 
+	if shardItem.waitForHandoff(shardInfo.Owner, shardInfo.HandingOff) {
+		// the previous owner flushes the shard info as part of the handoff, reload it
+		if err := backoff.Retry(getShard, retryPolicy, retryPredicate); err != nil {
+			shardItem.logger.Error("Fail to acquire shard.", tag.ShardID(shardItem.shardID), tag.Error(err))
+			return nil, err
+		}
+	}
+
 	updatedShardInfo := copyShardInfo(shardInfo)
 	ownershipChanged := shardInfo.Owner != shardItem.GetHostInfo().Identity()
 	updatedShardInfo.Owner = shardItem.GetHostInfo().Identity()
+	updatedShardInfo.HandingOff = false
 
 	// initialize the cluster current time to be the same as ack level
 	remoteClusterCurrentTime := make(map[string]time.Time)
@@ -1311,6 +1387,7 @@ func copyShardInfo(shardInfo *persistence.ShardInfoWithFailover) *persistence.Sh
 		ShardInfo: &persistenceblobs.ShardInfo{
 			ShardId:                      shardInfo.GetShardId(),
 			Owner:                        shardInfo.Owner,
+			HandingOff:                   shardInfo.HandingOff,
 			RangeId:                      shardInfo.GetRangeId(),
 			StolenSinceRenew:             shardInfo.StolenSinceRenew,
 			ReplicationAckLevel:          shardInfo.ReplicationAckLevel,
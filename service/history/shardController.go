@@ -25,11 +25,13 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -41,6 +43,9 @@ import (
 
 const (
 	shardControllerMembershipUpdateListenerName = "ShardController"
+
+	// shardHandoffPollInterval is how often the new owner checks the shard info while waiting for a handoff
+	shardHandoffPollInterval = 200 * time.Millisecond
 )
 
 type (
@@ -51,6 +56,7 @@ type (
 		engineFactory      EngineFactory
 		status             int32
 		shuttingDown       int32
+		initialAcquisition int32
 		shutdownWG         sync.WaitGroup
 		shutdownCh         chan struct{}
 		logger             log.Logger
@@ -60,6 +66,10 @@ type (
 
 		sync.RWMutex
 		historyShards map[int]*historyShardsItem
+
+		handoffLock sync.Mutex
+		// previous owner -> deadline for handing over its shards, reset on membership changes
+		handoffDeadlines map[string]time.Time
 	}
 
 	historyShardsItemStatus int
@@ -73,15 +83,25 @@ type (
 		throttledLogger log.Logger
 		engineFactory   EngineFactory
 
+		// handoffCh is closed once the previous owner has handed the shard over
+		handoffCh       chan struct{}
+		handoffOnce     sync.Once
+		handoffDeadline func(previousOwner string, handingOff bool) time.Time
+
 		sync.RWMutex
 		status historyShardsItemStatus
 		engine Engine
+		shard  *shardContextImpl
+		// requests are held while the shard is handed off to newOwner, until handoffDoneCh is closed
+		newOwner      *membership.HostInfo
+		handoffDoneCh chan struct{}
 	}
 )
 
 const (
 	historyShardsItemStatusInitialized = iota
 	historyShardsItemStatusStarted
+	historyShardsItemStatusHandingOff
 	historyShardsItemStatusStopped
 )
 
@@ -97,6 +117,7 @@ func newShardController(
 		membershipUpdateCh: make(chan *membership.ChangedEvent, 10),
 		engineFactory:      factory,
 		historyShards:      make(map[int]*historyShardsItem),
+		handoffDeadlines:   make(map[string]time.Time),
 		shutdownCh:         make(chan struct{}),
 		logger:             resource.GetLogger().WithTags(tag.ComponentShardController, tag.Address(hostIdentity)),
 		throttledLogger:    resource.GetThrottledLogger().WithTags(tag.ComponentShardController, tag.Address(hostIdentity)),
@@ -110,6 +131,7 @@ func newHistoryShardsItem(
 	shardID int,
 	factory EngineFactory,
 	config *Config,
+	handoffDeadline func(previousOwner string, handingOff bool) time.Time,
 ) (*historyShardsItem, error) {
 
 	hostIdentity := resource.GetHostInfo().Identity()
@@ -119,6 +141,8 @@ func newHistoryShardsItem(
 		status:          historyShardsItemStatusInitialized,
 		engineFactory:   factory,
 		config:          config,
+		handoffCh:       make(chan struct{}),
+		handoffDeadline: handoffDeadline,
 		logger:          resource.GetLogger().WithTags(tag.ShardID(shardID), tag.Address(hostIdentity)),
 		throttledLogger: resource.GetThrottledLogger().WithTags(tag.ShardID(shardID), tag.Address(hostIdentity)),
 	}
@@ -134,6 +158,7 @@ func (c *shardController) Start() {
 	}
 
 	c.acquireShards()
+	atomic.StoreInt32(&c.initialAcquisition, 1)
 	c.shutdownWG.Add(1)
 	go c.shardManagementPump()
 
@@ -173,6 +198,11 @@ func (c *shardController) isShuttingDown() bool {
 	return atomic.LoadInt32(&c.shuttingDown) != 0
 }
 
+// isInitialAcquisitionDone returns true once the controller has tried to acquire the shards it owns on start
+func (c *shardController) isInitialAcquisitionDone() bool {
+	return atomic.LoadInt32(&c.initialAcquisition) != 0
+}
+
 func (c *shardController) GetEngine(workflowID string) (Engine, error) {
 	shardID := c.config.GetShardID(workflowID)
 	return c.getEngineForShard(shardID)
@@ -246,6 +276,7 @@ func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShar
 			shardID,
 			c.engineFactory,
 			c.config,
+			c.handoffDeadline,
 		)
 		if err != nil {
 			return nil, err
@@ -288,9 +319,10 @@ func (c *shardController) removeHistoryShardItem(shardID int, shardItem *history
 // shardController. It is responsible for acquiring /
 // releasing shards in response to any event that can
 // change the shard ownership. These events are
-//   a. Ring membership change
-//   b. Periodic ticker
-//   c. ShardOwnershipLostError and subsequent ShardClosedEvents from engine
+//
+//	a. Ring membership change
+//	b. Periodic ticker
+//	c. ShardOwnershipLostError and subsequent ShardClosedEvents from engine
 func (c *shardController) shardManagementPump() {
 
 	defer c.shutdownWG.Done()
@@ -313,6 +345,7 @@ func (c *shardController) shardManagementPump() {
 				tag.NumberProcessed(len(changedEvent.HostsAdded)),
 				tag.NumberDeleted(len(changedEvent.HostsRemoved)),
 				tag.Number(int64(len(changedEvent.HostsUpdated))))
+			c.resetHandoffDeadlines()
 			c.acquireShards()
 		}
 	}
@@ -332,19 +365,22 @@ func (c *shardController) acquireShards() {
 		go func() {
 			defer wg.Done()
 			for shardID := range shardActionCh {
-				if c.isShuttingDown() {
-					return
-				}
 				info, err := c.GetHistoryServiceResolver().Lookup(string(shardID))
 				if err != nil {
 					c.logger.Error("Error looking up host for shardID", tag.Error(err), tag.OperationFailed, tag.ShardID(shardID))
 				} else {
 					if info.Identity() == c.GetHostInfo().Identity() {
+						if c.isShuttingDown() {
+							continue
+						}
 						_, err1 := c.getEngineForShard(shardID)
 						if err1 != nil {
 							c.metricsScope.IncCounter(metrics.GetEngineForShardErrorCounter)
 							c.logger.Error("Unable to create history shard engine", tag.Error(err1), tag.OperationFailed, tag.ShardID(shardID))
 						}
+					} else if c.config.EnableGracefulShardHandoff() {
+						// shards are handed off while shutting down as well
+						c.handoffShard(shardID, info)
 					}
 				}
 			}
@@ -353,9 +389,6 @@ func (c *shardController) acquireShards() {
 	// Submit tasks to the channel.
 	for shardID := 0; shardID < c.config.NumberOfShards; shardID++ {
 		shardActionCh <- shardID
-		if c.isShuttingDown() {
-			return
-		}
 	}
 	close(shardActionCh)
 	// Wait until all shards are processed.
@@ -364,6 +397,115 @@ func (c *shardController) acquireShards() {
 	c.metricsScope.UpdateGauge(metrics.NumShardsGauge, float64(c.numShards()))
 }
 
+// handoffShard drains the local shard and hands it over to newOwner, the shard is released even if the
+// handoff fails since newOwner is going to steal it anyway.
+func (c *shardController) handoffShard(shardID int, newOwner *membership.HostInfo) {
+	c.RLock()
+	item, ok := c.historyShards[shardID]
+	c.RUnlock()
+	if !ok {
+		return
+	}
+
+	c.metricsScope.IncCounter(metrics.ShardHandoffCounter)
+	sw := c.metricsScope.StartTimer(metrics.ShardHandoffLatency)
+	if err := item.handoff(newOwner); err != nil {
+		c.metricsScope.IncCounter(metrics.ShardHandoffFailedCounter)
+		item.logger.Warn("Failed to hand off shard", tag.Error(err), tag.Address(newOwner.Identity()))
+	}
+	sw.Stop()
+	c.removeEngineForShard(shardID, item)
+}
+
+// acceptShardHandoff acquires a shard right after its previous owner handed it over.
+func (c *shardController) acceptShardHandoff(shardID int) error {
+	// the shard item may be locked while it waits for the handoff, so do not check its status here
+	c.RLock()
+	item, ok := c.historyShards[shardID]
+	c.RUnlock()
+	if ok {
+		item.notifyHandoff()
+	}
+
+	_, err := c.getEngineForShard(shardID)
+	return err
+}
+
+// handoffDeadline returns until when a shard owned by previousOwner should be waited for instead of stolen.
+// Waiting is only worth it if previousOwner has started to hand the shard off, as recorded in the shard info,
+// or is still alive and about to do so. A previous owner which left the ring without handing the shard off
+// has crashed and is never waited for. All shards of the same previous owner share a deadline so that
+// acquiring them is delayed at most once.
+func (c *shardController) handoffDeadline(previousOwner string, handingOff bool) time.Time {
+	if !c.config.EnableGracefulShardHandoff() || previousOwner == "" || previousOwner == c.GetHostInfo().Identity() {
+		return time.Time{}
+	}
+
+	c.handoffLock.Lock()
+	defer c.handoffLock.Unlock()
+
+	if deadline, ok := c.handoffDeadlines[previousOwner]; ok {
+		return deadline
+	}
+	if !handingOff && !c.isMember(previousOwner) {
+		return time.Time{}
+	}
+	deadline := time.Now().Add(c.config.ShardHandoffTimeout())
+	c.handoffDeadlines[previousOwner] = deadline
+	return deadline
+}
+
+func (c *shardController) isMember(identity string) bool {
+	for _, member := range c.GetHistoryServiceResolver().Members() {
+		if member.Identity() == identity {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *shardController) resetHandoffDeadlines() {
+	c.handoffLock.Lock()
+	defer c.handoffLock.Unlock()
+
+	c.handoffDeadlines = make(map[string]time.Time)
+}
+
+// PrepareToHandoff records in the shard info of the local shards that they are about to be handed off,
+// before the host leaves the ring, so that their new owners wait for the handoff instead of stealing them.
+func (c *shardController) PrepareToHandoff() {
+	if !c.config.EnableGracefulShardHandoff() {
+		return
+	}
+
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	concurrency := common.MaxInt(c.config.AcquireShardConcurrency(), 1)
+	itemCh := make(chan *historyShardsItem, concurrency)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for item := range itemCh {
+				if err := item.startHandoff(); err != nil {
+					item.logger.Warn("Failed to record shard handoff", tag.Error(err))
+				}
+			}
+		}()
+	}
+	for _, item := range items {
+		itemCh <- item
+	}
+	close(itemCh)
+	wg.Wait()
+}
+
 func (c *shardController) doShutdown() {
 	c.logger.Info("", tag.LifeCycleStopping)
 	c.Lock()
@@ -401,9 +543,20 @@ func (i *historyShardsItem) getOrCreateEngine(
 		defer i.RUnlock()
 		return i.engine, nil
 	}
+	if i.status == historyShardsItemStatusHandingOff {
+		newOwner, handoffDoneCh := i.newOwner, i.handoffDoneCh
+		i.RUnlock()
+		return i.holdForHandoff(newOwner, handoffDoneCh), nil
+	}
 	i.RUnlock()
 
 	i.Lock()
+	if i.status == historyShardsItemStatusHandingOff {
+		// the handoff started after the read lock was released
+		newOwner, handoffDoneCh := i.newOwner, i.handoffDoneCh
+		i.Unlock()
+		return i.holdForHandoff(newOwner, handoffDoneCh), nil
+	}
 	defer i.Unlock()
 	switch i.status {
 	case historyShardsItemStatusInitialized:
@@ -420,6 +573,7 @@ func (i *historyShardsItem) getOrCreateEngine(
 			i.GetMetricsClient().RecordTimer(metrics.ShardInfoScope, metrics.ShardItemAcquisitionLatency,
 				context.GetCurrentTime(i.GetClusterMetadata().GetCurrentClusterName()).Sub(context.GetLastUpdatedTime()))
 		}
+		i.shard = context
 		i.engine = i.engineFactory.CreateEngine(context)
 		i.engine.Start()
 		i.logger.Info("", tag.LifeCycleStarted, tag.ComponentShardEngine)
@@ -447,6 +601,9 @@ func (i *historyShardsItem) stopEngine() {
 		i.engine = nil
 		i.logger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine)
 		i.status = historyShardsItemStatusStopped
+	case historyShardsItemStatusHandingOff:
+		// the engine is stopped by the handoff
+		i.status = historyShardsItemStatusStopped
 	case historyShardsItemStatusStopped:
 		// no op
 	default:
@@ -454,12 +611,115 @@ func (i *historyShardsItem) stopEngine() {
 	}
 }
 
+// startHandoff records in the shard info that the shard is about to be handed off
+func (i *historyShardsItem) startHandoff() error {
+	i.RLock()
+	status, shard := i.status, i.shard
+	i.RUnlock()
+	if status != historyShardsItemStatusStarted {
+		return nil
+	}
+	return shard.startHandoff()
+}
+
+// handoff stops the engine, drains and flushes the shard and notifies newOwner that it can acquire
+// the shard. Requests for the shard are held meanwhile and forwarded to newOwner afterwards.
+func (i *historyShardsItem) handoff(newOwner *membership.HostInfo) error {
+	i.Lock()
+	if i.status != historyShardsItemStatusStarted {
+		i.Unlock()
+		return nil
+	}
+	i.logger.Info("", tag.LifeCycleStopping, tag.ComponentShardEngine, tag.Address(newOwner.Identity()))
+	engine, shard := i.engine, i.shard
+	handoffDoneCh := make(chan struct{})
+	i.status = historyShardsItemStatusHandingOff
+	i.newOwner = newOwner
+	i.handoffDoneCh = handoffDoneCh
+	i.engine = nil
+	i.Unlock()
+	defer close(handoffDoneCh)
+
+	// the new owner waits for the shard once the handoff is recorded, even if this host has left the ring
+	if err := shard.startHandoff(); err != nil {
+		return err
+	}
+	engine.Stop()
+	i.logger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine)
+	if err := shard.handoff(newOwner.Identity()); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), i.config.ShardHandoffTimeout())
+	defer cancel()
+	_, err := i.GetHistoryClient().HandoffShard(ctx, &historyservice.HandoffShardRequest{
+		ShardId:       int32(i.shardID),
+		PreviousOwner: i.GetHostInfo().Identity(),
+	})
+	return err
+}
+
+// holdForHandoff holds a request until the shard has been handed off, and then returns an engine which
+// forwards it to the new owner. The new owner holds the forwarded request in turn if the handoff times out.
+func (i *historyShardsItem) holdForHandoff(newOwner *membership.HostInfo, handoffDoneCh <-chan struct{}) Engine {
+	timer := time.NewTimer(i.config.ShardHandoffTimeout())
+	defer timer.Stop()
+
+	select {
+	case <-handoffDoneCh:
+	case <-timer.C:
+		i.logger.Warn("Timed out holding request for shard handoff", tag.Address(newOwner.Identity()))
+	}
+	return newForwardingEngine(i.shardID, i.GetHistoryClient(), i.GetPayloadSerializer())
+}
+
+func (i *historyShardsItem) notifyHandoff() {
+	i.handoffOnce.Do(func() {
+		close(i.handoffCh)
+	})
+}
+
+// waitForHandoff gives previousOwner a chance to hand the shard over before it is stolen,
+// it returns true if the shard info needs to be reloaded.
+func (i *historyShardsItem) waitForHandoff(previousOwner string, handingOff bool) bool {
+	deadline := i.handoffDeadline(previousOwner, handingOff)
+	if !deadline.After(time.Now()) {
+		return false
+	}
+
+	i.logger.Info("Waiting for shard handoff", tag.Address(previousOwner))
+	sw := i.GetMetricsClient().StartTimer(metrics.HistoryShardControllerScope, metrics.ShardHandoffWaitLatency)
+	defer sw.Stop()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	pollTicker := time.NewTicker(shardHandoffPollInterval)
+	defer pollTicker.Stop()
+
+	for {
+		select {
+		case <-i.handoffCh:
+			return true
+		case <-pollTicker.C:
+			// the handoff notification may not reach us if the ring has not converged yet
+			resp, err := i.GetShardManager().GetShard(&persistence.GetShardRequest{ShardID: int32(i.shardID)})
+			if err == nil && resp.ShardInfo.GetOwner() != previousOwner {
+				return true
+			}
+		case <-timer.C:
+			i.GetMetricsClient().IncCounter(metrics.HistoryShardControllerScope, metrics.ShardHandoffWaitTimeoutCounter)
+			i.logger.Warn("Timed out waiting for shard handoff", tag.Address(previousOwner))
+			return true
+		}
+	}
+}
+
 func (i *historyShardsItem) isValid() bool {
 	i.RLock()
 	defer i.RUnlock()
 
 	switch i.status {
-	case historyShardsItemStatusInitialized, historyShardsItemStatusStarted:
+	case historyShardsItemStatusInitialized, historyShardsItemStatusStarted, historyShardsItemStatusHandingOff:
 		return true
 	case historyShardsItemStatusStopped:
 		return false
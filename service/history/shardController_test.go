@@ -25,6 +25,7 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -33,12 +34,14 @@ import (
 
 	"github.com/gogo/protobuf/types"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -553,6 +556,171 @@ func (s *shardControllerSuite) TestShardControllerClosed() {
 	workerWG.Wait()
 }
 
+func (s *shardControllerSuite) TestShardHandoff() {
+	s.config.NumberOfShards = 1
+	s.shardController = newShardController(s.mockResource, s.mockEngineFactory, s.config)
+	shardID := 0
+	mockEngine := NewMockEngine(s.controller)
+	s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.acquireShards()
+	s.Equal(1, s.shardController.numShards())
+
+	newOwner := membership.NewHostInfo("another-host", nil)
+	s.mockServiceResolver.EXPECT().Lookup(string(shardID)).Return(newOwner, nil).AnyTimes()
+	mockEngine.EXPECT().Stop().Times(1)
+	// the handoff is recorded before the shard is drained
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == s.hostInfo.Identity() && request.ShardInfo.HandingOff && request.PreviousRangeID == 6
+	})).Return(nil).Once()
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == newOwner.Identity() && !request.ShardInfo.HandingOff &&
+			request.ShardInfo.RangeId == 6 && request.PreviousRangeID == 6
+	})).Return(nil).Once()
+
+	heldEngineCh := make(chan Engine, 1)
+	s.mockResource.HistoryClient.EXPECT().HandoffShard(gomock.Any(), &historyservice.HandoffShardRequest{
+		ShardId:       int32(shardID),
+		PreviousOwner: s.hostInfo.Identity(),
+	}).DoAndReturn(func(_ context.Context, _ *historyservice.HandoffShardRequest, _ ...grpc.CallOption) (*historyservice.HandoffShardResponse, error) {
+		go func() {
+			engine, err := s.shardController.getEngineForShard(shardID)
+			s.NoError(err)
+			heldEngineCh <- engine
+		}()
+		select {
+		case <-heldEngineCh:
+			s.Fail("request should be held until the handoff completes")
+		case <-time.After(50 * time.Millisecond):
+		}
+		return &historyservice.HandoffShardResponse{}, nil
+	}).Times(1)
+
+	s.shardController.acquireShards()
+	s.Equal(0, s.shardController.numShards())
+
+	// the held request is forwarded to the new owner
+	signalRequest := &historyservice.SignalWorkflowExecutionRequest{NamespaceId: "test-namespace-id"}
+	s.mockResource.HistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), signalRequest).
+		Return(&historyservice.SignalWorkflowExecutionResponse{}, nil).Times(1)
+	engine := <-heldEngineCh
+	s.IsType(&forwardingEngine{}, engine)
+	s.NoError(engine.SignalWorkflowExecution(context.Background(), signalRequest))
+}
+
+func (s *shardControllerSuite) TestAcquireShardWaitsForHandoff() {
+	s.config.NumberOfShards = 1
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(10 * time.Second)
+	s.shardController = newShardController(s.mockResource, s.mockEngineFactory, s.config)
+	shardID := 0
+	previousOwner := membership.NewHostInfo("another-host", nil)
+
+	mockEngine := NewMockEngine(s.controller)
+	mockEngine.EXPECT().Start().Times(1)
+	s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
+	s.mockServiceResolver.EXPECT().Lookup(string(shardID)).Return(s.hostInfo, nil).Times(1)
+	s.mockServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{s.hostInfo, previousOwner}).Times(1)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: int32(shardID)}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistenceblobs.ShardInfo{ShardId: int32(shardID), Owner: previousOwner.Identity(), RangeId: 5},
+		}, nil).Once()
+	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: int32(shardID)}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistenceblobs.ShardInfo{ShardId: int32(shardID), Owner: s.hostInfo.Identity(), RangeId: 5},
+		}, nil)
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == s.hostInfo.Identity() && request.ShardInfo.RangeId == 6 && request.PreviousRangeID == 5
+	})).Return(nil).Once()
+
+	handoffErrCh := make(chan error, 1)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		handoffErrCh <- s.shardController.acceptShardHandoff(shardID)
+	}()
+
+	startTime := time.Now()
+	engine, err := s.shardController.getEngineForShard(shardID)
+	s.NoError(err)
+	s.Equal(mockEngine, engine)
+	s.True(time.Since(startTime) >= 50*time.Millisecond)
+	s.True(time.Since(startTime) < shardHandoffPollInterval)
+	s.NoError(<-handoffErrCh)
+}
+
+func (s *shardControllerSuite) TestAcquireShardWaitsForRecordedHandoff() {
+	s.config.NumberOfShards = 1
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(10 * time.Second)
+	s.shardController = newShardController(s.mockResource, s.mockEngineFactory, s.config)
+	shardID := 0
+	// the previous owner has left the ring after it started to hand the shard off
+	previousOwner := membership.NewHostInfo("another-host", nil)
+
+	mockEngine := NewMockEngine(s.controller)
+	mockEngine.EXPECT().Start().Times(1)
+	s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
+	s.mockServiceResolver.EXPECT().Lookup(string(shardID)).Return(s.hostInfo, nil).Times(1)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: int32(shardID)}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistenceblobs.ShardInfo{ShardId: int32(shardID), Owner: previousOwner.Identity(), RangeId: 5, HandingOff: true},
+		}, nil).Once()
+	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: int32(shardID)}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistenceblobs.ShardInfo{ShardId: int32(shardID), Owner: s.hostInfo.Identity(), RangeId: 5},
+		}, nil)
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == s.hostInfo.Identity() && !request.ShardInfo.HandingOff &&
+			request.ShardInfo.RangeId == 6 && request.PreviousRangeID == 5
+	})).Return(nil).Once()
+
+	handoffErrCh := make(chan error, 1)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		handoffErrCh <- s.shardController.acceptShardHandoff(shardID)
+	}()
+
+	startTime := time.Now()
+	engine, err := s.shardController.getEngineForShard(shardID)
+	s.NoError(err)
+	s.Equal(mockEngine, engine)
+	s.True(time.Since(startTime) >= 50*time.Millisecond)
+	s.NoError(<-handoffErrCh)
+}
+
+func (s *shardControllerSuite) TestAcquireShardDoesNotWaitForCrashedOwner() {
+	s.config.NumberOfShards = 1
+	s.config.ShardHandoffTimeout = dynamicconfig.GetDurationPropertyFn(10 * time.Second)
+	s.shardController = newShardController(s.mockResource, s.mockEngineFactory, s.config)
+	shardID := 0
+	// the previous owner has left the ring without handing the shard off
+	previousOwner := membership.NewHostInfo("another-host", nil)
+
+	mockEngine := NewMockEngine(s.controller)
+	mockEngine.EXPECT().Start().Times(1)
+	s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
+	s.mockServiceResolver.EXPECT().Lookup(string(shardID)).Return(s.hostInfo, nil).Times(1)
+	s.mockServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{s.hostInfo}).Times(1)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: int32(shardID)}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistenceblobs.ShardInfo{ShardId: int32(shardID), Owner: previousOwner.Identity(), RangeId: 5},
+		}, nil).Once()
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == s.hostInfo.Identity() && request.ShardInfo.RangeId == 6 && request.PreviousRangeID == 5
+	})).Return(nil).Once()
+
+	startTime := time.Now()
+	engine, err := s.shardController.getEngineForShard(shardID)
+	s.NoError(err)
+	s.Equal(mockEngine, engine)
+	s.True(time.Since(startTime) < shardHandoffPollInterval)
+}
+
 func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *MockEngine, currentRangeID,
 	newRangeID int64) {
 
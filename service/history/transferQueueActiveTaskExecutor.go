@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/versioning"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 )
 
@@ -155,10 +156,11 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	taskPriority := getActivityTaskPriority(mutableState, ai.ScheduleID, t.logger)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, timeout)
+	return t.pushActivity(task, timeout, taskPriority)
 }
 
 func (t *transferQueueActiveTaskExecutor) processDecisionTask(
@@ -210,10 +212,12 @@ func (t *transferQueueActiveTaskExecutor) processDecisionTask(
 		taskTimeout = executionInfo.StickyScheduleToStartTimeout
 	}
 
+	taskPriority := getDecisionTaskPriority(mutableState)
+	buildID := versioning.GetWorkflowBuildID(executionInfo.AutoResetPoints)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushDecision(task, taskQueue, taskTimeout)
+	return t.pushDecision(task, taskQueue, taskTimeout, taskPriority, buildID)
 }
 
 func (t *transferQueueActiveTaskExecutor) processCloseExecution(
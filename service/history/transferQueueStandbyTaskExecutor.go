@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/versioning"
 	"go.temporal.io/server/common/xdc"
 )
 
@@ -135,6 +136,7 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		if activityInfo.StartedID == common.EmptyEventID {
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
+				getActivityTaskPriority(mutableState, activityInfo.ScheduleID, t.logger),
 			), nil
 		}
 
@@ -181,6 +183,8 @@ func (t *transferQueueStandbyTaskExecutor) processDecisionTask(
 			return newPushDecisionToMatchingInfo(
 				decisionTimeout,
 				taskqueuepb.TaskQueue{Name: transferTask.TaskQueue},
+				getDecisionTaskPriority(mutableState),
+				versioning.GetWorkflowBuildID(executionInfo.AutoResetPoints),
 			), nil
 		}
 
@@ -527,6 +531,7 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 	return t.transferQueueTaskExecutorBase.pushActivity(
 		task.(*persistenceblobs.TransferTaskInfo),
 		timeout,
+		pushActivityInfo.taskPriority,
 	)
 }
 
@@ -546,6 +551,8 @@ func (t *transferQueueStandbyTaskExecutor) pushDecision(
 		task.(*persistenceblobs.TransferTaskInfo),
 		&pushDecisionInfo.taskqueue,
 		timeout,
+		pushDecisionInfo.taskPriority,
+		pushDecisionInfo.buildID,
 	)
 }
 
@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/priority"
 	"go.temporal.io/server/service/worker/archiver"
 )
 
@@ -63,6 +64,12 @@ type (
 		visibilityMgr  persistence.VisibilityManager
 		config         *Config
 	}
+
+	// matchingTaskPriority is the dispatch priority and the fairness key of a task in matching
+	matchingTaskPriority struct {
+		priority    int32
+		fairnessKey string
+	}
 )
 
 func newTransferQueueTaskExecutorBase(
@@ -97,6 +104,7 @@ func (t *transferQueueTaskExecutorBase) getNamespaceIDAndWorkflowExecution(
 func (t *transferQueueTaskExecutorBase) pushActivity(
 	task *persistenceblobs.TransferTaskInfo,
 	activityScheduleToStartTimeout int32,
+	taskPriority matchingTaskPriority,
 ) error {
 
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
@@ -116,6 +124,8 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 		TaskQueue:                     &taskqueuepb.TaskQueue{Name: task.TaskQueue},
 		ScheduleId:                    task.GetScheduleId(),
 		ScheduleToStartTimeoutSeconds: activityScheduleToStartTimeout,
+		Priority:                      taskPriority.priority,
+		FairnessKey:                   taskPriority.fairnessKey,
 	})
 
 	return err
@@ -125,6 +135,8 @@ func (t *transferQueueTaskExecutorBase) pushDecision(
 	task *persistenceblobs.TransferTaskInfo,
 	taskqueue *taskqueuepb.TaskQueue,
 	decisionScheduleToStartTimeout int32,
+	taskPriority matchingTaskPriority,
+	buildID string,
 ) error {
 
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
@@ -143,10 +155,43 @@ func (t *transferQueueTaskExecutorBase) pushDecision(
 		TaskQueue:                     taskqueue,
 		ScheduleId:                    task.GetScheduleId(),
 		ScheduleToStartTimeoutSeconds: decisionScheduleToStartTimeout,
+		Priority:                      taskPriority.priority,
+		FairnessKey:                   taskPriority.fairnessKey,
+		BuildId:                       buildID,
 	})
 	return err
 }
 
+// getDecisionTaskPriority returns the priority of the decision tasks of a workflow, set in its memo
+func getDecisionTaskPriority(
+	mutableState mutableState,
+) matchingTaskPriority {
+
+	taskPriority, fairnessKey, _ := priority.FromFields(mutableState.GetExecutionInfo().Memo)
+	return matchingTaskPriority{priority: taskPriority, fairnessKey: fairnessKey}
+}
+
+// getActivityTaskPriority returns the priority of an activity task, set in the header of the activity or
+// inherited from the workflow
+func getActivityTaskPriority(
+	mutableState mutableState,
+	scheduleID int64,
+	logger log.Logger,
+) matchingTaskPriority {
+
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(scheduleID)
+	if err != nil {
+		// the task is dispatched with the priority of the workflow
+		logger.Warn("Unable to load activity scheduled event for task priority", tag.WorkflowScheduleID(scheduleID), tag.Error(err))
+		return getDecisionTaskPriority(mutableState)
+	}
+	header := scheduledEvent.GetActivityTaskScheduledEventAttributes().GetHeader()
+	if taskPriority, fairnessKey, ok := priority.FromFields(header.GetFields()); ok {
+		return matchingTaskPriority{priority: taskPriority, fairnessKey: fairnessKey}
+	}
+	return getDecisionTaskPriority(mutableState)
+}
+
 func (t *transferQueueTaskExecutorBase) recordWorkflowStarted(
 	namespaceID string,
 	workflowID string,
@@ -155,6 +155,7 @@ type (
 		timeSource        clock.TimeSource
 
 		mutex           locks.Mutex
+		lockCtx         context.Context
 		mutableState    mutableState
 		stats           *persistence.ExecutionStats
 		updateCondition int64
@@ -191,10 +192,16 @@ func newWorkflowExecutionContext(
 }
 
 func (c *workflowExecutionContextImpl) lock(ctx context.Context) error {
-	return c.mutex.Lock(ctx)
+	if err := c.mutex.Lock(ctx); err != nil {
+		return err
+	}
+	// persistence calls made while the lock is held are traced as part of the request holding it
+	c.lockCtx = ctx
+	return nil
 }
 
 func (c *workflowExecutionContextImpl) unlock() {
+	c.lockCtx = nil
 	c.mutex.Unlock()
 }
 
@@ -976,7 +983,7 @@ func (c *workflowExecutionContextImpl) getWorkflowExecutionWithRetry(
 	var resp *persistence.GetWorkflowExecutionResponse
 	op := func() error {
 		var err error
-		resp, err = c.executionManager.GetWorkflowExecution(request)
+		resp, err = persistence.ExecutionManagerWithContext(c.lockCtx, c.executionManager).GetWorkflowExecution(request)
 
 		return err
 	}
@@ -26,6 +26,7 @@ package matching
 
 import (
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 
@@ -36,19 +37,26 @@ import (
 // Used to convert out of order acks into ackLevel movement.
 type ackManager struct {
 	sync.RWMutex
-	outstandingTasks map[int64]bool // key->TaskID, value->(true for acked, false->for non acked)
-	readLevel        int64          // Maximum TaskID inserted into outstandingTasks
-	ackLevel         int64          // Maximum TaskID below which all tasks are acked
+	outstandingTasks map[int64]bool      // key->TaskID, value->(true for acked, false->for non acked)
+	createTimes      map[int64]time.Time // key->TaskID of the non acked tasks, value->creation time of the task
+	readLevel        int64               // Maximum TaskID inserted into outstandingTasks
+	ackLevel         int64               // Maximum TaskID below which all tasks are acked
 	backlogCounter   atomic.Int64
 	logger           log.Logger
 }
 
 func newAckManager(logger log.Logger) ackManager {
-	return ackManager{logger: logger, outstandingTasks: make(map[int64]bool), readLevel: -1, ackLevel: -1}
+	return ackManager{
+		logger:           logger,
+		outstandingTasks: make(map[int64]bool),
+		createTimes:      make(map[int64]time.Time),
+		readLevel:        -1,
+		ackLevel:         -1,
+	}
 }
 
 // Registers task as in-flight and moves read level to it. Tasks can be added in increasing order of taskID only.
-func (m *ackManager) addTask(taskID int64) {
+func (m *ackManager) addTask(taskID int64, createTime time.Time) {
 	m.Lock()
 	defer m.Unlock()
 	if m.readLevel >= taskID {
@@ -61,6 +69,7 @@ func (m *ackManager) addTask(taskID int64) {
 		m.logger.Fatal("Already present in outstanding tasks", tag.TaskID(taskID))
 	}
 	m.outstandingTasks[taskID] = false // true is for acked
+	m.createTimes[taskID] = createTime
 	m.backlogCounter.Inc()
 }
 
@@ -100,6 +109,7 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 	defer m.Unlock()
 	if completed, ok := m.outstandingTasks[taskID]; ok && !completed {
 		m.outstandingTasks[taskID] = true
+		delete(m.createTimes, taskID)
 		m.backlogCounter.Dec()
 	}
 	// Update ackLevel
@@ -119,3 +129,19 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 func (m *ackManager) getBacklogCountHint() int64 {
 	return m.backlogCounter.Load()
 }
+
+// getOldestCreateTime returns the creation time of the oldest non acked task, zero if all the tasks are acked
+func (m *ackManager) getOldestCreateTime() time.Time {
+	m.RLock()
+	defer m.RUnlock()
+	var oldest time.Time
+	for _, createTime := range m.createTimes {
+		if createTime.Unix() <= 0 {
+			continue
+		}
+		if oldest.IsZero() || createTime.Before(oldest) {
+			oldest = createTime
+		}
+	}
+	return oldest
+}
@@ -0,0 +1,258 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/priority"
+)
+
+type (
+	// backlogLaneKey identifies the backlog lane of a priority and fairness bucket. The tasks of the
+	// zero key are stored in the task queue itself.
+	backlogLaneKey struct {
+		priority       int32
+		fairnessBucket int32
+	}
+)
+
+// backlogLaneName returns the name of the task queue storing the tasks of a backlog lane
+func backlogLaneName(taskQueueName string, key backlogLaneKey) string {
+	return fmt.Sprintf("%v%v/backlog-%v-%v", taskQueuePartitionPrefix, taskQueueName, key.priority, key.fairnessBucket)
+}
+
+// newBacklogLane creates the manager of a backlog lane of the task queue. A lane has its own task range,
+// writer and reader, and its tasks are dispatched by the task queue, from the task buffer they share.
+func newBacklogLane(c *taskQueueManagerImpl, key backlogLaneKey) *taskQueueManagerImpl {
+	id := *c.taskQueueID
+	id.name = backlogLaneName(c.taskQueueID.name, key)
+	db := newTaskQueueDB(c.engine.taskManager, id.namespaceID, id.name, id.taskType, c.taskQueueKind, c.engine.logger)
+	lane := &taskQueueManagerImpl{
+		namespaceCache: c.namespaceCache,
+		metricsClient:  c.metricsClient,
+		engine:         c.engine,
+		shutdownCh:     make(chan struct{}),
+		taskQueueID:    &id,
+		taskQueueKind:  c.taskQueueKind,
+		logger: c.engine.logger.WithTags(tag.WorkflowTaskQueueName(id.name),
+			tag.WorkflowTaskQueueType(id.taskType)),
+		db:             db,
+		taskAckManager: newAckManager(c.engine.logger),
+		taskGC:         newTaskGC(db, c.config),
+		config:         c.config,
+		backlogOf:      c,
+	}
+	lane.taskWriter = newTaskWriter(lane)
+	lane.taskReader = newTaskReader(lane)
+	lane.startWG.Add(1)
+	return lane
+}
+
+// backlogLaneKeyOf returns the key of the backlog lane storing a task
+func (c *taskQueueManagerImpl) backlogLaneKeyOf(taskInfo *persistenceblobs.TaskInfo) backlogLaneKey {
+	if c.taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY {
+		return backlogLaneKey{}
+	}
+	return backlogLaneKey{
+		priority:       taskInfo.GetPriority(),
+		fairnessBucket: priority.FairnessBucket(taskInfo.GetFairnessKey(), c.config.BacklogFairnessBuckets()),
+	}
+}
+
+// getBacklogLane returns the backlog lane of the given key, creating it if needed. The lane is stored in
+// the task queue before it is started, so that it is loaded back with the task queue.
+func (c *taskQueueManagerImpl) getBacklogLane(key backlogLaneKey) (*taskQueueManagerImpl, error) {
+	if key == (backlogLaneKey{}) {
+		return c, nil
+	}
+	if lane, ok := c.cachedBacklogLane(key); ok {
+		return lane, nil
+	}
+
+	c.backlogLanesCreateLock.Lock()
+	defer c.backlogLanesCreateLock.Unlock()
+	if lane, ok := c.cachedBacklogLane(key); ok {
+		return lane, nil
+	}
+	if atomic.LoadInt32(&c.stopped) == 1 {
+		return nil, errShutdown
+	}
+
+	// the lane is only stored if the task queue doesn't have it yet, e.g. when it was stored by a previous
+	// attempt whose start failed
+	if err := c.db.AddBacklogLane(&persistenceblobs.TaskQueueBacklogLane{
+		Priority:       key.priority,
+		FairnessBucket: key.fairnessBucket,
+	}); err != nil {
+		return nil, err
+	}
+	lane := newBacklogLane(c, key)
+	if err := lane.startBacklogLane(); err != nil {
+		return nil, err
+	}
+
+	c.backlogLanesLock.Lock()
+	defer c.backlogLanesLock.Unlock()
+	if atomic.LoadInt32(&c.stopped) == 1 {
+		lane.stop()
+		return nil, errShutdown
+	}
+	c.backlogLanes[key] = lane
+	return lane, nil
+}
+
+// cachedBacklogLane returns the started backlog lane of the given key
+func (c *taskQueueManagerImpl) cachedBacklogLane(key backlogLaneKey) (*taskQueueManagerImpl, bool) {
+	c.backlogLanesLock.Lock()
+	defer c.backlogLanesLock.Unlock()
+	lane, ok := c.backlogLanes[key]
+	return lane, ok
+}
+
+// startBacklogLanes starts the backlog lanes stored in the task queue
+func (c *taskQueueManagerImpl) startBacklogLanes(lanes []*persistenceblobs.TaskQueueBacklogLane) error {
+	c.backlogLanesLock.Lock()
+	defer c.backlogLanesLock.Unlock()
+	for _, info := range lanes {
+		key := backlogLaneKey{priority: info.GetPriority(), fairnessBucket: info.GetFairnessBucket()}
+		if _, ok := c.backlogLanes[key]; ok {
+			continue
+		}
+		lane := newBacklogLane(c, key)
+		if err := lane.startBacklogLane(); err != nil {
+			return err
+		}
+		c.backlogLanes[key] = lane
+	}
+	return nil
+}
+
+// startBacklogLane leases the task range of the backlog lane and starts its writer and reader, the lane
+// is stopped on failure
+func (c *taskQueueManagerImpl) startBacklogLane() error {
+	defer c.startWG.Done()
+
+	state, err := c.renewLeaseWithRetry()
+	if err != nil {
+		c.stop()
+		return err
+	}
+
+	c.taskAckManager.setAckLevel(state.ackLevel)
+	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
+	c.taskReader.Start()
+	return nil
+}
+
+// stopBacklogLanes stops the backlog lanes of the task queue
+func (c *taskQueueManagerImpl) stopBacklogLanes() {
+	c.backlogLanesLock.Lock()
+	defer c.backlogLanesLock.Unlock()
+	for _, lane := range c.backlogLanes {
+		lane.stop()
+	}
+}
+
+// releaseBacklogLanes stops the backlog lanes of an idle task queue and persists their ack levels. The
+// lanes left without tasks are removed from the task queue.
+func (c *taskQueueManagerImpl) releaseBacklogLanes() {
+	c.backlogLanesCreateLock.Lock()
+	defer c.backlogLanesCreateLock.Unlock()
+	c.backlogLanesLock.Lock()
+	defer c.backlogLanesLock.Unlock()
+	var lanes []*persistenceblobs.TaskQueueBacklogLane
+	for key, lane := range c.backlogLanes {
+		lane.stop()
+		_ = lane.taskReader.persistAckLevel()
+		lane.taskGC.RunNow(lane.taskAckManager.getAckLevel())
+		if !lane.isBacklogEmpty() {
+			lanes = append(lanes, &persistenceblobs.TaskQueueBacklogLane{
+				Priority:       key.priority,
+				FairnessBucket: key.fairnessBucket,
+			})
+		}
+		delete(c.backlogLanes, key)
+	}
+	if len(lanes) != len(c.db.BacklogLanes()) {
+		if err := c.db.UpdateBacklogLanes(lanes); err != nil {
+			c.logger.Error("Persistent store operation failure",
+				tag.StoreOperationUpdateTaskQueue,
+				tag.Error(err))
+		}
+	}
+}
+
+// isBacklogEmpty returns true if the stopped backlog lane has no task left. The lease of the lane is
+// renewed first, so that the writes still in flight fail instead of adding tasks to a removed lane.
+func (c *taskQueueManagerImpl) isBacklogEmpty() bool {
+	if _, err := c.db.RenewLease(); err != nil {
+		return false
+	}
+	resp, err := c.db.GetTasks(c.taskAckManager.getAckLevel(), math.MaxInt64, 1)
+	return err == nil && len(resp.Tasks) == 0
+}
+
+// backlogLanesStats returns the approximate number of tasks and the create time of the oldest task of the
+// backlog lanes
+func (c *taskQueueManagerImpl) backlogLanesStats() (int64, time.Time) {
+	c.backlogLanesLock.Lock()
+	defer c.backlogLanesLock.Unlock()
+	var count int64
+	var oldest time.Time
+	for _, lane := range c.backlogLanes {
+		count += lane.approximateBacklogCount()
+		createTime := lane.taskAckManager.getOldestCreateTime()
+		if !createTime.IsZero() && (oldest.IsZero() || createTime.Before(oldest)) {
+			oldest = createTime
+		}
+	}
+	return count, oldest
+}
+
+// appendTask writes a task to the backlog lane of its priority and fairness bucket
+func (c *taskQueueManagerImpl) appendTask(
+	execution *commonpb.WorkflowExecution,
+	taskInfo *persistenceblobs.TaskInfo,
+) (*persistence.CreateTasksResponse, error) {
+	lane, err := c.getBacklogLane(c.backlogLaneKeyOf(taskInfo))
+	if err != nil {
+		return nil, err
+	}
+	r, err := lane.taskWriter.appendTask(execution, taskInfo)
+	if err == nil && lane != c {
+		lane.taskReader.Signal()
+	}
+	return r, err
+}
@@ -0,0 +1,147 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+func addBacklogTestTask(t *testing.T, tlm *taskQueueManagerImpl, priority int32) {
+	// the deadline of the context leaves no time for a sync match
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	syncMatch, err := tlm.AddTask(ctx, addTaskParams{
+		execution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+		taskInfo: &persistenceblobs.TaskInfo{
+			NamespaceId: tlm.taskQueueID.namespaceID,
+			WorkflowId:  "wid",
+			RunId:       "rid",
+			Priority:    priority,
+			Expiry:      timestamp.TimestampNowAddSeconds(60).ToProto(),
+			CreatedTime: timestamp.TimestampNow().ToProto(),
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, syncMatch)
+}
+
+func pollBacklogTestTask(t *testing.T, tlm *taskQueueManagerImpl) *internalTask {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	task, err := tlm.GetTask(ctx, nil)
+	require.NoError(t, err)
+	task.finish(nil)
+	return task
+}
+
+func TestBacklogLane_TasksStoredInLaneAndReloaded(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskQueueManager(controller)
+	require.NoError(t, tlm.Start())
+	addBacklogTestTask(t, tlm, 5)
+
+	key := backlogLaneKey{priority: 5}
+	laneID := *tlm.taskQueueID
+	laneID.name = backlogLaneName(tlm.taskQueueID.name, key)
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	require.Equal(t, 0, tm.getTaskCount(tlm.taskQueueID))
+	require.Equal(t, 1, tm.getTaskCount(&laneID))
+	require.Equal(t, []*persistenceblobs.TaskQueueBacklogLane{{Priority: 5}},
+		tm.getTaskQueueManager(tlm.taskQueueID).backlogLanes)
+	require.Equal(t, int64(1), tlm.approximateBacklogCount())
+	tlm.Stop()
+
+	// the lanes are loaded back with the task queue
+	mgr, err := newTaskQueueManager(tlm.engine, tlm.taskQueueID, enumspb.TASK_QUEUE_KIND_NORMAL, tlm.engine.config)
+	require.NoError(t, err)
+	tlm = mgr.(*taskQueueManagerImpl)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+	require.Contains(t, tlm.backlogLanes, key)
+
+	task := pollBacklogTestTask(t, tlm)
+	require.Equal(t, int32(5), task.event.Data.GetPriority())
+	require.Equal(t, 0, tm.getTaskCount(&laneID))
+}
+
+func TestBacklogLane_ReadWhileDefaultBacklogIsFull(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(2)
+	tlm := createTestTaskQueueManagerWithConfig(controller, cfg)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	for i := 0; i < 3; i++ {
+		addBacklogTestTask(t, tlm, 0)
+	}
+	addBacklogTestTask(t, tlm, 5)
+
+	// one default task is held by the dispatcher and one fills the buffer, the task of the
+	// lane is still buffered
+	require.Eventually(t, func() bool {
+		return tlm.taskReader.taskBuffer.len() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.True(t, tlm.taskReader.taskBuffer.hasPriorityAbove(0))
+
+	require.Equal(t, int32(0), pollBacklogTestTask(t, tlm).event.Data.GetPriority())
+	require.Equal(t, int32(5), pollBacklogTestTask(t, tlm).event.Data.GetPriority())
+	require.Equal(t, int32(0), pollBacklogTestTask(t, tlm).event.Data.GetPriority())
+	require.Equal(t, int32(0), pollBacklogTestTask(t, tlm).event.Data.GetPriority())
+}
+
+func TestBacklogLane_StoredOnce(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskQueueManager(controller)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	// the lane was stored by an attempt whose start failed
+	require.NoError(t, tlm.db.AddBacklogLane(&persistenceblobs.TaskQueueBacklogLane{Priority: 5}))
+	addBacklogTestTask(t, tlm, 5)
+	addBacklogTestTask(t, tlm, 5)
+
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	require.Equal(t, []*persistenceblobs.TaskQueueBacklogLane{{Priority: 5}},
+		tm.getTaskQueueManager(tlm.taskQueueID).backlogLanes)
+	require.Len(t, tlm.backlogLanes, 1)
+}
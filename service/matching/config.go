@@ -40,6 +40,7 @@ type (
 		EnableSyncMatch         dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
 		RPS                     dynamicconfig.IntPropertyFn
 		ShutdownDrainDuration   dynamicconfig.DurationPropertyFn
+		HealthCheckInterval     dynamicconfig.DurationPropertyFn
 
 		// taskQueueManager configuration
 		RangeSize                    int64
@@ -49,6 +50,12 @@ type (
 		MaxTaskqueueIdleTime         dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		NumTaskqueueWritePartitions  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		NumTaskqueueReadPartitions   dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		EnablePartitionAutoscaling   dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		MaxTaskqueuePartitions       dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PartitionTargetAddRate       dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PartitionBacklogThreshold    dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PartitionScalingInterval     dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		BacklogFairnessBuckets       dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxOutstandingPolls dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxOutstandingTasks dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxRatePerSecond    dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
@@ -73,8 +80,17 @@ type (
 		ForwarderMaxChildrenPerNode  func() int
 	}
 
+	partitionScalerConfig struct {
+		EnablePartitionAutoscaling func() bool
+		MaxPartitions              func() int
+		PartitionTargetAddRate     func() int
+		PartitionBacklogThreshold  func() int
+		PartitionScalingInterval   func() time.Duration
+	}
+
 	taskQueueConfig struct {
 		forwarderConfig
+		partitionScalerConfig
 		EnableSyncMatch func() bool
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval func() time.Duration
@@ -90,6 +106,8 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		// number of buckets of the fairness keys whose backlogs are read separately
+		BacklogFairnessBuckets func() int
 	}
 )
 
@@ -113,11 +131,18 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
 		NumTaskqueueWritePartitions:     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingNumTaskqueueWritePartitions, 1),
 		NumTaskqueueReadPartitions:      dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingNumTaskqueueReadPartitions, 1),
+		EnablePartitionAutoscaling:      dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnablePartitionAutoscaling, false),
+		MaxTaskqueuePartitions:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskqueuePartitions, 8),
+		PartitionTargetAddRate:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionTargetAddRate, 500),
+		PartitionBacklogThreshold:       dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionBacklogThreshold, 1000),
+		PartitionScalingInterval:        dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionScalingInterval, time.Minute),
+		BacklogFairnessBuckets:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingBacklogFairnessBuckets, 4),
 		ForwarderMaxOutstandingPolls:    dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls, 1),
 		ForwarderMaxOutstandingTasks:    dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
 		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
 		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0),
+		HealthCheckInterval:             dc.GetDurationProperty(dynamicconfig.MatchingHealthCheckInterval, 10*time.Second),
 	}
 }
 
@@ -168,6 +193,26 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespaceCache cache.Na
 		NumReadPartitions: func() int {
 			return common.MaxInt(1, config.NumTaskqueueReadPartitions(namespace, taskQueueName, taskType))
 		},
+		BacklogFairnessBuckets: func() int {
+			return common.MaxInt(1, config.BacklogFairnessBuckets(namespace, taskQueueName, taskType))
+		},
+		partitionScalerConfig: partitionScalerConfig{
+			EnablePartitionAutoscaling: func() bool {
+				return config.EnablePartitionAutoscaling(namespace, taskQueueName, taskType)
+			},
+			MaxPartitions: func() int {
+				return common.MaxInt(1, config.MaxTaskqueuePartitions(namespace, taskQueueName, taskType))
+			},
+			PartitionTargetAddRate: func() int {
+				return common.MaxInt(1, config.PartitionTargetAddRate(namespace, taskQueueName, taskType))
+			},
+			PartitionBacklogThreshold: func() int {
+				return config.PartitionBacklogThreshold(namespace, taskQueueName, taskType)
+			},
+			PartitionScalingInterval: func() time.Duration {
+				return config.PartitionScalingInterval(namespace, taskQueueName, taskType)
+			},
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(namespace, taskQueueName, taskType)
@@ -39,18 +39,24 @@ import (
 type (
 	taskQueueDB struct {
 		sync.Mutex
-		namespaceID   string
-		taskQueueName string
-		taskQueueKind enumspb.TaskQueueKind
-		taskType      enumspb.TaskQueueType
-		rangeID       int64
-		ackLevel      int64
-		store         persistence.TaskManager
-		logger        log.Logger
+		namespaceID     string
+		taskQueueName   string
+		taskQueueKind   enumspb.TaskQueueKind
+		taskType        enumspb.TaskQueueType
+		rangeID         int64
+		ackLevel        int64
+		backlogLanes    []*persistenceblobs.TaskQueueBacklogLane
+		readPartitions  int32
+		writePartitions int32
+		store           persistence.TaskManager
+		logger          log.Logger
 	}
 	taskQueueState struct {
-		rangeID  int64
-		ackLevel int64
+		rangeID         int64
+		ackLevel        int64
+		backlogLanes    []*persistenceblobs.TaskQueueBacklogLane
+		readPartitions  int32
+		writePartitions int32
 	}
 )
 
@@ -98,8 +104,24 @@ func (db *taskQueueDB) RenewLease() (taskQueueState, error) {
 		return taskQueueState{}, err
 	}
 	db.ackLevel = resp.TaskQueueInfo.Data.AckLevel
+	db.backlogLanes = resp.TaskQueueInfo.Data.BacklogLanes
+	db.readPartitions = resp.TaskQueueInfo.Data.ReadPartitions
+	db.writePartitions = resp.TaskQueueInfo.Data.WritePartitions
 	db.rangeID = resp.TaskQueueInfo.RangeID
-	return taskQueueState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
+	return taskQueueState{
+		rangeID:         db.rangeID,
+		ackLevel:        db.ackLevel,
+		backlogLanes:    db.backlogLanes,
+		readPartitions:  db.readPartitions,
+		writePartitions: db.writePartitions,
+	}, nil
+}
+
+// BacklogLanes returns the backlog lanes of the taskQueue
+func (db *taskQueueDB) BacklogLanes() []*persistenceblobs.TaskQueueBacklogLane {
+	db.Lock()
+	defer db.Unlock()
+	return db.backlogLanes
 }
 
 // UpdateState updates the taskQueue state with the given value
@@ -107,14 +129,8 @@ func (db *taskQueueDB) UpdateState(ackLevel int64) error {
 	db.Lock()
 	defer db.Unlock()
 	_, err := db.store.UpdateTaskQueue(&persistence.UpdateTaskQueueRequest{
-		TaskQueueInfo: &persistenceblobs.TaskQueueInfo{
-			NamespaceId: db.namespaceID,
-			Name:        db.taskQueueName,
-			TaskType:    db.taskType,
-			AckLevel:    ackLevel,
-			Kind:        db.taskQueueKind,
-		},
-		RangeID: db.rangeID,
+		TaskQueueInfo: db.taskQueueInfo(ackLevel, db.backlogLanes),
+		RangeID:       db.rangeID,
 	})
 	if err == nil {
 		db.ackLevel = ackLevel
@@ -122,6 +138,60 @@ func (db *taskQueueDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// UpdateBacklogLanes updates the backlog lanes of the taskQueue, the tasks of a lane must not be written
+// before the lane is stored
+func (db *taskQueueDB) UpdateBacklogLanes(backlogLanes []*persistenceblobs.TaskQueueBacklogLane) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskQueue(&persistence.UpdateTaskQueueRequest{
+		TaskQueueInfo: db.taskQueueInfo(db.ackLevel, backlogLanes),
+		RangeID:       db.rangeID,
+	})
+	if err == nil {
+		db.backlogLanes = backlogLanes
+	}
+	return err
+}
+
+// AddBacklogLane adds a backlog lane to the taskQueue unless it already has one of the same priority and
+// fairness bucket, the tasks of the lane must not be written before the lane is stored
+func (db *taskQueueDB) AddBacklogLane(backlogLane *persistenceblobs.TaskQueueBacklogLane) error {
+	db.Lock()
+	defer db.Unlock()
+	for _, lane := range db.backlogLanes {
+		if lane.GetPriority() == backlogLane.GetPriority() && lane.GetFairnessBucket() == backlogLane.GetFairnessBucket() {
+			return nil
+		}
+	}
+	backlogLanes := append(append([]*persistenceblobs.TaskQueueBacklogLane(nil), db.backlogLanes...), backlogLane)
+	_, err := db.store.UpdateTaskQueue(&persistence.UpdateTaskQueueRequest{
+		TaskQueueInfo: db.taskQueueInfo(db.ackLevel, backlogLanes),
+		RangeID:       db.rangeID,
+	})
+	if err == nil {
+		db.backlogLanes = backlogLanes
+	}
+	return err
+}
+
+// UpdatePartitions updates the read and write partitions of the taskQueue scaled by this root partition
+func (db *taskQueueDB) UpdatePartitions(readPartitions int, writePartitions int) error {
+	db.Lock()
+	defer db.Unlock()
+	info := db.taskQueueInfo(db.ackLevel, db.backlogLanes)
+	info.ReadPartitions = int32(readPartitions)
+	info.WritePartitions = int32(writePartitions)
+	_, err := db.store.UpdateTaskQueue(&persistence.UpdateTaskQueueRequest{
+		TaskQueueInfo: info,
+		RangeID:       db.rangeID,
+	})
+	if err == nil {
+		db.readPartitions = info.ReadPartitions
+		db.writePartitions = info.WritePartitions
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task queue
 func (db *taskQueueDB) CreateTasks(tasks []*persistenceblobs.AllocatedTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
@@ -129,13 +199,7 @@ func (db *taskQueueDB) CreateTasks(tasks []*persistenceblobs.AllocatedTaskInfo)
 	return db.store.CreateTasks(
 		&persistence.CreateTasksRequest{
 			TaskQueueInfo: &persistence.PersistedTaskQueueInfo{
-				Data: &persistenceblobs.TaskQueueInfo{
-					NamespaceId: db.namespaceID,
-					Name:        db.taskQueueName,
-					TaskType:    db.taskType,
-					AckLevel:    db.ackLevel,
-					Kind:        db.taskQueueKind,
-				},
+				Data:    db.taskQueueInfo(db.ackLevel, db.backlogLanes),
 				RangeID: db.rangeID,
 			},
 			Tasks: tasks,
@@ -196,3 +260,16 @@ func (db *taskQueueDB) CompleteTasksLessThan(taskID int64, limit int) (int, erro
 	}
 	return n, err
 }
+
+func (db *taskQueueDB) taskQueueInfo(ackLevel int64, backlogLanes []*persistenceblobs.TaskQueueBacklogLane) *persistenceblobs.TaskQueueInfo {
+	return &persistenceblobs.TaskQueueInfo{
+		NamespaceId:     db.namespaceID,
+		Name:            db.taskQueueName,
+		TaskType:        db.taskType,
+		AckLevel:        ackLevel,
+		Kind:            db.taskQueueKind,
+		BacklogLanes:    backlogLanes,
+		ReadPartitions:  db.readPartitions,
+		WritePartitions: db.writePartitions,
+	}
+}
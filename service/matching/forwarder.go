@@ -161,6 +161,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Source:                        task.source,
 			ScheduleToStartTimeoutSeconds: newScheduleToStartTimeout,
 			ForwardedFrom:                 fwdr.taskQueueID.name,
+			Priority:                      task.event.Data.GetPriority(),
+			FairnessKey:                   task.event.Data.GetFairnessKey(),
 		})
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
 		_, err = fwdr.client.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -175,6 +177,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Source:                        task.source,
 			ScheduleToStartTimeoutSeconds: newScheduleToStartTimeout,
 			ForwardedFrom:                 fwdr.taskQueueID.name,
+			Priority:                      task.event.Data.GetPriority(),
+			FairnessKey:                   task.event.Data.GetFairnessKey(),
 		})
 	default:
 		return errInvalidTaskQueueType
@@ -206,6 +210,8 @@ func (fwdr *Forwarder) ForwardQueryTask(
 		},
 		QueryRequest:  task.query.request.QueryRequest,
 		ForwardedFrom: fwdr.taskQueueID.name,
+		Archived:      task.query.request.GetArchived(),
+		WorkflowType:  task.query.request.GetWorkflowType(),
 	})
 
 	return resp, fwdr.handleErr(err)
@@ -224,6 +230,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
+	buildID, _ := ctx.Value(buildIDKey).(string)
 
 	switch fwdr.taskQueueID.taskType {
 	case enumspb.TASK_QUEUE_TYPE_DECISION:
@@ -235,7 +242,8 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 					Name: name,
 					Kind: enumspb.TaskQueueKind(fwdr.taskQueueKind),
 				},
-				Identity: identity,
+				Identity:       identity,
+				BinaryChecksum: buildID,
 			},
 			ForwardedFrom: fwdr.taskQueueID.name,
 		})
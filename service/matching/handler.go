@@ -27,6 +27,7 @@ package matching
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
@@ -35,6 +36,7 @@ import (
 
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/health"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/quotas"
@@ -46,11 +48,13 @@ type (
 	Handler struct {
 		resource.Resource
 
+		shuttingDown  int32
 		engine        Engine
 		config        *Config
 		metricsClient metrics.Client
 		startWG       sync.WaitGroup
 		rateLimiter   quotas.Limiter
+		healthChecker *health.Checker
 	}
 )
 
@@ -84,6 +88,16 @@ func NewHandler(
 		),
 	}
 
+	handler.healthChecker = health.NewChecker(common.MatchingServiceName, config.HealthCheckInterval, resource.GetLogger())
+	health.AddServiceChecks(
+		handler.healthChecker,
+		common.MatchingServiceName,
+		handler.isShuttingDown,
+		resource.GetMembershipMonitor(),
+		resource.GetNamespaceCache(),
+		resource.GetMetadataManager(),
+	)
+
 	// prevent from serving requests before matching engine is started and ready
 	handler.startWG.Add(1)
 
@@ -93,24 +107,35 @@ func NewHandler(
 // Start starts the handler
 func (h *Handler) Start() {
 	h.startWG.Done()
+	h.healthChecker.Start()
 }
 
 // Stop stops the handler
 func (h *Handler) Stop() {
+	h.DrainHealthStatus()
+	h.healthChecker.Stop()
 	h.engine.Stop()
 }
 
+// DrainHealthStatus reports the host as NOT_SERVING so that clients stop sending requests to it
+func (h *Handler) DrainHealthStatus() {
+	atomic.StoreInt32(&h.shuttingDown, 1)
+	h.healthChecker.Refresh()
+}
+
+func (h *Handler) isShuttingDown() bool {
+	return atomic.LoadInt32(&h.shuttingDown) != 0
+}
+
 // https://github.com/grpc/grpc/blob/master/doc/health-checking.md
-func (h *Handler) Check(context.Context, *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
-	h.startWG.Wait()
+func (h *Handler) Check(ctx context.Context, request *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
 	h.GetLogger().Debug("Matching service health check endpoint (gRPC) reached.")
-	hs := &healthpb.HealthCheckResponse{
-		Status: healthpb.HealthCheckResponse_SERVING,
-	}
-	return hs, nil
+	return h.healthChecker.Check(ctx, request)
 }
-func (h *Handler) Watch(*healthpb.HealthCheckRequest, healthpb.Health_WatchServer) error {
-	return serviceerror.NewUnimplemented("Watch is not implemented.")
+
+// Watch streams the health status of the service whenever it changes
+func (h *Handler) Watch(request *healthpb.HealthCheckRequest, server healthpb.Health_WatchServer) error {
+	return h.healthChecker.Watch(request, server)
 }
 
 func (h *Handler) newHandlerContext(
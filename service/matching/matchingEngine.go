@@ -55,6 +55,8 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/priority"
+	"go.temporal.io/server/common/versioning"
 )
 
 // Implements matching.Engine
@@ -63,6 +65,7 @@ import (
 type (
 	pollerIDCtxKey string
 	identityCtxKey string
+	buildIDCtxKey  string
 
 	// lockableQueryTaskMap maps query TaskID (which is a UUID generated in QueryWorkflow() call) to a channel
 	// that QueryWorkflow() will block on. The channel is unblocked either by worker sending response through
@@ -104,6 +107,7 @@ var (
 
 	pollerIDKey pollerIDCtxKey = "pollerID"
 	identityKey identityCtxKey = "identity"
+	buildIDKey  buildIDCtxKey  = "buildID"
 )
 
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
@@ -239,6 +243,10 @@ func (e *matchingEngineImpl) AddDecisionTask(
 	if err != nil {
 		return false, err
 	}
+	taskQueue, err = e.getBuildIDSubQueue(taskQueue, taskQueueKind, addRequest.GetForwardedFrom(), addRequest.GetBuildId())
+	if err != nil {
+		return false, err
+	}
 
 	tlMgr, err := e.getTaskQueueManager(taskQueue, taskQueueKind)
 	if err != nil {
@@ -256,6 +264,8 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleId:  addRequest.GetScheduleId(),
 		Expiry:      expiry,
 		CreatedTime: now,
+		Priority:    priority.Clamp(addRequest.GetPriority()),
+		FairnessKey: addRequest.GetFairnessKey(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -303,6 +313,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleId:  addRequest.GetScheduleId(),
 		CreatedTime: now,
 		Expiry:      expiry,
+		Priority:    priority.Clamp(addRequest.GetPriority()),
+		FairnessKey: addRequest.GetFairnessKey(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -333,11 +345,19 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, buildIDKey, request.GetBinaryChecksum())
 		taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, enumspb.TASK_QUEUE_TYPE_DECISION)
 		if err != nil {
 			return nil, err
 		}
 		taskQueueKind := request.TaskQueue.GetKind()
+		if err := e.checkPollerBuildID(taskQueue, taskQueueKind, req.GetForwardedFrom(), request.GetBinaryChecksum()); err != nil {
+			return nil, err
+		}
+		taskQueue, err = e.getBuildIDSubQueue(taskQueue, taskQueueKind, req.GetForwardedFrom(), request.GetBinaryChecksum())
+		if err != nil {
+			return nil, err
+		}
 		task, err := e.getTask(pollerCtx, taskQueue, nil, taskQueueKind)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
@@ -357,6 +377,18 @@ pollLoop:
 		if task.isQuery() {
 			task.finish(nil) // this only means query task sync match succeed.
 
+			if task.query.request.GetArchived() {
+				// the mutable state of an archived workflow no longer exists, front end loads
+				// the history events of the decision task from the archival
+				resp := &historyservice.RecordDecisionTaskStartedResponse{
+					PreviousStartedEventId:     common.EmptyEventID,
+					WorkflowType:               task.query.request.GetWorkflowType(),
+					WorkflowExecutionTaskQueue: task.query.request.GetTaskQueue(),
+					StartedEventId:             common.EmptyEventID,
+				}
+				return e.createPollForDecisionTaskResponse(task, resp, hCtx.scope), nil
+			}
+
 			// for query task, we don't need to update history to record decision task started. but we need to know
 			// the NextEventID so front end knows what are the history events to load for this decision task.
 			mutableStateResp, err := e.historyService.GetMutableState(hCtx.Context, &historyservice.GetMutableStateRequest{
@@ -487,6 +519,10 @@ func (e *matchingEngineImpl) QueryWorkflow(
 	if err != nil {
 		return nil, err
 	}
+	taskQueue, err = e.getBuildIDSubQueue(taskQueue, taskQueueKind, queryRequest.GetForwardedFrom(), queryRequest.GetBuildId())
+	if err != nil {
+		return nil, err
+	}
 
 	tlMgr, err := e.getTaskQueueManager(taskQueue, taskQueueKind)
 	if err != nil {
@@ -587,52 +623,124 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus()), nil
+	response := tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus())
+	if !request.DescRequest.GetIncludeTaskQueueStatus() {
+		return response, nil
+	}
+
+	stats := tlMgr.Stats()
+	if taskQueue.IsRoot() && taskQueueKind != enumspb.TASK_QUEUE_KIND_STICKY {
+		// the root partition aggregates the statistics and the pollers of all the partitions
+		if err := e.describePartitions(hCtx, taskQueue, response, stats); err != nil {
+			return nil, err
+		}
+		response.TaskQueueStatus.BacklogCountHint = stats.ApproximateBacklogCount
+	}
+	response.TaskQueueStats = stats
+	return response, nil
+}
+
+// describePartitions adds the statistics and the pollers of the non root partitions of a task queue
+func (e *matchingEngineImpl) describePartitions(
+	hCtx *handlerContext,
+	taskQueue *taskQueueID,
+	response *matchingservice.DescribeTaskQueueResponse,
+	stats *matchingservice.TaskQueueStats,
+) error {
+	namespaceEntry, err := e.namespaceCache.GetNamespaceByID(taskQueue.namespaceID)
+	if err != nil {
+		return err
+	}
+	namespace := namespaceEntry.GetInfo().Name
+	numPartitions, _, err := e.getPartitions(namespace, taskQueue)
+	if err != nil {
+		return err
+	}
+
+	pollers := make(map[string]*taskqueuepb.PollerInfo)
+	for _, poller := range response.Pollers {
+		pollers[poller.GetIdentity()] = poller
+	}
+	for partition := 1; partition < numPartitions; partition++ {
+		resp, err := e.matchingClient.DescribeTaskQueue(hCtx.Context, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: taskQueue.namespaceID,
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				Namespace: namespace,
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: taskQueue.mkName(partition),
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				TaskQueueType:          taskQueue.taskType,
+				IncludeTaskQueueStatus: true,
+			},
+		})
+		if err != nil {
+			// the statistics of the other partitions are still useful
+			e.logger.Warn("Failed to describe task queue partition",
+				tag.WorkflowTaskQueueName(taskQueue.mkName(partition)), tag.Error(err))
+			continue
+		}
+		mergeTaskQueueStats(stats, resp.GetTaskQueueStats())
+		for _, poller := range resp.GetPollers() {
+			existing, ok := pollers[poller.GetIdentity()]
+			if !ok || existing.GetLastAccessTime() < poller.GetLastAccessTime() {
+				pollers[poller.GetIdentity()] = poller
+			}
+		}
+	}
+
+	response.Pollers = make([]*taskqueuepb.PollerInfo, 0, len(pollers))
+	for _, poller := range pollers {
+		response.Pollers = append(response.Pollers, poller)
+	}
+	return nil
 }
 
 func (e *matchingEngineImpl) ListTaskQueuePartitions(
 	hCtx *handlerContext,
 	request *matchingservice.ListTaskQueuePartitionsRequest,
 ) (*matchingservice.ListTaskQueuePartitionsResponse, error) {
-	activityTaskQueueInfo, err := e.listTaskQueuePartitions(request, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	activityTaskQueueInfo, activityWritePartitions, err := e.listTaskQueuePartitions(request, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
 	if err != nil {
 		return nil, err
 	}
-	decisionTaskQueueInfo, err := e.listTaskQueuePartitions(request, enumspb.TASK_QUEUE_TYPE_DECISION)
+	decisionTaskQueueInfo, decisionWritePartitions, err := e.listTaskQueuePartitions(request, enumspb.TASK_QUEUE_TYPE_DECISION)
 	if err != nil {
 		return nil, err
 	}
 	resp := matchingservice.ListTaskQueuePartitionsResponse{
-		ActivityTaskQueuePartitions: activityTaskQueueInfo,
-		DecisionTaskQueuePartitions: decisionTaskQueueInfo,
+		ActivityTaskQueuePartitions:      activityTaskQueueInfo,
+		DecisionTaskQueuePartitions:      decisionTaskQueueInfo,
+		ActivityTaskQueueWritePartitions: int32(activityWritePartitions),
+		DecisionTaskQueueWritePartitions: int32(decisionWritePartitions),
 	}
 	return &resp, nil
 }
 
-func (e *matchingEngineImpl) listTaskQueuePartitions(request *matchingservice.ListTaskQueuePartitionsRequest, taskQueueType enumspb.TaskQueueType) ([]*taskqueuepb.TaskQueuePartitionMetadata, error) {
-	partitions, err := e.getAllPartitions(
+// listTaskQueuePartitions lists the read partitions of a task queue, and returns the number of its write partitions
+func (e *matchingEngineImpl) listTaskQueuePartitions(request *matchingservice.ListTaskQueuePartitionsRequest, taskQueueType enumspb.TaskQueueType) ([]*taskqueuepb.TaskQueuePartitionMetadata, int, error) {
+	partitions, writePartitions, err := e.getAllPartitions(
 		request.GetNamespace(),
 		*request.TaskQueue,
 		taskQueueType,
 	)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	partitionHostInfo := make([]*taskqueuepb.TaskQueuePartitionMetadata, len(partitions))
 
-	if err != nil {
-		return nil, err
-	}
+	partitionHostInfo := make([]*taskqueuepb.TaskQueuePartitionMetadata, 0, len(partitions))
 	for _, partition := range partitions {
-		if host, err := e.getHostInfo(partition); err != nil {
-			partitionHostInfo = append(partitionHostInfo,
-				&taskqueuepb.TaskQueuePartitionMetadata{
-					Key:           partition,
-					OwnerHostName: host,
-				})
+		host, err := e.getHostInfo(partition)
+		if err != nil {
+			return nil, 0, err
 		}
+		partitionHostInfo = append(partitionHostInfo,
+			&taskqueuepb.TaskQueuePartitionMetadata{
+				Key:           partition,
+				OwnerHostName: host,
+			})
 	}
-	return partitionHostInfo, nil
+	return partitionHostInfo, writePartitions, nil
 }
 
 func (e *matchingEngineImpl) getHostInfo(partitionKey string) (string, error) {
@@ -643,32 +751,54 @@ func (e *matchingEngineImpl) getHostInfo(partitionKey string) (string, error) {
 	return host.GetAddress(), nil
 }
 
+// getAllPartitions returns the read partitions of a task queue, and the number of its write partitions
 func (e *matchingEngineImpl) getAllPartitions(
 	namespace string,
 	taskQueue taskqueuepb.TaskQueue,
 	taskQueueType enumspb.TaskQueueType,
-) ([]string, error) {
+) ([]string, int, error) {
 	var partitionKeys []string
 	namespaceID, err := e.namespaceCache.GetNamespaceID(namespace)
 	if err != nil {
-		return partitionKeys, err
+		return partitionKeys, 0, err
 	}
-	taskQueueID, err := newTaskQueueID(namespaceID, taskQueue.GetName(), enumspb.TASK_QUEUE_TYPE_DECISION)
-	rootPartition := taskQueueID.GetRoot()
-
-	partitionKeys = append(partitionKeys, rootPartition)
-
-	nWritePartitions := e.config.GetTasksBatchSize
-	n := nWritePartitions(namespace, rootPartition, taskQueueType)
-	if n <= 0 {
-		return partitionKeys, nil
+	taskQueueID, err := newTaskQueueID(namespaceID, taskQueue.GetName(), taskQueueType)
+	if err != nil {
+		return partitionKeys, 0, err
+	}
+	readPartitions, writePartitions, err := e.getPartitions(namespace, taskQueueID)
+	if err != nil {
+		return partitionKeys, 0, err
 	}
 
-	for i := 1; i < n; i++ {
-		partitionKeys = append(partitionKeys, fmt.Sprintf("%v%v/%v", taskQueuePartitionPrefix, rootPartition, i))
+	partitionKeys = append(partitionKeys, taskQueueID.GetRoot())
+	for i := 1; i < readPartitions; i++ {
+		partitionKeys = append(partitionKeys, taskQueueID.mkName(i))
 	}
 
-	return partitionKeys, nil
+	return partitionKeys, writePartitions, nil
+}
+
+// getPartitions returns the number of read and write partitions of a task queue, they are either scaled by its
+// root partition, which is owned by this host, or set by the dynamic config
+func (e *matchingEngineImpl) getPartitions(namespace string, taskQueue *taskQueueID) (int, int, error) {
+	rootPartition := taskQueue.GetRoot()
+	if e.config.EnablePartitionAutoscaling(namespace, rootPartition, taskQueue.taskType) {
+		rootID, err := newTaskQueueID(taskQueue.namespaceID, rootPartition, taskQueue.taskType)
+		if err != nil {
+			return 0, 0, err
+		}
+		tlMgr, err := e.getTaskQueueManager(rootID, enumspb.TASK_QUEUE_KIND_NORMAL)
+		if err != nil {
+			return 0, 0, err
+		}
+		if readPartitions, writePartitions := tlMgr.Partitions(); writePartitions > 0 {
+			return readPartitions, writePartitions, nil
+		}
+	}
+	writePartitions := common.MaxInt(1, e.config.NumTaskqueueWritePartitions(namespace, rootPartition, taskQueue.taskType))
+	readPartitions := common.MaxInt(writePartitions, e.config.NumTaskqueueReadPartitions(namespace, rootPartition, taskQueue.taskType))
+	return readPartitions, writePartitions, nil
 }
 
 // Loads a task from persistence and wraps it in a task context
@@ -718,6 +848,8 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(
 			RunId:           task.event.Data.GetRunId(),
 			ScheduleId:      historyResponse.GetScheduledEventId(),
 			ScheduleAttempt: historyResponse.GetAttempt(),
+			WorkflowType:    historyResponse.GetWorkflowType().GetName(),
+			TaskQueue:       historyResponse.GetWorkflowExecutionTaskQueue().GetName(),
 		}
 		serializedToken, _ = e.tokenSerializer.Serialize(taskToken)
 		if task.responseC == nil {
@@ -732,6 +864,7 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(
 		serializedToken)
 	if task.query != nil {
 		response.Query = task.query.request.QueryRequest.Query
+		response.Archived = task.query.request.GetArchived()
 	}
 	response.BacklogCountHint = task.backlogCountHint
 	return response
@@ -765,6 +898,8 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(
 		ScheduleAttempt: historyResponse.GetAttempt(),
 		ActivityId:      attributes.GetActivityId(),
 		ActivityType:    attributes.GetActivityType().GetName(),
+		WorkflowType:    historyResponse.GetWorkflowType().GetName(),
+		TaskQueue:       attributes.GetTaskQueue().GetName(),
 	}
 
 	serializedToken, _ := e.tokenSerializer.Serialize(taskToken)
@@ -818,6 +953,60 @@ func (e *matchingEngineImpl) recordDecisionTaskStarted(
 	return resp, err
 }
 
+// checkPollerBuildID returns an error if the task queue is versioned and the build ID of the poller
+// is not in any of its compatible build ID sets
+func (e *matchingEngineImpl) checkPollerBuildID(
+	taskQueue *taskQueueID,
+	taskQueueKind enumspb.TaskQueueKind,
+	forwardedFrom string,
+	buildID string,
+) error {
+	buildIDSets, err := e.getBuildIDSets(taskQueue, taskQueueKind, forwardedFrom)
+	if err != nil {
+		return err
+	}
+	if !buildIDSets.IsKnown(buildID) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Build ID %q is not compatible with task queue %v.", buildID, taskQueue.GetRoot()))
+	}
+	return nil
+}
+
+// getBuildIDSubQueue returns the partition of the sub-queue of a versioned task queue which holds the decision
+// tasks of the build ID set of the build ID, the task queue itself if it is not versioned. The tasks of a workflow
+// go to the sub-queue of the set of the build ID which last processed it, and pollers poll the sub-queue of the set
+// of their own build ID, so a decision task is only ever matched with a compatible poller.
+func (e *matchingEngineImpl) getBuildIDSubQueue(
+	taskQueue *taskQueueID,
+	taskQueueKind enumspb.TaskQueueKind,
+	forwardedFrom string,
+	buildID string,
+) (*taskQueueID, error) {
+	buildIDSets, err := e.getBuildIDSets(taskQueue, taskQueueKind, forwardedFrom)
+	if err != nil || len(buildIDSets) == 0 {
+		return taskQueue, err
+	}
+	subQueue := qualifiedTaskQueueName{baseName: versioning.SubQueueName(taskQueue.GetRoot(), buildIDSets.SetKey(buildID))}
+	return newTaskQueueID(taskQueue.namespaceID, subQueue.mkName(taskQueue.partition), taskQueue.taskType)
+}
+
+// getBuildIDSets returns the build ID sets of the task queue, nil if the task queue is not versioned or if the
+// requests to the task queue are not subject to versioning: the sticky task queues are specific to the worker which
+// processed the workflow, and the forwarded requests already target a sub-queue.
+func (e *matchingEngineImpl) getBuildIDSets(
+	taskQueue *taskQueueID,
+	taskQueueKind enumspb.TaskQueueKind,
+	forwardedFrom string,
+) (versioning.BuildIDSets, error) {
+	if taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY || forwardedFrom != "" || versioning.IsSubQueueName(taskQueue.GetRoot()) {
+		return nil, nil
+	}
+	namespaceEntry, err := e.namespaceCache.GetNamespaceByID(taskQueue.namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	return versioning.GetBuildIDSets(namespaceEntry.GetInfo().Data, taskQueue.GetRoot())
+}
+
 func (e *matchingEngineImpl) recordActivityTaskStarted(
 	ctx context.Context,
 	pollReq *workflowservice.PollForActivityTaskRequest,
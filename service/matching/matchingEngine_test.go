@@ -45,6 +45,7 @@ import (
 	decisionpb "go.temporal.io/api/decision/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	querypb "go.temporal.io/api/query/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
@@ -67,6 +68,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/common/versioning"
 )
 
 type (
@@ -153,14 +155,15 @@ func newMatchingEngine(
 	logger log.Logger, mockNamespaceCache cache.NamespaceCache,
 ) *matchingEngineImpl {
 	return &matchingEngineImpl{
-		taskManager:     taskMgr,
-		historyService:  mockHistoryClient,
-		taskQueues:      make(map[taskQueueID]taskQueueManager),
-		logger:          logger,
-		metricsClient:   metrics.NewClient(tally.NoopScope, metrics.Matching),
-		tokenSerializer: common.NewProtoTaskTokenSerializer(),
-		config:          config,
-		namespaceCache:  mockNamespaceCache,
+		taskManager:          taskMgr,
+		historyService:       mockHistoryClient,
+		taskQueues:           make(map[taskQueueID]taskQueueManager),
+		logger:               logger,
+		metricsClient:        metrics.NewClient(tally.NoopScope, metrics.Matching),
+		tokenSerializer:      common.NewProtoTaskTokenSerializer(),
+		config:               config,
+		namespaceCache:       mockNamespaceCache,
+		lockableQueryTaskMap: lockableQueryTaskMap{queryTaskMap: make(map[string]chan *queryResult)},
 	}
 }
 
@@ -175,11 +178,11 @@ func (s *matchingEngineSuite) TestAckManager() {
 	const t4 = 340
 	const t5 = 360
 
-	m.addTask(t1)
+	m.addTask(t1, time.Now())
 	s.EqualValues(100, m.getAckLevel())
 	s.EqualValues(t1, m.getReadLevel())
 
-	m.addTask(t2)
+	m.addTask(t2, time.Now())
 	s.EqualValues(100, m.getAckLevel())
 	s.EqualValues(t2, m.getReadLevel())
 
@@ -195,11 +198,11 @@ func (s *matchingEngineSuite) TestAckManager() {
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(300, m.getReadLevel())
 
-	m.addTask(t3)
+	m.addTask(t3, time.Now())
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(t3, m.getReadLevel())
 
-	m.addTask(t4)
+	m.addTask(t4, time.Now())
 	s.EqualValues(300, m.getAckLevel())
 	s.EqualValues(t4, m.getReadLevel())
 
@@ -321,6 +324,142 @@ func (s *matchingEngineSuite) PollForDecisionTasksResultTest() {
 	s.Equal(expectedResp, resp)
 }
 
+func (s *matchingEngineSuite) TestPollForDecisionTasks_BuildIDSubQueue() {
+	namespaceID := uuid.NewRandom().String()
+	tl := "makeToast"
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	execution := &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"}
+
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	namespaceCache := cache.NewMockNamespaceCache(controller)
+	namespaceCache.EXPECT().GetNamespaceByID(namespaceID).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{
+			Id:   namespaceID,
+			Name: matchingTestNamespace,
+			Data: map[string]string{versioning.NamespaceDataKey(tl): `[["1.0","1.1"],["2.0"]]`},
+		},
+		&persistenceblobs.NamespaceConfig{},
+		"",
+		nil,
+	), nil).AnyTimes()
+
+	config := defaultTestConfig()
+	config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(10 * time.Millisecond)
+	engine := newMatchingEngine(config, s.taskManager, s.mockHistoryClient, s.logger, namespaceCache)
+	engine.Start()
+	defer engine.Stop()
+
+	s.mockHistoryClient.EXPECT().RecordDecisionTaskStarted(gomock.Any(), gomock.Any()).Return(
+		&historyservice.RecordDecisionTaskStartedResponse{
+			WorkflowType:               &commonpb.WorkflowType{Name: "workflow"},
+			ScheduledEventId:           1,
+			WorkflowExecutionTaskQueue: taskQueue,
+		}, nil).Times(1)
+
+	_, err := engine.AddDecisionTask(s.handlerContext, &matchingservice.AddDecisionTaskRequest{
+		NamespaceId:                   namespaceID,
+		Execution:                     execution,
+		ScheduleId:                    1,
+		TaskQueue:                     taskQueue,
+		ScheduleToStartTimeoutSeconds: 100,
+		BuildId:                       "1.0",
+	})
+	s.NoError(err)
+	s.EqualValues(0, s.taskManager.getTaskCount(newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_DECISION)))
+	s.EqualValues(1, s.taskManager.getTaskCount(newTestTaskQueueID(namespaceID, versioning.SubQueueName(tl, "1.0"), enumspb.TASK_QUEUE_TYPE_DECISION)))
+
+	poll := func(buildID string) (*matchingservice.PollForDecisionTaskResponse, error) {
+		return engine.PollForDecisionTask(s.handlerContext, &matchingservice.PollForDecisionTaskRequest{
+			NamespaceId: namespaceID,
+			PollRequest: &workflowservice.PollForDecisionTaskRequest{
+				TaskQueue:      taskQueue,
+				Identity:       "selfDrivingToaster",
+				BinaryChecksum: buildID,
+			},
+		})
+	}
+
+	_, err = poll("3.0")
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	resp, err := poll("2.0")
+	s.NoError(err)
+	s.Equal(emptyPollForDecisionTaskResponse, resp)
+
+	resp, err = poll("1.1")
+	s.NoError(err)
+	s.Equal(execution, resp.WorkflowExecution)
+}
+
+func (s *matchingEngineSuite) TestQueryWorkflow_Archived() {
+	namespaceID := uuid.NewRandom().String()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "makeToast", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	execution := &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"}
+	workflowType := &commonpb.WorkflowType{Name: "workflow"}
+	query := &querypb.WorkflowQuery{QueryType: "state"}
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(time.Second)
+
+	// the mutable state of an archived workflow is not loaded from history service
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Times(0)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	var queryResp *matchingservice.QueryWorkflowResponse
+	var queryErr error
+	go func() {
+		defer wg.Done()
+		queryResp, queryErr = s.matchingEngine.QueryWorkflow(s.handlerContext, &matchingservice.QueryWorkflowRequest{
+			NamespaceId: namespaceID,
+			TaskQueue:   taskQueue,
+			QueryRequest: &workflowservice.QueryWorkflowRequest{
+				Execution: execution,
+				Query:     query,
+			},
+			Archived:     true,
+			WorkflowType: workflowType,
+		})
+	}()
+
+	var resp *matchingservice.PollForDecisionTaskResponse
+	for resp.GetWorkflowExecution() == nil {
+		var err error
+		resp, err = s.matchingEngine.PollForDecisionTask(s.handlerContext, &matchingservice.PollForDecisionTaskRequest{
+			NamespaceId: namespaceID,
+			PollRequest: &workflowservice.PollForDecisionTaskRequest{
+				TaskQueue: taskQueue,
+				Identity:  "selfDrivingToaster",
+			},
+		})
+		s.NoError(err)
+	}
+	s.True(resp.GetArchived())
+	s.Equal(execution, resp.GetWorkflowExecution())
+	s.Equal(workflowType, resp.GetWorkflowType())
+	s.Equal(query, resp.GetQuery())
+	s.Nil(resp.GetBranchToken())
+
+	queryToken, err := s.matchingEngine.tokenSerializer.DeserializeQueryTaskToken(resp.TaskToken)
+	s.NoError(err)
+	queryResult := payloads.EncodeString("toasted")
+	// the query result channel is registered once the dispatch of the query task returns
+	s.Eventually(func() bool {
+		return s.matchingEngine.RespondQueryTaskCompleted(s.handlerContext, &matchingservice.RespondQueryTaskCompletedRequest{
+			NamespaceId: namespaceID,
+			TaskQueue:   taskQueue,
+			TaskId:      queryToken.GetTaskId(),
+			CompletedRequest: &workflowservice.RespondQueryTaskCompletedRequest{
+				CompletedType: enumspb.QUERY_RESULT_TYPE_ANSWERED,
+				QueryResult:   queryResult,
+			},
+		}) == nil
+	}, time.Second, 10*time.Millisecond)
+
+	wg.Wait()
+	s.NoError(queryErr)
+	s.Equal(queryResult, queryResp.GetQueryResult())
+}
+
 func (s *matchingEngineSuite) PollForTasksEmptyResultTest(callContext context.Context, taskType enumspb.TaskQueueType) {
 	s.matchingEngine.config.RangeSize = 2 // to test that range is not updated without tasks
 	if _, ok := callContext.Deadline(); !ok {
@@ -381,8 +520,9 @@ func (s *matchingEngineSuite) PollForTasksEmptyResultTest(callContext context.Co
 		s.Equal(identity, descResp.Pollers[0].GetIdentity())
 		s.NotEmpty(descResp.Pollers[0].GetLastAccessTime())
 		s.Nil(descResp.GetTaskQueueStatus())
+		s.Nil(descResp.GetTaskQueueStats())
 	}
-	s.EqualValues(1, s.taskManager.taskQueues[*tlID].rangeID)
+	s.EqualValues(1, s.taskManager.getTaskQueueManager(tlID).rangeID)
 }
 
 func (s *matchingEngineSuite) TestAddActivityTasks() {
@@ -596,6 +736,7 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 			ScheduleId:   scheduleID,
 			ActivityId:   activityID,
 			ActivityType: activityTypeName,
+			TaskQueue:    tl,
 		}
 
 		serializedToken, _ := s.matchingEngine.tokenSerializer.Serialize(taskToken)
@@ -745,6 +886,7 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 			ScheduleId:   scheduleID,
 			ActivityId:   activityID,
 			ActivityType: activityTypeName,
+			TaskQueue:    tl,
 		}
 
 		serializedToken, _ := s.matchingEngine.tokenSerializer.Serialize(taskToken)
@@ -782,6 +924,7 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	s.Equal(_defaultTaskDispatchRPS, descResp.Pollers[0].GetRatePerSecond())
 	s.NotNil(descResp.GetTaskQueueStatus())
 	s.True(descResp.GetTaskQueueStatus().GetRatePerSecond() >= (_defaultTaskDispatchRPS - 1))
+	s.NotNil(descResp.GetTaskQueueStats())
 }
 
 func (s *matchingEngineSuite) TestConcurrentPublishConsumeActivities() {
@@ -928,6 +1071,7 @@ func (s *matchingEngineSuite) concurrentPublishConsumeActivities(
 					ScheduleId:   scheduleID,
 					ActivityId:   activityID,
 					ActivityType: activityTypeName,
+					TaskQueue:    tl,
 				}
 				resultToken, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
 				s.NoError(err)
@@ -1015,10 +1159,11 @@ func (s *matchingEngineSuite) TestConcurrentPublishConsumeDecisions() {
 		func(ctx context.Context, taskRequest *historyservice.RecordDecisionTaskStartedRequest) (*historyservice.RecordDecisionTaskStartedResponse, error) {
 			s.logger.Debug("Mock Received RecordDecisionTaskStartedRequest")
 			return &historyservice.RecordDecisionTaskStartedResponse{
-				PreviousStartedEventId: startedEventID,
-				StartedEventId:         startedEventID,
-				ScheduledEventId:       scheduleID,
-				WorkflowType:           workflowType,
+				PreviousStartedEventId:     startedEventID,
+				StartedEventId:             startedEventID,
+				ScheduledEventId:           scheduleID,
+				WorkflowType:               workflowType,
+				WorkflowExecutionTaskQueue: taskQueue,
 			}, nil
 		}).AnyTimes()
 	for p := 0; p < workerCount; p++ {
@@ -1043,10 +1188,12 @@ func (s *matchingEngineSuite) TestConcurrentPublishConsumeDecisions() {
 				s.EqualValues(startedEventID, result.StartedEventId)
 				s.EqualValues(workflowExecution, result.WorkflowExecution)
 				taskToken := &tokenspb.Task{
-					NamespaceId: namespaceID,
-					WorkflowId:  workflowID,
-					RunId:       runID,
-					ScheduleId:  scheduleID,
+					NamespaceId:  namespaceID,
+					WorkflowId:   workflowID,
+					RunId:        runID,
+					ScheduleId:   scheduleID,
+					WorkflowType: workflowTypeName,
+					TaskQueue:    tl,
 				}
 				resultToken, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -1226,6 +1373,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 					ScheduleId:   scheduleID,
 					ActivityId:   activityID,
 					ActivityType: activityTypeName,
+					TaskQueue:    tl,
 				}
 				resultToken, err := engine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -1328,10 +1476,11 @@ func (s *matchingEngineSuite) TestMultipleEnginesDecisionsRangeStealing() {
 			s.logger.Debug("Mock Received RecordDecisionTaskStartedRequest")
 			startedTasks[taskRequest.TaskId] = true
 			return &historyservice.RecordDecisionTaskStartedResponse{
-				PreviousStartedEventId: startedEventID,
-				StartedEventId:         startedEventID,
-				ScheduledEventId:       scheduleID,
-				WorkflowType:           workflowType,
+				PreviousStartedEventId:     startedEventID,
+				StartedEventId:             startedEventID,
+				ScheduledEventId:           scheduleID,
+				WorkflowType:               workflowType,
+				WorkflowExecutionTaskQueue: taskQueue,
 			}, nil
 		}).AnyTimes()
 	for j := 0; j < iterations; j++ {
@@ -1357,10 +1506,12 @@ func (s *matchingEngineSuite) TestMultipleEnginesDecisionsRangeStealing() {
 				s.EqualValues(startedEventID, result.StartedEventId)
 				s.EqualValues(workflowExecution, result.WorkflowExecution)
 				taskToken := &tokenspb.Task{
-					NamespaceId: namespaceID,
-					WorkflowId:  workflowID,
-					RunId:       runID,
-					ScheduleId:  scheduleID,
+					NamespaceId:  namespaceID,
+					WorkflowId:   workflowID,
+					RunId:        runID,
+					ScheduleId:   scheduleID,
+					WorkflowType: workflowTypeName,
+					TaskQueue:    tl,
 				}
 				resultToken, err := engine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -1473,8 +1624,8 @@ func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch() {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := common.MinInt(cap(tlMgr.taskReader.taskBuffer), taskCount)
-	s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) == expectedBufSize }, time.Second))
+	expectedBufSize := common.MinInt(tlMgr.taskReader.taskBuffer.cap(), taskCount)
+	s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.taskBuffer.len() == expectedBufSize }, time.Second))
 
 	// stop all goroutines that read / write tasks in the background
 	// remainder of this test works with the in-memory buffer
@@ -1617,7 +1768,7 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 
 		// wait until all tasks are loaded by into in-memory buffers by task queue manager
 		// the buffer size should be one less than expected because dispatcher will dequeue the head
-		s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) >= (taskCount/2 - 1) }, time.Second))
+		s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.taskBuffer.len() >= (taskCount/2 - 1) }, time.Second))
 
 		maxTimeBetweenTaskDeletes = tc.maxTimeBtwnDeletes
 		s.matchingEngine.config.MaxTaskDeleteBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(tc.batchSize)
@@ -1732,21 +1883,35 @@ func (m *testTaskManager) Close() {
 }
 
 func (m *testTaskManager) getTaskQueueManager(id *taskQueueID) *testTaskQueueManager {
+	key := newTestTaskManagerKey(id.namespaceID, id.name, id.taskType)
 	m.Lock()
 	defer m.Unlock()
-	result, ok := m.taskQueues[*id]
+	result, ok := m.taskQueues[*key]
 	if ok {
 		return result
 	}
 	result = newTestTaskQueueManager()
-	m.taskQueues[*id] = result
+	m.taskQueues[*key] = result
 	return result
 }
 
+// newTestTaskManagerKey returns the key of a task queue in testTaskManager, the task queues are identified
+// by their persisted name
+func newTestTaskManagerKey(namespaceID string, name string, taskType enumspb.TaskQueueType) *taskQueueID {
+	return &taskQueueID{
+		qualifiedTaskQueueName: qualifiedTaskQueueName{name: name},
+		namespaceID:            namespaceID,
+		taskType:               taskType,
+	}
+}
+
 type testTaskQueueManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
+	backlogLanes    []*persistenceblobs.TaskQueueBacklogLane
+	readPartitions  int32
+	writePartitions int32
 	createTaskCount int
 	tasks           *treemap.Map
 }
@@ -1778,7 +1943,7 @@ func newTestTaskQueueID(namespaceID string, name string, taskType enumspb.TaskQu
 
 // LeaseTaskQueue provides a mock function with given fields: request
 func (m *testTaskManager) LeaseTaskQueue(request *persistence.LeaseTaskQueueRequest) (*persistence.LeaseTaskQueueResponse, error) {
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(request.NamespaceID, request.TaskQueue, request.TaskType))
+	tlm := m.getTaskQueueManager(newTestTaskManagerKey(request.NamespaceID, request.TaskQueue, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	tlm.rangeID++
@@ -1787,11 +1952,14 @@ func (m *testTaskManager) LeaseTaskQueue(request *persistence.LeaseTaskQueueRequ
 	return &persistence.LeaseTaskQueueResponse{
 		TaskQueueInfo: &persistence.PersistedTaskQueueInfo{
 			Data: &persistenceblobs.TaskQueueInfo{
-				AckLevel:    tlm.ackLevel,
-				NamespaceId: request.NamespaceID,
-				Name:        request.TaskQueue,
-				TaskType:    request.TaskType,
-				Kind:        request.TaskQueueKind,
+				AckLevel:        tlm.ackLevel,
+				NamespaceId:     request.NamespaceID,
+				Name:            request.TaskQueue,
+				TaskType:        request.TaskType,
+				Kind:            request.TaskQueueKind,
+				BacklogLanes:    tlm.backlogLanes,
+				ReadPartitions:  tlm.readPartitions,
+				WritePartitions: tlm.writePartitions,
 			},
 			RangeID: tlm.rangeID,
 		},
@@ -1803,7 +1971,7 @@ func (m *testTaskManager) UpdateTaskQueue(request *persistence.UpdateTaskQueueRe
 	m.logger.Debug("UpdateTaskQueue", tag.TaskQueueInfo(request.TaskQueueInfo), tag.AckLevel(request.TaskQueueInfo.AckLevel))
 
 	tli := request.TaskQueueInfo
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(tli.GetNamespaceId(), tli.Name, tli.TaskType))
+	tlm := m.getTaskQueueManager(newTestTaskManagerKey(tli.GetNamespaceId(), tli.Name, tli.TaskType))
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -1813,6 +1981,9 @@ func (m *testTaskManager) UpdateTaskQueue(request *persistence.UpdateTaskQueueRe
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.backlogLanes = tli.BacklogLanes
+	tlm.readPartitions = tli.ReadPartitions
+	tlm.writePartitions = tli.WritePartitions
 	return &persistence.UpdateTaskQueueResponse{}, nil
 }
 
@@ -1824,7 +1995,7 @@ func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest)
 	}
 
 	tli := request.TaskQueue
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(tli.NamespaceID, tli.Name, tli.TaskType))
+	tlm := m.getTaskQueueManager(newTestTaskManagerKey(tli.NamespaceID, tli.Name, tli.TaskType))
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -1834,7 +2005,7 @@ func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest)
 }
 
 func (m *testTaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(request.NamespaceID, request.TaskQueueName, request.TaskType))
+	tlm := m.getTaskQueueManager(newTestTaskManagerKey(request.NamespaceID, request.TaskQueueName, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	keys := tlm.tasks.Keys()
@@ -1854,7 +2025,7 @@ func (m *testTaskManager) ListTaskQueue(request *persistence.ListTaskQueueReques
 func (m *testTaskManager) DeleteTaskQueue(request *persistence.DeleteTaskQueueRequest) error {
 	m.Lock()
 	defer m.Unlock()
-	key := newTestTaskQueueID(request.TaskQueue.NamespaceID, request.TaskQueue.Name, request.TaskQueue.TaskType)
+	key := newTestTaskManagerKey(request.TaskQueue.NamespaceID, request.TaskQueue.Name, request.TaskQueue.TaskType)
 	delete(m.taskQueues, *key)
 	return nil
 }
@@ -1866,7 +2037,7 @@ func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (
 	taskType := request.TaskQueueInfo.Data.TaskType
 	rangeID := request.TaskQueueInfo.RangeID
 
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(namespaceID, taskQueue, taskType))
+	tlm := m.getTaskQueueManager(newTestTaskManagerKey(namespaceID, taskQueue, taskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 
@@ -1912,7 +2083,7 @@ func (m *testTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persi
 		m.logger.Debug("testTaskManager.GetTasks", tag.ReadLevel(request.ReadLevel))
 	}
 
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(request.NamespaceID, request.TaskQueue, request.TaskType))
+	tlm := m.getTaskQueueManager(newTestTaskManagerKey(request.NamespaceID, request.TaskQueue, request.TaskType))
 	tlm.Lock()
 	defer tlm.Unlock()
 	var tasks []*persistenceblobs.AllocatedTaskInfo
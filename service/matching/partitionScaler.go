@@ -0,0 +1,260 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// partitionDescribeTimeout is the timeout of the describe calls made to the partitions when scaling
+	partitionDescribeTimeout = 5 * time.Second
+)
+
+type (
+	// partitionDescribeFn describes a partition of the task queue, including its status
+	partitionDescribeFn func(ctx context.Context, partition int) (*matchingservice.DescribeTaskQueueResponse, error)
+
+	// partitionPersistFn persists the number of read and write partitions of the task queue
+	partitionPersistFn func(readPartitions int, writePartitions int) error
+
+	// partitionScaler scales the number of partitions of a task queue from its load. It runs in the root
+	// partition, which counts the tasks added to it by the clients and periodically describes all the partitions
+	// to get their backlog and pollers. The task queue is scaled up when the add rate or the backlog needs more
+	// partitions, and scaled down one partition at a time. The clients add tasks to the write partitions and
+	// poll the read partitions. The read partitions grow with the write partitions, and a partition removed from
+	// the write partitions stays a read partition until its backlog is drained. The partitions are persisted in
+	// the root partition, so that a new owner of the root partition starts from the same layout.
+	partitionScaler struct {
+		sync.Mutex
+		taskQueueID        *taskQueueID
+		config             *taskQueueConfig
+		describeFn         partitionDescribeFn
+		persistFn          partitionPersistFn
+		logger             log.Logger
+		shutdownCh         <-chan struct{}
+		timeSource         func() time.Time
+		addCount           int64
+		numReadPartitions  int32
+		numWritePartitions int32
+		lastScale          time.Time
+	}
+
+	// partitionLoad is the load of the partitions of a task queue
+	partitionLoad struct {
+		addRate  float64
+		backlogs []int64
+		pollers  int
+	}
+)
+
+func newPartitionScaler(
+	taskQueueID *taskQueueID,
+	config *taskQueueConfig,
+	describeFn partitionDescribeFn,
+	persistFn partitionPersistFn,
+	logger log.Logger,
+	shutdownCh <-chan struct{},
+) *partitionScaler {
+	// the partitions of the dynamic config are used until the partitions are scaled
+	numWritePartitions := common.MaxInt(1, config.NumWritePartitions())
+	numReadPartitions := common.MaxInt(numWritePartitions, config.NumReadPartitions())
+	return &partitionScaler{
+		taskQueueID:        taskQueueID,
+		config:             config,
+		describeFn:         describeFn,
+		persistFn:          persistFn,
+		logger:             logger,
+		shutdownCh:         shutdownCh,
+		timeSource:         time.Now,
+		numReadPartitions:  int32(numReadPartitions),
+		numWritePartitions: int32(numWritePartitions),
+		lastScale:          time.Now(),
+	}
+}
+
+// Start starts the scaling loop from the persisted partitions, if any. The loop stops when the task queue
+// manager is stopped
+func (s *partitionScaler) Start(readPartitions int32, writePartitions int32) {
+	if writePartitions > 0 {
+		atomic.StoreInt32(&s.numWritePartitions, writePartitions)
+		atomic.StoreInt32(&s.numReadPartitions, int32(common.MaxInt(int(readPartitions), int(writePartitions))))
+	}
+	go s.scaleLoop()
+}
+
+// RecordAdd counts a task added to the root partition by a client
+func (s *partitionScaler) RecordAdd() {
+	atomic.AddInt64(&s.addCount, 1)
+}
+
+// Partitions returns the number of read and write partitions of the task queue
+func (s *partitionScaler) Partitions() (int, int) {
+	return int(atomic.LoadInt32(&s.numReadPartitions)), int(atomic.LoadInt32(&s.numWritePartitions))
+}
+
+func (s *partitionScaler) scaleLoop() {
+	for {
+		timer := time.NewTimer(s.config.PartitionScalingInterval())
+		select {
+		case <-s.shutdownCh:
+			timer.Stop()
+			return
+		case <-timer.C:
+			if s.config.EnablePartitionAutoscaling() {
+				s.scale()
+			}
+		}
+	}
+}
+
+func (s *partitionScaler) scale() {
+	s.Lock()
+	defer s.Unlock()
+
+	numReadPartitions, numWritePartitions := s.Partitions()
+	load, ok := s.getLoad(numReadPartitions, numWritePartitions)
+	if !ok {
+		return
+	}
+
+	minPartitions := common.MaxInt(1, s.config.NumWritePartitions())
+	maxPartitions := common.MaxInt(minPartitions, s.config.MaxPartitions())
+
+	target := int(math.Ceil(load.addRate / float64(s.config.PartitionTargetAddRate())))
+	var backlog int64
+	for _, partitionBacklog := range load.backlogs[:numWritePartitions] {
+		backlog += partitionBacklog
+	}
+	if threshold := s.config.PartitionBacklogThreshold(); threshold > 0 && backlog > int64(threshold*numWritePartitions) {
+		target = common.MaxInt(target, numWritePartitions+1)
+	}
+	// a partition without pollers only forwards its tasks to the root
+	target = common.MinInt(target, load.pollers)
+	target = common.MaxInt(minPartitions, common.MinInt(target, maxPartitions))
+
+	writePartitions := numWritePartitions
+	if target > numWritePartitions {
+		writePartitions = target
+	} else if target < numWritePartitions {
+		writePartitions--
+	}
+
+	// the clients may still add tasks to a partition removed from the write partitions until they refresh
+	// the layout, so it is read at least until the next scaling and then until its backlog is drained
+	readPartitions := common.MaxInt(numReadPartitions, writePartitions)
+	minReadPartitions := common.MaxInt(writePartitions, numWritePartitions)
+	for readPartitions > minReadPartitions && load.backlogs[readPartitions-1] == 0 {
+		readPartitions--
+	}
+
+	if readPartitions == numReadPartitions && writePartitions == numWritePartitions {
+		return
+	}
+	if err := s.persistFn(readPartitions, writePartitions); err != nil {
+		s.logger.Warn("Failed to persist task queue partitions", tag.Error(err))
+		return
+	}
+	atomic.StoreInt32(&s.numReadPartitions, int32(readPartitions))
+	atomic.StoreInt32(&s.numWritePartitions, int32(writePartitions))
+
+	if writePartitions != numWritePartitions {
+		s.logger.Info("Task queue partitions scaled",
+			tag.Counter(writePartitions),
+			tag.Number(backlog),
+			tag.Key(s.taskQueueID.name))
+	}
+}
+
+// getLoad describes the read partitions of the task queue, it returns false if a write partition could not
+// be described
+func (s *partitionScaler) getLoad(numReadPartitions int, numWritePartitions int) (partitionLoad, bool) {
+	now := s.timeSource()
+	elapsed := now.Sub(s.lastScale)
+	s.lastScale = now
+	adds := atomic.SwapInt64(&s.addCount, 0)
+
+	load := partitionLoad{}
+	if elapsed > 0 {
+		// the clients spread their tasks across the write partitions, the root gets its share of them
+		load.addRate = float64(adds) / elapsed.Seconds() * float64(numWritePartitions)
+	}
+
+	pollers := make(map[string]struct{})
+	load.backlogs = make([]int64, numReadPartitions)
+	for partition := 0; partition < numReadPartitions; partition++ {
+		ctx, cancel := context.WithTimeout(context.Background(), partitionDescribeTimeout)
+		resp, err := s.describeFn(ctx, partition)
+		cancel()
+		if err != nil {
+			s.logger.Warn("Failed to describe task queue partition", tag.Error(err), tag.Counter(partition))
+			if partition < numWritePartitions {
+				return load, false
+			}
+			// keep reading the partition until it can be described
+			load.backlogs[partition] = 1
+			continue
+		}
+		load.backlogs[partition] = resp.GetTaskQueueStatus().GetBacklogCountHint()
+		for _, poller := range resp.GetPollers() {
+			pollers[poller.GetIdentity()] = struct{}{}
+		}
+	}
+	load.pollers = len(pollers)
+	return load, true
+}
+
+// newPartitionDescribeFn returns the describe function of the partitions of the root task queue of the manager
+func newPartitionDescribeFn(c *taskQueueManagerImpl) partitionDescribeFn {
+	return func(ctx context.Context, partition int) (*matchingservice.DescribeTaskQueueResponse, error) {
+		if partition == 0 {
+			return c.DescribeTaskQueue(true), nil
+		}
+		return c.engine.matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: c.taskQueueID.namespaceID,
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: c.taskQueueID.mkName(partition),
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				TaskQueueType:          c.taskQueueID.taskType,
+				IncludeTaskQueueStatus: true,
+			},
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/log/loggerimpl"
+)
+
+type testPartitions struct {
+	backlogs []int64
+	pollers  int
+	failed   map[int]bool
+}
+
+func (p *testPartitions) describe(_ context.Context, partition int) (*matchingservice.DescribeTaskQueueResponse, error) {
+	if p.failed[partition] {
+		return nil, errors.New("describe failed")
+	}
+	resp := &matchingservice.DescribeTaskQueueResponse{TaskQueueStatus: &taskqueuepb.TaskQueueStatus{}}
+	if partition < len(p.backlogs) {
+		resp.TaskQueueStatus.BacklogCountHint = p.backlogs[partition]
+	}
+	if partition == 0 {
+		for i := 0; i < p.pollers; i++ {
+			resp.Pollers = append(resp.Pollers, &taskqueuepb.PollerInfo{Identity: fmt.Sprintf("poller-%v", i)})
+		}
+	}
+	return resp, nil
+}
+
+type testPartitionStore struct {
+	readPartitions  int
+	writePartitions int
+	err             error
+}
+
+func (p *testPartitionStore) persist(readPartitions int, writePartitions int) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.readPartitions = readPartitions
+	p.writePartitions = writePartitions
+	return nil
+}
+
+func newTestPartitionScaler(t *testing.T, partitions *testPartitions, store *testPartitionStore) (*partitionScaler, *time.Time) {
+	taskQueue, err := newTaskQueueID("namespace-id", "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	require.NoError(t, err)
+	config := &taskQueueConfig{
+		partitionScalerConfig: partitionScalerConfig{
+			EnablePartitionAutoscaling: func() bool { return true },
+			MaxPartitions:              func() int { return 4 },
+			PartitionTargetAddRate:     func() int { return 100 },
+			PartitionBacklogThreshold:  func() int { return 1000 },
+			PartitionScalingInterval:   func() time.Duration { return time.Minute },
+		},
+		NumWritePartitions: func() int { return 1 },
+		NumReadPartitions:  func() int { return 1 },
+	}
+	scaler := newPartitionScaler(taskQueue, config, partitions.describe, store.persist, loggerimpl.NewNopLogger(), make(chan struct{}))
+	now := time.Now()
+	scaler.lastScale = now
+	scaler.timeSource = func() time.Time { return now }
+	return scaler, &now
+}
+
+func recordAdds(scaler *partitionScaler, now *time.Time, adds int) {
+	for i := 0; i < adds; i++ {
+		scaler.RecordAdd()
+	}
+	*now = now.Add(time.Second)
+}
+
+func assertPartitions(t *testing.T, scaler *partitionScaler, readPartitions int, writePartitions int, msgAndArgs ...interface{}) {
+	read, write := scaler.Partitions()
+	assert.Equal(t, readPartitions, read, msgAndArgs...)
+	assert.Equal(t, writePartitions, write, msgAndArgs...)
+}
+
+func TestPartitionScaler_ScaleUpFromAddRate(t *testing.T) {
+	partitions := &testPartitions{pollers: 10}
+	store := &testPartitionStore{}
+	scaler, now := newTestPartitionScaler(t, partitions, store)
+	assertPartitions(t, scaler, 1, 1)
+
+	recordAdds(scaler, now, 250)
+	scaler.scale()
+	assertPartitions(t, scaler, 3, 3)
+	assert.Equal(t, &testPartitionStore{readPartitions: 3, writePartitions: 3}, store)
+
+	// the root gets a third of the 900 tasks added per second
+	recordAdds(scaler, now, 300)
+	scaler.scale()
+	assertPartitions(t, scaler, 4, 4, "capped at the max partitions")
+}
+
+func TestPartitionScaler_ScaleUpFromBacklog(t *testing.T) {
+	partitions := &testPartitions{backlogs: []int64{1500}, pollers: 10}
+	scaler, now := newTestPartitionScaler(t, partitions, &testPartitionStore{})
+
+	recordAdds(scaler, now, 0)
+	scaler.scale()
+	assertPartitions(t, scaler, 2, 2)
+}
+
+func TestPartitionScaler_CappedByPollers(t *testing.T) {
+	partitions := &testPartitions{pollers: 2}
+	scaler, now := newTestPartitionScaler(t, partitions, &testPartitionStore{})
+
+	recordAdds(scaler, now, 1000)
+	scaler.scale()
+	assertPartitions(t, scaler, 2, 2)
+}
+
+func TestPartitionScaler_ScaleDownDrainsRemovedPartitions(t *testing.T) {
+	partitions := &testPartitions{pollers: 10}
+	store := &testPartitionStore{}
+	scaler, now := newTestPartitionScaler(t, partitions, store)
+	recordAdds(scaler, now, 400)
+	scaler.scale()
+	assertPartitions(t, scaler, 4, 4)
+
+	recordAdds(scaler, now, 0)
+	scaler.scale()
+	assertPartitions(t, scaler, 4, 3, "the write partitions are scaled down one partition at a time")
+
+	partitions.backlogs = []int64{0, 0, 0, 10}
+	recordAdds(scaler, now, 0)
+	scaler.scale()
+	assertPartitions(t, scaler, 4, 2, "the removed partition is read until drained")
+	assert.Equal(t, &testPartitionStore{readPartitions: 4, writePartitions: 2}, store)
+
+	partitions.backlogs = []int64{0, 0, 0, 0}
+	recordAdds(scaler, now, 0)
+	scaler.scale()
+	assertPartitions(t, scaler, 2, 1)
+}
+
+func TestPartitionScaler_StartFromPersistedPartitions(t *testing.T) {
+	partitions := &testPartitions{pollers: 10}
+	scaler, _ := newTestPartitionScaler(t, partitions, &testPartitionStore{})
+
+	scaler.Start(3, 2)
+	assertPartitions(t, scaler, 3, 2)
+}
+
+func TestPartitionScaler_SkipWhenPartitionsNotPersisted(t *testing.T) {
+	partitions := &testPartitions{pollers: 10}
+	scaler, now := newTestPartitionScaler(t, partitions, &testPartitionStore{err: errors.New("persist failed")})
+
+	recordAdds(scaler, now, 1000)
+	scaler.scale()
+	assertPartitions(t, scaler, 1, 1)
+}
+
+func TestPartitionScaler_SkipWhenPartitionNotDescribed(t *testing.T) {
+	partitions := &testPartitions{pollers: 10, failed: map[int]bool{0: true}}
+	scaler, now := newTestPartitionScaler(t, partitions, &testPartitionStore{})
+
+	recordAdds(scaler, now, 1000)
+	scaler.scale()
+	assertPartitions(t, scaler, 1, 1)
+}
+
+func TestPartitionScaler_PartitionsLoadedWithRootPartition(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.EnablePartitionAutoscaling = func(string, string, enumspb.TaskQueueType) bool { return true }
+	tlm := createTestTaskQueueManagerWithConfig(controller, cfg)
+	require.NoError(t, tlm.Start())
+	require.NoError(t, tlm.db.UpdatePartitions(3, 2))
+	tlm.Stop()
+
+	mgr, err := newTaskQueueManager(tlm.engine, tlm.taskQueueID, enumspb.TASK_QUEUE_KIND_NORMAL, tlm.engine.config)
+	require.NoError(t, err)
+	require.NoError(t, mgr.Start())
+	defer mgr.Stop()
+	readPartitions, writePartitions := mgr.Partitions()
+	assert.Equal(t, 3, readPartitions)
+	assert.Equal(t, 2, writePartitions)
+}
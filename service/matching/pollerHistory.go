@@ -29,6 +29,7 @@ import (
 
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common/cache"
 )
 
@@ -43,6 +44,7 @@ type (
 
 	pollerInfo struct {
 		ratePerSecond float64
+		buildID       string
 	}
 )
 
@@ -64,12 +66,31 @@ func newPollerHistory() *pollerHistory {
 	}
 }
 
-func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64) {
+func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64, buildID string) {
 	rps := _defaultTaskDispatchRPS
 	if ratePerSecond != nil {
 		rps = *ratePerSecond
 	}
-	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps})
+	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps, buildID: buildID})
+}
+
+// getPollerBuildIDs returns the build IDs of the pollers which declared one
+func (pollers *pollerHistory) getPollerBuildIDs() []*matchingservice.PollerBuildId {
+	var result []*matchingservice.PollerBuildId
+
+	ite := pollers.history.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		if value := entry.Value().(*pollerInfo); value.buildID != "" {
+			result = append(result, &matchingservice.PollerBuildId{
+				Identity: string(entry.Key().(pollerIdentity)),
+				BuildId:  value.buildID,
+			})
+		}
+	}
+
+	return result
 }
 
 func (pollers *pollerHistory) getAllPollerInfo() []*taskqueuepb.PollerInfo {
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
@@ -105,7 +106,7 @@ func (s *Service) Start() {
 	if err != nil {
 		logger.Fatal("creating grpc server options failed", tag.Error(err))
 	}
-	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	opts = append(opts, grpc.ChainUnaryInterceptor(rpc.TracingServerInterceptor, interceptor))
 	s.server = grpc.NewServer(opts...)
 	nilCheckHandler := NewNilCheckHandler(s.handler)
 	matchingservice.RegisterMatchingServiceServer(s.server, nilCheckHandler)
@@ -124,7 +125,9 @@ func (s *Service) Stop() {
 		return
 	}
 
-	// remove self from membership ring and wait for traffic to drain
+	// fail health checks, remove self from membership ring and wait for traffic to drain
+	s.GetLogger().Info("ShutdownHandler: Updating rpc health status to NOT_SERVING")
+	s.handler.DrainHealthStatus()
 	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
 	s.GetMembershipMonitor().EvictSelf()
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
@@ -0,0 +1,213 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+)
+
+type (
+	// taskBuffer buffers the tasks loaded from persistence until they are dispatched. The tasks of a higher
+	// priority are dispatched first, and the tasks of the same priority are dispatched round-robin across their
+	// fairness keys, in the order they were loaded for each key. The number of buffered tasks is bounded, a slot
+	// is acquired by sending to slotC before pushing a task. The readers of the backlog lanes acquire the slots
+	// of their own slotC instead, so that a full lane does not hold back the reads of the others.
+	taskBuffer struct {
+		sync.Mutex
+		levels    map[int32]*fairTaskQueue
+		size      int
+		slotC     chan struct{}
+		notEmptyC chan struct{}
+		closedC   chan struct{}
+	}
+
+	// bufferedTask is a task in the buffer, with the reader which loaded it from its backlog lane
+	bufferedTask struct {
+		*persistenceblobs.AllocatedTaskInfo
+		reader *taskReader
+	}
+
+	// fairTaskQueue holds the buffered tasks of a priority, by fairness key
+	fairTaskQueue struct {
+		keys  []string // the fairness keys with tasks, in round-robin order
+		next  int
+		tasks map[string][]*bufferedTask
+	}
+)
+
+func newTaskBuffer(capacity int) *taskBuffer {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &taskBuffer{
+		levels:    make(map[int32]*fairTaskQueue),
+		slotC:     make(chan struct{}, capacity),
+		notEmptyC: make(chan struct{}, 1),
+		closedC:   make(chan struct{}),
+	}
+}
+
+// push adds a task to the buffer, a slot must have been acquired for it
+func (b *taskBuffer) push(task *persistenceblobs.AllocatedTaskInfo) {
+	b.pushFrom(task, nil)
+}
+
+// pushFrom adds a task loaded by the reader of a backlog lane to the buffer, a slot of the reader must
+// have been acquired for it. The slot is released when the task is taken.
+func (b *taskBuffer) pushFrom(info *persistenceblobs.AllocatedTaskInfo, reader *taskReader) {
+	task := &bufferedTask{AllocatedTaskInfo: info, reader: reader}
+	b.Lock()
+	priority := task.GetData().GetPriority()
+	level, ok := b.levels[priority]
+	if !ok {
+		level = &fairTaskQueue{tasks: make(map[string][]*bufferedTask)}
+		b.levels[priority] = level
+	}
+	level.push(task)
+	b.size++
+	b.Unlock()
+
+	select {
+	case b.notEmptyC <- struct{}{}:
+	default:
+	}
+}
+
+// take removes the next task to dispatch from the buffer, waiting until there is one. It returns false
+// if the buffer is closed and empty, or if the shutdown channel is closed.
+func (b *taskBuffer) take(shutdownC <-chan struct{}) (*bufferedTask, bool) {
+	for {
+		if task := b.pop(); task != nil {
+			b.release(task)
+			return task, true
+		}
+		select {
+		case <-b.notEmptyC:
+		case <-b.closedC:
+			// the tasks pushed before closing are still dispatched
+			if task := b.pop(); task != nil {
+				b.release(task)
+				return task, true
+			}
+			return nil, false
+		case <-shutdownC:
+			return nil, false
+		}
+	}
+}
+
+// close closes the buffer, no task is pushed to it afterwards
+func (b *taskBuffer) close() {
+	close(b.closedC)
+}
+
+// len returns the number of buffered tasks
+func (b *taskBuffer) len() int {
+	b.Lock()
+	defer b.Unlock()
+	return b.size
+}
+
+// cap returns the max number of buffered tasks
+func (b *taskBuffer) cap() int {
+	return cap(b.slotC)
+}
+
+// hasPriorityAbove returns true if a buffered task has a higher priority than the given one
+func (b *taskBuffer) hasPriorityAbove(priority int32) bool {
+	b.Lock()
+	defer b.Unlock()
+	for level := range b.levels {
+		if level > priority {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *taskBuffer) release(task *bufferedTask) {
+	if task.reader != nil {
+		<-task.reader.slotC
+		return
+	}
+	<-b.slotC
+}
+
+func (b *taskBuffer) pop() *bufferedTask {
+	b.Lock()
+	defer b.Unlock()
+	if b.size == 0 {
+		return nil
+	}
+	var highest int32
+	var level *fairTaskQueue
+	for priority, l := range b.levels {
+		if level == nil || priority > highest {
+			highest = priority
+			level = l
+		}
+	}
+	task := level.pop()
+	if len(level.keys) == 0 {
+		delete(b.levels, highest)
+	}
+	b.size--
+	return task
+}
+
+func (q *fairTaskQueue) push(task *bufferedTask) {
+	key := task.GetData().GetFairnessKey()
+	if _, ok := q.tasks[key]; !ok {
+		// the new key is served after all the keys with tasks
+		q.keys = append(q.keys, "")
+		copy(q.keys[q.next+1:], q.keys[q.next:])
+		q.keys[q.next] = key
+		q.next++
+		if q.next == len(q.keys) {
+			q.next = 0
+		}
+	}
+	q.tasks[key] = append(q.tasks[key], task)
+}
+
+func (q *fairTaskQueue) pop() *bufferedTask {
+	key := q.keys[q.next]
+	tasks := q.tasks[key]
+	task := tasks[0]
+	tasks[0] = nil
+	if len(tasks) == 1 {
+		delete(q.tasks, key)
+		q.keys = append(q.keys[:q.next], q.keys[q.next+1:]...)
+	} else {
+		q.tasks[key] = tasks[1:]
+		q.next++
+	}
+	if q.next >= len(q.keys) {
+		q.next = 0
+	}
+	return task
+}
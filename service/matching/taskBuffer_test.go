@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+)
+
+func pushTestTask(b *taskBuffer, taskID int64, priority int32, fairnessKey string) {
+	b.slotC <- struct{}{}
+	b.push(&persistenceblobs.AllocatedTaskInfo{
+		TaskId: taskID,
+		Data:   &persistenceblobs.TaskInfo{Priority: priority, FairnessKey: fairnessKey},
+	})
+}
+
+func takeTestTaskIDs(t *testing.T, b *taskBuffer) []int64 {
+	var taskIDs []int64
+	for b.len() > 0 {
+		task, ok := b.take(nil)
+		require.True(t, ok)
+		taskIDs = append(taskIDs, task.GetTaskId())
+	}
+	return taskIDs
+}
+
+func TestTaskBuffer_FIFOWithoutPriority(t *testing.T) {
+	b := newTaskBuffer(10)
+	for i := int64(1); i <= 5; i++ {
+		pushTestTask(b, i, 0, "")
+	}
+	assert.Equal(t, 5, b.len())
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, takeTestTaskIDs(t, b))
+}
+
+func TestTaskBuffer_HigherPriorityFirst(t *testing.T) {
+	b := newTaskBuffer(10)
+	pushTestTask(b, 1, 0, "")
+	pushTestTask(b, 2, 5, "")
+	pushTestTask(b, 3, 1, "")
+	pushTestTask(b, 4, 5, "")
+	assert.True(t, b.hasPriorityAbove(1))
+	assert.False(t, b.hasPriorityAbove(5))
+	assert.Equal(t, []int64{2, 4, 3, 1}, takeTestTaskIDs(t, b))
+	assert.False(t, b.hasPriorityAbove(0))
+}
+
+func TestTaskBuffer_RoundRobinAcrossFairnessKeys(t *testing.T) {
+	b := newTaskBuffer(10)
+	// a key with many tasks doesn't block the others
+	pushTestTask(b, 1, 0, "a")
+	pushTestTask(b, 2, 0, "a")
+	pushTestTask(b, 3, 0, "a")
+	pushTestTask(b, 4, 0, "b")
+	pushTestTask(b, 5, 0, "c")
+	pushTestTask(b, 6, 0, "b")
+
+	task, ok := b.take(nil)
+	require.True(t, ok)
+	assert.Equal(t, int64(1), task.GetTaskId())
+	// a new key is served after the keys with tasks
+	pushTestTask(b, 7, 0, "d")
+	assert.Equal(t, []int64{4, 5, 2, 7, 6, 3}, takeTestTaskIDs(t, b))
+}
+
+func TestTaskBuffer_Close(t *testing.T) {
+	b := newTaskBuffer(2)
+	pushTestTask(b, 1, 0, "")
+	b.close()
+
+	task, ok := b.take(nil)
+	require.True(t, ok, "the tasks pushed before closing are dispatched")
+	assert.Equal(t, int64(1), task.GetTaskId())
+	_, ok = b.take(nil)
+	assert.False(t, ok)
+}
+
+func TestTaskBuffer_Shutdown(t *testing.T) {
+	b := newTaskBuffer(2)
+	shutdownC := make(chan struct{})
+	close(shutdownC)
+	_, ok := b.take(shutdownC)
+	assert.False(t, ok)
+}
@@ -88,6 +88,11 @@ type (
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
 		// DescribeTaskQueue returns information about the target task queue
 		DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
+		// Stats returns the backlog statistics of the task queue partition
+		Stats() *matchingservice.TaskQueueStats
+		// Partitions returns the number of read and write partitions of the task queue scaled by this root
+		// partition, zeros when the partitions are not autoscaled
+		Partitions() (int, int)
 		String() string
 	}
 
@@ -101,8 +106,10 @@ type (
 		taskWriter       *taskWriter
 		taskReader       *taskReader // reads tasks from db and async matches it with poller
 		taskGC           *taskGC
-		taskAckManager   ackManager   // tracks ackLevel for delivered messages
-		matcher          *TaskMatcher // for matching a task producer with a poller
+		taskAckManager   ackManager            // tracks ackLevel for delivered messages
+		matcher          *TaskMatcher          // for matching a task producer with a poller
+		partitionScaler  *partitionScaler      // scales the partitions of the task queue, only set on the root partition
+		backlogOf        *taskQueueManagerImpl // the task queue of a backlog lane, nil for a task queue
 		namespaceCache   cache.NamespaceCache
 		logger           log.Logger
 		metricsClient    metrics.Client
@@ -110,6 +117,8 @@ type (
 		metricScopeValue atomic.Value // namespace/taskqueue tagged metric scope
 		// pollerHistory stores poller which poll from this taskqueue in last few minutes
 		pollerHistory *pollerHistory
+		// stats tracks the recent add, dispatch and sync match rates of this partition
+		stats *taskQueueStats
 		// outstandingPollsMap is needed to keep track of all outstanding pollers for a
 		// particular taskqueue.  PollerID generated by frontend is used as the key and
 		// CancelFunc is the value.  This is used to cancel the context to unblock any
@@ -117,6 +126,13 @@ type (
 		// prevent tasks being dispatched to zombie pollers.
 		outstandingPollsLock sync.Mutex
 		outstandingPollsMap  map[string]context.CancelFunc
+		// backlogLanes are the backlog lanes of the tasks of a non default priority or fairness bucket, whose
+		// tasks are stored and read separately, so that the backlog of a lane doesn't hold back the others
+		backlogLanesLock sync.Mutex
+		backlogLanes     map[backlogLaneKey]*taskQueueManagerImpl
+		// backlogLanesCreateLock serializes the creation and the release of the backlog lanes, which store
+		// and start them without holding backlogLanesLock
+		backlogLanesCreateLock sync.Mutex
 
 		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
@@ -161,7 +177,9 @@ func newTaskQueueManager(
 		taskGC:              newTaskGC(db, taskQueueConfig),
 		config:              taskQueueConfig,
 		pollerHistory:       newPollerHistory(),
+		stats:               newTaskQueueStats(),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
+		backlogLanes:        make(map[backlogLaneKey]*taskQueueManagerImpl),
 	}
 
 	tlMgr.namespaceValue.Store("")
@@ -183,6 +201,9 @@ func newTaskQueueManager(
 		fwdr = newForwarder(&taskQueueConfig.forwarderConfig, taskQueue, taskQueueKind, e.matchingClient)
 	}
 	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.metricScope)
+	if taskQueue.IsRoot() && taskQueueKind != enumspb.TASK_QUEUE_KIND_STICKY {
+		tlMgr.partitionScaler = newPartitionScaler(taskQueue, taskQueueConfig, newPartitionDescribeFn(tlMgr), db.UpdatePartitions, tlMgr.logger, tlMgr.shutdownCh)
+	}
 	tlMgr.startWG.Add(1)
 	return tlMgr, nil
 }
@@ -199,31 +220,56 @@ func (c *taskQueueManagerImpl) Start() error {
 		return err
 	}
 
+	if err := c.startBacklogLanes(state.backlogLanes); err != nil {
+		c.Stop()
+		return err
+	}
+
 	c.taskAckManager.setAckLevel(state.ackLevel)
 	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
 	c.taskReader.Start()
+	if c.partitionScaler != nil {
+		c.partitionScaler.Start(state.readPartitions, state.writePartitions)
+	}
 
 	return nil
 }
 
 // Stops pump that fills up taskBuffer from persistence.
 func (c *taskQueueManagerImpl) Stop() {
-	if !atomic.CompareAndSwapInt32(&c.stopped, 0, 1) {
+	if !c.stop() {
 		return
 	}
-	close(c.shutdownCh)
-	c.taskWriter.Stop()
-	c.taskReader.Stop()
+	if c.backlogOf != nil {
+		// the task queue can't dispatch the tasks of the lane anymore
+		c.backlogOf.Stop()
+		return
+	}
+	c.stopBacklogLanes()
 	c.engine.removeTaskQueueManager(c.taskQueueID)
 	c.engine.removeTaskQueueManager(c.taskQueueID)
 	c.logger.Info("", tag.LifeCycleStopped)
 }
 
+// stop stops the writer and the reader of the task queue, it returns false if they were already stopped
+func (c *taskQueueManagerImpl) stop() bool {
+	if !atomic.CompareAndSwapInt32(&c.stopped, 0, 1) {
+		return false
+	}
+	close(c.shutdownCh)
+	c.taskWriter.Stop()
+	c.taskReader.Stop()
+	return true
+}
+
 // AddTask adds a task to the task queue. This method will first attempt a synchronous
 // match with a poller. When there are no pollers or if ratelimit is exceeded, task will
 // be written to database and later asynchronously matched with a poller
 func (c *taskQueueManagerImpl) AddTask(ctx context.Context, params addTaskParams) (bool, error) {
 	c.startWG.Wait()
+	if params.forwardedFrom == "" && c.partitionScaler != nil {
+		c.partitionScaler.RecordAdd()
+	}
 	var syncMatch bool
 	_, err := c.executeWithRetry(func() (interface{}, error) {
 		td := params.taskInfo
@@ -234,14 +280,17 @@ func (c *taskQueueManagerImpl) AddTask(ctx context.Context, params addTaskParams
 		}
 
 		if namespaceEntry.GetNamespaceNotActiveErr() != nil {
-			r, err := c.taskWriter.appendTask(params.execution, td)
+			r, err := c.appendTask(params.execution, td)
 			syncMatch = false
 			return r, err
 		}
 
-		syncMatch, err = c.trySyncMatch(ctx, params)
-		if syncMatch {
-			return &persistence.CreateTasksResponse{}, err
+		// the backlog tasks of a higher priority are dispatched before the new task
+		if !c.taskReader.taskBuffer.hasPriorityAbove(td.GetPriority()) {
+			syncMatch, err = c.trySyncMatch(ctx, params)
+			if syncMatch {
+				return &persistence.CreateTasksResponse{}, err
+			}
 		}
 
 		if params.forwardedFrom != "" {
@@ -250,10 +299,17 @@ func (c *taskQueueManagerImpl) AddTask(ctx context.Context, params addTaskParams
 			return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 		}
 
-		return c.taskWriter.appendTask(params.execution, params.taskInfo)
+		return c.appendTask(params.execution, params.taskInfo)
 	})
 	if err == nil {
 		c.taskReader.Signal()
+		// the tasks forwarded from a child partition are counted by the child
+		if params.forwardedFrom == "" {
+			c.stats.addRate.inc()
+			if syncMatch {
+				c.stats.syncMatchRate.inc()
+			}
+		}
 	}
 	return syncMatch, err
 }
@@ -291,6 +347,10 @@ func (c *taskQueueManagerImpl) GetTask(
 	}
 	task.namespace = c.namespace()
 	task.backlogCountHint = c.taskAckManager.getBacklogCountHint()
+	// the tasks received from a parent partition are counted by the parent
+	if !task.isStarted() && !task.isQuery() {
+		c.stats.dispatchRate.inc()
+	}
 	return task, nil
 }
 
@@ -318,7 +378,8 @@ func (c *taskQueueManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 
 	identity, ok := ctx.Value(identityKey).(string)
 	if ok && identity != "" {
-		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
+		buildID, _ := ctx.Value(buildIDKey).(string)
+		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond, buildID)
 	}
 
 	namespaceEntry, err := c.namespaceCache.GetNamespaceByID(c.taskQueueID.namespaceID)
@@ -368,7 +429,7 @@ func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *m
 	response.TaskQueueStatus = &taskqueuepb.TaskQueueStatus{
 		ReadLevel:        c.taskAckManager.getReadLevel(),
 		AckLevel:         c.taskAckManager.getAckLevel(),
-		BacklogCountHint: c.taskAckManager.getBacklogCountHint(),
+		BacklogCountHint: c.approximateBacklogCount(),
 		RatePerSecond:    c.matcher.Rate(),
 		TaskIdBlock: &taskqueuepb.TaskIdBlock{
 			StartId: taskIDBlock.start,
@@ -379,6 +440,64 @@ func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *m
 	return response
 }
 
+// Stats returns the backlog statistics of the task queue partition
+func (c *taskQueueManagerImpl) Stats() *matchingservice.TaskQueueStats {
+	oldestTaskCreateTime := c.taskAckManager.getOldestCreateTime()
+	if _, createTime := c.backlogLanesStats(); !createTime.IsZero() &&
+		(oldestTaskCreateTime.IsZero() || createTime.Before(oldestTaskCreateTime)) {
+		oldestTaskCreateTime = createTime
+	}
+	var oldestTaskCreateTimeNanos int64
+	if !oldestTaskCreateTime.IsZero() {
+		oldestTaskCreateTimeNanos = oldestTaskCreateTime.UnixNano()
+	}
+	return &matchingservice.TaskQueueStats{
+		ApproximateBacklogCount: c.approximateBacklogCount(),
+		OldestTaskCreateTime:    oldestTaskCreateTimeNanos,
+		AddRate:                 c.stats.addRate.rate(),
+		DispatchRate:            c.stats.dispatchRate.rate(),
+		SyncMatchRate:           c.stats.syncMatchRate.rate(),
+		PollerBuildIds:          c.pollerHistory.getPollerBuildIDs(),
+	}
+}
+
+// approximateBacklogCount returns the number of tasks read from the database and not yet acked, plus the
+// number of tasks written after the read level, including the backlog lanes. The task IDs are allocated
+// in consecutive blocks, so the tasks written after the read level are approximated by the distance to
+// the max read level.
+func (c *taskQueueManagerImpl) approximateBacklogCount() int64 {
+	unread := c.taskWriter.GetMaxReadLevel() - c.taskAckManager.getReadLevel()
+	if unread < 0 {
+		unread = 0
+	}
+	lanesCount, _ := c.backlogLanesStats()
+	return c.taskAckManager.getBacklogCountHint() + unread + lanesCount
+}
+
+// emitStats emits the backlog statistics of the task queue partition
+func (c *taskQueueManagerImpl) emitStats() {
+	stats := c.Stats()
+	scope := c.metricScope()
+	scope.UpdateGauge(metrics.ApproximateBacklogCountPerTaskQueueGauge, float64(stats.ApproximateBacklogCount))
+	var oldestTaskAge time.Duration
+	if stats.OldestTaskCreateTime != 0 {
+		oldestTaskAge = time.Since(time.Unix(0, stats.OldestTaskCreateTime))
+	}
+	scope.UpdateGauge(metrics.OldestTaskAgePerTaskQueueGauge, oldestTaskAge.Seconds())
+	scope.UpdateGauge(metrics.AddRatePerTaskQueueGauge, stats.AddRate)
+	scope.UpdateGauge(metrics.DispatchRatePerTaskQueueGauge, stats.DispatchRate)
+	scope.UpdateGauge(metrics.SyncMatchRatePerTaskQueueGauge, stats.SyncMatchRate)
+}
+
+// Partitions returns the number of read and write partitions of the task queue scaled by this root partition,
+// zeros when the partitions are not autoscaled
+func (c *taskQueueManagerImpl) Partitions() (int, int) {
+	if c.partitionScaler == nil || !c.config.EnablePartitionAutoscaling() {
+		return 0, 0
+	}
+	return c.partitionScaler.Partitions()
+}
+
 func (c *taskQueueManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
@@ -443,7 +562,9 @@ func (c *taskQueueManagerImpl) renewLeaseWithRetry() (taskQueueState, error) {
 	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 	if err != nil {
 		c.metricScope().IncCounter(metrics.LeaseFailurePerTaskQueueCounter)
-		c.engine.unloadTaskQueue(c.taskQueueID)
+		if c.backlogOf == nil {
+			c.engine.unloadTaskQueue(c.taskQueueID)
+		}
 		return newState, err
 	}
 	return newState, nil
@@ -542,6 +663,9 @@ func (c *taskQueueManagerImpl) isFowardingAllowed(taskQueue *taskQueueID, kind e
 }
 
 func (c *taskQueueManagerImpl) metricScope() metrics.Scope {
+	if c.backlogOf != nil {
+		return c.backlogOf.metricScope()
+	}
 	c.tryInitNamespaceAndScope()
 	return c.metricScopeValue.Load().(metrics.Scope)
 }
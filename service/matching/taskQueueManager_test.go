@@ -50,12 +50,13 @@ func TestDeliverBufferTasks(t *testing.T) {
 	defer controller.Finish()
 
 	tests := []func(tlm *taskQueueManagerImpl){
-		func(tlm *taskQueueManagerImpl) { close(tlm.taskReader.taskBuffer) },
+		func(tlm *taskQueueManagerImpl) { tlm.taskReader.taskBuffer.close() },
 		func(tlm *taskQueueManagerImpl) { close(tlm.taskReader.dispatcherShutdownC) },
 		func(tlm *taskQueueManagerImpl) {
 			rps := 0.1
 			tlm.matcher.UpdateRatelimit(&rps)
-			tlm.taskReader.taskBuffer <- &persistenceblobs.AllocatedTaskInfo{}
+			tlm.taskReader.taskBuffer.slotC <- struct{}{}
+			tlm.taskReader.taskBuffer.push(&persistenceblobs.AllocatedTaskInfo{})
 			_, err := tlm.matcher.ratelimit(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.cancelFunc()
@@ -80,7 +81,8 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	defer controller.Finish()
 
 	tlm := createTestTaskQueueManager(controller)
-	tlm.taskReader.taskBuffer <- &persistenceblobs.AllocatedTaskInfo{}
+	tlm.taskReader.taskBuffer.slotC <- struct{}{}
+	tlm.taskReader.taskBuffer.push(&persistenceblobs.AllocatedTaskInfo{})
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -199,7 +201,7 @@ func TestDescribeTaskQueue(t *testing.T) {
 	tlm.taskAckManager.setAckLevel(tlm.db.ackLevel)
 
 	for i := int64(0); i < taskCount; i++ {
-		tlm.taskAckManager.addTask(startTaskID + i)
+		tlm.taskAckManager.addTask(startTaskID+i, time.Now())
 	}
 
 	includeTaskStatus := false
@@ -220,7 +222,7 @@ func TestDescribeTaskQueue(t *testing.T) {
 	require.Equal(t, tlm.config.RangeSize, taskIDBlock.GetEndId())
 
 	// Add a poller and complete all tasks
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil, "")
 	for i := int64(0); i < taskCount; i++ {
 		tlm.taskAckManager.completeTask(startTaskID + i)
 	}
@@ -232,7 +234,7 @@ func TestDescribeTaskQueue(t *testing.T) {
 	require.True(t, descResp.Pollers[0].GetRatePerSecond() > (_defaultTaskDispatchRPS-1))
 
 	rps := 5.0
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps, "")
 	descResp = tlm.DescribeTaskQueue(includeTaskStatus)
 	require.Equal(t, 1, len(descResp.GetPollers()))
 	require.Equal(t, PollerIdentity, descResp.Pollers[0].GetIdentity())
@@ -266,7 +268,7 @@ func TestCheckIdleTaskQueue(t *testing.T) {
 
 	// Active poll-er
 	tlm = createTestTaskQueueManagerWithConfig(controller, cfg)
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil, "")
 	require.Equal(t, 1, len(tlm.GetAllPollerInfo()))
 	tlMgrStartWithoutNotifyEvent(tlm)
 	time.Sleep(20 * time.Millisecond)
@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+)
+
+const (
+	// taskQueueRateWindow is the window over which the recent rates of a task queue are computed
+	taskQueueRateWindow = time.Minute
+	// taskQueueRateBuckets is the number of buckets the rate window is divided in
+	taskQueueRateBuckets = 6
+)
+
+type (
+	// rateCounter counts events in the buckets of a sliding window to compute their recent rate
+	rateCounter struct {
+		sync.Mutex
+		buckets     [taskQueueRateBuckets]int64
+		current     int
+		bucketStart time.Time
+		startTime   time.Time
+		timeSource  func() time.Time
+	}
+
+	// taskQueueStats tracks the recent rates of a task queue partition
+	taskQueueStats struct {
+		addRate       *rateCounter
+		dispatchRate  *rateCounter
+		syncMatchRate *rateCounter
+	}
+)
+
+// mergeTaskQueueStats adds the statistics of another partition of the task queue to stats
+func mergeTaskQueueStats(stats *matchingservice.TaskQueueStats, other *matchingservice.TaskQueueStats) {
+	if other == nil {
+		return
+	}
+	stats.ApproximateBacklogCount += other.ApproximateBacklogCount
+	if other.OldestTaskCreateTime != 0 &&
+		(stats.OldestTaskCreateTime == 0 || other.OldestTaskCreateTime < stats.OldestTaskCreateTime) {
+		stats.OldestTaskCreateTime = other.OldestTaskCreateTime
+	}
+	stats.AddRate += other.AddRate
+	stats.DispatchRate += other.DispatchRate
+	stats.SyncMatchRate += other.SyncMatchRate
+	// a poller polls every partition, it is reported once
+	identities := make(map[string]struct{}, len(stats.PollerBuildIds))
+	for _, poller := range stats.PollerBuildIds {
+		identities[poller.GetIdentity()] = struct{}{}
+	}
+	for _, poller := range other.PollerBuildIds {
+		if _, ok := identities[poller.GetIdentity()]; !ok {
+			identities[poller.GetIdentity()] = struct{}{}
+			stats.PollerBuildIds = append(stats.PollerBuildIds, poller)
+		}
+	}
+}
+
+func newRateCounter(timeSource func() time.Time) *rateCounter {
+	now := timeSource()
+	return &rateCounter{
+		bucketStart: now,
+		startTime:   now,
+		timeSource:  timeSource,
+	}
+}
+
+func newTaskQueueStats() *taskQueueStats {
+	return &taskQueueStats{
+		addRate:       newRateCounter(time.Now),
+		dispatchRate:  newRateCounter(time.Now),
+		syncMatchRate: newRateCounter(time.Now),
+	}
+}
+
+// inc counts an event
+func (r *rateCounter) inc() {
+	r.Lock()
+	defer r.Unlock()
+	r.advance()
+	r.buckets[r.current]++
+}
+
+// rate returns the rate per second of the events of the window
+func (r *rateCounter) rate() float64 {
+	r.Lock()
+	defer r.Unlock()
+	r.advance()
+
+	var count int64
+	for _, bucket := range r.buckets {
+		count += bucket
+	}
+	// the window is shorter until the counter is older than the window
+	window := r.timeSource().Sub(r.startTime)
+	if window > taskQueueRateWindow {
+		window = taskQueueRateWindow
+	}
+	if window < time.Second {
+		window = time.Second
+	}
+	return float64(count) / window.Seconds()
+}
+
+func (r *rateCounter) advance() {
+	now := r.timeSource()
+	bucketSize := taskQueueRateWindow / taskQueueRateBuckets
+	if now.Sub(r.bucketStart) >= taskQueueRateWindow {
+		r.buckets = [taskQueueRateBuckets]int64{}
+		r.bucketStart = now
+		return
+	}
+	for now.Sub(r.bucketStart) >= bucketSize {
+		r.current = (r.current + 1) % taskQueueRateBuckets
+		r.buckets[r.current] = 0
+		r.bucketStart = r.bucketStart.Add(bucketSize)
+	}
+}
@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+)
+
+func TestRateCounter(t *testing.T) {
+	now := time.Now()
+	counter := newRateCounter(func() time.Time { return now })
+
+	for i := 0; i < 10; i++ {
+		counter.inc()
+	}
+	assert.Equal(t, 10.0, counter.rate(), "the window is at least one second")
+
+	now = now.Add(5 * time.Second)
+	assert.Equal(t, 2.0, counter.rate())
+
+	now = now.Add(25 * time.Second)
+	for i := 0; i < 50; i++ {
+		counter.inc()
+	}
+	assert.Equal(t, 2.0, counter.rate())
+
+	now = now.Add(40 * time.Second)
+	assert.InDelta(t, 50.0/60, counter.rate(), 0.001, "the first bucket left the window")
+
+	now = now.Add(2 * time.Minute)
+	assert.Equal(t, 0.0, counter.rate())
+}
+
+func TestMergeTaskQueueStats(t *testing.T) {
+	stats := &matchingservice.TaskQueueStats{
+		ApproximateBacklogCount: 10,
+		OldestTaskCreateTime:    200,
+		AddRate:                 1,
+		DispatchRate:            2,
+		SyncMatchRate:           0.5,
+		PollerBuildIds:          []*matchingservice.PollerBuildId{{Identity: "worker-1", BuildId: "1.0"}},
+	}
+	mergeTaskQueueStats(stats, &matchingservice.TaskQueueStats{
+		ApproximateBacklogCount: 5,
+		OldestTaskCreateTime:    100,
+		AddRate:                 1,
+		PollerBuildIds: []*matchingservice.PollerBuildId{
+			{Identity: "worker-1", BuildId: "1.0"},
+			{Identity: "worker-2", BuildId: "2.0"},
+		},
+	})
+	mergeTaskQueueStats(stats, &matchingservice.TaskQueueStats{})
+	mergeTaskQueueStats(stats, nil)
+
+	assert.Equal(t, &matchingservice.TaskQueueStats{
+		ApproximateBacklogCount: 15,
+		OldestTaskCreateTime:    100,
+		AddRate:                 2,
+		DispatchRate:            2,
+		SyncMatchRate:           0.5,
+		PollerBuildIds: []*matchingservice.PollerBuildId{
+			{Identity: "worker-1", BuildId: "1.0"},
+			{Identity: "worker-2", BuildId: "2.0"},
+		},
+	}, stats)
+}
@@ -29,8 +29,11 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/gogo/protobuf/types"
+
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -40,8 +43,9 @@ import (
 
 type (
 	taskReader struct {
-		taskBuffer chan *persistenceblobs.AllocatedTaskInfo // tasks loaded from persistence
-		notifyC    chan struct{}                            // Used as signal to notify pump of new tasks
+		taskBuffer *taskBuffer   // tasks loaded from persistence, shared with the backlog lanes
+		slotC      chan struct{} // the slots of the buffer to acquire before pushing a task
+		notifyC    chan struct{} // Used as signal to notify pump of new tasks
 		tlMgr      *taskQueueManagerImpl
 		// The cancel objects are to cancel the ratelimiter Wait in dispatchBufferedTasks. The ideal
 		// approach is to use request-scoped contexts and use a unique one for each call to Wait. However
@@ -58,21 +62,32 @@ type (
 
 func newTaskReader(tlMgr *taskQueueManagerImpl) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
-	return &taskReader{
+	tr := &taskReader{
 		tlMgr:               tlMgr,
 		cancelCtx:           ctx,
 		cancelFunc:          cancel,
 		notifyC:             make(chan struct{}, 1),
 		dispatcherShutdownC: make(chan struct{}),
-		// we always dequeue the head of the buffer and try to dispatch it to a poller
-		// so allocate one less than desired target buffer size
-		taskBuffer: make(chan *persistenceblobs.AllocatedTaskInfo, tlMgr.config.GetTasksBatchSize()-1),
 	}
+	// we always dequeue the head of the buffer and try to dispatch it to a poller
+	// so allocate one less than desired target buffer size
+	capacity := tlMgr.config.GetTasksBatchSize() - 1
+	if tlMgr.backlogOf != nil {
+		// the tasks of a backlog lane are dispatched by its task queue
+		tr.taskBuffer = tlMgr.backlogOf.taskReader.taskBuffer
+		tr.slotC = make(chan struct{}, common.MaxInt(1, capacity))
+	} else {
+		tr.taskBuffer = newTaskBuffer(capacity)
+		tr.slotC = tr.taskBuffer.slotC
+	}
+	return tr
 }
 
 func (tr *taskReader) Start() {
 	tr.Signal()
-	go tr.dispatchBufferedTasks()
+	if tr.tlMgr.backlogOf == nil {
+		go tr.dispatchBufferedTasks()
+	}
 	go tr.getTasksPump()
 }
 
@@ -92,35 +107,39 @@ func (tr *taskReader) Signal() {
 func (tr *taskReader) dispatchBufferedTasks() {
 dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task queue getTasks pump is shutdown
-				break dispatchLoop
+		// the buffered task of the highest priority is dispatched first
+		taskInfo, ok := tr.taskBuffer.take(tr.dispatcherShutdownC)
+		if !ok { // Task queue getTasks pump or dispatcher is shutdown
+			break dispatchLoop
+		}
+		completeFn := tr.tlMgr.completeTask
+		if taskInfo.reader != nil {
+			// the task is acked in the backlog lane it was read from
+			completeFn = taskInfo.reader.tlMgr.completeTask
+		}
+		task := newInternalTask(taskInfo.AllocatedTaskInfo, completeFn, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+		for {
+			err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
+			if err == nil {
+				break
 			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
-			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
-				if err == nil {
-					break
-				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
-					break dispatchLoop
-				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottlePerTaskQueueCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				runtime.Gosched()
+			if err == context.Canceled {
+				tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
+				break dispatchLoop
 			}
-		case <-tr.dispatcherShutdownC:
-			break dispatchLoop
+			// this should never happen unless there is a bug - don't drop the task
+			tr.scope().IncCounter(metrics.BufferThrottlePerTaskQueueCounter)
+			tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+			runtime.Gosched()
 		}
 	}
 }
 
 func (tr *taskReader) getTasksPump() {
 	tr.tlMgr.startWG.Wait()
-	defer close(tr.taskBuffer)
+	if tr.tlMgr.backlogOf == nil {
+		defer tr.taskBuffer.close()
+	}
 
 	updateAckTimer := time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 	checkIdleTaskQueueTimer := time.NewTimer(tr.tlMgr.config.IdleTaskqueueCheckInterval())
@@ -169,6 +188,9 @@ getTasksPumpLoop:
 					}
 					// keep going as saving ack is not critical
 				}
+				if tr.tlMgr.backlogOf == nil {
+					tr.tlMgr.emitStats()
+				}
 				tr.Signal() // periodically signal pump to check persistence for tasks
 				updateAckTimer = time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 			}
@@ -225,10 +247,15 @@ func (tr *taskReader) getTaskBatch() ([]*persistenceblobs.AllocatedTaskInfo, int
 }
 
 func (tr *taskReader) isIdle(lastWriteTime time.Time) bool {
+	if tr.tlMgr.backlogOf != nil {
+		// the backlog lanes are released by their task queue when it is idle
+		return false
+	}
 	return !tr.isTaskAddedRecently(lastWriteTime) && len(tr.tlMgr.GetAllPollerInfo()) == 0
 }
 
 func (tr *taskReader) handleIdleTimeout() {
+	tr.tlMgr.releaseBacklogLanes()
 	_ = tr.persistAckLevel()
 	tr.tlMgr.taskGC.RunNow(tr.tlMgr.taskAckManager.getAckLevel())
 	tr.tlMgr.Stop()
@@ -252,10 +279,13 @@ func (tr *taskReader) addTasksToBuffer(tasks []*persistenceblobs.AllocatedTaskIn
 
 func (tr *taskReader) addSingleTaskToBuffer(
 	task *persistenceblobs.AllocatedTaskInfo, lastWriteTime time.Time, idleTimer *time.Timer) bool {
-	tr.tlMgr.taskAckManager.addTask(task.GetTaskId())
+	// tasks without a valid creation time don't count in the age of the backlog
+	createTime, _ := types.TimestampFromProto(task.GetData().GetCreatedTime())
+	tr.tlMgr.taskAckManager.addTask(task.GetTaskId(), createTime)
 	for {
 		select {
-		case tr.taskBuffer <- task:
+		case tr.slotC <- struct{}{}:
+			tr.taskBuffer.pushFrom(task, tr)
 			return true
 		case <-idleTimer.C:
 			if tr.isIdle(lastWriteTime) {
@@ -0,0 +1,381 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalbackfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+
+	archiverproto "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log/tag"
+	p "go.temporal.io/server/common/persistence"
+	warchiver "go.temporal.io/server/service/worker/archiver"
+)
+
+type (
+	contextKey string
+
+	// scanState is the heartbeat of the backfill activity, a retried activity resumes from it
+	scanState struct {
+		PageToken []byte
+		Progress  Progress
+	}
+
+	// archivalTargets are the archivers and URIs the executions of the namespace are archived into,
+	// a nil archiver means the archival is disabled for the namespace
+	archivalTargets struct {
+		historyURI         archiver.URI
+		historyArchiver    archiver.HistoryArchiver
+		visibilityURI      archiver.URI
+		visibilityArchiver archiver.VisibilityArchiver
+	}
+)
+
+var (
+	errVisibilityUnknown = errors.New("archived visibility record can not be looked up")
+)
+
+const (
+	backfillerContextKey = contextKey("archivalBackfillerContext")
+
+	visibilityPageSize = 100
+	// activityBatchDuration is how long an activity scans before returning its progress to the workflow
+	activityBatchDuration = 5 * time.Minute
+	// archivedVisibilityLookupMaxPages bounds the pages read to find the archived visibility record of an execution
+	archivedVisibilityLookupMaxPages = 10
+	archivalTimeout                  = time.Minute
+)
+
+// BackfillActivity scans a batch of the closed executions of the namespace, starting from the page token
+// of the params, and archives those missing from the archival. It returns the progress of the batch and
+// the page token the next batch starts from.
+func BackfillActivity(ctx context.Context, params BackfillActivityParams) (BackfillResult, error) {
+	bctx := ctx.Value(backfillerContextKey).(*backfillerContext)
+	state := &scanState{PageToken: params.PageToken}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, state); err != nil {
+			return BackfillResult{}, err
+		}
+	}
+
+	targets, err := getArchivalTargets(bctx, params.NamespaceID)
+	if err != nil {
+		return BackfillResult{}, err
+	}
+
+	request := &p.ListWorkflowExecutionsRequest{
+		NamespaceID:       params.NamespaceID,
+		Namespace:         params.Namespace,
+		EarliestStartTime: 0,
+		LatestStartTime:   time.Now().UnixNano(),
+		PageSize:          visibilityPageSize,
+		NextPageToken:     state.PageToken,
+	}
+	batchStart := time.Now()
+	for {
+		resp, err := bctx.GetVisibilityManager().ListClosedWorkflowExecutions(request)
+		if err != nil {
+			return BackfillResult{}, err
+		}
+		for _, execution := range resp.Executions {
+			if err := bctx.rateLimiter.Wait(ctx); err != nil {
+				return BackfillResult{}, err
+			}
+			backfillExecution(ctx, bctx, params, targets, execution, &state.Progress)
+		}
+		state.PageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, state)
+		if len(state.PageToken) == 0 || time.Since(batchStart) >= activityBatchDuration {
+			break
+		}
+		request.NextPageToken = state.PageToken
+	}
+	return BackfillResult{Progress: state.Progress, PageToken: state.PageToken}, nil
+}
+
+// getArchivalTargets returns the archivers of the current archival URIs of the namespace
+func getArchivalTargets(bctx *backfillerContext, namespaceID string) (*archivalTargets, error) {
+	entry, err := bctx.GetNamespaceCache().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	config := entry.GetConfig()
+	archivalMetadata := bctx.GetArchivalMetadata()
+	provider := bctx.GetArchiverProvider()
+
+	targets := &archivalTargets{}
+	if archivalMetadata.GetHistoryConfig().ClusterConfiguredForArchival() &&
+		config.HistoryArchivalStatus == enumspb.ARCHIVAL_STATUS_ENABLED {
+		if targets.historyURI, err = archiver.NewURI(config.HistoryArchivalUri); err != nil {
+			return nil, temporal.NewNonRetryableApplicationError("invalid history archival URI", "", err)
+		}
+		if targets.historyArchiver, err = provider.GetHistoryArchiver(targets.historyURI.Scheme(), common.WorkerServiceName); err != nil {
+			return nil, err
+		}
+	}
+	if archivalMetadata.GetVisibilityConfig().ClusterConfiguredForArchival() &&
+		config.VisibilityArchivalStatus == enumspb.ARCHIVAL_STATUS_ENABLED {
+		if targets.visibilityURI, err = archiver.NewURI(config.VisibilityArchivalUri); err != nil {
+			return nil, temporal.NewNonRetryableApplicationError("invalid visibility archival URI", "", err)
+		}
+		if targets.visibilityArchiver, err = provider.GetVisibilityArchiver(targets.visibilityURI.Scheme(), common.WorkerServiceName); err != nil {
+			return nil, err
+		}
+	}
+	if targets.historyArchiver == nil && targets.visibilityArchiver == nil {
+		return nil, temporal.NewNonRetryableApplicationError("archival is not enabled for the namespace", "", nil)
+	}
+	return targets, nil
+}
+
+// backfillExecution archives the history and the visibility record of a closed execution if they are
+// missing from the archival. Failures are counted and logged, they never fail the scan.
+func backfillExecution(
+	ctx context.Context,
+	bctx *backfillerContext,
+	params BackfillActivityParams,
+	targets *archivalTargets,
+	record *workflowpb.WorkflowExecutionInfo,
+	progress *Progress,
+) {
+	progress.Scanned++
+	execution := record.GetExecution()
+	logger := bctx.logger.WithTags(
+		tag.WorkflowNamespace(params.Namespace),
+		tag.WorkflowID(execution.GetWorkflowId()),
+		tag.WorkflowRunID(execution.GetRunId()),
+	)
+
+	if targets.historyArchiver != nil {
+		missing, err := isHistoryMissing(ctx, targets, params.NamespaceID, execution)
+		switch {
+		case err != nil:
+			progress.Failed++
+			logger.Warn("Failed to check archived history.", tag.Error(err))
+		case missing:
+			progress.HistoryMissing++
+			if params.DryRun {
+				break
+			}
+			archived, err := archiveHistory(ctx, bctx, targets, params, execution)
+			switch {
+			case err != nil:
+				progress.Failed++
+				logger.Warn("Failed to archive history.", tag.Error(err))
+			case archived:
+				progress.HistoryArchived++
+			default:
+				progress.Skipped++
+			}
+		}
+	}
+
+	if targets.visibilityArchiver != nil {
+		missing, err := isVisibilityMissing(ctx, targets, params.NamespaceID, execution)
+		switch {
+		case err == errVisibilityUnknown:
+			progress.VisibilityUnknown++
+		case err != nil:
+			progress.Failed++
+			logger.Warn("Failed to check archived visibility record.", tag.Error(err))
+		case missing:
+			progress.VisibilityMissing++
+			if params.DryRun {
+				break
+			}
+			if err := archiveVisibility(ctx, targets, params, record); err != nil {
+				progress.Failed++
+				logger.Warn("Failed to archive visibility record.", tag.Error(err))
+				break
+			}
+			progress.VisibilityArchived++
+		}
+	}
+}
+
+func isHistoryMissing(
+	ctx context.Context,
+	targets *archivalTargets,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, archivalTimeout)
+	defer cancel()
+	_, err := targets.historyArchiver.Get(ctx, targets.historyURI, &archiver.GetHistoryRequest{
+		NamespaceID: namespaceID,
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       execution.GetRunId(),
+		PageSize:    1,
+	})
+	switch err.(type) {
+	case nil:
+		return false, nil
+	case *serviceerror.NotFound:
+		return true, nil
+	default:
+		return false, err
+	}
+}
+
+// isVisibilityMissing returns errVisibilityUnknown if the visibility archiver can not look up the
+// archived visibility records of an execution
+func isVisibilityMissing(
+	ctx context.Context,
+	targets *archivalTargets,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, archivalTimeout)
+	defer cancel()
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: namespaceID,
+		PageSize:    visibilityPageSize,
+		Query:       fmt.Sprintf("WorkflowId = '%s'", strings.ReplaceAll(execution.GetWorkflowId(), "'", "\\'")),
+	}
+	for page := 0; page < archivedVisibilityLookupMaxPages; page++ {
+		resp, err := targets.visibilityArchiver.Query(ctx, targets.visibilityURI, request)
+		if err != nil {
+			if _, ok := err.(*serviceerror.InvalidArgument); ok {
+				return false, errVisibilityUnknown
+			}
+			return false, err
+		}
+		for _, record := range resp.Executions {
+			if record.GetExecution().GetRunId() == execution.GetRunId() {
+				return false, nil
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	return true, nil
+}
+
+// archiveHistory archives the history of a closed execution, it returns false when the execution
+// is gone and its history can no longer be archived
+func archiveHistory(
+	ctx context.Context,
+	bctx *backfillerContext,
+	targets *archivalTargets,
+	params BackfillActivityParams,
+	execution *commonpb.WorkflowExecution,
+) (bool, error) {
+	shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowId(), bctx.cfg.NumHistoryShards)
+	executionManager, err := bctx.GetExecutionManager(shardID)
+	if err != nil {
+		return false, err
+	}
+	resp, err := executionManager.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		NamespaceID: params.NamespaceID,
+		Execution:   *execution,
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+
+	mutableState := resp.State
+	branchToken := mutableState.ExecutionInfo.BranchToken
+	closeFailoverVersion := common.EmptyVersion
+	if mutableState.VersionHistories != nil {
+		versionHistory, err := mutableState.VersionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return false, err
+		}
+		lastItem, err := versionHistory.GetLastItem()
+		if err != nil {
+			return false, err
+		}
+		branchToken = versionHistory.GetBranchToken()
+		closeFailoverVersion = lastItem.GetVersion()
+	} else if mutableState.ReplicationState != nil {
+		closeFailoverVersion = mutableState.ReplicationState.LastWriteVersion
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, archivalTimeout)
+	defer cancel()
+	if err := targets.historyArchiver.Archive(ctx, targets.historyURI, &archiver.ArchiveHistoryRequest{
+		ShardID:              shardID,
+		NamespaceID:          params.NamespaceID,
+		Namespace:            params.Namespace,
+		WorkflowID:           execution.GetWorkflowId(),
+		RunID:                execution.GetRunId(),
+		BranchToken:          branchToken,
+		NextEventID:          mutableState.ExecutionInfo.NextEventID,
+		CloseFailoverVersion: closeFailoverVersion,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func archiveVisibility(
+	ctx context.Context,
+	targets *archivalTargets,
+	params BackfillActivityParams,
+	record *workflowpb.WorkflowExecutionInfo,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, archivalTimeout)
+	defer cancel()
+	return targets.visibilityArchiver.Archive(ctx, targets.visibilityURI, &archiverproto.ArchiveVisibilityRequest{
+		NamespaceId:        params.NamespaceID,
+		Namespace:          params.Namespace,
+		WorkflowId:         record.GetExecution().GetWorkflowId(),
+		RunId:              record.GetExecution().GetRunId(),
+		WorkflowTypeName:   record.GetType().GetName(),
+		StartTimestamp:     record.GetStartTime().GetValue(),
+		ExecutionTimestamp: record.GetExecutionTime(),
+		CloseTimestamp:     record.GetCloseTime().GetValue(),
+		Status:             record.GetStatus(),
+		HistoryLength:      record.GetHistoryLength(),
+		Memo:               record.GetMemo(),
+		SearchAttributes:   warchiver.ConvertSearchAttributesToString(record.GetSearchAttributes().GetIndexedFields()),
+		HistoryArchivalUri: targets.historyURIString(),
+	})
+}
+
+func (t *archivalTargets) historyURIString() string {
+	if t.historyURI == nil {
+		return ""
+	}
+	return t.historyURI.String()
+}
@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalbackfill
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	// Config defines the configuration for the archival backfiller
+	Config struct {
+		// NumHistoryShards is the number of history shards, used to locate the mutable state of executions
+		NumHistoryShards int
+		// RPS is the max rate of closed executions checked and archived
+		RPS dynamicconfig.IntPropertyFn
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the archival backfiller sub-system
+	BootstrapParams struct {
+		// Config contains the configuration for the archival backfiller
+		Config Config
+	}
+
+	// backfillerContext is the context object that gets
+	// passed around within the backfill workflows / activities
+	backfillerContext struct {
+		resource.Resource
+		cfg         Config
+		rateLimiter quotas.Limiter
+		logger      log.Logger
+	}
+
+	// Backfiller is the background sub-system that runs the workflows which archive the closed
+	// executions of a namespace missing from its archival
+	Backfiller struct {
+		context *backfillerContext
+	}
+)
+
+// New returns a new instance of the archival backfiller
+func New(
+	resource resource.Resource,
+	params *BootstrapParams,
+) *Backfiller {
+
+	cfg := params.Config
+	logger := resource.GetLogger().WithTags(tag.ComponentArchivalBackfiller)
+	return &Backfiller{
+		context: &backfillerContext{
+			Resource: resource,
+			cfg:      cfg,
+			rateLimiter: quotas.NewDynamicRateLimiter(func() float64 {
+				return float64(cfg.RPS())
+			}),
+			logger: logger,
+		},
+	}
+}
+
+// Start starts the archival backfiller
+func (b *Backfiller) Start() error {
+	workerOpts := worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), backfillerContextKey, b.context),
+	}
+	backfillWorker := worker.New(b.context.GetSDKClient(), TaskQueueName, workerOpts)
+	backfillWorker.RegisterWorkflowWithOptions(BackfillArchivalWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	backfillWorker.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+
+	return backfillWorker.Start()
+}
@@ -0,0 +1,171 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalbackfill
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	// TaskQueueName is the task queue of the archival backfill workflows
+	TaskQueueName = "temporal-sys-archival-backfill-taskqueue"
+	// WorkflowTypeName is the workflow type of the archival backfill workflows
+	WorkflowTypeName = "temporal-sys-archival-backfill-workflow"
+	// WorkflowIDPrefix is the prefix of the backfill workflow ID, followed by the name of the namespace
+	WorkflowIDPrefix = "temporal-sys-archival-backfill"
+	// ProgressQueryType is the query type which returns the Progress of a backfill workflow
+	ProgressQueryType = "progress"
+
+	backfillActivityName = "temporal-sys-archival-backfill-activity"
+
+	// InfiniteDuration is a long duration (20 yrs) we use for infinite timeouts
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+
+	scanHeartbeatTimeout = time.Minute
+	// maxActivitiesPerRun bounds the size of the history of a run, the workflow continues as new after it
+	maxActivitiesPerRun = 500
+)
+
+type (
+	// BackfillParams is the input of the archival backfill workflow
+	BackfillParams struct {
+		Namespace   string
+		NamespaceID string
+		// DryRun only counts the executions missing from the archival, nothing is archived
+		DryRun bool
+		// PageToken and Progress carry the scan over to the next run of the workflow
+		PageToken []byte
+		Progress  Progress
+	}
+
+	// BackfillActivityParams is the input of the backfill activity, which resumes the scan from PageToken
+	BackfillActivityParams struct {
+		Namespace   string
+		NamespaceID string
+		DryRun      bool
+		PageToken   []byte
+	}
+
+	// BackfillResult is the result of a backfill activity, the scan is done when PageToken is empty
+	BackfillResult struct {
+		Progress  Progress
+		PageToken []byte
+	}
+
+	// Progress reports how far the backfill of a namespace went
+	Progress struct {
+		// Scanned is the number of closed executions checked against the archival
+		Scanned int64
+		// HistoryMissing and VisibilityMissing are the numbers of executions found missing from the archival
+		HistoryMissing    int64
+		VisibilityMissing int64
+		// VisibilityUnknown is the number of executions whose archived visibility record can not be looked up
+		// because the visibility archiver does not support queries by workflow ID, they are not archived
+		VisibilityUnknown int64
+		// HistoryArchived and VisibilityArchived are the numbers of missing executions archived, always 0 for a dry run
+		HistoryArchived    int64
+		VisibilityArchived int64
+		// Skipped is the number of executions whose history is missing but can no longer be archived
+		// because the execution was deleted in the meantime
+		Skipped int64
+		// Failed is the number of executions which failed to be checked or archived
+		Failed int64
+		Done   bool
+	}
+)
+
+var (
+	retryForeverPolicy = temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+	}
+
+	scanActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: InfiniteDuration,
+		StartToCloseTimeout:    InfiniteDuration,
+		HeartbeatTimeout:       scanHeartbeatTimeout,
+		RetryPolicy:            &retryForeverPolicy,
+	}
+)
+
+// BackfillArchivalWorkflow scans the closed executions of a namespace which are still within retention and
+// archives the history and visibility record of those missing at the current archival URIs of the namespace.
+// The progress is returned by the ProgressQueryType query.
+func BackfillArchivalWorkflow(ctx workflow.Context, params BackfillParams) (Progress, error) {
+	progress := params.Progress
+	if err := workflow.SetQueryHandler(ctx, ProgressQueryType, func() (Progress, error) {
+		return progress, nil
+	}); err != nil {
+		return progress, err
+	}
+
+	scanCtx := workflow.WithActivityOptions(ctx, scanActivityOptions)
+	pageToken := params.PageToken
+	for i := 0; i < maxActivitiesPerRun; i++ {
+		activityParams := BackfillActivityParams{
+			Namespace:   params.Namespace,
+			NamespaceID: params.NamespaceID,
+			DryRun:      params.DryRun,
+			PageToken:   pageToken,
+		}
+		var result BackfillResult
+		if err := workflow.ExecuteActivity(scanCtx, backfillActivityName, activityParams).Get(ctx, &result); err != nil {
+			return progress, err
+		}
+		progress.add(result.Progress)
+		if len(result.PageToken) == 0 {
+			progress.Done = true
+			workflow.GetLogger(ctx).Info("Archival backfill completed.",
+				zap.String("namespace", params.Namespace), zap.Int64("scanned", progress.Scanned))
+			return progress, nil
+		}
+		pageToken = result.PageToken
+	}
+
+	params.PageToken = pageToken
+	params.Progress = progress
+	return progress, workflow.NewContinueAsNewError(ctx, WorkflowTypeName, params)
+}
+
+// GetWorkflowID returns the ID of the backfill workflow of a namespace
+func GetWorkflowID(namespace string) string {
+	return WorkflowIDPrefix + "-" + namespace
+}
+
+func (p *Progress) add(delta Progress) {
+	p.Scanned += delta.Scanned
+	p.HistoryMissing += delta.HistoryMissing
+	p.VisibilityMissing += delta.VisibilityMissing
+	p.VisibilityUnknown += delta.VisibilityUnknown
+	p.HistoryArchived += delta.HistoryArchived
+	p.VisibilityArchived += delta.VisibilityArchived
+	p.Skipped += delta.Skipped
+	p.Failed += delta.Failed
+}
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalbackfill
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type workflowSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env    *testsuite.TestWorkflowEnvironment
+	params BackfillParams
+}
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.params = BackfillParams{Namespace: "test-namespace", NamespaceID: "test-namespace-id", DryRun: true}
+	s.env = s.NewTestWorkflowEnvironment()
+	s.env.RegisterWorkflowWithOptions(BackfillArchivalWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.env.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+}
+
+func (s *workflowSuite) TearDownTest() {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestBackfill() {
+	var pageTokens [][]byte
+	results := []BackfillResult{
+		{Progress: Progress{Scanned: 100, HistoryMissing: 10, VisibilityMissing: 5}, PageToken: []byte("token-1")},
+		{Progress: Progress{Scanned: 50, HistoryMissing: 2, VisibilityUnknown: 3, Failed: 1}},
+	}
+	s.env.OnActivity(backfillActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params BackfillActivityParams) (BackfillResult, error) {
+			s.Equal(s.params.NamespaceID, params.NamespaceID)
+			s.True(params.DryRun)
+			pageTokens = append(pageTokens, params.PageToken)
+			result := results[0]
+			results = results[1:]
+			return result, nil
+		}).Times(2)
+
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	s.Equal([][]byte{nil, []byte("token-1")}, pageTokens)
+	var progress Progress
+	s.NoError(s.env.GetWorkflowResult(&progress))
+	s.Equal(Progress{Scanned: 150, HistoryMissing: 12, VisibilityMissing: 5, VisibilityUnknown: 3, Failed: 1, Done: true}, progress)
+
+	value, err := s.env.QueryWorkflow(ProgressQueryType)
+	s.NoError(err)
+	var queried Progress
+	s.NoError(value.Get(&queried))
+	s.Equal(progress, queried)
+}
+
+func (s *workflowSuite) TestBackfill_ContinueAsNew() {
+	s.env.OnActivity(backfillActivityName, mock.Anything, mock.Anything).Return(BackfillResult{
+		Progress:  Progress{Scanned: 1, HistoryArchived: 1},
+		PageToken: []byte("token"),
+	}, nil).Times(maxActivitiesPerRun)
+
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params)
+	s.True(s.env.IsWorkflowCompleted())
+	var continueAsNew *workflow.ContinueAsNewError
+	s.True(errors.As(s.env.GetWorkflowError(), &continueAsNew), "Called ContinueAsNew")
+}
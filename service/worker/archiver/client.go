@@ -258,7 +258,7 @@ func (c *client) archiveVisibilityInline(ctx context.Context, request *ClientReq
 		Status:             request.ArchiveRequest.Status,
 		HistoryLength:      request.ArchiveRequest.HistoryLength,
 		Memo:               request.ArchiveRequest.Memo,
-		SearchAttributes:   convertSearchAttributesToString(request.ArchiveRequest.SearchAttributes),
+		SearchAttributes:   ConvertSearchAttributesToString(request.ArchiveRequest.SearchAttributes),
 		HistoryArchivalUri: request.ArchiveRequest.HistoryURI,
 	})
 }
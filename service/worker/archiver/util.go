@@ -100,7 +100,8 @@ func tagLoggerWithActivityInfo(logger log.Logger, activityInfo activity.Info) lo
 		tag.Attempt(activityInfo.Attempt))
 }
 
-func convertSearchAttributesToString(searchAttr map[string]*commonpb.Payload) map[string]string {
+// ConvertSearchAttributesToString decodes the indexed search attributes into the string map of the archived visibility records
+func ConvertSearchAttributesToString(searchAttr map[string]*commonpb.Payload) map[string]string {
 	searchAttrStr := make(map[string]string)
 	for k, v := range searchAttr {
 		var s string
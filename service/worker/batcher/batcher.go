@@ -33,10 +33,13 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
@@ -46,6 +49,10 @@ type (
 		AdminOperationToken dynamicconfig.StringPropertyFn
 		// ClusterMetadata contains the metadata for this cluster
 		ClusterMetadata cluster.Metadata
+		// RPS is the rate limit of all batch operations for the whole cluster, zero means no limit
+		RPS dynamicconfig.IntPropertyFn
+		// LatencyThreshold is the request latency above which batch operations slow down
+		LatencyThreshold dynamicconfig.DurationPropertyFn
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -65,24 +72,29 @@ type (
 	// Batcher is the background sub-system that execute workflow for batch operations
 	// It is also the context object that get's passed around within the scanner workflows / activities
 	Batcher struct {
+		resource      resource.Resource
 		cfg           Config
 		svcClient     sdkclient.Client
 		clientBean    client.Bean
 		metricsClient metrics.Client
 		logger        log.Logger
+		rateLimiter   quotas.Limiter
 	}
 )
 
 // New returns a new instance of batcher daemon Batcher
-func New(params *BootstrapParams) *Batcher {
+func New(resource resource.Resource, params *BootstrapParams) *Batcher {
 	cfg := params.Config
-	return &Batcher{
+	batcher := &Batcher{
+		resource:      resource,
 		cfg:           cfg,
 		svcClient:     params.ServiceClient,
 		metricsClient: params.MetricsClient,
 		logger:        params.Logger.WithTags(tag.ComponentBatcher),
 		clientBean:    params.ClientBean,
 	}
+	batcher.rateLimiter = quotas.NewDynamicRateLimiter(batcher.hostRPS)
+	return batcher
 }
 
 // Start starts the scanner
@@ -98,3 +110,36 @@ func (s *Batcher) Start() error {
 
 	return batchWorker.Start()
 }
+
+// hostRPS returns the share of this host of the cluster wide rate limit of batch operations
+func (s *Batcher) hostRPS() float64 {
+	rps := s.cfg.RPS()
+	if rps <= 0 {
+		return 0
+	}
+	if monitor := s.resource.GetMembershipMonitor(); monitor != nil {
+		ringSize, err := monitor.GetMemberCount(common.WorkerServiceName)
+		if err == nil && ringSize > 0 {
+			rps = common.MaxInt(rps/ringSize, 1)
+		}
+	}
+	return float64(rps)
+}
+
+// waitClusterRateLimit waits for a token of the cluster wide rate limit, which is shared by all the batch operations of this host
+func (s *Batcher) waitClusterRateLimit(ctx context.Context) error {
+	if s.cfg.RPS() <= 0 {
+		return nil
+	}
+	return s.rateLimiter.Wait(ctx)
+}
+
+// effectiveRPS returns the rate a batch operation is processed at, which is bounded by both its adaptive rate
+// and the cluster wide rate limit
+func (s *Batcher) effectiveRPS(limiter *adaptiveRateLimiter) float64 {
+	rps := limiter.RPS()
+	if hostRPS := s.hostRPS(); hostRPS > 0 && hostRPS < rps {
+		return hostRPS
+	}
+	return rps
+}
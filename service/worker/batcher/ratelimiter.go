@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"golang.org/x/time/rate"
+
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const (
+	// minRPS is the lowest rate the adaptive rate limiter slows down to
+	minRPS = 1.0
+	// throttledDecreaseFactor is applied to the rate when the frontend throttles a request
+	throttledDecreaseFactor = 0.5
+	// slowDecreaseFactor is applied to the rate when a request is slower than the latency threshold
+	slowDecreaseFactor = 0.8
+	// decreaseCooldown is the minimum time between two decreases, so the requests in flight
+	// when the rate is decreased don't decrease it again
+	decreaseCooldown = time.Second
+)
+
+type (
+	// adaptiveRateLimiter is a rate limiter whose rate is adjusted from the backpressure of the frontend.
+	// The rate is decreased multiplicatively when requests are throttled or slow and increased additively,
+	// by about one request per second every second, when they succeed, up to the max rate of the batch operation.
+	adaptiveRateLimiter struct {
+		sync.Mutex
+		limiter          *rate.Limiter
+		rps              float64
+		maxRPS           float64
+		lastDecrease     time.Time
+		latencyThreshold dynamicconfig.DurationPropertyFn
+		timeSource       func() time.Time
+	}
+)
+
+func newAdaptiveRateLimiter(maxRPS int, latencyThreshold dynamicconfig.DurationPropertyFn) *adaptiveRateLimiter {
+	rps := math.Max(float64(maxRPS), minRPS)
+	return &adaptiveRateLimiter{
+		limiter:          rate.NewLimiter(rate.Limit(rps), int(rps)),
+		rps:              rps,
+		maxRPS:           rps,
+		latencyThreshold: latencyThreshold,
+		timeSource:       time.Now,
+	}
+}
+
+// Wait waits for a token of the current rate
+func (l *adaptiveRateLimiter) Wait(ctx context.Context) error {
+	return l.limiter.Wait(ctx)
+}
+
+// RPS returns the current rate
+func (l *adaptiveRateLimiter) RPS() float64 {
+	l.Lock()
+	defer l.Unlock()
+	return l.rps
+}
+
+// Observe adjusts the rate from the latency and the result of a request
+func (l *adaptiveRateLimiter) Observe(latency time.Duration, err error) {
+	l.Lock()
+	defer l.Unlock()
+
+	if _, ok := err.(*serviceerror.ResourceExhausted); ok {
+		l.decrease(throttledDecreaseFactor)
+		return
+	}
+	if threshold := l.latencyThreshold(); threshold > 0 && latency > threshold {
+		l.decrease(slowDecreaseFactor)
+		return
+	}
+	if err == nil && l.rps < l.maxRPS {
+		l.setRPS(math.Min(l.rps+1/l.rps, l.maxRPS))
+	}
+}
+
+func (l *adaptiveRateLimiter) decrease(factor float64) {
+	now := l.timeSource()
+	if now.Sub(l.lastDecrease) < decreaseCooldown {
+		return
+	}
+	l.lastDecrease = now
+	l.setRPS(math.Max(l.rps*factor, minRPS))
+}
+
+func (l *adaptiveRateLimiter) setRPS(rps float64) {
+	l.rps = rps
+	l.limiter.SetLimit(rate.Limit(rps))
+	l.limiter.SetBurst(int(math.Max(rps, minRPS)))
+}
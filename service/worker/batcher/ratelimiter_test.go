@@ -0,0 +1,101 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type adaptiveRateLimiterSuite struct {
+	suite.Suite
+
+	now     time.Time
+	limiter *adaptiveRateLimiter
+}
+
+func TestAdaptiveRateLimiterSuite(t *testing.T) {
+	suite.Run(t, new(adaptiveRateLimiterSuite))
+}
+
+func (s *adaptiveRateLimiterSuite) SetupTest() {
+	s.now = time.Unix(1000, 0)
+	s.limiter = newAdaptiveRateLimiter(100, dynamicconfig.GetDurationPropertyFn(time.Second))
+	s.limiter.timeSource = func() time.Time { return s.now }
+}
+
+func (s *adaptiveRateLimiterSuite) TestThrottled() {
+	s.limiter.Observe(time.Millisecond, serviceerror.NewResourceExhausted("throttled"))
+	s.Equal(50.0, s.limiter.RPS())
+
+	// requests in flight during the cooldown don't decrease the rate again
+	s.limiter.Observe(time.Millisecond, serviceerror.NewResourceExhausted("throttled"))
+	s.Equal(50.0, s.limiter.RPS())
+
+	s.now = s.now.Add(decreaseCooldown)
+	s.limiter.Observe(time.Millisecond, serviceerror.NewResourceExhausted("throttled"))
+	s.Equal(25.0, s.limiter.RPS())
+}
+
+func (s *adaptiveRateLimiterSuite) TestSlow() {
+	s.limiter.Observe(2*time.Second, nil)
+	s.Equal(80.0, s.limiter.RPS())
+
+	// other errors don't change the rate
+	s.now = s.now.Add(decreaseCooldown)
+	s.limiter.Observe(time.Millisecond, errors.New("some random error"))
+	s.Equal(80.0, s.limiter.RPS())
+}
+
+func (s *adaptiveRateLimiterSuite) TestMinRPS() {
+	for i := 0; i < 10; i++ {
+		s.now = s.now.Add(decreaseCooldown)
+		s.limiter.Observe(time.Millisecond, serviceerror.NewResourceExhausted("throttled"))
+	}
+	s.Equal(minRPS, s.limiter.RPS())
+}
+
+func (s *adaptiveRateLimiterSuite) TestIncrease() {
+	s.limiter.Observe(time.Millisecond, serviceerror.NewResourceExhausted("throttled"))
+	s.Equal(50.0, s.limiter.RPS())
+
+	// a second of requests at the current rate increases it by about one
+	for i := 0; i < 50; i++ {
+		s.limiter.Observe(time.Millisecond, nil)
+	}
+	s.InDelta(51.0, s.limiter.RPS(), 0.01)
+
+	// the rate never goes above the max rate
+	for i := 0; i < 10000; i++ {
+		s.limiter.Observe(time.Millisecond, nil)
+	}
+	s.Equal(100.0, s.limiter.RPS())
+}
@@ -26,18 +26,20 @@ package batcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
-	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
@@ -73,10 +75,33 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeDelete is batch type for deleting workflows, running workflows are terminated before deletion
+	BatchTypeDelete = "delete"
+)
+
+const (
+	// ResetTypeLastDecisionCompleted resets to the last completed decision of the workflow
+	ResetTypeLastDecisionCompleted = "LastDecisionCompleted"
+	// ResetTypeFirstDecisionCompleted resets to the first completed decision of the workflow
+	ResetTypeFirstDecisionCompleted = "FirstDecisionCompleted"
+	// ResetTypeBadBinary resets to the first decision completed by the bad binary
+	ResetTypeBadBinary = "BadBinary"
+)
+
+const (
+	// maxReportedFailures is the max number of failed workflows kept in HeartBeatDetails
+	maxReportedFailures = 100
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReset, BatchTypeDelete}
+
+// AllResetTypes is the reset types we supported for BatchTypeReset
+var AllResetTypes = []string{ResetTypeLastDecisionCompleted, ResetTypeFirstDecisionCompleted, ResetTypeBadBinary}
+
+var errNoResetPoint = errors.New("no decision to reset to")
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -101,6 +126,17 @@ type (
 		Input      *commonpb.Payloads
 	}
 
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// ResetType is one of AllResetTypes
+		ResetType string
+		// BadBinaryChecksum is required for ResetTypeBadBinary
+		BadBinaryChecksum string
+		// this indicates whether to skip the workflows whose run is not the current run any more. Default to true.
+		// It also makes the operation idempotent, as a reset workflow is not the current run after the reset.
+		SkipBaseNotCurrent *bool
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -119,8 +155,9 @@ type (
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
-		// RPS of processing. Default to DefaultRPS
-		// TODO we will implement smarter way than this static rate limiter: https://go.temporal.io/server/issues/2138
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
+		// Max RPS of processing, the rate is adjusted below it from the backpressure of the frontend. Default to DefaultRPS
 		RPS int
 		// Number of goroutines running in parallel to process
 		Concurrency int
@@ -144,13 +181,27 @@ type (
 		SuccessCount int
 		// Number of workflows that give up due to errors.
 		ErrorCount int
+		// The workflows that give up due to errors, at most maxReportedFailures are kept
+		Failures []WorkflowFailure
+		// The current RPS of processing
+		EffectiveRPS float64
+	}
+
+	// WorkflowFailure is a workflow the batch operation failed to process
+	WorkflowFailure struct {
+		WorkflowID string
+		RunID      string
+		Error      string
+	}
+
+	taskResult struct {
+		execution commonpb.WorkflowExecution
+		err       error
 	}
 
 	taskDetail struct {
 		execution commonpb.WorkflowExecution
 		attempts  int
-		// passing along the current heartbeat details to make heartbeat within a task so that it won't timeout
-		hbd HeartBeatDetails
 	}
 )
 
@@ -195,9 +246,19 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeReset:
+		switch params.ResetParams.ResetType {
+		case ResetTypeLastDecisionCompleted, ResetTypeFirstDecisionCompleted:
+			return nil
+		case ResetTypeBadBinary:
+			if params.ResetParams.BadBinaryChecksum == "" {
+				return fmt.Errorf("must provide bad binary checksum")
+			}
+			return nil
+		default:
+			return fmt.Errorf("not supported reset type: %v", params.ResetParams.ResetType)
+		}
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -226,6 +287,9 @@ func setDefaultParams(params BatchParams) BatchParams {
 	if params.TerminateParams.TerminateChildren == nil {
 		params.TerminateParams.TerminateChildren = convert.BoolPtr(true)
 	}
+	if params.ResetParams.SkipBaseNotCurrent == nil {
+		params.ResetParams.SkipBaseNotCurrent = convert.BoolPtr(true)
+	}
 	return params
 }
 
@@ -256,12 +320,16 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		}
 		hbd.TotalEstimate = resp.GetCount()
 	}
-	rateLimiter := rate.NewLimiter(rate.Limit(batchParams.RPS), batchParams.RPS)
+	rateLimiter := newAdaptiveRateLimiter(batchParams.RPS, batcher.cfg.LatencyThreshold)
 	taskCh := make(chan taskDetail, pageSize)
-	respCh := make(chan error, pageSize)
+	respCh := make(chan taskResult, pageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
 		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, client)
 	}
+	// the heartbeat details are only accessed by this goroutine, which keeps heartbeating while the tasks
+	// of a page are processed so that the activity won't timeout
+	heartbeatTicker := time.NewTicker(batchParams.ActivityHeartBeatTimeout / 2)
+	defer heartbeatTicker.Stop()
 
 	for {
 		// TODO https://github.com/uber/cadence/issues/2154
@@ -286,7 +354,6 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 			taskCh <- taskDetail{
 				execution: *wf.Execution,
 				attempts:  0,
-				hbd:       hbd,
 			}
 		}
 
@@ -296,15 +363,25 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	Loop:
 		for {
 			select {
-			case err := <-respCh:
-				if err == nil {
+			case result := <-respCh:
+				if result.err == nil {
 					succCount++
 				} else {
 					errCount++
+					if len(hbd.Failures) < maxReportedFailures {
+						hbd.Failures = append(hbd.Failures, WorkflowFailure{
+							WorkflowID: result.execution.GetWorkflowId(),
+							RunID:      result.execution.GetRunId(),
+							Error:      result.err.Error(),
+						})
+					}
 				}
 				if succCount+errCount == batchCount {
 					break Loop
 				}
+			case <-heartbeatTicker.C:
+				hbd.EffectiveRPS = batcher.effectiveRPS(rateLimiter)
+				activity.RecordHeartbeat(ctx, hbd)
 			case <-ctx.Done():
 				return HeartBeatDetails{}, ctx.Err()
 			}
@@ -314,6 +391,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		hbd.PageToken = resp.NextPageToken
 		hbd.SuccessCount += succCount
 		hbd.ErrorCount += errCount
+		hbd.EffectiveRPS = batcher.effectiveRPS(rateLimiter)
 		activity.RecordHeartbeat(ctx, hbd)
 
 		if len(hbd.PageToken) == 0 {
@@ -328,8 +406,8 @@ func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
 	taskCh chan taskDetail,
-	respCh chan error,
-	limiter *rate.Limiter,
+	respCh chan taskResult,
+	limiter *adaptiveRateLimiter,
 	client frontend.Client,
 ) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
@@ -391,14 +469,24 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, batchParams, workflowID, runID)
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return batcher.deleteWorkflow(ctx, client, batchParams, workflowID, runID)
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
-				if ok || task.attempts >= batchParams.AttemptsOnRetryableError {
-					respCh <- err
+				if ok || err == errNoResetPoint || task.attempts >= batchParams.AttemptsOnRetryableError {
+					respCh <- taskResult{execution: task.execution, err: err}
 				} else {
 					// put back to the channel if less than attemptsOnError
 					task.attempts++
@@ -406,7 +494,7 @@ func startTaskProcessor(
 				}
 			} else {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorSuccess)
-				respCh <- nil
+				respCh <- taskResult{execution: task.execution}
 			}
 		}
 	}
@@ -414,13 +502,14 @@ func startTaskProcessor(
 
 func processTask(
 	ctx context.Context,
-	limiter *rate.Limiter,
+	limiter *adaptiveRateLimiter,
 	task taskDetail,
 	batchParams BatchParams,
 	client frontend.Client,
 	applyOnChild *bool,
 	procFn func(string, string) error,
 ) error {
+	batcher := ctx.Value(batcherContextKey).(*Batcher)
 	wfs := []commonpb.WorkflowExecution{task.execution}
 	for len(wfs) > 0 {
 		wf := wfs[0]
@@ -429,9 +518,13 @@ func processTask(
 		if err != nil {
 			return err
 		}
-		activity.RecordHeartbeat(ctx, task.hbd)
+		if err := batcher.waitClusterRateLimit(ctx); err != nil {
+			return err
+		}
 
+		startTime := time.Now()
 		err = procFn(wf.GetWorkflowId(), wf.GetRunId())
+		limiter.Observe(time.Since(startTime), err)
 		if err != nil {
 			// NotFound means wf is not running or deleted
 			if _, ok := err.(*serviceerror.NotFound); !ok {
@@ -470,6 +563,152 @@ func processTask(
 	return nil
 }
 
+func resetWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+) error {
+	// the request ID is the same for every attempt of the reset of the workflow by the batch job,
+	// so that a retried reset is deduplicated instead of resetting the new run again
+	requestID := uuid.NewSHA1(uuid.NameSpaceOID, []byte(activity.GetInfo(ctx).WorkflowExecution.ID+"/"+workflowID+"/"+runID)).String()
+	resp, err := client.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: batchParams.Namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	currentRunID := resp.WorkflowExecutionInfo.Execution.GetRunId()
+	if runID == "" {
+		runID = currentRunID
+	}
+	if runID != currentRunID {
+		if skip := batchParams.ResetParams.SkipBaseNotCurrent; skip == nil || *skip {
+			return nil
+		}
+		resp, err = client.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: batchParams.Namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	var decisionFinishID int64
+	switch batchParams.ResetParams.ResetType {
+	case ResetTypeLastDecisionCompleted, ResetTypeFirstDecisionCompleted:
+		decisionFinishID, err = getDecisionCompletedID(ctx, client, batchParams, workflowID, runID)
+		if err != nil {
+			return err
+		}
+	case ResetTypeBadBinary:
+		for _, point := range resp.WorkflowExecutionInfo.GetAutoResetPoints().GetPoints() {
+			if point.GetBinaryChecksum() == batchParams.ResetParams.BadBinaryChecksum && point.GetResettable() {
+				decisionFinishID = point.GetFirstDecisionCompletedId()
+				break
+			}
+		}
+	}
+	if decisionFinishID == 0 {
+		return errNoResetPoint
+	}
+
+	_, err = client.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace: batchParams.Namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Reason:                batchParams.Reason,
+		DecisionFinishEventId: decisionFinishID,
+		RequestId:             requestID,
+	})
+	return err
+}
+
+// getDecisionCompletedID returns the event ID of the first or the last DecisionTaskCompleted event
+// depending on the reset type, or 0 if the workflow has none
+func getDecisionCompletedID(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+) (int64, error) {
+	req := &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: batchParams.Namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		MaximumPageSize: pageSize,
+	}
+	var decisionFinishID int64
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return 0, err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if e.GetEventType() == enumspb.EVENT_TYPE_DECISION_TASK_COMPLETED {
+				decisionFinishID = e.GetEventId()
+				if batchParams.ResetParams.ResetType == ResetTypeFirstDecisionCompleted {
+					return decisionFinishID, nil
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return decisionFinishID, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
+// deleteWorkflow terminates the workflow if it is still running and asks the history service to delete it. The
+// deletion is carried out asynchronously by the shard owning the workflow like when its retention period expires.
+func (s *Batcher) deleteWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+) error {
+	namespaceID, err := s.resource.GetNamespaceCache().GetNamespaceID(batchParams.Namespace)
+	if err != nil {
+		return err
+	}
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      runID,
+	}
+
+	if _, err := client.TerminateWorkflowExecution(ctx, &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace:         batchParams.Namespace,
+		WorkflowExecution: execution,
+		Reason:            batchParams.Reason,
+		Identity:          BatchWFTypeName,
+	}); err != nil {
+		// NotFound means the workflow is already closed
+		if _, ok := err.(*serviceerror.NotFound); !ok {
+			return err
+		}
+	}
+
+	_, err = s.resource.GetHistoryClient().DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: execution,
+	})
+	return err
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
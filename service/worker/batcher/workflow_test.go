@@ -0,0 +1,237 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type batcherSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	controller   *gomock.Controller
+	mockResource *resource.Test
+	env          *testsuite.TestActivityEnvironment
+}
+
+func TestBatcherSuite(t *testing.T) {
+	suite.Run(t, new(batcherSuite))
+}
+
+func (s *batcherSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockResource = resource.NewTest(s.controller, metrics.Worker)
+
+	batcher := New(s.mockResource, &BootstrapParams{
+		Config: Config{
+			RPS:              dynamicconfig.GetIntPropertyFn(0),
+			LatencyThreshold: dynamicconfig.GetDurationPropertyFn(time.Second),
+		},
+		MetricsClient: s.mockResource.GetMetricsClient(),
+		Logger:        loggerimpl.NewNopLogger(),
+		ClientBean:    s.mockResource.GetClientBean(),
+	})
+	s.env = s.NewTestActivityEnvironment()
+	s.env.RegisterActivityWithOptions(BatchActivity, activity.RegisterOptions{Name: batchActivityName})
+	s.env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), batcherContextKey, batcher),
+	})
+}
+
+func (s *batcherSuite) TearDownTest() {
+	s.mockResource.Finish(s.T())
+	s.controller.Finish()
+}
+
+func (s *batcherSuite) TestValidateParams() {
+	params := setDefaultParams(BatchParams{
+		Namespace: "test-namespace",
+		Query:     "WorkflowType = 'test'",
+		Reason:    "test",
+		BatchType: BatchTypeReset,
+	})
+	s.Error(validateParams(params))
+	params.ResetParams.ResetType = ResetTypeBadBinary
+	s.Error(validateParams(params))
+	params.ResetParams.BadBinaryChecksum = "checksum"
+	s.NoError(validateParams(params))
+	s.True(*params.ResetParams.SkipBaseNotCurrent)
+
+	params.BatchType = BatchTypeDelete
+	s.NoError(validateParams(params))
+}
+
+func (s *batcherSuite) TestReset() {
+	s.expectScan("wf-1", "wf-2", "wf-3")
+	// wf-2 was already reset, so its run is not current
+	currentRunIDs := map[string]string{"wf-1": "run-wf-1", "wf-2": "new-run", "wf-3": "run-wf-3"}
+	histories := map[string][]*historypb.HistoryEvent{
+		"wf-1": {
+			{EventId: 4, EventType: enumspb.EVENT_TYPE_DECISION_TASK_COMPLETED},
+			{EventId: 10, EventType: enumspb.EVENT_TYPE_DECISION_TASK_COMPLETED},
+			{EventId: 11, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED},
+		},
+		// wf-3 has no decision to reset to
+		"wf-3": {
+			{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+		},
+	}
+	frontendClient := s.mockResource.FrontendClient
+	frontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.DescribeWorkflowExecutionRequest, _ ...interface{}) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+			workflowID := request.Execution.GetWorkflowId()
+			return &workflowservice.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+					Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: currentRunIDs[workflowID]},
+				},
+			}, nil
+		}).Times(5)
+	frontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.GetWorkflowExecutionHistoryRequest, _ ...interface{}) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+			return &workflowservice.GetWorkflowExecutionHistoryResponse{
+				History: &historypb.History{Events: histories[request.Execution.GetWorkflowId()]},
+			}, nil
+		}).Times(2)
+	frontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.ResetWorkflowExecutionRequest, _ ...interface{}) (*workflowservice.ResetWorkflowExecutionResponse, error) {
+			s.Equal("wf-1", request.WorkflowExecution.GetWorkflowId())
+			s.Equal("run-wf-1", request.WorkflowExecution.GetRunId())
+			s.Equal(int64(10), request.GetDecisionFinishEventId())
+			return &workflowservice.ResetWorkflowExecutionResponse{RunId: "new-run"}, nil
+		})
+
+	hbd := s.execute(BatchParams{
+		BatchType:   BatchTypeReset,
+		ResetParams: ResetParams{ResetType: ResetTypeLastDecisionCompleted},
+	})
+	s.Equal(2, hbd.SuccessCount)
+	s.Equal(1, hbd.ErrorCount)
+	s.Equal([]WorkflowFailure{{WorkflowID: "wf-3", RunID: "run-wf-3", Error: errNoResetPoint.Error()}}, hbd.Failures)
+}
+
+func (s *batcherSuite) TestReset_RetryWithSameRequestID() {
+	s.expectScan("wf-1")
+	frontendClient := s.mockResource.FrontendClient
+	frontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&workflowservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-wf-1"},
+			},
+		}, nil).Times(3)
+	frontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(
+		&workflowservice.GetWorkflowExecutionHistoryResponse{
+			History: &historypb.History{Events: []*historypb.HistoryEvent{
+				{EventId: 4, EventType: enumspb.EVENT_TYPE_DECISION_TASK_COMPLETED},
+			}},
+		}, nil).Times(2)
+	var requestIDs []string
+	frontendClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.ResetWorkflowExecutionRequest, _ ...interface{}) (*workflowservice.ResetWorkflowExecutionResponse, error) {
+			requestIDs = append(requestIDs, request.GetRequestId())
+			if len(requestIDs) == 1 {
+				return nil, serviceerror.NewUnavailable("timeout")
+			}
+			return &workflowservice.ResetWorkflowExecutionResponse{RunId: "new-run"}, nil
+		}).Times(2)
+
+	hbd := s.execute(BatchParams{
+		BatchType:   BatchTypeReset,
+		ResetParams: ResetParams{ResetType: ResetTypeLastDecisionCompleted},
+	})
+	s.Equal(1, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Len(requestIDs, 2)
+	s.NotEmpty(requestIDs[0])
+	s.Equal(requestIDs[0], requestIDs[1])
+}
+
+func (s *batcherSuite) TestDelete() {
+	s.expectScan("wf-1")
+	s.mockResource.NamespaceCache.EXPECT().GetNamespaceID("test-namespace").Return("test-namespace-id", nil)
+	// the running workflow is terminated before it's deleted
+	s.mockResource.FrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&workflowservice.TerminateWorkflowExecutionResponse{}, nil)
+	s.mockResource.HistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       "test-namespace-id",
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-wf-1"},
+	}).Return(&historyservice.DeleteWorkflowExecutionResponse{}, nil)
+	// the workflow is already deleted when looking for its children
+	s.mockResource.FrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		nil, serviceerror.NewNotFound("workflow not found"))
+
+	hbd := s.execute(BatchParams{BatchType: BatchTypeDelete})
+	s.Equal(1, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+}
+
+func (s *batcherSuite) execute(params BatchParams) HeartBeatDetails {
+	params.Namespace = "test-namespace"
+	params.Query = "WorkflowType = 'test'"
+	params.Reason = "test"
+	params.AttemptsOnRetryableError = 1
+	params = setDefaultParams(params)
+	s.NoError(validateParams(params))
+
+	value, err := s.env.ExecuteActivity(batchActivityName, params)
+	s.NoError(err)
+	var hbd HeartBeatDetails
+	s.NoError(value.Get(&hbd))
+	return hbd
+}
+
+func (s *batcherSuite) expectScan(workflowIDs ...string) {
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for _, workflowID := range workflowIDs {
+		executions = append(executions, &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: "run-" + workflowID},
+		})
+	}
+	frontendClient := s.mockResource.FrontendClient
+	frontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&workflowservice.CountWorkflowExecutionsResponse{Count: int64(len(workflowIDs))}, nil)
+	frontendClient.EXPECT().ScanWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&workflowservice.ScanWorkflowExecutionsResponse{Executions: executions}, nil)
+}
@@ -0,0 +1,538 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/log/tag"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/versioning"
+)
+
+type (
+	contextKey string
+
+	// scanState is the heartbeat of the activities scanning the visibility records of the namespace,
+	// a retried activity resumes from it
+	scanState struct {
+		PageToken  []byte
+		Count      int64
+		Open       int64
+		TaskQueues []string
+	}
+
+	// visitExecutionFn is applied to every execution of the namespace found by scanExecutions
+	visitExecutionFn func(info *workflowpb.WorkflowExecutionInfo, state *scanState) error
+
+	// listExecutionsFn lists a page of the visibility records of the namespace
+	listExecutionsFn func(request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error)
+)
+
+const (
+	deleterContextKey = contextKey("namespaceDeleterContext")
+
+	visibilityPageSize = 100
+	taskQueuesPageSize = 100
+	tasksDeleteLimit   = 1000
+
+	// progressUpdateInterval is the min interval between two progress updates of the namespace data
+	progressUpdateInterval = 30 * time.Second
+	// maxCollectedTaskQueues bounds the size of the task queue names carried by the workflow
+	maxCollectedTaskQueues = 10000
+
+	// taskQueuePartitionPrefix is the naming prefix of the task queues backing a task queue, such as its partitions
+	taskQueuePartitionPrefix = "/__temporal_sys/"
+
+	terminateReason = "namespace deleted"
+	identity        = WorkflowTypeName
+)
+
+// MarkNamespaceDeletedActivity sets the status of the namespace to deleted, which stops new executions
+// from being started, and writes the initial progress to the namespace data
+func MarkNamespaceDeletedActivity(ctx context.Context, params DeleteNamespaceParams, progress Progress) error {
+	dctx := ctx.Value(deleterContextKey).(*deleterContext)
+	return updateNamespace(dctx, params, func(info *persistenceblobs.NamespaceInfo) {
+		info.Status = enumspb.NAMESPACE_STATUS_DELETED
+		info.Data = progress.toData(info.Data)
+	})
+}
+
+// UpdateProgressActivity writes the progress of the deletion to the namespace data
+func UpdateProgressActivity(ctx context.Context, params DeleteNamespaceParams, progress Progress) error {
+	dctx := ctx.Value(deleterContextKey).(*deleterContext)
+	return updateProgress(dctx, params, progress)
+}
+
+// TerminateExecutionsActivity terminates the open executions of the namespace, it returns the number
+// of terminated executions
+func TerminateExecutionsActivity(ctx context.Context, params DeleteNamespaceParams, progress Progress) (int64, error) {
+	dctx := ctx.Value(deleterContextKey).(*deleterContext)
+	historyClient := dctx.GetHistoryClient()
+	state, err := scanExecutions(ctx, dctx, params, dctx.GetVisibilityManager().ListOpenWorkflowExecutions,
+		func(state *scanState) Progress {
+			current := progress
+			current.TerminatedExecutions += state.Count
+			return current
+		},
+		func(info *workflowpb.WorkflowExecutionInfo, state *scanState) error {
+			if err := dctx.rateLimiter.Wait(ctx); err != nil {
+				return err
+			}
+			_, err := historyClient.TerminateWorkflowExecution(ctx, &historyservice.TerminateWorkflowExecutionRequest{
+				NamespaceId: params.NamespaceID,
+				TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
+					Namespace:         params.Namespace,
+					WorkflowExecution: info.GetExecution(),
+					Reason:            terminateReason,
+					Identity:          identity,
+				},
+			})
+			switch err.(type) {
+			case nil:
+				state.Count++
+			case *serviceerror.NotFound:
+			default:
+				// the execution is left open and picked up by the next pass
+				dctx.logger.Warn("Failed to terminate execution",
+					tag.WorkflowNamespace(params.Namespace),
+					tag.WorkflowID(info.GetExecution().GetWorkflowId()),
+					tag.WorkflowRunID(info.GetExecution().GetRunId()),
+					tag.Error(err))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return state.Count, nil
+}
+
+// DeleteExecutionsActivity asks the history service to delete the closed executions of the namespace, the
+// executions are deleted along with their histories and visibility records by the shards owning them.
+// Executions which are still open are counted and left to the next pass.
+func DeleteExecutionsActivity(ctx context.Context, params DeleteNamespaceParams, progress Progress) (DeleteExecutionsResult, error) {
+	dctx := ctx.Value(deleterContextKey).(*deleterContext)
+	historyClient := dctx.GetHistoryClient()
+	state, err := scanExecutions(ctx, dctx, params, dctx.GetVisibilityManager().ListClosedWorkflowExecutions,
+		func(state *scanState) Progress {
+			current := progress
+			current.DeletedExecutions += state.Count
+			return current
+		},
+		func(info *workflowpb.WorkflowExecutionInfo, state *scanState) error {
+			if err := dctx.rateLimiter.Wait(ctx); err != nil {
+				return err
+			}
+			_, err := historyClient.DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+				NamespaceId:       params.NamespaceID,
+				WorkflowExecution: info.GetExecution(),
+			})
+			switch err.(type) {
+			case nil:
+				state.Count++
+				collectTaskQueue(state, info.GetTaskQueue())
+			case *serviceerror.NotFound:
+			case *serviceerror.InvalidArgument:
+				// the execution is still running, it is left to the next pass
+				state.Open++
+			default:
+				return err
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return DeleteExecutionsResult{}, err
+	}
+
+	// executions started behind the terminate pass
+	resp, err := dctx.GetVisibilityManager().ListOpenWorkflowExecutions(&p.ListWorkflowExecutionsRequest{
+		NamespaceID:       params.NamespaceID,
+		Namespace:         params.Namespace,
+		EarliestStartTime: 0,
+		LatestStartTime:   time.Now().UnixNano(),
+		PageSize:          visibilityPageSize,
+	})
+	if err != nil {
+		return DeleteExecutionsResult{}, err
+	}
+	state.Open += int64(len(resp.Executions))
+
+	return DeleteExecutionsResult{
+		Deleted:    state.Count,
+		Open:       state.Open,
+		TaskQueues: state.TaskQueues,
+	}, nil
+}
+
+// DeleteVisibilityActivity deletes the visibility records of the namespace left behind by the deletion
+// of the executions, it returns the number of deleted records
+func DeleteVisibilityActivity(ctx context.Context, params DeleteNamespaceParams, progress Progress) (int64, error) {
+	dctx := ctx.Value(deleterContextKey).(*deleterContext)
+	visibilityManager := dctx.GetVisibilityManager()
+
+	var deleted int64
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &deleted); err != nil {
+			return 0, err
+		}
+	}
+
+	listFns := []func(*p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error){
+		visibilityManager.ListOpenWorkflowExecutions,
+		visibilityManager.ListClosedWorkflowExecutions,
+	}
+	lastProgressUpdate := time.Now()
+	for _, listFn := range listFns {
+		request := &p.ListWorkflowExecutionsRequest{
+			NamespaceID:       params.NamespaceID,
+			Namespace:         params.Namespace,
+			EarliestStartTime: 0,
+			LatestStartTime:   time.Now().UnixNano(),
+			PageSize:          visibilityPageSize,
+		}
+		for {
+			resp, err := listFn(request)
+			if err != nil {
+				return 0, err
+			}
+			for _, execution := range resp.Executions {
+				if err := dctx.rateLimiter.Wait(ctx); err != nil {
+					return 0, err
+				}
+				if err := visibilityManager.DeleteWorkflowExecution(&p.VisibilityDeleteWorkflowExecutionRequest{
+					NamespaceID: params.NamespaceID,
+					WorkflowID:  execution.GetExecution().GetWorkflowId(),
+					RunID:       execution.GetExecution().GetRunId(),
+				}); err != nil {
+					return 0, err
+				}
+				deleted++
+			}
+			activity.RecordHeartbeat(ctx, deleted)
+			if time.Since(lastProgressUpdate) >= progressUpdateInterval {
+				current := progress
+				current.DeletedVisibilityRecords += deleted
+				if err := updateProgress(dctx, params, current); err != nil {
+					return 0, err
+				}
+				lastProgressUpdate = time.Now()
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			request.NextPageToken = resp.NextPageToken
+		}
+	}
+	return deleted, nil
+}
+
+// DeleteTaskQueuesActivity deletes the task queues of the namespace along with their tasks, it returns
+// the number of deleted task queues
+func DeleteTaskQueuesActivity(
+	ctx context.Context,
+	params DeleteNamespaceParams,
+	progress Progress,
+	taskQueueNames []string,
+) (int64, error) {
+	dctx := ctx.Value(deleterContextKey).(*deleterContext)
+	taskManager := dctx.GetTaskManager()
+
+	rangeIDs, err := listTaskQueues(taskManager, params)
+	if err != nil {
+		// listing task queues is not supported by every persistence store, the task queues backing the
+		// collected names are deleted then
+		dctx.logger.Info("Unable to list task queues, deleting the task queues used by the executions only.", tag.Error(err))
+		if rangeIDs, err = expandTaskQueues(dctx, params, taskQueueNames); err != nil {
+			return 0, err
+		}
+	}
+
+	var deleted int64
+	lastProgressUpdate := time.Now()
+	for key, rangeID := range rangeIDs {
+		if err := dctx.rateLimiter.Wait(ctx); err != nil {
+			return 0, err
+		}
+		if err := deleteTaskQueue(dctx, key, rangeID); err != nil {
+			return 0, err
+		}
+		deleted++
+		activity.RecordHeartbeat(ctx, deleted)
+		if time.Since(lastProgressUpdate) >= progressUpdateInterval {
+			current := progress
+			current.DeletedTaskQueues += deleted
+			if err := updateProgress(dctx, params, current); err != nil {
+				return 0, err
+			}
+			lastProgressUpdate = time.Now()
+		}
+	}
+	return deleted, nil
+}
+
+// DeleteNamespaceRecordActivity deletes the namespace record, once all of its data is gone
+func DeleteNamespaceRecordActivity(ctx context.Context, params DeleteNamespaceParams) error {
+	dctx := ctx.Value(deleterContextKey).(*deleterContext)
+	err := dctx.GetMetadataManager().DeleteNamespace(&p.DeleteNamespaceRequest{ID: params.NamespaceID})
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return nil
+	}
+	return err
+}
+
+// scanExecutions applies visit to every execution of the namespace listed by listFn. The scan heartbeats
+// its position and resumes from it when the activity is retried.
+func scanExecutions(
+	ctx context.Context,
+	dctx *deleterContext,
+	params DeleteNamespaceParams,
+	listFn listExecutionsFn,
+	progressOf func(state *scanState) Progress,
+	visit visitExecutionFn,
+) (*scanState, error) {
+	state := &scanState{}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, state); err != nil {
+			return nil, err
+		}
+	}
+
+	lastProgressUpdate := time.Now()
+	request := &p.ListWorkflowExecutionsRequest{
+		NamespaceID:       params.NamespaceID,
+		Namespace:         params.Namespace,
+		EarliestStartTime: 0,
+		LatestStartTime:   time.Now().UnixNano(),
+		PageSize:          visibilityPageSize,
+		NextPageToken:     state.PageToken,
+	}
+	for {
+		resp, err := listFn(request)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range resp.Executions {
+			if err := visit(info, state); err != nil {
+				return nil, err
+			}
+		}
+		state.PageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, state)
+		if time.Since(lastProgressUpdate) >= progressUpdateInterval {
+			if err := updateProgress(dctx, params, progressOf(state)); err != nil {
+				return nil, err
+			}
+			lastProgressUpdate = time.Now()
+		}
+		if len(state.PageToken) == 0 {
+			return state, nil
+		}
+		request.NextPageToken = state.PageToken
+	}
+}
+
+// listTaskQueues returns the range IDs of the task queues of the namespace
+func listTaskQueues(taskManager p.TaskManager, params DeleteNamespaceParams) (map[p.TaskQueueKey]int64, error) {
+	rangeIDs := make(map[p.TaskQueueKey]int64)
+	var pageToken []byte
+	for {
+		resp, err := taskManager.ListTaskQueue(&p.ListTaskQueueRequest{PageSize: taskQueuesPageSize, PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			if item.Data.GetNamespaceId() != params.NamespaceID {
+				continue
+			}
+			rangeIDs[p.TaskQueueKey{NamespaceID: params.NamespaceID, Name: item.Data.GetName(), TaskType: item.Data.GetTaskType()}] = item.RangeID
+		}
+		if len(resp.NextPageToken) == 0 {
+			return rangeIDs, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// expandTaskQueues returns the task queues backing the task queues of the given names: the partitions of the
+// task queues, and the partitions of the sub-queues of their build ID sets. Their range IDs are unknown, and
+// their backlog lanes are found when they are leased for the deletion.
+func expandTaskQueues(dctx *deleterContext, params DeleteNamespaceParams, taskQueueNames []string) (map[p.TaskQueueKey]int64, error) {
+	getResponse, err := dctx.GetMetadataManager().GetNamespace(&p.GetNamespaceRequest{ID: params.NamespaceID})
+	if err != nil {
+		return nil, err
+	}
+	namespaceData := getResponse.Namespace.Info.GetData()
+
+	rangeIDs := make(map[p.TaskQueueKey]int64)
+	addPartitions := func(root string, name string, taskType enumspb.TaskQueueType) {
+		// the sub-queues of a task queue have as many partitions as the task queue itself
+		partitions := dctx.cfg.NumTaskQueuePartitions(params.Namespace, root, taskType)
+		if partitions < 1 {
+			partitions = 1
+		}
+		for partition := 0; partition < partitions; partition++ {
+			rangeIDs[p.TaskQueueKey{NamespaceID: params.NamespaceID, Name: partitionName(name, partition), TaskType: taskType}] = 0
+		}
+	}
+	for _, name := range taskQueueNames {
+		addPartitions(name, name, enumspb.TASK_QUEUE_TYPE_DECISION)
+		addPartitions(name, name, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+		buildIDSets, err := versioning.GetBuildIDSets(namespaceData, name)
+		if err != nil {
+			return nil, err
+		}
+		// only the decision tasks are routed to the sub-queues of the build ID sets
+		for _, set := range buildIDSets {
+			addPartitions(name, versioning.SubQueueName(name, buildIDSets.SetKey(set[0])), enumspb.TASK_QUEUE_TYPE_DECISION)
+		}
+	}
+	return rangeIDs, nil
+}
+
+// partitionName returns the name of a partition of a task queue, the same way matching names them
+func partitionName(name string, partition int) string {
+	if partition == 0 {
+		return name
+	}
+	return fmt.Sprintf("%v%v/%v", taskQueuePartitionPrefix, name, partition)
+}
+
+// backlogLaneName returns the name of the task queue storing the tasks of a backlog lane of a task queue,
+// the same way matching names them
+func backlogLaneName(name string, lane *persistenceblobs.TaskQueueBacklogLane) string {
+	return fmt.Sprintf("%v%v/backlog-%v-%v", taskQueuePartitionPrefix, name, lane.GetPriority(), lane.GetFairnessBucket())
+}
+
+// deleteTaskQueue deletes the tasks of a task queue, then the task queue itself. The task queue is leased
+// first when its range ID is unknown, which fences off a matching host still owning it, and its backlog
+// lanes, which are task queues of their own, are deleted along with it.
+func deleteTaskQueue(dctx *deleterContext, key p.TaskQueueKey, rangeID int64) error {
+	taskManager := dctx.GetTaskManager()
+	for {
+		// the number of deleted tasks is unknown for the stores deleting all the tasks at once
+		deletedTasks, err := taskManager.CompleteTasksLessThan(&p.CompleteTasksLessThanRequest{
+			NamespaceID:   key.NamespaceID,
+			TaskQueueName: key.Name,
+			TaskType:      key.TaskType,
+			TaskID:        math.MaxInt64,
+			Limit:         tasksDeleteLimit,
+		})
+		if err != nil {
+			return err
+		}
+		if deletedTasks < tasksDeleteLimit {
+			break
+		}
+	}
+	if rangeID == 0 {
+		resp, err := taskManager.LeaseTaskQueue(&p.LeaseTaskQueueRequest{
+			NamespaceID: key.NamespaceID,
+			TaskQueue:   key.Name,
+			TaskType:    key.TaskType,
+		})
+		if err != nil {
+			return err
+		}
+		rangeID = resp.TaskQueueInfo.RangeID
+		for _, lane := range resp.TaskQueueInfo.Data.GetBacklogLanes() {
+			laneKey := p.TaskQueueKey{NamespaceID: key.NamespaceID, Name: backlogLaneName(key.Name, lane), TaskType: key.TaskType}
+			if err := deleteTaskQueue(dctx, laneKey, 0); err != nil {
+				return err
+			}
+		}
+	}
+	err := taskManager.DeleteTaskQueue(&p.DeleteTaskQueueRequest{
+		TaskQueue: &key,
+		RangeID:   rangeID,
+	})
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return nil
+	}
+	return err
+}
+
+func updateProgress(dctx *deleterContext, params DeleteNamespaceParams, progress Progress) error {
+	return updateNamespace(dctx, params, func(info *persistenceblobs.NamespaceInfo) {
+		info.Data = progress.toData(info.Data)
+	})
+}
+
+// updateNamespace applies update to the namespace info and bumps the config version of the namespace,
+// the same way the namespace handler does
+func updateNamespace(
+	dctx *deleterContext,
+	params DeleteNamespaceParams,
+	update func(info *persistenceblobs.NamespaceInfo),
+) error {
+	metadataManager := dctx.GetMetadataManager()
+	// the notification version must be read first, it is the lock on the namespace table
+	metadata, err := metadataManager.GetMetadata()
+	if err != nil {
+		return err
+	}
+	getResponse, err := metadataManager.GetNamespace(&p.GetNamespaceRequest{ID: params.NamespaceID})
+	if err != nil {
+		return err
+	}
+
+	update(getResponse.Namespace.Info)
+	return metadataManager.UpdateNamespace(&p.UpdateNamespaceRequest{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info:                        getResponse.Namespace.Info,
+			Config:                      getResponse.Namespace.Config,
+			ReplicationConfig:           getResponse.Namespace.ReplicationConfig,
+			ConfigVersion:               getResponse.Namespace.ConfigVersion + 1,
+			FailoverVersion:             getResponse.Namespace.FailoverVersion,
+			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+		},
+		NotificationVersion: metadata.NotificationVersion,
+	})
+}
+
+func collectTaskQueue(state *scanState, name string) {
+	if name == "" || len(state.TaskQueues) >= maxCollectedTaskQueues {
+		return
+	}
+	for _, collected := range state.TaskQueues {
+		if collected == name {
+			return
+		}
+	}
+	state.TaskQueues = append(state.TaskQueues, name)
+}
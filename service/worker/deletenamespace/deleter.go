@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	// Config defines the configuration for the namespace deleter
+	Config struct {
+		// RPS is the max rate of executions terminated or deleted, and of task queues deleted
+		RPS dynamicconfig.IntPropertyFn
+		// NumTaskQueuePartitions is the number of partitions of a task queue, its partitions are deleted along
+		// with it when the task queues of the namespace can't be listed
+		NumTaskQueuePartitions dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the namespace deleter sub-system
+	BootstrapParams struct {
+		// Config contains the configuration for the namespace deleter
+		Config Config
+	}
+
+	// deleterContext is the context object that gets
+	// passed around within the deletion workflows / activities
+	deleterContext struct {
+		resource.Resource
+		cfg         Config
+		rateLimiter quotas.Limiter
+		logger      log.Logger
+	}
+
+	// Deleter is the background sub-system that runs the workflows which delete
+	// namespaces along with all of their data
+	Deleter struct {
+		context *deleterContext
+	}
+)
+
+// New returns a new instance of the namespace deleter
+func New(
+	resource resource.Resource,
+	params *BootstrapParams,
+) *Deleter {
+
+	cfg := params.Config
+	return &Deleter{
+		context: &deleterContext{
+			Resource: resource,
+			cfg:      cfg,
+			rateLimiter: quotas.NewDynamicRateLimiter(func() float64 {
+				return float64(cfg.RPS())
+			}),
+			logger: resource.GetLogger().WithTags(tag.ComponentNamespaceDeleter),
+		},
+	}
+}
+
+// Start starts the namespace deleter
+func (d *Deleter) Start() error {
+	workerOpts := worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), deleterContextKey, d.context),
+	}
+	deleterWorker := worker.New(d.context.GetSDKClient(), TaskQueueName, workerOpts)
+	deleterWorker.RegisterWorkflowWithOptions(DeleteNamespaceWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	deleterWorker.RegisterActivityWithOptions(MarkNamespaceDeletedActivity, activity.RegisterOptions{Name: markNamespaceDeletedActivityName})
+	deleterWorker.RegisterActivityWithOptions(UpdateProgressActivity, activity.RegisterOptions{Name: updateProgressActivityName})
+	deleterWorker.RegisterActivityWithOptions(TerminateExecutionsActivity, activity.RegisterOptions{Name: terminateExecutionsActivityName})
+	deleterWorker.RegisterActivityWithOptions(DeleteExecutionsActivity, activity.RegisterOptions{Name: deleteExecutionsActivityName})
+	deleterWorker.RegisterActivityWithOptions(DeleteVisibilityActivity, activity.RegisterOptions{Name: deleteVisibilityActivityName})
+	deleterWorker.RegisterActivityWithOptions(DeleteTaskQueuesActivity, activity.RegisterOptions{Name: deleteTaskQueuesActivityName})
+	deleterWorker.RegisterActivityWithOptions(DeleteNamespaceRecordActivity, activity.RegisterOptions{Name: deleteNamespaceRecordActivityName})
+
+	return deleterWorker.Start()
+}
@@ -0,0 +1,253 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"strconv"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/cache"
+)
+
+const (
+	// TaskQueueName is the task queue of the namespace deletion workflows
+	TaskQueueName = "temporal-sys-delete-namespace-taskqueue"
+	// WorkflowTypeName is the workflow type of the namespace deletion workflows
+	WorkflowTypeName = "temporal-sys-delete-namespace-workflow"
+	// WorkflowIDPrefix is the prefix of the deletion workflow ID, followed by the name of the namespace
+	WorkflowIDPrefix = "temporal-sys-delete-namespace"
+
+	markNamespaceDeletedActivityName  = "temporal-sys-delete-namespace-mark-deleted-activity"
+	updateProgressActivityName        = "temporal-sys-delete-namespace-update-progress-activity"
+	terminateExecutionsActivityName   = "temporal-sys-delete-namespace-terminate-executions-activity"
+	deleteExecutionsActivityName      = "temporal-sys-delete-namespace-delete-executions-activity"
+	deleteVisibilityActivityName      = "temporal-sys-delete-namespace-delete-visibility-activity"
+	deleteTaskQueuesActivityName      = "temporal-sys-delete-namespace-delete-task-queues-activity"
+	deleteNamespaceRecordActivityName = "temporal-sys-delete-namespace-delete-record-activity"
+
+	// InfiniteDuration is a long duration (20 yrs) we use for infinite timeouts
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+
+	// namespaceCacheRefreshDelay lets every host see the deleted status before executions are terminated,
+	// so that no new execution is started behind the deleter
+	namespaceCacheRefreshDelay = 3 * cache.NamespaceCacheRefreshInterval
+	// closeTasksDrainDelay lets the history service process the tasks of terminated executions before they are deleted
+	closeTasksDrainDelay = time.Minute
+	// deleteTasksDrainDelay lets the history service process the delete tasks of the executions before the
+	// visibility records left behind are swept
+	deleteTasksDrainDelay = time.Minute
+	scanHeartbeatTimeout  = time.Minute
+)
+
+const (
+	// ProgressDataKeyPrefix is the prefix of the namespace data keys which report the progress of the deletion
+	ProgressDataKeyPrefix = "temporal-system.deletion."
+
+	progressStageKey                    = ProgressDataKeyPrefix + "stage"
+	progressTerminatedExecutionsKey     = ProgressDataKeyPrefix + "terminated-executions"
+	progressDeletedExecutionsKey        = ProgressDataKeyPrefix + "deleted-executions"
+	progressDeletedVisibilityRecordsKey = ProgressDataKeyPrefix + "deleted-visibility-records"
+	progressDeletedTaskQueuesKey        = ProgressDataKeyPrefix + "deleted-task-queues"
+)
+
+const (
+	// StageTerminatingExecutions is the stage during which the open executions are terminated
+	StageTerminatingExecutions = "terminating-executions"
+	// StageDeletingExecutions is the stage during which executions and their histories are deleted
+	StageDeletingExecutions = "deleting-executions"
+	// StageDeletingVisibility is the stage during which the visibility records left behind are deleted
+	StageDeletingVisibility = "deleting-visibility"
+	// StageDeletingTaskQueues is the stage during which the task queues and their tasks are deleted
+	StageDeletingTaskQueues = "deleting-task-queues"
+	// StageDeletingNamespace is the last stage, during which the namespace record is deleted
+	StageDeletingNamespace = "deleting-namespace"
+)
+
+type (
+	// DeleteNamespaceParams is the input of the namespace deletion workflow
+	DeleteNamespaceParams struct {
+		Namespace   string
+		NamespaceID string
+	}
+
+	// Progress reports how far the deletion of a namespace went, it is stored in the namespace data
+	Progress struct {
+		Stage                    string
+		TerminatedExecutions     int64
+		DeletedExecutions        int64
+		DeletedVisibilityRecords int64
+		DeletedTaskQueues        int64
+	}
+
+	// DeleteExecutionsResult is the result of a pass of the delete executions activity
+	DeleteExecutionsResult struct {
+		Deleted int64
+		// Open is the number of executions which were still open, they are left to the next pass
+		Open int64
+		// TaskQueues are the names of the task queues used by the deleted executions
+		TaskQueues []string
+	}
+)
+
+var (
+	retryForeverPolicy = temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+	}
+
+	shortActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: InfiniteDuration,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &retryForeverPolicy,
+	}
+
+	scanActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: InfiniteDuration,
+		StartToCloseTimeout:    InfiniteDuration,
+		HeartbeatTimeout:       scanHeartbeatTimeout,
+		RetryPolicy:            &retryForeverPolicy,
+	}
+)
+
+// DeleteNamespaceWorkflow deletes a namespace along with all of its data. The namespace is marked as deleted first,
+// then its executions are terminated and deleted with their histories and visibility records, then its task queues
+// are deleted and finally the namespace record itself. The progress is written to the namespace data.
+func DeleteNamespaceWorkflow(ctx workflow.Context, params DeleteNamespaceParams) error {
+	logger := workflow.GetLogger(ctx)
+	shortCtx := workflow.WithActivityOptions(ctx, shortActivityOptions)
+	scanCtx := workflow.WithActivityOptions(ctx, scanActivityOptions)
+
+	progress := Progress{Stage: StageTerminatingExecutions}
+	if err := workflow.ExecuteActivity(shortCtx, markNamespaceDeletedActivityName, params, progress).Get(ctx, nil); err != nil {
+		return err
+	}
+	if err := workflow.Sleep(ctx, namespaceCacheRefreshDelay); err != nil {
+		return err
+	}
+
+	taskQueues := make(map[string]struct{})
+	for {
+		var terminated int64
+		if err := workflow.ExecuteActivity(scanCtx, terminateExecutionsActivityName, params, progress).Get(ctx, &terminated); err != nil {
+			return err
+		}
+		progress.TerminatedExecutions += terminated
+		progress.Stage = StageDeletingExecutions
+		if err := workflow.ExecuteActivity(shortCtx, updateProgressActivityName, params, progress).Get(ctx, nil); err != nil {
+			return err
+		}
+		if err := workflow.Sleep(ctx, closeTasksDrainDelay); err != nil {
+			return err
+		}
+
+		var result DeleteExecutionsResult
+		if err := workflow.ExecuteActivity(scanCtx, deleteExecutionsActivityName, params, progress).Get(ctx, &result); err != nil {
+			return err
+		}
+		progress.DeletedExecutions += result.Deleted
+		for _, taskQueue := range result.TaskQueues {
+			taskQueues[taskQueue] = struct{}{}
+		}
+		if result.Open == 0 {
+			break
+		}
+		// executions started before every host saw the namespace as deleted, or which failed to terminate
+		logger.Info("Open executions left behind, terminating them again.")
+		progress.Stage = StageTerminatingExecutions
+	}
+
+	if err := workflow.Sleep(ctx, deleteTasksDrainDelay); err != nil {
+		return err
+	}
+	progress.Stage = StageDeletingVisibility
+	var deletedVisibilityRecords int64
+	if err := workflow.ExecuteActivity(scanCtx, deleteVisibilityActivityName, params, progress).Get(ctx, &deletedVisibilityRecords); err != nil {
+		return err
+	}
+	progress.DeletedVisibilityRecords += deletedVisibilityRecords
+
+	progress.Stage = StageDeletingTaskQueues
+	taskQueueNames := make([]string, 0, len(taskQueues))
+	for taskQueue := range taskQueues {
+		taskQueueNames = append(taskQueueNames, taskQueue)
+	}
+	var deletedTaskQueues int64
+	if err := workflow.ExecuteActivity(scanCtx, deleteTaskQueuesActivityName, params, progress, taskQueueNames).Get(ctx, &deletedTaskQueues); err != nil {
+		return err
+	}
+	progress.DeletedTaskQueues += deletedTaskQueues
+
+	progress.Stage = StageDeletingNamespace
+	if err := workflow.ExecuteActivity(shortCtx, updateProgressActivityName, params, progress).Get(ctx, nil); err != nil {
+		return err
+	}
+	if err := workflow.ExecuteActivity(shortCtx, deleteNamespaceRecordActivityName, params).Get(ctx, nil); err != nil {
+		return err
+	}
+	logger.Info("Namespace deleted.")
+	return nil
+}
+
+// GetWorkflowID returns the ID of the deletion workflow of a namespace
+func GetWorkflowID(namespace string) string {
+	return WorkflowIDPrefix + "-" + namespace
+}
+
+// ProgressFromData reads the progress of the deletion from the namespace data, it returns false
+// if the deletion of the namespace has not started
+func ProgressFromData(data map[string]string) (Progress, bool) {
+	stage, ok := data[progressStageKey]
+	if !ok {
+		return Progress{}, false
+	}
+	parse := func(key string) int64 {
+		value, _ := strconv.ParseInt(data[key], 10, 64)
+		return value
+	}
+	return Progress{
+		Stage:                    stage,
+		TerminatedExecutions:     parse(progressTerminatedExecutionsKey),
+		DeletedExecutions:        parse(progressDeletedExecutionsKey),
+		DeletedVisibilityRecords: parse(progressDeletedVisibilityRecordsKey),
+		DeletedTaskQueues:        parse(progressDeletedTaskQueuesKey),
+	}, true
+}
+
+func (p Progress) toData(data map[string]string) map[string]string {
+	result := make(map[string]string, len(data)+5)
+	for key, value := range data {
+		result[key] = value
+	}
+	result[progressStageKey] = p.Stage
+	result[progressTerminatedExecutionsKey] = strconv.FormatInt(p.TerminatedExecutions, 10)
+	result[progressDeletedExecutionsKey] = strconv.FormatInt(p.DeletedExecutions, 10)
+	result[progressDeletedVisibilityRecordsKey] = strconv.FormatInt(p.DeletedVisibilityRecords, 10)
+	result[progressDeletedTaskQueuesKey] = strconv.FormatInt(p.DeletedTaskQueues, 10)
+	return result
+}
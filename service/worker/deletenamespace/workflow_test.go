@@ -0,0 +1,158 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type workflowSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env      *testsuite.TestWorkflowEnvironment
+	params   DeleteNamespaceParams
+	progress []Progress
+}
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.params = DeleteNamespaceParams{Namespace: "test-namespace", NamespaceID: "test-namespace-id"}
+	s.progress = nil
+	s.env = s.NewTestWorkflowEnvironment()
+	s.env.RegisterWorkflowWithOptions(DeleteNamespaceWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.env.RegisterActivityWithOptions(MarkNamespaceDeletedActivity, activity.RegisterOptions{Name: markNamespaceDeletedActivityName})
+	s.env.RegisterActivityWithOptions(UpdateProgressActivity, activity.RegisterOptions{Name: updateProgressActivityName})
+	s.env.RegisterActivityWithOptions(TerminateExecutionsActivity, activity.RegisterOptions{Name: terminateExecutionsActivityName})
+	s.env.RegisterActivityWithOptions(DeleteExecutionsActivity, activity.RegisterOptions{Name: deleteExecutionsActivityName})
+	s.env.RegisterActivityWithOptions(DeleteVisibilityActivity, activity.RegisterOptions{Name: deleteVisibilityActivityName})
+	s.env.RegisterActivityWithOptions(DeleteTaskQueuesActivity, activity.RegisterOptions{Name: deleteTaskQueuesActivityName})
+	s.env.RegisterActivityWithOptions(DeleteNamespaceRecordActivity, activity.RegisterOptions{Name: deleteNamespaceRecordActivityName})
+
+	s.env.OnActivity(markNamespaceDeletedActivityName, mock.Anything, s.params, mock.Anything).Return(
+		func(_ context.Context, _ DeleteNamespaceParams, progress Progress) error {
+			s.progress = append(s.progress, progress)
+			return nil
+		}).Once()
+	s.env.OnActivity(updateProgressActivityName, mock.Anything, s.params, mock.Anything).Return(
+		func(_ context.Context, _ DeleteNamespaceParams, progress Progress) error {
+			s.progress = append(s.progress, progress)
+			return nil
+		})
+}
+
+func (s *workflowSuite) TearDownTest() {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestDeleteNamespace() {
+	s.env.OnActivity(terminateExecutionsActivityName, mock.Anything, s.params, mock.Anything).Return(int64(3), nil).Once()
+	s.env.OnActivity(deleteExecutionsActivityName, mock.Anything, s.params, mock.Anything).Return(DeleteExecutionsResult{
+		Deleted:    5,
+		TaskQueues: []string{"tq"},
+	}, nil).Once()
+	s.env.OnActivity(deleteVisibilityActivityName, mock.Anything, s.params, mock.Anything).Return(int64(2), nil).Once()
+	s.env.OnActivity(deleteTaskQueuesActivityName, mock.Anything, s.params, mock.Anything, []string{"tq"}).Return(int64(2), nil).Once()
+	s.env.OnActivity(deleteNamespaceRecordActivityName, mock.Anything, s.params).Return(nil).Once()
+
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	s.Equal([]Progress{
+		{Stage: StageTerminatingExecutions},
+		{Stage: StageDeletingExecutions, TerminatedExecutions: 3},
+		{
+			Stage:                    StageDeletingNamespace,
+			TerminatedExecutions:     3,
+			DeletedExecutions:        5,
+			DeletedVisibilityRecords: 2,
+			DeletedTaskQueues:        2,
+		},
+	}, s.progress)
+}
+
+func (s *workflowSuite) TestDeleteNamespace_OpenExecutionsLeft() {
+	s.env.OnActivity(terminateExecutionsActivityName, mock.Anything, s.params, mock.Anything).Return(int64(3), nil).Once()
+	s.env.OnActivity(terminateExecutionsActivityName, mock.Anything, s.params, mock.Anything).Return(int64(1), nil).Once()
+	s.env.OnActivity(deleteExecutionsActivityName, mock.Anything, s.params, mock.Anything).Return(DeleteExecutionsResult{
+		Deleted:    2,
+		Open:       1,
+		TaskQueues: []string{"tq1"},
+	}, nil).Once()
+	s.env.OnActivity(deleteExecutionsActivityName, mock.Anything, s.params, mock.Anything).Return(DeleteExecutionsResult{
+		Deleted:    2,
+		TaskQueues: []string{"tq2"},
+	}, nil).Once()
+	s.env.OnActivity(deleteVisibilityActivityName, mock.Anything, s.params, mock.Anything).Return(int64(0), nil).Once()
+	s.env.OnActivity(deleteTaskQueuesActivityName, mock.Anything, s.params, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ DeleteNamespaceParams, _ Progress, taskQueueNames []string) (int64, error) {
+			s.ElementsMatch([]string{"tq1", "tq2"}, taskQueueNames)
+			return int64(len(taskQueueNames)), nil
+		}).Once()
+	s.env.OnActivity(deleteNamespaceRecordActivityName, mock.Anything, s.params).Return(nil).Once()
+
+	s.env.ExecuteWorkflow(WorkflowTypeName, s.params)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	last := s.progress[len(s.progress)-1]
+	s.Equal(Progress{
+		Stage:                StageDeletingNamespace,
+		TerminatedExecutions: 4,
+		DeletedExecutions:    4,
+		DeletedTaskQueues:    2,
+	}, last)
+}
+
+func TestProgressData(t *testing.T) {
+	progress := Progress{
+		Stage:                    StageDeletingVisibility,
+		TerminatedExecutions:     1,
+		DeletedExecutions:        2,
+		DeletedVisibilityRecords: 3,
+		DeletedTaskQueues:        4,
+	}
+	data := progress.toData(map[string]string{"key": "value"})
+	require.Equal(t, "value", data["key"])
+
+	parsed, ok := ProgressFromData(data)
+	require.True(t, ok)
+	require.Equal(t, progress, parsed)
+
+	_, ok = ProgressFromData(map[string]string{"key": "value"})
+	require.False(t, ok)
+}
@@ -31,17 +31,24 @@ import (
 	es "go.temporal.io/server/common/elasticsearch"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/messaging"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
 type (
-	// Indexer used to consumer data from kafka then send to ElasticSearch
+	// Indexer used to consumer data from kafka or from the visibility queue of the persistence store
+	// then send to ElasticSearch
 	Indexer struct {
 		config              *Config
 		kafkaClient         messaging.Client
+		visibilityQueue     persistence.VisibilityQueue
+		serviceResolver     membership.ServiceResolver
+		hostInfo            *membership.HostInfo
 		esClient            es.Client
+		esConfig            *es.Config
 		logger              log.Logger
 		metricsClient       metrics.Client
 		visibilityProcessor *indexProcessor
@@ -51,6 +58,8 @@ type (
 	// Config contains all configs for indexer
 	Config struct {
 		IndexerConcurrency       dynamicconfig.IntPropertyFn
+		QueuePollInterval        dynamicconfig.DurationPropertyFn // only used with the persistence visibility queue
+		QueueBatchSize           dynamicconfig.IntPropertyFn      // only used with the persistence visibility queue
 		ESProcessorNumOfWorkers  dynamicconfig.IntPropertyFn
 		ESProcessorBulkActions   dynamicconfig.IntPropertyFn // max number of requests in bulk
 		ESProcessorBulkSize      dynamicconfig.IntPropertyFn // max total size of bytes in bulk
@@ -63,15 +72,21 @@ const (
 	visibilityProcessorName = "visibility-processor"
 )
 
-// NewIndexer create a new Indexer
-func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esConfig *es.Config,
+// NewIndexer create a new Indexer, the visibility queue, service resolver and host info are only used
+// when the advanced visibility store is configured with the persistence visibility queue
+func NewIndexer(config *Config, client messaging.Client, visibilityQueue persistence.VisibilityQueue,
+	serviceResolver membership.ServiceResolver, hostInfo *membership.HostInfo, esClient es.Client, esConfig *es.Config,
 	logger log.Logger, metricsClient metrics.Client) *Indexer {
 	logger = logger.WithTags(tag.ComponentIndexer)
 
 	return &Indexer{
 		config:              config,
 		kafkaClient:         client,
+		visibilityQueue:     visibilityQueue,
+		serviceResolver:     serviceResolver,
+		hostInfo:            hostInfo,
 		esClient:            esClient,
+		esConfig:            esConfig,
 		logger:              logger,
 		metricsClient:       metricsClient,
 		visibilityIndexName: esConfig.Indices[common.VisibilityAppName],
@@ -79,17 +94,30 @@ func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esC
 }
 
 // Start indexer
-func (x Indexer) Start() error {
-	visibilityApp := common.VisibilityAppName
+func (x *Indexer) Start() error {
 	visConsumerName := getConsumerName(x.visibilityIndexName)
-	x.visibilityProcessor = newIndexProcessor(visibilityApp, visConsumerName, x.kafkaClient, x.esClient,
+	var consumer messaging.Consumer
+	if x.esConfig.UsePersistenceVisibilityQueue() {
+		consumer = newQueueConsumer(x.visibilityQueue, visConsumerName, x.config, x.serviceResolver, x.hostInfo, x.logger)
+	} else {
+		kafkaConsumer, err := x.kafkaClient.NewConsumer(common.VisibilityAppName, visConsumerName, x.config.IndexerConcurrency())
+		if err != nil {
+			x.logger.Info("", tag.LifeCycleStartFailed, tag.Error(err))
+			return err
+		}
+		consumer = kafkaConsumer
+	}
+
+	x.visibilityProcessor = newIndexProcessor(consumer, x.esClient,
 		visibilityProcessorName, x.visibilityIndexName, x.config, x.logger, x.metricsClient)
 	return x.visibilityProcessor.Start()
 }
 
 // Stop indexer
-func (x Indexer) Stop() {
-	x.visibilityProcessor.Stop()
+func (x *Indexer) Stop() {
+	if x.visibilityProcessor != nil {
+		x.visibilityProcessor.Stop()
+	}
 }
 
 func getConsumerName(topic string) string {
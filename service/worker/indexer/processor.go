@@ -47,9 +47,6 @@ import (
 )
 
 type indexProcessor struct {
-	appName         string
-	consumerName    string
-	kafkaClient     messaging.Client
 	consumer        messaging.Consumer
 	esClient        es.Client
 	esProcessor     ESProcessor
@@ -76,12 +73,10 @@ var (
 	errUnknownMessageType = serviceerror.NewInvalidArgument("unknown message type")
 )
 
-func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Client, esClient es.Client,
+func newIndexProcessor(consumer messaging.Consumer, esClient es.Client,
 	esProcessorName, esIndexName string, config *Config, logger log.Logger, metricsClient metrics.Client) *indexProcessor {
 	return &indexProcessor{
-		appName:         appName,
-		consumerName:    consumerName,
-		kafkaClient:     kafkaClient,
+		consumer:        consumer,
 		esClient:        esClient,
 		esProcessorName: esProcessorName,
 		esIndexName:     esIndexName,
@@ -99,13 +94,7 @@ func (p *indexProcessor) Start() error {
 	}
 
 	p.logger.Info("", tag.LifeCycleStarting)
-	consumer, err := p.kafkaClient.NewConsumer(p.appName, p.consumerName, p.config.IndexerConcurrency())
-	if err != nil {
-		p.logger.Info("", tag.LifeCycleStartFailed, tag.Error(err))
-		return err
-	}
-
-	if err := consumer.Start(); err != nil {
+	if err := p.consumer.Start(); err != nil {
 		p.logger.Info("", tag.LifeCycleStartFailed, tag.Error(err))
 		return err
 	}
@@ -116,7 +105,6 @@ func (p *indexProcessor) Start() error {
 		return err
 	}
 
-	p.consumer = consumer
 	p.esProcessor = esProcessor
 	p.shutdownWG.Add(1)
 	go p.processorPump()
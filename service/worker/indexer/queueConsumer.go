@@ -0,0 +1,327 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/messaging"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// queueConsumer delivers the messages of the visibility queue of the persistence store to the index processor,
+	// it is the persistence counterpart of the kafka consumer. Every partition of the queue is owned by a single
+	// worker host, which polls it on its own.
+	queueConsumer struct {
+		queue      persistence.VisibilityQueue
+		partitions []*queuePartition
+		logger     log.Logger
+
+		status     int32
+		msgC       chan messaging.Message
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+
+	// queuePartition tracks the read and ack levels of a partition of the visibility queue
+	queuePartition struct {
+		consumer        *queueConsumer
+		partition       int
+		consumerName    string
+		ownershipKey    string
+		config          *Config
+		serviceResolver membership.ServiceResolver
+		hostInfo        *membership.HostInfo
+		logger          log.Logger
+
+		sync.Mutex
+		isOwner         bool
+		readLevel       int64
+		ackLevel        int64
+		persistedLevel  int64
+		outstandingIDs  []int64        // IDs of the delivered messages not covered by the ack level, ascending
+		outstandingMsgs map[int64]bool // message ID -> is acked
+	}
+
+	// queueMessage is a message of the visibility queue, its offset is its ID within its partition
+	queueMessage struct {
+		partition *queuePartition
+		message   *persistence.QueueMessage
+	}
+)
+
+const (
+	queueAckLevelUpdateInterval = 10 * time.Second
+)
+
+var _ messaging.Consumer = (*queueConsumer)(nil)
+var _ messaging.Message = (*queueMessage)(nil)
+
+func newQueueConsumer(
+	queue persistence.VisibilityQueue,
+	consumerName string,
+	config *Config,
+	serviceResolver membership.ServiceResolver,
+	hostInfo *membership.HostInfo,
+	logger log.Logger,
+) *queueConsumer {
+	c := &queueConsumer{
+		queue:      queue,
+		logger:     logger.WithTags(tag.ComponentIndexerProcessor),
+		status:     common.DaemonStatusInitialized,
+		msgC:       make(chan messaging.Message, config.QueueBatchSize()),
+		shutdownCh: make(chan struct{}),
+	}
+	numPartitions := queue.NumPartitions()
+	for partition := 0; partition < numPartitions; partition++ {
+		c.partitions = append(c.partitions, &queuePartition{
+			consumer:        c,
+			partition:       partition,
+			consumerName:    consumerName,
+			ownershipKey:    fmt.Sprintf("%v-%v", consumerName, partition),
+			config:          config,
+			serviceResolver: serviceResolver,
+			hostInfo:        hostInfo,
+			logger:          c.logger.WithTags(tag.KafkaPartition(int32(partition))),
+			outstandingMsgs: make(map[int64]bool),
+		})
+	}
+	return c
+}
+
+func (c *queueConsumer) Start() error {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+
+	c.shutdownWG.Add(len(c.partitions))
+	for _, partition := range c.partitions {
+		go partition.pollLoop()
+	}
+	go func() {
+		c.shutdownWG.Wait()
+		close(c.msgC)
+	}()
+	return nil
+}
+
+// Stop stops the consumer
+func (c *queueConsumer) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(c.shutdownCh)
+	if success := common.AwaitWaitGroup(&c.shutdownWG, time.Minute); !success {
+		c.logger.Warn("Visibility queue consumer timed out on shutdown.")
+	}
+	for _, partition := range c.partitions {
+		partition.persistAckLevel()
+	}
+}
+
+// Messages return the message channel for this consumer
+func (c *queueConsumer) Messages() <-chan messaging.Message {
+	return c.msgC
+}
+
+func (p *queuePartition) pollLoop() {
+	defer p.consumer.shutdownWG.Done()
+
+	pollTimer := time.NewTimer(0)
+	defer pollTimer.Stop()
+	ackLevelTicker := time.NewTicker(queueAckLevelUpdateInterval)
+	defer ackLevelTicker.Stop()
+
+	for {
+		select {
+		case <-p.consumer.shutdownCh:
+			return
+		case <-ackLevelTicker.C:
+			p.persistAckLevel()
+		case <-pollTimer.C:
+			hasMore := p.poll()
+			if hasMore {
+				pollTimer.Reset(0)
+			} else {
+				pollTimer.Reset(p.config.QueuePollInterval())
+			}
+		}
+	}
+}
+
+// poll reads the next batch of messages and delivers them, it returns true if there may be more messages to read
+func (p *queuePartition) poll() bool {
+	// Only one worker is responsible for a partition of the visibility queue. When the ring is under
+	// reconfiguration, it is possible that two workers deliver the same messages for a small period of time,
+	// this is fine as documents are indexed with external versioning.
+	if !p.checkOwnership() {
+		return false
+	}
+
+	p.Lock()
+	readLevel := p.readLevel
+	p.Unlock()
+
+	batchSize := p.config.QueueBatchSize()
+	messages, err := p.consumer.queue.ReadMessages(p.partition, readLevel, batchSize)
+	if err != nil {
+		p.logger.Warn("Failed to read messages from visibility queue.", tag.ReadLevel(readLevel), tag.Error(err))
+		return false
+	}
+
+	for _, message := range messages {
+		p.Lock()
+		if !p.isOwner || message.ID <= p.readLevel {
+			p.Unlock()
+			return false
+		}
+		p.readLevel = message.ID
+		p.outstandingIDs = append(p.outstandingIDs, message.ID)
+		p.outstandingMsgs[message.ID] = false
+		p.Unlock()
+
+		select {
+		case p.consumer.msgC <- &queueMessage{partition: p, message: message}:
+		case <-p.consumer.shutdownCh:
+			return false
+		}
+	}
+	return len(messages) == batchSize
+}
+
+// checkOwnership returns true if the current host owns the partition, the ack level is reloaded
+// from the persistence store whenever the ownership is acquired
+func (p *queuePartition) checkOwnership() bool {
+	info, err := p.serviceResolver.Lookup(p.ownershipKey)
+	if err != nil {
+		p.logger.Info("Failed to lookup host info. Skip current run")
+		return false
+	}
+	isOwner := info.Identity() == p.hostInfo.Identity()
+
+	p.Lock()
+	wasOwner := p.isOwner
+	p.isOwner = isOwner
+	p.Unlock()
+
+	if !isOwner || wasOwner {
+		return isOwner
+	}
+
+	ackLevel, err := p.consumer.queue.GetAckLevel(p.partition, p.consumerName)
+	if err != nil {
+		p.logger.Warn("Failed to get ack level of visibility queue.", tag.Error(err))
+		p.Lock()
+		p.isOwner = false
+		p.Unlock()
+		return false
+	}
+
+	p.logger.Info("Acquired visibility queue partition.", tag.AckLevel(ackLevel))
+	p.Lock()
+	defer p.Unlock()
+	p.readLevel = ackLevel
+	p.ackLevel = ackLevel
+	p.persistedLevel = ackLevel
+	p.outstandingIDs = nil
+	p.outstandingMsgs = make(map[int64]bool)
+	return true
+}
+
+func (p *queuePartition) ack(messageID int64) {
+	p.Lock()
+	defer p.Unlock()
+
+	if _, ok := p.outstandingMsgs[messageID]; !ok {
+		// message delivered before the ownership was lost
+		return
+	}
+	p.outstandingMsgs[messageID] = true
+
+	for len(p.outstandingIDs) > 0 && p.outstandingMsgs[p.outstandingIDs[0]] {
+		p.ackLevel = p.outstandingIDs[0]
+		delete(p.outstandingMsgs, p.ackLevel)
+		p.outstandingIDs = p.outstandingIDs[1:]
+	}
+}
+
+func (p *queuePartition) persistAckLevel() {
+	p.Lock()
+	isOwner := p.isOwner
+	ackLevel := p.ackLevel
+	persistedLevel := p.persistedLevel
+	p.Unlock()
+
+	if !isOwner || ackLevel == persistedLevel {
+		return
+	}
+
+	if err := p.consumer.queue.UpdateAckLevel(p.partition, ackLevel, p.consumerName); err != nil {
+		p.logger.Warn("Failed to update ack level of visibility queue.", tag.AckLevel(ackLevel), tag.Error(err))
+		return
+	}
+
+	p.Lock()
+	if p.persistedLevel < ackLevel {
+		p.persistedLevel = ackLevel
+	}
+	p.Unlock()
+}
+
+func (m *queueMessage) Value() []byte {
+	return m.message.Payload
+}
+
+func (m *queueMessage) Partition() int32 {
+	return int32(m.partition.partition)
+}
+
+func (m *queueMessage) Offset() int64 {
+	return m.message.ID
+}
+
+func (m *queueMessage) Ack() error {
+	m.partition.ack(m.message.ID)
+	return nil
+}
+
+// Nack moves the message to the DLQ of its partition of the visibility queue
+func (m *queueMessage) Nack() error {
+	if err := m.partition.consumer.queue.PublishToDLQ(m.partition.partition, m.message.Payload); err != nil {
+		m.partition.logger.Error("Failed to publish message to DLQ of visibility queue.", tag.TaskID(m.message.ID), tag.Error(err))
+		return err
+	}
+	m.partition.ack(m.message.ID)
+	return nil
+}
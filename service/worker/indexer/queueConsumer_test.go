@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type queueConsumerSuite struct {
+	suite.Suite
+
+	controller          *gomock.Controller
+	mockQueue           *persistence.MockVisibilityQueue
+	mockServiceResolver *membership.MockServiceResolver
+	hostInfo            *membership.HostInfo
+
+	consumer  *queueConsumer
+	partition *queuePartition
+}
+
+const (
+	testConsumerName = "test-index-consumer"
+	// testOwnershipKey is the ownership key of the partition 1 of the queue
+	testOwnershipKey = "test-index-consumer-1"
+)
+
+func TestQueueConsumerSuite(t *testing.T) {
+	s := new(queueConsumerSuite)
+	suite.Run(t, s)
+}
+
+func (s *queueConsumerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockQueue = persistence.NewMockVisibilityQueue(s.controller)
+	s.mockServiceResolver = membership.NewMockServiceResolver(s.controller)
+	s.hostInfo = membership.NewHostInfo("127.0.0.1:7239", nil)
+
+	config := &Config{
+		QueuePollInterval: dynamicconfig.GetDurationPropertyFn(time.Minute),
+		QueueBatchSize:    dynamicconfig.GetIntPropertyFn(3),
+	}
+	s.mockQueue.EXPECT().NumPartitions().Return(2)
+	s.consumer = newQueueConsumer(s.mockQueue, testConsumerName, config, s.mockServiceResolver, s.hostInfo, loggerimpl.NewNopLogger())
+	s.Len(s.consumer.partitions, 2)
+	s.partition = s.consumer.partitions[1]
+}
+
+func (s *queueConsumerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *queueConsumerSuite) TestPoll_NotOwner() {
+	s.mockServiceResolver.EXPECT().Lookup(testOwnershipKey).Return(membership.NewHostInfo("127.0.0.2:7239", nil), nil)
+
+	s.False(s.partition.poll())
+	s.Empty(s.consumer.msgC)
+}
+
+func (s *queueConsumerSuite) TestPoll_DeliverMessages() {
+	s.mockServiceResolver.EXPECT().Lookup(testOwnershipKey).Return(s.hostInfo, nil).Times(2)
+	s.mockQueue.EXPECT().GetAckLevel(1, testConsumerName).Return(int64(9), nil)
+	s.mockQueue.EXPECT().ReadMessages(1, int64(9), 3).Return(s.queueMessages(10, 11, 12), nil)
+	s.mockQueue.EXPECT().ReadMessages(1, int64(12), 3).Return(nil, nil)
+
+	s.True(s.partition.poll())
+	for _, id := range []int64{10, 11, 12} {
+		msg := <-s.consumer.Messages()
+		s.Equal(int32(1), msg.Partition())
+		s.Equal(id, msg.Offset())
+		s.Equal([]byte{byte(id)}, msg.Value())
+	}
+	s.False(s.partition.poll())
+}
+
+func (s *queueConsumerSuite) TestAck_AckLevel() {
+	s.mockServiceResolver.EXPECT().Lookup(testOwnershipKey).Return(s.hostInfo, nil)
+	s.mockQueue.EXPECT().GetAckLevel(1, testConsumerName).Return(int64(-1), nil)
+	s.mockQueue.EXPECT().ReadMessages(1, int64(-1), 3).Return(s.queueMessages(0, 1), nil)
+	s.mockQueue.EXPECT().PublishToDLQ(1, []byte{1}).Return(nil)
+	s.mockQueue.EXPECT().UpdateAckLevel(1, int64(1), testConsumerName).Return(nil)
+
+	s.False(s.partition.poll())
+	msg0 := <-s.consumer.Messages()
+	msg1 := <-s.consumer.Messages()
+
+	s.NoError(msg1.Nack())
+	s.Equal(int64(-1), s.partition.ackLevel)
+	s.NoError(msg0.Ack())
+	s.Equal(int64(1), s.partition.ackLevel)
+	s.Empty(s.partition.outstandingMsgs)
+
+	s.partition.persistAckLevel()
+	// ack level did not move, nothing to persist
+	s.partition.persistAckLevel()
+}
+
+func (s *queueConsumerSuite) TestNack_DLQFailure() {
+	s.mockServiceResolver.EXPECT().Lookup(testOwnershipKey).Return(s.hostInfo, nil)
+	s.mockQueue.EXPECT().GetAckLevel(1, testConsumerName).Return(int64(-1), nil)
+	s.mockQueue.EXPECT().ReadMessages(1, int64(-1), 3).Return(s.queueMessages(0), nil)
+	s.mockQueue.EXPECT().PublishToDLQ(1, []byte{0}).Return(errors.New("some error"))
+
+	s.False(s.partition.poll())
+	msg := <-s.consumer.Messages()
+	s.Error(msg.Nack())
+	s.Equal(int64(-1), s.partition.ackLevel)
+}
+
+func (s *queueConsumerSuite) TestAck_OwnershipLost() {
+	s.mockServiceResolver.EXPECT().Lookup(testOwnershipKey).Return(s.hostInfo, nil)
+	s.mockQueue.EXPECT().GetAckLevel(1, testConsumerName).Return(int64(-1), nil)
+	s.mockQueue.EXPECT().ReadMessages(1, int64(-1), 3).Return(s.queueMessages(0), nil)
+	s.False(s.partition.poll())
+	msg := <-s.consumer.Messages()
+
+	s.mockServiceResolver.EXPECT().Lookup(testOwnershipKey).Return(membership.NewHostInfo("127.0.0.2:7239", nil), nil)
+	s.False(s.partition.poll())
+
+	// the ack level is reloaded when the ownership is acquired again
+	s.mockServiceResolver.EXPECT().Lookup(testOwnershipKey).Return(s.hostInfo, nil)
+	s.mockQueue.EXPECT().GetAckLevel(1, testConsumerName).Return(int64(5), nil)
+	s.mockQueue.EXPECT().ReadMessages(1, int64(5), 3).Return(nil, nil)
+	s.False(s.partition.poll())
+
+	s.NoError(msg.Ack())
+	s.Equal(int64(5), s.partition.ackLevel)
+}
+
+func (s *queueConsumerSuite) queueMessages(ids ...int64) []*persistence.QueueMessage {
+	var messages []*persistence.QueueMessage
+	for _, id := range ids {
+		messages = append(messages, &persistence.QueueMessage{ID: id, Payload: []byte{byte(id)}})
+	}
+	return messages
+}
@@ -24,7 +24,10 @@
 
 package executions
 
-import "go.temporal.io/server/service/worker/scanner/executor"
+import (
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/worker/scanner/executor"
+)
 
 type handlerStatus = executor.TaskStatus
 
@@ -37,11 +40,43 @@ const (
 const scannerTaskQueuePrefix = "temporal-sys-executions-scanner"
 
 // validateHandler validates a single execution.
-// It operates in two phases: collection step and validation step.
+// It operates in three phases: collection step, validation step and fix step.
 // During collection step information from persistence is read for this workflow execution.
 // During validation step invariants are asserted over everything that was read.
-// In the future its possible to add a third step here which will additionally take automatic recovery actions if validation failed.
+// During fix step, which only runs in fix mode, invariants that failed validation attempt to repair the execution.
 func (s *Scavenger) validateHandler(key *executionKey) handlerStatus {
-	// TODO: implement this
+	execution, err := s.loadExecution(key)
+	if err != nil {
+		s.logger.Error("failed to load execution",
+			tag.ShardID(key.shardID),
+			tag.WorkflowNamespaceID(key.namespaceID),
+			tag.WorkflowID(key.workflowID),
+			tag.WorkflowRunID(key.runID),
+			tag.Error(err))
+		return handlerStatusErr
+	}
+	if execution == nil {
+		// execution was deleted after it was listed
+		return handlerStatusDone
+	}
+
+	checkResults := s.invariants.RunChecks(execution)
+	var fixResults []FixResult
+	if s.params.Fix {
+		fixResults = s.invariants.RunFixes(execution, checkResults)
+	}
+	s.record(key, checkResults, fixResults)
+
+	for _, result := range checkResults {
+		if result.CheckResultType == CheckResultTypeHealthy {
+			continue
+		}
+		s.logger.Warn("execution failed invariant check",
+			tag.ShardID(key.shardID),
+			tag.WorkflowNamespaceID(key.namespaceID),
+			tag.WorkflowID(key.workflowID),
+			tag.WorkflowRunID(key.runID),
+			tag.Value(result))
+	}
 	return handlerStatusDone
 }
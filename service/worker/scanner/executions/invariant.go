@@ -0,0 +1,220 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"fmt"
+
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	// InvariantType identifies an invariant which is asserted over a single workflow execution
+	InvariantType string
+
+	// CheckResultType is the result type of checking an invariant
+	CheckResultType string
+
+	// FixResultType is the result type of fixing an invariant
+	FixResultType string
+
+	// CheckResult is the result of checking a single invariant against a single execution
+	CheckResult struct {
+		CheckResultType CheckResultType
+		InvariantType   InvariantType
+		Info            string
+		InfoDetails     string
+	}
+
+	// FixResult is the result of fixing a single invariant for a single execution
+	FixResult struct {
+		FixResultType FixResultType
+		InvariantType InvariantType
+		Info          string
+		InfoDetails   string
+	}
+
+	// Execution is a workflow execution as loaded from persistence
+	Execution struct {
+		ShardID int
+		State   *p.WorkflowMutableState
+	}
+
+	// Invariant represents an invariant of a workflow execution.
+	// Check must never mutate persistence, Fix is only invoked when running in fix mode
+	// and is expected to re-check the invariant before taking any action.
+	Invariant interface {
+		Check(execution *Execution) CheckResult
+		Fix(execution *Execution) FixResult
+		InvariantType() InvariantType
+	}
+
+	// ExecutionManagerProvider returns the execution manager for a given shard
+	ExecutionManagerProvider func(shardID int) (p.ExecutionManager, error)
+
+	// invariantManager runs a set of invariants over an execution
+	invariantManager struct {
+		invariants []Invariant
+	}
+)
+
+const (
+	// InvariantTypeHistoryExists asserts that the history of an execution exists and is readable up to NextEventID
+	InvariantTypeHistoryExists InvariantType = "history_exists"
+	// InvariantTypeOpenCurrentExecution asserts that an open execution is pointed to by its current execution record
+	InvariantTypeOpenCurrentExecution InvariantType = "open_current_execution"
+	// InvariantTypeMutableStateChecksum asserts that the persisted mutable state checksum verifies
+	InvariantTypeMutableStateChecksum InvariantType = "mutable_state_checksum"
+	// InvariantTypeEventReferences asserts that pending timers and activities refer to events which exist
+	InvariantTypeEventReferences InvariantType = "event_references"
+)
+
+const (
+	// CheckResultTypeHealthy indicates the invariant holds
+	CheckResultTypeHealthy CheckResultType = "healthy"
+	// CheckResultTypeCorrupted indicates the invariant does not hold
+	CheckResultTypeCorrupted CheckResultType = "corrupted"
+	// CheckResultTypeFailed indicates the invariant could not be checked
+	CheckResultTypeFailed CheckResultType = "failed"
+)
+
+const (
+	// FixResultTypeFixed indicates the corruption was fixed
+	FixResultTypeFixed FixResultType = "fixed"
+	// FixResultTypeSkipped indicates there was nothing to fix or the invariant does not support fixing
+	FixResultTypeSkipped FixResultType = "skipped"
+	// FixResultTypeFailed indicates fixing was attempted but failed
+	FixResultTypeFailed FixResultType = "failed"
+)
+
+// AllInvariantTypes returns every invariant type known to the scanner
+func AllInvariantTypes() []InvariantType {
+	return []InvariantType{
+		InvariantTypeHistoryExists,
+		InvariantTypeOpenCurrentExecution,
+		InvariantTypeMutableStateChecksum,
+		InvariantTypeEventReferences,
+	}
+}
+
+func newInvariant(
+	invariantType InvariantType,
+	historyDB p.HistoryManager,
+	executionDBProvider ExecutionManagerProvider,
+) (Invariant, error) {
+	switch invariantType {
+	case InvariantTypeHistoryExists:
+		return newHistoryExists(historyDB, executionDBProvider), nil
+	case InvariantTypeOpenCurrentExecution:
+		return newOpenCurrentExecution(executionDBProvider), nil
+	case InvariantTypeMutableStateChecksum:
+		return newMutableStateChecksum(), nil
+	case InvariantTypeEventReferences:
+		return newEventReferences(), nil
+	default:
+		return nil, fmt.Errorf("unknown invariant type: %v", invariantType)
+	}
+}
+
+func newInvariantManager(
+	invariantTypes []InvariantType,
+	historyDB p.HistoryManager,
+	executionDBProvider ExecutionManagerProvider,
+) (*invariantManager, error) {
+	if len(invariantTypes) == 0 {
+		invariantTypes = AllInvariantTypes()
+	}
+	invariants := make([]Invariant, 0, len(invariantTypes))
+	for _, invariantType := range invariantTypes {
+		invariant, err := newInvariant(invariantType, historyDB, executionDBProvider)
+		if err != nil {
+			return nil, err
+		}
+		invariants = append(invariants, invariant)
+	}
+	return &invariantManager{invariants: invariants}, nil
+}
+
+// RunChecks checks every invariant against the execution and returns all results
+func (m *invariantManager) RunChecks(execution *Execution) []CheckResult {
+	results := make([]CheckResult, 0, len(m.invariants))
+	for _, invariant := range m.invariants {
+		results = append(results, invariant.Check(execution))
+	}
+	return results
+}
+
+// RunFixes fixes every invariant which was found to be corrupted by RunChecks.
+// Once an invariant reports the execution as fixed, the remaining invariants are skipped
+// because the persisted state they were checked against is no longer current.
+func (m *invariantManager) RunFixes(execution *Execution, checkResults []CheckResult) []FixResult {
+	var results []FixResult
+	for i, invariant := range m.invariants {
+		if checkResults[i].CheckResultType != CheckResultTypeCorrupted {
+			continue
+		}
+		result := invariant.Fix(execution)
+		results = append(results, result)
+		if result.FixResultType == FixResultTypeFixed {
+			break
+		}
+	}
+	return results
+}
+
+func healthy(invariantType InvariantType) CheckResult {
+	return CheckResult{
+		CheckResultType: CheckResultTypeHealthy,
+		InvariantType:   invariantType,
+	}
+}
+
+func corrupted(invariantType InvariantType, info string, details string) CheckResult {
+	return CheckResult{
+		CheckResultType: CheckResultTypeCorrupted,
+		InvariantType:   invariantType,
+		Info:            info,
+		InfoDetails:     details,
+	}
+}
+
+func checkFailed(invariantType InvariantType, info string, err error) CheckResult {
+	return CheckResult{
+		CheckResultType: CheckResultTypeFailed,
+		InvariantType:   invariantType,
+		Info:            info,
+		InfoDetails:     err.Error(),
+	}
+}
+
+// fixSkippedForCheck converts a non corrupted check result into a skipped fix result
+func fixSkippedForCheck(result CheckResult) FixResult {
+	return FixResult{
+		FixResultType: FixResultTypeSkipped,
+		InvariantType: result.InvariantType,
+		Info:          fmt.Sprintf("check result was %v: %v", result.CheckResultType, result.Info),
+		InfoDetails:   result.InfoDetails,
+	}
+}
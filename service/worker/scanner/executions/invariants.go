@@ -0,0 +1,383 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/checksum"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	historyExists struct {
+		historyDB           p.HistoryManager
+		executionDBProvider ExecutionManagerProvider
+	}
+
+	openCurrentExecution struct {
+		executionDBProvider ExecutionManagerProvider
+	}
+
+	mutableStateChecksum struct{}
+
+	eventReferences struct{}
+)
+
+const (
+	historyPageSize = 100
+)
+
+var _ Invariant = (*historyExists)(nil)
+var _ Invariant = (*openCurrentExecution)(nil)
+var _ Invariant = (*mutableStateChecksum)(nil)
+var _ Invariant = (*eventReferences)(nil)
+
+func newHistoryExists(
+	historyDB p.HistoryManager,
+	executionDBProvider ExecutionManagerProvider,
+) *historyExists {
+	return &historyExists{
+		historyDB:           historyDB,
+		executionDBProvider: executionDBProvider,
+	}
+}
+
+func (h *historyExists) InvariantType() InvariantType {
+	return InvariantTypeHistoryExists
+}
+
+// Check reads the whole history branch of the execution and verifies that it ends right before NextEventID
+func (h *historyExists) Check(execution *Execution) CheckResult {
+	executionInfo := execution.State.ExecutionInfo
+	branchToken, err := getCurrentBranchToken(execution.State)
+	if err != nil {
+		return checkFailed(h.InvariantType(), "failed to get current branch token", err)
+	}
+
+	shardID := execution.ShardID
+	lastEventID := common.EmptyEventID
+	var pageToken []byte
+	for {
+		resp, err := h.historyDB.ReadHistoryBranch(&p.ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    executionInfo.NextEventID,
+			PageSize:      historyPageSize,
+			NextPageToken: pageToken,
+			ShardID:       &shardID,
+		})
+		if err != nil {
+			if _, ok := err.(*serviceerror.NotFound); ok {
+				return corrupted(h.InvariantType(), "history branch does not exist", err.Error())
+			}
+			return checkFailed(h.InvariantType(), "failed to read history branch", err)
+		}
+		if len(resp.HistoryEvents) > 0 {
+			lastEventID = resp.HistoryEvents[len(resp.HistoryEvents)-1].GetEventId()
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	if lastEventID != executionInfo.NextEventID-1 {
+		return corrupted(
+			h.InvariantType(),
+			"history branch ends before next event id",
+			fmt.Sprintf("last event id: %v, next event id: %v", lastEventID, executionInfo.NextEventID),
+		)
+	}
+	return healthy(h.InvariantType())
+}
+
+// Fix deletes the execution since it cannot make progress without its history
+func (h *historyExists) Fix(execution *Execution) FixResult {
+	if result := h.Check(execution); result.CheckResultType != CheckResultTypeCorrupted {
+		return fixSkippedForCheck(result)
+	}
+	return deleteExecution(h.InvariantType(), h.executionDBProvider, execution)
+}
+
+func newOpenCurrentExecution(
+	executionDBProvider ExecutionManagerProvider,
+) *openCurrentExecution {
+	return &openCurrentExecution{
+		executionDBProvider: executionDBProvider,
+	}
+}
+
+func (o *openCurrentExecution) InvariantType() InvariantType {
+	return InvariantTypeOpenCurrentExecution
+}
+
+// Check verifies that an open execution is the current run of its workflow
+func (o *openCurrentExecution) Check(execution *Execution) CheckResult {
+	executionInfo := execution.State.ExecutionInfo
+	if !isOpen(executionInfo) {
+		return healthy(o.InvariantType())
+	}
+
+	executionDB, err := o.executionDBProvider(execution.ShardID)
+	if err != nil {
+		return checkFailed(o.InvariantType(), "failed to get execution manager", err)
+	}
+	current, err := executionDB.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+		NamespaceID: executionInfo.NamespaceID,
+		WorkflowID:  executionInfo.WorkflowID,
+	})
+	switch err.(type) {
+	case nil:
+		if current.RunID == executionInfo.RunID {
+			return healthy(o.InvariantType())
+		}
+	case *serviceerror.NotFound:
+	default:
+		return checkFailed(o.InvariantType(), "failed to get current execution", err)
+	}
+
+	// the execution might have been closed or deleted since it was loaded
+	stillOpen, err := concreteExecutionStillOpen(executionDB, executionInfo)
+	if err != nil {
+		return checkFailed(o.InvariantType(), "failed to reload concrete execution", err)
+	}
+	if !stillOpen {
+		return healthy(o.InvariantType())
+	}
+
+	if current == nil {
+		return corrupted(o.InvariantType(), "current execution does not exist for open execution", "")
+	}
+	return corrupted(
+		o.InvariantType(),
+		"current execution points to a different run",
+		fmt.Sprintf("current run id: %v", current.RunID),
+	)
+}
+
+// Fix deletes the concrete execution since it can never be reached through its workflow id
+func (o *openCurrentExecution) Fix(execution *Execution) FixResult {
+	if result := o.Check(execution); result.CheckResultType != CheckResultTypeCorrupted {
+		return fixSkippedForCheck(result)
+	}
+	return deleteExecution(o.InvariantType(), o.executionDBProvider, execution)
+}
+
+func newMutableStateChecksum() *mutableStateChecksum {
+	return &mutableStateChecksum{}
+}
+
+func (m *mutableStateChecksum) InvariantType() InvariantType {
+	return InvariantTypeMutableStateChecksum
+}
+
+// Check verifies the persisted mutable state against its checksum, executions without a checksum are healthy
+func (m *mutableStateChecksum) Check(execution *Execution) CheckResult {
+	csum := execution.State.Checksum
+	if len(csum.Value) == 0 {
+		return healthy(m.InvariantType())
+	}
+	if csum.Version != p.MutableStateChecksumPayloadV1 {
+		return checkFailed(
+			m.InvariantType(),
+			"unknown checksum payload version",
+			fmt.Errorf("checksum payload version: %v", csum.Version),
+		)
+	}
+	if err := checksum.Verify(p.NewMutableStateChecksumPayload(execution.State), csum); err != nil {
+		return corrupted(m.InvariantType(), "mutable state checksum mismatch", err.Error())
+	}
+	return healthy(m.InvariantType())
+}
+
+// Fix is not supported, a checksum mismatch requires manual investigation
+func (m *mutableStateChecksum) Fix(execution *Execution) FixResult {
+	result := m.Check(execution)
+	if result.CheckResultType != CheckResultTypeCorrupted {
+		return fixSkippedForCheck(result)
+	}
+	return FixResult{
+		FixResultType: FixResultTypeSkipped,
+		InvariantType: m.InvariantType(),
+		Info:          "mutable state checksum mismatch cannot be fixed automatically",
+		InfoDetails:   result.InfoDetails,
+	}
+}
+
+func newEventReferences() *eventReferences {
+	return &eventReferences{}
+}
+
+func (e *eventReferences) InvariantType() InvariantType {
+	return InvariantTypeEventReferences
+}
+
+// Check verifies that pending timers and activities refer to events below NextEventID
+func (e *eventReferences) Check(execution *Execution) CheckResult {
+	nextEventID := execution.State.ExecutionInfo.NextEventID
+	for timerID, ti := range execution.State.TimerInfos {
+		if ti.GetStartedId() >= nextEventID {
+			return corrupted(
+				e.InvariantType(),
+				"pending timer refers to event beyond next event id",
+				fmt.Sprintf("timer id: %v, started event id: %v, next event id: %v", timerID, ti.GetStartedId(), nextEventID),
+			)
+		}
+	}
+	for scheduleID, ai := range execution.State.ActivityInfos {
+		if scheduleID >= nextEventID {
+			return corrupted(
+				e.InvariantType(),
+				"pending activity refers to event beyond next event id",
+				fmt.Sprintf("scheduled event id: %v, next event id: %v", scheduleID, nextEventID),
+			)
+		}
+		// started id is either a sentinel or a real event written after the scheduled event
+		if ai.StartedID > 0 && (ai.StartedID >= nextEventID || ai.StartedID <= scheduleID) {
+			return corrupted(
+				e.InvariantType(),
+				"pending activity refers to invalid started event",
+				fmt.Sprintf("scheduled event id: %v, started event id: %v, next event id: %v", scheduleID, ai.StartedID, nextEventID),
+			)
+		}
+	}
+	return healthy(e.InvariantType())
+}
+
+// Fix is not supported, dangling references require manual investigation
+func (e *eventReferences) Fix(execution *Execution) FixResult {
+	result := e.Check(execution)
+	if result.CheckResultType != CheckResultTypeCorrupted {
+		return fixSkippedForCheck(result)
+	}
+	return FixResult{
+		FixResultType: FixResultTypeSkipped,
+		InvariantType: e.InvariantType(),
+		Info:          "dangling event references cannot be fixed automatically",
+		InfoDetails:   result.InfoDetails,
+	}
+}
+
+func isOpen(executionInfo *p.WorkflowExecutionInfo) bool {
+	switch executionInfo.State {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED, enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING:
+		return true
+	default:
+		return false
+	}
+}
+
+func getCurrentBranchToken(state *p.WorkflowMutableState) ([]byte, error) {
+	if state.VersionHistories == nil {
+		return state.ExecutionInfo.BranchToken, nil
+	}
+	versionHistory, err := state.VersionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		return nil, err
+	}
+	return versionHistory.GetBranchToken(), nil
+}
+
+func concreteExecutionStillOpen(
+	executionDB p.ExecutionManager,
+	executionInfo *p.WorkflowExecutionInfo,
+) (bool, error) {
+	resp, err := executionDB.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		NamespaceID: executionInfo.NamespaceID,
+		Execution: commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.WorkflowID,
+			RunId:      executionInfo.RunID,
+		},
+	})
+	switch err.(type) {
+	case nil:
+		return isOpen(resp.State.ExecutionInfo), nil
+	case *serviceerror.NotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// deleteExecution deletes the concrete execution and, if it points to this run, the current execution record
+func deleteExecution(
+	invariantType InvariantType,
+	executionDBProvider ExecutionManagerProvider,
+	execution *Execution,
+) FixResult {
+	fixFailed := func(info string, err error) FixResult {
+		return FixResult{
+			FixResultType: FixResultTypeFailed,
+			InvariantType: invariantType,
+			Info:          info,
+			InfoDetails:   err.Error(),
+		}
+	}
+
+	executionInfo := execution.State.ExecutionInfo
+	executionDB, err := executionDBProvider(execution.ShardID)
+	if err != nil {
+		return fixFailed("failed to get execution manager", err)
+	}
+	if err := executionDB.DeleteWorkflowExecution(&p.DeleteWorkflowExecutionRequest{
+		NamespaceID: executionInfo.NamespaceID,
+		WorkflowID:  executionInfo.WorkflowID,
+		RunID:       executionInfo.RunID,
+	}); err != nil {
+		return fixFailed("failed to delete concrete execution", err)
+	}
+
+	current, err := executionDB.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+		NamespaceID: executionInfo.NamespaceID,
+		WorkflowID:  executionInfo.WorkflowID,
+	})
+	switch err.(type) {
+	case nil:
+		if current.RunID == executionInfo.RunID {
+			if err := executionDB.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+				NamespaceID: executionInfo.NamespaceID,
+				WorkflowID:  executionInfo.WorkflowID,
+				RunID:       executionInfo.RunID,
+			}); err != nil {
+				return fixFailed("failed to delete current execution", err)
+			}
+		}
+	case *serviceerror.NotFound:
+	default:
+		return fixFailed("failed to get current execution", err)
+	}
+
+	return FixResult{
+		FixResultType: FixResultTypeFixed,
+		InvariantType: invariantType,
+		Info:          "deleted execution",
+	}
+}
@@ -29,48 +29,90 @@ import (
 	"sync/atomic"
 	"time"
 
-	"go.temporal.io/server/client/frontend"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/worker/scanner/executor"
 )
 
-type ()
-
 type (
 	// Scavenger is the type that holds the state for executions scavenger daemon
 	Scavenger struct {
-		params ScannerWorkflowParams
-		// TODO: currently frontend client does not support scan visibility records without namespace filter (need to chat with Bowei to understand if querying without namespace filter is an issue)? Maybe we go directly to elasticSearch?
-		frontendClient frontend.Client // used to query visibility
-		historyDB      p.HistoryManager
-		executor       executor.Executor
-		metrics        metrics.Client
-		logger         log.Logger
-		stats          stats
-		status         int32
-		stopC          chan struct{}
-		stopWG         sync.WaitGroup
+		params              ScannerWorkflowParams
+		firstShardID        int
+		lastShardID         int
+		executionDBProvider ExecutionManagerProvider
+		invariants          *invariantManager
+		executor            executor.Executor
+		metrics             metrics.Client
+		logger              log.Logger
+		stats               stats
+		status              int32
+		stopC               chan struct{}
+		stopWG              sync.WaitGroup
 	}
 
 	// ScannerWorkflowParams are the parameters passed to the executions scanner workflow
 	ScannerWorkflowParams struct {
-		VisibilityQuery string // optionally can be provided to limit the scope of the scan
+		// InvariantTypes are the invariants to check, all invariants are checked if empty
+		InvariantTypes []InvariantType
+		// Fix indicates corrupted executions should be fixed, otherwise executions are only checked
+		Fix bool
+	}
+
+	// ScanBatchParams are the parameters of a run of the executions scavenger over a batch of history shards
+	ScanBatchParams struct {
+		ScannerWorkflowParams
+		// FirstShardID is the first history shard of the batch
+		FirstShardID int
+	}
+
+	// ScanBatchResult is the output of a run of the executions scavenger over a batch of history shards
+	ScanBatchResult struct {
+		Report ScanReport
+		// NextShardID is the first history shard of the next batch
+		NextShardID int
+		// Completed indicates the batch was the last one
+		Completed bool
+	}
+
+	// ScanReport is the queryable output of a run of the executions scavenger
+	ScanReport struct {
+		ExecutionsCount   int64
+		CorruptedCount    int64
+		CheckFailedCount  int64
+		FixedCount        int64
+		FixFailedCount    int64
+		CorruptionsByType map[InvariantType]int64
+		// CorruptedExecutions is capped at maxReportedCorruptions entries
+		CorruptedExecutions []CorruptedExecution
+	}
 
-		// add other fields here such as: bool generateReport, string outputLocation, bool runInDryMode etc...
+	// CorruptedExecution is a single corrupted execution found by the scavenger
+	CorruptedExecution struct {
+		ShardID      int
+		NamespaceID  string
+		WorkflowID   string
+		RunID        string
+		CheckResults []CheckResult
+		FixResults   []FixResult
 	}
 
 	executionKey struct {
+		shardID     int
 		namespaceID string
 		workflowID  string
 		runID       string
 	}
 
 	stats struct {
-		// TODO: include stats here that should be tracked throughout execution of scavenger
+		sync.Mutex
+		report ScanReport
 	}
 
 	// executorTask is a runnable task that adheres to the executor.Task interface
@@ -83,40 +125,54 @@ type (
 
 var (
 	executionsBatchSize      = 32   // maximum number of executions we process concurrently
-	executionsPageSize       = 1000 // page size of executions read from visibility manager
+	executionsPageSize       = 1000 // page size of executions read from execution manager
 	executorPollInterval     = time.Minute
 	executorMaxDeferredTasks = 10000
+	maxReportedCorruptions   = 1000
 )
 
 // NewScavenger returns an instance of executions scavenger daemon
 // The Scavenger can be started by calling the Start() method on the
 // returned object. Calling the Start() method will result in one
-// complete iteration over all of the open workflow executions in the system. For
-// each executions, will attempt to validate the workflow execution and emit metrics/logs on validation failures.
+// complete iteration over all of the workflow executions in the history shards
+// from firstShardID to lastShardID, excluded. For
+// each execution, the configured invariants are checked and, if running in fix mode,
+// corrupted executions are fixed. Corruptions are emitted as metrics/logs and collected
+// into a report which is available through Report().
 //
-// The scavenger will retry on all persistence errors infinitely and will only stop under
-// two conditions
-//  - either all executions are processed successfully (or)
+// The scavenger will only stop under two conditions
+//  - either all executions are processed (or)
 //  - Stop() method is called to stop the scavenger
 func NewScavenger(
 	params ScannerWorkflowParams,
-	frontendClient frontend.Client,
+	firstShardID int,
+	lastShardID int,
 	historyDB p.HistoryManager,
+	executionDBProvider ExecutionManagerProvider,
 	metricsClient metrics.Client,
 	logger log.Logger,
-) *Scavenger {
+) (*Scavenger, error) {
+	invariants, err := newInvariantManager(params.InvariantTypes, historyDB, executionDBProvider)
+	if err != nil {
+		return nil, err
+	}
 	stopC := make(chan struct{})
 	taskExecutor := executor.NewFixedSizePoolExecutor(
 		executionsBatchSize, executorMaxDeferredTasks, metricsClient, metrics.ExecutionsScavengerScope)
 	return &Scavenger{
-		params:         params,
-		frontendClient: frontendClient,
-		historyDB:      historyDB,
-		metrics:        metricsClient,
-		logger:         logger,
-		stopC:          stopC,
-		executor:       taskExecutor,
-	}
+		params:              params,
+		firstShardID:        firstShardID,
+		lastShardID:         lastShardID,
+		executionDBProvider: executionDBProvider,
+		invariants:          invariants,
+		metrics:             metricsClient,
+		logger:              logger,
+		stats: stats{
+			report: ScanReport{CorruptionsByType: make(map[InvariantType]int64)},
+		},
+		stopC:    stopC,
+		executor: taskExecutor,
+	}, nil
 }
 
 // Start starts the scavenger
@@ -150,14 +206,53 @@ func (s *Scavenger) Alive() bool {
 	return atomic.LoadInt32(&s.status) == common.DaemonStatusStarted
 }
 
-// run does a single run over all executions and validates them
+// run does a single run over the executions of the shards and validates them
 func (s *Scavenger) run() {
-	// TODO: implement this
-	// 1. read from visibility records from frontend.Client
-	// 2. create executionTasks
-	// 3. pass them off to the executor to run
-	// 4. wait until the executor is done
-	// 5. emit metrics on the run of scavenger
+	defer func() {
+		s.emitStats()
+		go s.Stop()
+		s.stopWG.Done()
+	}()
+
+	for shardID := s.firstShardID; shardID < s.lastShardID; shardID++ {
+		if !s.scanShard(shardID) {
+			return
+		}
+	}
+
+	s.awaitExecutor()
+}
+
+// scanShard submits every execution of a single shard to the executor, it returns false if the scavenger should stop
+func (s *Scavenger) scanShard(shardID int) bool {
+	executionDB, err := s.executionDBProvider(shardID)
+	if err != nil {
+		s.logger.Error("failed to get execution manager", tag.ShardID(shardID), tag.Error(err))
+		return false
+	}
+
+	var pageToken []byte
+	for {
+		resp, err := executionDB.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  executionsPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			s.logger.Error("listConcreteExecutions error", tag.ShardID(shardID), tag.Error(err))
+			return false
+		}
+
+		for _, info := range resp.ExecutionInfos {
+			if !s.executor.Submit(s.newTask(shardID, info.NamespaceID, info.WorkflowID, info.RunID)) {
+				return false
+			}
+		}
+
+		pageToken = resp.PageToken
+		if len(pageToken) == 0 {
+			return true
+		}
+	}
 }
 
 func (s *Scavenger) awaitExecutor() {
@@ -174,13 +269,124 @@ func (s *Scavenger) awaitExecutor() {
 }
 
 func (s *Scavenger) emitStats() {
-	// TODO: implement this, this will emit metrics after a full run of executor scavenger is finished
+	report := s.Report()
+	s.metrics.UpdateGauge(metrics.ExecutionsScavengerScope, metrics.ExecutionsProcessedCount, float64(report.ExecutionsCount))
+	s.metrics.UpdateGauge(metrics.ExecutionsScavengerScope, metrics.ExecutionsCorruptedCount, float64(report.CorruptedCount))
+	s.metrics.UpdateGauge(metrics.ExecutionsScavengerScope, metrics.ExecutionsCheckFailedCount, float64(report.CheckFailedCount))
+	s.metrics.UpdateGauge(metrics.ExecutionsScavengerScope, metrics.ExecutionsFixedCount, float64(report.FixedCount))
+	s.metrics.UpdateGauge(metrics.ExecutionsScavengerScope, metrics.ExecutionsFixFailedCount, float64(report.FixFailedCount))
+}
+
+// Report returns a snapshot of the report of the current run
+func (s *Scavenger) Report() ScanReport {
+	s.stats.Lock()
+	defer s.stats.Unlock()
+
+	report := s.stats.report
+	report.CorruptionsByType = make(map[InvariantType]int64, len(s.stats.report.CorruptionsByType))
+	for invariantType, count := range s.stats.report.CorruptionsByType {
+		report.CorruptionsByType[invariantType] = count
+	}
+	report.CorruptedExecutions = append([]CorruptedExecution(nil), s.stats.report.CorruptedExecutions...)
+	return report
+}
+
+// Merge adds the report of another run, e.g. over another batch of shards
+func (r *ScanReport) Merge(other ScanReport) {
+	r.ExecutionsCount += other.ExecutionsCount
+	r.CorruptedCount += other.CorruptedCount
+	r.CheckFailedCount += other.CheckFailedCount
+	r.FixedCount += other.FixedCount
+	r.FixFailedCount += other.FixFailedCount
+	for invariantType, count := range other.CorruptionsByType {
+		if r.CorruptionsByType == nil {
+			r.CorruptionsByType = make(map[InvariantType]int64)
+		}
+		r.CorruptionsByType[invariantType] += count
+	}
+	for _, execution := range other.CorruptedExecutions {
+		if len(r.CorruptedExecutions) >= maxReportedCorruptions {
+			break
+		}
+		r.CorruptedExecutions = append(r.CorruptedExecutions, execution)
+	}
+}
+
+// record adds the results of validating a single execution to the report
+func (s *Scavenger) record(
+	key *executionKey,
+	checkResults []CheckResult,
+	fixResults []FixResult,
+) {
+	s.stats.Lock()
+	defer s.stats.Unlock()
+
+	report := &s.stats.report
+	report.ExecutionsCount++
+
+	var corruptions []CheckResult
+	for _, result := range checkResults {
+		switch result.CheckResultType {
+		case CheckResultTypeCorrupted:
+			corruptions = append(corruptions, result)
+			report.CorruptionsByType[result.InvariantType]++
+		case CheckResultTypeFailed:
+			report.CheckFailedCount++
+		}
+	}
+	for _, result := range fixResults {
+		switch result.FixResultType {
+		case FixResultTypeFixed:
+			report.FixedCount++
+		case FixResultTypeFailed:
+			report.FixFailedCount++
+		}
+	}
+	if len(corruptions) == 0 {
+		return
+	}
+
+	report.CorruptedCount++
+	if len(report.CorruptedExecutions) < maxReportedCorruptions {
+		report.CorruptedExecutions = append(report.CorruptedExecutions, CorruptedExecution{
+			ShardID:      key.shardID,
+			NamespaceID:  key.namespaceID,
+			WorkflowID:   key.workflowID,
+			RunID:        key.runID,
+			CheckResults: corruptions,
+			FixResults:   fixResults,
+		})
+	}
+}
+
+// loadExecution loads the mutable state of a single execution, it returns nil if the execution no longer exists
+func (s *Scavenger) loadExecution(key *executionKey) (*Execution, error) {
+	executionDB, err := s.executionDBProvider(key.shardID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := executionDB.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		NamespaceID: key.namespaceID,
+		Execution: commonpb.WorkflowExecution{
+			WorkflowId: key.workflowID,
+			RunId:      key.runID,
+		},
+	})
+	switch err.(type) {
+	case nil:
+		return &Execution{ShardID: key.shardID, State: resp.State}, nil
+	case *serviceerror.NotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
 }
 
 // newTask returns a new instance of an executable task which will process a single execution
-func (s *Scavenger) newTask(namespaceID, workflowID, runID string) executor.Task {
+func (s *Scavenger) newTask(shardID int, namespaceID, workflowID, runID string) executor.Task {
 	return &executorTask{
 		executionKey: executionKey{
+			shardID:     shardID,
 			namespaceID: namespaceID,
 			workflowID:  workflowID,
 			runID:       runID,
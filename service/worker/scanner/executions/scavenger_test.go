@@ -23,3 +23,237 @@
 // THE SOFTWARE.
 
 package executions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.uber.org/zap"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/checksum"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/mocks"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		historyDB    *mocks.HistoryV2Manager
+		executionDBs map[int]*mocks.ExecutionManager
+	}
+)
+
+const (
+	testNamespaceID = "test-namespace-id"
+	testNextEventID = int64(5)
+)
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	s.historyDB = &mocks.HistoryV2Manager{}
+	s.executionDBs = map[int]*mocks.ExecutionManager{
+		0: {},
+		1: {},
+	}
+	executorPollInterval = time.Millisecond * 50
+}
+
+func (s *ScavengerTestSuite) TearDownTest() {
+	s.historyDB.AssertExpectations(s.T())
+	for _, executionDB := range s.executionDBs {
+		executionDB.AssertExpectations(s.T())
+	}
+}
+
+func (s *ScavengerTestSuite) TestCheckOnly() {
+	s.setupExecutions()
+
+	report := s.runScavenger(ScannerWorkflowParams{
+		InvariantTypes: []InvariantType{InvariantTypeHistoryExists, InvariantTypeOpenCurrentExecution},
+	})
+	s.Equal(int64(3), report.ExecutionsCount)
+	s.Equal(int64(2), report.CorruptedCount)
+	s.Equal(int64(0), report.FixedCount)
+	s.Equal(map[InvariantType]int64{
+		InvariantTypeHistoryExists:        1,
+		InvariantTypeOpenCurrentExecution: 1,
+	}, report.CorruptionsByType)
+	s.Len(report.CorruptedExecutions, 2)
+	for _, corruptedExecution := range report.CorruptedExecutions {
+		s.Empty(corruptedExecution.FixResults)
+	}
+	s.executionDBs[0].AssertNotCalled(s.T(), "DeleteWorkflowExecution", mock.Anything)
+	s.executionDBs[1].AssertNotCalled(s.T(), "DeleteWorkflowExecution", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestFix() {
+	s.setupExecutions()
+	s.executionDBs[0].On("DeleteWorkflowExecution", &p.DeleteWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  "wf-orphan",
+		RunID:       "run-orphan",
+	}).Return(nil).Once()
+	s.executionDBs[1].On("DeleteWorkflowExecution", &p.DeleteWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  "wf-no-history",
+		RunID:       "run-no-history",
+	}).Return(nil).Once()
+	s.executionDBs[1].On("DeleteCurrentWorkflowExecution", &p.DeleteCurrentWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  "wf-no-history",
+		RunID:       "run-no-history",
+	}).Return(nil).Once()
+
+	report := s.runScavenger(ScannerWorkflowParams{
+		InvariantTypes: []InvariantType{InvariantTypeHistoryExists, InvariantTypeOpenCurrentExecution},
+		Fix:            true,
+	})
+	s.Equal(int64(3), report.ExecutionsCount)
+	s.Equal(int64(2), report.CorruptedCount)
+	s.Equal(int64(2), report.FixedCount)
+	s.Equal(int64(0), report.FixFailedCount)
+}
+
+func (s *ScavengerTestSuite) TestUnknownInvariant() {
+	_, err := NewScavenger(
+		ScannerWorkflowParams{InvariantTypes: []InvariantType{"unknown"}},
+		0,
+		1,
+		s.historyDB,
+		s.executionDBProvider,
+		metrics.NewClient(tally.NoopScope, metrics.Worker),
+		loggerimpl.NewNopLogger(),
+	)
+	s.Error(err)
+}
+
+func (s *ScavengerTestSuite) TestMutableStateChecksum() {
+	state := newTestMutableState("wf", "run", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING)
+	invariant := newMutableStateChecksum()
+
+	s.Equal(CheckResultTypeHealthy, invariant.Check(&Execution{State: state}).CheckResultType)
+
+	csum, err := checksum.GenerateCRC32(p.NewMutableStateChecksumPayload(state), p.MutableStateChecksumPayloadV1)
+	s.NoError(err)
+	state.Checksum = csum
+	s.Equal(CheckResultTypeHealthy, invariant.Check(&Execution{State: state}).CheckResultType)
+
+	state.ExecutionInfo.NextEventID++
+	s.Equal(CheckResultTypeCorrupted, invariant.Check(&Execution{State: state}).CheckResultType)
+	s.Equal(FixResultTypeSkipped, invariant.Fix(&Execution{State: state}).FixResultType)
+}
+
+func (s *ScavengerTestSuite) TestEventReferences() {
+	state := newTestMutableState("wf", "run", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING)
+	invariant := newEventReferences()
+
+	state.TimerInfos = map[string]*persistenceblobs.TimerInfo{"timer": {StartedId: 2}}
+	state.ActivityInfos = map[int64]*p.ActivityInfo{3: {ScheduleID: 3, StartedID: common.TransientEventID}}
+	s.Equal(CheckResultTypeHealthy, invariant.Check(&Execution{State: state}).CheckResultType)
+
+	state.ActivityInfos[3].StartedID = testNextEventID
+	s.Equal(CheckResultTypeCorrupted, invariant.Check(&Execution{State: state}).CheckResultType)
+
+	state.ActivityInfos = nil
+	state.TimerInfos["timer"].StartedId = testNextEventID
+	s.Equal(CheckResultTypeCorrupted, invariant.Check(&Execution{State: state}).CheckResultType)
+}
+
+// setupExecutions creates a healthy execution and an open execution which is not current on shard 0
+// and a closed execution with missing history on shard 1
+func (s *ScavengerTestSuite) setupExecutions() {
+	healthy := newTestMutableState("wf-healthy", "run-healthy", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED)
+	orphan := newTestMutableState("wf-orphan", "run-orphan", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING)
+	noHistory := newTestMutableState("wf-no-history", "run-no-history", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED)
+
+	s.setupShard(0, healthy, orphan)
+	s.setupShard(1, noHistory)
+
+	s.historyDB.On("ReadHistoryBranch", mock.MatchedBy(func(req *p.ReadHistoryBranchRequest) bool {
+		return string(req.BranchToken) != "run-no-history"
+	})).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{{EventId: 1}, {EventId: 2}, {EventId: 3}, {EventId: 4}},
+	}, nil)
+	s.historyDB.On("ReadHistoryBranch", mock.MatchedBy(func(req *p.ReadHistoryBranchRequest) bool {
+		return string(req.BranchToken) == "run-no-history"
+	})).Return(nil, serviceerror.NewNotFound("history not found"))
+
+	s.executionDBs[0].On("GetCurrentExecution", &p.GetCurrentExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  "wf-orphan",
+	}).Return(&p.GetCurrentExecutionResponse{RunID: "run-newer"}, nil)
+	s.executionDBs[1].On("GetCurrentExecution", &p.GetCurrentExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  "wf-no-history",
+	}).Return(&p.GetCurrentExecutionResponse{RunID: "run-no-history"}, nil).Maybe()
+}
+
+func (s *ScavengerTestSuite) setupShard(shardID int, states ...*p.WorkflowMutableState) {
+	executionDB := s.executionDBs[shardID]
+	infos := make([]*p.WorkflowExecutionInfo, 0, len(states))
+	for _, state := range states {
+		runID := state.ExecutionInfo.RunID
+		infos = append(infos, state.ExecutionInfo)
+		executionDB.On("GetWorkflowExecution", mock.MatchedBy(func(req *p.GetWorkflowExecutionRequest) bool {
+			return req.Execution.GetRunId() == runID
+		})).Return(&p.GetWorkflowExecutionResponse{State: state}, nil)
+	}
+	executionDB.On("ListConcreteExecutions", mock.Anything).Return(&p.ListConcreteExecutionsResponse{
+		ExecutionInfos: infos,
+	}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) executionDBProvider(shardID int) (p.ExecutionManager, error) {
+	return s.executionDBs[shardID], nil
+}
+
+func (s *ScavengerTestSuite) runScavenger(params ScannerWorkflowParams) ScanReport {
+	zapLogger, err := zap.NewDevelopment()
+	s.Require().NoError(err)
+	scvgr, err := NewScavenger(
+		params,
+		0,
+		len(s.executionDBs),
+		s.historyDB,
+		s.executionDBProvider,
+		metrics.NewClient(tally.NoopScope, metrics.Worker),
+		loggerimpl.NewLogger(zapLogger),
+	)
+	s.Require().NoError(err)
+
+	scvgr.Start()
+	timer := time.NewTimer(10 * time.Second)
+	select {
+	case <-scvgr.stopC:
+		timer.Stop()
+	case <-timer.C:
+		s.Fail("timed out waiting for scavenger to finish")
+	}
+	return scvgr.Report()
+}
+
+func newTestMutableState(workflowID string, runID string, state enumsspb.WorkflowExecutionState) *p.WorkflowMutableState {
+	return &p.WorkflowMutableState{
+		ExecutionInfo: &p.WorkflowExecutionInfo{
+			NamespaceID: testNamespaceID,
+			WorkflowID:  workflowID,
+			RunID:       runID,
+			State:       state,
+			NextEventID: testNextEventID,
+			BranchToken: []byte(runID),
+		},
+	}
+}
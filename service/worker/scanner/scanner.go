@@ -50,8 +50,9 @@ const (
 
 var (
 	defaultExecutionsScannerParams = executions.ScannerWorkflowParams{
-		// fullExecutionsScanDefaultQuery indicates the visibility scanner should scan through all open workflows
-		VisibilityQuery: "SELECT * from elasticSearch.executions WHERE state IS open", // TODO: depending on if we go straight to ES or through frontend this query will look different
+		// the scheduled scan checks every invariant and only reports corruptions
+		InvariantTypes: executions.AllInvariantTypes(),
+		Fix:            false,
 	}
 )
 
@@ -65,11 +65,17 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	// ExecutionsScannerReportQuery is the query type which returns the report of the executions scanner
+	ExecutionsScannerReportQuery = "report"
 )
 
 var (
 	tlScavengerHBInterval         = 10 * time.Second
 	executionsScavengerHBInterval = 10 * time.Second
+	// executionsScannerShardBatchSize is the number of history shards scanned by an executions scavenger activity,
+	// the report of the scanner is updated after each batch
+	executionsScannerShardBatchSize = 16
 
 	activityRetryPolicy = temporal.RetryPolicy{
 		InitialInterval:    10 * time.Second,
@@ -130,8 +136,26 @@ func ExecutionsScannerWorkflow(
 	executionsScannerWorkflowParams executions.ScannerWorkflowParams,
 ) error {
 
-	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), executionsScavengerActivityName, executionsScannerWorkflowParams)
-	return future.Get(ctx, nil)
+	report := executions.ScanReport{CorruptionsByType: make(map[executions.InvariantType]int64)}
+	if err := workflow.SetQueryHandler(ctx, ExecutionsScannerReportQuery, func() (executions.ScanReport, error) {
+		return report, nil
+	}); err != nil {
+		return err
+	}
+
+	params := executions.ScanBatchParams{ScannerWorkflowParams: executionsScannerWorkflowParams}
+	for {
+		var result executions.ScanBatchResult
+		future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), executionsScavengerActivityName, params)
+		if err := future.Get(ctx, &result); err != nil {
+			return err
+		}
+		report.Merge(result.Report)
+		if result.Completed {
+			return nil
+		}
+		params.FirstShardID = result.NextShardID
+	}
 }
 
 // HistoryScavengerActivity is the activity that runs history scavenger
@@ -181,24 +205,43 @@ func TaskQueueScavengerActivity(
 	return nil
 }
 
-// ExecutionsScavengerActivity is the activity that runs executions scavenger
+// ExecutionsScavengerActivity is the activity that runs executions scavenger over a batch of history shards
 func ExecutionsScavengerActivity(
 	activityCtx context.Context,
-	executionsScannerWorkflowParams executions.ScannerWorkflowParams,
-) error {
+	params executions.ScanBatchParams,
+) (executions.ScanBatchResult, error) {
 
 	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
-	scavenger := executions.NewScavenger(executionsScannerWorkflowParams, ctx.GetFrontendClient(), ctx.GetHistoryManager(), ctx.GetMetricsClient(), ctx.GetLogger())
-	ctx.GetLogger().Info("Starting executions scavenger")
+	numHistoryShards := ctx.cfg.Persistence.NumHistoryShards
+	lastShardID := params.FirstShardID + executionsScannerShardBatchSize
+	if lastShardID > numHistoryShards {
+		lastShardID = numHistoryShards
+	}
+	result := executions.ScanBatchResult{NextShardID: lastShardID, Completed: lastShardID == numHistoryShards}
+	scavenger, err := executions.NewScavenger(
+		params.ScannerWorkflowParams,
+		params.FirstShardID,
+		lastShardID,
+		ctx.GetHistoryManager(),
+		ctx.GetExecutionManager,
+		ctx.GetMetricsClient(),
+		ctx.GetLogger(),
+	)
+	if err != nil {
+		return result, temporal.NewNonRetryableApplicationError(err.Error(), "", nil)
+	}
+	ctx.GetLogger().Info("Starting executions scavenger", tag.ShardID(params.FirstShardID))
 	scavenger.Start()
 	for scavenger.Alive() {
 		activity.RecordHeartbeat(activityCtx)
 		if activityCtx.Err() != nil {
 			ctx.GetLogger().Info("activity context error, stopping scavenger", tag.Error(activityCtx.Err()))
 			scavenger.Stop()
-			return activityCtx.Err()
+			result.Report = scavenger.Report()
+			return result, activityCtx.Err()
 		}
 		time.Sleep(executionsScavengerHBInterval)
 	}
-	return nil
+	result.Report = scavenger.Report()
+	return result, nil
 }
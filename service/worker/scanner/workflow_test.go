@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/service/worker/scanner/executions"
 )
 
 type scannerWorkflowTestSuite struct {
@@ -55,8 +56,10 @@ func TestScannerWorkflowTestSuite(t *testing.T) {
 func (s *scannerWorkflowTestSuite) registerWorkflows(env *testsuite.TestWorkflowEnvironment) {
 	env.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 	env.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
+	env.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
 	env.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 	env.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
+	env.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
 }
 
 func (s *scannerWorkflowTestSuite) registerActivities(env *testsuite.TestActivityEnvironment) {
@@ -72,6 +75,48 @@ func (s *scannerWorkflowTestSuite) TestWorkflow() {
 	s.True(env.IsWorkflowCompleted())
 }
 
+func (s *scannerWorkflowTestSuite) TestExecutionsScannerWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	s.registerWorkflows(env)
+	params := executions.ScannerWorkflowParams{InvariantTypes: executions.AllInvariantTypes()}
+	env.OnActivity(executionsScavengerActivityName, mock.Anything, executions.ScanBatchParams{ScannerWorkflowParams: params}).
+		Return(executions.ScanBatchResult{
+			Report: executions.ScanReport{
+				ExecutionsCount:   10,
+				CorruptedCount:    1,
+				CorruptionsByType: map[executions.InvariantType]int64{executions.InvariantTypeHistoryExists: 1},
+			},
+			NextShardID: executionsScannerShardBatchSize,
+		}, nil).Once()
+	env.OnActivity(executionsScavengerActivityName, mock.Anything, executions.ScanBatchParams{ScannerWorkflowParams: params, FirstShardID: executionsScannerShardBatchSize}).
+		Return(func(_ context.Context, _ executions.ScanBatchParams) (executions.ScanBatchResult, error) {
+			// the report of the previous batches is available while the batch is scanned
+			value, err := env.QueryWorkflow(ExecutionsScannerReportQuery)
+			s.NoError(err)
+			var report executions.ScanReport
+			s.NoError(value.Get(&report))
+			s.Equal(int64(10), report.ExecutionsCount)
+
+			return executions.ScanBatchResult{
+				Report:      executions.ScanReport{ExecutionsCount: 5},
+				NextShardID: 2 * executionsScannerShardBatchSize,
+				Completed:   true,
+			}, nil
+		}).Once()
+	env.ExecuteWorkflow(executionsScannerWFTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+
+	value, err := env.QueryWorkflow(ExecutionsScannerReportQuery)
+	s.NoError(err)
+	var report executions.ScanReport
+	s.NoError(value.Get(&report))
+	s.Equal(int64(15), report.ExecutionsCount)
+	s.Equal(int64(1), report.CorruptedCount)
+	s.Equal(map[executions.InvariantType]int64{executions.InvariantTypeHistoryExists: 1}, report.CorruptionsByType)
+	env.AssertExpectations(s.T())
+}
+
 func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	env := s.NewTestActivityEnvironment()
 	s.registerActivities(env)
@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const schedulerContextKey = "schedulerContext"
+
+// StartWorkflowActivity starts the workflow of a schedule action, it's idempotent for a given request
+func StartWorkflowActivity(ctx context.Context, request StartWorkflowRequest) (commonpb.WorkflowExecution, error) {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	client := scheduler.clientBean.GetFrontendClient()
+
+	action := request.Action
+	workflowID := getWorkflowID(action, request.NominalTime)
+	resp, err := client.StartWorkflowExecution(ctx, &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                       request.Namespace,
+		WorkflowId:                      workflowID,
+		WorkflowType:                    &commonpb.WorkflowType{Name: action.WorkflowType},
+		TaskQueue:                       &taskqueuepb.TaskQueue{Name: action.TaskQueue},
+		Input:                           action.Input,
+		WorkflowExecutionTimeoutSeconds: int32(action.WorkflowExecutionTimeout.Seconds()),
+		WorkflowRunTimeoutSeconds:       int32(action.WorkflowRunTimeout.Seconds()),
+		WorkflowTaskTimeoutSeconds:      int32(action.WorkflowTaskTimeout.Seconds()),
+		Identity:                        SchedulerWFTypeName,
+		RequestId:                       request.RequestID,
+		WorkflowIdReusePolicy:           enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	})
+	if err != nil {
+		if alreadyStarted, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok {
+			// the workflow of this nominal time was started by an earlier attempt
+			return commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: alreadyStarted.RunId}, nil
+		}
+		scheduler.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerStartWorkflowFailures)
+		getActivityLogger(ctx).Error("Failed to start scheduled workflow", tag.WorkflowID(workflowID), tag.Error(err))
+		return commonpb.WorkflowExecution{}, err
+	}
+	scheduler.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerStartWorkflowSuccess)
+	return commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: resp.GetRunId()}, nil
+}
+
+// WatchWorkflowActivity waits for the workflow to close, following its continue as new chain
+func WatchWorkflowActivity(ctx context.Context, request WatchWorkflowRequest) error {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	client := scheduler.clientBean.GetFrontendClient()
+
+	execution := request.Execution
+	var pageToken []byte
+	for {
+		activity.RecordHeartbeat(ctx)
+		pollCtx, cancel := context.WithTimeout(ctx, watchLongPollTimeout)
+		resp, err := client.GetWorkflowExecutionHistory(pollCtx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace:              request.Namespace,
+			Execution:              &execution,
+			NextPageToken:          pageToken,
+			WaitForNewEvent:        true,
+			HistoryEventFilterType: enumspb.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT,
+			SkipArchival:           true,
+		})
+		cancel()
+		if err != nil {
+			if _, ok := err.(*serviceerror.NotFound); ok {
+				// the workflow is deleted, so it's closed as far as the schedule is concerned
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if pollCtx.Err() == context.DeadlineExceeded {
+				continue
+			}
+			return err
+		}
+
+		events := resp.GetHistory().GetEvents()
+		if len(events) == 0 {
+			pageToken = resp.NextPageToken
+			continue
+		}
+		closeEvent := events[len(events)-1]
+		if closeEvent.GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW {
+			return nil
+		}
+		execution.RunId = closeEvent.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId()
+		pageToken = nil
+	}
+}
+
+// CancelWorkflowActivity cancels or terminates a running workflow of the schedule
+func CancelWorkflowActivity(ctx context.Context, request CancelWorkflowRequest) error {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	client := scheduler.clientBean.GetFrontendClient()
+
+	// the run ID is omitted to cancel the current run of the continue as new chain
+	execution := &commonpb.WorkflowExecution{WorkflowId: request.Execution.GetWorkflowId()}
+	var err error
+	if request.Terminate {
+		_, err = client.TerminateWorkflowExecution(ctx, &workflowservice.TerminateWorkflowExecutionRequest{
+			Namespace:         request.Namespace,
+			WorkflowExecution: execution,
+			Reason:            fmt.Sprintf("terminated by the overlap policy of schedule %v", request.ScheduleID),
+			Identity:          SchedulerWFTypeName,
+		})
+	} else {
+		_, err = client.RequestCancelWorkflowExecution(ctx, &workflowservice.RequestCancelWorkflowExecutionRequest{
+			Namespace:         request.Namespace,
+			WorkflowExecution: execution,
+			Identity:          SchedulerWFTypeName,
+			RequestId:         uuid.New().String(),
+		})
+	}
+	if err != nil {
+		// NotFound means wf is not running or deleted
+		if _, ok := err.(*serviceerror.NotFound); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	wfInfo := activity.GetInfo(ctx)
+	return scheduler.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+		tag.WorkflowNamespace(common.SystemLocalNamespace),
+	)
+}
@@ -0,0 +1,194 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	scheduleWorkflowIDPrefix = "temporal-sys-scheduler:"
+
+	searchAttrCustomNamespace = "CustomNamespace"
+	searchAttrOperator        = "Operator"
+)
+
+type (
+	// Client manages the schedules of namespaces. The schedules are workflows in the system namespace,
+	// so the client must be created for the system namespace.
+	Client struct {
+		svcClient sdkclient.Client
+	}
+
+	// ScheduleListEntry is a schedule returned by List
+	ScheduleListEntry struct {
+		ScheduleID string
+		Operator   string
+	}
+)
+
+// NewClient creates a schedule client on top of a client of the system namespace
+func NewClient(svcClient sdkclient.Client) *Client {
+	return &Client{svcClient: svcClient}
+}
+
+// Create creates a schedule, it fails if a schedule with the same ID exists in the namespace
+func (c *Client) Create(ctx context.Context, namespace string, scheduleID string, schedule Schedule, operator string) error {
+	if namespace == "" || scheduleID == "" {
+		return serviceerror.NewInvalidArgument("must provide namespace and schedule id")
+	}
+	if err := schedule.Validate(); err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+	options := sdkclient.StartWorkflowOptions{
+		ID:                       getScheduleWorkflowID(namespace, scheduleID),
+		TaskQueue:                SchedulerTaskQueueName,
+		WorkflowExecutionTimeout: InfiniteDuration,
+		WorkflowIDReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		SearchAttributes: map[string]interface{}{
+			searchAttrCustomNamespace: namespace,
+			searchAttrOperator:        operator,
+		},
+	}
+	_, err := c.svcClient.ExecuteWorkflow(ctx, options, SchedulerWFTypeName, SchedulerWorkflowArgs{
+		Namespace:  namespace,
+		ScheduleID: scheduleID,
+		Schedule:   schedule,
+	})
+	return err
+}
+
+// Describe returns the schedule and its info
+func (c *Client) Describe(ctx context.Context, namespace string, scheduleID string) (*DescribeResponse, error) {
+	value, err := c.svcClient.QueryWorkflow(ctx, getScheduleWorkflowID(namespace, scheduleID), "", QueryNameDescribe)
+	if err != nil {
+		return nil, err
+	}
+	var resp DescribeResponse
+	if err := value.Get(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update replaces the schedule
+func (c *Client) Update(ctx context.Context, namespace string, scheduleID string, schedule Schedule) error {
+	if err := schedule.Validate(); err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+	return c.signal(ctx, namespace, scheduleID, SignalNameUpdate, schedule)
+}
+
+// Pause pauses the schedule, no actions are taken until it's unpaused
+func (c *Client) Pause(ctx context.Context, namespace string, scheduleID string, note string) error {
+	if note == "" {
+		note = "paused"
+	}
+	return c.signal(ctx, namespace, scheduleID, SignalNamePatch, SchedulePatch{Pause: note})
+}
+
+// Unpause unpauses the schedule, the actions missed while it was paused are not taken
+func (c *Client) Unpause(ctx context.Context, namespace string, scheduleID string, note string) error {
+	if note == "" {
+		note = "unpaused"
+	}
+	return c.signal(ctx, namespace, scheduleID, SignalNamePatch, SchedulePatch{Unpause: note})
+}
+
+// Trigger takes the action of the schedule now, the overlap policy of the schedule is used if empty
+func (c *Client) Trigger(ctx context.Context, namespace string, scheduleID string, overlapPolicy string) error {
+	if !isValidOverlapPolicy(overlapPolicy) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("not supported overlap policy: %v", overlapPolicy))
+	}
+	return c.signal(ctx, namespace, scheduleID, SignalNamePatch, SchedulePatch{
+		TriggerImmediately: &TriggerImmediatelyRequest{OverlapPolicy: overlapPolicy},
+	})
+}
+
+// Backfill takes the actions the schedule would have taken in the time range
+func (c *Client) Backfill(ctx context.Context, namespace string, scheduleID string, request BackfillRequest) error {
+	if !isValidOverlapPolicy(request.OverlapPolicy) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("not supported overlap policy: %v", request.OverlapPolicy))
+	}
+	if request.EndTime.Before(request.StartTime) {
+		return serviceerror.NewInvalidArgument("backfill end time must not be before the start time")
+	}
+	return c.signal(ctx, namespace, scheduleID, SignalNamePatch, SchedulePatch{
+		BackfillRequests: []BackfillRequest{request},
+	})
+}
+
+// Delete deletes the schedule, the running workflows started by the schedule are not affected
+func (c *Client) Delete(ctx context.Context, namespace string, scheduleID string) error {
+	return c.signal(ctx, namespace, scheduleID, SignalNameDelete, nil)
+}
+
+// List returns a page of the schedules of the namespace
+func (c *Client) List(ctx context.Context, namespace string, pageSize int, nextPageToken []byte) ([]ScheduleListEntry, []byte, error) {
+	resp, err := c.svcClient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace:     common.SystemLocalNamespace,
+		PageSize:      int32(pageSize),
+		NextPageToken: nextPageToken,
+		Query:         fmt.Sprintf("%v = '%v' AND WorkflowType = '%v'", searchAttrCustomNamespace, namespace, SchedulerWFTypeName),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries []ScheduleListEntry
+	for _, wf := range resp.Executions {
+		// the closed runs are either deleted schedules or continued as new
+		if wf.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			continue
+		}
+		var operator string
+		if err := payload.Decode(wf.GetSearchAttributes().GetIndexedFields()[searchAttrOperator], &operator); err != nil {
+			return nil, nil, err
+		}
+		entries = append(entries, ScheduleListEntry{
+			ScheduleID: strings.TrimPrefix(wf.Execution.GetWorkflowId(), getScheduleWorkflowID(namespace, "")),
+			Operator:   operator,
+		})
+	}
+	return entries, resp.NextPageToken, nil
+}
+
+func (c *Client) signal(ctx context.Context, namespace string, scheduleID string, signalName string, arg interface{}) error {
+	return c.svcClient.SignalWorkflow(ctx, getScheduleWorkflowID(namespace, scheduleID), "", signalName, arg)
+}
+
+func getScheduleWorkflowID(namespace string, scheduleID string) string {
+	return scheduleWorkflowIDPrefix + namespace + ":" + scheduleID
+}
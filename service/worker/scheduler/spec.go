@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+type (
+	// ScheduleSpec describes the times at which a schedule takes its action. The action is taken
+	// at the union of the times matched by the cron expressions and the interval.
+	ScheduleSpec struct {
+		// CronExpressions in the standard five field format, e.g. "0 12 * * MON-FRI"
+		CronExpressions []string
+		// Interval takes the action every Interval, aligned to the unix epoch plus Phase
+		Interval time.Duration
+		Phase    time.Duration
+		// TimeZone is the IANA name of the time zone the cron expressions are evaluated in. Default to UTC.
+		TimeZone string
+		// StartTime and EndTime bound the times the spec matches, zero means unbounded
+		StartTime time.Time
+		EndTime   time.Time
+	}
+
+	compiledSpec struct {
+		spec      ScheduleSpec
+		schedules []cron.Schedule
+		location  *time.Location
+	}
+)
+
+// Validate checks the spec can be compiled
+func (s *ScheduleSpec) Validate() error {
+	_, err := compileSpec(s)
+	return err
+}
+
+func compileSpec(spec *ScheduleSpec) (*compiledSpec, error) {
+	if len(spec.CronExpressions) == 0 && spec.Interval <= 0 {
+		return nil, fmt.Errorf("schedule spec must have a cron expression or an interval")
+	}
+	if spec.Interval < 0 || spec.Phase < 0 {
+		return nil, fmt.Errorf("schedule interval and phase must not be negative")
+	}
+	if spec.Interval > 0 && spec.Interval < time.Second {
+		return nil, fmt.Errorf("schedule interval must be at least one second")
+	}
+	if spec.Interval > 0 && spec.Phase >= spec.Interval {
+		return nil, fmt.Errorf("schedule phase must be less than the interval")
+	}
+	if !spec.StartTime.IsZero() && !spec.EndTime.IsZero() && !spec.StartTime.Before(spec.EndTime) {
+		return nil, fmt.Errorf("schedule start time must be before the end time")
+	}
+
+	location := time.UTC
+	if spec.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(spec.TimeZone); err != nil {
+			return nil, fmt.Errorf("invalid schedule time zone %q: %v", spec.TimeZone, err)
+		}
+	}
+	compiled := &compiledSpec{
+		spec:     *spec,
+		location: location,
+	}
+	for _, expression := range spec.CronExpressions {
+		schedule, err := cron.ParseStandard(expression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", expression, err)
+		}
+		compiled.schedules = append(compiled.schedules, schedule)
+	}
+	return compiled, nil
+}
+
+// getNextTime returns the first time matched by the spec strictly after the given time,
+// or the zero time if there is none
+func (c *compiledSpec) getNextTime(after time.Time) time.Time {
+	if !c.spec.StartTime.IsZero() && after.Before(c.spec.StartTime) {
+		// the start time itself may be matched
+		after = c.spec.StartTime.Add(-time.Nanosecond)
+	}
+
+	var next time.Time
+	for _, schedule := range c.schedules {
+		t := schedule.Next(after.In(c.location))
+		if !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	if c.spec.Interval > 0 {
+		interval := int64(c.spec.Interval)
+		phase := int64(c.spec.Phase)
+		t := time.Unix(0, ((after.UnixNano()-phase)/interval+1)*interval+phase)
+		if next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+
+	if next.IsZero() || (!c.spec.EndTime.IsZero() && next.After(c.spec.EndTime)) {
+		return time.Time{}
+	}
+	return next.UTC()
+}
@@ -0,0 +1,94 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type specSuite struct {
+	suite.Suite
+}
+
+func TestSpecSuite(t *testing.T) {
+	suite.Run(t, new(specSuite))
+}
+
+func (s *specSuite) TestValidate() {
+	for _, spec := range []ScheduleSpec{
+		{},
+		{CronExpressions: []string{"* * *"}},
+		{Interval: time.Millisecond},
+		{Interval: time.Hour, Phase: time.Hour},
+		{Interval: time.Hour, TimeZone: "Mars/Olympus_Mons"},
+		{Interval: time.Hour, StartTime: time.Unix(100, 0), EndTime: time.Unix(100, 0)},
+	} {
+		s.Error(spec.Validate(), "%+v", spec)
+	}
+	s.NoError((&ScheduleSpec{CronExpressions: []string{"0 12 * * MON-FRI"}, Interval: time.Hour}).Validate())
+}
+
+func (s *specSuite) TestCron() {
+	spec := s.compile(ScheduleSpec{CronExpressions: []string{"30 9 * * *", "0 17 * * *"}})
+	start := time.Date(2020, 7, 1, 10, 0, 0, 0, time.UTC)
+	s.Equal(time.Date(2020, 7, 1, 17, 0, 0, 0, time.UTC), spec.getNextTime(start))
+	s.Equal(time.Date(2020, 7, 2, 9, 30, 0, 0, time.UTC), spec.getNextTime(spec.getNextTime(start)))
+}
+
+func (s *specSuite) TestCronTimeZone() {
+	spec := s.compile(ScheduleSpec{CronExpressions: []string{"0 9 * * *"}, TimeZone: "America/New_York"})
+	next := spec.getNextTime(time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC))
+	// 9am EDT is 1pm UTC
+	s.Equal(time.Date(2020, 7, 1, 13, 0, 0, 0, time.UTC), next)
+	s.Equal(time.UTC, next.Location())
+}
+
+func (s *specSuite) TestInterval() {
+	spec := s.compile(ScheduleSpec{Interval: time.Hour, Phase: 15 * time.Minute})
+	start := time.Date(2020, 7, 1, 10, 15, 0, 0, time.UTC)
+	s.Equal(time.Date(2020, 7, 1, 11, 15, 0, 0, time.UTC), spec.getNextTime(start))
+	s.Equal(time.Date(2020, 7, 1, 10, 15, 0, 0, time.UTC), spec.getNextTime(start.Add(-time.Second)))
+}
+
+func (s *specSuite) TestBounds() {
+	startTime := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	spec := s.compile(ScheduleSpec{
+		Interval:  time.Hour,
+		StartTime: startTime,
+		EndTime:   startTime.Add(2 * time.Hour),
+	})
+	s.Equal(startTime, spec.getNextTime(startTime.Add(-24*time.Hour)))
+	s.Equal(startTime.Add(2*time.Hour), spec.getNextTime(startTime.Add(time.Hour)))
+	s.True(spec.getNextTime(startTime.Add(2 * time.Hour)).IsZero())
+}
+
+func (s *specSuite) compile(spec ScheduleSpec) *compiledSpec {
+	compiled, err := compileSpec(&spec)
+	s.NoError(err)
+	return compiled
+}
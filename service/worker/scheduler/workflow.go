@@ -0,0 +1,639 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	// SchedulerTaskQueueName is the taskqueue name
+	SchedulerTaskQueueName = "temporal-sys-scheduler-taskqueue"
+	// SchedulerWFTypeName is the workflow type
+	SchedulerWFTypeName = "temporal-sys-scheduler-workflow"
+
+	startWorkflowActivityName  = "temporal-sys-scheduler-start-workflow-activity"
+	watchWorkflowActivityName  = "temporal-sys-scheduler-watch-workflow-activity"
+	cancelWorkflowActivityName = "temporal-sys-scheduler-cancel-workflow-activity"
+
+	// SignalNameUpdate is the signal to replace the schedule
+	SignalNameUpdate = "update"
+	// SignalNamePatch is the signal to pause, unpause, trigger or backfill the schedule
+	SignalNamePatch = "patch"
+	// SignalNameDelete is the signal to delete the schedule
+	SignalNameDelete = "delete"
+	// QueryNameDescribe is the query to get the schedule and its info
+	QueryNameDescribe = "describe"
+
+	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+	// DefaultCatchupWindow is the default value for SchedulePolicies.CatchupWindow
+	DefaultCatchupWindow = time.Minute
+
+	maxRecentActions       = 10
+	maxFutureActionTimes   = 5
+	maxBufferedStarts      = 1000
+	maxIterationsPerRun    = 500
+	watchHeartbeatTimeout  = time.Minute
+	watchLongPollTimeout   = 30 * time.Second
+	workflowIDTimeLayout   = "2006-01-02T15:04:05Z"
+	retryForeverMaxBackoff = 5 * time.Minute
+)
+
+const (
+	// OverlapPolicySkip skips the action if the previous one is still running
+	OverlapPolicySkip = "skip"
+	// OverlapPolicyBufferOne takes the action after the previous one closes, buffering at most one action
+	OverlapPolicyBufferOne = "buffer_one"
+	// OverlapPolicyBufferAll takes the actions one after another as the previous ones close
+	OverlapPolicyBufferAll = "buffer_all"
+	// OverlapPolicyCancelOther cancels the running workflow and takes the action once it closes
+	OverlapPolicyCancelOther = "cancel_other"
+	// OverlapPolicyTerminateOther terminates the running workflow and takes the action once it closes
+	OverlapPolicyTerminateOther = "terminate_other"
+	// OverlapPolicyAllowAll takes the action regardless of the running workflows
+	OverlapPolicyAllowAll = "allow_all"
+)
+
+// AllOverlapPolicies is the overlap policies we supported
+var AllOverlapPolicies = []string{
+	OverlapPolicySkip,
+	OverlapPolicyBufferOne,
+	OverlapPolicyBufferAll,
+	OverlapPolicyCancelOther,
+	OverlapPolicyTerminateOther,
+	OverlapPolicyAllowAll,
+}
+
+type (
+	// ScheduleAction is the workflow started by the schedule
+	ScheduleAction struct {
+		WorkflowType string
+		// WorkflowID is the prefix of the workflow IDs, the nominal time of each action is appended to it
+		WorkflowID               string
+		TaskQueue                string
+		Input                    *commonpb.Payloads
+		WorkflowExecutionTimeout time.Duration
+		WorkflowRunTimeout       time.Duration
+		WorkflowTaskTimeout      time.Duration
+	}
+
+	// SchedulePolicies control how the schedule behaves when actions overlap or are missed
+	SchedulePolicies struct {
+		// OverlapPolicy is applied when an action is due while a previous workflow is running. Default to skip.
+		OverlapPolicy string
+		// CatchupWindow is how late an action may be taken after its nominal time, e.g. after an outage.
+		// Default to DefaultCatchupWindow.
+		CatchupWindow time.Duration
+	}
+
+	// ScheduleState is the user controlled state of the schedule
+	ScheduleState struct {
+		Paused bool
+		Notes  string
+	}
+
+	// Schedule is the definition of a schedule
+	Schedule struct {
+		Spec     ScheduleSpec
+		Action   ScheduleAction
+		Policies SchedulePolicies
+		State    ScheduleState
+	}
+
+	// ScheduleActionResult is a workflow started by the schedule
+	ScheduleActionResult struct {
+		NominalTime time.Time
+		ActualTime  time.Time
+		WorkflowID  string
+		RunID       string
+	}
+
+	// ScheduleInfo is the system maintained state of the schedule
+	ScheduleInfo struct {
+		ActionCount         int64
+		MissedCatchupWindow int64
+		OverlapSkipped      int64
+		RunningWorkflows    []commonpb.WorkflowExecution
+		RecentActions       []ScheduleActionResult
+		FutureActionTimes   []time.Time
+		CreateTime          time.Time
+		UpdateTime          time.Time
+	}
+
+	// BufferedStart is an action which is due but not taken yet
+	BufferedStart struct {
+		NominalTime time.Time
+		ActualTime  time.Time
+		// OverlapPolicy overrides the policy of the schedule if set
+		OverlapPolicy string
+	}
+
+	// BackfillRequest takes the actions the spec matches in [StartTime, EndTime] as if they were due now
+	BackfillRequest struct {
+		StartTime     time.Time
+		EndTime       time.Time
+		OverlapPolicy string
+	}
+
+	// TriggerImmediatelyRequest takes the action now
+	TriggerImmediatelyRequest struct {
+		OverlapPolicy string
+	}
+
+	// SchedulePatch is the payload of the patch signal
+	SchedulePatch struct {
+		TriggerImmediately *TriggerImmediatelyRequest
+		BackfillRequests   []BackfillRequest
+		// Pause pauses the schedule with the given note if not empty
+		Pause string
+		// Unpause unpauses the schedule with the given note if not empty
+		Unpause string
+	}
+
+	// DescribeResponse is the result of the describe query
+	DescribeResponse struct {
+		Namespace  string
+		ScheduleID string
+		Schedule   Schedule
+		Info       ScheduleInfo
+	}
+
+	// SchedulerWorkflowArgs is the input of the scheduler workflow, it's carried over on continue as new
+	SchedulerWorkflowArgs struct {
+		Namespace         string
+		ScheduleID        string
+		Schedule          Schedule
+		Info              ScheduleInfo
+		LastProcessedTime time.Time
+		BufferedStarts    []BufferedStart
+	}
+
+	// StartWorkflowRequest is the input of the start workflow activity
+	StartWorkflowRequest struct {
+		Namespace   string
+		ScheduleID  string
+		RequestID   string
+		NominalTime time.Time
+		Action      ScheduleAction
+	}
+
+	// WatchWorkflowRequest is the input of the watch workflow activity
+	WatchWorkflowRequest struct {
+		Namespace string
+		Execution commonpb.WorkflowExecution
+	}
+
+	// CancelWorkflowRequest is the input of the cancel workflow activity
+	CancelWorkflowRequest struct {
+		Namespace  string
+		ScheduleID string
+		Execution  commonpb.WorkflowExecution
+		Terminate  bool
+	}
+
+	scheduler struct {
+		SchedulerWorkflowArgs
+
+		ctx             workflow.Context
+		logger          *zap.Logger
+		spec            *compiledSpec
+		watchers        map[string]workflow.Future
+		cancelRequested map[string]struct{}
+		deleted         bool
+	}
+)
+
+var (
+	retryForeverPolicy = temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    retryForeverMaxBackoff,
+	}
+
+	startWorkflowActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    10,
+		},
+	}
+
+	watchWorkflowActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: InfiniteDuration,
+		StartToCloseTimeout:    InfiniteDuration,
+		HeartbeatTimeout:       watchHeartbeatTimeout,
+		RetryPolicy:            &retryForeverPolicy,
+	}
+
+	cancelWorkflowActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &retryForeverPolicy,
+	}
+)
+
+// Validate checks the schedule is well formed
+func (s *Schedule) Validate() error {
+	if err := s.Spec.Validate(); err != nil {
+		return err
+	}
+	if s.Action.WorkflowType == "" || s.Action.WorkflowID == "" || s.Action.TaskQueue == "" {
+		return fmt.Errorf("must provide required parameters: WorkflowType/WorkflowID/TaskQueue")
+	}
+	if !isValidOverlapPolicy(s.Policies.OverlapPolicy) {
+		return fmt.Errorf("not supported overlap policy: %v", s.Policies.OverlapPolicy)
+	}
+	if s.Policies.CatchupWindow < 0 {
+		return fmt.Errorf("catchup window must not be negative")
+	}
+	return nil
+}
+
+func isValidOverlapPolicy(policy string) bool {
+	if policy == "" {
+		return true
+	}
+	for _, p := range AllOverlapPolicies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// SchedulerWorkflow is the workflow that takes the actions of a schedule. The workflow runs until the
+// schedule is deleted and stores the state of the schedule, continuing as new to keep its history short.
+func SchedulerWorkflow(ctx workflow.Context, args SchedulerWorkflowArgs) error {
+	s := &scheduler{
+		SchedulerWorkflowArgs: args,
+		ctx:                   ctx,
+		logger:                workflow.GetLogger(ctx).With(zap.String("schedule-id", args.ScheduleID)),
+		watchers:              make(map[string]workflow.Future),
+		cancelRequested:       make(map[string]struct{}),
+	}
+	return s.run()
+}
+
+func (s *scheduler) run() error {
+	if err := workflow.SetQueryHandler(s.ctx, QueryNameDescribe, s.describe); err != nil {
+		return err
+	}
+
+	now := workflow.Now(s.ctx)
+	if s.Info.CreateTime.IsZero() {
+		s.Info.CreateTime = now
+	}
+	if s.LastProcessedTime.IsZero() {
+		s.LastProcessedTime = now
+	}
+	s.compileSpec()
+	for _, execution := range s.Info.RunningWorkflows {
+		s.watch(execution)
+	}
+
+	updateCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdate)
+	patchCh := workflow.GetSignalChannel(s.ctx, SignalNamePatch)
+	deleteCh := workflow.GetSignalChannel(s.ctx, SignalNameDelete)
+
+	for i := 0; i < maxIterationsPerRun && !s.deleted; i++ {
+		now = workflow.Now(s.ctx)
+		s.processTimeRange(s.LastProcessedTime, now)
+		s.LastProcessedTime = now
+		s.processBuffer()
+		s.updateFutureActionTimes(now)
+
+		timerCtx, cancelTimer := workflow.WithCancel(s.ctx)
+		selector := workflow.NewSelector(s.ctx)
+		if next := s.nextWakeupTime(now); !next.IsZero() {
+			selector.AddFuture(workflow.NewTimer(timerCtx, next.Sub(now)), func(workflow.Future) {})
+		}
+		selector.AddReceive(updateCh, func(c workflow.ReceiveChannel, more bool) {
+			var schedule Schedule
+			c.Receive(s.ctx, &schedule)
+			s.update(schedule)
+		})
+		selector.AddReceive(patchCh, func(c workflow.ReceiveChannel, more bool) {
+			var patch SchedulePatch
+			c.Receive(s.ctx, &patch)
+			s.patch(patch)
+		})
+		selector.AddReceive(deleteCh, func(c workflow.ReceiveChannel, more bool) {
+			c.Receive(s.ctx, nil)
+			s.logger.Info("Schedule is deleted")
+			s.deleted = true
+		})
+		// iterate the running workflows rather than the map to keep the order deterministic
+		for _, execution := range s.Info.RunningWorkflows {
+			workflowID := execution.GetWorkflowId()
+			selector.AddFuture(s.watchers[workflowID], func(f workflow.Future) {
+				s.onWorkflowClosed(workflowID, f)
+			})
+		}
+		selector.Select(s.ctx)
+		cancelTimer()
+	}
+
+	if s.deleted {
+		return nil
+	}
+	// drain the signals which arrived after the last select, they would be lost on continue as new
+	for {
+		var schedule Schedule
+		if !updateCh.ReceiveAsync(&schedule) {
+			break
+		}
+		s.update(schedule)
+	}
+	for {
+		var patch SchedulePatch
+		if !patchCh.ReceiveAsync(&patch) {
+			break
+		}
+		s.patch(patch)
+	}
+	if deleteCh.ReceiveAsync(nil) {
+		return nil
+	}
+	return workflow.NewContinueAsNewError(s.ctx, SchedulerWFTypeName, s.SchedulerWorkflowArgs)
+}
+
+func (s *scheduler) describe() (*DescribeResponse, error) {
+	return &DescribeResponse{
+		Namespace:  s.Namespace,
+		ScheduleID: s.ScheduleID,
+		Schedule:   s.Schedule,
+		Info:       s.Info,
+	}, nil
+}
+
+func (s *scheduler) compileSpec() {
+	spec, err := compileSpec(&s.Schedule.Spec)
+	if err != nil {
+		// the spec is validated by the client, so this only happens if the validation changed
+		s.logger.Error("Invalid schedule spec, no actions will be taken until the schedule is updated", zap.Error(err))
+		s.spec = nil
+		return
+	}
+	s.spec = spec
+}
+
+func (s *scheduler) update(schedule Schedule) {
+	if err := schedule.Validate(); err != nil {
+		s.logger.Error("Ignoring invalid schedule update", zap.Error(err))
+		return
+	}
+	s.Schedule = schedule
+	s.Info.UpdateTime = workflow.Now(s.ctx)
+	s.compileSpec()
+}
+
+func (s *scheduler) patch(patch SchedulePatch) {
+	now := workflow.Now(s.ctx)
+	if patch.TriggerImmediately != nil {
+		s.addStart(BufferedStart{
+			NominalTime:   now,
+			ActualTime:    now,
+			OverlapPolicy: patch.TriggerImmediately.OverlapPolicy,
+		})
+	}
+	for _, backfill := range patch.BackfillRequests {
+		s.backfill(backfill, now)
+	}
+	if patch.Pause != "" {
+		s.Schedule.State.Paused = true
+		s.Schedule.State.Notes = patch.Pause
+	}
+	if patch.Unpause != "" {
+		s.Schedule.State.Paused = false
+		s.Schedule.State.Notes = patch.Unpause
+	}
+	s.Info.UpdateTime = now
+}
+
+func (s *scheduler) backfill(request BackfillRequest, now time.Time) {
+	if s.spec == nil {
+		return
+	}
+	// the range is inclusive on both ends
+	for t := s.spec.getNextTime(request.StartTime.Add(-time.Nanosecond)); !t.IsZero() && !t.After(request.EndTime); t = s.spec.getNextTime(t) {
+		if !s.addStart(BufferedStart{
+			NominalTime:   t,
+			ActualTime:    now,
+			OverlapPolicy: request.OverlapPolicy,
+		}) {
+			return
+		}
+	}
+}
+
+// processTimeRange buffers the actions the spec matches in (start, end]
+func (s *scheduler) processTimeRange(start, end time.Time) {
+	if s.spec == nil {
+		return
+	}
+	catchupWindow := s.Schedule.Policies.CatchupWindow
+	if catchupWindow == 0 {
+		catchupWindow = DefaultCatchupWindow
+	}
+	for t := s.spec.getNextTime(start); !t.IsZero() && !t.After(end); t = s.spec.getNextTime(t) {
+		if s.Schedule.State.Paused {
+			continue
+		}
+		if end.Sub(t) > catchupWindow {
+			s.Info.MissedCatchupWindow++
+			continue
+		}
+		if !s.addStart(BufferedStart{NominalTime: t, ActualTime: end}) {
+			return
+		}
+	}
+}
+
+func (s *scheduler) addStart(start BufferedStart) bool {
+	if len(s.BufferedStarts) >= maxBufferedStarts {
+		s.logger.Warn("Too many buffered actions, dropping the rest", zap.Time("nominal-time", start.NominalTime))
+		return false
+	}
+	s.BufferedStarts = append(s.BufferedStarts, start)
+	return true
+}
+
+// processBuffer takes the buffered actions which are allowed by their overlap policy
+func (s *scheduler) processBuffer() {
+	var remaining []BufferedStart
+	blocked := false
+	for _, start := range s.BufferedStarts {
+		policy := start.OverlapPolicy
+		if policy == "" {
+			policy = s.Schedule.Policies.OverlapPolicy
+		}
+		if policy == "" {
+			policy = OverlapPolicySkip
+		}
+
+		if len(s.Info.RunningWorkflows) == 0 || policy == OverlapPolicyAllowAll {
+			s.startWorkflow(start)
+			continue
+		}
+		switch policy {
+		case OverlapPolicySkip:
+			s.Info.OverlapSkipped++
+		case OverlapPolicyBufferOne:
+			if blocked {
+				s.Info.OverlapSkipped++
+			} else {
+				remaining = append(remaining, start)
+			}
+		case OverlapPolicyBufferAll:
+			remaining = append(remaining, start)
+		case OverlapPolicyCancelOther:
+			s.cancelRunning(false)
+			remaining = append(remaining, start)
+		case OverlapPolicyTerminateOther:
+			s.cancelRunning(true)
+			remaining = append(remaining, start)
+		}
+		blocked = true
+	}
+	s.BufferedStarts = remaining
+}
+
+func (s *scheduler) startWorkflow(start BufferedStart) {
+	var requestID string
+	if err := workflow.SideEffect(s.ctx, func(ctx workflow.Context) interface{} {
+		return uuid.New().String()
+	}).Get(&requestID); err != nil {
+		s.logger.Error("Failed to generate request id", zap.Error(err))
+		return
+	}
+
+	ctx := workflow.WithActivityOptions(s.ctx, startWorkflowActivityOptions)
+	var execution commonpb.WorkflowExecution
+	err := workflow.ExecuteActivity(ctx, startWorkflowActivityName, StartWorkflowRequest{
+		Namespace:   s.Namespace,
+		ScheduleID:  s.ScheduleID,
+		RequestID:   requestID,
+		NominalTime: start.NominalTime,
+		Action:      s.Schedule.Action,
+	}).Get(s.ctx, &execution)
+	if err != nil {
+		s.logger.Error("Failed to start workflow", zap.Time("nominal-time", start.NominalTime), zap.Error(err))
+		return
+	}
+
+	s.Info.ActionCount++
+	s.Info.RecentActions = append(s.Info.RecentActions, ScheduleActionResult{
+		NominalTime: start.NominalTime,
+		ActualTime:  workflow.Now(s.ctx),
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       execution.GetRunId(),
+	})
+	if len(s.Info.RecentActions) > maxRecentActions {
+		s.Info.RecentActions = s.Info.RecentActions[len(s.Info.RecentActions)-maxRecentActions:]
+	}
+	if _, ok := s.watchers[execution.GetWorkflowId()]; ok {
+		// the workflow of an earlier action with the same nominal time is still running
+		return
+	}
+	s.Info.RunningWorkflows = append(s.Info.RunningWorkflows, execution)
+	s.watch(execution)
+}
+
+func (s *scheduler) watch(execution commonpb.WorkflowExecution) {
+	if _, ok := s.watchers[execution.GetWorkflowId()]; ok {
+		return
+	}
+	ctx := workflow.WithActivityOptions(s.ctx, watchWorkflowActivityOptions)
+	s.watchers[execution.GetWorkflowId()] = workflow.ExecuteActivity(ctx, watchWorkflowActivityName, WatchWorkflowRequest{
+		Namespace: s.Namespace,
+		Execution: execution,
+	})
+}
+
+func (s *scheduler) onWorkflowClosed(workflowID string, future workflow.Future) {
+	if err := future.Get(s.ctx, nil); err != nil {
+		s.logger.Error("Failed to watch workflow", zap.String("workflow-id", workflowID), zap.Error(err))
+	}
+	delete(s.watchers, workflowID)
+	delete(s.cancelRequested, workflowID)
+	for i, execution := range s.Info.RunningWorkflows {
+		if execution.GetWorkflowId() == workflowID {
+			s.Info.RunningWorkflows = append(s.Info.RunningWorkflows[:i], s.Info.RunningWorkflows[i+1:]...)
+			break
+		}
+	}
+}
+
+func (s *scheduler) cancelRunning(terminate bool) {
+	ctx := workflow.WithActivityOptions(s.ctx, cancelWorkflowActivityOptions)
+	for _, execution := range s.Info.RunningWorkflows {
+		if _, ok := s.cancelRequested[execution.GetWorkflowId()]; ok {
+			continue
+		}
+		err := workflow.ExecuteActivity(ctx, cancelWorkflowActivityName, CancelWorkflowRequest{
+			Namespace:  s.Namespace,
+			ScheduleID: s.ScheduleID,
+			Execution:  execution,
+			Terminate:  terminate,
+		}).Get(s.ctx, nil)
+		if err != nil {
+			s.logger.Error("Failed to cancel workflow", zap.String("workflow-id", execution.GetWorkflowId()), zap.Error(err))
+			continue
+		}
+		s.cancelRequested[execution.GetWorkflowId()] = struct{}{}
+	}
+}
+
+func (s *scheduler) updateFutureActionTimes(now time.Time) {
+	s.Info.FutureActionTimes = nil
+	if s.spec == nil || s.Schedule.State.Paused {
+		return
+	}
+	for t := s.spec.getNextTime(now); !t.IsZero() && len(s.Info.FutureActionTimes) < maxFutureActionTimes; t = s.spec.getNextTime(t) {
+		s.Info.FutureActionTimes = append(s.Info.FutureActionTimes, t)
+	}
+}
+
+func (s *scheduler) nextWakeupTime(now time.Time) time.Time {
+	if s.spec == nil || s.Schedule.State.Paused {
+		return time.Time{}
+	}
+	return s.spec.getNextTime(now)
+}
+
+func getWorkflowID(action ScheduleAction, nominalTime time.Time) string {
+	return action.WorkflowID + "-" + nominalTime.UTC().Format(workflowIDTimeLayout)
+}
@@ -0,0 +1,239 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type workflowSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env     *testsuite.TestWorkflowEnvironment
+	started []StartWorkflowRequest
+}
+
+var testStartTime = time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.started = nil
+	s.env = s.NewTestWorkflowEnvironment()
+	s.env.SetStartTime(testStartTime)
+	s.env.RegisterWorkflowWithOptions(SchedulerWorkflow, workflow.RegisterOptions{Name: SchedulerWFTypeName})
+	s.env.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	s.env.RegisterActivityWithOptions(WatchWorkflowActivity, activity.RegisterOptions{Name: watchWorkflowActivityName})
+	s.env.RegisterActivityWithOptions(CancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+	s.env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request StartWorkflowRequest) (commonpb.WorkflowExecution, error) {
+			s.started = append(s.started, request)
+			return commonpb.WorkflowExecution{
+				WorkflowId: getWorkflowID(request.Action, request.NominalTime),
+				RunId:      request.RequestID,
+			}, nil
+		})
+}
+
+func (s *workflowSuite) TearDownTest() {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestInterval() {
+	s.env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).After(10 * time.Minute)
+	s.run(s.newSchedule(OverlapPolicySkip), 3*time.Hour+time.Minute)
+
+	s.Len(s.started, 3)
+	for i, request := range s.started {
+		s.Equal(testStartTime.Add(time.Duration(i+1)*time.Hour), request.NominalTime)
+		s.Equal("test-namespace", request.Namespace)
+	}
+	s.Equal("wf-2020-07-01T01:00:00Z", getWorkflowID(s.started[0].Action, s.started[0].NominalTime))
+}
+
+func (s *workflowSuite) TestOverlapSkip() {
+	s.env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).After(90 * time.Minute)
+	info := s.run(s.newSchedule(OverlapPolicySkip), 4*time.Hour+time.Minute)
+
+	// the actions at 2h and 4h are skipped
+	s.Len(s.started, 2)
+	s.Equal(testStartTime.Add(time.Hour), s.started[0].NominalTime)
+	s.Equal(testStartTime.Add(3*time.Hour), s.started[1].NominalTime)
+	s.Equal(int64(2), info.OverlapSkipped)
+}
+
+func (s *workflowSuite) TestOverlapBufferAll() {
+	s.env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).After(90 * time.Minute)
+	info := s.run(s.newSchedule(OverlapPolicyBufferAll), 3*time.Hour+time.Minute)
+
+	// 1h runs until 2h30m, then 2h runs until 4h, and 3h is still buffered
+	s.Len(s.started, 2)
+	s.Equal(testStartTime.Add(2*time.Hour), s.started[1].NominalTime)
+	s.Equal(int64(0), info.OverlapSkipped)
+	s.Len(info.RunningWorkflows, 1)
+}
+
+func (s *workflowSuite) TestOverlapTerminateOther() {
+	s.env.OnActivity(cancelWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request CancelWorkflowRequest) error {
+			s.True(request.Terminate)
+			return nil
+		}).Times(1)
+	// the first run is terminated after one hour
+	s.env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).After(time.Hour).Once()
+	s.env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).After(10 * time.Minute)
+	s.run(s.newSchedule(OverlapPolicyTerminateOther), 2*time.Hour+time.Minute)
+
+	s.Len(s.started, 2)
+}
+
+func (s *workflowSuite) TestPauseAndTrigger() {
+	s.env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).After(time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNamePatch, SchedulePatch{Pause: "maintenance"})
+	}, 90*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNamePatch, SchedulePatch{TriggerImmediately: &TriggerImmediatelyRequest{}})
+	}, 150*time.Minute)
+	schedule := s.newSchedule(OverlapPolicySkip)
+	s.env.RegisterDelayedCallback(func() {
+		resp := s.describe()
+		s.True(resp.Schedule.State.Paused)
+		s.Equal("maintenance", resp.Schedule.State.Notes)
+		s.Empty(resp.Info.FutureActionTimes)
+	}, 4*time.Hour)
+	info := s.run(schedule, 4*time.Hour+time.Minute)
+
+	s.Len(s.started, 2)
+	s.Equal(testStartTime.Add(time.Hour), s.started[0].NominalTime)
+	s.Equal(testStartTime.Add(150*time.Minute), s.started[1].NominalTime)
+	s.Equal(int64(2), info.ActionCount)
+}
+
+func (s *workflowSuite) TestBackfill() {
+	s.env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).After(time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNamePatch, SchedulePatch{BackfillRequests: []BackfillRequest{{
+			StartTime:     testStartTime.Add(-3 * time.Hour),
+			EndTime:       testStartTime.Add(-time.Hour),
+			OverlapPolicy: OverlapPolicyBufferAll,
+		}}})
+	}, time.Minute)
+	s.run(s.newSchedule(OverlapPolicySkip), 30*time.Minute)
+
+	s.Len(s.started, 3)
+	for i, request := range s.started {
+		s.Equal(testStartTime.Add(time.Duration(i-3)*time.Hour), request.NominalTime)
+	}
+}
+
+func (s *workflowSuite) TestUpdate() {
+	s.env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).After(time.Minute)
+	schedule := s.newSchedule(OverlapPolicySkip)
+	updated := schedule
+	updated.Spec = ScheduleSpec{Interval: 30 * time.Minute}
+	updated.Action.WorkflowID = "updated"
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameUpdate, updated)
+	}, 90*time.Minute)
+	s.run(schedule, 3*time.Hour+time.Minute)
+
+	// 1h, then every 30m from the update
+	s.Len(s.started, 5)
+	s.Equal("wf", s.started[0].Action.WorkflowID)
+	s.Equal("updated", s.started[1].Action.WorkflowID)
+	s.Equal(testStartTime.Add(90*time.Minute), s.started[1].NominalTime)
+	s.Equal(testStartTime.Add(3*time.Hour), s.started[4].NominalTime)
+}
+
+func (s *workflowSuite) TestCatchupWindow() {
+	s.env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything).Return(nil).After(time.Minute)
+	schedule := s.newSchedule(OverlapPolicyAllowAll)
+	info := s.runWithArgs(SchedulerWorkflowArgs{
+		Namespace:  "test-namespace",
+		ScheduleID: "test-schedule",
+		Schedule:   schedule,
+		// the worker was down for five hours
+		LastProcessedTime: testStartTime.Add(-5*time.Hour - 30*time.Second),
+	}, time.Minute)
+
+	// only the action at the start time is within the catchup window
+	s.Len(s.started, 1)
+	s.Equal(testStartTime, s.started[0].NominalTime)
+	s.Equal(int64(5), info.MissedCatchupWindow)
+}
+
+func (s *workflowSuite) newSchedule(overlapPolicy string) Schedule {
+	return Schedule{
+		Spec: ScheduleSpec{Interval: time.Hour},
+		Action: ScheduleAction{
+			WorkflowType: "test-workflow",
+			WorkflowID:   "wf",
+			TaskQueue:    "test-taskqueue",
+		},
+		Policies: SchedulePolicies{OverlapPolicy: overlapPolicy},
+	}
+}
+
+func (s *workflowSuite) run(schedule Schedule, duration time.Duration) ScheduleInfo {
+	return s.runWithArgs(SchedulerWorkflowArgs{
+		Namespace:  "test-namespace",
+		ScheduleID: "test-schedule",
+		Schedule:   schedule,
+	}, duration)
+}
+
+// runWithArgs runs the workflow for the duration, then deletes the schedule and returns its last info
+func (s *workflowSuite) runWithArgs(args SchedulerWorkflowArgs, duration time.Duration) ScheduleInfo {
+	var info ScheduleInfo
+	s.env.RegisterDelayedCallback(func() {
+		info = s.describe().Info
+		s.env.SignalWorkflow(SignalNameDelete, nil)
+	}, duration)
+	s.env.ExecuteWorkflow(SchedulerWFTypeName, args)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	return info
+}
+
+func (s *workflowSuite) describe() *DescribeResponse {
+	value, err := s.env.QueryWorkflow(QueryNameDescribe)
+	s.NoError(err)
+	var resp DescribeResponse
+	s.NoError(value.Get(&resp))
+	return &resp
+}
@@ -39,12 +39,15 @@ import (
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/worker/archivalbackfill"
 	"go.temporal.io/server/service/worker/archiver"
 	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/deletenamespace"
 	"go.temporal.io/server/service/worker/indexer"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 type (
@@ -68,9 +71,12 @@ type (
 		IndexerCfg                    *indexer.Config
 		ScannerCfg                    *scanner.Config
 		BatcherCfg                    *batcher.Config
+		DeleteNamespaceCfg            *deletenamespace.Config
+		ArchivalBackfillCfg           *archivalbackfill.Config
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS       dynamicconfig.IntPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
+		EnableScheduler               dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
 	}
 )
@@ -141,8 +147,19 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 		BatcherCfg: &batcher.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
+			RPS:                 dc.GetIntProperty(dynamicconfig.BatcherRPS, 0),
+			LatencyThreshold:    dc.GetDurationProperty(dynamicconfig.BatcherLatencyThreshold, time.Second),
+		},
+		DeleteNamespaceCfg: &deletenamespace.Config{
+			RPS:                    dc.GetIntProperty(dynamicconfig.DeleteNamespaceRPS, 100),
+			NumTaskQueuePartitions: dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingNumTaskqueueReadPartitions, 1),
+		},
+		ArchivalBackfillCfg: &archivalbackfill.Config{
+			NumHistoryShards: params.PersistenceConfig.NumHistoryShards,
+			RPS:              dc.GetIntProperty(dynamicconfig.ArchivalBackfillRPS, 50),
 		},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, false),
+		EnableScheduler:               dc.GetBoolProperty(dynamicconfig.EnableScheduler, false),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 		PersistenceGlobalMaxQPS:       dc.GetIntProperty(dynamicconfig.WorkerPersistenceGlobalMaxQPS, 0),
@@ -154,6 +171,8 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 	if advancedVisWritingMode() != common.AdvancedVisibilityWritingModeOff {
 		config.IndexerCfg = &indexer.Config{
 			IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 1000),
+			QueuePollInterval:        dc.GetDurationProperty(dynamicconfig.WorkerIndexerQueuePollInterval, 1*time.Second),
+			QueueBatchSize:           dc.GetIntProperty(dynamicconfig.WorkerIndexerQueueBatchSize, 100),
 			ESProcessorNumOfWorkers:  dc.GetIntProperty(dynamicconfig.WorkerESProcessorNumOfWorkers, 1),
 			ESProcessorBulkActions:   dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkActions, 1000),
 			ESProcessorBulkSize:      dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkSize, 2<<24), // 16MB
@@ -177,6 +196,8 @@ func (s *Service) Start() {
 
 	s.ensureSystemNamespaceExists()
 	s.startScanner()
+	s.startNamespaceDeleter()
+	s.startArchivalBackfiller()
 	if s.config.IndexerCfg != nil {
 		s.startIndexer()
 	}
@@ -190,6 +211,9 @@ func (s *Service) Start() {
 	if s.config.EnableBatcher() {
 		s.startBatcher()
 	}
+	if s.config.EnableScheduler() {
+		s.startScheduler()
+	}
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
@@ -232,11 +256,41 @@ func (s *Service) startBatcher() {
 		Logger:        s.GetLogger(),
 		ClientBean:    s.GetClientBean(),
 	}
-	if err := batcher.New(params).Start(); err != nil {
+	if err := batcher.New(s.Resource, params).Start(); err != nil {
 		s.GetLogger().Fatal("error starting batcher", tag.Error(err))
 	}
 }
 
+func (s *Service) startScheduler() {
+	params := &scheduler.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := scheduler.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
+func (s *Service) startNamespaceDeleter() {
+	params := &deletenamespace.BootstrapParams{
+		Config: *s.config.DeleteNamespaceCfg,
+	}
+	if err := deletenamespace.New(s.Resource, params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting namespace deleter", tag.Error(err))
+	}
+}
+
+func (s *Service) startArchivalBackfiller() {
+	params := &archivalbackfill.BootstrapParams{
+		Config: *s.config.ArchivalBackfillCfg,
+	}
+	if err := archivalbackfill.New(s.Resource, params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting archival backfiller", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config: *s.config.ScannerCfg,
@@ -275,6 +329,9 @@ func (s *Service) startIndexer() {
 	visibilityIndexer := indexer.NewIndexer(
 		s.config.IndexerCfg,
 		s.GetMessagingClient(),
+		s.GetPersistenceBean().GetVisibilityQueue(),
+		s.GetWorkerServiceResolver(),
+		s.GetHostInfo(),
 		s.params.ESClient,
 		s.params.ESConfig,
 		s.GetLogger(),
@@ -380,6 +380,14 @@ func newAdminNamespaceCommands() []cli.Command {
 				newNamespaceCLI(c, true).DescribeNamespace(c)
 			},
 		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete a local namespace along with all of its workflow executions, task queues and visibility records",
+			Action: func(c *cli.Context) {
+				AdminDeleteNamespace(c)
+			},
+		},
 		{
 			Name:    "get_namespaceidorname",
 			Aliases: []string{"getdn"},
@@ -1002,3 +1010,143 @@ func newDBCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminConfigCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "get",
+			Usage: "Get the values of a dynamic config key stored in the persistence store",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "Dynamic config key name",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "set",
+			Usage: "Set the value of a dynamic config key for the given constraints, it takes effect on all hosts within seconds",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "Dynamic config key name",
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigValue,
+					Usage: "YAML encoded value, e.g. 10, true, 30s or {key: value}",
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigConstraints,
+					Usage: "Optional constraints in the format of 'k1:v1,k2:v2', supported constraints are namespace, namespaceID, taskQueueName, taskType and shardID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason of the change, recorded in the dynamic config history",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminSetDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete the value of a dynamic config key for the given constraints",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "Dynamic config key name",
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigConstraints,
+					Usage: "Optional constraints in the format of 'k1:v1,k2:v2'",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason of the change, recorded in the dynamic config history",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDeleteDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List all the dynamic config values stored in the persistence store",
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfig(c)
+			},
+		},
+		{
+			Name:  "history",
+			Usage: "List the changes of the dynamic config stored in the persistence store, from the most recent",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 20,
+					Usage: "Result page size",
+				},
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfigHistory(c)
+			},
+		},
+		{
+			Name:  "dump",
+			Usage: "Dump the effective value of every dynamic config key for the namespace and a task queue",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "Optional task queue the values are evaluated for",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskQueueTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional task queue type [decision|activity]",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "Optional shard the values of the keys filtered by shard are evaluated for",
+				},
+				cli.BoolFlag{
+					Name:  FlagDynamicConfigChangedOnly,
+					Usage: "Only dump the keys with a configured value",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDumpDynamicConfig(c)
+			},
+		},
+	}
+}
+
+func newAdminArchivalCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "backfill",
+			Usage: "Archive the closed executions of the namespace, still within retention, missing at its current archival URIs",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only count the executions missing from the archival, nothing is archived",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminBackfillArchival(c)
+			},
+		},
+		{
+			Name:  "status",
+			Usage: "Show the progress of the archival backfill of the namespace",
+			Action: func(c *cli.Context) {
+				AdminDescribeArchivalBackfill(c)
+			},
+		},
+	}
+}
@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+	commonpb "go.temporal.io/api/common/v1"
+	querypb "go.temporal.io/api/query/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/service/worker/archivalbackfill"
+)
+
+// AdminBackfillArchival starts the archival backfill of a namespace
+func AdminBackfillArchival(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.BackfillArchival(ctx, &adminservice.BackfillArchivalRequest{
+		Namespace: namespace,
+		DryRun:    c.Bool(FlagDryRun),
+	})
+	if err != nil {
+		ErrorAndExit("Operation BackfillArchival failed.", err)
+	}
+	fmt.Printf("Archival of namespace %v is being backfilled by workflow %v, run %v.\n", namespace, resp.GetWorkflowId(), resp.GetRunId())
+	fmt.Println("Use 'admin archival status' to follow the progress of the backfill.")
+}
+
+// AdminDescribeArchivalBackfill prints the progress of the archival backfill of a namespace
+func AdminDescribeArchivalBackfill(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	frontendClient := cFactory.FrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := frontendClient.QueryWorkflow(ctx, &workflowservice.QueryWorkflowRequest{
+		Namespace: common.SystemLocalNamespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: archivalbackfill.GetWorkflowID(namespace)},
+		Query:     &querypb.WorkflowQuery{QueryType: archivalbackfill.ProgressQueryType},
+	})
+	if err != nil {
+		ErrorAndExit("Unable to query the archival backfill workflow.", err)
+	}
+	var progress archivalbackfill.Progress
+	if err := payloads.Decode(resp.GetQueryResult(), &progress); err != nil {
+		ErrorAndExit("Unable to decode the archival backfill progress.", err)
+	}
+	prettyPrintJSONObject(progress)
+}
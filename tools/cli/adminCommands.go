@@ -31,6 +31,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/fatih/color"
 	"github.com/gocql/gocql"
 	"github.com/urfave/cli"
 	commonpb "go.temporal.io/api/common/v1"
@@ -381,6 +382,26 @@ func AdminGetNamespaceIDOrName(c *cli.Context) {
 	}
 }
 
+// AdminDeleteNamespace starts the deletion of a namespace and all of its data
+func AdminDeleteNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	promptMsg := fmt.Sprintf(
+		"Are you trying to delete namespace [%s] along with all of its workflow executions? Y/N",
+		color.YellowString(namespace),
+	)
+	prompt(promptMsg, c.GlobalBool(FlagAutoConfirm))
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{Namespace: namespace})
+	if err != nil {
+		ErrorAndExit("Operation DeleteNamespace failed.", err)
+	}
+	fmt.Printf("Namespace %v is being deleted by workflow %v, run %v.\n", namespace, resp.GetWorkflowId(), resp.GetRunId())
+	fmt.Println("Use 'namespace describe' to follow the progress of the deletion.")
+}
+
 // AdminGetShardID get shardID
 func AdminGetShardID(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
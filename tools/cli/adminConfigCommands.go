@@ -0,0 +1,310 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminGetDynamicConfig prints the values of a dynamic config key stored in the persistence store
+func AdminGetDynamicConfig(c *cli.Context) {
+	name := getRequiredOption(c, FlagName)
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{Name: name})
+	if err != nil {
+		ErrorAndExit("Operation GetDynamicConfig failed.", err)
+	}
+	if len(resp.GetValues()) == 0 {
+		fmt.Printf("Dynamic config %v is not set in the persistence store.\n", name)
+		return
+	}
+	printDynamicConfigEntries([]*adminservice.DynamicConfigEntry{{Name: name, Values: resp.GetValues()}})
+}
+
+// AdminSetDynamicConfig sets the value of a dynamic config key for the given constraints,
+// the values of the key for other constraints are kept
+func AdminSetDynamicConfig(c *cli.Context) {
+	name := getRequiredOption(c, FlagName)
+	value := getRequiredOption(c, FlagDynamicConfigValue)
+	constraints := parseDynamicConfigConstraints(c)
+
+	promptMsg := fmt.Sprintf(
+		"Are you trying to set dynamic config [%s] to [%s]%s? Y/N",
+		color.YellowString(name),
+		color.YellowString(value),
+		formatDynamicConfigConstraints(constraints),
+	)
+	prompt(promptMsg, c.GlobalBool(FlagAutoConfirm))
+
+	updateDynamicConfigValues(c, name, func(values []*adminservice.DynamicConfigValue) []*adminservice.DynamicConfigValue {
+		newValue := &adminservice.DynamicConfigValue{Value: value, Constraints: constraints}
+		for i, current := range values {
+			if sameDynamicConfigConstraints(current.GetConstraints(), constraints) {
+				values[i] = newValue
+				return values
+			}
+		}
+		return append(values, newValue)
+	})
+}
+
+// AdminDeleteDynamicConfig deletes the value of a dynamic config key for the given constraints
+func AdminDeleteDynamicConfig(c *cli.Context) {
+	name := getRequiredOption(c, FlagName)
+	constraints := parseDynamicConfigConstraints(c)
+
+	promptMsg := fmt.Sprintf(
+		"Are you trying to delete dynamic config [%s]%s? Y/N",
+		color.YellowString(name),
+		formatDynamicConfigConstraints(constraints),
+	)
+	prompt(promptMsg, c.GlobalBool(FlagAutoConfirm))
+
+	updateDynamicConfigValues(c, name, func(values []*adminservice.DynamicConfigValue) []*adminservice.DynamicConfigValue {
+		var newValues []*adminservice.DynamicConfigValue
+		for _, current := range values {
+			if !sameDynamicConfigConstraints(current.GetConstraints(), constraints) {
+				newValues = append(newValues, current)
+			}
+		}
+		if len(newValues) == len(values) {
+			ErrorAndExit(fmt.Sprintf("Dynamic config %v has no value for these constraints.", name), nil)
+		}
+		return newValues
+	})
+}
+
+// AdminListDynamicConfig prints all the dynamic config values stored in the persistence store
+func AdminListDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListDynamicConfig(ctx, &adminservice.ListDynamicConfigRequest{})
+	if err != nil {
+		ErrorAndExit("Operation ListDynamicConfig failed.", err)
+	}
+	fmt.Printf("Dynamic config version: %v\n", resp.GetVersion())
+	printDynamicConfigEntries(resp.GetEntries())
+}
+
+// AdminListDynamicConfigHistory prints the changes of the dynamic config stored in the persistence store
+func AdminListDynamicConfigHistory(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	pageSize := c.Int(FlagPageSize)
+	more := c.Bool(FlagMore)
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListDynamicConfigHistory(ctx, &adminservice.ListDynamicConfigHistoryRequest{
+			PageSize:      int32(pageSize),
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ListDynamicConfigHistory failed.", err)
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetBorder(false)
+		table.SetColumnSeparator("|")
+		table.SetHeader([]string{"Version", "Update Time", "Name", "Values", "Identity", "Reason"})
+		table.SetHeaderLine(false)
+		table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+		for _, change := range resp.GetChanges() {
+			table.Append([]string{
+				strconv.FormatInt(change.GetVersion(), 10),
+				convertTime(change.GetUpdateTime(), false),
+				change.GetName(),
+				formatDynamicConfigValues(change.GetValues()),
+				change.GetIdentity(),
+				change.GetReason(),
+			})
+		}
+		table.Render()
+
+		nextPageToken = resp.GetNextPageToken()
+		if !more || len(nextPageToken) == 0 || !showNextPage() {
+			return
+		}
+	}
+}
+
+// AdminDumpDynamicConfig prints the effective value of every dynamic config key for a namespace and a task queue
+func AdminDumpDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	request := &adminservice.DumpDynamicConfigRequest{
+		Namespace: c.GlobalString(FlagNamespace),
+		TaskQueue: c.String(FlagTaskQueue),
+		ShardId:   int32(c.Int(FlagShardID)),
+	}
+	if request.TaskQueue != "" {
+		request.TaskQueueType = strToTaskQueueType(c.String(FlagTaskQueueType))
+	}
+	resp, err := adminClient.DumpDynamicConfig(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation DumpDynamicConfig failed.", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetAutoWrapText(false)
+	table.SetHeader([]string{"Name", "Type", "Value", "Default", "Filters", "Description"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, key := range resp.GetKeys() {
+		if c.Bool(FlagDynamicConfigChangedOnly) && key.GetIsDefault() {
+			continue
+		}
+		value := key.GetValue()
+		if !key.GetIsDefault() {
+			value = color.YellowString(value)
+		}
+		table.Append([]string{
+			key.GetName(),
+			key.GetType(),
+			value,
+			key.GetDefaultValue(),
+			strings.Join(key.GetFilters(), ","),
+			key.GetDescription(),
+		})
+	}
+	table.Render()
+}
+
+func updateDynamicConfigValues(
+	c *cli.Context,
+	name string,
+	update func([]*adminservice.DynamicConfigValue) []*adminservice.DynamicConfigValue,
+) {
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	getResp, err := adminClient.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{Name: name})
+	if err != nil {
+		ErrorAndExit("Operation GetDynamicConfig failed.", err)
+	}
+	resp, err := adminClient.UpdateDynamicConfig(ctx, &adminservice.UpdateDynamicConfigRequest{
+		Name:     name,
+		Values:   update(getResp.GetValues()),
+		Identity: getCliIdentity(),
+		Reason:   c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateDynamicConfig failed.", err)
+	}
+	fmt.Printf("Dynamic config updated, version: %v\n", resp.GetVersion())
+}
+
+func printDynamicConfigEntries(entries []*adminservice.DynamicConfigEntry) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Name", "Value", "Constraints"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, entry := range entries {
+		for _, value := range entry.GetValues() {
+			table.Append([]string{entry.GetName(), value.GetValue(), formatConstraints(value.GetConstraints())})
+		}
+	}
+	table.Render()
+}
+
+func parseDynamicConfigConstraints(c *cli.Context) []*adminservice.DynamicConfigConstraint {
+	constraintsStr := c.String(FlagDynamicConfigConstraints)
+	if constraintsStr == "" {
+		return nil
+	}
+	var constraints []*adminservice.DynamicConfigConstraint
+	for _, kvstr := range strings.Split(constraintsStr, ",") {
+		kv := strings.Split(kvstr, ":")
+		if len(kv) != 2 {
+			ErrorAndExit("Constraints format error. It must be k1:v1,k2:v2,...,kn:vn", nil)
+		}
+		constraints = append(constraints, &adminservice.DynamicConfigConstraint{
+			Name:  strings.TrimSpace(kv[0]),
+			Value: strings.TrimSpace(kv[1]),
+		})
+	}
+	sort.Slice(constraints, func(i, j int) bool {
+		return constraints[i].GetName() < constraints[j].GetName()
+	})
+	return constraints
+}
+
+func sameDynamicConfigConstraints(a, b []*adminservice.DynamicConfigConstraint) bool {
+	return formatConstraints(a) == formatConstraints(b)
+}
+
+func formatConstraints(constraints []*adminservice.DynamicConfigConstraint) string {
+	kvs := make([]string, 0, len(constraints))
+	for _, constraint := range constraints {
+		kvs = append(kvs, constraint.GetName()+":"+constraint.GetValue())
+	}
+	sort.Strings(kvs)
+	return strings.Join(kvs, ",")
+}
+
+func formatDynamicConfigConstraints(constraints []*adminservice.DynamicConfigConstraint) string {
+	if len(constraints) == 0 {
+		return ""
+	}
+	return " for " + color.YellowString(formatConstraints(constraints))
+}
+
+func formatDynamicConfigValues(values []*adminservice.DynamicConfigValue) string {
+	if len(values) == 0 {
+		return "<deleted>"
+	}
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		if len(value.GetConstraints()) == 0 {
+			formatted = append(formatted, value.GetValue())
+		} else {
+			formatted = append(formatted, fmt.Sprintf("%v (%v)", value.GetValue(), formatConstraints(value.GetConstraints())))
+		}
+	}
+	return strings.Join(formatted, "; ")
+}
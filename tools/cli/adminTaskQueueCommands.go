@@ -76,7 +76,7 @@ func AdminDescribeTaskQueue(c *cli.Context) {
 	if len(pollers) == 0 {
 		ErrorAndExit(colorMagenta("No poller for taskqueue: "+taskQueue), nil)
 	}
-	printPollerInfo(pollers, tlType)
+	printPollerInfo(pollers, tlType, nil)
 }
 
 func printTaskQueueStatus(taskQueueStatus *taskqueuepb.TaskQueueStatus) {
@@ -96,19 +96,30 @@ func printTaskQueueStatus(taskQueueStatus *taskqueuepb.TaskQueueStatus) {
 	table.Render()
 }
 
-func printPollerInfo(pollers []*taskqueuepb.PollerInfo, taskQueueType enumspb.TaskQueueType) {
+func printPollerInfo(pollers []*taskqueuepb.PollerInfo, taskQueueType enumspb.TaskQueueType, buildIDs map[string]string) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
+	header := []string{"Decision Poller Identity", "Last Access Time"}
 	if taskQueueType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
-		table.SetHeader([]string{"Activity Poller Identity", "Last Access Time"})
-	} else {
-		table.SetHeader([]string{"Decision Poller Identity", "Last Access Time"})
+		header[0] = "Activity Poller Identity"
 	}
+	if buildIDs != nil {
+		header = append(header, "Build ID")
+	}
+	table.SetHeader(header)
 	table.SetHeaderLine(false)
-	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
+	headerColors := make([]tablewriter.Colors, len(header))
+	for i := range headerColors {
+		headerColors[i] = tableHeaderBlue
+	}
+	table.SetHeaderColor(headerColors...)
 	for _, poller := range pollers {
-		table.Append([]string{poller.GetIdentity(), convertTime(poller.GetLastAccessTime(), false)})
+		row := []string{poller.GetIdentity(), convertTime(poller.GetLastAccessTime(), false)}
+		if buildIDs != nil {
+			row = append(row, buildIDs[poller.GetIdentity()])
+		}
+		table.Append(row)
 	}
 	table.Render()
 }
@@ -100,6 +100,12 @@ func NewCliApp() *cli.App {
 			Usage:       "batch operation on a list of workflows from query.",
 			Subcommands: newBatchCommands(),
 		},
+		{
+			Name:        "schedule",
+			Aliases:     []string{"sch"},
+			Usage:       "Operate schedules which start workflows periodically",
+			Subcommands: newScheduleCommands(),
+		},
 		{
 			Name:    "admin",
 			Aliases: []string{"adm"},
@@ -164,6 +170,18 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on DLQ",
 					Subcommands: newAdminDLQCommands(),
 				},
+				{
+					Name:        "config",
+					Aliases:     []string{"cfg"},
+					Usage:       "Run admin operation on the dynamic config stored in the persistence store",
+					Subcommands: newAdminConfigCommands(),
+				},
+				{
+					Name:        "archival",
+					Aliases:     []string{"arc"},
+					Usage:       "Run admin operation on archival",
+					Subcommands: newAdminArchivalCommands(),
+				},
 				{
 					Name:        "db",
 					Aliases:     []string{"db"},
@@ -452,6 +452,26 @@ func (s *cliAppSuite) TestListWorkflow_WithWorkflowID() {
 	s.sdkClient.AssertExpectations(s.T())
 }
 
+func (s *cliAppSuite) TestListWorkflow_WithWorkflowID_Archived() {
+	s.sdkClient.On("ListClosedWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListClosedWorkflowExecutionsResponse{}, nil).Once()
+	s.sdkClient.On("ListArchivedWorkflow", mock.Anything, mock.MatchedBy(func(request *workflowservice.ListArchivedWorkflowExecutionsRequest) bool {
+		return request.GetQuery() == "WorkflowId = 'test-list-workflow-id'"
+	})).Return(&workflowservice.ListArchivedWorkflowExecutionsResponse{
+		Executions: listClosedWorkflowExecutionsResponse.Executions,
+	}, nil).Once()
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "list", "-wid", "test-list-workflow-id", "--include_archived"})
+	s.Nil(err)
+	s.sdkClient.AssertExpectations(s.T())
+}
+
+func (s *cliAppSuite) TestListWorkflow_WithWorkflowID_NotFound() {
+	s.sdkClient.On("ListClosedWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListClosedWorkflowExecutionsResponse{}, nil).Once()
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "list", "-wid", "test-list-workflow-id"})
+	s.Nil(err)
+	s.sdkClient.AssertExpectations(s.T())
+	s.sdkClient.AssertNotCalled(s.T(), "ListArchivedWorkflow", mock.Anything, mock.Anything)
+}
+
 func (s *cliAppSuite) TestListWorkflow_WithWorkflowType() {
 	s.sdkClient.On("ListClosedWorkflow", mock.Anything, mock.Anything).Return(listClosedWorkflowExecutionsResponse, nil).Once()
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "list", "-wt", "no-type"})
@@ -553,17 +573,15 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 }
 
 func (s *cliAppSuite) TestDescribeTaskQueue() {
-	s.sdkClient.On("DescribeTaskQueue", mock.Anything, mock.Anything, mock.Anything).Return(describeTaskQueueResponse, nil).Once()
+	s.frontendClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(describeTaskQueueResponse, nil)
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "taskqueue", "describe", "-tq", "test-taskQueue"})
 	s.Nil(err)
-	s.sdkClient.AssertExpectations(s.T())
 }
 
 func (s *cliAppSuite) TestDescribeTaskQueue_Activity() {
-	s.sdkClient.On("DescribeTaskQueue", mock.Anything, mock.Anything, mock.Anything).Return(describeTaskQueueResponse, nil).Once()
+	s.frontendClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(describeTaskQueueResponse, nil)
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "taskqueue", "describe", "-tq", "test-taskQueue", "-tqt", "activity"})
 	s.Nil(err)
-	s.sdkClient.AssertExpectations(s.T())
 }
 
 func (s *cliAppSuite) TestObserveWorkflow() {
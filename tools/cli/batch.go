@@ -105,10 +105,22 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagInputWithAlias,
 					Usage: "Optional input of signal",
 				},
+				cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, where to reset. Support one of these: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Binary checksum for resetType of BadBinary",
+				},
+				cli.BoolTFlag{
+					Name:  FlagSkipBaseIsNotCurrent,
+					Usage: "Skip the workflows whose base run is not the current run for batch reset, default to true",
+				},
 				cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
-					Usage: "RPS of processing",
+					Usage: "Max RPS of processing, the rate is lowered automatically when the cluster is overloaded",
 				},
 				cli.BoolFlag{
 					Name:  FlagYes,
@@ -189,6 +189,7 @@ const (
 	FlagResetBadBinaryChecksum            = "reset_bad_binary_checksum"
 	FlagListQuery                         = "query"
 	FlagListQueryWithAlias                = FlagListQuery + ", q"
+	FlagIncludeArchived                   = "include_archived"
 	FlagBatchType                         = "batch_type"
 	FlagBatchTypeWithAlias                = FlagBatchType + ", bt"
 	FlagSignalName                        = "signal_name"
@@ -206,6 +207,16 @@ const (
 	FlagJobID                             = "job_id"
 	FlagJobIDWithAlias                    = FlagJobID + ", jid"
 	FlagYes                               = "yes"
+	FlagScheduleID                        = "schedule_id"
+	FlagScheduleIDWithAlias               = FlagScheduleID + ", sid"
+	FlagInterval                          = "interval"
+	FlagPhase                             = "phase"
+	FlagTimeZone                          = "time_zone"
+	FlagOverlapPolicy                     = "overlap_policy"
+	FlagOverlapPolicyWithAlias            = FlagOverlapPolicy + ", op"
+	FlagCatchupWindow                     = "catchup_window"
+	FlagPaused                            = "paused"
+	FlagNote                              = "note"
 	FlagServiceConfigDir                  = "service_config_dir"
 	FlagServiceConfigDirWithAlias         = FlagServiceConfigDir + ", scd"
 	FlagServiceEnv                        = "service_env"
@@ -229,6 +240,13 @@ const (
 	FlagUpperShardBound                   = "upper_shard_bound"
 	FlagInputDirectory                    = "input_directory"
 	FlagAutoConfirm                       = "auto_confirm"
+	FlagBuildID                           = "build_id"
+	FlagBuildIDWithAlias                  = FlagBuildID + ", bid"
+	FlagCompatibleWith                    = "compatible_with"
+	FlagPromote                           = "promote"
+	FlagDynamicConfigValue                = "value"
+	FlagDynamicConfigConstraints          = "constraints"
+	FlagDynamicConfigChangedOnly          = "changed_only"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -456,6 +474,10 @@ func getFlagsForListAll() []cli.Flag {
 			Usage: "Optional SQL like query for use of search attributes. NOTE: using query will ignore all other filter flags including: " +
 				"[open, earliest_time, latest_time, workflow_id, workflow_type]",
 		},
+		cli.BoolFlag{
+			Name:  FlagIncludeArchived,
+			Usage: "List the archived runs of the workflow_id when no closed run is found, the runs may have passed retention",
+		},
 	}
 	flagsForListAll = append(getCommonFlagsForVisibility(), flagsForListAll...)
 	return flagsForListAll
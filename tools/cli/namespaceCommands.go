@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/worker/deletenamespace"
 )
 
 type (
@@ -338,6 +339,15 @@ func printNamespace(resp *workflowservice.DescribeNamespaceResponse) {
 		descValues = append(descValues, resp.Config.GetVisibilityArchivalUri())
 	}
 	fmt.Printf(formatStr, descValues...)
+	if progress, ok := deletenamespace.ProgressFromData(resp.NamespaceInfo.GetData()); ok {
+		fmt.Printf("DeletionStage: %v\nTerminatedExecutions: %v\nDeletedExecutions: %v\nDeletedVisibilityRecords: %v\nDeletedTaskQueues: %v\n",
+			progress.Stage,
+			progress.TerminatedExecutions,
+			progress.DeletedExecutions,
+			progress.DeletedVisibilityRecords,
+			progress.DeletedTaskQueues,
+		)
+	}
 	if resp.Config.BadBinaries != nil {
 		fmt.Println("Bad binaries to reset:")
 		table := tablewriter.NewWriter(os.Stdout)
@@ -0,0 +1,248 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/service/worker/scheduler"
+)
+
+func newScheduleCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "create",
+			Usage: "Create a schedule",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "Schedule Id",
+				},
+			}, getScheduleFlags()...),
+			Action: func(c *cli.Context) {
+				CreateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe a schedule, its recent and future actions",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "Schedule Id",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeSchedule(c)
+			},
+		},
+		{
+			Name:  "update",
+			Usage: "Replace the spec, action and policies of a schedule",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "Schedule Id",
+				},
+			}, getScheduleFlags()...),
+			Action: func(c *cli.Context) {
+				UpdateSchedule(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "Schedule Id",
+				},
+				cli.StringFlag{
+					Name:  FlagNote,
+					Usage: "Optional note of why the schedule is paused",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseSchedule(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "Schedule Id",
+				},
+				cli.StringFlag{
+					Name:  FlagNote,
+					Usage: "Optional note of why the schedule is unpaused",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseSchedule(c)
+			},
+		},
+		{
+			Name:  "trigger",
+			Usage: "Take the action of a schedule now",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "Schedule Id",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy overriding the one of the schedule: " + strings.Join(scheduler.AllOverlapPolicies, ","),
+				},
+			},
+			Action: func(c *cli.Context) {
+				TriggerSchedule(c)
+			},
+		},
+		{
+			Name:  "backfill",
+			Usage: "Take the actions a schedule would have taken in a time range",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "Schedule Id",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTime,
+					Usage: "Start of the time range, supported formats are '2006-01-02T15:04:05Z', raw UnixNano and time range (N<duration>)",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTime,
+					Usage: "End of the time range, supported formats are '2006-01-02T15:04:05Z', raw UnixNano and time range (N<duration>)",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy overriding the one of the schedule: " + strings.Join(scheduler.AllOverlapPolicies, ","),
+				},
+			},
+			Action: func(c *cli.Context) {
+				BackfillSchedule(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a schedule, the workflows it started keep running",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "Schedule Id",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DeleteSchedule(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of a namespace",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 30,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListSchedules(c)
+			},
+		},
+	}
+}
+
+func getScheduleFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  FlagCronSchedule,
+			Usage: "Cron expression of the times to take the action, can be passed multiple times",
+		},
+		cli.StringFlag{
+			Name:  FlagInterval,
+			Usage: "Take the action every interval, e.g. 90m",
+		},
+		cli.StringFlag{
+			Name:  FlagPhase,
+			Usage: "Optional offset of the interval from the unix epoch, e.g. 15m",
+		},
+		cli.StringFlag{
+			Name:  FlagTimeZone,
+			Usage: "Optional time zone of the cron expressions, default to UTC",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "Type of the workflow to start",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDWithAlias,
+			Usage: "Workflow Id prefix, the nominal time of each action is appended to it",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskQueueWithAlias,
+			Usage: "TaskQueue of the workflow to start",
+		},
+		cli.StringFlag{
+			Name:  FlagInputWithAlias,
+			Usage: "Optional input for the workflow, in JSON format. If there are multiple parameters, concatenate them and separate by space.",
+		},
+		cli.StringFlag{
+			Name:  FlagInputFileWithAlias,
+			Usage: "Optional input for the workflow from JSON file. If there are multiple JSON, concatenate them and separate by space or newline.",
+		},
+		cli.IntFlag{
+			Name:  FlagExecutionTimeoutWithAlias,
+			Usage: "Optional workflow execution timeout of the workflow to start, including retries and continue-as-new (seconds)",
+		},
+		cli.IntFlag{
+			Name:  FlagDecisionTimeoutWithAlias,
+			Usage: "Optional decision task start to close timeout of the workflow to start (seconds)",
+		},
+		cli.StringFlag{
+			Name:  FlagOverlapPolicyWithAlias,
+			Value: scheduler.OverlapPolicySkip,
+			Usage: "What to do when an action is due while the previous workflow is running: " + strings.Join(scheduler.AllOverlapPolicies, ","),
+		},
+		cli.StringFlag{
+			Name:  FlagCatchupWindow,
+			Usage: "Optional window in which missed actions are still taken, e.g. after an outage. Default to 1m",
+		},
+		cli.BoolFlag{
+			Name:  FlagPaused,
+			Usage: "Optional flag to create or update the schedule in paused state",
+		},
+		cli.StringFlag{
+			Name:  FlagNote,
+			Usage: "Optional note of the schedule state",
+		},
+	}
+}
@@ -0,0 +1,239 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/service/worker/scheduler"
+)
+
+// CreateSchedule creates a schedule
+func CreateSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	schedule := getScheduleFromFlags(c)
+
+	client := newScheduleClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	if err := client.Create(tcCtx, namespace, scheduleID, schedule, getCurrentUserFromEnv()); err != nil {
+		ErrorAndExit("Failed to create schedule", err)
+	}
+	output := map[string]interface{}{
+		"msg":        "schedule is created",
+		"scheduleId": scheduleID,
+	}
+	prettyPrintJSONObject(output)
+}
+
+// DescribeSchedule describes a schedule
+func DescribeSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	client := newScheduleClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.Describe(tcCtx, namespace, scheduleID)
+	if err != nil {
+		ErrorAndExit("Failed to describe schedule", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// UpdateSchedule replaces the schedule
+func UpdateSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	schedule := getScheduleFromFlags(c)
+
+	client := newScheduleClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	if err := client.Update(tcCtx, namespace, scheduleID, schedule); err != nil {
+		ErrorAndExit("Failed to update schedule", err)
+	}
+	printScheduleMessage("schedule is updated")
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	client := newScheduleClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	if err := client.Pause(tcCtx, namespace, scheduleID, c.String(FlagNote)); err != nil {
+		ErrorAndExit("Failed to pause schedule", err)
+	}
+	printScheduleMessage("schedule is paused")
+}
+
+// UnpauseSchedule unpauses a schedule
+func UnpauseSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	client := newScheduleClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	if err := client.Unpause(tcCtx, namespace, scheduleID, c.String(FlagNote)); err != nil {
+		ErrorAndExit("Failed to unpause schedule", err)
+	}
+	printScheduleMessage("schedule is unpaused")
+}
+
+// TriggerSchedule takes the action of a schedule now
+func TriggerSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	client := newScheduleClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	if err := client.Trigger(tcCtx, namespace, scheduleID, c.String(FlagOverlapPolicy)); err != nil {
+		ErrorAndExit("Failed to trigger schedule", err)
+	}
+	printScheduleMessage("schedule is triggered")
+}
+
+// BackfillSchedule takes the actions a schedule would have taken in a time range
+func BackfillSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	now := time.Now()
+	startTime := parseTime(getRequiredOption(c, FlagEarliestTime), 0, now)
+	endTime := parseTime(getRequiredOption(c, FlagLatestTime), 0, now)
+
+	client := newScheduleClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := client.Backfill(tcCtx, namespace, scheduleID, scheduler.BackfillRequest{
+		StartTime:     time.Unix(0, startTime).UTC(),
+		EndTime:       time.Unix(0, endTime).UTC(),
+		OverlapPolicy: c.String(FlagOverlapPolicy),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to backfill schedule", err)
+	}
+	printScheduleMessage("schedule backfill is requested")
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	client := newScheduleClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	if err := client.Delete(tcCtx, namespace, scheduleID); err != nil {
+		ErrorAndExit("Failed to delete schedule", err)
+	}
+	printScheduleMessage("schedule is deleted")
+}
+
+// ListSchedules lists the schedules of a namespace
+func ListSchedules(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	pageSize := c.Int(FlagPageSize)
+
+	client := newScheduleClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	output := []scheduler.ScheduleListEntry{}
+	var nextPageToken []byte
+	for {
+		entries, token, err := client.List(tcCtx, namespace, pageSize, nextPageToken)
+		if err != nil {
+			ErrorAndExit("Failed to list schedules", err)
+		}
+		output = append(output, entries...)
+		if len(token) == 0 {
+			break
+		}
+		nextPageToken = token
+	}
+	prettyPrintJSONObject(output)
+}
+
+func newScheduleClient(c *cli.Context) *scheduler.Client {
+	return scheduler.NewClient(cFactory.SDKClient(c, common.SystemLocalNamespace))
+}
+
+func getScheduleFromFlags(c *cli.Context) scheduler.Schedule {
+	schedule := scheduler.Schedule{
+		Spec: scheduler.ScheduleSpec{
+			CronExpressions: c.StringSlice(FlagCronSchedule),
+			Interval:        parseScheduleDuration(c, FlagInterval),
+			Phase:           parseScheduleDuration(c, FlagPhase),
+			TimeZone:        c.String(FlagTimeZone),
+		},
+		Action: scheduler.ScheduleAction{
+			WorkflowType:             getRequiredOption(c, FlagWorkflowType),
+			WorkflowID:               getRequiredOption(c, FlagWorkflowID),
+			TaskQueue:                getRequiredOption(c, FlagTaskQueue),
+			Input:                    processJSONInput(c),
+			WorkflowExecutionTimeout: time.Duration(c.Int(FlagExecutionTimeout)) * time.Second,
+			WorkflowTaskTimeout:      time.Duration(c.Int(FlagDecisionTimeout)) * time.Second,
+		},
+		Policies: scheduler.SchedulePolicies{
+			OverlapPolicy: c.String(FlagOverlapPolicy),
+			CatchupWindow: parseScheduleDuration(c, FlagCatchupWindow),
+		},
+		State: scheduler.ScheduleState{
+			Paused: c.Bool(FlagPaused),
+			Notes:  c.String(FlagNote),
+		},
+	}
+	if err := schedule.Validate(); err != nil {
+		ErrorAndExit("Invalid schedule", err)
+	}
+	return schedule
+}
+
+func parseScheduleDuration(c *cli.Context, flagName string) time.Duration {
+	if !c.IsSet(flagName) {
+		return 0
+	}
+	d, err := time.ParseDuration(c.String(flagName))
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", flagName), err)
+	}
+	return d
+}
+
+func printScheduleMessage(msg string) {
+	output := map[string]interface{}{
+		"msg": msg,
+	}
+	prettyPrintJSONObject(output)
+}
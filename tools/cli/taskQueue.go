@@ -61,5 +61,74 @@ func newTaskQueueCommands() []cli.Command {
 				ListTaskQueuePartitions(c)
 			},
 		},
+		{
+			Name:        "build-ids",
+			Aliases:     []string{"bid"},
+			Usage:       "Operate the compatible worker build ID sets of taskqueue",
+			Subcommands: newTaskQueueBuildIDCommands(),
+		},
+	}
+}
+
+func newTaskQueueBuildIDCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the compatible build ID sets of taskqueue, the last one is the default",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "TaskQueue name",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListTaskQueueBuildIDs(c)
+			},
+		},
+		{
+			Name:    "add",
+			Aliases: []string{"a"},
+			Usage:   "Add a build ID as the new default, or compatible with an existing build ID",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "TaskQueue name",
+				},
+				cli.StringFlag{
+					Name:  FlagBuildIDWithAlias,
+					Usage: "Build ID of the workers",
+				},
+				cli.StringFlag{
+					Name:  FlagCompatibleWith,
+					Usage: "Optional existing build ID the new build ID is compatible with",
+				},
+				cli.BoolFlag{
+					Name:  FlagPromote,
+					Usage: "Make the set of the compatible build IDs the default one",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AddTaskQueueBuildID(c)
+			},
+		},
+		{
+			Name:    "promote",
+			Aliases: []string{"p"},
+			Usage:   "Make the set of a build ID the default one",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "TaskQueue name",
+				},
+				cli.StringFlag{
+					Name:  FlagBuildIDWithAlias,
+					Usage: "Build ID of the workers",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PromoteTaskQueueBuildID(c)
+			},
+		},
 	}
 }
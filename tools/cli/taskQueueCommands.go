@@ -25,47 +25,83 @@
 package cli
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
-	enumspb "go.temporal.io/api/enums/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/versioning"
 )
 
-// DescribeTaskQueue show pollers info of a given taskqueue
+// DescribeTaskQueue show pollers info and backlog statistics of a given taskqueue
 func DescribeTaskQueue(c *cli.Context) {
-	wfClient := getWorkflowClient(c)
+	frontendClient := cFactory.FrontendClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	taskQueue := getRequiredOption(c, FlagTaskQueue)
 	taskQueueType := strToTaskQueueType(c.String(FlagTaskQueueType)) // default type is decision
 
 	ctx, cancel := newContext(c)
 	defer cancel()
-	response, err := wfClient.DescribeTaskQueue(ctx, taskQueue, taskQueueType)
+	request := &workflowservice.DescribeTaskQueueRequest{
+		Namespace:              namespace,
+		TaskQueue:              &taskqueuepb.TaskQueue{Name: taskQueue},
+		TaskQueueType:          taskQueueType,
+		IncludeTaskQueueStatus: true,
+	}
+	var header metadata.MD
+	response, err := frontendClient.DescribeTaskQueue(ctx, request, grpc.Header(&header))
 	if err != nil {
 		ErrorAndExit("Operation DescribeTaskQueue failed.", err)
 	}
 
+	stats, err := headers.GetTaskQueueStats(header)
+	if err != nil {
+		ErrorAndExit("Failed to parse task queue stats.", err)
+	}
+	if stats != nil {
+		printTaskQueueStats(stats)
+		fmt.Printf("\n")
+	}
+
 	pollers := response.Pollers
 	if len(pollers) == 0 {
 		ErrorAndExit(colorMagenta("No poller for taskqueue: "+taskQueue), nil)
 	}
+	var buildIDs map[string]string
+	if stats != nil && len(stats.PollerBuildIDs) > 0 {
+		buildIDs = stats.PollerBuildIDs
+	}
+	printPollerInfo(pollers, taskQueueType, buildIDs)
+}
+
+func printTaskQueueStats(stats *headers.TaskQueueStats) {
+	oldestTaskAge := "-"
+	if !stats.OldestTaskCreateTime.IsZero() {
+		oldestTaskAge = time.Since(stats.OldestTaskCreateTime).Round(time.Second).String()
+	}
 
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
-	if taskQueueType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
-		table.SetHeader([]string{"Activity Poller Identity", "Last Access Time"})
-	} else {
-		table.SetHeader([]string{"Decision Poller Identity", "Last Access Time"})
-	}
+	table.SetHeader([]string{"Approximate Backlog", "Oldest Task Age", "Add Rate", "Dispatch Rate", "Sync Match Rate"})
 	table.SetHeaderLine(false)
-	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
-	for _, poller := range pollers {
-		table.Append([]string{poller.GetIdentity(), convertTime(poller.GetLastAccessTime(), false)})
-	}
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	table.Append([]string{strconv.FormatInt(stats.ApproximateBacklogCount, 10),
+		oldestTaskAge,
+		strconv.FormatFloat(stats.AddRate, 'f', 2, 64),
+		strconv.FormatFloat(stats.DispatchRate, 'f', 2, 64),
+		strconv.FormatFloat(stats.SyncMatchRate, 'f', 2, 64)})
 	table.Render()
 }
 
@@ -106,3 +142,98 @@ func printTaskQueuePartitions(taskQueueType string, partitions []*taskqueuepb.Ta
 	}
 	table.Render()
 }
+
+// ListTaskQueueBuildIDs lists the compatible build ID sets of a taskqueue
+func ListTaskQueueBuildIDs(c *cli.Context) {
+	taskQueue := getRequiredOption(c, FlagTaskQueue)
+	buildIDSets := getTaskQueueBuildIDSets(c, taskQueue)
+	if len(buildIDSets) == 0 {
+		fmt.Println(colorMagenta("TaskQueue " + taskQueue + " is not versioned."))
+		return
+	}
+	printTaskQueueBuildIDSets(buildIDSets)
+}
+
+// AddTaskQueueBuildID adds a build ID to the compatible build ID sets of a taskqueue
+func AddTaskQueueBuildID(c *cli.Context) {
+	taskQueue := getRequiredOption(c, FlagTaskQueue)
+	buildID := getRequiredOption(c, FlagBuildID)
+	buildIDSets := getTaskQueueBuildIDSets(c, taskQueue)
+
+	var err error
+	if compatibleWith := c.String(FlagCompatibleWith); compatibleWith != "" {
+		buildIDSets, err = buildIDSets.AddCompatible(buildID, compatibleWith, c.Bool(FlagPromote))
+	} else {
+		buildIDSets, err = buildIDSets.AddNewDefault(buildID)
+	}
+	if err != nil {
+		ErrorAndExit("Failed to add build ID.", err)
+	}
+	updateTaskQueueBuildIDSets(c, taskQueue, buildIDSets)
+	printTaskQueueBuildIDSets(buildIDSets)
+}
+
+// PromoteTaskQueueBuildID makes the compatible build ID set of a build ID the default one of a taskqueue
+func PromoteTaskQueueBuildID(c *cli.Context) {
+	taskQueue := getRequiredOption(c, FlagTaskQueue)
+	buildID := getRequiredOption(c, FlagBuildID)
+	buildIDSets, err := getTaskQueueBuildIDSets(c, taskQueue).Promote(buildID)
+	if err != nil {
+		ErrorAndExit("Failed to promote build ID.", err)
+	}
+	updateTaskQueueBuildIDSets(c, taskQueue, buildIDSets)
+	printTaskQueueBuildIDSets(buildIDSets)
+}
+
+func getTaskQueueBuildIDSets(c *cli.Context, taskQueue string) versioning.BuildIDSets {
+	frontendClient := cFactory.FrontendClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := frontendClient.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Name: namespace,
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeNamespace failed.", err)
+	}
+	buildIDSets, err := versioning.GetBuildIDSets(response.GetNamespaceInfo().GetData(), taskQueue)
+	if err != nil {
+		ErrorAndExit("Failed to parse build IDs.", err)
+	}
+	return buildIDSets
+}
+
+func updateTaskQueueBuildIDSets(c *cli.Context, taskQueue string, buildIDSets versioning.BuildIDSets) {
+	frontendClient := cFactory.FrontendClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	value, err := buildIDSets.Encode()
+	if err != nil {
+		ErrorAndExit("Failed to encode build IDs.", err)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = frontendClient.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Name: namespace,
+		UpdateInfo: &namespacepb.UpdateNamespaceInfo{
+			Data: map[string]string{versioning.NamespaceDataKey(taskQueue): value},
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateNamespace failed.", err)
+	}
+}
+
+func printTaskQueueBuildIDSets(buildIDSets versioning.BuildIDSets) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Compatible Build IDs", "Default"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
+	for i, set := range buildIDSets {
+		table.Append([]string{strings.Join(set, ", "), strconv.FormatBool(i == len(buildIDSets)-1)})
+	}
+	table.Render()
+}
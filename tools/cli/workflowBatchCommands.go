@@ -36,6 +36,7 @@ import (
 	sdkclient "go.temporal.io/sdk/client"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/service/worker/batcher"
@@ -155,6 +156,14 @@ func StartBatchJob(c *cli.Context) {
 		sigName = getRequiredOption(c, FlagSignalName)
 		sigVal = getRequiredOption(c, FlagInput)
 	}
+	var resetParams batcher.ResetParams
+	if batchType == batcher.BatchTypeReset {
+		resetParams.ResetType = getRequiredOption(c, FlagResetType)
+		if resetParams.ResetType == batcher.ResetTypeBadBinary {
+			resetParams.BadBinaryChecksum = getRequiredOption(c, FlagResetBadBinaryChecksum)
+		}
+		resetParams.SkipBaseNotCurrent = convert.BoolPtr(c.BoolT(FlagSkipBaseIsNotCurrent))
+	}
 	rps := c.Int(FlagRPS)
 
 	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
@@ -212,7 +221,8 @@ func StartBatchJob(c *cli.Context) {
 			SignalName: sigName,
 			Input:      sigInput,
 		},
-		RPS: rps,
+		ResetParams: resetParams,
+		RPS:         rps,
 	}
 	wf, err := client.ExecuteWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
 	if err != nil {
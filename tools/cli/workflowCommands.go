@@ -1199,9 +1199,35 @@ func listClosedWorkflow(client client.Client, pageSize int, earliestTime, latest
 	if err != nil {
 		ErrorAndExit("Failed to list closed workflow.", err)
 	}
+	if c.Bool(FlagIncludeArchived) && len(workflowID) > 0 &&
+		nextPageToken == nil && len(response.Executions) == 0 && response.NextPageToken == nil {
+		// the runs of the workflow may have passed retention, look them up from the archival
+		return listArchivedWorkflowByID(client, pageSize, workflowID, c), nil
+	}
 	return response.Executions, response.NextPageToken
 }
 
+// listArchivedWorkflowByID returns the first page of archived runs of a workflow,
+// nothing is returned if the namespace does not archive visibility
+func listArchivedWorkflowByID(client client.Client, pageSize int, workflowID string, c *cli.Context) []*workflowpb.WorkflowExecutionInfo {
+	request := &workflowservice.ListArchivedWorkflowExecutionsRequest{
+		PageSize: int32(pageSize),
+		Query:    fmt.Sprintf("WorkflowId = '%s'", strings.ReplaceAll(workflowID, "'", "\\'")),
+	}
+
+	contextTimeout := defaultContextTimeoutForListArchivedWorkflow
+	if c.GlobalIsSet(FlagContextTimeout) {
+		contextTimeout = time.Duration(c.GlobalInt(FlagContextTimeout)) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+	response, err := client.ListArchivedWorkflow(ctx, request)
+	if err != nil {
+		return nil
+	}
+	return response.Executions
+}
+
 func getListResultInRaw(c *cli.Context, queryOpen bool, nextPageToken []byte) ([]*workflowpb.WorkflowExecutionInfo, []byte) {
 	wfClient := getWorkflowClient(c)
 